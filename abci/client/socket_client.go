@@ -33,6 +33,7 @@ type socketClient struct {
 
 	addr        string
 	mustConnect bool
+	failover    bool // invoke the callbacks of the pending requests on stop
 	conn        net.Conn
 
 	reqQueue   chan *ReqRes
@@ -63,6 +64,16 @@ func NewSocketClient(addr string, mustConnect bool) Client {
 	return cli
 }
 
+// NewFailoverSocketClient creates a new socket client like NewSocketClient,
+// for a connection which fails over to another client once it stops. The
+// callbacks of the requests pending when it stops are invoked with an
+// exception response, so that their callers can submit them again.
+func NewFailoverSocketClient(addr string, mustConnect bool) Client {
+	cli := NewSocketClient(addr, mustConnect).(*socketClient)
+	cli.failover = true
+	return cli
+}
+
 // OnStart implements Service by connecting to the server and spawning reading
 // and writing goroutines.
 func (cli *socketClient) OnStart() error {
@@ -291,8 +302,8 @@ func (cli *socketClient) Query(ctx context.Context, req *types.RequestQuery) (*t
 	return reqRes.Response.GetQuery(), cli.Error()
 }
 
-func (cli *socketClient) Commit(ctx context.Context, _ *types.RequestCommit) (*types.ResponseCommit, error) {
-	reqRes, err := cli.queueRequest(ctx, types.ToRequestCommit())
+func (cli *socketClient) Commit(ctx context.Context, req *types.RequestCommit) (*types.ResponseCommit, error) {
+	reqRes, err := cli.queueRequest(ctx, types.ToRequestCommitWithSnapshot(req.GetSnapshot()))
 	if err != nil {
 		return nil, err
 	}
@@ -434,27 +445,48 @@ func (cli *socketClient) queueRequest(ctx context.Context, req *types.Request) (
 }
 
 // flushQueue marks as complete and discards all remaining pending requests
-// from the queue.
+// from the queue. On failover clients, their callbacks are invoked with an
+// exception response, so that the callers waiting for them are released.
 func (cli *socketClient) flushQueue() {
 	cli.mtx.Lock()
-	defer cli.mtx.Unlock()
+	errStr := "abci.socketClient stopped"
+	if cli.err != nil {
+		errStr = cli.err.Error()
+	}
 
 	// mark all in-flight messages as resolved (they will get cli.Error())
+	var flushed []*ReqRes
 	for req := cli.reqSent.Front(); req != nil; req = req.Next() {
-		reqres := req.Value.(*ReqRes)
-		reqres.Done()
+		flushed = append(flushed, req.Value.(*ReqRes))
 	}
+	cli.reqSent.Init()
 
 	// mark all queued messages as resolved
 LOOP:
 	for {
 		select {
 		case reqres := <-cli.reqQueue:
-			reqres.Done()
+			flushed = append(flushed, reqres)
 		default:
 			break LOOP
 		}
 	}
+
+	for _, reqres := range flushed {
+		if cli.failover {
+			reqres.Response = types.ToResponseException(errStr)
+		}
+		reqres.Done()
+	}
+	cli.mtx.Unlock()
+
+	if !cli.failover {
+		return
+	}
+	// the callbacks may call the client, so they are invoked without the lock
+	for _, reqres := range flushed {
+		reqres.InvokeCallback()
+	}
 }
 
 //----------------------------------------
@@ -74,6 +74,41 @@ func TestHangingAsyncCalls(t *testing.T) {
 	}
 }
 
+// TestCallbackInvokedWhenClientStops ensures that the callbacks of the
+// requests in flight are invoked when a failover client stops, and only then.
+func TestCallbackInvokedWhenClientStops(t *testing.T) {
+	for name, tc := range map[string]struct {
+		newClient func(addr string, mustConnect bool) abcicli.Client
+		invoked   bool
+	}{
+		"socket":   {abcicli.NewSocketClient, false},
+		"failover": {abcicli.NewFailoverSocketClient, true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s, c := setupClientServerWith(t, slowApp{}, tc.newClient)
+
+			reqRes, err := c.CheckTxAsync(context.Background(), &types.RequestCheckTx{})
+			require.NoError(t, err)
+			done := make(chan *types.Response, 1)
+			reqRes.SetCallback(func(res *types.Response) {
+				done <- res
+			})
+			// wait for the request to travel the socket, then kill the server
+			time.Sleep(50 * time.Millisecond)
+			require.NoError(t, s.Stop())
+
+			select {
+			case <-time.After(time.Second):
+				require.False(t, tc.invoked, "No callback invoked")
+			case res := <-done:
+				require.True(t, tc.invoked, "Callback invoked")
+				assert.NotNil(t, res.GetException())
+				assert.Nil(t, res.GetCheckTx())
+			}
+		})
+	}
+}
+
 func TestBulk(t *testing.T) {
 	const numTxs = 700000
 	// use a socket instead of a port
@@ -125,6 +160,15 @@ func setupClientServer(t *testing.T, app types.Application) (
 	service.Service, abcicli.Client,
 ) {
 	t.Helper()
+	return setupClientServerWith(t, app, abcicli.NewSocketClient)
+}
+
+func setupClientServerWith(
+	t *testing.T,
+	app types.Application,
+	newClient func(addr string, mustConnect bool) abcicli.Client,
+) (service.Service, abcicli.Client) {
+	t.Helper()
 
 	// some port between 20k and 30k
 	port := 20000 + cmtrand.Int32()%10000
@@ -140,7 +184,7 @@ func setupClientServer(t *testing.T, app types.Application) (
 		}
 	})
 
-	c := abcicli.NewSocketClient(addr, true)
+	c := newClient(addr, true)
 	err = c.Start()
 	require.NoError(t, err)
 
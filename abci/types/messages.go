@@ -53,8 +53,14 @@ func ToRequestCheckTx(req *RequestCheckTx) *Request {
 }
 
 func ToRequestCommit() *Request {
+	return ToRequestCommitWithSnapshot(false)
+}
+
+// ToRequestCommitWithSnapshot returns a commit request asking the application
+// to take a state sync snapshot of the committed state if snapshot is set.
+func ToRequestCommitWithSnapshot(snapshot bool) *Request {
 	return &Request{
-		Value: &Request_Commit{&RequestCommit{}},
+		Value: &Request_Commit{&RequestCommit{Snapshot: snapshot}},
 	}
 }
 
@@ -842,6 +842,9 @@ func (m *RequestCheckTx) GetType() CheckTxType {
 }
 
 type RequestCommit struct {
+	// snapshot is set when the node's snapshot schedule asks the application
+	// to take a state sync snapshot of the state being committed.
+	Snapshot bool `protobuf:"varint,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 }
 
 func (m *RequestCommit) Reset()         { *m = RequestCommit{} }
@@ -877,6 +880,13 @@ func (m *RequestCommit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RequestCommit proto.InternalMessageInfo
 
+func (m *RequestCommit) GetSnapshot() bool {
+	if m != nil {
+		return m.Snapshot
+	}
+	return false
+}
+
 // lists available snapshots
 type RequestListSnapshots struct {
 }
@@ -3827,218 +3837,218 @@ func init() {
 func init() { proto.RegisterFile("tendermint/abci/types.proto", fileDescriptor_252557cfdd89a31a) }
 
 var fileDescriptor_252557cfdd89a31a = []byte{
-	// 3374 bytes of a gzipped FileDescriptorProto
+	// 3373 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x3d, 0x70, 0x23, 0xc7,
 	0xb1, 0xc6, 0x02, 0x0b, 0x10, 0x68, 0xfc, 0x2d, 0x87, 0xbc, 0x13, 0x0e, 0x3a, 0xf1, 0xa8, 0xd5,
-	0x93, 0x74, 0x3a, 0x49, 0xa4, 0x1e, 0xef, 0x9d, 0x7e, 0xea, 0xa4, 0x57, 0x05, 0xe2, 0x70, 0x0f,
-	0xe4, 0x51, 0x24, 0xb5, 0xc4, 0x9d, 0x4a, 0xcf, 0xb6, 0x56, 0x4b, 0x60, 0x48, 0xac, 0x0e, 0xc0,
-	0xae, 0x76, 0x07, 0x14, 0x79, 0x91, 0x6d, 0x95, 0x03, 0xcb, 0x89, 0xaa, 0xec, 0x40, 0x81, 0x15,
-	0x38, 0x70, 0x39, 0x76, 0xe2, 0xd0, 0x4e, 0x15, 0x38, 0x50, 0xe8, 0x48, 0x76, 0x49, 0x99, 0x43,
-	0x3b, 0x70, 0x68, 0xd7, 0xfc, 0xec, 0x1f, 0x80, 0x25, 0x00, 0x4a, 0x0e, 0x5c, 0x76, 0xb6, 0xd3,
-	0xd3, 0xdd, 0xb3, 0xdb, 0xdb, 0xd3, 0xd3, 0xfd, 0xf5, 0xc0, 0xe3, 0x04, 0x0f, 0x3a, 0xd8, 0xe9,
-	0x9b, 0x03, 0xb2, 0x6e, 0x1c, 0xb6, 0xcd, 0x75, 0x72, 0x66, 0x63, 0x77, 0xcd, 0x76, 0x2c, 0x62,
-	0xa1, 0x72, 0x30, 0xb9, 0x46, 0x27, 0xab, 0x4f, 0x84, 0xb8, 0xdb, 0xce, 0x99, 0x4d, 0xac, 0x75,
-	0xdb, 0xb1, 0xac, 0x23, 0xce, 0x5f, 0xbd, 0x3a, 0x3e, 0xfd, 0x10, 0x9f, 0x09, 0x6d, 0x11, 0x61,
-	0xb6, 0xca, 0xba, 0x6d, 0x38, 0x46, 0xdf, 0x9b, 0x5e, 0x1d, 0x9b, 0x3e, 0x31, 0x7a, 0x66, 0xc7,
-	0x20, 0x96, 0x33, 0x41, 0x3d, 0xe7, 0x08, 0xbd, 0x6c, 0xf5, 0xda, 0xb1, 0x65, 0x1d, 0xf7, 0xf0,
-	0x3a, 0x1b, 0x1d, 0x0e, 0x8f, 0xd6, 0x89, 0xd9, 0xc7, 0x2e, 0x31, 0xfa, 0xb6, 0x60, 0x58, 0x19,
-	0x65, 0xe8, 0x0c, 0x1d, 0x83, 0x98, 0xd6, 0x40, 0xcc, 0x2f, 0x1f, 0x5b, 0xc7, 0x16, 0x7b, 0x5c,
-	0xa7, 0x4f, 0x9c, 0xaa, 0xfe, 0x2e, 0x07, 0x0b, 0x1a, 0xfe, 0x60, 0x88, 0x5d, 0x82, 0x36, 0x40,
-	0xc6, 0xed, 0xae, 0x55, 0x91, 0x56, 0xa5, 0xeb, 0xf9, 0x8d, 0xab, 0x6b, 0x23, 0xe6, 0x59, 0x13,
-	0x7c, 0x8d, 0x76, 0xd7, 0x6a, 0x26, 0x34, 0xc6, 0x8b, 0x6e, 0x41, 0xfa, 0xa8, 0x37, 0x74, 0xbb,
-	0x95, 0x24, 0x13, 0x7a, 0x22, 0x4e, 0xe8, 0x2e, 0x65, 0x6a, 0x26, 0x34, 0xce, 0x4d, 0x97, 0x32,
-	0x07, 0x47, 0x56, 0x25, 0x75, 0xfe, 0x52, 0x5b, 0x83, 0x23, 0xb6, 0x14, 0xe5, 0x45, 0x9b, 0x00,
-	0xe6, 0xc0, 0x24, 0x7a, 0xbb, 0x6b, 0x98, 0x83, 0x4a, 0x9a, 0x49, 0x3e, 0x19, 0x2f, 0x69, 0x92,
-	0x3a, 0x65, 0x6c, 0x26, 0xb4, 0x9c, 0xe9, 0x0d, 0xe8, 0xeb, 0x7e, 0x30, 0xc4, 0xce, 0x59, 0x25,
-	0x73, 0xfe, 0xeb, 0xbe, 0x45, 0x99, 0xe8, 0xeb, 0x32, 0x6e, 0xf4, 0x3a, 0x64, 0xdb, 0x5d, 0xdc,
-	0x7e, 0xa8, 0x93, 0xd3, 0x4a, 0x96, 0x49, 0x5e, 0x8b, 0x93, 0xac, 0x53, 0xbe, 0xd6, 0x69, 0x33,
-	0xa1, 0x2d, 0xb4, 0xf9, 0x23, 0x7a, 0x15, 0x32, 0x6d, 0xab, 0xdf, 0x37, 0x49, 0x25, 0xcf, 0x64,
-	0x57, 0x62, 0x65, 0x19, 0x57, 0x33, 0xa1, 0x09, 0x7e, 0xb4, 0x0b, 0xa5, 0x9e, 0xe9, 0x12, 0xdd,
-	0x1d, 0x18, 0xb6, 0xdb, 0xb5, 0x88, 0x5b, 0x29, 0x30, 0x0d, 0x4f, 0xc7, 0x69, 0xd8, 0x31, 0x5d,
-	0x72, 0xe0, 0x31, 0x37, 0x13, 0x5a, 0xb1, 0x17, 0x26, 0x50, 0x7d, 0xd6, 0xd1, 0x11, 0x76, 0x7c,
-	0x85, 0x95, 0xe2, 0xf9, 0xfa, 0xf6, 0x28, 0xb7, 0x27, 0x4f, 0xf5, 0x59, 0x61, 0x02, 0xfa, 0x0e,
-	0x2c, 0xf5, 0x2c, 0xa3, 0xe3, 0xab, 0xd3, 0xdb, 0xdd, 0xe1, 0xe0, 0x61, 0xa5, 0xc4, 0x94, 0x3e,
-	0x17, 0xfb, 0x92, 0x96, 0xd1, 0xf1, 0x54, 0xd4, 0xa9, 0x40, 0x33, 0xa1, 0x2d, 0xf6, 0x46, 0x89,
-	0xe8, 0x5d, 0x58, 0x36, 0x6c, 0xbb, 0x77, 0x36, 0xaa, 0xbd, 0xcc, 0xb4, 0xdf, 0x88, 0xd3, 0x5e,
-	0xa3, 0x32, 0xa3, 0xea, 0x91, 0x31, 0x46, 0x45, 0x2d, 0x50, 0x6c, 0x07, 0xdb, 0x86, 0x83, 0x75,
-	0xdb, 0xb1, 0x6c, 0xcb, 0x35, 0x7a, 0x15, 0x85, 0xe9, 0x7e, 0x36, 0x4e, 0xf7, 0x3e, 0xe7, 0xdf,
-	0x17, 0xec, 0xcd, 0x84, 0x56, 0xb6, 0xa3, 0x24, 0xae, 0xd5, 0x6a, 0x63, 0xd7, 0x0d, 0xb4, 0x2e,
-	0x4e, 0xd3, 0xca, 0xf8, 0xa3, 0x5a, 0x23, 0x24, 0xd4, 0x80, 0x3c, 0x3e, 0xa5, 0xe2, 0xfa, 0x89,
-	0x45, 0x70, 0x05, 0x31, 0x85, 0x6a, 0xec, 0x0e, 0x65, 0xac, 0x0f, 0x2c, 0x82, 0x9b, 0x09, 0x0d,
-	0xb0, 0x3f, 0x42, 0x06, 0x5c, 0x3a, 0xc1, 0x8e, 0x79, 0x74, 0xc6, 0xd4, 0xe8, 0x6c, 0xc6, 0x35,
-	0xad, 0x41, 0x65, 0x89, 0x29, 0x7c, 0x3e, 0x4e, 0xe1, 0x03, 0x26, 0x44, 0x55, 0x34, 0x3c, 0x91,
-	0x66, 0x42, 0x5b, 0x3a, 0x19, 0x27, 0x53, 0x17, 0x3b, 0x32, 0x07, 0x46, 0xcf, 0x7c, 0x84, 0xf5,
-	0xc3, 0x9e, 0xd5, 0x7e, 0x58, 0x59, 0x3e, 0xdf, 0xc5, 0xee, 0x0a, 0xee, 0x4d, 0xca, 0x4c, 0x5d,
-	0xec, 0x28, 0x4c, 0xd8, 0x5c, 0x80, 0xf4, 0x89, 0xd1, 0x1b, 0xe2, 0x6d, 0x39, 0x2b, 0x2b, 0xe9,
-	0x6d, 0x39, 0xbb, 0xa0, 0x64, 0xb7, 0xe5, 0x6c, 0x4e, 0x81, 0x6d, 0x39, 0x0b, 0x4a, 0x5e, 0x7d,
-	0x16, 0xf2, 0xa1, 0xc0, 0x84, 0x2a, 0xb0, 0xd0, 0xc7, 0xae, 0x6b, 0x1c, 0x63, 0x16, 0xc7, 0x72,
-	0x9a, 0x37, 0x54, 0x4b, 0x50, 0x08, 0x07, 0x23, 0xf5, 0x13, 0xc9, 0x97, 0xa4, 0x71, 0x86, 0x4a,
-	0x9e, 0x60, 0x87, 0x99, 0x43, 0x48, 0x8a, 0x21, 0x7a, 0x0a, 0x8a, 0xec, 0x53, 0x74, 0x6f, 0x9e,
-	0x06, 0x3b, 0x59, 0x2b, 0x30, 0xe2, 0x03, 0xc1, 0x74, 0x0d, 0xf2, 0xf6, 0x86, 0xed, 0xb3, 0xa4,
-	0x18, 0x0b, 0xd8, 0x1b, 0xb6, 0xc7, 0xf0, 0x24, 0x14, 0xe8, 0x77, 0xfb, 0x1c, 0x32, 0x5b, 0x24,
-	0x4f, 0x69, 0x82, 0x45, 0xfd, 0x7d, 0x12, 0x94, 0xd1, 0x00, 0x86, 0x5e, 0x05, 0x99, 0xc6, 0x7a,
-	0x11, 0x96, 0xab, 0x6b, 0x3c, 0xce, 0xaf, 0x79, 0x71, 0x7e, 0xad, 0xe5, 0x1d, 0x04, 0x9b, 0xd9,
-	0xcf, 0xbf, 0xbc, 0x96, 0xf8, 0xe4, 0x8f, 0xd7, 0x24, 0x8d, 0x49, 0xa0, 0x2b, 0x34, 0x6c, 0x19,
-	0xe6, 0x40, 0x37, 0x3b, 0xec, 0x95, 0x73, 0x34, 0x26, 0x19, 0xe6, 0x60, 0xab, 0x83, 0x76, 0x40,
-	0x69, 0x5b, 0x03, 0x17, 0x0f, 0xdc, 0xa1, 0xab, 0xf3, 0x83, 0x4a, 0x04, 0xe3, 0x48, 0x48, 0xe5,
-	0x27, 0x50, 0xdd, 0xe3, 0xdc, 0x67, 0x8c, 0x5a, 0xb9, 0x1d, 0x25, 0xa0, 0xbb, 0x00, 0xfe, 0x69,
-	0xe6, 0x56, 0xe4, 0xd5, 0xd4, 0xf5, 0xfc, 0xc6, 0xea, 0xd8, 0x0f, 0x7f, 0xe0, 0xb1, 0xdc, 0xb7,
-	0x3b, 0x06, 0xc1, 0x9b, 0x32, 0x7d, 0x5d, 0x2d, 0x24, 0x89, 0x9e, 0x81, 0xb2, 0x61, 0xdb, 0xba,
-	0x4b, 0x0c, 0x82, 0xf5, 0xc3, 0x33, 0x82, 0x5d, 0x16, 0xe7, 0x0b, 0x5a, 0xd1, 0xb0, 0xed, 0x03,
-	0x4a, 0xdd, 0xa4, 0x44, 0xf4, 0x34, 0x94, 0x68, 0x4c, 0x37, 0x8d, 0x9e, 0xde, 0xc5, 0xe6, 0x71,
-	0x97, 0xb0, 0x78, 0x9e, 0xd2, 0x8a, 0x82, 0xda, 0x64, 0x44, 0xb5, 0xe3, 0xff, 0x71, 0x16, 0xcf,
-	0x11, 0x02, 0xb9, 0x63, 0x10, 0x83, 0x59, 0xb2, 0xa0, 0xb1, 0x67, 0x4a, 0xb3, 0x0d, 0xd2, 0x15,
-	0xf6, 0x61, 0xcf, 0xe8, 0x32, 0x64, 0x84, 0xda, 0x14, 0x53, 0x2b, 0x46, 0x68, 0x19, 0xd2, 0xb6,
-	0x63, 0x9d, 0x60, 0xf6, 0xeb, 0xb2, 0x1a, 0x1f, 0xa8, 0x1a, 0x94, 0xa2, 0xb1, 0x1f, 0x95, 0x20,
-	0x49, 0x4e, 0xc5, 0x2a, 0x49, 0x72, 0x8a, 0x5e, 0x02, 0x99, 0x1a, 0x92, 0xad, 0x51, 0x9a, 0x70,
-	0xda, 0x09, 0xb9, 0xd6, 0x99, 0x8d, 0x35, 0xc6, 0xa9, 0x96, 0xa1, 0x18, 0x39, 0x13, 0xd4, 0xcb,
-	0xb0, 0x3c, 0x29, 0xc4, 0xab, 0x3f, 0x96, 0xfc, 0x89, 0x48, 0xac, 0x46, 0xb7, 0x20, 0xeb, 0x07,
-	0x79, 0xee, 0x39, 0x57, 0xc6, 0xd6, 0xf5, 0x98, 0x35, 0x9f, 0x95, 0xba, 0x0c, 0xfd, 0x03, 0x5d,
-	0x43, 0x1c, 0xe9, 0x05, 0x6d, 0xc1, 0xb0, 0xed, 0xa6, 0xe1, 0x76, 0xa9, 0x83, 0xd3, 0xa9, 0x11,
-	0x07, 0x37, 0x6c, 0xcf, 0xc1, 0xd5, 0xf7, 0xa0, 0x12, 0x17, 0xe1, 0x43, 0x26, 0x95, 0x98, 0x9c,
-	0x67, 0xd2, 0xcb, 0x90, 0x39, 0xb2, 0x9c, 0xbe, 0x41, 0xd8, 0x6a, 0x45, 0x4d, 0x8c, 0xa8, 0xa9,
-	0x79, 0xb4, 0x4f, 0x31, 0x32, 0x1f, 0xa8, 0x3a, 0x5c, 0x89, 0x8d, 0xf2, 0x54, 0xc4, 0x1c, 0x74,
-	0x30, 0x37, 0x7c, 0x51, 0xe3, 0x83, 0x40, 0x11, 0xff, 0x1a, 0x3e, 0xa0, 0xcb, 0xba, 0xcc, 0x18,
-	0x4c, 0x7f, 0x4e, 0x13, 0x23, 0xf5, 0xd3, 0x14, 0x5c, 0x9e, 0x1c, 0xeb, 0xd1, 0x2a, 0x14, 0xfa,
-	0xc6, 0xa9, 0x4e, 0x4e, 0x85, 0x63, 0x4a, 0xcc, 0x35, 0xa0, 0x6f, 0x9c, 0xb6, 0x4e, 0xb9, 0x57,
-	0x2a, 0x90, 0x22, 0xa7, 0x6e, 0x25, 0xb9, 0x9a, 0xba, 0x5e, 0xd0, 0xe8, 0x23, 0xba, 0x0f, 0x8b,
-	0x3d, 0xab, 0x6d, 0xf4, 0xf4, 0x9e, 0xe1, 0x12, 0x5d, 0x24, 0x01, 0x7c, 0x9b, 0x3d, 0x35, 0xf6,
-	0x37, 0x78, 0xd4, 0xc6, 0x1d, 0xfe, 0xc7, 0x69, 0x48, 0x12, 0x3b, 0xa4, 0xcc, 0x74, 0xec, 0x18,
-	0x9e, 0x33, 0xa0, 0x3b, 0x90, 0xef, 0x9b, 0xee, 0x21, 0xee, 0x1a, 0x27, 0xa6, 0xe5, 0x88, 0xfd,
-	0x36, 0xee, 0x56, 0x6f, 0x06, 0x3c, 0x42, 0x53, 0x58, 0x2c, 0xf4, 0x4b, 0xd2, 0x11, 0x2f, 0xf7,
-	0xe2, 0x4d, 0x66, 0xee, 0x78, 0xf3, 0x12, 0x2c, 0x0f, 0xf0, 0x29, 0xd1, 0x83, 0x1d, 0xcd, 0x1d,
-	0x69, 0x81, 0x99, 0x1e, 0xd1, 0x39, 0x3f, 0x06, 0xb8, 0xcc, 0xa7, 0x9e, 0x63, 0xa7, 0xa5, 0x6d,
-	0xb9, 0xd8, 0xd1, 0x8d, 0x4e, 0xc7, 0xc1, 0xae, 0xcb, 0x12, 0xac, 0x02, 0x3b, 0x02, 0x19, 0xbd,
-	0xc6, 0xc9, 0xea, 0x0f, 0xe4, 0xd0, 0xaf, 0x89, 0x9e, 0x8e, 0xc2, 0xf0, 0x52, 0x60, 0xf8, 0x03,
-	0x58, 0x16, 0xf2, 0x9d, 0x88, 0xed, 0x79, 0x96, 0xfa, 0xf8, 0xf8, 0x0e, 0x1c, 0xb5, 0x39, 0xf2,
-	0xc4, 0xe3, 0xcd, 0x9e, 0xba, 0x98, 0xd9, 0x11, 0xc8, 0xcc, 0x28, 0x32, 0x0f, 0x42, 0xf4, 0xf9,
-	0x5f, 0xec, 0x57, 0xd0, 0x48, 0xe0, 0x7e, 0x30, 0xa4, 0x89, 0x93, 0x6b, 0x3e, 0xc2, 0x95, 0x1c,
-	0x8f, 0x04, 0x9c, 0x74, 0x60, 0x3e, 0xc2, 0xe8, 0xbf, 0xa0, 0x44, 0x83, 0xab, 0xee, 0x58, 0x16,
-	0xe1, 0xeb, 0x02, 0xd3, 0x54, 0xa0, 0x54, 0xcd, 0xb2, 0x08, 0x5b, 0xf1, 0x25, 0xfa, 0xd5, 0x06,
-	0xdd, 0x84, 0x3c, 0x45, 0xa8, 0x8c, 0x9f, 0x3c, 0x4d, 0x36, 0xaf, 0x09, 0x3e, 0xf5, 0xa3, 0x14,
-	0x2c, 0x8e, 0xe5, 0x38, 0xbe, 0x45, 0xa5, 0x89, 0x16, 0x4d, 0x4e, 0xb4, 0x68, 0x6a, 0x6e, 0x8b,
-	0x0a, 0x27, 0x93, 0xa7, 0x3b, 0x59, 0xfa, 0x5b, 0x74, 0xb2, 0xcc, 0xc5, 0x9c, 0xec, 0x9f, 0xba,
-	0x13, 0x7f, 0x2e, 0x41, 0x35, 0x3e, 0x31, 0x9c, 0xf8, 0x3b, 0x9e, 0x87, 0x45, 0xff, 0x55, 0x7c,
-	0xf5, 0x3c, 0x22, 0x2b, 0xfe, 0x84, 0xe7, 0x5e, 0x71, 0xc7, 0xef, 0xd3, 0x50, 0x1a, 0x49, 0x5b,
-	0xf9, 0x1e, 0x2a, 0x9e, 0x84, 0xd7, 0x57, 0x7f, 0x93, 0xf2, 0x8f, 0xc4, 0x48, 0x6e, 0x39, 0x21,
-	0x4c, 0xbc, 0x05, 0x4b, 0x1d, 0xdc, 0x36, 0x3b, 0x17, 0x8d, 0x12, 0x8b, 0x42, 0xfa, 0x3f, 0x41,
-	0x62, 0x3c, 0x48, 0xcc, 0xbf, 0xbb, 0x7f, 0x06, 0x90, 0xd5, 0xb0, 0x6b, 0xd3, 0xe4, 0x12, 0x6d,
-	0x42, 0x0e, 0x9f, 0xb6, 0xb1, 0x4d, 0xbc, 0x7c, 0x7c, 0x72, 0xbd, 0xc3, 0xb9, 0x1b, 0x1e, 0x27,
-	0xad, 0xf6, 0x7d, 0x31, 0x74, 0x53, 0x00, 0x1a, 0xf1, 0xd8, 0x84, 0x10, 0x0f, 0x23, 0x1a, 0x2f,
-	0x7b, 0x88, 0x46, 0x2a, 0xb6, 0x58, 0xe7, 0x52, 0x23, 0x90, 0xc6, 0x4d, 0x01, 0x69, 0xc8, 0x53,
-	0x16, 0x8b, 0x60, 0x1a, 0xf5, 0x08, 0xa6, 0x91, 0x99, 0xf2, 0x99, 0x31, 0xa0, 0xc6, 0xcb, 0x1e,
-	0xa8, 0xb1, 0x30, 0xe5, 0x8d, 0x47, 0x50, 0x8d, 0x37, 0x42, 0xa8, 0x46, 0x8e, 0x89, 0xae, 0xc6,
-	0x8a, 0x4e, 0x80, 0x35, 0x5e, 0xf3, 0x61, 0x8d, 0x42, 0x2c, 0x24, 0x22, 0x84, 0x47, 0x71, 0x8d,
-	0xbd, 0x31, 0x5c, 0x83, 0xe3, 0x10, 0xcf, 0xc4, 0xaa, 0x98, 0x02, 0x6c, 0xec, 0x8d, 0x01, 0x1b,
-	0xa5, 0x29, 0x0a, 0xa7, 0x20, 0x1b, 0xdf, 0x9d, 0x8c, 0x6c, 0xc4, 0x63, 0x0f, 0xe2, 0x35, 0x67,
-	0x83, 0x36, 0xf4, 0x18, 0x68, 0x43, 0x89, 0x2d, 0xc3, 0xb9, 0xfa, 0x99, 0xb1, 0x8d, 0xfb, 0x13,
-	0xb0, 0x0d, 0x8e, 0x42, 0x5c, 0x8f, 0x55, 0x3e, 0x03, 0xb8, 0x71, 0x7f, 0x02, 0xb8, 0x81, 0xa6,
-	0xaa, 0x9d, 0x8a, 0x6e, 0xdc, 0x8d, 0xa2, 0x1b, 0x4b, 0x31, 0x09, 0x72, 0xb0, 0xdb, 0x63, 0xe0,
-	0x8d, 0xc3, 0x38, 0x78, 0x83, 0x47, 0xa0, 0x17, 0x62, 0x35, 0xce, 0x81, 0x6f, 0xec, 0x8d, 0xe1,
-	0x1b, 0x97, 0xa6, 0x78, 0xda, 0xec, 0x00, 0x47, 0x5a, 0xc9, 0x6c, 0xcb, 0xd9, 0xac, 0x92, 0xe3,
-	0xd0, 0xc6, 0xb6, 0x9c, 0xcd, 0x2b, 0x05, 0xf5, 0x39, 0x9a, 0xf3, 0x8c, 0xc4, 0x39, 0x5a, 0xd6,
-	0x60, 0xc7, 0xb1, 0x1c, 0x01, 0x55, 0xf0, 0x81, 0x7a, 0x9d, 0x16, 0xbc, 0x41, 0x4c, 0x3b, 0x07,
-	0x0c, 0x61, 0x05, 0x66, 0x28, 0x8e, 0xa9, 0x7f, 0x97, 0x02, 0x59, 0x06, 0x87, 0x84, 0x8b, 0xe5,
-	0x9c, 0x28, 0x96, 0x43, 0x10, 0x49, 0x32, 0x0a, 0x91, 0x4c, 0x2b, 0x0e, 0xd1, 0x0d, 0x58, 0x64,
-	0x47, 0x2c, 0x07, 0x52, 0xc4, 0x41, 0x26, 0xb3, 0x83, 0xac, 0x4c, 0x27, 0xb8, 0x75, 0xf8, 0x89,
-	0xf6, 0x22, 0x2c, 0x85, 0x78, 0xfd, 0x7a, 0x94, 0x43, 0x01, 0x8a, 0xcf, 0x5d, 0x13, 0x85, 0x69,
-	0x03, 0x0a, 0xf4, 0x38, 0xb3, 0x86, 0x44, 0x67, 0x11, 0x38, 0x13, 0x03, 0x2a, 0xb7, 0x38, 0x53,
-	0xe8, 0xfc, 0xce, 0x93, 0x80, 0xa4, 0xfe, 0x24, 0x19, 0x18, 0x3a, 0x40, 0x5f, 0x26, 0x01, 0x25,
-	0xd2, 0xb7, 0x04, 0x94, 0x24, 0x2f, 0x0c, 0x94, 0x84, 0xcb, 0xf4, 0x54, 0xb4, 0x4c, 0x1f, 0xb5,
-	0x86, 0x7c, 0x31, 0x6b, 0xfc, 0x4d, 0x0a, 0x3c, 0xc4, 0x47, 0x4f, 0xda, 0x56, 0x07, 0x8b, 0xf2,
-	0x9a, 0x3d, 0xd3, 0x94, 0xaa, 0x67, 0x1d, 0x8b, 0x22, 0x9a, 0x3e, 0x52, 0x2e, 0x7f, 0xd9, 0x9c,
-	0x38, 0xe5, 0xfc, 0xca, 0x9c, 0x27, 0x2e, 0xa2, 0x32, 0x57, 0x20, 0xf5, 0x10, 0x73, 0x24, 0xbe,
-	0xa0, 0xd1, 0x47, 0xca, 0xc7, 0xb6, 0x82, 0x48, 0x40, 0xf8, 0x00, 0xbd, 0x0a, 0x39, 0xd6, 0x85,
-	0xd1, 0x2d, 0xdb, 0x15, 0xe8, 0x7b, 0x24, 0x35, 0xe3, 0xad, 0x98, 0xb5, 0x7d, 0xca, 0xb3, 0x67,
-	0xbb, 0x5a, 0xd6, 0x16, 0x4f, 0xa1, 0x8c, 0x29, 0x17, 0xc9, 0x98, 0xae, 0x42, 0x8e, 0xbe, 0xbd,
-	0x6b, 0x1b, 0x6d, 0xcc, 0x2a, 0x93, 0x9c, 0x16, 0x10, 0xd4, 0xbf, 0x24, 0xa1, 0x3c, 0x72, 0xec,
-	0x4d, 0xfc, 0x76, 0x6f, 0x83, 0x24, 0x43, 0x68, 0xd2, 0x6c, 0xf6, 0x58, 0x01, 0x38, 0x36, 0x5c,
-	0xfd, 0x43, 0x63, 0x40, 0x70, 0x47, 0x18, 0x25, 0x44, 0x41, 0x55, 0xc8, 0xd2, 0xd1, 0xd0, 0xc5,
-	0x1d, 0x01, 0x6c, 0xf9, 0x63, 0xd4, 0x84, 0x0c, 0x3e, 0xc1, 0x03, 0xe2, 0x56, 0x16, 0x98, 0xf7,
-	0x5c, 0x1e, 0xc7, 0x11, 0xe8, 0xf4, 0x66, 0x85, 0xfe, 0xd2, 0x3f, 0x7f, 0x79, 0x4d, 0xe1, 0xdc,
-	0x2f, 0x58, 0x7d, 0x93, 0xe0, 0xbe, 0x4d, 0xce, 0x34, 0x21, 0x1f, 0xb5, 0x42, 0x76, 0xc4, 0x0a,
-	0x74, 0xab, 0x7b, 0x09, 0x5e, 0x59, 0x38, 0x98, 0x48, 0xec, 0xaa, 0x90, 0xb5, 0x1d, 0xd3, 0x72,
-	0x4c, 0x72, 0xc6, 0x0e, 0xac, 0x94, 0xe6, 0x8f, 0xe9, 0x9c, 0x4b, 0x53, 0xef, 0x41, 0x1b, 0xb3,
-	0xf3, 0x46, 0xd6, 0xfc, 0x31, 0x03, 0x6d, 0x0b, 0x1e, 0xd2, 0xa2, 0x15, 0xfb, 0xb8, 0x6f, 0x5b,
-	0x56, 0x4f, 0xe7, 0x91, 0xab, 0x06, 0xa5, 0x68, 0xb6, 0x80, 0x9e, 0x82, 0xa2, 0x83, 0x89, 0x61,
-	0x0e, 0xf4, 0x48, 0x31, 0x50, 0xe0, 0x44, 0x1e, 0x29, 0xb6, 0xe5, 0xac, 0xa4, 0x24, 0xb7, 0xe5,
-	0x6c, 0x52, 0x49, 0xa9, 0xfb, 0x70, 0x69, 0x62, 0xb6, 0x80, 0x5e, 0x81, 0x5c, 0x90, 0x68, 0x48,
-	0xcc, 0x6a, 0xe7, 0x60, 0x61, 0x01, 0xaf, 0xfa, 0x5b, 0x29, 0x50, 0x19, 0x45, 0xd7, 0x1a, 0x90,
-	0x71, 0xb0, 0x3b, 0xec, 0x71, 0x38, 0xab, 0xb4, 0xf1, 0xe2, 0x6c, 0x79, 0x06, 0xa5, 0x0e, 0x7b,
-	0x44, 0x13, 0xc2, 0xea, 0xbb, 0x90, 0xe1, 0x14, 0x94, 0x87, 0x85, 0xfb, 0xbb, 0xf7, 0x76, 0xf7,
-	0xde, 0xde, 0x55, 0x12, 0x08, 0x20, 0x53, 0xab, 0xd7, 0x1b, 0xfb, 0x2d, 0x45, 0x42, 0x39, 0x48,
-	0xd7, 0x36, 0xf7, 0xb4, 0x96, 0x92, 0xa4, 0x64, 0xad, 0xb1, 0xdd, 0xa8, 0xb7, 0x94, 0x14, 0x5a,
-	0x84, 0x22, 0x7f, 0xd6, 0xef, 0xee, 0x69, 0x6f, 0xd6, 0x5a, 0x8a, 0x1c, 0x22, 0x1d, 0x34, 0x76,
-	0xef, 0x34, 0x34, 0x25, 0xad, 0xfe, 0x37, 0x5c, 0x89, 0xcd, 0x4c, 0x02, 0x64, 0x4c, 0x0a, 0x21,
-	0x63, 0xea, 0xa7, 0x49, 0x5a, 0xdc, 0xc5, 0xa5, 0x1b, 0x68, 0x7b, 0xe4, 0xc3, 0x37, 0xe6, 0xc8,
-	0x55, 0x46, 0xbe, 0x9e, 0xd6, 0x73, 0x0e, 0x3e, 0xc2, 0xa4, 0xdd, 0xe5, 0xe9, 0x0f, 0x0f, 0x88,
-	0x45, 0xad, 0x28, 0xa8, 0x4c, 0xc8, 0xe5, 0x6c, 0xef, 0xe3, 0x36, 0xd1, 0xb9, 0xeb, 0xb8, 0xac,
-	0xa8, 0xca, 0x51, 0x36, 0x4a, 0x3d, 0xe0, 0x44, 0xf5, 0xbd, 0xb9, 0x6c, 0x99, 0x83, 0xb4, 0xd6,
-	0x68, 0x69, 0xef, 0x28, 0x29, 0x84, 0xa0, 0xc4, 0x1e, 0xf5, 0x83, 0xdd, 0xda, 0xfe, 0x41, 0x73,
-	0x8f, 0xda, 0x72, 0x09, 0xca, 0x9e, 0x2d, 0x3d, 0x62, 0x5a, 0x75, 0xe0, 0xb1, 0x98, 0x5c, 0x69,
-	0x42, 0x69, 0x39, 0x82, 0x91, 0x24, 0x67, 0xc0, 0x48, 0x52, 0xe3, 0x18, 0x89, 0xfa, 0x0b, 0x29,
-	0xbc, 0x68, 0x34, 0x6d, 0xda, 0x83, 0x8c, 0x4b, 0x0c, 0x32, 0x74, 0xc5, 0xbf, 0x78, 0x65, 0xd6,
-	0x1c, 0x6c, 0xcd, 0x7b, 0x38, 0x60, 0xe2, 0x9a, 0x50, 0xa3, 0xde, 0x82, 0x52, 0x74, 0x26, 0xde,
-	0x94, 0x81, 0x2f, 0x26, 0xd5, 0xdb, 0x80, 0xc6, 0x53, 0xb3, 0x09, 0xd5, 0xba, 0x34, 0xa9, 0x5a,
-	0xff, 0xa5, 0x04, 0x8f, 0x9f, 0x93, 0x86, 0xa1, 0xb7, 0x46, 0x3e, 0xf2, 0xb5, 0x79, 0x92, 0xb8,
-	0x35, 0x4e, 0x1b, 0xf9, 0xcc, 0x9b, 0x50, 0x08, 0xd3, 0x67, 0xfb, 0xc8, 0x5f, 0xa7, 0x82, 0x58,
-	0x10, 0x85, 0x15, 0x82, 0x88, 0x2c, 0x7d, 0xc3, 0x88, 0xfc, 0x3a, 0x00, 0x39, 0xd5, 0xf9, 0xee,
-	0xf0, 0xb2, 0x83, 0x27, 0x26, 0xe0, 0xc4, 0xb8, 0xdd, 0x3a, 0x15, 0x7b, 0x29, 0x47, 0xc4, 0x93,
-	0x8b, 0x0e, 0xc2, 0x18, 0xcb, 0x90, 0x65, 0x0e, 0xae, 0xc0, 0x1f, 0x66, 0x4d, 0x31, 0x02, 0x2c,
-	0x86, 0x93, 0x5d, 0xf4, 0x0e, 0x3c, 0x36, 0x92, 0xfe, 0xf8, 0xaa, 0xe5, 0x59, 0xb3, 0xa0, 0x4b,
-	0xd1, 0x2c, 0xc8, 0x53, 0x1d, 0xce, 0x61, 0xd2, 0xe7, 0xe7, 0x30, 0x17, 0xcc, 0xe8, 0xde, 0x01,
-	0x08, 0x20, 0x1b, 0x1a, 0xef, 0x1c, 0x6b, 0x38, 0xe8, 0x30, 0x47, 0x4a, 0x6b, 0x7c, 0x80, 0x6e,
-	0x41, 0x9a, 0x3a, 0xa4, 0x67, 0xee, 0xf1, 0x83, 0x81, 0x3a, 0x54, 0x68, 0x01, 0xce, 0xad, 0x9a,
-	0x80, 0xc6, 0xf1, 0xfa, 0x98, 0x25, 0xde, 0x88, 0x2e, 0xf1, 0x64, 0x2c, 0xf2, 0x3f, 0x79, 0xa9,
-	0x47, 0x90, 0x66, 0x0e, 0x44, 0x53, 0x09, 0xd6, 0x46, 0x12, 0x19, 0x39, 0x7d, 0x46, 0xdf, 0x03,
-	0x30, 0x08, 0x71, 0xcc, 0xc3, 0x61, 0xb0, 0xc0, 0xb5, 0xc9, 0x0e, 0x58, 0xf3, 0xf8, 0x36, 0xaf,
-	0x0a, 0x4f, 0x5c, 0x0e, 0x44, 0x43, 0xde, 0x18, 0x52, 0xa8, 0xee, 0x42, 0x29, 0x2a, 0xeb, 0x65,
-	0x6d, 0xfc, 0x1d, 0xa2, 0x59, 0x1b, 0x2f, 0x09, 0x44, 0xd6, 0xe6, 0xe7, 0x7c, 0x29, 0xde, 0x2b,
-	0x63, 0x03, 0xf5, 0xfb, 0x49, 0x28, 0x84, 0xfd, 0xf7, 0xdf, 0x2f, 0xb1, 0x52, 0x7f, 0x24, 0x41,
-	0xd6, 0xff, 0xfc, 0x68, 0x5b, 0x2c, 0xd2, 0x69, 0xe4, 0xd6, 0x4b, 0x86, 0x7b, 0x59, 0xbc, 0xaf,
-	0x98, 0xf2, 0xfb, 0x8a, 0xb7, 0xfd, 0xc3, 0x38, 0x0e, 0x74, 0x0a, 0xdb, 0x5a, 0x78, 0x95, 0x97,
-	0x7b, 0xdc, 0x86, 0x9c, 0x1f, 0x04, 0xc2, 0xd9, 0x9e, 0x14, 0xcd, 0xf6, 0x96, 0x21, 0x6d, 0x5b,
-	0x1f, 0x8a, 0x46, 0x59, 0x4a, 0xe3, 0x03, 0xb5, 0x03, 0xe5, 0x91, 0x08, 0x82, 0x6e, 0xc3, 0x82,
-	0x3d, 0x3c, 0xd4, 0x3d, 0xe7, 0x18, 0xd9, 0xae, 0x5e, 0x92, 0x3e, 0x3c, 0xec, 0x99, 0xed, 0x7b,
-	0xf8, 0xcc, 0x7b, 0x19, 0x7b, 0x78, 0x78, 0x8f, 0xfb, 0x10, 0x5f, 0x25, 0x19, 0x5e, 0xe5, 0xa7,
-	0x12, 0x64, 0xbd, 0x3d, 0x81, 0xfe, 0x17, 0x72, 0x7e, 0x74, 0xf2, 0x7b, 0xe1, 0xb1, 0x61, 0x4d,
-	0xe8, 0x0f, 0x44, 0x50, 0xcd, 0x6b, 0xe2, 0x9b, 0x1d, 0xfd, 0xa8, 0x67, 0x70, 0x5f, 0x2a, 0x45,
-	0x6d, 0xc6, 0xe3, 0x17, 0x0b, 0xeb, 0x5b, 0x77, 0xee, 0xf6, 0x8c, 0x63, 0x2d, 0xcf, 0x64, 0xb6,
-	0x3a, 0x74, 0x20, 0xf2, 0xcc, 0xbf, 0x4a, 0xa0, 0x8c, 0xee, 0xd8, 0x6f, 0xfc, 0x76, 0xe3, 0xa7,
-	0x65, 0x6a, 0xc2, 0x69, 0x89, 0xd6, 0x61, 0xc9, 0xe7, 0xd0, 0x5d, 0xf3, 0x78, 0x60, 0x90, 0xa1,
-	0x83, 0x05, 0x4c, 0x8c, 0xfc, 0xa9, 0x03, 0x6f, 0x66, 0xfc, 0xab, 0xd3, 0x17, 0xfc, 0xea, 0x8f,
-	0x92, 0x90, 0x0f, 0x81, 0xd6, 0xe8, 0x7f, 0x42, 0xc1, 0xa8, 0x34, 0xe1, 0x80, 0x09, 0xf1, 0x06,
-	0x7d, 0xed, 0xa8, 0x99, 0x92, 0xf3, 0x9b, 0x29, 0xae, 0x35, 0xe0, 0x61, 0xe0, 0xf2, 0xdc, 0x18,
-	0xf8, 0x0b, 0x80, 0x88, 0x45, 0x8c, 0x9e, 0x7e, 0x62, 0x11, 0x73, 0x70, 0xac, 0x73, 0x37, 0xe4,
-	0xa1, 0x43, 0x61, 0x33, 0x0f, 0xd8, 0xc4, 0x3e, 0xf3, 0xc8, 0x1f, 0x4a, 0x90, 0xf5, 0x8b, 0x80,
-	0x79, 0x7b, 0xda, 0x97, 0x21, 0x23, 0xf2, 0x5c, 0xde, 0xd4, 0x16, 0xa3, 0x89, 0x60, 0x7f, 0x15,
-	0xb2, 0x7d, 0x4c, 0x0c, 0x16, 0x07, 0xf9, 0xe1, 0xe8, 0x8f, 0xd5, 0x5f, 0x49, 0x90, 0x0f, 0x9d,
-	0x7c, 0x68, 0x07, 0xca, 0xde, 0x69, 0x29, 0x40, 0x78, 0xbf, 0xe3, 0x3f, 0x6a, 0x87, 0x3b, 0xe2,
-	0x4e, 0x20, 0x37, 0xc3, 0xa7, 0xd4, 0x0c, 0x25, 0x21, 0xcb, 0x33, 0x3f, 0x8c, 0xb6, 0xc1, 0xa3,
-	0x44, 0xdb, 0x21, 0x33, 0x29, 0x2b, 0x0a, 0x51, 0x7e, 0x26, 0xde, 0x78, 0x0d, 0xf2, 0xa1, 0xbb,
-	0x0d, 0x34, 0x88, 0xef, 0x36, 0xde, 0x56, 0x12, 0xd5, 0x85, 0x8f, 0x3f, 0x5b, 0x4d, 0xed, 0xe2,
-	0x0f, 0x69, 0xdc, 0xd1, 0x1a, 0xf5, 0x66, 0xa3, 0x7e, 0x4f, 0x91, 0xaa, 0xf9, 0x8f, 0x3f, 0x5b,
-	0x5d, 0xd0, 0x30, 0xc3, 0x97, 0x6f, 0xdc, 0x83, 0xf2, 0x88, 0x0b, 0x45, 0xf3, 0x34, 0x04, 0xa5,
-	0x3b, 0xf7, 0xf7, 0x77, 0xb6, 0xea, 0xb5, 0x56, 0x43, 0x7f, 0xb0, 0xd7, 0x6a, 0x28, 0x12, 0x7a,
-	0x0c, 0x96, 0x76, 0xb6, 0xfe, 0xaf, 0xd9, 0xd2, 0xeb, 0x3b, 0x5b, 0x8d, 0xdd, 0x96, 0x5e, 0x6b,
-	0xb5, 0x6a, 0xf5, 0x7b, 0x4a, 0x72, 0xe3, 0xb3, 0x3c, 0xc8, 0xb5, 0xcd, 0xfa, 0x16, 0xaa, 0x83,
-	0xcc, 0x80, 0xb1, 0x73, 0x2f, 0x37, 0x56, 0xcf, 0xef, 0x14, 0xa0, 0xbb, 0x90, 0x66, 0x98, 0x19,
-	0x3a, 0xff, 0xb6, 0x63, 0x75, 0x4a, 0xeb, 0x80, 0xbe, 0x0c, 0xfb, 0x7f, 0xe7, 0x5e, 0x7f, 0xac,
-	0x9e, 0xdf, 0x49, 0x40, 0x3b, 0xb0, 0xe0, 0x81, 0x14, 0xd3, 0xee, 0x24, 0x56, 0xa7, 0xc2, 0xfb,
-	0xf4, 0xd3, 0x38, 0xd8, 0x73, 0xfe, 0xcd, 0xc8, 0xea, 0x94, 0x1e, 0x03, 0xda, 0x82, 0x8c, 0x28,
-	0xe3, 0xa7, 0x5c, 0x76, 0xac, 0x4e, 0xeb, 0x1a, 0x20, 0x0d, 0x72, 0x01, 0x1a, 0x37, 0xfd, 0xbe,
-	0x67, 0x75, 0x86, 0xf6, 0x09, 0x7a, 0x17, 0x8a, 0x51, 0x88, 0x60, 0xb6, 0x0b, 0x95, 0xd5, 0x19,
-	0xfb, 0x13, 0x54, 0x7f, 0x14, 0x2f, 0x98, 0xed, 0x82, 0x65, 0x75, 0xc6, 0x76, 0x05, 0x7a, 0x1f,
-	0x16, 0xc7, 0xeb, 0xf9, 0xd9, 0xef, 0x5b, 0x56, 0xe7, 0x68, 0x60, 0xa0, 0x3e, 0xa0, 0x09, 0x38,
-	0xc0, 0x1c, 0xd7, 0x2f, 0xab, 0xf3, 0xf4, 0x33, 0x50, 0x07, 0xca, 0xa3, 0xc5, 0xf5, 0xac, 0xd7,
-	0x31, 0xab, 0x33, 0xf7, 0x36, 0xf8, 0x2a, 0xd1, 0x6a, 0x7a, 0xd6, 0xeb, 0x99, 0xd5, 0x99, 0x5b,
-	0x1d, 0xe8, 0x3e, 0x40, 0xa8, 0x20, 0x9e, 0xe1, 0xba, 0x66, 0x75, 0x96, 0xa6, 0x07, 0xb2, 0x61,
-	0x69, 0x52, 0xa5, 0x3c, 0xcf, 0xed, 0xcd, 0xea, 0x5c, 0xbd, 0x10, 0xea, 0xcf, 0xd1, 0x9a, 0x77,
-	0xb6, 0xdb, 0x9c, 0xd5, 0x19, 0x9b, 0x22, 0x9b, 0xb5, 0xcf, 0xbf, 0x5a, 0x91, 0xbe, 0xf8, 0x6a,
-	0x45, 0xfa, 0xd3, 0x57, 0x2b, 0xd2, 0x27, 0x5f, 0xaf, 0x24, 0xbe, 0xf8, 0x7a, 0x25, 0xf1, 0x87,
-	0xaf, 0x57, 0x12, 0xff, 0xff, 0xec, 0xb1, 0x49, 0xba, 0xc3, 0xc3, 0xb5, 0xb6, 0xd5, 0x5f, 0x6f,
-	0x5b, 0x7d, 0x4c, 0x0e, 0x8f, 0x48, 0xf0, 0x10, 0x5c, 0xe9, 0x3f, 0xcc, 0xb0, 0x63, 0xe9, 0xe6,
-	0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x62, 0xa8, 0x14, 0x6b, 0xf2, 0x2f, 0x00, 0x00,
+	0x93, 0x74, 0x3a, 0x49, 0xa4, 0x1e, 0xef, 0xe9, 0xaf, 0x4e, 0x7a, 0x55, 0x20, 0x0e, 0xf7, 0x40,
+	0x1e, 0x45, 0x52, 0x4b, 0xdc, 0xa9, 0xf4, 0x7e, 0xb4, 0x5a, 0x02, 0x43, 0x62, 0x75, 0x00, 0x76,
+	0xb5, 0x3b, 0xa0, 0xc0, 0x8b, 0x9e, 0xad, 0x72, 0x60, 0x39, 0x51, 0x95, 0x1d, 0x28, 0xb0, 0x02,
+	0x07, 0x2e, 0xc7, 0x4e, 0x1c, 0xda, 0xa9, 0x02, 0x07, 0x0a, 0x1d, 0xc9, 0x2e, 0x29, 0x73, 0x68,
+	0x07, 0x0e, 0xed, 0x9a, 0x9f, 0x5d, 0xec, 0x02, 0xbb, 0x04, 0x70, 0x92, 0x03, 0x97, 0x9d, 0xcd,
+	0xf4, 0x74, 0xf7, 0xcc, 0xf6, 0xf6, 0xf4, 0xf4, 0x7c, 0x3d, 0xf0, 0x38, 0xc1, 0x83, 0x0e, 0x76,
+	0xfa, 0xe6, 0x80, 0x6c, 0x1a, 0xc7, 0x6d, 0x73, 0x93, 0x9c, 0xdb, 0xd8, 0xdd, 0xb0, 0x1d, 0x8b,
+	0x58, 0xa8, 0x3c, 0x1e, 0xdc, 0xa0, 0x83, 0xd5, 0x27, 0x02, 0xdc, 0x6d, 0xe7, 0xdc, 0x26, 0xd6,
+	0xa6, 0xed, 0x58, 0xd6, 0x09, 0xe7, 0xaf, 0x5e, 0x9d, 0x1e, 0x7e, 0x80, 0xcf, 0x85, 0xb6, 0x90,
+	0x30, 0x9b, 0x65, 0xd3, 0x36, 0x1c, 0xa3, 0xef, 0x0d, 0xaf, 0x4f, 0x0d, 0x9f, 0x19, 0x3d, 0xb3,
+	0x63, 0x10, 0xcb, 0x89, 0x50, 0xcf, 0x39, 0x02, 0x8b, 0xad, 0x5e, 0x3b, 0xb5, 0xac, 0xd3, 0x1e,
+	0xde, 0x64, 0xbd, 0xe3, 0xe1, 0xc9, 0x26, 0x31, 0xfb, 0xd8, 0x25, 0x46, 0xdf, 0x16, 0x0c, 0x6b,
+	0x93, 0x0c, 0x9d, 0xa1, 0x63, 0x10, 0xd3, 0x1a, 0x88, 0xf1, 0xd5, 0x53, 0xeb, 0xd4, 0x62, 0xcd,
+	0x4d, 0xda, 0xe2, 0x54, 0xf5, 0x37, 0x39, 0x58, 0xd2, 0xf0, 0x87, 0x43, 0xec, 0x12, 0xb4, 0x05,
+	0x32, 0x6e, 0x77, 0xad, 0x8a, 0xb4, 0x2e, 0x5d, 0xcf, 0x6f, 0x5d, 0xdd, 0x98, 0x30, 0xcf, 0x86,
+	0xe0, 0x6b, 0xb4, 0xbb, 0x56, 0x33, 0xa1, 0x31, 0x5e, 0xf4, 0x32, 0xa4, 0x4f, 0x7a, 0x43, 0xb7,
+	0x5b, 0x49, 0x32, 0xa1, 0x27, 0xe2, 0x84, 0xee, 0x50, 0xa6, 0x66, 0x42, 0xe3, 0xdc, 0x74, 0x2a,
+	0x73, 0x70, 0x62, 0x55, 0x52, 0x17, 0x4f, 0xb5, 0x33, 0x38, 0x61, 0x53, 0x51, 0x5e, 0xb4, 0x0d,
+	0x60, 0x0e, 0x4c, 0xa2, 0xb7, 0xbb, 0x86, 0x39, 0xa8, 0xa4, 0x99, 0xe4, 0x93, 0xf1, 0x92, 0x26,
+	0xa9, 0x53, 0xc6, 0x66, 0x42, 0xcb, 0x99, 0x5e, 0x87, 0x2e, 0xf7, 0xc3, 0x21, 0x76, 0xce, 0x2b,
+	0x99, 0x8b, 0x97, 0xfb, 0x36, 0x65, 0xa2, 0xcb, 0x65, 0xdc, 0xe8, 0x0d, 0xc8, 0xb6, 0xbb, 0xb8,
+	0xfd, 0x40, 0x27, 0xa3, 0x4a, 0x96, 0x49, 0x5e, 0x8b, 0x93, 0xac, 0x53, 0xbe, 0xd6, 0xa8, 0x99,
+	0xd0, 0x96, 0xda, 0xbc, 0x89, 0x5e, 0x83, 0x4c, 0xdb, 0xea, 0xf7, 0x4d, 0x52, 0xc9, 0x33, 0xd9,
+	0xb5, 0x58, 0x59, 0xc6, 0xd5, 0x4c, 0x68, 0x82, 0x1f, 0xed, 0x43, 0xa9, 0x67, 0xba, 0x44, 0x77,
+	0x07, 0x86, 0xed, 0x76, 0x2d, 0xe2, 0x56, 0x0a, 0x4c, 0xc3, 0xd3, 0x71, 0x1a, 0xf6, 0x4c, 0x97,
+	0x1c, 0x79, 0xcc, 0xcd, 0x84, 0x56, 0xec, 0x05, 0x09, 0x54, 0x9f, 0x75, 0x72, 0x82, 0x1d, 0x5f,
+	0x61, 0xa5, 0x78, 0xb1, 0xbe, 0x03, 0xca, 0xed, 0xc9, 0x53, 0x7d, 0x56, 0x90, 0x80, 0xfe, 0x07,
+	0x56, 0x7a, 0x96, 0xd1, 0xf1, 0xd5, 0xe9, 0xed, 0xee, 0x70, 0xf0, 0xa0, 0x52, 0x62, 0x4a, 0x9f,
+	0x8b, 0x5d, 0xa4, 0x65, 0x74, 0x3c, 0x15, 0x75, 0x2a, 0xd0, 0x4c, 0x68, 0xcb, 0xbd, 0x49, 0x22,
+	0x7a, 0x0f, 0x56, 0x0d, 0xdb, 0xee, 0x9d, 0x4f, 0x6a, 0x2f, 0x33, 0xed, 0x37, 0xe2, 0xb4, 0xd7,
+	0xa8, 0xcc, 0xa4, 0x7a, 0x64, 0x4c, 0x51, 0x51, 0x0b, 0x14, 0xdb, 0xc1, 0xb6, 0xe1, 0x60, 0xdd,
+	0x76, 0x2c, 0xdb, 0x72, 0x8d, 0x5e, 0x45, 0x61, 0xba, 0x9f, 0x8d, 0xd3, 0x7d, 0xc8, 0xf9, 0x0f,
+	0x05, 0x7b, 0x33, 0xa1, 0x95, 0xed, 0x30, 0x89, 0x6b, 0xb5, 0xda, 0xd8, 0x75, 0xc7, 0x5a, 0x97,
+	0x67, 0x69, 0x65, 0xfc, 0x61, 0xad, 0x21, 0x12, 0x6a, 0x40, 0x1e, 0x8f, 0xa8, 0xb8, 0x7e, 0x66,
+	0x11, 0x5c, 0x41, 0x4c, 0xa1, 0x1a, 0xbb, 0x43, 0x19, 0xeb, 0x7d, 0x8b, 0xe0, 0x66, 0x42, 0x03,
+	0xec, 0xf7, 0x90, 0x01, 0x97, 0xce, 0xb0, 0x63, 0x9e, 0x9c, 0x33, 0x35, 0x3a, 0x1b, 0x71, 0x4d,
+	0x6b, 0x50, 0x59, 0x61, 0x0a, 0x9f, 0x8f, 0x53, 0x78, 0x9f, 0x09, 0x51, 0x15, 0x0d, 0x4f, 0xa4,
+	0x99, 0xd0, 0x56, 0xce, 0xa6, 0xc9, 0xd4, 0xc5, 0x4e, 0xcc, 0x81, 0xd1, 0x33, 0x1f, 0x62, 0xfd,
+	0xb8, 0x67, 0xb5, 0x1f, 0x54, 0x56, 0x2f, 0x76, 0xb1, 0x3b, 0x82, 0x7b, 0x9b, 0x32, 0x53, 0x17,
+	0x3b, 0x09, 0x12, 0xb6, 0x97, 0x20, 0x7d, 0x66, 0xf4, 0x86, 0x78, 0x57, 0xce, 0xca, 0x4a, 0x7a,
+	0x57, 0xce, 0x2e, 0x29, 0xd9, 0x5d, 0x39, 0x9b, 0x53, 0x60, 0x57, 0xce, 0x82, 0x92, 0x57, 0x9f,
+	0x85, 0x7c, 0x20, 0x30, 0xa1, 0x0a, 0x2c, 0xf5, 0xb1, 0xeb, 0x1a, 0xa7, 0x98, 0xc5, 0xb1, 0x9c,
+	0xe6, 0x75, 0xd5, 0x12, 0x14, 0x82, 0xc1, 0x48, 0xfd, 0x54, 0x82, 0x7c, 0x20, 0xce, 0x50, 0xc9,
+	0x33, 0xec, 0x30, 0x73, 0x08, 0x49, 0xd1, 0x45, 0x4f, 0x41, 0x91, 0x7d, 0x8a, 0xee, 0x8d, 0xd3,
+	0x60, 0x27, 0x6b, 0x05, 0x46, 0xbc, 0x2f, 0x98, 0xae, 0x41, 0xde, 0xde, 0xb2, 0x7d, 0x96, 0x14,
+	0x63, 0x01, 0x7b, 0xcb, 0xf6, 0x18, 0x9e, 0x84, 0x02, 0xfd, 0x6e, 0x9f, 0x43, 0x66, 0x93, 0xe4,
+	0x29, 0x4d, 0xb0, 0xa8, 0xbf, 0x4d, 0x82, 0x32, 0x19, 0xc0, 0xd0, 0x6b, 0x20, 0xd3, 0x58, 0x2f,
+	0xc2, 0x72, 0x75, 0x83, 0xc7, 0xf9, 0x0d, 0x2f, 0xce, 0x6f, 0xb4, 0xbc, 0x83, 0x60, 0x3b, 0xfb,
+	0xc5, 0x57, 0xd7, 0x12, 0x9f, 0xfe, 0xfe, 0x9a, 0xa4, 0x31, 0x09, 0x74, 0x85, 0x86, 0x2d, 0xc3,
+	0x1c, 0xe8, 0x66, 0x87, 0x2d, 0x39, 0x47, 0x63, 0x92, 0x61, 0x0e, 0x76, 0x3a, 0x68, 0x0f, 0x94,
+	0xb6, 0x35, 0x70, 0xf1, 0xc0, 0x1d, 0xba, 0x3a, 0x3f, 0xa8, 0x2a, 0xa9, 0xe9, 0x90, 0xca, 0x4f,
+	0xa0, 0xba, 0xc7, 0x79, 0xc8, 0x18, 0xb5, 0x72, 0x3b, 0x4c, 0x40, 0x77, 0x00, 0xfc, 0xd3, 0xcc,
+	0xad, 0xc8, 0xeb, 0xa9, 0xeb, 0xf9, 0xad, 0xf5, 0xa9, 0x1f, 0x7e, 0xdf, 0x63, 0xb9, 0x67, 0x77,
+	0x0c, 0x82, 0xb7, 0x65, 0xba, 0x5c, 0x2d, 0x20, 0x89, 0x9e, 0x81, 0xb2, 0x61, 0xdb, 0xba, 0x4b,
+	0x0c, 0x82, 0xf5, 0xe3, 0x73, 0x82, 0x5d, 0x16, 0xe7, 0x0b, 0x5a, 0xd1, 0xb0, 0xed, 0x23, 0x4a,
+	0xdd, 0xa6, 0x44, 0xf4, 0x34, 0x94, 0x68, 0x4c, 0x37, 0x8d, 0x9e, 0xde, 0xc5, 0xe6, 0x69, 0x97,
+	0xb0, 0x78, 0x9e, 0xd2, 0x8a, 0x82, 0xda, 0x64, 0x44, 0xb5, 0x03, 0x85, 0x60, 0x3c, 0x47, 0x08,
+	0xe4, 0x8e, 0x41, 0x0c, 0x66, 0xc9, 0x82, 0xc6, 0xda, 0x94, 0x66, 0x1b, 0xa4, 0x2b, 0xec, 0xc3,
+	0xda, 0xe8, 0x32, 0x64, 0x84, 0xda, 0x14, 0x53, 0x2b, 0x7a, 0x68, 0x15, 0xd2, 0xb6, 0x63, 0x9d,
+	0x61, 0xf6, 0xeb, 0xb2, 0x1a, 0xef, 0xa8, 0x1a, 0x94, 0xc2, 0xb1, 0x1f, 0x95, 0x20, 0x49, 0x46,
+	0x62, 0x96, 0x24, 0x19, 0xa1, 0x97, 0x40, 0xa6, 0x86, 0x64, 0x73, 0x94, 0x22, 0x4e, 0x3b, 0x21,
+	0xd7, 0x3a, 0xb7, 0xb1, 0xc6, 0x38, 0xd5, 0xe7, 0xa1, 0x18, 0x3a, 0x13, 0x50, 0x15, 0xb2, 0x7e,
+	0xcc, 0x96, 0xd8, 0xec, 0x7e, 0x5f, 0xbd, 0x0c, 0xab, 0x51, 0xe1, 0x5f, 0xfd, 0xa1, 0x04, 0xab,
+	0x51, 0x71, 0x1c, 0xbd, 0x3c, 0xa1, 0x2c, 0xbf, 0x75, 0x65, 0x6a, 0x4d, 0x1e, 0xf3, 0x78, 0x1e,
+	0xea, 0x4e, 0xf4, 0xef, 0x74, 0x0d, 0x71, 0xdc, 0x17, 0xb4, 0x25, 0xc3, 0xb6, 0x9b, 0x86, 0xdb,
+	0xa5, 0xce, 0x4f, 0x87, 0x26, 0x9c, 0xdf, 0xb0, 0x3d, 0xe7, 0x57, 0xdf, 0x87, 0x4a, 0x5c, 0xf4,
+	0x0f, 0x98, 0x5b, 0x62, 0x72, 0xa2, 0x47, 0xe9, 0x27, 0x96, 0xd3, 0x37, 0x08, 0x9b, 0xad, 0xa8,
+	0x89, 0x1e, 0xfd, 0x0d, 0xfc, 0x24, 0x48, 0x31, 0x32, 0xef, 0xa8, 0x3a, 0x5c, 0x89, 0x3d, 0x01,
+	0xa8, 0x88, 0x39, 0xe8, 0x60, 0xfe, 0x53, 0x8a, 0x1a, 0xef, 0x8c, 0x15, 0xf1, 0xaf, 0xe1, 0x1d,
+	0x3a, 0xad, 0xcb, 0x8c, 0xc1, 0xf4, 0xe7, 0x34, 0xd1, 0x53, 0x3f, 0x4b, 0xc1, 0xe5, 0xe8, 0x73,
+	0x00, 0xad, 0x43, 0xa1, 0x6f, 0x8c, 0x74, 0x32, 0x12, 0x4e, 0x2b, 0x31, 0xb7, 0x81, 0xbe, 0x31,
+	0x6a, 0x8d, 0xb8, 0xc7, 0x2a, 0x90, 0x22, 0x23, 0xb7, 0x92, 0x5c, 0x4f, 0x5d, 0x2f, 0x68, 0xb4,
+	0x89, 0xee, 0xc1, 0x72, 0xcf, 0x6a, 0x1b, 0x3d, 0xbd, 0x67, 0xb8, 0x44, 0x17, 0x09, 0x02, 0xdf,
+	0x82, 0x4f, 0x4d, 0xfd, 0x0d, 0x1e, 0xd1, 0x71, 0x87, 0x7b, 0x03, 0x0d, 0x57, 0x62, 0xf7, 0x94,
+	0x99, 0x8e, 0x3d, 0xc3, 0x77, 0x94, 0xdb, 0x90, 0xef, 0x9b, 0xee, 0x31, 0xee, 0x1a, 0x67, 0xa6,
+	0xe5, 0x88, 0xbd, 0x38, 0xed, 0x72, 0x6f, 0x8d, 0x79, 0x84, 0xa6, 0xa0, 0x58, 0xe0, 0x97, 0xa4,
+	0x43, 0x3b, 0xc0, 0x8b, 0x45, 0x99, 0x85, 0x63, 0xd1, 0x4b, 0xb0, 0x3a, 0xc0, 0x23, 0xa2, 0x8f,
+	0x77, 0x3b, 0x77, 0xa4, 0x25, 0x66, 0x7a, 0x44, 0xc7, 0xfc, 0xf8, 0xe0, 0x32, 0x9f, 0x7a, 0x8e,
+	0x9d, 0xa4, 0xb6, 0xe5, 0x62, 0x47, 0x37, 0x3a, 0x1d, 0x07, 0xbb, 0x2e, 0x4b, 0xbe, 0x0a, 0x5a,
+	0xd9, 0xa3, 0xd7, 0x38, 0x59, 0xfd, 0x9e, 0x1c, 0xf8, 0x35, 0xe1, 0x93, 0x53, 0x18, 0x5e, 0x1a,
+	0x1b, 0xfe, 0x08, 0x56, 0x85, 0x7c, 0x27, 0x64, 0x7b, 0x9e, 0xc1, 0x3e, 0x3e, 0xbd, 0x3b, 0x27,
+	0x6d, 0x8e, 0x3c, 0xf1, 0x78, 0xb3, 0xa7, 0x1e, 0xcd, 0xec, 0x08, 0x64, 0x66, 0x14, 0x99, 0x07,
+	0x28, 0xda, 0xfe, 0x07, 0xfb, 0x15, 0x34, 0x12, 0xb8, 0x1f, 0x0e, 0x69, 0x52, 0xe5, 0x9a, 0x0f,
+	0x71, 0x25, 0xc7, 0x23, 0x01, 0x27, 0x1d, 0x99, 0x0f, 0x31, 0xfa, 0x37, 0x28, 0xd1, 0xc0, 0xab,
+	0x3b, 0x96, 0x45, 0xf8, 0xbc, 0xc0, 0x34, 0x15, 0x28, 0x55, 0xb3, 0x2c, 0xc2, 0x66, 0x7c, 0x89,
+	0x7e, 0xb5, 0x41, 0x37, 0x21, 0x4f, 0x1f, 0x2a, 0xd3, 0xa7, 0x52, 0x93, 0x8d, 0x6b, 0x82, 0x4f,
+	0xfd, 0x38, 0x05, 0xcb, 0x53, 0xf9, 0x8f, 0x6f, 0x51, 0x29, 0xd2, 0xa2, 0xc9, 0x48, 0x8b, 0xa6,
+	0x16, 0xb6, 0xa8, 0x70, 0x32, 0x79, 0xb6, 0x93, 0xa5, 0xbf, 0x43, 0x27, 0xcb, 0x3c, 0x9a, 0x93,
+	0xfd, 0x5d, 0x77, 0xe2, 0x4f, 0x25, 0xa8, 0xc6, 0x27, 0x8d, 0x91, 0xbf, 0xe3, 0x79, 0x58, 0xf6,
+	0x97, 0xe2, 0xab, 0xe7, 0x11, 0x59, 0xf1, 0x07, 0x3c, 0xf7, 0x8a, 0x3b, 0x9a, 0x9f, 0x86, 0xd2,
+	0x44, 0x4a, 0xcb, 0xf7, 0x50, 0xf1, 0x2c, 0x38, 0xbf, 0xfa, 0xab, 0x14, 0xac, 0x46, 0xe5, 0x9d,
+	0x11, 0x61, 0xe2, 0x6d, 0x58, 0xe9, 0xe0, 0xb6, 0xd9, 0x79, 0xd4, 0x28, 0xb1, 0x2c, 0xa4, 0xff,
+	0x15, 0x24, 0xa6, 0x83, 0xc4, 0xe2, 0xbb, 0xfb, 0x27, 0x00, 0x59, 0x0d, 0xbb, 0xb6, 0x35, 0x70,
+	0x31, 0xda, 0x86, 0x1c, 0x1e, 0xb5, 0xb1, 0x4d, 0xbc, 0x5c, 0x3d, 0xfa, 0x2e, 0xc4, 0xb9, 0x1b,
+	0x1e, 0x27, 0x45, 0x02, 0x7c, 0x31, 0x74, 0x53, 0x80, 0x1d, 0xf1, 0xb8, 0x85, 0x10, 0x0f, 0xa2,
+	0x1d, 0xaf, 0x78, 0x68, 0x47, 0x2a, 0xf6, 0x22, 0xcf, 0xa5, 0x26, 0xe0, 0x8e, 0x9b, 0x02, 0xee,
+	0x90, 0x67, 0x4c, 0x16, 0xc2, 0x3b, 0xea, 0x21, 0xbc, 0x23, 0x33, 0xe3, 0x33, 0x63, 0x00, 0x8f,
+	0x57, 0x3c, 0xc0, 0x63, 0x69, 0xc6, 0x8a, 0x27, 0x10, 0x8f, 0x37, 0x03, 0x88, 0x47, 0x6e, 0x5d,
+	0x8a, 0xcc, 0xe7, 0x3d, 0xd1, 0x08, 0xc8, 0xe3, 0x75, 0x1f, 0xf2, 0x28, 0xc4, 0xc2, 0x25, 0x42,
+	0x78, 0x12, 0xf3, 0x38, 0x98, 0xc2, 0x3c, 0x38, 0x46, 0xf1, 0x4c, 0xac, 0x8a, 0x19, 0xa0, 0xc7,
+	0xc1, 0x14, 0xe8, 0x51, 0x9a, 0xa1, 0x70, 0x06, 0xea, 0xf1, 0xbf, 0xd1, 0xa8, 0x47, 0x3c, 0x2e,
+	0x21, 0x96, 0x39, 0x1f, 0xec, 0xa1, 0xc7, 0xc0, 0x1e, 0x4a, 0xec, 0x15, 0x9d, 0xab, 0x9f, 0x1b,
+	0xf7, 0xb8, 0x17, 0x81, 0x7b, 0x70, 0x84, 0xe2, 0x7a, 0xac, 0xf2, 0x39, 0x80, 0x8f, 0x7b, 0x11,
+	0xc0, 0x07, 0x9a, 0xa9, 0x76, 0x26, 0xf2, 0x71, 0x27, 0x8c, 0x7c, 0xac, 0xc4, 0x24, 0xc8, 0xe3,
+	0xdd, 0x1e, 0x03, 0x7d, 0x1c, 0xc7, 0x41, 0x1f, 0x3c, 0x02, 0xbd, 0x10, 0xab, 0x71, 0x01, 0xec,
+	0xe3, 0x60, 0x0a, 0xfb, 0xb8, 0x34, 0xc3, 0xd3, 0xe6, 0x07, 0x3f, 0xd2, 0x4a, 0x66, 0x57, 0xce,
+	0x66, 0x95, 0x1c, 0x87, 0x3d, 0x76, 0xe5, 0x6c, 0x5e, 0x29, 0xa8, 0xcf, 0xc1, 0xb2, 0xa7, 0xca,
+	0x8f, 0x73, 0xf4, 0x5a, 0x83, 0x1d, 0xc7, 0x72, 0x04, 0x8c, 0xc1, 0x3b, 0xea, 0x75, 0x28, 0xf8,
+	0xac, 0x17, 0x03, 0x25, 0x65, 0x28, 0x7a, 0x9c, 0x1c, 0x29, 0xf9, 0xab, 0x04, 0x85, 0x60, 0x88,
+	0x0a, 0x5d, 0xa4, 0x73, 0xe2, 0x22, 0x1d, 0x80, 0x4f, 0x92, 0x61, 0xf8, 0x64, 0xd6, 0xe5, 0x10,
+	0xdd, 0x80, 0x65, 0x76, 0xc4, 0x72, 0x90, 0x45, 0x1c, 0x64, 0x32, 0x3b, 0xc8, 0xca, 0x74, 0x80,
+	0x5b, 0x87, 0x91, 0xd1, 0x8b, 0xb0, 0x12, 0xe0, 0xf5, 0xef, 0xa3, 0x1c, 0x26, 0x50, 0x7c, 0xee,
+	0x9a, 0xb8, 0x98, 0x36, 0xa0, 0x40, 0x8f, 0x33, 0x6b, 0x48, 0x74, 0x16, 0x81, 0x33, 0x31, 0x80,
+	0x73, 0x8b, 0x33, 0x05, 0xce, 0xef, 0x3c, 0x19, 0x93, 0xd4, 0x1f, 0x25, 0x61, 0x79, 0x2a, 0xd2,
+	0x46, 0x82, 0x28, 0xd2, 0x77, 0x04, 0xa2, 0x24, 0x1f, 0x19, 0x44, 0x09, 0x5e, 0xd3, 0x53, 0xe1,
+	0x6b, 0xfa, 0xa4, 0x35, 0xe4, 0x47, 0xb3, 0xc6, 0x5f, 0x24, 0x28, 0x86, 0xce, 0x0d, 0xea, 0x10,
+	0x6d, 0xab, 0x83, 0xc5, 0xf5, 0x9a, 0xb5, 0x69, 0x4a, 0xd5, 0xb3, 0x4e, 0xc5, 0x25, 0x9a, 0x36,
+	0x29, 0x97, 0x3f, 0x6d, 0x4e, 0x9c, 0x72, 0xfe, 0xcd, 0x9c, 0x27, 0x2e, 0xbc, 0x43, 0x65, 0x1f,
+	0x60, 0x8e, 0xd2, 0x17, 0x34, 0xda, 0x44, 0xab, 0x62, 0x2b, 0x88, 0x04, 0x84, 0x77, 0xd0, 0x6b,
+	0x90, 0x63, 0x15, 0x1a, 0xdd, 0xb2, 0xdd, 0x4a, 0x76, 0x3a, 0x35, 0xe3, 0x65, 0x9a, 0x8d, 0x43,
+	0xca, 0x73, 0x60, 0xbb, 0x5a, 0xd6, 0x16, 0xad, 0x40, 0xc6, 0x94, 0x0b, 0x65, 0x4c, 0x57, 0x21,
+	0x47, 0x57, 0xef, 0xda, 0x46, 0x1b, 0xb3, 0x9b, 0x49, 0x4e, 0x1b, 0x13, 0xd4, 0x3f, 0x25, 0xa1,
+	0x3c, 0x71, 0xec, 0x45, 0x7e, 0xbb, 0xb7, 0x41, 0x92, 0x01, 0xa4, 0x69, 0x3e, 0x7b, 0xac, 0x01,
+	0x9c, 0x1a, 0xae, 0xfe, 0x91, 0x31, 0x20, 0xb8, 0x23, 0x8c, 0x12, 0xa0, 0x50, 0x20, 0x88, 0xf6,
+	0x86, 0x2e, 0xee, 0x08, 0xd0, 0xcb, 0xef, 0xa3, 0x26, 0x64, 0xf0, 0x19, 0x1e, 0x10, 0xb7, 0xb2,
+	0xc4, 0xbc, 0xe7, 0xf2, 0x34, 0x8e, 0x40, 0x87, 0xb7, 0x2b, 0xf4, 0x97, 0xfe, 0xf1, 0xab, 0x6b,
+	0x0a, 0xe7, 0x7e, 0xc1, 0xea, 0x9b, 0x04, 0xf7, 0x6d, 0x72, 0xae, 0x09, 0xf9, 0xb0, 0x15, 0xb2,
+	0x13, 0x56, 0xa0, 0x5b, 0xdd, 0x4b, 0xf0, 0xca, 0xc2, 0xc1, 0x78, 0x97, 0xae, 0xce, 0x76, 0x4c,
+	0xcb, 0x31, 0xc9, 0x39, 0x3b, 0xb0, 0x52, 0x9a, 0xdf, 0xa7, 0x63, 0x2e, 0x4d, 0xbd, 0x07, 0x6d,
+	0xcc, 0xce, 0x1b, 0x59, 0xf3, 0xfb, 0x0c, 0xd0, 0x2d, 0x78, 0x48, 0x8b, 0x56, 0xec, 0xe3, 0xbe,
+	0x6d, 0x59, 0x3d, 0x9d, 0x47, 0xae, 0x1a, 0x94, 0x7c, 0x9b, 0xf3, 0x1c, 0xe1, 0x29, 0x28, 0x3a,
+	0x98, 0x50, 0x64, 0x33, 0x74, 0x19, 0x28, 0x70, 0x22, 0x8f, 0x14, 0xbb, 0x72, 0x56, 0x52, 0x92,
+	0xbb, 0x72, 0x36, 0xa9, 0xa4, 0xd4, 0x43, 0xb8, 0x14, 0x99, 0x2d, 0xa0, 0x57, 0x21, 0x37, 0x4e,
+	0x34, 0xa4, 0xf5, 0xd4, 0xc5, 0x58, 0xd8, 0x98, 0x57, 0xfd, 0xb5, 0x04, 0x97, 0x22, 0xf3, 0x05,
+	0xd4, 0x80, 0x8c, 0x83, 0xdd, 0x61, 0x8f, 0xc3, 0x59, 0xa5, 0xad, 0x17, 0xe7, 0xcb, 0x33, 0x28,
+	0x75, 0xd8, 0x23, 0x9a, 0x10, 0x56, 0xdf, 0x83, 0x0c, 0xa7, 0xa0, 0x3c, 0x2c, 0xdd, 0xdb, 0xbf,
+	0xbb, 0x7f, 0xf0, 0xce, 0xbe, 0x92, 0x40, 0x00, 0x99, 0x5a, 0xbd, 0xde, 0x38, 0x6c, 0x29, 0x12,
+	0xca, 0x41, 0xba, 0xb6, 0x7d, 0xa0, 0xb5, 0x94, 0x24, 0x25, 0x6b, 0x8d, 0xdd, 0x46, 0xbd, 0xa5,
+	0xa4, 0xd0, 0x32, 0x14, 0x79, 0x5b, 0xbf, 0x73, 0xa0, 0xbd, 0x55, 0x6b, 0x29, 0x72, 0x80, 0x74,
+	0xd4, 0xd8, 0xbf, 0xdd, 0xd0, 0x94, 0xb4, 0xfa, 0xef, 0x70, 0xc5, 0x5b, 0xc7, 0x34, 0x24, 0xe7,
+	0x23, 0x63, 0x52, 0x00, 0x19, 0x53, 0x3f, 0x4b, 0x42, 0xd5, 0x93, 0x89, 0x00, 0xd9, 0x76, 0x27,
+	0x3e, 0x7c, 0x6b, 0x81, 0x5c, 0x65, 0xe2, 0xeb, 0xe9, 0x7d, 0xce, 0xc1, 0x27, 0x98, 0xb4, 0xbb,
+	0x3c, 0xfd, 0xe1, 0x01, 0xb1, 0xa8, 0x15, 0x05, 0x95, 0x09, 0xb9, 0x9c, 0xed, 0x03, 0xdc, 0x26,
+	0x3a, 0x77, 0x1d, 0x97, 0x5d, 0xaa, 0x72, 0x5a, 0x91, 0x53, 0x8f, 0x38, 0x51, 0x7d, 0x7f, 0x21,
+	0x5b, 0xe6, 0x20, 0xad, 0x35, 0x5a, 0xda, 0xbb, 0x4a, 0x0a, 0x21, 0x28, 0xb1, 0xa6, 0x7e, 0xb4,
+	0x5f, 0x3b, 0x3c, 0x6a, 0x1e, 0x50, 0x5b, 0xae, 0x40, 0xd9, 0xb3, 0xa5, 0x47, 0x4c, 0xab, 0x0e,
+	0x3c, 0x16, 0x93, 0x2b, 0x45, 0x5c, 0x2d, 0x27, 0x30, 0x92, 0xe4, 0x1c, 0x18, 0x49, 0x6a, 0x1a,
+	0x23, 0x51, 0x7f, 0x26, 0x05, 0x27, 0x0d, 0xa7, 0x4d, 0x07, 0x90, 0x71, 0x89, 0x41, 0x86, 0xae,
+	0xf8, 0x17, 0xaf, 0xce, 0x9b, 0x83, 0x6d, 0x78, 0x8d, 0x23, 0x26, 0xae, 0x09, 0x35, 0xea, 0xcb,
+	0x50, 0x0a, 0x8f, 0xc4, 0x9b, 0x72, 0xec, 0x8b, 0x49, 0xf5, 0x16, 0xa0, 0xe9, 0xd4, 0x2c, 0xe2,
+	0xb6, 0x2e, 0x45, 0xdd, 0xd6, 0x7f, 0x2e, 0xc1, 0xe3, 0x17, 0xa4, 0x61, 0xe8, 0xed, 0x89, 0x8f,
+	0x7c, 0x7d, 0x91, 0x24, 0x6e, 0x83, 0xd3, 0x26, 0x3e, 0xf3, 0x26, 0x14, 0x82, 0xf4, 0xf9, 0x3e,
+	0xf2, 0x97, 0x29, 0xb8, 0x14, 0x99, 0xd1, 0x05, 0x22, 0xb2, 0xf4, 0x2d, 0x23, 0xf2, 0x1b, 0x00,
+	0x64, 0xa4, 0xf3, 0xdd, 0xe1, 0x65, 0x07, 0xd3, 0x17, 0xc9, 0xc6, 0x08, 0xb7, 0x5b, 0x23, 0xb1,
+	0x97, 0x72, 0x44, 0xb4, 0x28, 0x1c, 0x15, 0xc0, 0x58, 0x86, 0x2c, 0x73, 0x70, 0x2b, 0xa9, 0x85,
+	0x52, 0x0c, 0xe5, 0x2c, 0x4c, 0x76, 0xd1, 0xbb, 0xf0, 0xd8, 0x44, 0xfa, 0xe3, 0xab, 0x96, 0xe7,
+	0xcd, 0x82, 0x2e, 0x85, 0xb3, 0x20, 0x4f, 0x75, 0x30, 0x87, 0x49, 0x5f, 0x9c, 0xc3, 0x3c, 0x62,
+	0x46, 0xf7, 0x2e, 0xc0, 0x18, 0xb2, 0xa1, 0xf1, 0xce, 0xb1, 0x86, 0x83, 0x0e, 0x73, 0xa4, 0xb4,
+	0xc6, 0x3b, 0xf4, 0xb5, 0x00, 0x75, 0x48, 0xcf, 0xdc, 0xd3, 0x07, 0x03, 0x75, 0xa8, 0xc0, 0x04,
+	0x9c, 0x5b, 0x35, 0x01, 0x4d, 0xe3, 0xf5, 0x31, 0x53, 0xbc, 0x19, 0x9e, 0xe2, 0xc9, 0x58, 0xe4,
+	0x3f, 0x7a, 0xaa, 0x87, 0x90, 0x66, 0x0e, 0x44, 0x53, 0x09, 0x56, 0x62, 0x12, 0x19, 0x39, 0x6d,
+	0xa3, 0xff, 0x03, 0x30, 0x08, 0x71, 0xcc, 0xe3, 0xe1, 0x78, 0x82, 0x6b, 0xd1, 0x0e, 0x58, 0xf3,
+	0xf8, 0xb6, 0xaf, 0x0a, 0x4f, 0x5c, 0x1d, 0x8b, 0x06, 0xbc, 0x31, 0xa0, 0x50, 0xdd, 0x87, 0x52,
+	0x58, 0xd6, 0xcb, 0xda, 0xf8, 0x1a, 0xc2, 0x59, 0x1b, 0xbf, 0x12, 0xf0, 0xce, 0x38, 0xe7, 0x4b,
+	0xf1, 0x3a, 0x1a, 0xeb, 0xa8, 0xff, 0x9f, 0x84, 0x42, 0xd0, 0x7f, 0xff, 0xf9, 0x12, 0x2b, 0xf5,
+	0x07, 0x12, 0x64, 0xfd, 0xcf, 0x0f, 0x97, 0xc5, 0x42, 0x55, 0x48, 0x6e, 0xbd, 0x64, 0xb0, 0x96,
+	0xc5, 0x6b, 0x8e, 0x29, 0xbf, 0xe6, 0x78, 0xcb, 0x3f, 0x8c, 0xe3, 0x40, 0xa7, 0xa0, 0xad, 0x85,
+	0x57, 0x79, 0xb9, 0xc7, 0x2d, 0xc8, 0xf9, 0x41, 0x20, 0x98, 0xed, 0x49, 0xe1, 0x6c, 0x8f, 0xd6,
+	0x43, 0xad, 0x8f, 0x44, 0xa1, 0x2c, 0xa5, 0xf1, 0x8e, 0xda, 0x81, 0xf2, 0x44, 0x04, 0x41, 0xb7,
+	0x60, 0xc9, 0x1e, 0x1e, 0xeb, 0x9e, 0x73, 0x4c, 0x6c, 0x57, 0x2f, 0x49, 0x1f, 0x1e, 0xf7, 0xcc,
+	0xf6, 0x5d, 0x7c, 0xee, 0x2d, 0xc6, 0x1e, 0x1e, 0xdf, 0xe5, 0x3e, 0xc4, 0x67, 0x49, 0x06, 0x67,
+	0xf9, 0xb1, 0x04, 0x59, 0x6f, 0x4f, 0xa0, 0xff, 0x84, 0x9c, 0x1f, 0x9d, 0xfc, 0x3a, 0x79, 0x6c,
+	0x58, 0x13, 0xfa, 0xc7, 0x22, 0xa8, 0xe6, 0x15, 0xf8, 0xcd, 0x8e, 0x7e, 0xd2, 0x33, 0xb8, 0x2f,
+	0x95, 0xc2, 0x36, 0xe3, 0xf1, 0x8b, 0x85, 0xf5, 0x9d, 0xdb, 0x77, 0x7a, 0xc6, 0xa9, 0x96, 0x67,
+	0x32, 0x3b, 0x1d, 0xda, 0x11, 0x79, 0xe6, 0x9f, 0x25, 0x50, 0x26, 0x77, 0xec, 0xb7, 0x5e, 0xdd,
+	0xf4, 0x69, 0x99, 0x8a, 0x38, 0x2d, 0xd1, 0x26, 0xac, 0xf8, 0x1c, 0xba, 0x6b, 0x9e, 0x0e, 0x0c,
+	0x32, 0x74, 0xb0, 0x80, 0x89, 0x91, 0x3f, 0x74, 0xe4, 0x8d, 0x4c, 0x7f, 0x75, 0xfa, 0x11, 0xbf,
+	0xfa, 0xe3, 0x24, 0xe4, 0x03, 0xa0, 0x35, 0xfa, 0x8f, 0x40, 0x30, 0x2a, 0x45, 0x1c, 0x30, 0x01,
+	0xde, 0x71, 0xcd, 0x3b, 0x6c, 0xa6, 0xe4, 0xe2, 0x66, 0x8a, 0x2b, 0x0d, 0x78, 0x18, 0xb8, 0xbc,
+	0x30, 0x06, 0xfe, 0x02, 0x20, 0x62, 0x11, 0xa3, 0x47, 0x21, 0x23, 0x73, 0x70, 0xaa, 0x73, 0x37,
+	0xe4, 0xa1, 0x43, 0x61, 0x23, 0xf7, 0xd9, 0xc0, 0x21, 0xf3, 0xc8, 0xef, 0x4b, 0x90, 0xf5, 0x2f,
+	0x01, 0x8b, 0xd6, 0xb4, 0x2f, 0x43, 0x46, 0xe4, 0xb9, 0xbc, 0xa8, 0x2d, 0x7a, 0x91, 0x60, 0x7f,
+	0x15, 0xb2, 0x7d, 0x4c, 0x0c, 0x16, 0x07, 0xf9, 0xe1, 0xe8, 0xf7, 0xd5, 0x5f, 0x48, 0x90, 0x0f,
+	0x9c, 0x7c, 0x68, 0x0f, 0xca, 0xde, 0x69, 0x29, 0x40, 0x78, 0xbf, 0xe2, 0x3f, 0x69, 0x87, 0xdb,
+	0xe2, 0xbd, 0x20, 0x37, 0xc3, 0x67, 0xd4, 0x0c, 0x25, 0x21, 0xcb, 0x33, 0x3f, 0x8c, 0x76, 0xc1,
+	0xa3, 0x84, 0xcb, 0x21, 0x73, 0x29, 0x2b, 0x0a, 0x51, 0x7e, 0x26, 0xde, 0x78, 0x1d, 0xf2, 0x81,
+	0x77, 0x0f, 0x34, 0x88, 0xef, 0x37, 0xde, 0x51, 0x12, 0xd5, 0xa5, 0x4f, 0x3e, 0x5f, 0x4f, 0xed,
+	0xe3, 0x8f, 0x68, 0xdc, 0xd1, 0x1a, 0xf5, 0x66, 0xa3, 0x7e, 0x57, 0x91, 0xaa, 0xf9, 0x4f, 0x3e,
+	0x5f, 0x5f, 0xd2, 0x30, 0xc3, 0x97, 0x6f, 0xdc, 0x85, 0xf2, 0x84, 0x0b, 0x85, 0xf3, 0x34, 0x04,
+	0xa5, 0xdb, 0xf7, 0x0e, 0xf7, 0x76, 0xea, 0xb5, 0x56, 0x43, 0xbf, 0x7f, 0xd0, 0x6a, 0x28, 0x12,
+	0x7a, 0x0c, 0x56, 0xf6, 0x76, 0xfe, 0xab, 0xd9, 0xd2, 0xeb, 0x7b, 0x3b, 0x8d, 0xfd, 0x96, 0x5e,
+	0x6b, 0xb5, 0x6a, 0xf5, 0xbb, 0x4a, 0x72, 0xeb, 0xf3, 0x3c, 0xc8, 0xb5, 0xed, 0xfa, 0x0e, 0xaa,
+	0x83, 0xcc, 0x80, 0xb1, 0x0b, 0x1f, 0x3e, 0x56, 0x2f, 0xae, 0x14, 0xa0, 0x3b, 0x90, 0x66, 0x98,
+	0x19, 0xba, 0xf8, 0x25, 0x64, 0x75, 0x46, 0xe9, 0x80, 0x2e, 0x86, 0xfd, 0xbf, 0x0b, 0x9f, 0x46,
+	0x56, 0x2f, 0xae, 0x24, 0xa0, 0x3d, 0x58, 0xf2, 0x40, 0x8a, 0x59, 0xef, 0x15, 0xab, 0x33, 0xe1,
+	0x7d, 0xfa, 0x69, 0x1c, 0xec, 0xb9, 0xf8, 0xd5, 0x64, 0x75, 0x46, 0x8d, 0x01, 0xed, 0x40, 0x46,
+	0x5c, 0xe3, 0x67, 0x3c, 0x84, 0xac, 0xce, 0xaa, 0x1a, 0x20, 0x0d, 0x72, 0x63, 0x34, 0x6e, 0xf6,
+	0x5b, 0xd0, 0xea, 0x1c, 0xe5, 0x13, 0xf4, 0x1e, 0x14, 0xc3, 0x10, 0xc1, 0x7c, 0x8f, 0x2d, 0xab,
+	0x73, 0xd6, 0x27, 0xa8, 0xfe, 0x30, 0x5e, 0x30, 0xdf, 0xe3, 0xcb, 0xea, 0x9c, 0xe5, 0x0a, 0xf4,
+	0x01, 0x2c, 0x4f, 0xdf, 0xe7, 0xe7, 0x7f, 0x8b, 0x59, 0x5d, 0xa0, 0x80, 0x81, 0xfa, 0x80, 0x22,
+	0x70, 0x80, 0x05, 0x9e, 0x66, 0x56, 0x17, 0xa9, 0x67, 0xa0, 0x0e, 0x94, 0x27, 0x2f, 0xd7, 0xf3,
+	0x3e, 0xd5, 0xac, 0xce, 0x5d, 0xdb, 0xe0, 0xb3, 0x84, 0x6f, 0xd3, 0xf3, 0x3e, 0xdd, 0xac, 0xce,
+	0x5d, 0xea, 0x40, 0xf7, 0x00, 0x02, 0x17, 0xe2, 0x39, 0x9e, 0x72, 0x56, 0xe7, 0x29, 0x7a, 0x20,
+	0x1b, 0x56, 0xa2, 0x6e, 0xca, 0x8b, 0xbc, 0xec, 0xac, 0x2e, 0x54, 0x0b, 0xa1, 0xfe, 0x1c, 0xbe,
+	0xf3, 0xce, 0xf7, 0xd2, 0xb3, 0x3a, 0x67, 0x51, 0x64, 0xbb, 0xf6, 0xc5, 0xd7, 0x6b, 0xd2, 0x97,
+	0x5f, 0xaf, 0x49, 0x7f, 0xf8, 0x7a, 0x4d, 0xfa, 0xf4, 0x9b, 0xb5, 0xc4, 0x97, 0xdf, 0xac, 0x25,
+	0x7e, 0xf7, 0xcd, 0x5a, 0xe2, 0xbf, 0x9f, 0x3d, 0x35, 0x49, 0x77, 0x78, 0xbc, 0xd1, 0xb6, 0xfa,
+	0x9b, 0x6d, 0xab, 0x8f, 0xc9, 0xf1, 0x09, 0x19, 0x37, 0xc6, 0xcf, 0xfd, 0x8f, 0x33, 0xec, 0x58,
+	0xba, 0xf9, 0xb7, 0x01, 0x00, 0x13, 0x4a, 0xfb, 0xbd, 0x0e, 0x30, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5323,6 +5333,16 @@ func (m *RequestCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Snapshot {
+		i--
+		if m.Snapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -8077,6 +8097,9 @@ func (m *RequestCommit) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Snapshot {
+		n += 2
+	}
 	return n
 }
 
@@ -10603,6 +10626,26 @@ func (m *RequestCommit) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: RequestCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Snapshot = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,47 @@
+package blockrelay
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	brproto "github.com/cometbft/cometbft/proto/tendermint/blockrelay"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// NOTE: keep up to date with brproto.Block
+	BlockMessagePrefixSize   = 4
+	BlockMessageFieldKeySize = 2
+	MaxMsgSize               = types.MaxBlockSizeBytes +
+		int(types.MaxCommitOverheadBytes+types.MaxCommitSigBytes*types.MaxVotesCount) +
+		BlockMessagePrefixSize +
+		BlockMessageFieldKeySize
+)
+
+// ValidateMsg validates a message.
+func ValidateMsg(pb proto.Message) error {
+	if pb == nil {
+		return errors.New("message cannot be nil")
+	}
+
+	switch msg := pb.(type) {
+	case *brproto.Subscribe:
+		if msg.Height < 0 {
+			return fmt.Errorf("negative height %d", msg.Height)
+		}
+	case *brproto.Unsubscribe:
+		return nil
+	case *brproto.Block:
+		if msg.Block == nil {
+			return errors.New("missing block")
+		}
+		if (msg.Commit == nil) == (msg.ExtCommit == nil) {
+			return errors.New("exactly one of commit and extended commit must be set")
+		}
+	default:
+		return fmt.Errorf("unknown message type %T", msg)
+	}
+	return nil
+}
@@ -0,0 +1,523 @@
+package blockrelay
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/libs/protoio"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+	brproto "github.com/cometbft/cometbft/proto/tendermint/blockrelay"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// BlockRelayChannel is a channel for relaying committed blocks to the
+	// subscribed peers.
+	BlockRelayChannel = byte(0x41)
+
+	// maximum number of stored blocks sent in response to a subscription
+	maxCatchUpBlocks = 100
+	// maximum number of relayed blocks buffered ahead of the next height
+	maxPendingBlocks = 100
+	// maximum number of distinct blocks buffered for a height, until one of
+	// them is verified
+	maxBlockCandidates = 4
+
+	subscriber = "BlockRelayReactor"
+)
+
+type consensusReactor interface {
+	// for when the node becomes a validator while following the chain
+	// through relayed blocks
+	SwitchToConsensus(state sm.State, skipWAL bool)
+}
+
+// relayedBlock is a block received from a peer, not verified yet.
+type relayedBlock struct {
+	block     *types.Block
+	commit    *types.Commit
+	extCommit *types.ExtendedCommit
+	peerID    p2p.ID
+}
+
+// Reactor relays the blocks committed by the node to the subscribed peers,
+// and lets a full node follow the chain through the blocks relayed by its
+// peers instead of taking part in the consensus gossip.
+type Reactor struct {
+	p2p.BaseReactor
+
+	// immutable
+	config    *cfg.BlockRelayConfig
+	blockExec *sm.BlockExecutor
+	store     *store.BlockStore
+	eventBus  *types.EventBus
+	localAddr crypto.Address
+
+	mtx         cmtsync.Mutex
+	subscribers map[p2p.ID]p2p.Peer
+	catchingUp  map[p2p.ID]bool // subscribers being sent stored blocks
+	following   bool
+	state       sm.State                  // last state applied while following
+	pending     map[int64][]*relayedBlock // candidates by height, distinct blocks
+	requested   map[p2p.ID]int64          // height of the last subscription sent to each peer
+	pendingCh   chan struct{}
+}
+
+// NewReactor returns a new reactor instance.
+func NewReactor(config *cfg.BlockRelayConfig, blockExec *sm.BlockExecutor, store *store.BlockStore,
+	localAddr crypto.Address,
+) *Reactor {
+	r := &Reactor{
+		config:      config,
+		blockExec:   blockExec,
+		store:       store,
+		localAddr:   localAddr,
+		subscribers: make(map[p2p.ID]p2p.Peer),
+		catchingUp:  make(map[p2p.ID]bool),
+		pending:     make(map[int64][]*relayedBlock),
+		requested:   make(map[p2p.ID]int64),
+		pendingCh:   make(chan struct{}, 1),
+	}
+	r.BaseReactor = *p2p.NewBaseReactor("BlockRelay", r)
+	return r
+}
+
+// SetEventBus sets the event bus the committed blocks are read from.
+func (r *Reactor) SetEventBus(b *types.EventBus) {
+	r.eventBus = b
+}
+
+// OnStart implements service.Service.
+func (r *Reactor) OnStart() error {
+	if !r.config.Serve {
+		return nil
+	}
+	sub, err := r.eventBus.Subscribe(context.Background(), subscriber, types.EventQueryNewBlock, maxPendingBlocks)
+	if err != nil {
+		return err
+	}
+	go r.relayRoutine(sub)
+	return nil
+}
+
+// OnStop implements service.Service.
+func (r *Reactor) OnStop() {
+	if r.config.Serve {
+		if err := r.eventBus.UnsubscribeAll(context.Background(), subscriber); err != nil {
+			r.Logger.Error("Error unsubscribing from the event bus", "err", err)
+		}
+	}
+}
+
+// GetChannels implements Reactor
+func (r *Reactor) GetChannels() []*p2p.ChannelDescriptor {
+	return []*p2p.ChannelDescriptor{
+		{
+			ID:                  BlockRelayChannel,
+			Priority:            5,
+			SendQueueCapacity:   100,
+			RecvBufferCapacity:  50 * 4096,
+			RecvMessageCapacity: MaxMsgSize,
+			MessageType:         &brproto.Message{},
+			Compression:         p2p.CompressionSnappy,
+			// a block holds as many transactions as fit in its size, each
+			// taking at least 2 bytes
+			DecodeLimits: protoio.DecodeLimits{MaxRepeated: MaxMsgSize / 2},
+		},
+	}
+}
+
+// AddPeer implements Reactor by subscribing to the peer if the node follows
+// the chain through relayed blocks.
+func (r *Reactor) AddPeer(peer p2p.Peer) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.following {
+		r.subscribeTo(peer, r.state.LastBlockHeight+1)
+	}
+}
+
+// RemovePeer implements Reactor.
+func (r *Reactor) RemovePeer(peer p2p.Peer, _ interface{}) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	delete(r.subscribers, peer.ID())
+	delete(r.catchingUp, peer.ID())
+	delete(r.requested, peer.ID())
+}
+
+// Receive implements Reactor by handling the subscriptions and the relayed
+// blocks.
+func (r *Reactor) Receive(e p2p.Envelope) {
+	if err := ValidateMsg(e.Message); err != nil {
+		r.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", e.Message, "err", err)
+		r.Switch.StopPeerForError(e.Src, err)
+		return
+	}
+
+	switch msg := e.Message.(type) {
+	case *brproto.Subscribe:
+		r.addSubscriber(e.Src, msg.Height)
+	case *brproto.Unsubscribe:
+		r.mtx.Lock()
+		delete(r.subscribers, e.Src.ID())
+		r.mtx.Unlock()
+	case *brproto.Block:
+		rb, err := relayedBlockFromProto(msg)
+		if err != nil {
+			r.Logger.Error("Peer sent us invalid block", "peer", e.Src, "err", err)
+			r.Switch.StopPeerForError(e.Src, err)
+			return
+		}
+		rb.peerID = e.Src.ID()
+		r.addPending(rb, e.Src)
+	default:
+		r.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+	}
+}
+
+// Subscribing returns true if the node is configured to follow the chain
+// through relayed blocks once block sync has caught up.
+func (r *Reactor) Subscribing() bool {
+	return r.config.Subscribe
+}
+
+// Following returns true if the node follows the chain through the blocks
+// relayed by its peers, having caught up with block sync.
+func (r *Reactor) Following() bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.following
+}
+
+// SwitchToBlockRelay is called by the block sync reactor once caught up, to
+// follow the chain through the blocks relayed by the peers from then on.
+func (r *Reactor) SwitchToBlockRelay(state sm.State) {
+	r.Logger.Info("SwitchToBlockRelay", "height", state.LastBlockHeight)
+
+	r.mtx.Lock()
+	r.following = true
+	r.state = state
+	for _, peer := range r.Switch.Peers().List() {
+		r.subscribeTo(peer, state.LastBlockHeight+1)
+	}
+	r.mtx.Unlock()
+
+	go r.followRoutine()
+}
+
+// addSubscriber registers a subscription from the peer and sends it the
+// stored blocks from the given height, if any. The stored blocks are not sent
+// again to a peer subscribing while they are being sent to it.
+func (r *Reactor) addSubscriber(peer p2p.Peer, height int64) {
+	if !r.config.Serve {
+		r.Logger.Debug("Ignoring subscription, not serving relayed blocks", "peer", peer)
+		return
+	}
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if _, ok := r.subscribers[peer.ID()]; !ok && len(r.subscribers) >= r.config.MaxSubscribers {
+		r.Logger.Info("Rejecting subscription, too many subscribers", "peer", peer)
+		return
+	}
+	r.subscribers[peer.ID()] = peer
+
+	if height > 0 && height <= r.store.Height() && !r.catchingUp[peer.ID()] {
+		r.catchingUp[peer.ID()] = true
+		go r.sendStoredBlocks(peer, height)
+	}
+}
+
+// sendStoredBlocks sends the peer a bounded number of stored blocks, from the
+// given height.
+func (r *Reactor) sendStoredBlocks(peer p2p.Peer, from int64) {
+	defer func() {
+		r.mtx.Lock()
+		delete(r.catchingUp, peer.ID())
+		r.mtx.Unlock()
+	}()
+	if base := r.store.Base(); from < base {
+		from = base
+	}
+	to := r.store.Height()
+	if to >= from+maxCatchUpBlocks {
+		to = from + maxCatchUpBlocks - 1
+	}
+	for height := from; height <= to; height++ {
+		block := r.store.LoadBlock(height)
+		if block == nil {
+			return
+		}
+		msg, err := r.blockMessage(block)
+		if err != nil {
+			r.Logger.Error("Failed to load stored block to relay", "height", height, "err", err)
+			return
+		}
+		if !peer.Send(p2p.Envelope{ChannelID: BlockRelayChannel, Message: msg}) {
+			return
+		}
+	}
+}
+
+// relayRoutine sends each committed block to the subscribers.
+func (r *Reactor) relayRoutine(sub types.Subscription) {
+	for {
+		select {
+		case <-r.Quit():
+			return
+		case <-sub.Canceled():
+			return
+		case msg := <-sub.Out():
+			block := msg.Data().(types.EventDataNewBlock).Block
+			bm, err := r.blockMessage(block)
+			if err != nil {
+				r.Logger.Error("Failed to relay block", "height", block.Height, "err", err)
+				continue
+			}
+			r.mtx.Lock()
+			for _, peer := range r.subscribers {
+				// a subscriber missing the block asks for it again on the
+				// next one
+				if !peer.TrySend(p2p.Envelope{ChannelID: BlockRelayChannel, Message: bm}) {
+					r.Logger.Debug("Send queue is full, drop relayed block", "peer", peer.ID(), "height", block.Height)
+				}
+			}
+			r.mtx.Unlock()
+		}
+	}
+}
+
+// blockMessage returns the message relaying the block, along with the commit
+// stored for it.
+func (r *Reactor) blockMessage(block *types.Block) (*brproto.Block, error) {
+	pb, err := block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	if extCommit := r.store.LoadBlockExtendedCommit(block.Height); extCommit != nil {
+		return &brproto.Block{Block: pb, ExtCommit: extCommit.ToProto()}, nil
+	}
+	commit := r.store.LoadSeenCommit(block.Height)
+	if commit == nil {
+		commit = r.store.LoadBlockCommit(block.Height)
+	}
+	if commit == nil {
+		return nil, fmt.Errorf("no commit stored for height %d", block.Height)
+	}
+	return &brproto.Block{Block: pb, Commit: commit.ToProto()}, nil
+}
+
+func relayedBlockFromProto(msg *brproto.Block) (*relayedBlock, error) {
+	block, err := types.BlockFromProto(msg.Block)
+	if err != nil {
+		return nil, err
+	}
+	rb := &relayedBlock{block: block}
+	if msg.ExtCommit != nil {
+		rb.extCommit, err = types.ExtendedCommitFromProto(msg.ExtCommit)
+		if err != nil {
+			return nil, err
+		}
+		rb.commit = rb.extCommit.ToCommit()
+	} else {
+		rb.commit, err = types.CommitFromProto(msg.Commit)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if rb.commit.Height != block.Height {
+		return nil, fmt.Errorf("commit for height %d relayed with block %d", rb.commit.Height, block.Height)
+	}
+	return rb, nil
+}
+
+// addPending buffers a relayed block until its height is the next one to
+// apply. The distinct blocks relayed for a height are buffered until one of
+// them is verified, a peer relaying a single block per height. If the next
+// block is missing, the subscription to the peer is restarted from its
+// height.
+func (r *Reactor) addPending(rb *relayedBlock, src p2p.Peer) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if !r.following {
+		return
+	}
+	next := r.state.LastBlockHeight + 1
+	height := rb.block.Height
+	if height < next || height >= next+maxPendingBlocks {
+		return
+	}
+	r.addCandidate(rb)
+	if len(r.pending[next]) == 0 {
+		if r.requested[src.ID()] != next {
+			r.subscribeTo(src, next)
+		}
+		return
+	}
+	select {
+	case r.pendingCh <- struct{}{}:
+	default:
+	}
+}
+
+// addCandidate buffers rb among the candidates for its height, unless the
+// same block or another block from the same peer is buffered, or there are
+// enough candidates.
+// Must be called with r.mtx held.
+func (r *Reactor) addCandidate(rb *relayedBlock) {
+	candidates := r.pending[rb.block.Height]
+	if len(candidates) >= maxBlockCandidates {
+		return
+	}
+	hash := rb.block.Hash()
+	for _, c := range candidates {
+		if c.peerID == rb.peerID || bytes.Equal(c.block.Hash(), hash) {
+			return
+		}
+	}
+	r.pending[rb.block.Height] = append(candidates, rb)
+}
+
+// subscribeTo subscribes to the blocks relayed by the peer from the given
+// height. It's fine if the peer doesn't have the channel. The subscription is
+// dropped rather than waited for if the send queue of the peer is full, so
+// that a slow peer doesn't hold up the reactor.
+// Must be called with r.mtx held.
+func (r *Reactor) subscribeTo(peer p2p.Peer, height int64) {
+	if peer.TrySend(p2p.Envelope{ChannelID: BlockRelayChannel, Message: &brproto.Subscribe{Height: height}}) {
+		r.requested[peer.ID()] = height
+	}
+}
+
+// followRoutine applies the relayed blocks in order, until the node becomes a
+// validator.
+func (r *Reactor) followRoutine() {
+	for {
+		select {
+		case <-r.Quit():
+			return
+		case <-r.pendingCh:
+		}
+		for {
+			r.mtx.Lock()
+			state := r.state
+			rb, ok := r.nextCandidate(state.LastBlockHeight + 1)
+			r.mtx.Unlock()
+			if !ok || !r.IsRunning() {
+				break
+			}
+
+			state, err := r.applyBlock(state, rb)
+			if err != nil {
+				r.Logger.Error("Error in validation", "height", rb.block.Height, "peer", rb.peerID, "err", err)
+				r.mtx.Lock()
+				// the other candidates for the height are tried next, if
+				// any, while the block is requested from the other peers
+				if len(r.pending[rb.block.Height]) == 0 {
+					for _, peer := range r.Switch.Peers().List() {
+						if peer.ID() != rb.peerID {
+							r.subscribeTo(peer, rb.block.Height)
+						}
+					}
+				}
+				r.mtx.Unlock()
+				if peer := r.Switch.Peers().Get(rb.peerID); peer != nil {
+					r.Switch.StopPeerForError(peer, err)
+				}
+				continue
+			}
+
+			r.mtx.Lock()
+			delete(r.pending, state.LastBlockHeight)
+			r.state = state
+			if state.Validators.HasAddress(r.localAddr) {
+				r.stopFollowing()
+				r.mtx.Unlock()
+				r.Logger.Info("Became a validator, time to switch to consensus reactor!", "height", state.LastBlockHeight)
+				if conR, ok := r.Switch.Reactor("CONSENSUS").(consensusReactor); ok {
+					conR.SwitchToConsensus(state, true)
+				}
+				return
+			}
+			r.mtx.Unlock()
+		}
+	}
+}
+
+// nextCandidate removes and returns the first candidate buffered for the
+// height, if any.
+// Must be called with r.mtx held.
+func (r *Reactor) nextCandidate(height int64) (*relayedBlock, bool) {
+	candidates := r.pending[height]
+	if len(candidates) == 0 {
+		delete(r.pending, height)
+		return nil, false
+	}
+	r.pending[height] = candidates[1:]
+	return candidates[0], true
+}
+
+// stopFollowing unsubscribes from all the peers.
+// Must be called with r.mtx held.
+func (r *Reactor) stopFollowing() {
+	r.following = false
+	r.pending = make(map[int64][]*relayedBlock)
+	for id := range r.requested {
+		if peer := r.Switch.Peers().Get(id); peer != nil {
+			peer.TrySend(p2p.Envelope{ChannelID: BlockRelayChannel, Message: &brproto.Unsubscribe{}})
+		}
+	}
+	r.requested = make(map[p2p.ID]int64)
+}
+
+// applyBlock verifies a relayed block against the state, then stores and
+// applies it.
+func (r *Reactor) applyBlock(state sm.State, rb *relayedBlock) (sm.State, error) {
+	block := rb.block
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	if err != nil {
+		return state, err
+	}
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+	if err := state.Validators.VerifyCommitLight(state.ChainID, blockID, block.Height, rb.commit); err != nil {
+		return state, err
+	}
+	if err := r.blockExec.ValidateBlock(state, block); err != nil {
+		return state, err
+	}
+	// As with block sync, the application checks the `Data` of the block,
+	// which celestia-core can't validate.
+	valid, err := r.blockExec.ProcessProposal(block, state)
+	if !valid {
+		return state, fmt.Errorf("application has rejected relayed block (%X) at height %d, %w", block.Hash(), block.Height, err)
+	}
+
+	extensionsEnabled := state.ConsensusParams.ABCI.VoteExtensionsEnabled(block.Height)
+	if (rb.extCommit != nil) != extensionsEnabled {
+		return state, fmt.Errorf("extended commit must be relayed iff vote extensions are enabled for its height "+
+			"(height %d, extended commit %t, extensions enabled %t)",
+			block.Height, rb.extCommit != nil, extensionsEnabled)
+	}
+	if extensionsEnabled {
+		if err := rb.extCommit.EnsureExtensions(true); err != nil {
+			return state, err
+		}
+		r.store.SaveBlockWithExtendedCommit(block, parts, rb.extCommit)
+	} else {
+		r.store.SaveBlock(block, parts, rb.commit)
+	}
+
+	state, err = r.blockExec.ApplyVerifiedBlock(state, blockID, block, rb.commit)
+	if err != nil {
+		// the block was verified, so the node can't recover from this
+		panic(fmt.Sprintf("Failed to process relayed block (%d:%X): %v", block.Height, block.Hash(), err))
+	}
+	return state, nil
+}
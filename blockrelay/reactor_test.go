@@ -0,0 +1,275 @@
+package blockrelay
+
+import (
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	mpmocks "github.com/cometbft/cometbft/mempool/mocks"
+	"github.com/cometbft/cometbft/p2p"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+type testNode struct {
+	reactor   *Reactor
+	state     sm.State
+	blockExec *sm.BlockExecutor
+	store     *store.BlockStore
+	eventBus  *types.EventBus
+	app       proxy.AppConns
+	privVal   types.PrivValidator
+	// the commit for the last block
+	seenCommit *types.Commit
+}
+
+func newTestNode(t *testing.T, config *cfg.BlockRelayConfig, genDoc *types.GenesisDoc,
+	privVal types.PrivValidator, localAddr crypto.Address,
+) *testNode {
+	app := proxy.NewAppConns(proxy.NewLocalClientCreator(abci.NewBaseApplication()), proxy.NopMetrics())
+	require.NoError(t, app.Start())
+	t.Cleanup(func() { require.NoError(t, app.Stop()) })
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() { require.NoError(t, eventBus.Stop()) })
+
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{DiscardABCIResponses: false})
+	state, err := stateStore.LoadFromDBOrGenesisDoc(genDoc)
+	require.NoError(t, err)
+	require.NoError(t, stateStore.Save(state))
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+	mp := &mpmocks.Mempool{}
+	mp.On("Lock").Return()
+	mp.On("Unlock").Return()
+	mp.On("FlushAppConn", mock.Anything).Return(nil)
+	mp.On("Update",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything).Return(nil)
+
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), app.Consensus(),
+		mp, sm.EmptyEvidencePool{}, blockStore)
+	blockExec.SetEventBus(eventBus)
+
+	reactor := NewReactor(config, blockExec, blockStore, localAddr)
+	reactor.SetEventBus(eventBus)
+	reactor.SetLogger(log.TestingLogger())
+
+	return &testNode{
+		reactor:    reactor,
+		state:      state,
+		blockExec:  blockExec,
+		store:      blockStore,
+		eventBus:   eventBus,
+		app:        app,
+		privVal:    privVal,
+		seenCommit: &types.Commit{},
+	}
+}
+
+// commitBlock commits the next block as a single validator would.
+func (n *testNode) commitBlock(t *testing.T) {
+	height := n.state.LastBlockHeight + 1
+	block := n.state.MakeBlock(height, types.MakeData([]types.Tx{}), n.seenCommit, nil, n.state.Validators.Proposer.Address)
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+
+	vote, err := types.MakeVote(n.privVal, block.ChainID, 0, height, 0, cmtproto.PrecommitType, blockID, time.Now())
+	require.NoError(t, err)
+	lastCommit := n.seenCommit
+	n.seenCommit = &types.Commit{
+		Height:     height,
+		BlockID:    blockID,
+		Signatures: []types.CommitSig{vote.CommitSig()},
+	}
+
+	n.store.SaveBlock(block, parts, n.seenCommit)
+	n.state, err = n.blockExec.ApplyBlock(n.state, blockID, block, lastCommit)
+	require.NoError(t, err)
+}
+
+func randGenesisDoc() (*types.GenesisDoc, types.PrivValidator) {
+	val, privVal := types.RandValidator(false, 30)
+	return &types.GenesisDoc{
+		GenesisTime:     cmttime.Now(),
+		ChainID:         test.DefaultTestChainID,
+		Validators:      []types.GenesisValidator{{PubKey: val.PubKey, Power: val.VotingPower}},
+		ConsensusParams: types.DefaultConsensusParams(),
+	}, privVal
+}
+
+type consensusReactorStub struct {
+	p2p.BaseReactor
+
+	mtx   sync.Mutex
+	state *sm.State
+}
+
+func (r *consensusReactorStub) SwitchToConsensus(state sm.State, _ bool) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.state = &state
+}
+
+func (r *consensusReactorStub) switchedAt() int64 {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.state == nil {
+		return 0
+	}
+	return r.state.LastBlockHeight
+}
+
+func connect(t *testing.T, nodes ...*testNode) []*p2p.Switch {
+	config := test.ResetTestRoot("blockrelay_reactor_test")
+	t.Cleanup(func() { os.RemoveAll(config.RootDir) })
+
+	switches := p2p.MakeConnectedSwitches(config.P2P, len(nodes), func(i int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("BLOCKRELAY", nodes[i].reactor)
+		return s
+	}, p2p.Connect2Switches)
+	t.Cleanup(func() {
+		for _, s := range switches {
+			require.NoError(t, s.Stop())
+		}
+	})
+	return switches
+}
+
+func TestReactorRelaysBlocks(t *testing.T) {
+	genDoc, privVal := randGenesisDoc()
+
+	server := newTestNode(t, &cfg.BlockRelayConfig{Serve: true, MaxSubscribers: 1}, genDoc, privVal, nil)
+	follower := newTestNode(t, &cfg.BlockRelayConfig{Subscribe: true}, genDoc, nil, nil)
+
+	// the follower catches up with the stored blocks on subscription
+	const storedBlocks = 10
+	for i := 0; i < storedBlocks; i++ {
+		server.commitBlock(t)
+	}
+	connect(t, server, follower)
+	follower.reactor.SwitchToBlockRelay(follower.state)
+	require.Eventually(t, func() bool {
+		return follower.store.Height() == storedBlocks
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// then receives each block committed by the server
+	for i := 0; i < 3; i++ {
+		server.commitBlock(t)
+	}
+	require.Eventually(t, func() bool {
+		return follower.store.Height() == storedBlocks+3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	state, err := follower.blockExec.Store().Load()
+	require.NoError(t, err)
+	assert.Equal(t, server.state.AppHash, state.AppHash)
+	assert.Equal(t, server.store.LoadBlock(storedBlocks+3).Hash(), follower.store.LoadBlock(storedBlocks+3).Hash())
+}
+
+func TestReactorLimitsSubscribers(t *testing.T) {
+	genDoc, privVal := randGenesisDoc()
+
+	server := newTestNode(t, &cfg.BlockRelayConfig{Serve: true, MaxSubscribers: 1}, genDoc, privVal, nil)
+	followers := []*testNode{
+		newTestNode(t, &cfg.BlockRelayConfig{Subscribe: true}, genDoc, nil, nil),
+		newTestNode(t, &cfg.BlockRelayConfig{Subscribe: true}, genDoc, nil, nil),
+	}
+	connect(t, server, followers[0], followers[1])
+	for _, f := range followers {
+		f.reactor.SwitchToBlockRelay(f.state)
+	}
+	require.Eventually(t, func() bool {
+		server.reactor.mtx.Lock()
+		defer server.reactor.mtx.Unlock()
+		return len(server.reactor.subscribers) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	server.commitBlock(t)
+	require.Eventually(t, func() bool {
+		return followers[0].store.Height()+followers[1].store.Height() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestReactorSwitchesToConsensusAsValidator(t *testing.T) {
+	genDoc, privVal := randGenesisDoc()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	server := newTestNode(t, &cfg.BlockRelayConfig{Serve: true, MaxSubscribers: 1}, genDoc, privVal, nil)
+	follower := newTestNode(t, &cfg.BlockRelayConfig{Subscribe: true}, genDoc, nil, pubKey.Address())
+	server.commitBlock(t)
+
+	conR := &consensusReactorStub{}
+	conR.BaseReactor = *p2p.NewBaseReactor("Consensus", conR)
+	switches := connect(t, server, follower)
+	switches[1].AddReactor("CONSENSUS", conR)
+
+	follower.reactor.SwitchToBlockRelay(follower.state)
+	require.Eventually(t, func() bool {
+		return conR.switchedAt() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the relay stops once the node takes part in consensus
+	require.Eventually(t, func() bool {
+		server.reactor.mtx.Lock()
+		defer server.reactor.mtx.Unlock()
+		return len(server.reactor.subscribers) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	server.commitBlock(t)
+	time.Sleep(100 * time.Millisecond)
+	assert.EqualValues(t, 1, follower.store.Height())
+}
+
+func TestReactorKeepsBlockCandidates(t *testing.T) {
+	genDoc, privVal := randGenesisDoc()
+	server := newTestNode(t, &cfg.BlockRelayConfig{Serve: true, MaxSubscribers: 1}, genDoc, privVal, nil)
+	server.commitBlock(t)
+	valid := &relayedBlock{block: server.store.LoadBlock(1), commit: server.seenCommit, peerID: "honest"}
+
+	forged := server.store.LoadBlock(1)
+	forged.AppHash = []byte("forged")
+	invalid := &relayedBlock{block: forged, commit: valid.commit, peerID: "forger"}
+
+	r := NewReactor(&cfg.BlockRelayConfig{Subscribe: true}, nil, nil, nil)
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	// the block relayed first is not the only one kept for its height
+	r.addCandidate(invalid)
+	r.addCandidate(&relayedBlock{block: forged, commit: valid.commit, peerID: "other"})
+	r.addCandidate(&relayedBlock{block: valid.block, commit: valid.commit, peerID: "forger"})
+	r.addCandidate(valid)
+	require.Len(t, r.pending[1], 2)
+
+	rb, ok := r.nextCandidate(1)
+	require.True(t, ok)
+	assert.Equal(t, invalid, rb)
+	rb, ok = r.nextCandidate(1)
+	require.True(t, ok)
+	assert.Equal(t, valid, rb)
+	_, ok = r.nextCandidate(1)
+	assert.False(t, ok)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/protoio"
 	"github.com/cometbft/cometbft/p2p"
 	bcproto "github.com/cometbft/cometbft/proto/tendermint/blocksync"
 	sm "github.com/cometbft/cometbft/state"
@@ -40,6 +41,13 @@ type consensusReactor interface {
 	SwitchToConsensus(state sm.State, skipWAL bool)
 }
 
+type blockRelayReactor interface {
+	// for when we switch from blocksync reactor and block sync to following
+	// the chain through relayed blocks
+	Subscribing() bool
+	SwitchToBlockRelay(state sm.State)
+}
+
 type peerError struct {
 	err    error
 	peerID p2p.ID
@@ -154,16 +162,18 @@ func (bcR *Reactor) SwitchToBlockSync(state sm.State) error {
 	bcR.initialState = state
 
 	bcR.pool.height = state.LastBlockHeight + 1
-	err := bcR.pool.Start()
-	if err != nil {
-		return err
-	}
-	bcR.poolRoutineWg.Add(1)
-	go func() {
-		defer bcR.poolRoutineWg.Done()
-		bcR.poolRoutine(true)
-	}()
-	return nil
+	var err error
+	bcR.WithProfileLabels(func() {
+		if err = bcR.pool.Start(); err != nil {
+			return
+		}
+		bcR.poolRoutineWg.Add(1)
+		go func() {
+			defer bcR.poolRoutineWg.Done()
+			bcR.poolRoutine(true)
+		}()
+	})
+	return err
 }
 
 // OnStop implements service.Service.
@@ -186,6 +196,9 @@ func (bcR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			RecvBufferCapacity:  50 * 4096,
 			RecvMessageCapacity: MaxMsgSize,
 			MessageType:         &bcproto.Message{},
+			// a block holds as many transactions as fit in its size, each
+			// taking at least 2 bytes
+			DecodeLimits: protoio.DecodeLimits{MaxRepeated: MaxMsgSize / 2},
 		},
 	}
 }
@@ -255,6 +268,7 @@ func (bcR *Reactor) respondToPeer(msg *bcproto.BlockRequest, src p2p.Peer) (queu
 func (bcR *Reactor) Receive(e p2p.Envelope) {
 	if err := ValidateMsg(e.Message); err != nil {
 		bcR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", e.Message, "err", err)
+		bcR.Switch.PeerScorer().RecordInvalidMessage(e.Src.ID(), err)
 		bcR.Switch.StopPeerForError(e.Src, err)
 		return
 	}
@@ -268,6 +282,7 @@ func (bcR *Reactor) Receive(e p2p.Envelope) {
 		bi, err := types.BlockFromProto(msg.Block)
 		if err != nil {
 			bcR.Logger.Error("Peer sent us invalid block", "peer", e.Src, "msg", e.Message, "err", err)
+			bcR.Switch.PeerScorer().RecordInvalidMessage(e.Src.ID(), err)
 			bcR.Switch.StopPeerForError(e.Src, err)
 			return
 		}
@@ -279,6 +294,7 @@ func (bcR *Reactor) Receive(e p2p.Envelope) {
 				bcR.Logger.Error("failed to convert extended commit from proto",
 					"peer", e.Src,
 					"err", err)
+				bcR.Switch.PeerScorer().RecordInvalidMessage(e.Src.ID(), err)
 				bcR.Switch.StopPeerForError(e.Src, err)
 				return
 			}
@@ -286,6 +302,8 @@ func (bcR *Reactor) Receive(e p2p.Envelope) {
 
 		if err := bcR.pool.AddBlock(e.Src.ID(), bi, extCommit, msg.Block.Size()); err != nil {
 			bcR.Logger.Error("failed to add block", "peer", e.Src, "err", err)
+		} else {
+			bcR.Switch.PeerScorer().RecordUsefulBlock(e.Src.ID())
 		}
 	case *bcproto.StatusRequest:
 		// Send peer our state.
@@ -429,6 +447,13 @@ FOR_LOOP:
 				if err := bcR.pool.Stop(); err != nil {
 					bcR.Logger.Error("Error stopping pool", "err", err)
 				}
+				// A full node subscribed to relayed blocks follows the chain
+				// through them rather than through consensus.
+				if relayR, ok := bcR.Switch.Reactor("BLOCKRELAY").(blockRelayReactor); ok &&
+					relayR.Subscribing() && !state.Validators.HasAddress(bcR.localAddr) {
+					relayR.SwitchToBlockRelay(state)
+					break FOR_LOOP
+				}
 				conR, ok := bcR.Switch.Reactor("CONSENSUS").(consensusReactor)
 				if ok {
 					conR.SwitchToConsensus(state, blocksSynced > 0 || stateSynced)
@@ -534,6 +559,7 @@ FOR_LOOP:
 			if err != nil {
 				bcR.Logger.Error("Error in validation", "err", err)
 				peerID := bcR.pool.RemovePeerAndRedoAllPeerRequests(first.Height)
+				bcR.Switch.PeerScorer().RecordInvalidMessage(peerID, err)
 				peer := bcR.Switch.Peers().Get(peerID)
 				if peer != nil {
 					// NOTE: we've already removed the peer's request, but we
@@ -541,6 +567,9 @@ FOR_LOOP:
 					bcR.Switch.StopPeerForError(peer, ErrReactorValidation{Err: err})
 				}
 				peerID2 := bcR.pool.RemovePeerAndRedoAllPeerRequests(second.Height)
+				if peerID2 != peerID {
+					bcR.Switch.PeerScorer().RecordInvalidMessage(peerID2, err)
+				}
 				peer2 := bcR.Switch.Peers().Get(peerID2)
 				if peer2 != nil && peer2 != peer {
 					// NOTE: we've already removed the peer's request, but we
@@ -573,6 +602,20 @@ FOR_LOOP:
 			bcR.metrics.recordBlockMetrics(first)
 			blocksSynced++
 
+			// The upgrade params halt the node after this block: stop
+			// syncing and let consensus halt, as it does when it commits
+			// the block itself.
+			if halt := bcR.blockExec.HaltHeight(); halt > 0 {
+				bcR.Logger.Info("Halted for upgrade; switching to consensus", "height", halt)
+				if err := bcR.pool.Stop(); err != nil {
+					bcR.Logger.Error("Error stopping pool", "err", err)
+				}
+				if conR, ok := bcR.Switch.Reactor("CONSENSUS").(consensusReactor); ok {
+					conR.SwitchToConsensus(state, true)
+				}
+				break FOR_LOOP
+			}
+
 			if blocksSynced%100 == 0 {
 				lastRate = 0.9*lastRate + 0.1*(100/time.Since(lastHundred).Seconds())
 				bcR.Logger.Info("Block Sync Rate", "height", bcR.pool.height,
@@ -245,6 +245,45 @@ func TestNoBlockResponse(t *testing.T) {
 // or without significant refactoring of the module.
 // Alternatively we could actually dial a TCP conn but
 // that seems extreme.
+// block sync stops after the block at the halt height of the upgrade params
+func TestHaltForUpgrade(t *testing.T) {
+	config = test.ResetTestRoot("blocksync_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc(1, false, 30)
+
+	const maxBlockHeight, haltHeight = int64(30), int64(10)
+	// the upgrade params are not part of the hash of the consensus params
+	haltGenDoc := *genDoc
+	haltParams := *genDoc.ConsensusParams
+	haltParams.Upgrade.HaltHeight = haltHeight
+	haltGenDoc.ConsensusParams = &haltParams
+
+	reactorPairs := []ReactorPair{
+		newReactor(t, log.TestingLogger(), genDoc, privVals, maxBlockHeight),
+		newReactor(t, log.TestingLogger(), &haltGenDoc, privVals, 0),
+	}
+	p2p.MakeConnectedSwitches(config.P2P, 2, func(i int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("BLOCKSYNC", reactorPairs[i].reactor)
+		return s
+	}, p2p.Connect2Switches)
+	defer func() {
+		for _, r := range reactorPairs {
+			require.NoError(t, r.reactor.Stop())
+			require.NoError(t, r.app.Stop())
+		}
+	}()
+
+	r := reactorPairs[1].reactor
+	require.Eventually(t, func() bool {
+		return !r.pool.IsRunning()
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, haltHeight, r.blockExec.HaltHeight())
+	state, err := r.blockExec.Store().Load()
+	require.NoError(t, err)
+	assert.Equal(t, haltHeight, state.LastBlockHeight)
+	assert.Equal(t, haltHeight, r.store.Height())
+}
+
 func TestBadBlockStopsPeer(t *testing.T) {
 	config = test.ResetTestRoot("blocksync_reactor_test")
 	defer os.RemoveAll(config.RootDir)
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/types/testvectors"
+)
+
+var (
+	testVectorsChainID string
+	testVectorsHeight  int64
+	testVectorsOutput  string
+)
+
+// GenTestVectorsCmd generates the canonical encodings of the consensus-critical
+// data structures for client library authors to test against.
+var GenTestVectorsCmd = &cobra.Command{
+	Use:     "gen-test-vectors",
+	Aliases: []string{"gen_test_vectors"},
+	Short:   "Generate canonical JSON and protobuf test vectors of headers, votes, proposals and evidence",
+	Long: `Generate the canonical JSON encoding, protobuf encoding, sign bytes and
+hashes of headers, votes, proposals, commits and evidence for the given chain ID
+and height. The structures are signed by deterministic validator keys, so the
+output only depends on the chain ID and the height.`,
+	RunE: genTestVectors,
+}
+
+func init() {
+	GenTestVectorsCmd.Flags().StringVar(&testVectorsChainID, "chain-id", "test-chain", "chain ID of the generated structures")
+	GenTestVectorsCmd.Flags().Int64Var(&testVectorsHeight, "height", 2, "height of the generated structures")
+	GenTestVectorsCmd.Flags().StringVarP(&testVectorsOutput, "output", "o", "", "file to write the vectors to (default: stdout)")
+}
+
+func genTestVectors(*cobra.Command, []string) error {
+	vectors, err := testvectors.Generate(testVectorsChainID, testVectorsHeight)
+	if err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(vectors, "", "  ")
+	if err != nil {
+		return err
+	}
+	if testVectorsOutput == "" {
+		fmt.Println(string(bz))
+		return nil
+	}
+	return os.WriteFile(testVectorsOutput, append(bz, '\n'), 0o644)
+}
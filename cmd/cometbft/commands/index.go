@@ -0,0 +1,365 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+// maxIndexRecordSize is the size of the longest line of an index export read
+// on import.
+const maxIndexRecordSize = 512 * 1024 * 1024
+
+var indexFile string
+
+// IndexCmd exports, imports and verifies the tx and block index.
+var IndexCmd = &cobra.Command{
+	Use:   "index",
+	Short: "Export, import and verify the tx and block index",
+	Long: `
+index is an offline tooling to move the tx and block index between nodes and
+to check it against the blockstore, without re-indexing from the ABCI responses.
+
+An export holds, for each height, the block events and the results of the
+transactions of the block, one JSON object per line. It can be imported into
+any event sink configured in the tx-index section of the config.toml, e.g. to
+seed the index of a node which discarded its ABCI responses.
+
+The default start-height is 0, meaning the base block height of the blockstore
+(inclusive); the default end-height is 0, meaning the latest block height
+(inclusive).
+`,
+}
+
+var indexExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the tx and block index of a height range",
+	Long: `
+export writes the indexed transaction results and the block events of a height
+range. The block events are read from the ABCI responses, as the block index
+only stores the keys to search them: do not set DiscardABCIResponses to true if
+you want to use this command.
+`,
+	Example: `
+	cometbft index export --output index.jsonl
+	cometbft index export --start-height 2 --end-height 10 --output index.jsonl
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		riArgs, chainID, err := loadIndexArgs(true)
+		if err != nil {
+			return err
+		}
+		out := cmd.OutOrStdout()
+		if indexFile != "" {
+			f, err := os.Create(indexFile)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+		w := bufio.NewWriter(out)
+		if err := exportIndex(cmd.Context(), w, chainID, riArgs); err != nil {
+			return err
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "exported the index of heights %d to %d\n", riArgs.startHeight, riArgs.endHeight)
+		return nil
+	},
+}
+
+var indexImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import an index export into the event sink",
+	Example: `
+	cometbft index import --input index.jsonl
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		riArgs, chainID, err := loadIndexArgs(false)
+		if err != nil {
+			return err
+		}
+		in := cmd.InOrStdin()
+		if indexFile != "" {
+			f, err := os.Open(indexFile)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			in = f
+		}
+		n, err := importIndex(cmd.Context(), in, chainID, riArgs)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "imported the index of %d heights\n", n)
+		return nil
+	},
+}
+
+var indexVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Check that the blocks of a height range are indexed",
+	Long: `
+verify checks that each height of the range is in the block index and that each
+transaction of the blocks is in the tx index, at the height and position it has
+in the blockstore. Inconsistent heights can be fixed by importing an export of a
+consistent node.
+
+Note: the tx index only keeps one location per transaction hash, so a
+transaction included in several blocks is reported at all but one of them.
+`,
+	Example: `
+	cometbft index verify --start-height 2 --end-height 10
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		riArgs, _, err := loadIndexArgs(true)
+		if err != nil {
+			return err
+		}
+		problems, err := verifyIndex(cmd.Context(), riArgs)
+		if err != nil {
+			return err
+		}
+		for _, problem := range problems {
+			fmt.Fprintln(cmd.OutOrStdout(), problem)
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("found %d inconsistencies in the index of heights %d to %d",
+				len(problems), riArgs.startHeight, riArgs.endHeight)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "the index of heights %d to %d is consistent with the blockstore\n",
+			riArgs.startHeight, riArgs.endHeight)
+		return nil
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{indexExportCmd, indexVerifyCmd} {
+		cmd.Flags().Int64Var(&startHeight, "start-height", 0, "the first block height of the range")
+		cmd.Flags().Int64Var(&endHeight, "end-height", 0, "the last block height of the range")
+	}
+	indexExportCmd.Flags().StringVar(&indexFile, "output", "", "file to write the export to (default: standard output)")
+	indexImportCmd.Flags().StringVar(&indexFile, "input", "", "file to read the export from (default: standard input)")
+
+	IndexCmd.AddCommand(indexExportCmd)
+	IndexCmd.AddCommand(indexImportCmd)
+	IndexCmd.AddCommand(indexVerifyCmd)
+}
+
+// loadIndexArgs opens the stores and the event sinks of the node, checking the
+// requested height range against the blockstore if checkHeights is set.
+func loadIndexArgs(checkHeights bool) (eventReIndexArgs, string, error) {
+	bs, ss, err := loadStateAndBlockStore(config)
+	if err != nil {
+		return eventReIndexArgs{}, "", err
+	}
+	state, err := ss.Load()
+	if err != nil {
+		return eventReIndexArgs{}, "", err
+	}
+	if checkHeights {
+		if err := checkValidHeight(bs); err != nil {
+			return eventReIndexArgs{}, "", err
+		}
+	}
+	bi, ti, err := loadEventSinks(config, state.ChainID)
+	if err != nil {
+		return eventReIndexArgs{}, "", err
+	}
+	return eventReIndexArgs{
+		startHeight:  startHeight,
+		endHeight:    endHeight,
+		blockIndexer: bi,
+		txIndexer:    ti,
+		blockStore:   bs,
+		stateStore:   ss,
+	}, state.ChainID, nil
+}
+
+// indexExportHeader is the first line of an index export.
+type indexExportHeader struct {
+	ChainID     string `json:"chain_id"`
+	StartHeight int64  `json:"start_height"`
+	EndHeight   int64  `json:"end_height"`
+}
+
+// indexExportRecord is what is indexed for a height. It follows the header, in
+// increasing height order.
+type indexExportRecord struct {
+	Height    int64                 `json:"height"`
+	Events    []abcitypes.Event     `json:"events"`
+	TxResults []*abcitypes.TxResult `json:"tx_results"`
+}
+
+func writeIndexLine(w io.Writer, v any) error {
+	bz, err := cmtjson.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(bz, '\n'))
+	return err
+}
+
+func exportIndex(ctx context.Context, w io.Writer, chainID string, args eventReIndexArgs) error {
+	header := indexExportHeader{ChainID: chainID, StartHeight: args.startHeight, EndHeight: args.endHeight}
+	if err := writeIndexLine(w, header); err != nil {
+		return err
+	}
+	for height := args.startHeight; height <= args.endHeight; height++ {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("index export terminated at height %d: %w", height, err)
+		}
+		block := args.blockStore.LoadBlock(height)
+		if block == nil {
+			return fmt.Errorf("not able to load block at height %d from the blockstore", height)
+		}
+		resp, err := args.stateStore.LoadFinalizeBlockResponse(height)
+		if err != nil {
+			return fmt.Errorf("not able to load the block events at height %d from the statestore: %w", height, err)
+		}
+
+		record := indexExportRecord{
+			Height:    height,
+			Events:    resp.Events,
+			TxResults: make([]*abcitypes.TxResult, len(block.Txs)),
+		}
+		for idx, tx := range block.Txs {
+			txResult, err := args.txIndexer.Get(tx.Hash())
+			if err != nil {
+				return fmt.Errorf("not able to load tx %X from the tx index: %w", tx.Hash(), err)
+			}
+			if txResult == nil {
+				return fmt.Errorf("tx %X at height %d is not indexed, run verify and fix the index first",
+					tx.Hash(), height)
+			}
+			// the tx index holds the last location of a tx included more than
+			// once, the export holds the result at each location
+			if txResult.Height != height || txResult.Index != uint32(idx) {
+				if idx >= len(resp.TxResults) {
+					return fmt.Errorf("no result of tx %X at height %d in the statestore", tx.Hash(), height)
+				}
+				txResult = &abcitypes.TxResult{
+					Height: height,
+					Index:  uint32(idx),
+					Tx:     tx,
+					Result: *resp.TxResults[idx],
+				}
+			}
+			record.TxResults[idx] = txResult
+		}
+		if err := writeIndexLine(w, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importIndex indexes the records read from r and returns their number.
+func importIndex(ctx context.Context, r io.Reader, chainID string, args eventReIndexArgs) (int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxIndexRecordSize)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return 0, err
+		}
+		return 0, errors.New("empty index export")
+	}
+	var header indexExportHeader
+	if err := cmtjson.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return 0, fmt.Errorf("invalid index export header: %w", err)
+	}
+	if header.ChainID != chainID {
+		return 0, fmt.Errorf("index export of chain %q, expected %q", header.ChainID, chainID)
+	}
+
+	n := 0
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return n, fmt.Errorf("index import terminated after %d heights: %w", n, err)
+		}
+		var record indexExportRecord
+		if err := cmtjson.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return n, fmt.Errorf("invalid index export record: %w", err)
+		}
+		if record.Height < header.StartHeight || record.Height > header.EndHeight {
+			return n, fmt.Errorf("record of height %d out of the exported range %d to %d",
+				record.Height, header.StartHeight, header.EndHeight)
+		}
+
+		if len(record.TxResults) > 0 {
+			batch := txindex.NewBatch(int64(len(record.TxResults)))
+			for idx, txResult := range record.TxResults {
+				if txResult == nil || txResult.Height != record.Height || txResult.Index != uint32(idx) {
+					return n, fmt.Errorf("invalid tx result %d in the record of height %d", idx, record.Height)
+				}
+				if err := batch.Add(txResult); err != nil {
+					return n, fmt.Errorf("adding tx to batch: %w", err)
+				}
+			}
+			if err := args.txIndexer.AddBatch(batch); err != nil {
+				return n, fmt.Errorf("tx index import at height %d failed: %w", record.Height, err)
+			}
+		}
+		e := types.EventDataNewBlockEvents{
+			Height: record.Height,
+			Events: record.Events,
+			NumTxs: int64(len(record.TxResults)),
+		}
+		if err := args.blockIndexer.Index(e); err != nil {
+			return n, fmt.Errorf("block index import at height %d failed: %w", record.Height, err)
+		}
+		n++
+	}
+	return n, scanner.Err()
+}
+
+// verifyIndex returns the inconsistencies found between the blockstore and the
+// index, one per missing height or misplaced transaction.
+func verifyIndex(ctx context.Context, args eventReIndexArgs) ([]string, error) {
+	var problems []string
+	for height := args.startHeight; height <= args.endHeight; height++ {
+		if err := ctx.Err(); err != nil {
+			return problems, fmt.Errorf("index verification terminated at height %d: %w", height, err)
+		}
+		block := args.blockStore.LoadBlock(height)
+		if block == nil {
+			return problems, fmt.Errorf("not able to load block at height %d from the blockstore", height)
+		}
+
+		ok, err := args.blockIndexer.Has(height)
+		if err != nil {
+			return problems, fmt.Errorf("not able to query the block index at height %d: %w", height, err)
+		}
+		if !ok {
+			problems = append(problems, fmt.Sprintf("height %d: block not indexed", height))
+		}
+
+		for idx, tx := range block.Txs {
+			txResult, err := args.txIndexer.Get(tx.Hash())
+			if err != nil {
+				return problems, fmt.Errorf("not able to query the tx index for tx %X: %w", tx.Hash(), err)
+			}
+			switch {
+			case txResult == nil:
+				problems = append(problems, fmt.Sprintf("height %d: tx %d (%X) not indexed", height, idx, tx.Hash()))
+			case txResult.Height != height || txResult.Index != uint32(idx):
+				problems = append(problems, fmt.Sprintf("height %d: tx %d (%X) indexed at height %d, index %d",
+					height, idx, tx.Hash(), txResult.Height, txResult.Index))
+			}
+		}
+	}
+	return problems, nil
+}
@@ -0,0 +1,141 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/test"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
+	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/state/txindex/kv"
+	"github.com/cometbft/cometbft/types"
+)
+
+// newIndexTestArgs returns the arguments of the index tooling for a chain of
+// blocks with a tx each from base to height, with empty kv event sinks.
+func newIndexTestArgs() eventReIndexArgs {
+	blockStore := &mocks.BlockStore{}
+	stateStore := &mocks.Store{}
+	for h := base; h <= height; h++ {
+		tx := types.Tx("tx" + string(rune('a'+h)))
+		blockStore.On("LoadBlock", h).Return(&types.Block{
+			Header: types.Header{Height: h},
+			Data:   types.Data{Txs: types.Txs{tx}},
+		})
+		stateStore.On("LoadFinalizeBlockResponse", h).Return(&abcitypes.ResponseFinalizeBlock{
+			Events: []abcitypes.Event{{
+				Type:       "account",
+				Attributes: []abcitypes.EventAttribute{{Key: "owner", Value: "Ivan", Index: true}},
+			}},
+			TxResults: []*abcitypes.ExecTxResult{{
+				Events: []abcitypes.Event{{
+					Type:       "transfer",
+					Attributes: []abcitypes.EventAttribute{{Key: "amount", Value: "10", Index: true}},
+				}},
+			}},
+		}, nil)
+	}
+
+	store := dbm.NewMemDB()
+	return eventReIndexArgs{
+		startHeight:  base,
+		endHeight:    height,
+		blockIndexer: blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events"))),
+		txIndexer:    kv.NewTxIndex(store),
+		blockStore:   blockStore,
+		stateStore:   stateStore,
+	}
+}
+
+func TestIndexExportImportVerify(t *testing.T) {
+	ctx := context.Background()
+	from := newIndexTestArgs()
+	require.NoError(t, eventReIndex(setupReIndexEventCmd(), from))
+	problems, err := verifyIndex(ctx, from)
+	require.NoError(t, err)
+	require.Empty(t, problems)
+
+	var export bytes.Buffer
+	require.NoError(t, exportIndex(ctx, &export, test.DefaultTestChainID, from))
+	assert.Len(t, strings.Split(strings.TrimSpace(export.String()), "\n"), int(height-base+2))
+
+	to := newIndexTestArgs()
+	problems, err = verifyIndex(ctx, to)
+	require.NoError(t, err)
+	assert.Len(t, problems, int(height-base+1)*2)
+
+	_, err = importIndex(ctx, strings.NewReader(export.String()), "other-chain", to)
+	require.Error(t, err)
+	n, err := importIndex(ctx, strings.NewReader(export.String()), test.DefaultTestChainID, to)
+	require.NoError(t, err)
+	assert.EqualValues(t, height-base+1, n)
+
+	problems, err = verifyIndex(ctx, to)
+	require.NoError(t, err)
+	require.Empty(t, problems)
+
+	// the imported events can be searched
+	txs, err := to.txIndexer.Search(ctx, query.MustCompile("transfer.amount = 10"))
+	require.NoError(t, err)
+	assert.Len(t, txs, int(height-base+1))
+	heights, err := to.blockIndexer.Search(ctx, query.MustCompile("account.owner = 'Ivan'"))
+	require.NoError(t, err)
+	assert.Len(t, heights, int(height-base+1))
+}
+
+func TestIndexVerifyMisplacedTx(t *testing.T) {
+	args := newIndexTestArgs()
+	require.NoError(t, eventReIndex(setupReIndexEventCmd(), args))
+
+	// index the tx of the base block again at another height
+	tx := args.blockStore.LoadBlock(base).Txs[0]
+	batch := txindex.NewBatch(1)
+	require.NoError(t, batch.Add(&abcitypes.TxResult{Height: height + 1, Index: 0, Tx: tx}))
+	require.NoError(t, args.txIndexer.AddBatch(batch))
+
+	problems, err := verifyIndex(context.Background(), args)
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.Contains(t, problems[0], "indexed at height 11")
+}
+
+func TestIndexExportTxIncludedTwice(t *testing.T) {
+	args := newIndexTestArgs()
+	require.NoError(t, eventReIndex(setupReIndexEventCmd(), args))
+
+	// index the tx of the base block again at another height, with another
+	// result
+	tx := args.blockStore.LoadBlock(base).Txs[0]
+	batch := txindex.NewBatch(1)
+	require.NoError(t, batch.Add(&abcitypes.TxResult{
+		Height: height + 1,
+		Index:  0,
+		Tx:     tx,
+		Result: abcitypes.ExecTxResult{Code: 5},
+	}))
+	require.NoError(t, args.txIndexer.AddBatch(batch))
+
+	var export bytes.Buffer
+	require.NoError(t, exportIndex(context.Background(), &export, test.DefaultTestChainID, args))
+	lines := strings.Split(strings.TrimSpace(export.String()), "\n")
+	var record indexExportRecord
+	require.NoError(t, cmtjson.Unmarshal([]byte(lines[1]), &record))
+	require.EqualValues(t, base, record.Height)
+	require.Len(t, record.TxResults, 1)
+	txResult := record.TxResults[0]
+	assert.EqualValues(t, base, txResult.Height)
+	assert.Zero(t, txResult.Index)
+	assert.Equal(t, abcitypes.CodeTypeOK, txResult.Result.Code)
+	assert.Len(t, txResult.Result.Events, 1)
+}
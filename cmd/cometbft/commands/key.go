@@ -0,0 +1,334 @@
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/argon2"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/armor"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/mnemonic"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/crypto/xsalsa20symmetric"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/libs/tempfile"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/privval"
+)
+
+const (
+	keyFormatMnemonic = "mnemonic"
+	keyFormatArchive  = "archive"
+
+	keyArchiveBlockType = "COMETBFT KEY BACKUP"
+	keyArchiveKDF       = "argon2id"
+	keyArchiveSaltSize  = 16
+
+	nodeKeyName          = "node_key"
+	privValidatorKeyName = "priv_validator_key"
+)
+
+var (
+	keyFormat         string
+	keyFile           string
+	keyPassphraseFile string
+	keyForce          bool
+)
+
+// KeyCmd backs up and restores the node and validator keys.
+var KeyCmd = &cobra.Command{
+	Use:   "key",
+	Short: "Back up and restore the node and validator keys",
+	Long: `
+key backs up the node key and the validator key of this node, and restores
+them on another one. The keys are serialized either as:
+
+  - mnemonic: a BIP39 mnemonic per key, on its own line after the name and
+    the type of the key. The checksum of each mnemonic catches typos. Only
+    ed25519 and secp256k1 keys can be backed up as mnemonics.
+  - archive: an ASCII armored archive of both keys, encrypted with a key
+    derived from the passphrase read from --passphrase-file. Decryption
+    fails if the archive was altered or the passphrase is wrong.
+
+Restoring the validator key keeps the existing validator state file, if any.
+Never run two nodes with the same validator key: this leads to double signing.
+`,
+}
+
+var keyBackupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Write a backup of the node and validator keys",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		passphrase, err := readKeyPassphrase()
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err := backupKeys(&buf, config.NodeKeyFile(), config.PrivValidatorKeyFile(), keyFormat, passphrase); err != nil {
+			return err
+		}
+		if keyFile == "" {
+			_, err = cmd.OutOrStdout().Write(buf.Bytes())
+			return err
+		}
+		return tempfile.WriteFileAtomic(keyFile, buf.Bytes(), 0o600)
+	},
+}
+
+var keyRestoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore the node and validator keys from a backup",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		passphrase, err := readKeyPassphrase()
+		if err != nil {
+			return err
+		}
+		in := cmd.InOrStdin()
+		if keyFile != "" {
+			f, err := os.Open(keyFile)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			in = f
+		}
+		return restoreKeys(in, config.NodeKeyFile(), config.PrivValidatorKeyFile(), config.PrivValidatorStateFile(),
+			keyFormat, passphrase, keyForce)
+	},
+}
+
+func init() {
+	KeyCmd.PersistentFlags().StringVar(&keyFormat, "format", keyFormatArchive,
+		"serialization of the keys: \"mnemonic\" or \"archive\"")
+	KeyCmd.PersistentFlags().StringVar(&keyPassphraseFile, "passphrase-file", "",
+		"file holding the passphrase of the archive")
+	keyBackupCmd.Flags().StringVar(&keyFile, "output", "", "file to write the backup to (default: standard output)")
+	keyRestoreCmd.Flags().StringVar(&keyFile, "input", "", "file to read the backup from (default: standard input)")
+	keyRestoreCmd.Flags().BoolVar(&keyForce, "force", false, "overwrite the existing keys")
+
+	KeyCmd.AddCommand(keyBackupCmd)
+	KeyCmd.AddCommand(keyRestoreCmd)
+}
+
+func readKeyPassphrase() ([]byte, error) {
+	if keyFormat != keyFormatArchive {
+		return nil, nil
+	}
+	if keyPassphraseFile == "" {
+		return nil, errors.New("--passphrase-file is required for archives")
+	}
+	passphrase, err := os.ReadFile(keyPassphraseFile)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(passphrase, "\r\n"), nil
+}
+
+// keyBackup is the content of an archive.
+type keyBackup struct {
+	NodeKey          *p2p.NodeKey       `json:"node_key"`
+	PrivValidatorKey *privval.FilePVKey `json:"priv_validator_key"`
+}
+
+func backupKeys(w io.Writer, nodeKeyFile, pvKeyFile, format string, passphrase []byte) error {
+	nodeKey, err := p2p.LoadNodeKey(nodeKeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load node key: %w", err)
+	}
+	pvKeyJSON, err := os.ReadFile(pvKeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load validator key: %w", err)
+	}
+	pvKey := &privval.FilePVKey{}
+	if err := cmtjson.Unmarshal(pvKeyJSON, pvKey); err != nil {
+		return fmt.Errorf("failed to load validator key: %w", err)
+	}
+
+	switch format {
+	case keyFormatMnemonic:
+		for _, key := range []struct {
+			name    string
+			privKey crypto.PrivKey
+		}{
+			{nodeKeyName, nodeKey.PrivKey},
+			{privValidatorKeyName, pvKey.PrivKey},
+		} {
+			words, err := keyToMnemonic(key.privKey)
+			if err != nil {
+				return fmt.Errorf("%s: %w", key.name, err)
+			}
+			if _, err := fmt.Fprintf(w, "%s %s %s\n", key.name, key.privKey.Type(), words); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case keyFormatArchive:
+		if len(passphrase) == 0 {
+			return errors.New("empty passphrase")
+		}
+		plaintext, err := cmtjson.Marshal(keyBackup{NodeKey: nodeKey, PrivValidatorKey: pvKey})
+		if err != nil {
+			return err
+		}
+		salt := crypto.CRandBytes(keyArchiveSaltSize)
+		headers := map[string]string{
+			"kdf":  keyArchiveKDF,
+			"salt": fmt.Sprintf("%X", salt),
+		}
+		ciphertext := xsalsa20symmetric.EncryptSymmetric(plaintext, archiveSecret(passphrase, salt))
+		_, err = io.WriteString(w, armor.EncodeArmor(keyArchiveBlockType, headers, ciphertext))
+		return err
+
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func restoreKeys(r io.Reader, nodeKeyFile, pvKeyFile, pvStateFile, format string, passphrase []byte,
+	force bool,
+) error {
+	backup := keyBackup{}
+	switch format {
+	case keyFormatMnemonic:
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) == 0 {
+				continue
+			}
+			if len(fields) < 3 {
+				return fmt.Errorf("invalid line %q, expected the name and type of the key followed by its mnemonic", scanner.Text())
+			}
+			privKey, err := keyFromMnemonic(fields[1], strings.Join(fields[2:], " "))
+			if err != nil {
+				return fmt.Errorf("%s: %w", fields[0], err)
+			}
+			switch fields[0] {
+			case nodeKeyName:
+				backup.NodeKey = &p2p.NodeKey{PrivKey: privKey}
+			case privValidatorKeyName:
+				backup.PrivValidatorKey = &privval.FilePVKey{PrivKey: privKey}
+			default:
+				return fmt.Errorf("unknown key %q", fields[0])
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return err
+		}
+
+	case keyFormatArchive:
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		blockType, headers, ciphertext, err := armor.DecodeArmor(string(data))
+		if err != nil {
+			return fmt.Errorf("invalid archive: %w", err)
+		}
+		if blockType != keyArchiveBlockType {
+			return fmt.Errorf("invalid archive type %q", blockType)
+		}
+		if headers["kdf"] != keyArchiveKDF {
+			return fmt.Errorf("unsupported key derivation function %q", headers["kdf"])
+		}
+		salt, err := hex.DecodeString(headers["salt"])
+		if err != nil || len(salt) != keyArchiveSaltSize {
+			return errors.New("invalid archive salt")
+		}
+		plaintext, err := xsalsa20symmetric.DecryptSymmetric(ciphertext, archiveSecret(passphrase, salt))
+		if err != nil {
+			return errors.New("failed to decrypt archive: wrong passphrase or corrupted archive")
+		}
+		if err := cmtjson.Unmarshal(plaintext, &backup); err != nil {
+			return fmt.Errorf("invalid archive: %w", err)
+		}
+		if pvKey := backup.PrivValidatorKey; pvKey != nil && (!pvKey.PubKey.Equals(pvKey.PrivKey.PubKey()) ||
+			!bytes.Equal(pvKey.Address, pvKey.PubKey.Address())) {
+			return errors.New("invalid archive: inconsistent validator key")
+		}
+
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+
+	if backup.NodeKey == nil && backup.PrivValidatorKey == nil {
+		return errors.New("no key found in the backup")
+	}
+	if !force {
+		for _, file := range []string{nodeKeyFile, pvKeyFile} {
+			if cmtos.FileExists(file) {
+				return fmt.Errorf("key at %s already exists, use --force to overwrite it", file)
+			}
+		}
+	}
+
+	if backup.NodeKey != nil {
+		if err := cmtos.EnsureDir(filepath.Dir(nodeKeyFile), 0o700); err != nil {
+			return err
+		}
+		if err := backup.NodeKey.SaveAs(nodeKeyFile); err != nil {
+			return err
+		}
+		logger.Info("Restored node key", "path", nodeKeyFile, "id", backup.NodeKey.ID())
+	}
+	if backup.PrivValidatorKey != nil {
+		for _, file := range []string{pvKeyFile, pvStateFile} {
+			if err := cmtos.EnsureDir(filepath.Dir(file), 0o700); err != nil {
+				return err
+			}
+		}
+		pv := privval.NewFilePV(backup.PrivValidatorKey.PrivKey, pvKeyFile, pvStateFile)
+		pv.Key.Save()
+		if !cmtos.FileExists(pvStateFile) {
+			pv.LastSignState.Save()
+		}
+		logger.Info("Restored validator key", "path", pvKeyFile, "address", pv.Key.Address)
+	}
+	return nil
+}
+
+// archiveSecret derives the secret encrypting an archive from the passphrase.
+func archiveSecret(passphrase, salt []byte) []byte {
+	return argon2.IDKey(passphrase, salt, 3, 64*1024, 4, 32)
+}
+
+func keyToMnemonic(privKey crypto.PrivKey) (string, error) {
+	switch k := privKey.(type) {
+	case ed25519.PrivKey:
+		return mnemonic.FromEntropy(k.Seed())
+	case secp256k1.PrivKey:
+		return mnemonic.FromEntropy(k.Bytes())
+	default:
+		return "", fmt.Errorf("%s keys can't be backed up as mnemonics, use an archive", privKey.Type())
+	}
+}
+
+func keyFromMnemonic(keyType, words string) (crypto.PrivKey, error) {
+	entropy, err := mnemonic.ToEntropy(words)
+	if err != nil {
+		return nil, err
+	}
+	switch keyType {
+	case ed25519.KeyType:
+		return ed25519.GenPrivKeyFromSeed(entropy)
+	case secp256k1.KeyType:
+		if len(entropy) != secp256k1.PrivKeySize {
+			return nil, fmt.Errorf("invalid secp256k1 key length %d", len(entropy))
+		}
+		return secp256k1.PrivKey(entropy), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", keyType)
+	}
+}
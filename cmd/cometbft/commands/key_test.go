@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/privval"
+)
+
+func newKeyTestConfig(t *testing.T, initFiles bool) *cfg.Config {
+	config := cfg.TestConfig()
+	dir := t.TempDir()
+	config.SetRoot(dir)
+	cfg.EnsureRoot(dir)
+	if initFiles {
+		require.NoError(t, initFilesWithConfig(config))
+	}
+	return config
+}
+
+func requireSameKeys(t *testing.T, expected, actual *cfg.Config) {
+	t.Helper()
+	expectedNodeKey, err := p2p.LoadNodeKey(expected.NodeKeyFile())
+	require.NoError(t, err)
+	actualNodeKey, err := p2p.LoadNodeKey(actual.NodeKeyFile())
+	require.NoError(t, err)
+	require.Equal(t, expectedNodeKey.PrivKey, actualNodeKey.PrivKey)
+
+	expectedPV := privval.LoadFilePV(expected.PrivValidatorKeyFile(), expected.PrivValidatorStateFile())
+	actualPV := privval.LoadFilePV(actual.PrivValidatorKeyFile(), actual.PrivValidatorStateFile())
+	require.Equal(t, expectedPV.Key.PrivKey, actualPV.Key.PrivKey)
+	require.Equal(t, expectedPV.Key.Address, actualPV.Key.Address)
+}
+
+func TestKeyBackupRestoreMnemonic(t *testing.T) {
+	from := newKeyTestConfig(t, true)
+
+	var backup bytes.Buffer
+	require.NoError(t, backupKeys(&backup, from.NodeKeyFile(), from.PrivValidatorKeyFile(), keyFormatMnemonic, nil))
+	lines := strings.Split(strings.TrimSpace(backup.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.True(t, strings.HasPrefix(lines[0], "node_key ed25519 "))
+	assert.Len(t, strings.Fields(lines[0]), 26)
+	assert.True(t, strings.HasPrefix(lines[1], "priv_validator_key ed25519 "))
+
+	to := newKeyTestConfig(t, false)
+	require.NoError(t, restoreKeys(strings.NewReader(backup.String()), to.NodeKeyFile(),
+		to.PrivValidatorKeyFile(), to.PrivValidatorStateFile(), keyFormatMnemonic, nil, false))
+	requireSameKeys(t, from, to)
+
+	// a misspelled word is rejected
+	words := strings.Fields(lines[0])
+	typo := strings.Replace(backup.String(), " "+words[5]+" ", " "+words[5]+"x ", 1)
+	require.Error(t, restoreKeys(strings.NewReader(typo), to.NodeKeyFile(),
+		to.PrivValidatorKeyFile(), to.PrivValidatorStateFile(), keyFormatMnemonic, nil, true))
+}
+
+func TestKeyBackupRestoreMnemonicSecp256k1(t *testing.T) {
+	from := newKeyTestConfig(t, true)
+	pv := privval.NewFilePV(secp256k1.GenPrivKey(), from.PrivValidatorKeyFile(), from.PrivValidatorStateFile())
+	pv.Save()
+
+	var backup bytes.Buffer
+	require.NoError(t, backupKeys(&backup, from.NodeKeyFile(), from.PrivValidatorKeyFile(), keyFormatMnemonic, nil))
+	assert.Contains(t, backup.String(), "priv_validator_key secp256k1 ")
+
+	to := newKeyTestConfig(t, false)
+	require.NoError(t, restoreKeys(&backup, to.NodeKeyFile(),
+		to.PrivValidatorKeyFile(), to.PrivValidatorStateFile(), keyFormatMnemonic, nil, false))
+	requireSameKeys(t, from, to)
+}
+
+func TestKeyBackupRestoreArchive(t *testing.T) {
+	from := newKeyTestConfig(t, true)
+	passphrase := []byte("correct horse battery staple")
+
+	var backup bytes.Buffer
+	require.NoError(t, backupKeys(&backup, from.NodeKeyFile(), from.PrivValidatorKeyFile(), keyFormatArchive, passphrase))
+	assert.Contains(t, backup.String(), "BEGIN COMETBFT KEY BACKUP")
+	require.Error(t, backupKeys(&bytes.Buffer{}, from.NodeKeyFile(), from.PrivValidatorKeyFile(), keyFormatArchive, nil))
+
+	to := newKeyTestConfig(t, false)
+	restore := func(archive string, passphrase []byte, force bool) error {
+		return restoreKeys(strings.NewReader(archive), to.NodeKeyFile(),
+			to.PrivValidatorKeyFile(), to.PrivValidatorStateFile(), keyFormatArchive, passphrase, force)
+	}
+	require.Error(t, restore(backup.String(), []byte("wrong"), false))
+
+	// alter a byte of the armored ciphertext
+	lines := strings.Split(backup.String(), "\n")
+	for i, line := range lines {
+		if i > 0 && len(line) > 10 && !strings.Contains(line, ":") && !strings.HasPrefix(line, "=") {
+			b := []byte(line)
+			if b[5] == 'A' {
+				b[5] = 'B'
+			} else {
+				b[5] = 'A'
+			}
+			lines[i] = string(b)
+			break
+		}
+	}
+	require.Error(t, restore(strings.Join(lines, "\n"), passphrase, false))
+
+	require.NoError(t, restore(backup.String(), passphrase, false))
+	requireSameKeys(t, from, to)
+
+	// existing keys are only overwritten on demand
+	require.Error(t, restore(backup.String(), passphrase, false))
+	require.NoError(t, restore(backup.String(), passphrase, true))
+}
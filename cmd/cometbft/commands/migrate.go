@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/store/migrate"
+)
+
+var (
+	migrateDB     string
+	migrateTarget int64
+)
+
+func init() {
+	MigrateCmd.Flags().StringVar(&migrateDB, "db", "",
+		"only migrate this database: blockstore, state, evidence or tx_index")
+	MigrateCmd.Flags().Int64Var(&migrateTarget, "target", -1,
+		"schema version to migrate --db to, lower than the current one to roll back (default: latest)")
+}
+
+// MigrateCmd migrates the databases of the node to the schema expected by
+// this version of CometBFT.
+var MigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Migrate the databases to the latest schema version",
+	Long: `
+migrate upgrades the databases of the node to the schema expected by this
+version of CometBFT, which refuses to start on databases with an older schema.
+The databases are migrated in parallel, and the progress of each migration is
+logged. This should only be run once the node has stopped.
+
+A migration interrupted or failed halfway is reverted when possible, and the
+next run resumes from the last completed migration. With --target, a single
+database is migrated to the given schema version, rolling back the migrations
+of the later versions, e.g. before downgrading CometBFT.
+`,
+	Example: `
+	cometbft migrate
+	cometbft migrate --db tx_index --target 2
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if migrateTarget >= 0 && migrateDB == "" {
+			return fmt.Errorf("--target requires --db")
+		}
+		return migrateDBs(cmd.Context(), config, migrate.DefaultRegistry, migrateDB, migrateTarget, logger)
+	},
+}
+
+// migrateDBs migrates the databases of the node found on disk, or only
+// dbID if set, to target, or to their latest version if target is negative.
+func migrateDBs(
+	ctx context.Context,
+	config *cfg.Config,
+	registry *migrate.Registry,
+	dbID string,
+	target int64,
+	logger log.Logger,
+) error {
+	dbs := []*cfg.DBContext{
+		{ID: "blockstore", Config: config, Path: config.BlockstoreDir()},
+		{ID: "state", Config: config, Path: config.DBDir()},
+		{ID: "evidence", Config: config, Path: config.DBDir()},
+		{ID: "tx_index", Config: config, Path: config.DBDir()},
+	}
+	if dbID != "" {
+		var selected []*cfg.DBContext
+		for _, db := range dbs {
+			if db.ID == dbID {
+				selected = append(selected, db)
+			}
+		}
+		if len(selected) == 0 {
+			return fmt.Errorf("unknown database %q", dbID)
+		}
+		dbs = selected
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	for _, dbCtx := range dbs {
+		dbCtx := dbCtx
+		if path := cfg.DBPath(dbCtx); path == "" || !cmtos.FileExists(path) {
+			if dbID != "" {
+				return fmt.Errorf("no %s database found in %v", dbCtx.ID, dbCtx.Path)
+			}
+			continue
+		}
+		g.Go(func() error {
+			db, err := cfg.DefaultDBProvider(dbCtx)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			version := registry.LatestVersion(dbCtx.ID)
+			if target >= 0 {
+				version = uint64(target)
+			}
+			if err := registry.Migrate(gctx, dbCtx.ID, db, version, logger); err != nil {
+				return err
+			}
+			logger.Info("Database migrated", "db", dbCtx.ID, "version", version)
+			return nil
+		})
+	}
+	return g.Wait()
+}
@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/prove"
+	"github.com/cometbft/cometbft/proxy"
+	rpccore "github.com/cometbft/cometbft/rpc/core"
+)
+
+var (
+	proveHeight int64
+	proveIndex  uint32
+)
+
+// ProveCmd generates proofs of data committed at past heights from the
+// block and state stores, without running the node.
+var ProveCmd = &cobra.Command{
+	Use:   "prove",
+	Short: "Generate proofs of transactions, results and validators at past heights",
+	Long: `
+prove generates merkle proofs from the block and state stores of a stopped node,
+and prints them as JSON. Proofs of results are verified against the
+LastResultsHash of the next header, and proofs of validators against the
+ValidatorsHash of the header at the height.
+
+Proving transactions requires the application, as only the application knows
+how blocks are laid out in shares: prove tx connects to it at --proxy_app.
+`,
+}
+
+var proveTxCmd = &cobra.Command{
+	Use:   "tx",
+	Short: "Prove the inclusion of a transaction in the data root of its block",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		proxyApp, err := cmd.Flags().GetString("proxy_app")
+		if err != nil {
+			return err
+		}
+		client, err := abcicli.NewClient(proxyApp, config.ABCI, true)
+		if err != nil {
+			return fmt.Errorf("failed to connect to the application: %w", err)
+		}
+		if err := client.Start(); err != nil {
+			return fmt.Errorf("failed to connect to the application: %w", err)
+		}
+		defer func() { _ = client.Stop() }()
+
+		return runProve(func(p *prove.Prover) (interface{}, error) {
+			return p.ProveTx(proveHeight, proveIndex)
+		}, proxy.NewAppConnQuery(client, proxy.NopMetrics()))
+	},
+}
+
+var proveResultCmd = &cobra.Command{
+	Use:   "result",
+	Short: "Prove the result of executing a transaction",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runProve(func(p *prove.Prover) (interface{}, error) {
+			return p.ProveResult(proveHeight, proveIndex)
+		}, nil)
+	},
+}
+
+var proveValidatorsCmd = &cobra.Command{
+	Use:   "validators",
+	Short: "Prove the membership of each validator in the validator set",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runProve(func(p *prove.Prover) (interface{}, error) {
+			return p.ProveValidators(proveHeight)
+		}, nil)
+	},
+}
+
+func init() {
+	ProveCmd.PersistentFlags().Int64Var(&proveHeight, "height", 0, "height of the block")
+	proveTxCmd.Flags().Uint32Var(&proveIndex, "index", 0, "index of the transaction in the block")
+	proveTxCmd.Flags().String("proxy_app", config.ProxyApp,
+		"address of the application, proving transactions")
+	proveResultCmd.Flags().Uint32Var(&proveIndex, "index", 0, "index of the transaction in the block")
+
+	ProveCmd.AddCommand(proveTxCmd)
+	ProveCmd.AddCommand(proveResultCmd)
+	ProveCmd.AddCommand(proveValidatorsCmd)
+}
+
+// runProve prints the proof generated by fn as JSON. proxyAppQuery, which may
+// be nil, is used to prove transactions.
+func runProve(fn func(*prove.Prover) (interface{}, error), proxyAppQuery proxy.AppConnQuery) error {
+	if proveHeight <= 0 {
+		return fmt.Errorf("--height must be positive, got %d", proveHeight)
+	}
+	blockStore, stateStore, err := loadStateAndBlockStore(config)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+
+	var txProofs rpccore.TxProofProvider
+	if proxyAppQuery != nil {
+		txProofs = rpccore.NewAppTxProofProvider(proxyAppQuery, blockStore)
+	}
+	proof, err := fn(prove.NewProver(blockStore, stateStore, txProofs))
+	if err != nil {
+		return err
+	}
+	bz, err := cmtjson.MarshalIndent(proof, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(bz))
+	return nil
+}
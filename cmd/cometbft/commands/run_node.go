@@ -3,9 +3,11 @@ package commands
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -37,6 +39,13 @@ func AddNodeFlags(cmd *cobra.Command) {
 		"genesis_hash",
 		[]byte{},
 		"optional SHA-256 hash of the genesis file")
+	cmd.Flags().String("genesis_url", config.GenesisURL,
+		"HTTPS URL to download the genesis file from, if it does not exist")
+	cmd.Flags().String("genesis_sha256", config.GenesisSHA256,
+		"hex encoded SHA-256 hash of the genesis file, required with --genesis_url")
+	cmd.Flags().Bool("solo", config.Solo,
+		"run as the only validator of a local chain, without p2p, "+
+			"producing blocks as soon as txs arrive")
 	cmd.Flags().Int64("consensus.double_sign_check_height", config.Consensus.DoubleSignCheckHeight,
 		"how many blocks to look back to check existence of the node's "+
 			"consensus votes before joining consensus")
@@ -165,6 +174,15 @@ func checkGenesisHash(config *cfg.Config) error {
 
 	// Calculate SHA-256 hash of the genesis file.
 	f, err := os.Open(config.GenesisFile())
+	if os.IsNotExist(err) && config.GenesisURL != "" {
+		// The file is yet to be downloaded, and verified against
+		// genesis_sha256.
+		if !strings.EqualFold(hex.EncodeToString(genesisHash), config.GenesisSHA256) {
+			return fmt.Errorf("--genesis_hash=%X does not match genesis_sha256: %s",
+				genesisHash, config.GenesisSHA256)
+		}
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("can't open genesis file: %w", err)
 	}
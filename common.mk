@@ -29,4 +29,9 @@ endif
 ifeq (pebbledb,$(findstring pebbledb,$(COMETBFT_BUILD_OPTIONS)))
   CGO_ENABLED=1
   BUILD_TAGS += pebbledb
-endif
\ No newline at end of file
+endif
+
+# handle e2e
+ifeq (e2e,$(findstring e2e,$(COMETBFT_BUILD_OPTIONS)))
+  BUILD_TAGS += e2e
+endif
@@ -1,15 +1,22 @@
 package config
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/version"
 )
 
@@ -27,6 +34,11 @@ const (
 	// DefaultLogLevel defines a default log level as INFO.
 	DefaultLogLevel = "info"
 
+	// MinMaxPacketMsgPayloadSize is the smallest maximum packet payload size a
+	// node may use or a peer may advertise, below which the overhead of the
+	// packets would dominate.
+	MinMaxPacketMsgPayloadSize = 512
+
 	DefaultTendermintDir = ".cometbft"
 	DefaultConfigDir     = "config"
 	DefaultDataDir       = "data"
@@ -37,8 +49,9 @@ const (
 	DefaultPrivValKeyName   = "priv_validator_key.json"
 	DefaultPrivValStateName = "priv_validator_state.json"
 
-	DefaultNodeKeyName  = "node_key.json"
-	DefaultAddrBookName = "addrbook.json"
+	DefaultNodeKeyName      = "node_key.json"
+	DefaultTelemetryKeyName = "telemetry_key.json"
+	DefaultAddrBookName     = "addrbook.json"
 
 	MempoolTypeFlood          = "flood"
 	MempoolTypeNop            = "nop"
@@ -47,6 +60,14 @@ const (
 	LegacyMempoolTypeFlood    = "v0"
 	LegacyMempoolTypePriority = "v1"
 	LegacyMempoolTypeCAT      = "v2"
+
+	GossipStrategyPush        = "push"
+	GossipStrategyRarestFirst = "rarest-first"
+	GossipStrategyPull        = "pull"
+
+	InvariantChecksOff   = "off"
+	InvariantChecksAlert = "alert"
+	InvariantChecksHalt  = "halt"
 )
 
 // NOTE: Most of the structs & relevant comments + the
@@ -61,8 +82,9 @@ var (
 	defaultPrivValKeyPath   = filepath.Join(DefaultConfigDir, DefaultPrivValKeyName)
 	defaultPrivValStatePath = filepath.Join(DefaultDataDir, DefaultPrivValStateName)
 
-	defaultNodeKeyPath  = filepath.Join(DefaultConfigDir, DefaultNodeKeyName)
-	defaultAddrBookPath = filepath.Join(DefaultConfigDir, DefaultAddrBookName)
+	defaultNodeKeyPath      = filepath.Join(DefaultConfigDir, DefaultNodeKeyName)
+	defaultTelemetryKeyPath = filepath.Join(DefaultConfigDir, DefaultTelemetryKeyName)
+	defaultAddrBookPath     = filepath.Join(DefaultConfigDir, DefaultAddrBookName)
 
 	minSubscriptionBufferSize     = 100
 	defaultSubscriptionBufferSize = 200
@@ -88,6 +110,7 @@ type Config struct {
 	Mempool         *MempoolConfig         `mapstructure:"mempool"`
 	StateSync       *StateSyncConfig       `mapstructure:"statesync"`
 	BlockSync       *BlockSyncConfig       `mapstructure:"blocksync"`
+	BlockRelay      *BlockRelayConfig      `mapstructure:"blockrelay"`
 	Consensus       *ConsensusConfig       `mapstructure:"consensus"`
 	Storage         *StorageConfig         `mapstructure:"storage"`
 	TxIndex         *TxIndexConfig         `mapstructure:"tx_index"`
@@ -103,6 +126,7 @@ func DefaultConfig() *Config {
 		Mempool:         DefaultMempoolConfig(),
 		StateSync:       DefaultStateSyncConfig(),
 		BlockSync:       DefaultBlockSyncConfig(),
+		BlockRelay:      DefaultBlockRelayConfig(),
 		Consensus:       DefaultConsensusConfig(),
 		Storage:         DefaultStorageConfig(),
 		TxIndex:         DefaultTxIndexConfig(),
@@ -119,6 +143,7 @@ func TestConfig() *Config {
 		Mempool:         TestMempoolConfig(),
 		StateSync:       TestStateSyncConfig(),
 		BlockSync:       TestBlockSyncConfig(),
+		BlockRelay:      TestBlockRelayConfig(),
 		Consensus:       TestConsensusConfig(),
 		Storage:         TestStorageConfig(),
 		TxIndex:         TestTxIndexConfig(),
@@ -157,9 +182,18 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.BlockSync.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [blocksync] section: %w", err)
 	}
+	if err := cfg.BlockRelay.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [blockrelay] section: %w", err)
+	}
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [consensus] section: %w", err)
 	}
+	if err := cfg.Storage.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [storage] section: %w", err)
+	}
+	if err := cfg.TxIndex.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [tx_index] section: %w", err)
+	}
 	if err := cfg.Instrumentation.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [instrumentation] section: %w", err)
 	}
@@ -193,6 +227,12 @@ type BaseConfig struct { //nolint: maligned
 	// or the name of an ABCI application compiled in with the CometBFT binary
 	ProxyApp string `mapstructure:"proxy_app"`
 
+	// TCP or UNIX socket addresses of replicas of the ABCI application, in
+	// order of priority. If the connection to the application is lost, the
+	// node connects to the next address, replaying the blocks it misses,
+	// instead of stopping. The first address is ProxyApp.
+	ProxyAppFailover []string `mapstructure:"proxy_app_failover"`
+
 	// A custom human readable name for this node
 	Moniker string `mapstructure:"moniker"`
 
@@ -232,6 +272,14 @@ type BaseConfig struct { //nolint: maligned
 	// Path to the JSON file containing the initial validator set and other meta data
 	Genesis string `mapstructure:"genesis_file"`
 
+	// HTTPS URL to download the genesis file from, if it does not exist
+	// yet. The downloaded file is verified against GenesisSHA256 and cached
+	// at the path of the genesis file.
+	GenesisURL string `mapstructure:"genesis_url"`
+
+	// Hex encoded SHA-256 hash of the genesis file, required with GenesisURL
+	GenesisSHA256 string `mapstructure:"genesis_sha256"`
+
 	// Path to the JSON file containing the private key to use as a validator in the consensus protocol
 	PrivValidatorKey string `mapstructure:"priv_validator_key_file"`
 
@@ -242,34 +290,65 @@ type BaseConfig struct { //nolint: maligned
 	// connections from an external PrivValidator process
 	PrivValidatorListenAddr string `mapstructure:"priv_validator_laddr"`
 
+	// Maximum number of requests awaiting a response from the external
+	// PrivValidator process at the same time. Values greater than 1 let
+	// signers that support it sign e.g. a proposal and a vote concurrently.
+	PrivValidatorMaxInFlight int `mapstructure:"priv_validator_max_in_flight"`
+
+	// EXPERIMENTAL. Allow the file-based PrivValidator to compute VRF proofs
+	// with its ed25519 key. Nothing in consensus uses them yet.
+	PrivValidatorVRF bool `mapstructure:"experimental_priv_validator_vrf"`
+
 	// A JSON file containing the private key to use for p2p authenticated encryption
 	NodeKey string `mapstructure:"node_key_file"`
 
+	// A JSON file containing the private key signing the reports of the
+	// telemetry beacon, generated if missing. It is distinct from the node
+	// key so that the reports can't be linked to the p2p identity of the node.
+	TelemetryKey string `mapstructure:"telemetry_key_file"`
+
 	// Mechanism to connect to the ABCI application: socket | grpc
 	ABCI string `mapstructure:"abci"`
 
 	// If true, query the ABCI app on connecting to a new peer
 	// so the app can decide if we should keep the connection or not
 	FilterPeers bool `mapstructure:"filter_peers"` // false
+
+	// Limits on the events of each transaction, and of the block, in the
+	// FinalizeBlock responses of the ABCI application, bounding what is
+	// stored, indexed and served by /block_results. The events exceeding them
+	// are dropped with an error logged; the execution of the block is not
+	// affected. 0 disables a limit.
+	ABCIMaxEventsPerTx              int `mapstructure:"abci_max_events_per_tx"`
+	ABCIMaxEventBytesPerTx          int `mapstructure:"abci_max_event_bytes_per_tx"`
+	ABCIMaxEventAttributeKeyBytes   int `mapstructure:"abci_max_event_attribute_key_bytes"`
+	ABCIMaxEventAttributeValueBytes int `mapstructure:"abci_max_event_attribute_value_bytes"`
+
+	// If true, run the node as the only validator of a local chain, e.g. to
+	// test an application: p2p is disabled, and blocks are produced as soon
+	// as transactions arrive.
+	Solo bool `mapstructure:"solo"`
 }
 
 // DefaultBaseConfig returns a default base configuration for a CometBFT node
 func DefaultBaseConfig() BaseConfig {
 	return BaseConfig{
-		Version:            version.TMCoreSemVer,
-		Genesis:            defaultGenesisJSONPath,
-		PrivValidatorKey:   defaultPrivValKeyPath,
-		PrivValidatorState: defaultPrivValStatePath,
-		NodeKey:            defaultNodeKeyPath,
-		Moniker:            defaultMoniker,
-		ProxyApp:           "tcp://127.0.0.1:26658",
-		ABCI:               "socket",
-		LogLevel:           DefaultLogLevel,
-		LogFormat:          LogFormatPlain,
-		FilterPeers:        false,
-		DBBackend:          "goleveldb",
-		DBPath:             DefaultDataDir,
-		BlockstorePath:     DefaultDataDir,
+		Version:                  version.TMCoreSemVer,
+		Genesis:                  defaultGenesisJSONPath,
+		PrivValidatorKey:         defaultPrivValKeyPath,
+		PrivValidatorState:       defaultPrivValStatePath,
+		PrivValidatorMaxInFlight: 1,
+		NodeKey:                  defaultNodeKeyPath,
+		TelemetryKey:             defaultTelemetryKeyPath,
+		Moniker:                  defaultMoniker,
+		ProxyApp:                 "tcp://127.0.0.1:26658",
+		ABCI:                     "socket",
+		LogLevel:                 DefaultLogLevel,
+		LogFormat:                LogFormatPlain,
+		FilterPeers:              false,
+		DBBackend:                "goleveldb",
+		DBPath:                   DefaultDataDir,
+		BlockstorePath:           DefaultDataDir,
 	}
 }
 
@@ -301,6 +380,11 @@ func (cfg BaseConfig) NodeKeyFile() string {
 	return rootify(cfg.NodeKey, cfg.RootDir)
 }
 
+// TelemetryKeyFile returns the full path to the telemetry_key.json file
+func (cfg BaseConfig) TelemetryKeyFile() string {
+	return rootify(cfg.TelemetryKey, cfg.RootDir)
+}
+
 // DBDir returns the full path to the database directory
 func (cfg BaseConfig) DBDir() string {
 	return rootify(cfg.DBPath, cfg.RootDir)
@@ -328,6 +412,43 @@ func (cfg BaseConfig) ValidateBasic() error {
 	default:
 		return errors.New("unknown log_format (must be 'plain' or 'json')")
 	}
+	for _, addr := range cfg.ProxyAppFailover {
+		if addr == "" {
+			return errors.New("proxy_app_failover can't contain empty addresses")
+		}
+	}
+	if cfg.GenesisURL != "" {
+		u, err := url.Parse(cfg.GenesisURL)
+		if err != nil {
+			return fmt.Errorf("invalid genesis_url: %w", err)
+		}
+		if u.Scheme != "https" {
+			return errors.New("genesis_url must be an https URL")
+		}
+		if cfg.GenesisSHA256 == "" {
+			return errors.New("genesis_sha256 is required with genesis_url")
+		}
+	}
+	if cfg.GenesisSHA256 != "" {
+		if hash, err := hex.DecodeString(cfg.GenesisSHA256); err != nil || len(hash) != sha256.Size {
+			return errors.New("genesis_sha256 must be a hex encoded SHA-256 hash")
+		}
+	}
+	if cfg.PrivValidatorMaxInFlight < 0 {
+		return errors.New("priv_validator_max_in_flight can't be negative")
+	}
+	if cfg.ABCIMaxEventsPerTx < 0 {
+		return errors.New("abci_max_events_per_tx can't be negative")
+	}
+	if cfg.ABCIMaxEventBytesPerTx < 0 {
+		return errors.New("abci_max_event_bytes_per_tx can't be negative")
+	}
+	if cfg.ABCIMaxEventAttributeKeyBytes < 0 {
+		return errors.New("abci_max_event_attribute_key_bytes can't be negative")
+	}
+	if cfg.ABCIMaxEventAttributeValueBytes < 0 {
+		return errors.New("abci_max_event_attribute_value_bytes can't be negative")
+	}
 	return nil
 }
 
@@ -386,6 +507,13 @@ type RPCConfig struct {
 	// to the estimated maximum number of broadcast_tx_commit calls per block.
 	MaxSubscriptionsPerClient int `mapstructure:"max_subscriptions_per_client"`
 
+	// Comma separated list of <node ID>=<max subscriptions> entries granting
+	// websocket clients that authenticate with the key of the node ID (a node
+	// key or an operator key) their own subscription quota, shared by all of
+	// their connections. Authenticated clients are not subject to
+	// MaxSubscriptionClients. Authentication is disabled if empty.
+	AuthenticatedSubscriptionQuotas string `mapstructure:"authenticated_subscription_quotas"`
+
 	// The number of events that can be buffered per subscription before
 	// returning `ErrOutOfCapacity`.
 	SubscriptionBufferSize int `mapstructure:"experimental_subscription_buffer_size"`
@@ -409,16 +537,53 @@ type RPCConfig struct {
 	// predictability in subscription behavior.
 	CloseOnSlowClient bool `mapstructure:"experimental_close_on_slow_client"`
 
+	// If consensus has been working on the current height for longer than
+	// this, the RPC server sheds expensive queries (see
+	// LoadSheddingMaxBlockResultsTxs) to protect validator duties. 0 disables.
+	LoadSheddingConsensusLatency time.Duration `mapstructure:"experimental_load_shedding_consensus_latency"`
+
+	// If consensus reaches this round of the current height, the RPC server
+	// sheds expensive queries. 0 disables.
+	LoadSheddingRound int32 `mapstructure:"experimental_load_shedding_round"`
+
+	// While shedding load, /tx_search and /block_search are rejected, as
+	// is /block_results for blocks with more transactions than this.
+	LoadSheddingMaxBlockResultsTxs int `mapstructure:"experimental_load_shedding_max_block_results_txs"`
+
 	// How long to wait for a tx to be committed during /broadcast_tx_commit
 	// WARNING: Using a value larger than 10s will result in increasing the
 	// global HTTP write timeout, which applies to all connections and endpoints.
 	// See https://github.com/tendermint/tendermint/issues/3435
 	TimeoutBroadcastTxCommit time.Duration `mapstructure:"timeout_broadcast_tx_commit"`
 
+	// Comma separated list of <path prefix>=<timeout>/<max concurrent queries>
+	// entries routing /abci_query requests by path. A query is routed by the
+	// longest matching prefix, fails if it takes longer than the timeout of
+	// its route, and is rejected if the route already runs its maximum number
+	// of concurrent queries. A zero timeout or maximum means no limit.
+	ABCIQueryRoutes string `mapstructure:"abci_query_routes"`
+
+	// If true, /abci_query only serves paths matching one of
+	// ABCIQueryRoutes. It does not apply to in-process clients.
+	ABCIQueryAllowlist bool `mapstructure:"abci_query_allowlist"`
+
 	// Maximum number of requests that can be sent in a batch
 	// https://www.jsonrpc.org/specification#batch
 	MaxRequestBatchSize int `mapstructure:"max_request_batch_size"`
 
+	// Maximum number of transactions that can be sent in a single
+	// /broadcast_tx_batch request
+	MaxTxBatchSize int `mapstructure:"max_tx_batch_size"`
+
+	// How long the result of a /broadcast_tx_* request with a request_id is
+	// returned to the retries of the request, instead of submitting the
+	// transaction again. 0 disables request IDs.
+	TxRequestIDWindow time.Duration `mapstructure:"tx_request_id_window"`
+
+	// Maximum number of request IDs whose results are remembered. Once
+	// reached, the oldest results are forgotten before their window ends.
+	MaxTxRequestIDs int `mapstructure:"max_tx_request_ids"`
+
 	// Maximum size of request body, in bytes
 	MaxBodyBytes int64 `mapstructure:"max_body_bytes"`
 
@@ -446,6 +611,99 @@ type RPCConfig struct {
 	// pprof listen address (https://golang.org/pkg/net/http/pprof)
 	// FIXME: This should be moved under the instrumentation section
 	PprofListenAddress string `mapstructure:"pprof_laddr"`
+
+	// Virtual hosts serving the RPC with their own restrictions to the
+	// requests on some of the listen addresses or for some hosts, e.g. a
+	// restricted public surface next to a richer internal one. The other
+	// requests, including the ones for unknown hosts, are served with the
+	// options above. The rate limits of a virtual host apply to its requests
+	// on all the addresses.
+	VirtualHosts []*RPCVirtualHost `mapstructure:"virtual_hosts"`
+}
+
+// RPCVirtualHost restricts the RPC served to the requests on its listen
+// addresses, or whose Host header matches one of its hosts.
+type RPCVirtualHost struct {
+	// Name of the virtual host, used in the logs.
+	Name string `mapstructure:"name"`
+
+	// Listen addresses, among laddr, all the requests of which are served by
+	// the virtual host.
+	ListenAddresses []string `mapstructure:"laddrs"`
+
+	// Host names, without port, the requests for which are served by the
+	// virtual host on the other listen addresses.
+	Hosts []string `mapstructure:"hosts"`
+
+	// RPC methods served, e.g. "status", or all the methods starting with a
+	// prefix if ending with "*", e.g. "block*". All if empty.
+	Methods []string `mapstructure:"methods"`
+
+	// Maximum rate of the HTTP requests, websocket connections and requests
+	// over websocket of each client IP, per second, allowing bursts of up to
+	// RateBurst. No limit if 0.
+	RateLimit float64 `mapstructure:"rate_limit"`
+	RateBurst int     `mapstructure:"rate_burst"`
+
+	// Origins a cross-domain request can be executed from, as in
+	// cors_allowed_origins. CORS is disabled if empty.
+	CORSAllowedOrigins []string `mapstructure:"cors_allowed_origins"`
+}
+
+// ValidateBasic performs basic validation of the virtual host, whose listen
+// addresses must be among listenAddrs.
+func (vh *RPCVirtualHost) ValidateBasic(listenAddrs []string) error {
+	if vh.Name == "" {
+		return errors.New("name can't be empty")
+	}
+	if len(vh.ListenAddresses) == 0 && len(vh.Hosts) == 0 {
+		return errors.New("laddrs or hosts must be set")
+	}
+	for _, addr := range vh.ListenAddresses {
+		found := false
+		for _, laddr := range listenAddrs {
+			found = found || addr == laddr
+		}
+		if !found {
+			return fmt.Errorf("%q is not a listen address of laddr", addr)
+		}
+	}
+	for _, host := range vh.Hosts {
+		if host == "" || strings.Contains(host, ":") {
+			return fmt.Errorf("invalid host %q, expected a host name without port", host)
+		}
+	}
+	if vh.RateLimit < 0 {
+		return errors.New("rate_limit can't be negative")
+	}
+	if vh.RateLimit > 0 && vh.RateBurst < 1 {
+		return errors.New("rate_burst must be positive when rate_limit is set")
+	}
+	return nil
+}
+
+// ServesMethod returns whether the virtual host serves the RPC method.
+func (vh *RPCVirtualHost) ServesMethod(method string) bool {
+	if len(vh.Methods) == 0 {
+		return true
+	}
+	for _, m := range vh.Methods {
+		if prefix, ok := strings.CutSuffix(m, "*"); ok && strings.HasPrefix(method, prefix) || m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// ListenAddresses returns the addresses of ListenAddress.
+func (cfg *RPCConfig) ListenAddresses() []string {
+	var addrs []string
+	for _, addr := range strings.Split(cfg.ListenAddress, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
 }
 
 // DefaultRPCConfig returns a default configuration for the RPC server
@@ -468,9 +726,15 @@ func DefaultRPCConfig() *RPCConfig {
 		WebSocketWriteBufferSize:  defaultSubscriptionBufferSize,
 
 		MaxRequestBatchSize: 10,             // maximum requests in a JSON-RPC batch request
+		MaxTxBatchSize:      100,            // maximum transactions in a /broadcast_tx_batch request
 		MaxBodyBytes:        int64(1000000), // 1MB
 		MaxHeaderBytes:      1 << 20,        // same as the net/http default
 
+		LoadSheddingMaxBlockResultsTxs: 1000,
+
+		TxRequestIDWindow: 5 * time.Minute,
+		MaxTxRequestIDs:   10000,
+
 		TLSCertFile: "",
 		TLSKeyFile:  "",
 	}
@@ -500,6 +764,12 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.MaxSubscriptionsPerClient < 0 {
 		return errors.New("max_subscriptions_per_client can't be negative")
 	}
+	if _, err := cfg.SubscriptionQuotas(); err != nil {
+		return fmt.Errorf("invalid authenticated_subscription_quotas: %w", err)
+	}
+	if _, err := cfg.QueryRoutes(); err != nil {
+		return fmt.Errorf("invalid abci_query_routes: %w", err)
+	}
 	if cfg.SubscriptionBufferSize < minSubscriptionBufferSize {
 		return fmt.Errorf(
 			"experimental_subscription_buffer_size must be >= %d",
@@ -518,15 +788,67 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.MaxRequestBatchSize < 0 {
 		return errors.New("max_request_batch_size can't be negative")
 	}
+	if cfg.MaxTxBatchSize < 0 {
+		return errors.New("max_tx_batch_size can't be negative")
+	}
+	if cfg.TxRequestIDWindow < 0 {
+		return errors.New("tx_request_id_window can't be negative")
+	}
+	if cfg.MaxTxRequestIDs < 0 {
+		return errors.New("max_tx_request_ids can't be negative")
+	}
+	if cfg.TxRequestIDWindow > 0 && cfg.MaxTxRequestIDs == 0 {
+		return errors.New("max_tx_request_ids must be positive when tx_request_id_window is set")
+	}
 	if cfg.MaxBodyBytes < 0 {
 		return errors.New("max_body_bytes can't be negative")
 	}
 	if cfg.MaxHeaderBytes < 0 {
 		return errors.New("max_header_bytes can't be negative")
 	}
+	if cfg.LoadSheddingConsensusLatency < 0 {
+		return errors.New("experimental_load_shedding_consensus_latency can't be negative")
+	}
+	if cfg.LoadSheddingRound < 0 {
+		return errors.New("experimental_load_shedding_round can't be negative")
+	}
+	if cfg.LoadSheddingMaxBlockResultsTxs < 0 {
+		return errors.New("experimental_load_shedding_max_block_results_txs can't be negative")
+	}
+	names := make(map[string]struct{})
+	laddrs := make(map[string]string)
+	hosts := make(map[string]string)
+	for _, vh := range cfg.VirtualHosts {
+		if err := vh.ValidateBasic(cfg.ListenAddresses()); err != nil {
+			return fmt.Errorf("invalid virtual host %q: %w", vh.Name, err)
+		}
+		if _, ok := names[vh.Name]; ok {
+			return fmt.Errorf("duplicate virtual host %q", vh.Name)
+		}
+		names[vh.Name] = struct{}{}
+		for _, addr := range vh.ListenAddresses {
+			if other, ok := laddrs[addr]; ok {
+				return fmt.Errorf("listen address %q of virtual host %q already served by %q", addr, vh.Name, other)
+			}
+			laddrs[addr] = vh.Name
+		}
+		for _, host := range vh.Hosts {
+			host = strings.ToLower(host)
+			if other, ok := hosts[host]; ok {
+				return fmt.Errorf("host %q of virtual host %q already served by %q", host, vh.Name, other)
+			}
+			hosts[host] = vh.Name
+		}
+	}
 	return nil
 }
 
+// IsLoadSheddingEnabled returns true if the RPC server sheds expensive
+// queries while consensus is stressed.
+func (cfg *RPCConfig) IsLoadSheddingEnabled() bool {
+	return cfg.LoadSheddingConsensusLatency > 0 || cfg.LoadSheddingRound > 0
+}
+
 // IsCorsEnabled returns true if cross-origin resource sharing is enabled.
 func (cfg *RPCConfig) IsCorsEnabled() bool {
 	return len(cfg.CORSAllowedOrigins) != 0
@@ -556,6 +878,81 @@ func (cfg RPCConfig) IsTLSEnabled() bool {
 	return cfg.TLSCertFile != "" && cfg.TLSKeyFile != ""
 }
 
+// ABCIQueryRoute limits the /abci_query requests whose path starts with
+// Prefix.
+type ABCIQueryRoute struct {
+	Prefix        string
+	Timeout       time.Duration // no timeout if zero
+	MaxConcurrent int           // no limit if zero
+}
+
+// QueryRoutes parses ABCIQueryRoutes, sorted from the longest prefix to the
+// shortest.
+func (cfg *RPCConfig) QueryRoutes() ([]ABCIQueryRoute, error) {
+	var routes []ABCIQueryRoute
+	seen := make(map[string]struct{})
+	for _, entry := range strings.Split(cfg.ABCIQueryRoutes, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		prefix, limits, ok := strings.Cut(entry, "=")
+		timeout, maxConcurrent, ok2 := strings.Cut(limits, "/")
+		if !ok || !ok2 {
+			return nil, fmt.Errorf("invalid entry %q, expected <path prefix>=<timeout>/<max concurrent queries>", entry)
+		}
+		prefix = strings.TrimSpace(prefix)
+		if _, ok := seen[prefix]; ok {
+			return nil, fmt.Errorf("duplicate path prefix %q", prefix)
+		}
+		seen[prefix] = struct{}{}
+		route := ABCIQueryRoute{Prefix: prefix}
+		var err error
+		route.Timeout, err = time.ParseDuration(strings.TrimSpace(timeout))
+		if err != nil || route.Timeout < 0 {
+			return nil, fmt.Errorf("invalid timeout %q for path prefix %q", timeout, prefix)
+		}
+		route.MaxConcurrent, err = strconv.Atoi(strings.TrimSpace(maxConcurrent))
+		if err != nil || route.MaxConcurrent < 0 {
+			return nil, fmt.Errorf("invalid max concurrent queries %q for path prefix %q", maxConcurrent, prefix)
+		}
+		routes = append(routes, route)
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		return len(routes[i].Prefix) > len(routes[j].Prefix)
+	})
+	return routes, nil
+}
+
+// SubscriptionQuotas parses AuthenticatedSubscriptionQuotas into a map from
+// node ID to the maximum number of subscriptions.
+func (cfg *RPCConfig) SubscriptionQuotas() (map[string]int, error) {
+	quotas := make(map[string]int)
+	for _, entry := range strings.Split(cfg.AuthenticatedSubscriptionQuotas, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, maxSubs, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid entry %q, expected <node ID>=<max subscriptions>", entry)
+		}
+		id = strings.ToLower(strings.TrimSpace(id))
+		if b, err := hex.DecodeString(id); err != nil || len(b) != crypto.AddressSize {
+			return nil, fmt.Errorf("invalid node ID %q", id)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(maxSubs))
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid max subscriptions %q for node ID %q", maxSubs, id)
+		}
+		if _, ok := quotas[id]; ok {
+			return nil, fmt.Errorf("duplicate node ID %q", id)
+		}
+		quotas[id] = n
+	}
+	return quotas, nil
+}
+
 //-----------------------------------------------------------------------------
 // P2PConfig
 
@@ -569,6 +966,24 @@ type P2PConfig struct { //nolint: maligned
 	// Address to advertise to peers for them to dial
 	ExternalAddress string `mapstructure:"external_address"`
 
+	// Transport of the peer connections: "tcp" multiplexes the channels over
+	// an encrypted TCP connection, "quic" carries each channel on its own
+	// QUIC stream, over UDP on the port of ListenAddress. Peers can only
+	// connect over the same transport.
+	Transport string `mapstructure:"transport"`
+
+	// Address of a SOCKS5 proxy, such as Tor, all outbound peer connections
+	// are dialed through, as host:port. Required to dial the onion addresses
+	// of peers. Not supported by the quic transport. Peers are dialed directly
+	// if empty.
+	ProxyAddress string `mapstructure:"proxy_address"`
+
+	// Role of the node, selecting peer counts, mempool gossip fanout and PEX
+	// behavior suited to it: "validator", "full", "seed" or "archive". Settings
+	// changed from their default values take precedence over the profile.
+	// No profile is applied if empty.
+	Profile string `mapstructure:"profile"`
+
 	// Comma separated list of seed nodes to connect to
 	// We only use these if we can’t connect to peers in the addrbook
 	Seeds string `mapstructure:"seeds"`
@@ -576,6 +991,11 @@ type P2PConfig struct { //nolint: maligned
 	// Comma separated list of nodes to keep persistent connections to
 	PersistentPeers string `mapstructure:"persistent_peers"`
 
+	// Path to the file pinning the node IDs of persistent peers to their
+	// addresses on first use. Dials to a pinned address with a different node
+	// ID are refused. Pinning is disabled if empty.
+	PeerPinning string `mapstructure:"peer_pinning_file"`
+
 	// Path to address book
 	AddrBook string `mapstructure:"addr_book_file"`
 
@@ -592,13 +1012,24 @@ type P2PConfig struct { //nolint: maligned
 	// List of node IDs, to which a connection will be (re)established ignoring any existing limits
 	UnconditionalPeerIDs string `mapstructure:"unconditional_peer_ids"`
 
+	// List of node IDs of high-priority peers, typically other validators.
+	// They are accepted regardless of the inbound and outbound peer limits and
+	// do not take up slots, so they are never crowded out by peer churn.
+	PriorityPeerIDs string `mapstructure:"priority_peer_ids"`
+
+	// Factor applied to send_rate and recv_rate on connections to priority
+	// peers.
+	PriorityPeerRateMultiplier int64 `mapstructure:"priority_peer_rate_multiplier"`
+
 	// Maximum pause when redialing a persistent peer (if zero, exponential backoff is used)
 	PersistentPeersMaxDialPeriod time.Duration `mapstructure:"persistent_peers_max_dial_period"`
 
 	// Time to wait before flushing messages out on the connection
 	FlushThrottleTimeout time.Duration `mapstructure:"flush_throttle_timeout"`
 
-	// Maximum size of a message packet payload, in bytes
+	// Maximum size of a message packet payload, in bytes. The peers
+	// supporting it negotiate the smaller of their sizes during the handshake.
+	// Can't be less than 512.
 	MaxPacketMsgPayloadSize int `mapstructure:"max_packet_msg_payload_size"`
 
 	// Rate at which packets can be sent, in bytes/second
@@ -607,6 +1038,52 @@ type P2PConfig struct { //nolint: maligned
 	// Rate at which packets can be received, in bytes/second
 	RecvRate int64 `mapstructure:"recv_rate"`
 
+	// Adapt the rate at which packets are sent on each connection, starting
+	// from send_rate, to the capacity of the peer to receive, as observed
+	// from the round-trip time of pings and the rate at which the connection
+	// drains, within [min_send_rate, max_send_rate]
+	AdaptiveSendRate bool  `mapstructure:"adaptive_send_rate"`
+	MinSendRate      int64 `mapstructure:"min_send_rate"`
+	MaxSendRate      int64 `mapstructure:"max_send_rate"`
+
+	// Comma separated list of limits on the messages received from each peer
+	// on a channel, as <channel ID>=<messages/s>/<bytes/s>, e.g.
+	// "0x30=100/1048576". Zero means no limit. Channels not listed are not
+	// limited.
+	ChannelRecvRateLimits string `mapstructure:"channel_recv_rate_limits"`
+
+	// What to do with a peer exceeding a limit of ChannelRecvRateLimits:
+	// "throttle" stops reading from the peer until the rate is back under the
+	// limit, "disconnect" disconnects from the peer.
+	ChannelRecvRateLimitAction string `mapstructure:"channel_recv_rate_limit_action"`
+
+	// Memory, in bytes, shared by the send queues and receive buffers of the
+	// channels of all peer connections. It is split evenly between
+	// max_num_inbound_peers + max_num_outbound_peers connections, and within a
+	// connection between channels in proportion to the capacities declared by
+	// their reactors. If zero, the declared capacities are used as is.
+	ChannelBufferBudget int64 `mapstructure:"channel_buffer_budget"`
+
+	// Maximum offset of the local clock relative to the median clock of the
+	// peers, estimated during ping/pong, before a warning is raised. Clock
+	// skew checks are disabled if zero.
+	ClockSkewThreshold time.Duration `mapstructure:"clock_skew_threshold"`
+
+	// Advertise support for numbering the packets sent over peer connections,
+	// so that replayed and reordered packets are rejected. Packets are only
+	// numbered on connections with peers that advertise it too.
+	PacketSequence bool `mapstructure:"packet_sequence"`
+
+	// Advertise support for compressing the messages of the channels that
+	// select a compression, e.g. transactions and block parts. Messages are
+	// only compressed on connections with peers that advertise it too.
+	Compression bool `mapstructure:"compression"`
+
+	// Advertise support for multiplexing the channels over the streams of a
+	// yamux session, each channel being flow controlled independently.
+	// Streams are only used on connections with peers that advertise it too.
+	Yamux bool `mapstructure:"yamux"`
+
 	// Set true to enable the peer-exchange reactor
 	PexReactor bool `mapstructure:"pex"`
 
@@ -616,6 +1093,15 @@ type P2PConfig struct { //nolint: maligned
 	// Does not work if the peer-exchange reactor is disabled.
 	SeedMode bool `mapstructure:"seed_mode"`
 
+	// Time a seed keeps the peers connecting to it connected after answering
+	// their request for addresses. If zero, the seed disconnects right away.
+	SeedGracePeriod time.Duration `mapstructure:"seed_grace_period"`
+
+	// Set true for a seed to send its latest height and header to the peers
+	// connecting to it, so they learn the height of the network while
+	// bootstrapping.
+	SeedServeStatus bool `mapstructure:"seed_serve_status"`
+
 	// Comma separated list of peer IDs to keep private (will not be gossiped to
 	// other peers)
 	PrivatePeerIDs string `mapstructure:"private_peer_ids"`
@@ -623,6 +1109,57 @@ type P2PConfig struct { //nolint: maligned
 	// Toggle to disable guard against peers connecting from the same ip.
 	AllowDuplicateIP bool `mapstructure:"allow_duplicate_ip"`
 
+	// Comma separated list of subnets, in CIDR notation or as single IPs,
+	// inbound connections are accepted from, before the handshake. All
+	// subnets are allowed if empty. Can be changed at runtime with the
+	// dial_control RPC.
+	AllowedSubnets string `mapstructure:"allowed_subnets"`
+
+	// Comma separated list of subnets, in CIDR notation or as single IPs,
+	// inbound connections are rejected from, before the handshake, even if
+	// allowed by allowed_subnets. Can be changed at runtime with the
+	// dial_control RPC.
+	BlockedSubnets string `mapstructure:"blocked_subnets"`
+
+	// Maximum rate of the inbound connection attempts from each IP, per
+	// second, with bursts of up to inbound_conn_burst attempts. Attempts over
+	// the rate are closed right after being accepted. No limit if zero.
+	InboundConnRate  float64 `mapstructure:"inbound_conn_rate"`
+	InboundConnBurst int     `mapstructure:"inbound_conn_burst"`
+
+	// Maximum rate of the inbound connection attempts from each /24 IPv4 or
+	// /48 IPv6 subnet, per second, with bursts of up to
+	// inbound_subnet_conn_burst attempts. No limit if zero.
+	InboundSubnetConnRate  float64 `mapstructure:"inbound_subnet_conn_rate"`
+	InboundSubnetConnBurst int     `mapstructure:"inbound_subnet_conn_burst"`
+
+	// Duration for which an IP or subnet exceeding its inbound connection rate
+	// is banned, its attempts being all rejected. No ban if zero.
+	InboundConnBanDuration time.Duration `mapstructure:"inbound_conn_ban_duration"`
+
+	// Maximum number of peers in the same /24 IPv4 or /48 IPv6 subnet, when
+	// accepting and dialing peers. Persistent, unconditional and priority
+	// peers are exempt. No limit if zero.
+	MaxPeersPerSubnet int `mapstructure:"max_peers_per_subnet"`
+
+	// Maximum number of peers in the same autonomous system, as resolved using
+	// asn_database_file. Persistent, unconditional and priority peers are
+	// exempt. No limit if zero.
+	MaxPeersPerASN int `mapstructure:"max_peers_per_asn"`
+
+	// Path to a CSV file mapping IP networks to autonomous system numbers, in
+	// the format of the GeoLite2 ASN CSV database: one
+	// "<network CIDR>,<ASN>[,...]" record per line, with an optional header.
+	// Required if max_peers_per_asn is set.
+	ASNDatabase string `mapstructure:"asn_database_file"`
+
+	// Score below which peers are disconnected, and not dialed or accepted
+	// until their score recovers. Scores are raised by the blocks and
+	// transactions peers deliver and lowered by their invalid messages,
+	// disconnects for misbehavior and latency. Persistent, unconditional and
+	// priority peers are exempt. Peer scoring is disabled if zero.
+	MinPeerScore float64 `mapstructure:"min_peer_score"`
+
 	// Peer connection configuration.
 	HandshakeTimeout time.Duration `mapstructure:"handshake_timeout"`
 	DialTimeout      time.Duration `mapstructure:"dial_timeout"`
@@ -649,9 +1186,24 @@ func DefaultP2PConfig() *P2PConfig {
 		MaxPacketMsgPayloadSize:      1024,    // 1 kB
 		SendRate:                     5120000, // 5 mB/s
 		RecvRate:                     5120000, // 5 mB/s
+		AdaptiveSendRate:             false,
+		MinSendRate:                  512000,   // 500 kB/s
+		MaxSendRate:                  51200000, // 50 mB/s
+		PriorityPeerRateMultiplier:   2,
+		ChannelRecvRateLimitAction:   ChannelRecvRateLimitThrottle,
+		Transport:                    P2PTransportTCP,
+		ProxyAddress:                 "",
 		PexReactor:                   true,
 		SeedMode:                     false,
+		SeedGracePeriod:              0,
+		SeedServeStatus:              false,
 		AllowDuplicateIP:             false,
+		InboundConnRate:              0,
+		InboundConnBurst:             10,
+		InboundSubnetConnRate:        0,
+		InboundSubnetConnBurst:       40,
+		InboundConnBanDuration:       time.Minute,
+		MinPeerScore:                 -100,
 		HandshakeTimeout:             20 * time.Second,
 		DialTimeout:                  3 * time.Second,
 		TestDialFail:                 false,
@@ -674,6 +1226,24 @@ func (cfg *P2PConfig) AddrBookFile() string {
 	return rootify(cfg.AddrBook, cfg.RootDir)
 }
 
+// PeerPinningFile returns the full path to the peer pinning file, or an empty
+// string if pinning is disabled.
+func (cfg *P2PConfig) PeerPinningFile() string {
+	if cfg.PeerPinning == "" {
+		return ""
+	}
+	return rootify(cfg.PeerPinning, cfg.RootDir)
+}
+
+// ASNDatabaseFile returns the full path to the ASN database file, or an empty
+// string if none is configured.
+func (cfg *P2PConfig) ASNDatabaseFile() string {
+	if cfg.ASNDatabase == "" {
+		return ""
+	}
+	return rootify(cfg.ASNDatabase, cfg.RootDir)
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *P2PConfig) ValidateBasic() error {
@@ -689,8 +1259,8 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.PersistentPeersMaxDialPeriod < 0 {
 		return errors.New("persistent_peers_max_dial_period can't be negative")
 	}
-	if cfg.MaxPacketMsgPayloadSize < 0 {
-		return errors.New("max_packet_msg_payload_size can't be negative")
+	if cfg.MaxPacketMsgPayloadSize < MinMaxPacketMsgPayloadSize {
+		return fmt.Errorf("max_packet_msg_payload_size can't be less than %d", MinMaxPacketMsgPayloadSize)
 	}
 	if cfg.SendRate < 0 {
 		return errors.New("send_rate can't be negative")
@@ -698,9 +1268,275 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.RecvRate < 0 {
 		return errors.New("recv_rate can't be negative")
 	}
+	if cfg.SeedGracePeriod < 0 {
+		return errors.New("seed_grace_period can't be negative")
+	}
+	if cfg.MinSendRate < 0 {
+		return errors.New("min_send_rate can't be negative")
+	}
+	if cfg.MaxSendRate < 0 {
+		return errors.New("max_send_rate can't be negative")
+	}
+	if cfg.AdaptiveSendRate {
+		if cfg.MinSendRate == 0 {
+			return errors.New("min_send_rate must be positive with adaptive_send_rate")
+		}
+		if cfg.MinSendRate > cfg.MaxSendRate {
+			return errors.New("min_send_rate can't be greater than max_send_rate")
+		}
+	}
+	if cfg.ChannelBufferBudget < 0 {
+		return errors.New("channel_buffer_budget can't be negative")
+	}
+	if _, err := cfg.ChannelRecvLimits(); err != nil {
+		return fmt.Errorf("invalid channel_recv_rate_limits: %w", err)
+	}
+	switch cfg.ChannelRecvRateLimitAction {
+	case ChannelRecvRateLimitThrottle, ChannelRecvRateLimitDisconnect:
+	default:
+		return fmt.Errorf("unknown channel_recv_rate_limit_action %q", cfg.ChannelRecvRateLimitAction)
+	}
+	switch cfg.Transport {
+	case P2PTransportTCP, P2PTransportQUIC:
+	default:
+		return fmt.Errorf("unknown transport %q", cfg.Transport)
+	}
+	if cfg.ProxyAddress != "" {
+		if _, _, err := net.SplitHostPort(cfg.ProxyAddress); err != nil {
+			return fmt.Errorf("invalid proxy_address: %w", err)
+		}
+		if cfg.Transport == P2PTransportQUIC {
+			return errors.New("proxy_address is not supported by the quic transport")
+		}
+	}
+	if cfg.ClockSkewThreshold < 0 {
+		return errors.New("clock_skew_threshold can't be negative")
+	}
+	if err := validateSubnets(cfg.AllowedSubnets); err != nil {
+		return fmt.Errorf("invalid allowed_subnets: %w", err)
+	}
+	if err := validateSubnets(cfg.BlockedSubnets); err != nil {
+		return fmt.Errorf("invalid blocked_subnets: %w", err)
+	}
+	if cfg.InboundConnRate < 0 {
+		return errors.New("inbound_conn_rate can't be negative")
+	}
+	if cfg.InboundConnRate > 0 && cfg.InboundConnBurst < 1 {
+		return errors.New("inbound_conn_burst must be positive if inbound_conn_rate is set")
+	}
+	if cfg.InboundSubnetConnRate < 0 {
+		return errors.New("inbound_subnet_conn_rate can't be negative")
+	}
+	if cfg.InboundSubnetConnRate > 0 && cfg.InboundSubnetConnBurst < 1 {
+		return errors.New("inbound_subnet_conn_burst must be positive if inbound_subnet_conn_rate is set")
+	}
+	if cfg.InboundConnBanDuration < 0 {
+		return errors.New("inbound_conn_ban_duration can't be negative")
+	}
+	if cfg.MaxPeersPerSubnet < 0 {
+		return errors.New("max_peers_per_subnet can't be negative")
+	}
+	if cfg.MaxPeersPerASN < 0 {
+		return errors.New("max_peers_per_asn can't be negative")
+	}
+	if cfg.MaxPeersPerASN > 0 && cfg.ASNDatabase == "" {
+		return errors.New("max_peers_per_asn requires asn_database_file")
+	}
+	if cfg.MinPeerScore > 0 {
+		return errors.New("min_peer_score can't be positive")
+	}
+	if cfg.PriorityPeerRateMultiplier < 1 {
+		return errors.New("priority_peer_rate_multiplier must be at least 1")
+	}
+	if _, ok := p2pProfiles[cfg.Profile]; cfg.Profile != "" && !ok {
+		return fmt.Errorf("unknown profile %q", cfg.Profile)
+	}
 	return nil
 }
 
+// Transports selectable via P2PConfig.Transport.
+const (
+	P2PTransportTCP  = "tcp"
+	P2PTransportQUIC = "quic"
+)
+
+// Actions selectable via P2PConfig.ChannelRecvRateLimitAction.
+const (
+	ChannelRecvRateLimitThrottle   = "throttle"
+	ChannelRecvRateLimitDisconnect = "disconnect"
+)
+
+// ChannelRecvLimit limits the messages received from a peer on a channel.
+type ChannelRecvLimit struct {
+	MsgsPerSecond  int   // no limit if zero
+	BytesPerSecond int64 // no limit if zero
+}
+
+// validateSubnets returns an error if the comma separated list of subnets
+// holds an entry neither in CIDR notation nor a single IP.
+func validateSubnets(subnets string) error {
+	for _, subnet := range strings.Split(subnets, ",") {
+		subnet = strings.TrimSpace(subnet)
+		if subnet == "" {
+			continue
+		}
+		if strings.Contains(subnet, "/") {
+			if _, _, err := net.ParseCIDR(subnet); err != nil {
+				return err
+			}
+		} else if net.ParseIP(subnet) == nil {
+			return fmt.Errorf("invalid IP %q", subnet)
+		}
+	}
+	return nil
+}
+
+// ChannelRecvLimits parses ChannelRecvRateLimits into a map from channel ID
+// to the limits of the channel.
+func (cfg *P2PConfig) ChannelRecvLimits() (map[byte]ChannelRecvLimit, error) {
+	limits := make(map[byte]ChannelRecvLimit)
+	for _, entry := range strings.Split(cfg.ChannelRecvRateLimits, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, rates, ok := strings.Cut(entry, "=")
+		msgRate, byteRate, ok2 := strings.Cut(rates, "/")
+		if !ok || !ok2 {
+			return nil, fmt.Errorf("invalid entry %q, expected <channel ID>=<messages/s>/<bytes/s>", entry)
+		}
+		id = strings.TrimSpace(id)
+		chID, err := strconv.ParseUint(id, 0, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid channel ID %q", id)
+		}
+		if _, ok := limits[byte(chID)]; ok {
+			return nil, fmt.Errorf("duplicate channel ID %q", id)
+		}
+		var limit ChannelRecvLimit
+		limit.MsgsPerSecond, err = strconv.Atoi(strings.TrimSpace(msgRate))
+		if err != nil || limit.MsgsPerSecond < 0 {
+			return nil, fmt.Errorf("invalid messages/s %q for channel ID %q", msgRate, id)
+		}
+		limit.BytesPerSecond, err = strconv.ParseInt(strings.TrimSpace(byteRate), 10, 64)
+		if err != nil || limit.BytesPerSecond < 0 {
+			return nil, fmt.Errorf("invalid bytes/s %q for channel ID %q", byteRate, id)
+		}
+		limits[byte(chID)] = limit
+	}
+	return limits, nil
+}
+
+// P2P profiles selectable via P2PConfig.Profile.
+const (
+	P2PProfileValidator = "validator"
+	P2PProfileFull      = "full"
+	P2PProfileSeed      = "seed"
+	P2PProfileArchive   = "archive"
+)
+
+// p2pProfile holds the settings a P2P profile applies.
+type p2pProfile struct {
+	maxNumInboundPeers  int
+	maxNumOutboundPeers int
+	pex                 bool
+	seedMode            bool
+	// mempool gossip fanout, 0 meaning all non-persistent peers
+	mempoolBroadcast                    bool
+	maxGossipConnectionsToNonPersistent int
+}
+
+var p2pProfiles = map[string]p2pProfile{
+	// Validators mostly talk to their sentries and other validators, and
+	// should not spend bandwidth on a large set of random peers.
+	P2PProfileValidator: {
+		maxNumInboundPeers:                  10,
+		maxNumOutboundPeers:                 5,
+		pex:                                 true,
+		mempoolBroadcast:                    true,
+		maxGossipConnectionsToNonPersistent: 5,
+	},
+	P2PProfileFull: {
+		maxNumInboundPeers:  40,
+		maxNumOutboundPeers: 10,
+		pex:                 true,
+		mempoolBroadcast:    true,
+	},
+	// Seeds crawl the network and serve addresses to as many nodes as
+	// possible, but don't relay transactions.
+	P2PProfileSeed: {
+		maxNumInboundPeers:  200,
+		maxNumOutboundPeers: 30,
+		pex:                 true,
+		seedMode:            true,
+		mempoolBroadcast:    false,
+	},
+	// Archive nodes serve historical blocks to syncing nodes.
+	P2PProfileArchive: {
+		maxNumInboundPeers:                  80,
+		maxNumOutboundPeers:                 20,
+		pex:                                 true,
+		mempoolBroadcast:                    true,
+		maxGossipConnectionsToNonPersistent: 10,
+	},
+}
+
+// ApplyP2PProfile returns a copy of the configuration with the profile
+// selected by P2P.Profile applied to the P2P and mempool configurations.
+// The profile only replaces settings still at their default values: settings
+// changed from their defaults, in the config file, by flag or by environment
+// variable, take precedence over it. The configuration itself is left
+// unchanged, and returned as is if no profile is selected.
+func (cfg *Config) ApplyP2PProfile() (*Config, error) {
+	if cfg.P2P.Profile == "" {
+		return cfg, nil
+	}
+	profile, ok := p2pProfiles[cfg.P2P.Profile]
+	if !ok {
+		return nil, fmt.Errorf("unknown p2p profile %q", cfg.P2P.Profile)
+	}
+
+	c := *cfg
+	p2pCfg, mempoolCfg := *cfg.P2P, *cfg.Mempool
+	c.P2P, c.Mempool = &p2pCfg, &mempoolCfg
+	defP2P, defMempool := DefaultP2PConfig(), DefaultMempoolConfig()
+	if c.P2P.MaxNumInboundPeers == defP2P.MaxNumInboundPeers {
+		c.P2P.MaxNumInboundPeers = profile.maxNumInboundPeers
+	}
+	if c.P2P.MaxNumOutboundPeers == defP2P.MaxNumOutboundPeers {
+		c.P2P.MaxNumOutboundPeers = profile.maxNumOutboundPeers
+	}
+	if c.P2P.PexReactor == defP2P.PexReactor {
+		c.P2P.PexReactor = profile.pex
+	}
+	if c.P2P.SeedMode == defP2P.SeedMode {
+		c.P2P.SeedMode = profile.seedMode
+	}
+	if c.Mempool.Broadcast == defMempool.Broadcast {
+		c.Mempool.Broadcast = profile.mempoolBroadcast
+	}
+	if c.Mempool.ExperimentalMaxGossipConnectionsToNonPersistentPeers ==
+		defMempool.ExperimentalMaxGossipConnectionsToNonPersistentPeers {
+		c.Mempool.ExperimentalMaxGossipConnectionsToNonPersistentPeers = profile.maxGossipConnectionsToNonPersistent
+	}
+	return &c, nil
+}
+
+// ApplySolo adjusts the configuration of a solo node, which runs consensus on
+// its own: it does not gossip, and proposes a block as soon as there are
+// transactions, committing it without waiting for straggler votes. It is a
+// no-op unless BaseConfig.Solo is set.
+func (cfg *Config) ApplySolo() {
+	if !cfg.Solo {
+		return
+	}
+	cfg.P2P.PexReactor = false
+	cfg.Mempool.Broadcast = false
+	cfg.Consensus.CreateEmptyBlocks = false
+	cfg.Consensus.CreateEmptyBlocksInterval = 0
+	cfg.Consensus.SkipTimeoutCommit = true
+}
+
 // FuzzConnConfig is a FuzzedConnection configuration.
 type FuzzConnConfig struct {
 	Mode         int
@@ -777,6 +1613,14 @@ type MempoolConfig struct {
 	// This only accounts for raw transactions (e.g. given 1MB transactions and
 	// max_txs_bytes=5MB, mempool will only accept 5 transactions).
 	MaxTxsBytes int64 `mapstructure:"max_txs_bytes"`
+	// Limit the estimated memory used by the txs in the mempool, i.e. the raw
+	// transactions plus their metadata, indexes and cache entries. The
+	// estimate leaves out the sets of peers each tx was received from, so the
+	// heap usage can exceed it. When the limit is reached, the priority and
+	// CAT mempools evict lower-priority transactions to make room for new
+	// ones, while the flood mempool rejects new ones. 0 means no limit other
+	// than max_txs_bytes.
+	MaxMemoryBytes int64 `mapstructure:"max_memory_bytes"`
 	// Size of the cache (used to filter transactions we saw earlier) in transactions
 	CacheSize int `mapstructure:"cache_size"`
 	// Do not remove invalid transactions from the cache (default: false)
@@ -826,6 +1670,92 @@ type MempoolConfig struct {
 	// has existed in the mempool at least TTLNumBlocks number of blocks or if
 	// it's insertion time into the mempool is beyond TTLDuration.
 	TTLNumBlocks int64 `mapstructure:"ttl-num-blocks"`
+
+	// FeeMarketWindow is the number of heights over which the priority
+	// mempools track admitted and committed transaction priorities for the
+	// /fee_market RPC endpoint.
+	// Only applicable to the priority and CAT mempools.
+	// Default is 20
+	FeeMarketWindow int `mapstructure:"fee-market-window"`
+
+	// SummaryGossipInterval, if non-zero, defines how often a summary of the
+	// mempool (size and admission priority) is sent to peers. Peers use it to
+	// skip broadcasting transactions which would not be admitted while the
+	// mempool is full. All peers on the mempool channel must understand the
+	// summary message, so it is disabled by default.
+	// Only applicable to the priority mempool.
+	SummaryGossipInterval time.Duration `mapstructure:"summary-gossip-interval"`
+
+	// MaxInFlightCheckTx is the maximum number of new transactions awaiting
+	// their ABCI CheckTx response at any time. CheckTx requests are pipelined
+	// to the application up to this limit; further transactions wait for a
+	// slot to free up.
+	// Only applicable to the priority mempool.
+	// Default is 1000
+	MaxInFlightCheckTx int `mapstructure:"max-inflight-checktx"`
+
+	// MaxTxBytesByType limits the size of transactions by type before they are
+	// passed to CheckTx. It is a comma separated list of <type>=<max bytes>
+	// pairs, where <type> is either "blob" for blob transactions or a hex
+	// encoded prefix of the raw transaction bytes. The longest matching
+	// prefix applies. Transactions matching no type are only subject to
+	// MaxTxBytes.
+	MaxTxBytesByType string `mapstructure:"max-tx-bytes-by-type"`
+
+	// ReapMaxClassPercent, if non-zero, is the maximum percentage of the bytes
+	// reaped for a block proposal taken by transactions of the same class, so
+	// that a single spammer cannot fill the whole candidate set passed to
+	// PrepareProposal. Transactions are classified by the sender returned by
+	// CheckTx unless the node is given another classifier, e.g. by namespace.
+	ReapMaxClassPercent int `mapstructure:"reap-max-class-percent"`
+
+	// GossipFanout, if non-zero, is the number of peers a new transaction is
+	// pushed to immediately. The other peers get it lazily: the flood mempool
+	// pushes it to them once GossipDedupWindow has passed, unless they relayed
+	// it to us first, and the CAT mempool announces it to them with a SeenTx
+	// message so that they request it if they still need it.
+	// Only applicable to the flood and CAT mempools.
+	GossipFanout int `mapstructure:"gossip-fanout"`
+
+	// GossipDelayJitter is the maximum random delay added before relaying a
+	// transaction lazily, so that the peers of the network do not all relay it
+	// at the same time. It delays the SeenTx messages of the CAT mempool and
+	// the lazy pushes of the flood mempool. No delay if zero.
+	// Only applicable to the flood and CAT mempools.
+	// Default is 100ms
+	GossipDelayJitter time.Duration `mapstructure:"gossip-delay-jitter"`
+
+	// GossipDedupWindow is the window during which duplicate sends of a
+	// transaction to a peer are suppressed. For the flood mempool, it is how
+	// long a transaction is withheld from the peers outside of the fanout, in
+	// case they relay it to us first. For the CAT mempool, it bounds how long
+	// we remember that a peer has a transaction, so as not to send it again,
+	// which is otherwise TTLDuration, or an hour if zero.
+	// Only applicable to the flood and CAT mempools.
+	GossipDedupWindow time.Duration `mapstructure:"gossip-dedup-window"`
+
+	// MaxBroadcastRoutines caps the number of routines broadcasting
+	// transactions, one per peer. Peers beyond the cap are sent transactions
+	// once a routine returns, e.g. as a peer disconnects, and meanwhile get
+	// them from other peers. Persistent and unconditional peers are not
+	// capped. 0 means no cap.
+	// Only applicable to the flood and priority mempools.
+	MaxBroadcastRoutines int `mapstructure:"max-broadcast-routines"`
+
+	// CommittedTxsWindow is the number of heights committed transactions are
+	// remembered for, so that the ones still in the mempool or received from
+	// peers, e.g. after falling out of the cache, are purged instead of being
+	// gossiped again. 0 disables it.
+	// Default is 5
+	CommittedTxsWindow int64 `mapstructure:"committed-txs-window"`
+
+	// BroadcastProxyAddrs is a comma-separated list of RPC addresses of
+	// full nodes the broadcast_tx RPCs are forwarded to, tried in order until
+	// one of them accepts the transaction. It lets nodes without a mempool,
+	// e.g. RPC or archive nodes, serve the broadcast_tx RPCs without ever
+	// storing or gossiping pending transactions.
+	// Only applicable to the nop mempool.
+	BroadcastProxyAddrs string `mapstructure:"broadcast-proxy-addrs"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the CometBFT mempool
@@ -844,8 +1774,12 @@ func DefaultMempoolConfig() *MempoolConfig {
 		MaxTxBytes:  1024 * 1024, // 1MB
 		ExperimentalMaxGossipConnectionsToNonPersistentPeers: 0,
 		ExperimentalMaxGossipConnectionsToPersistentPeers:    0,
-		TTLDuration:  0 * time.Second,
-		TTLNumBlocks: 0,
+		TTLDuration:        0 * time.Second,
+		TTLNumBlocks:       0,
+		FeeMarketWindow:    20,
+		MaxInFlightCheckTx: 1000,
+		GossipDelayJitter:  100 * time.Millisecond,
+		CommittedTxsWindow: 5,
 	}
 }
 
@@ -881,6 +1815,9 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.MaxTxsBytes < 0 {
 		return errors.New("max_txs_bytes can't be negative")
 	}
+	if cfg.MaxMemoryBytes < 0 {
+		return errors.New("max_memory_bytes can't be negative")
+	}
 	if cfg.CacheSize < 0 {
 		return errors.New("cache_size can't be negative")
 	}
@@ -893,9 +1830,90 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.ExperimentalMaxGossipConnectionsToNonPersistentPeers < 0 {
 		return errors.New("experimental_max_gossip_connections_to_non_persistent_peers can't be negative")
 	}
+	if cfg.FeeMarketWindow < 0 {
+		return errors.New("fee-market-window can't be negative")
+	}
+	if cfg.SummaryGossipInterval < 0 {
+		return errors.New("summary-gossip-interval can't be negative")
+	}
+	if cfg.MaxInFlightCheckTx < 0 {
+		return errors.New("max-inflight-checktx can't be negative")
+	}
+	if _, err := cfg.TxBytesLimits(); err != nil {
+		return fmt.Errorf("max-tx-bytes-by-type: %w", err)
+	}
+	if cfg.ReapMaxClassPercent < 0 || cfg.ReapMaxClassPercent > 100 {
+		return errors.New("reap-max-class-percent must be between 0 and 100")
+	}
+	if cfg.GossipFanout < 0 {
+		return errors.New("gossip-fanout can't be negative")
+	}
+	if cfg.GossipDelayJitter < 0 {
+		return errors.New("gossip-delay-jitter can't be negative")
+	}
+	if cfg.GossipDedupWindow < 0 {
+		return errors.New("gossip-dedup-window can't be negative")
+	}
+	if cfg.MaxBroadcastRoutines < 0 {
+		return errors.New("max-broadcast-routines can't be negative")
+	}
+	if cfg.CommittedTxsWindow < 0 {
+		return errors.New("committed-txs-window can't be negative")
+	}
+	if len(cfg.BroadcastProxies()) > 0 && cfg.Type != MempoolTypeNop {
+		return errors.New("broadcast-proxy-addrs is only supported by the nop mempool")
+	}
 	return nil
 }
 
+// BroadcastProxies parses BroadcastProxyAddrs into the list of RPC addresses
+// the broadcast_tx RPCs are forwarded to.
+func (cfg *MempoolConfig) BroadcastProxies() []string {
+	var addrs []string
+	for _, addr := range strings.Split(cfg.BroadcastProxyAddrs, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+// TxBlobType is the transaction type of blob transactions in
+// MaxTxBytesByType.
+const TxBlobType = "blob"
+
+// TxBytesLimits parses MaxTxBytesByType into a map from transaction type,
+// TxBlobType or a lower case hex encoded prefix, to the maximum size in bytes.
+func (cfg *MempoolConfig) TxBytesLimits() (map[string]int, error) {
+	limits := make(map[string]int)
+	for _, entry := range strings.Split(cfg.MaxTxBytesByType, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		txType, maxBytes, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid entry %q, expected <type>=<max bytes>", entry)
+		}
+		txType = strings.ToLower(strings.TrimSpace(txType))
+		if txType != TxBlobType {
+			prefix, err := hex.DecodeString(txType)
+			if err != nil || len(prefix) == 0 {
+				return nil, fmt.Errorf("invalid type %q, expected %q or a hex encoded prefix", txType, TxBlobType)
+			}
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(maxBytes))
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid max bytes %q for type %q", maxBytes, txType)
+		}
+		if _, ok := limits[txType]; ok {
+			return nil, fmt.Errorf("duplicate type %q", txType)
+		}
+		limits[txType] = n
+	}
+	return limits, nil
+}
+
 //-----------------------------------------------------------------------------
 // StateSyncConfig
 
@@ -910,6 +1928,16 @@ type StateSyncConfig struct {
 	DiscoveryTime       time.Duration `mapstructure:"discovery_time"`
 	ChunkRequestTimeout time.Duration `mapstructure:"chunk_request_timeout"`
 	ChunkFetchers       int32         `mapstructure:"chunk_fetchers"`
+	// LocalSnapshot makes the node restore a snapshot the local application
+	// already has, e.g. copied from another node, before discovering
+	// snapshots from peers.
+	LocalSnapshot bool `mapstructure:"local_snapshot"`
+	// SnapshotInterval asks the application to take a snapshot every
+	// SnapshotInterval heights, through RequestCommit.Snapshot.
+	SnapshotInterval int64 `mapstructure:"snapshot_interval"`
+	// SnapshotTimeInterval asks the application to take a snapshot after the
+	// first block of every SnapshotTimeInterval period of block time.
+	SnapshotTimeInterval time.Duration `mapstructure:"snapshot_time_interval"`
 }
 
 func (cfg *StateSyncConfig) TrustHashBytes() []byte {
@@ -938,6 +1966,16 @@ func TestStateSyncConfig() *StateSyncConfig {
 
 // ValidateBasic performs basic validation.
 func (cfg *StateSyncConfig) ValidateBasic() error {
+	if cfg.SnapshotInterval < 0 {
+		return errors.New("snapshot_interval can't be negative")
+	}
+	if cfg.SnapshotTimeInterval < 0 {
+		return errors.New("snapshot_time_interval can't be negative")
+	}
+	if cfg.SnapshotTimeInterval > 0 && cfg.SnapshotTimeInterval < time.Minute {
+		return errors.New("snapshot_time_interval must be 0s or at least one minute")
+	}
+
 	if cfg.Enable {
 		if len(cfg.RPCServers) == 0 {
 			return errors.New("rpc_servers is required")
@@ -1018,6 +2056,54 @@ func (cfg *BlockSyncConfig) ValidateBasic() error {
 	}
 }
 
+//-----------------------------------------------------------------------------
+// BlockRelayConfig
+
+// BlockRelayConfig defines the configuration for the block relay service,
+// which pushes committed blocks to full nodes that follow the chain without
+// taking part in consensus.
+type BlockRelayConfig struct {
+	// Serve relays each block committed by this node to the peers subscribed
+	// to it.
+	Serve bool `mapstructure:"serve"`
+
+	// Subscribe makes the node follow the chain through the blocks relayed by
+	// its peers once block sync has caught up, instead of switching to
+	// consensus. Ignored while the node is a validator.
+	Subscribe bool `mapstructure:"subscribe"`
+
+	// Maximum number of peers served at once.
+	MaxSubscribers int `mapstructure:"max_subscribers"`
+}
+
+// DefaultBlockRelayConfig returns a default configuration for the block relay
+// service.
+func DefaultBlockRelayConfig() *BlockRelayConfig {
+	return &BlockRelayConfig{
+		Serve:          false,
+		Subscribe:      false,
+		MaxSubscribers: 20,
+	}
+}
+
+// TestBlockRelayConfig returns a default configuration for the block relay.
+func TestBlockRelayConfig() *BlockRelayConfig {
+	return DefaultBlockRelayConfig()
+}
+
+// Enabled returns true if the node serves or subscribes to relayed blocks.
+func (cfg *BlockRelayConfig) Enabled() bool {
+	return cfg.Serve || cfg.Subscribe
+}
+
+// ValidateBasic performs basic validation.
+func (cfg *BlockRelayConfig) ValidateBasic() error {
+	if cfg.MaxSubscribers < 0 {
+		return errors.New("max_subscribers can't be negative")
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // ConsensusConfig
 
@@ -1032,6 +2118,11 @@ type ConsensusConfig struct {
 	OnlyInternalWal bool   `mapstructure:"only_internal_wal"`
 	WalPath         string `mapstructure:"wal_file"`
 	walFile         string // overrides WalPath if set
+	// WalReplicaPath, if set, is the location of a copy of the WAL which is
+	// kept up to date asynchronously, e.g. on another disk or a network file
+	// system shared with a warm-standby validator.
+	// Default: ""
+	WalReplicaPath string `mapstructure:"wal_replica_file"`
 
 	// How long we wait for a proposal block before prevoting nil
 	TimeoutPropose time.Duration `mapstructure:"timeout_propose"`
@@ -1062,7 +2153,34 @@ type ConsensusConfig struct {
 	PeerGossipSleepDuration     time.Duration `mapstructure:"peer_gossip_sleep_duration"`
 	PeerQueryMaj23SleepDuration time.Duration `mapstructure:"peer_query_maj23_sleep_duration"`
 
+	// GossipStrategy selects how proposal block parts are gossiped to peers
+	// running the same strategy: "push", "rarest-first" or "pull". Block
+	// parts are pushed to other peers.
+	GossipStrategy string `mapstructure:"gossip_strategy"`
+
+	// LazyEmptyProposals sends peers a compact message instead of the parts of
+	// empty proposal blocks, when the consensus params allow it. Peers rebuild
+	// the block from it, or get the parts after LazyEmptyProposalTimeout.
+	LazyEmptyProposals       bool          `mapstructure:"lazy_empty_proposals"`
+	LazyEmptyProposalTimeout time.Duration `mapstructure:"lazy_empty_proposal_timeout"`
+
 	DoubleSignCheckHeight int64 `mapstructure:"double_sign_check_height"`
+
+	// InvariantChecks validates the internal consistency of the consensus
+	// state at runtime: the locked block has a polka, the seen commits are
+	// signed by the validator set, and the WAL heights increase. On a
+	// violation, "alert" logs an error and increments a metric, while "halt"
+	// also stops the consensus state machine. "off" disables the checks.
+	InvariantChecks string `mapstructure:"invariant_checks"`
+
+	// Testing params, only honored by binaries built with the e2e build tag.
+	// Delay the broadcast of our own prevotes and precommits, adding a jitter
+	// of up to TestVoteJitter derived from TestVoteJitterSeed and the height,
+	// round and type of the vote, so that runs are reproducible.
+	TestPrevoteDelay   time.Duration `mapstructure:"test_prevote_delay"`
+	TestPrecommitDelay time.Duration `mapstructure:"test_precommit_delay"`
+	TestVoteJitter     time.Duration `mapstructure:"test_vote_jitter"`
+	TestVoteJitterSeed int64         `mapstructure:"test_vote_jitter_seed"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service
@@ -1082,7 +2200,11 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		CreateEmptyBlocksInterval:   0 * time.Second,
 		PeerGossipSleepDuration:     100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration: 2000 * time.Millisecond,
+		GossipStrategy:              GossipStrategyPush,
+		LazyEmptyProposals:          true,
+		LazyEmptyProposalTimeout:    300 * time.Millisecond,
 		DoubleSignCheckHeight:       int64(0),
+		InvariantChecks:             InvariantChecksOff,
 	}
 }
 
@@ -1101,6 +2223,7 @@ func TestConsensusConfig() *ConsensusConfig {
 	cfg.SkipTimeoutCommit = true
 	cfg.PeerGossipSleepDuration = 5 * time.Millisecond
 	cfg.PeerQueryMaj23SleepDuration = 250 * time.Millisecond
+	cfg.LazyEmptyProposalTimeout = 20 * time.Millisecond
 	cfg.DoubleSignCheckHeight = int64(0)
 	return cfg
 }
@@ -1171,6 +2294,21 @@ func (cfg *ConsensusConfig) WalFile() string {
 	return rootify(cfg.WalPath, cfg.RootDir)
 }
 
+// OwnPerformanceFile returns the full path to the file persisting the counts
+// of the proposals and votes of the validator which were not sent on time.
+func (cfg *ConsensusConfig) OwnPerformanceFile() string {
+	return rootify(filepath.Join(DefaultDataDir, "cs_own_performance.json"), cfg.RootDir)
+}
+
+// WalReplicaFile returns the full path to the write-ahead log replica, or an
+// empty string if the WAL is not replicated.
+func (cfg *ConsensusConfig) WalReplicaFile() string {
+	if cfg.WalReplicaPath == "" {
+		return ""
+	}
+	return rootify(cfg.WalReplicaPath, cfg.RootDir)
+}
+
 // SetWalFile sets the path to the write-ahead log file
 func (cfg *ConsensusConfig) SetWalFile(walFile string) {
 	cfg.walFile = walFile
@@ -1209,9 +2347,33 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.PeerQueryMaj23SleepDuration < 0 {
 		return errors.New("peer_query_maj23_sleep_duration can't be negative")
 	}
+	switch cfg.GossipStrategy {
+	case GossipStrategyPush, GossipStrategyRarestFirst, GossipStrategyPull:
+	case "": // allow empty string to be backwards compatible
+	default:
+		return fmt.Errorf("unknown gossip_strategy: %q", cfg.GossipStrategy)
+	}
+	if cfg.LazyEmptyProposalTimeout < 0 {
+		return errors.New("lazy_empty_proposal_timeout can't be negative")
+	}
 	if cfg.DoubleSignCheckHeight < 0 {
 		return errors.New("double_sign_check_height can't be negative")
 	}
+	switch cfg.InvariantChecks {
+	case InvariantChecksOff, InvariantChecksAlert, InvariantChecksHalt:
+	case "": // allow empty string to be backwards compatible
+	default:
+		return fmt.Errorf("unknown invariant_checks: %q", cfg.InvariantChecks)
+	}
+	if cfg.TestPrevoteDelay < 0 {
+		return errors.New("test_prevote_delay can't be negative")
+	}
+	if cfg.TestPrecommitDelay < 0 {
+		return errors.New("test_precommit_delay can't be negative")
+	}
+	if cfg.TestVoteJitter < 0 {
+		return errors.New("test_vote_jitter can't be negative")
+	}
 	return nil
 }
 
@@ -1225,6 +2387,11 @@ type StorageConfig struct {
 	// required for `/block_results` RPC queries, and to reindex events in the
 	// command-line tool.
 	DiscardABCIResponses bool `mapstructure:"discard_abci_responses"`
+
+	// Budget, in MB, of the in-memory cache of the block metas, commits,
+	// validator sets and consensus params read from the block store and state
+	// databases. The cache is disabled if zero.
+	ReadCacheSizeMB int64 `mapstructure:"read_cache_size_mb"`
 }
 
 // DefaultStorageConfig returns the default configuration options relating to
@@ -1232,6 +2399,7 @@ type StorageConfig struct {
 func DefaultStorageConfig() *StorageConfig {
 	return &StorageConfig{
 		DiscardABCIResponses: false,
+		ReadCacheSizeMB:      0,
 	}
 }
 
@@ -1240,7 +2408,17 @@ func DefaultStorageConfig() *StorageConfig {
 func TestStorageConfig() *StorageConfig {
 	return &StorageConfig{
 		DiscardABCIResponses: false,
+		ReadCacheSizeMB:      0,
+	}
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *StorageConfig) ValidateBasic() error {
+	if cfg.ReadCacheSizeMB < 0 {
+		return errors.New("read_cache_size_mb can't be negative")
 	}
+	return nil
 }
 
 // -----------------------------------------------------------------------------
@@ -1263,6 +2441,12 @@ type TxIndexConfig struct {
 	// The PostgreSQL connection configuration, the connection format:
 	// postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 	PsqlConn string `mapstructure:"psql-conn"`
+
+	// IndexResourceUsage indexes the resource usage report of each height
+	// with the block events, under the "resource_usage" event type. It has no
+	// effect unless Instrumentation.ResourceUsageEvents is enabled. Only
+	// supported by the "kv" indexer.
+	IndexResourceUsage bool `mapstructure:"index-resource-usage"`
 }
 
 // DefaultTxIndexConfig returns a default configuration for the transaction indexer.
@@ -1277,6 +2461,15 @@ func TestTxIndexConfig() *TxIndexConfig {
 	return DefaultTxIndexConfig()
 }
 
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *TxIndexConfig) ValidateBasic() error {
+	if cfg.IndexResourceUsage && cfg.Indexer != "kv" {
+		return fmt.Errorf("index-resource-usage is only supported by the kv indexer, not %q", cfg.Indexer)
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // InstrumentationConfig
 
@@ -1330,6 +2523,27 @@ type InstrumentationConfig struct {
 	// inuse_objects, inuse_space, goroutines, mutex_count, mutex_duration,
 	// block_count, block_duration.
 	PyroscopeProfileTypes []string `mapstructure:"pyroscope_profile_types"`
+
+	// StorageMetricsInterval is how often the size, key count and compaction
+	// statistics of the node's databases are collected. They are reported as
+	// Prometheus metrics and by the /storage_status RPC endpoint.
+	// 0 disables the collection.
+	StorageMetricsInterval time.Duration `mapstructure:"storage_metrics_interval"`
+
+	// ResourceUsageEvents enables the ResourceUsage event, published after
+	// each block is committed with the bytes gossiped per channel, the time
+	// spent in the application, the bytes written to the databases and the
+	// mempool churn since the previous block.
+	ResourceUsageEvents bool `mapstructure:"resource_usage_events"`
+
+	// TelemetryBeaconURL is the HTTP(S) endpoint the node periodically posts
+	// its anonymized statistics to, signed with its telemetry key: version, chain
+	// ID, height, peer count and whether it is catching up. Empty disables
+	// the beacon.
+	TelemetryBeaconURL string `mapstructure:"telemetry_beacon_url"`
+
+	// TelemetryBeaconInterval is how often the statistics are posted.
+	TelemetryBeaconInterval time.Duration `mapstructure:"telemetry_beacon_interval"`
 }
 
 // DefaultInstrumentationConfig returns a default configuration for metrics
@@ -1357,6 +2571,10 @@ func DefaultInstrumentationConfig() *InstrumentationConfig {
 			"block_count",
 			"block_duration",
 		},
+		StorageMetricsInterval:  time.Minute,
+		ResourceUsageEvents:     false,
+		TelemetryBeaconURL:      "",
+		TelemetryBeaconInterval: time.Hour,
 	}
 }
 
@@ -1372,9 +2590,24 @@ func (cfg *InstrumentationConfig) ValidateBasic() error {
 	if cfg.MaxOpenConnections < 0 {
 		return errors.New("max_open_connections can't be negative")
 	}
+	if cfg.StorageMetricsInterval < 0 {
+		return errors.New("storage_metrics_interval can't be negative")
+	}
 	if cfg.PyroscopeTrace && cfg.PyroscopeURL == "" {
 		return errors.New("pyroscope_trace can't be enabled if profiling is disabled")
 	}
+	if cfg.TelemetryBeaconURL != "" {
+		u, err := url.Parse(cfg.TelemetryBeaconURL)
+		if err != nil {
+			return fmt.Errorf("invalid telemetry_beacon_url: %w", err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("telemetry_beacon_url must be an http or https URL, got %q", cfg.TelemetryBeaconURL)
+		}
+		if cfg.TelemetryBeaconInterval < time.Minute {
+			return errors.New("telemetry_beacon_interval can't be less than 1m")
+		}
+	}
 	// if there is not TracePushConfig configured, then we do not need to validate the rest
 	// of the config because we are not connecting.
 	if cfg.TracePushConfig == "" {
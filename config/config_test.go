@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -70,6 +71,31 @@ func TestBaseConfigValidateBasic(t *testing.T) {
 	// tamper with log format
 	cfg.LogFormat = "invalid"
 	assert.Error(t, cfg.ValidateBasic())
+	cfg.LogFormat = config.LogFormatPlain
+
+	cfg.ABCIMaxEventsPerTx = -1
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.ABCIMaxEventsPerTx = 0
+	cfg.ABCIMaxEventAttributeValueBytes = -1
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.ABCIMaxEventAttributeValueBytes = 0
+
+	cfg.ProxyAppFailover = []string{"tcp://127.0.0.1:26668"}
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.ProxyAppFailover = []string{"tcp://127.0.0.1:26668", ""}
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.ProxyAppFailover = nil
+
+	hash := strings.Repeat("ab", 32)
+	cfg.GenesisURL = "https://example.com/genesis.json"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.GenesisSHA256 = hash
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.GenesisURL = "http://example.com/genesis.json"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.GenesisURL = ""
+	cfg.GenesisSHA256 = hash[2:]
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestRPCConfigValidateBasic(t *testing.T) {
@@ -85,6 +111,11 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 		"MaxBodyBytes",
 		"MaxHeaderBytes",
 		"MaxRequestBatchSize",
+		"TxRequestIDWindow",
+		"MaxTxRequestIDs",
+		"LoadSheddingConsensusLatency",
+		"LoadSheddingRound",
+		"LoadSheddingMaxBlockResultsTxs",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -102,16 +133,79 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		"MaxNumInboundPeers",
 		"MaxNumOutboundPeers",
 		"FlushThrottleTimeout",
-		"MaxPacketMsgPayloadSize",
 		"SendRate",
 		"RecvRate",
+		"MinSendRate",
+		"MaxSendRate",
+		"ChannelBufferBudget",
+		"ClockSkewThreshold",
+		"MaxPeersPerSubnet",
+		"MaxPeersPerASN",
+		"InboundConnBanDuration",
+		"SeedGracePeriod",
 	}
 
+	cfg.MaxPacketMsgPayloadSize = config.MinMaxPacketMsgPayloadSize - 1
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.MaxPacketMsgPayloadSize = config.MinMaxPacketMsgPayloadSize
+
 	for _, fieldName := range fieldsToTest {
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(-1)
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.AdaptiveSendRate = true
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.MinSendRate, cfg.MaxSendRate = 2, 1
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.MaxSendRate = 2
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.AdaptiveSendRate = false
+
+	cfg.Transport = config.P2PTransportQUIC
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.Transport = "udp"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.Transport = config.P2PTransportTCP
+
+	cfg.ProxyAddress = "127.0.0.1:9050"
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.Transport = config.P2PTransportQUIC
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.Transport = config.P2PTransportTCP
+	cfg.ProxyAddress = "127.0.0.1"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.ProxyAddress = ""
+
+	cfg.AllowedSubnets = "10.0.0.0/8, 2001:db8::/32,192.0.2.1"
+	cfg.BlockedSubnets = "10.1.0.0/16"
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.AllowedSubnets = "10.0.0.0/33"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.AllowedSubnets = ""
+	cfg.BlockedSubnets = "10.1.0"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.BlockedSubnets = ""
+
+	cfg.InboundConnRate = 1
+	cfg.InboundSubnetConnRate = 4
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.InboundConnBurst = 0
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.InboundConnBurst = 10
+	cfg.InboundSubnetConnBurst = 0
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.InboundSubnetConnBurst = 40
+	cfg.InboundConnRate = -1
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.InboundConnRate = 0
+	cfg.InboundSubnetConnRate = 0
+
+	cfg.MinPeerScore = 1
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.MinPeerScore = 0
+	assert.NoError(t, cfg.ValidateBasic())
 }
 
 func TestMempoolConfigValidateBasic(t *testing.T) {
@@ -121,8 +215,14 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 	fieldsToTest := []string{
 		"Size",
 		"MaxTxsBytes",
+		"MaxMemoryBytes",
 		"CacheSize",
 		"MaxTxBytes",
+		"ReapMaxClassPercent",
+		"GossipFanout",
+		"GossipDelayJitter",
+		"GossipDedupWindow",
+		"MaxBroadcastRoutines",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -135,9 +235,45 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 	assert.Error(t, cfg.ValidateBasic())
 }
 
+func TestMempoolConfigTxBytesLimits(t *testing.T) {
+	cfg := config.TestMempoolConfig()
+	cfg.MaxTxBytesByType = " blob=2000, 0A=10,"
+	limits, err := cfg.TxBytesLimits()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{config.TxBlobType: 2000, "0a": 10}, limits)
+
+	for _, invalid := range []string{"blob", "blob=-1", "zz=10", "=10", "0a=1,0A=2"} {
+		cfg.MaxTxBytesByType = invalid
+		assert.Error(t, cfg.ValidateBasic(), invalid)
+	}
+}
+
+func TestMempoolConfigBroadcastProxies(t *testing.T) {
+	cfg := config.TestMempoolConfig()
+	assert.Empty(t, cfg.BroadcastProxies())
+
+	cfg.BroadcastProxyAddrs = " tcp://10.0.0.1:26657, ,https://rpc.example.com:443"
+	assert.Equal(t, []string{"tcp://10.0.0.1:26657", "https://rpc.example.com:443"}, cfg.BroadcastProxies())
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.Type = config.MempoolTypeNop
+	assert.NoError(t, cfg.ValidateBasic())
+}
+
 func TestStateSyncConfigValidateBasic(t *testing.T) {
 	cfg := config.TestStateSyncConfig()
 	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.SnapshotInterval = 100
+	cfg.SnapshotTimeInterval = 6 * time.Hour
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.SnapshotTimeInterval = time.Second
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.SnapshotTimeInterval = 0
+	cfg.SnapshotInterval = -1
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestBlockSyncConfigValidateBasic(t *testing.T) {
@@ -152,6 +288,18 @@ func TestBlockSyncConfigValidateBasic(t *testing.T) {
 	assert.Error(t, cfg.ValidateBasic())
 }
 
+func TestBlockRelayConfigValidateBasic(t *testing.T) {
+	cfg := config.TestBlockRelayConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.False(t, cfg.Enabled())
+
+	cfg.Subscribe = true
+	assert.True(t, cfg.Enabled())
+
+	cfg.MaxSubscribers = -1
+	assert.Error(t, cfg.ValidateBasic())
+}
+
 func TestConsensusConfig_ValidateBasic(t *testing.T) {
 	//nolint: lll
 	testcases := map[string]struct {
@@ -177,6 +325,12 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"PeerQueryMaj23SleepDuration":          {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
 		"PeerQueryMaj23SleepDuration negative": {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = -1 }, true},
 		"DoubleSignCheckHeight negative":       {func(c *config.ConsensusConfig) { c.DoubleSignCheckHeight = -1 }, true},
+		"GossipStrategy":                       {func(c *config.ConsensusConfig) { c.GossipStrategy = config.GossipStrategyPull }, false},
+		"GossipStrategy unknown":               {func(c *config.ConsensusConfig) { c.GossipStrategy = "flood" }, true},
+		"LazyEmptyProposalTimeout":             {func(c *config.ConsensusConfig) { c.LazyEmptyProposalTimeout = time.Second }, false},
+		"LazyEmptyProposalTimeout negative":    {func(c *config.ConsensusConfig) { c.LazyEmptyProposalTimeout = -1 }, true},
+		"InvariantChecks":                      {func(c *config.ConsensusConfig) { c.InvariantChecks = config.InvariantChecksHalt }, false},
+		"InvariantChecks unknown":              {func(c *config.ConsensusConfig) { c.InvariantChecks = "panic" }, true},
 	}
 	for desc, tc := range testcases {
 		tc := tc // appease linter
@@ -201,6 +355,38 @@ func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	// tamper with maximum open connections
 	cfg.MaxOpenConnections = -1
 	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = config.TestInstrumentationConfig()
+	cfg.TelemetryBeaconURL = "https://beacon.example.com/report"
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.TelemetryBeaconInterval = time.Second
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.TelemetryBeaconInterval = time.Hour
+	for _, invalid := range []string{"beacon.example.com", "ftp://beacon.example.com", "https://", "http://%zz"} {
+		cfg.TelemetryBeaconURL = invalid
+		assert.Error(t, cfg.ValidateBasic(), invalid)
+	}
+}
+
+func TestStorageConfigValidateBasic(t *testing.T) {
+	cfg := config.TestStorageConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.ReadCacheSizeMB = -1
+	assert.Error(t, cfg.ValidateBasic())
+}
+
+func TestTxIndexConfigValidateBasic(t *testing.T) {
+	cfg := config.TestTxIndexConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.IndexResourceUsage = true
+	assert.NoError(t, cfg.ValidateBasic())
+
+	for _, indexer := range []string{"null", "psql"} {
+		cfg.Indexer = indexer
+		assert.Error(t, cfg.ValidateBasic(), indexer)
+	}
 }
 
 func TestProposeWithCustomTimeout(t *testing.T) {
@@ -248,3 +434,161 @@ func TestBlockstoreDir(t *testing.T) {
 	cfg.BlockstorePath = "/opt/blockstore"
 	assert.Equal(t, "/opt/blockstore", cfg.BlockstoreDir())
 }
+
+func TestRPCConfigSubscriptionQuotas(t *testing.T) {
+	cfg := config.DefaultRPCConfig()
+	quotas, err := cfg.SubscriptionQuotas()
+	require.NoError(t, err)
+	assert.Empty(t, quotas)
+
+	id := "3F6C7A0D1E2B3C4D5E6F708192A3B4C5D6E7F801"
+	cfg.AuthenticatedSubscriptionQuotas = id + "=100, "
+	quotas, err = cfg.SubscriptionQuotas()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{strings.ToLower(id): 100}, quotas)
+	require.NoError(t, cfg.ValidateBasic())
+
+	for _, invalid := range []string{id, "abcd=1", id + "=-1", id + "=1," + id + "=2"} {
+		cfg.AuthenticatedSubscriptionQuotas = invalid
+		assert.Error(t, cfg.ValidateBasic(), invalid)
+	}
+}
+
+func TestRPCConfigQueryRoutes(t *testing.T) {
+	cfg := config.DefaultRPCConfig()
+	routes, err := cfg.QueryRoutes()
+	require.NoError(t, err)
+	assert.Empty(t, routes)
+
+	cfg.ABCIQueryRoutes = "/store=1s/10, /store/bank=0s/0,"
+	routes, err = cfg.QueryRoutes()
+	require.NoError(t, err)
+	assert.Equal(t, []config.ABCIQueryRoute{
+		{Prefix: "/store/bank"},
+		{Prefix: "/store", Timeout: time.Second, MaxConcurrent: 10},
+	}, routes)
+	require.NoError(t, cfg.ValidateBasic())
+
+	for _, invalid := range []string{"/store", "/store=1s", "/store=x/1", "/store=1s/-1", "/store=-1s/1", "/a=1s/1,/a=2s/2"} {
+		cfg.ABCIQueryRoutes = invalid
+		assert.Error(t, cfg.ValidateBasic(), invalid)
+	}
+}
+
+func TestRPCConfigVirtualHosts(t *testing.T) {
+	public := func() *config.RPCVirtualHost {
+		return &config.RPCVirtualHost{
+			Name:            "public",
+			ListenAddresses: []string{"tcp://127.0.0.1:26657"},
+			Methods:         []string{"status", "block*"},
+			RateLimit:       10,
+			RateBurst:       20,
+		}
+	}
+	cfg := config.DefaultRPCConfig()
+	cfg.ListenAddress = "tcp://127.0.0.1:26657, tcp://127.0.0.1:26667"
+	assert.Equal(t, []string{"tcp://127.0.0.1:26657", "tcp://127.0.0.1:26667"}, cfg.ListenAddresses())
+	cfg.VirtualHosts = []*config.RPCVirtualHost{public(), {Name: "internal", Hosts: []string{"rpc.internal"}}}
+	require.NoError(t, cfg.ValidateBasic())
+
+	vh := public()
+	assert.True(t, vh.ServesMethod("status"))
+	assert.True(t, vh.ServesMethod("block_results"))
+	assert.False(t, vh.ServesMethod("net_info"))
+	assert.True(t, cfg.VirtualHosts[1].ServesMethod("net_info"))
+
+	testCases := map[string]func(*config.RPCVirtualHost){
+		"empty name":         func(vh *config.RPCVirtualHost) { vh.Name = "" },
+		"nothing served":     func(vh *config.RPCVirtualHost) { vh.ListenAddresses = nil },
+		"unknown laddr":      func(vh *config.RPCVirtualHost) { vh.ListenAddresses = []string{"tcp://0.0.0.0:1"} },
+		"host with port":     func(vh *config.RPCVirtualHost) { vh.Hosts = []string{"rpc.internal:80"} },
+		"duplicate host":     func(vh *config.RPCVirtualHost) { vh.Hosts = []string{"RPC.internal"} },
+		"negative rate":      func(vh *config.RPCVirtualHost) { vh.RateLimit = -1 },
+		"rate without burst": func(vh *config.RPCVirtualHost) { vh.RateBurst = 0 },
+		"duplicate name":     func(vh *config.RPCVirtualHost) { vh.Name = "internal" },
+	}
+	for name, modify := range testCases {
+		vh := public()
+		modify(vh)
+		cfg.VirtualHosts = []*config.RPCVirtualHost{vh, {Name: "internal", Hosts: []string{"rpc.internal"}}}
+		assert.Error(t, cfg.ValidateBasic(), name)
+	}
+
+	cfg.VirtualHosts = []*config.RPCVirtualHost{public(), public()}
+	cfg.VirtualHosts[1].Name = "other"
+	assert.Error(t, cfg.ValidateBasic(), "duplicate laddr")
+}
+
+func TestP2PConfigChannelRecvLimits(t *testing.T) {
+	cfg := config.DefaultP2PConfig()
+	limits, err := cfg.ChannelRecvLimits()
+	require.NoError(t, err)
+	assert.Empty(t, limits)
+
+	cfg.ChannelRecvRateLimits = "0x30=100/1048576, 64=0/1000,"
+	limits, err = cfg.ChannelRecvLimits()
+	require.NoError(t, err)
+	assert.Equal(t, map[byte]config.ChannelRecvLimit{
+		0x30: {MsgsPerSecond: 100, BytesPerSecond: 1048576},
+		0x40: {BytesPerSecond: 1000},
+	}, limits)
+	require.NoError(t, cfg.ValidateBasic())
+
+	for _, invalid := range []string{"0x30", "0x30=1", "0x100=1/1", "x=1/1", "0x30=-1/1", "0x30=1/-1", "0x30=1/1,48=2/2"} {
+		cfg.ChannelRecvRateLimits = invalid
+		assert.Error(t, cfg.ValidateBasic(), invalid)
+	}
+
+	cfg.ChannelRecvRateLimits = ""
+	cfg.ChannelRecvRateLimitAction = "drop"
+	assert.Error(t, cfg.ValidateBasic())
+}
+
+func TestApplyP2PProfile(t *testing.T) {
+	cfg := config.DefaultConfig()
+	applied, err := cfg.ApplyP2PProfile()
+	require.NoError(t, err)
+	assert.Same(t, cfg, applied)
+
+	cfg.P2P.Profile = config.P2PProfileSeed
+	// settings changed from their defaults take precedence
+	cfg.P2P.MaxNumOutboundPeers = 7
+	cfg.Mempool.Broadcast = false
+	require.NoError(t, cfg.P2P.ValidateBasic())
+	applied, err = cfg.ApplyP2PProfile()
+	require.NoError(t, err)
+	assert.Equal(t, 200, applied.P2P.MaxNumInboundPeers)
+	assert.Equal(t, 7, applied.P2P.MaxNumOutboundPeers)
+	assert.True(t, applied.P2P.SeedMode)
+	assert.False(t, applied.Mempool.Broadcast)
+	// the configuration itself is left unchanged
+	assert.Equal(t, config.DefaultP2PConfig().MaxNumInboundPeers, cfg.P2P.MaxNumInboundPeers)
+	assert.False(t, cfg.P2P.SeedMode)
+
+	cfg = config.DefaultConfig()
+	cfg.P2P.Profile = config.P2PProfileValidator
+	applied, err = cfg.ApplyP2PProfile()
+	require.NoError(t, err)
+	assert.Equal(t, 10, applied.P2P.MaxNumInboundPeers)
+	assert.Equal(t, 5, applied.Mempool.ExperimentalMaxGossipConnectionsToNonPersistentPeers)
+	assert.False(t, applied.P2P.SeedMode)
+
+	cfg.P2P.Profile = "sentry"
+	assert.Error(t, cfg.P2P.ValidateBasic())
+	_, err = cfg.ApplyP2PProfile()
+	assert.Error(t, err)
+}
+
+func TestApplySolo(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.ApplySolo()
+	assert.True(t, cfg.Consensus.CreateEmptyBlocks)
+	assert.True(t, cfg.P2P.PexReactor)
+
+	cfg.Solo = true
+	cfg.ApplySolo()
+	assert.False(t, cfg.Consensus.CreateEmptyBlocks)
+	assert.True(t, cfg.Consensus.SkipTimeoutCommit)
+	assert.False(t, cfg.P2P.PexReactor)
+	assert.False(t, cfg.Mempool.Broadcast)
+}
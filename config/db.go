@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"path/filepath"
 
 	dbm "github.com/cometbft/cometbft-db"
 
@@ -32,3 +33,21 @@ func DefaultDBProvider(ctx *DBContext) (dbm.DB, error) {
 	}
 	return dbm.NewDB(ctx.ID, dbType, path)
 }
+
+// DBPath returns the location on disk of the database DefaultDBProvider opens
+// for ctx, following the naming of the configured backend. It is empty for
+// the in-memory backend.
+func DBPath(ctx *DBContext) string {
+	path := ctx.Path
+	if path == "" {
+		path = ctx.Config.DBDir()
+	}
+	switch dbm.BackendType(ctx.Config.DBBackend) {
+	case dbm.MemDBBackend:
+		return ""
+	case dbm.BadgerDBBackend:
+		return filepath.Join(path, ctx.ID)
+	default:
+		return filepath.Join(path, ctx.ID+".db")
+	}
+}
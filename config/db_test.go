@@ -0,0 +1,24 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDBPath(t *testing.T) {
+	cfg := TestConfig()
+	cfg.SetRoot("/root")
+
+	cfg.DBBackend = "goleveldb"
+	assert.Equal(t, filepath.Join(cfg.DBDir(), "state.db"), DBPath(&DBContext{ID: "state", Config: cfg}))
+	assert.Equal(t, filepath.Join("/blocks", "blockstore.db"),
+		DBPath(&DBContext{ID: "blockstore", Config: cfg, Path: "/blocks"}))
+
+	cfg.DBBackend = "badgerdb"
+	assert.Equal(t, filepath.Join(cfg.DBDir(), "state"), DBPath(&DBContext{ID: "state", Config: cfg}))
+
+	cfg.DBBackend = "memdb"
+	assert.Empty(t, DBPath(&DBContext{ID: "state", Config: cfg}))
+}
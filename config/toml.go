@@ -86,6 +86,12 @@ version = "{{ .BaseConfig.Version }}"
 # or the name of an ABCI application compiled in with the CometBFT binary
 proxy_app = "{{ .BaseConfig.ProxyApp }}"
 
+# TCP or UNIX socket addresses of replicas of the ABCI application, in order
+# of priority, to fail over to if the connection to proxy_app is lost. The
+# node replays the blocks missing in the replica instead of stopping.
+# proxy_app must then be the address of a remote application as well.
+proxy_app_failover = [{{ range .BaseConfig.ProxyAppFailover }}{{ printf "%q, " . }}{{end}}]
+
 # A custom human readable name for this node
 moniker = "{{ .BaseConfig.Moniker }}"
 
@@ -127,6 +133,14 @@ log_format = "{{ .BaseConfig.LogFormat }}"
 # Path to the JSON file containing the initial validator set and other meta data
 genesis_file = "{{ js .BaseConfig.Genesis }}"
 
+# HTTPS URL to download the genesis file from at the first start, when the
+# file does not exist yet. The downloaded file is verified against
+# genesis_sha256 and cached at genesis_file.
+genesis_url = "{{ .BaseConfig.GenesisURL }}"
+
+# Hex encoded SHA-256 hash of the genesis file. Required with genesis_url.
+genesis_sha256 = "{{ .BaseConfig.GenesisSHA256 }}"
+
 # Path to the JSON file containing the private key to use as a validator in the consensus protocol
 priv_validator_key_file = "{{ js .BaseConfig.PrivValidatorKey }}"
 
@@ -137,9 +151,26 @@ priv_validator_state_file = "{{ js .BaseConfig.PrivValidatorState }}"
 # connections from an external PrivValidator process
 priv_validator_laddr = "{{ .BaseConfig.PrivValidatorListenAddr }}"
 
+# Maximum number of requests awaiting a response from the external
+# PrivValidator process at the same time. Values greater than 1 tag requests
+# with IDs, letting signers that support it sign concurrently (e.g. a proposal
+# and a vote), which reduces latency with HSM-backed signers.
+# 0 or 1 sends one request at a time.
+priv_validator_max_in_flight = {{ .BaseConfig.PrivValidatorMaxInFlight }}
+
+# EXPERIMENTAL. If true, the file-based PrivValidator can compute VRF proofs
+# (ECVRF over ed25519) with its key. Nothing in consensus uses them yet; this
+# lets forks experiment with VRF-based proposer selection.
+experimental_priv_validator_vrf = {{ .BaseConfig.PrivValidatorVRF }}
+
 # Path to the JSON file containing the private key to use for node authentication in the p2p protocol
 node_key_file = "{{ js .BaseConfig.NodeKey }}"
 
+# Path to the JSON file containing the private key signing the reports of the
+# telemetry beacon, generated if missing. It is distinct from the node key so
+# that the reports can't be linked to the p2p identity of the node.
+telemetry_key_file = "{{ js .BaseConfig.TelemetryKey }}"
+
 # Mechanism to connect to the ABCI application: socket | grpc
 abci = "{{ .BaseConfig.ABCI }}"
 
@@ -147,6 +178,22 @@ abci = "{{ .BaseConfig.ABCI }}"
 # so the app can decide if we should keep the connection or not
 filter_peers = {{ .BaseConfig.FilterPeers }}
 
+# Limits on the events of each transaction, and of the block, in the
+# FinalizeBlock responses of the ABCI application, bounding what is stored,
+# indexed and served by /block_results. The events exceeding them are dropped
+# with an error logged; the execution of the block is not affected. 0 disables
+# a limit.
+abci_max_events_per_tx = {{ .BaseConfig.ABCIMaxEventsPerTx }}
+abci_max_event_bytes_per_tx = {{ .BaseConfig.ABCIMaxEventBytesPerTx }}
+abci_max_event_attribute_key_bytes = {{ .BaseConfig.ABCIMaxEventAttributeKeyBytes }}
+abci_max_event_attribute_value_bytes = {{ .BaseConfig.ABCIMaxEventAttributeValueBytes }}
+
+# If true, run the node as the only validator of a local chain, e.g. to test
+# an application: p2p is disabled, and blocks are produced as soon as
+# transactions arrive (create_empty_blocks and skip_timeout_commit are
+# overridden). The node must be the only validator.
+solo = {{ .BaseConfig.Solo }}
+
 
 #######################################################################
 ###                 Advanced Configuration Options                  ###
@@ -206,6 +253,14 @@ max_subscription_clients = {{ .RPC.MaxSubscriptionClients }}
 # the estimated # maximum number of broadcast_tx_commit calls per block.
 max_subscriptions_per_client = {{ .RPC.MaxSubscriptionsPerClient }}
 
+# Comma separated list of <node ID>=<max subscriptions> entries, e.g.
+# "3f6c...e1a2=100". Websocket clients proving control of the key of a listed
+# node ID (a node key or an operator key) via the auth_challenge and
+# authenticate methods get the given subscription quota, shared by all of
+# their connections, and are not subject to max_subscription_clients.
+# Authentication is disabled if empty.
+authenticated_subscription_quotas = "{{ .RPC.AuthenticatedSubscriptionQuotas }}"
+
 # Experimental parameter to specify the maximum number of events a node will
 # buffer, per subscription, before returning an error and closing the
 # subscription. Must be set to at least 100, but higher values will accommodate
@@ -233,17 +288,57 @@ experimental_websocket_write_buffer_size = {{ .RPC.WebSocketWriteBufferSize }}
 # predictability in subscription behavior.
 experimental_close_on_slow_client = {{ .RPC.CloseOnSlowClient }}
 
+# Experimental load shedding for nodes serving RPC alongside validator duties.
+# While consensus is stressed, /tx_search and /block_search are rejected, as
+# is /block_results for blocks with more than
+# experimental_load_shedding_max_block_results_txs transactions.
+#
+# Consensus is stressed when it has been working on the current height for
+# longer than experimental_load_shedding_consensus_latency, or when it reached
+# round experimental_load_shedding_round. 0 disables the respective trigger.
+experimental_load_shedding_consensus_latency = "{{ .RPC.LoadSheddingConsensusLatency }}"
+experimental_load_shedding_round = {{ .RPC.LoadSheddingRound }}
+experimental_load_shedding_max_block_results_txs = {{ .RPC.LoadSheddingMaxBlockResultsTxs }}
+
 # How long to wait for a tx to be committed during /broadcast_tx_commit.
 # WARNING: Using a value larger than 10s will result in increasing the
 # global HTTP write timeout, which applies to all connections and endpoints.
 # See https://github.com/tendermint/tendermint/issues/3435
 timeout_broadcast_tx_commit = "{{ .RPC.TimeoutBroadcastTxCommit }}"
 
+# Comma separated list of <path prefix>=<timeout>/<max concurrent queries>
+# entries routing /abci_query requests by path, e.g.
+# "/store/bank=1s/20,/custom/expensive=10s/2", so that expensive application
+# queries cannot exhaust the query connection to the application. A query is
+# routed by the longest matching prefix, fails if it takes longer than the
+# timeout of its route, and is rejected if the route already runs its maximum
+# number of concurrent queries. A zero timeout or maximum means no limit.
+abci_query_routes = "{{ .RPC.ABCIQueryRoutes }}"
+
+# If true, /abci_query only serves paths matching one of abci_query_routes.
+# It does not apply to in-process clients.
+abci_query_allowlist = {{ .RPC.ABCIQueryAllowlist }}
+
 # Maximum number of requests that can be sent in a batch
 # If the value is set to '0' (zero-value), then no maximum batch size will be
 # enforced for a JSON-RPC batch request.
 max_request_batch_size = {{ .RPC.MaxRequestBatchSize }}
 
+# Maximum number of transactions that can be sent in a single
+# /broadcast_tx_batch request. If the value is set to '0' (zero-value), then
+# no maximum number of transactions will be enforced.
+max_tx_batch_size = {{ .RPC.MaxTxBatchSize }}
+
+# How long the result of a /broadcast_tx_{async,sync,commit} request with a
+# request_id is returned to the retries of the request, instead of submitting
+# the transaction again. A request ID used again with another transaction is
+# rejected. Set to 0 to ignore request IDs.
+tx_request_id_window = "{{ .RPC.TxRequestIDWindow }}"
+
+# Maximum number of request IDs whose results are remembered. Once reached,
+# the oldest results are forgotten before the end of their window.
+max_tx_request_ids = {{ .RPC.MaxTxRequestIDs }}
+
 # Maximum size of request body, in bytes
 max_body_bytes = {{ .RPC.MaxBodyBytes }}
 
@@ -266,8 +361,45 @@ tls_cert_file = "{{ .RPC.TLSCertFile }}"
 tls_key_file = "{{ .RPC.TLSKeyFile }}"
 
 # pprof listen address (https://golang.org/pkg/net/http/pprof)
+# It also serves /debug/reactors?seconds=10, which profiles the node for the
+# given number of seconds and reports the shares of CPU time and allocations
+# of each reactor.
 pprof_laddr = "{{ .RPC.PprofListenAddress }}"
 
+# Virtual hosts serving the RPC with their own restrictions to the requests on
+# some of the listen addresses of laddr, or for some hosts (matched against the
+# Host header), e.g. to expose a restricted public surface next to a richer
+# internal one. The other requests, including the ones for unknown hosts, are
+# served with the options above. Each virtual host is a [[rpc.virtual_hosts]]
+# table, which must come after the other options of the section:
+#
+# [[rpc.virtual_hosts]]
+# name = "public"
+# # Listen addresses all the requests of which are served by the virtual host
+# laddrs = []
+# # Host names, without port, served by the virtual host on the other addresses
+# hosts = ["rpc.example.com"]
+# # RPC methods served, or all the methods starting with a prefix if ending
+# # with "*". All if empty.
+# methods = ["status", "block*", "tx", "broadcast_tx_sync"]
+# # Maximum rate of the HTTP requests, websocket connections and requests over
+# # websocket of each client IP, per second, allowing bursts of up to
+# # rate_burst. No limit if 0.
+# rate_limit = 10.0
+# rate_burst = 20
+# # Origins a cross-domain request can be executed from. CORS is disabled if
+# # empty.
+# cors_allowed_origins = ["*"]
+{{ range .RPC.VirtualHosts }}
+[[rpc.virtual_hosts]]
+name = "{{ .Name }}"
+laddrs = [{{ range .ListenAddresses }}{{ printf "%q, " . }}{{end}}]
+hosts = [{{ range .Hosts }}{{ printf "%q, " . }}{{end}}]
+methods = [{{ range .Methods }}{{ printf "%q, " . }}{{end}}]
+rate_limit = {{ .RateLimit }}
+rate_burst = {{ .RateBurst }}
+cors_allowed_origins = [{{ range .CORSAllowedOrigins }}{{ printf "%q, " . }}{{end}}]
+{{ end }}
 #######################################################
 ###           P2P Configuration Options             ###
 #######################################################
@@ -281,12 +413,46 @@ laddr = "{{ .P2P.ListenAddress }}"
 # address. IP and port are required. Example: 159.89.10.97:26656
 external_address = "{{ .P2P.ExternalAddress }}"
 
+# Transport of the peer connections: "tcp" multiplexes the channels over an
+# encrypted TCP connection, "quic" carries each channel on its own QUIC
+# stream, over UDP on the port of laddr. Peers can only connect over the same
+# transport.
+transport = "{{ .P2P.Transport }}"
+
+# Address of a SOCKS5 proxy, such as Tor, to dial all outbound peer
+# connections through, hiding the location of the node. Required to dial the
+# onion addresses of peers (e.g. id@<56 characters>.onion:26656). Not
+# supported by the quic transport. Peers are dialed directly if empty.
+# Example: "127.0.0.1:9050"
+proxy_address = "{{ .P2P.ProxyAddress }}"
+
+# Role of the node: "validator", "full", "seed" or "archive". Each profile sets
+# inbound/outbound peer counts, the mempool gossip fanout
+# (mempool.broadcast and mempool.experimental_max_gossip_connections_*) and PEX
+# behavior (pex, seed_mode) suited to the role:
+#   validator: 10 inbound, 5 outbound, mempool gossip to at most 5 non-persistent peers
+#   full:      40 inbound, 10 outbound, mempool gossip to all peers
+#   seed:      200 inbound, 30 outbound, seed mode, no mempool gossip
+#   archive:   80 inbound, 20 outbound, mempool gossip to at most 10 non-persistent peers
+# The profile only replaces settings left at their default values: settings
+# changed from their defaults, in this file, by flag or by environment variable,
+# take precedence over it.
+# No profile is applied if empty.
+profile = "{{ .P2P.Profile }}"
+
 # Comma separated list of seed nodes to connect to
 seeds = "{{ .P2P.Seeds }}"
 
 # Comma separated list of nodes to keep persistent connections to
 persistent_peers = "{{ .P2P.PersistentPeers }}"
 
+# Path to a file pinning the node IDs of persistent peers to their addresses
+# (trust on first use). If the node ID configured for a pinned address
+# changes, e.g. because the peer's node key was replaced, the node refuses to
+# connect and logs an error until the entry is removed from the file.
+# Pinning is disabled if empty.
+peer_pinning_file = "{{ js .P2P.PeerPinning }}"
+
 # Path to address book
 addr_book_file = "{{ js .P2P.AddrBook }}"
 
@@ -303,13 +469,23 @@ max_num_outbound_peers = {{ .P2P.MaxNumOutboundPeers }}
 # List of node IDs, to which a connection will be (re)established ignoring any existing limits
 unconditional_peer_ids = "{{ .P2P.UnconditionalPeerIDs }}"
 
+# Comma separated list of node IDs of high-priority peers, typically other
+# validators. They are accepted regardless of the inbound and outbound peer
+# limits and do not take up slots, so they are never crowded out by peer churn.
+priority_peer_ids = "{{ .P2P.PriorityPeerIDs }}"
+
+# Factor applied to send_rate and recv_rate on connections to priority peers
+priority_peer_rate_multiplier = {{ .P2P.PriorityPeerRateMultiplier }}
+
 # Maximum pause when redialing a persistent peer (if zero, exponential backoff is used)
 persistent_peers_max_dial_period = "{{ .P2P.PersistentPeersMaxDialPeriod }}"
 
 # Time to wait before flushing messages out on the connection
 flush_throttle_timeout = "{{ .P2P.FlushThrottleTimeout }}"
 
-# Maximum size of a message packet payload, in bytes
+# Maximum size of a message packet payload, in bytes. The peers supporting
+# it negotiate the smaller of their sizes during the handshake. Can't be less
+# than 512.
 max_packet_msg_payload_size = {{ .P2P.MaxPacketMsgPayloadSize }}
 
 # Rate at which packets can be sent, in bytes/second
@@ -318,6 +494,57 @@ send_rate = {{ .P2P.SendRate }}
 # Rate at which packets can be received, in bytes/second
 recv_rate = {{ .P2P.RecvRate }}
 
+# Adapt the rate at which packets are sent on each connection, starting from
+# send_rate, to the capacity of the peer to receive, as observed from the
+# round-trip time of pings and the rate at which the connection drains, within
+# [min_send_rate, max_send_rate]
+adaptive_send_rate = {{ .P2P.AdaptiveSendRate }}
+min_send_rate = {{ .P2P.MinSendRate }}
+max_send_rate = {{ .P2P.MaxSendRate }}
+
+# Comma separated list of limits on the messages received from each peer on a
+# channel, as <channel ID>=<messages/s>/<bytes/s>, e.g. "0x30=100/1048576".
+# Zero means no limit. Channels not listed are not limited.
+channel_recv_rate_limits = "{{ .P2P.ChannelRecvRateLimits }}"
+
+# What to do with a peer exceeding a limit of channel_recv_rate_limits:
+# "throttle" stops reading from the peer until the rate is back under the
+# limit, "disconnect" disconnects from the peer.
+channel_recv_rate_limit_action = "{{ .P2P.ChannelRecvRateLimitAction }}"
+
+# Memory, in bytes, shared by the send queues and receive buffers of the
+# channels of all peer connections. It is split evenly between
+# max_num_inbound_peers + max_num_outbound_peers connections, and within a
+# connection between channels in proportion to the capacities declared by their
+# reactors. The resulting capacities are logged at startup.
+# If zero, the capacities declared by the reactors are used as is.
+channel_buffer_budget = {{ .P2P.ChannelBufferBudget }}
+
+# Maximum offset of the local clock relative to the median clock of the peers,
+# estimated during ping/pong, before a warning is logged, published as a
+# ClockSkew event and reported by /status. The offset is only judged once the
+# clocks of at least 3 peers are known. Clock skew checks are disabled if zero.
+clock_skew_threshold = "{{ .P2P.ClockSkewThreshold }}"
+
+# If true, the packets sent over peer connections are numbered and packets
+# received out of sequence, e.g. replayed, are rejected, independently of the
+# ordering provided by the encrypted connection. Only used with peers that
+# enable it too; other connections are unaffected.
+packet_sequence = {{ .P2P.PacketSequence }}
+
+# If true, the messages of the channels that benefit from it, e.g. transactions
+# and block parts, are compressed on the wire, trading CPU for bandwidth. Only
+# used with peers that enable it too; other connections are unaffected.
+compression = {{ .P2P.Compression }}
+
+# If true, each channel of a peer connection is carried by its own yamux stream,
+# with its own flow control, instead of all channels sharing a single framed
+# stream: a channel whose messages a peer is slow to process does not hold back
+# the others. Only used with peers that enable it too; other connections are
+# unaffected. Not used by the quic transport, whose channels have their own
+# streams already.
+yamux = {{ .P2P.Yamux }}
+
 # Set true to enable the peer-exchange reactor
 pex = {{ .P2P.PexReactor }}
 
@@ -327,12 +554,75 @@ pex = {{ .P2P.PexReactor }}
 # Does not work if the peer-exchange reactor is disabled.
 seed_mode = {{ .P2P.SeedMode }}
 
+# Time a seed keeps the peers connecting to it connected after answering their
+# request for addresses. If zero, the seed disconnects right away.
+seed_grace_period = "{{ .P2P.SeedGracePeriod }}"
+
+# Set true for a seed to send its latest height and header to the peers
+# connecting to it, so they learn the height of the network while bootstrapping.
+seed_serve_status = {{ .P2P.SeedServeStatus }}
+
 # Comma separated list of peer IDs to keep private (will not be gossiped to other peers)
 private_peer_ids = "{{ .P2P.PrivatePeerIDs }}"
 
 # Toggle to disable guard against peers connecting from the same ip.
 allow_duplicate_ip = {{ .P2P.AllowDuplicateIP }}
 
+# Comma separated list of subnets, in CIDR notation or as single IPs, inbound
+# connections are accepted from, before the handshake. All subnets are allowed
+# if empty. Can be changed at runtime with the dial_control RPC.
+# Example: "10.0.0.0/8,2001:db8::/32,192.0.2.1"
+allowed_subnets = "{{ .P2P.AllowedSubnets }}"
+
+# Comma separated list of subnets, in CIDR notation or as single IPs, inbound
+# connections are rejected from, before the handshake, even if allowed by
+# allowed_subnets. Can be changed at runtime with the dial_control RPC.
+blocked_subnets = "{{ .P2P.BlockedSubnets }}"
+
+# Maximum rate of the inbound connection attempts from each IP, per second,
+# with bursts of up to inbound_conn_burst attempts, to mitigate connection
+# churn against public nodes. Attempts over the rate are closed right after
+# being accepted, before the handshake. No limit if zero.
+inbound_conn_rate = {{ .P2P.InboundConnRate }}
+inbound_conn_burst = {{ .P2P.InboundConnBurst }}
+
+# Maximum rate of the inbound connection attempts from each /24 IPv4 or /48
+# IPv6 subnet, per second, with bursts of up to inbound_subnet_conn_burst
+# attempts. No limit if zero.
+inbound_subnet_conn_rate = {{ .P2P.InboundSubnetConnRate }}
+inbound_subnet_conn_burst = {{ .P2P.InboundSubnetConnBurst }}
+
+# Duration for which an IP or subnet exceeding its inbound connection rate is
+# banned, all its connection attempts being rejected. No ban if zero.
+inbound_conn_ban_duration = "{{ .P2P.InboundConnBanDuration }}"
+
+# Maximum number of peers in the same /24 IPv4 or /48 IPv6 subnet, enforced
+# when accepting and dialing peers, to reduce the risk of being eclipsed by a
+# single hosting network. Persistent, unconditional and priority peers are
+# exempt. No limit if zero.
+max_peers_per_subnet = {{ .P2P.MaxPeersPerSubnet }}
+
+# Maximum number of peers in the same autonomous system, as resolved using
+# asn_database_file, enforced when accepting and dialing peers. Persistent,
+# unconditional and priority peers are exempt. No limit if zero.
+max_peers_per_asn = {{ .P2P.MaxPeersPerASN }}
+
+# Path to a CSV file mapping IP networks to autonomous system numbers, such as
+# the GeoLite2 ASN CSV database (GeoLite2-ASN-Blocks-IPv4.csv and
+# GeoLite2-ASN-Blocks-IPv6.csv concatenated): one "<network CIDR>,<ASN>[,...]"
+# record per line, with an optional header.
+# Required if max_peers_per_asn is set.
+asn_database_file = "{{ js .P2P.ASNDatabase }}"
+
+# Score below which peers are disconnected, and not dialed or accepted until
+# their score recovers. Peers start at zero; their score is raised by the
+# blocks and transactions they deliver and lowered by their invalid messages,
+# disconnects for misbehavior and latency, each event weighing less as time
+# passes. The scores of the peers, and the peers dropped recently, are reported
+# by /net_info. Persistent, unconditional and priority peers are exempt.
+# Peer scoring is disabled if zero.
+min_peer_score = {{ .P2P.MinPeerScore }}
+
 # Peer connection configuration.
 handshake_timeout = "{{ .P2P.HandshakeTimeout }}"
 dial_timeout = "{{ .P2P.DialTimeout }}"
@@ -392,6 +682,14 @@ size = {{ .Mempool.Size }}
 # max_txs_bytes=5MB, mempool will only accept 5 transactions).
 max_txs_bytes = {{ .Mempool.MaxTxsBytes }}
 
+# Limit the estimated memory used by the txs in the mempool, i.e. the raw
+# transactions plus their metadata, indexes and cache entries. The estimate
+# leaves out the sets of peers each tx was received from, so the heap usage can
+# exceed it. When the limit is reached, the priority and CAT mempools evict
+# lower-priority transactions to make room for new ones, while the flood
+# mempool rejects new ones. 0 means no limit other than max_txs_bytes.
+max_memory_bytes = {{ .Mempool.MaxMemoryBytes }}
+
 # Size of the cache (used to filter transactions we saw earlier) in transactions
 cache_size = {{ .Mempool.CacheSize }}
 
@@ -446,6 +744,89 @@ experimental_max_gossip_connections_to_non_persistent_peers = {{ .Mempool.Experi
 # Default is 200ms
 max-gossip-delay = "{{ .Mempool.MaxGossipDelay }}"
 
+# fee-market-window is the number of heights over which admitted and committed
+# transaction priorities are tracked for the /fee_market RPC endpoint.
+# Only applicable to the priority and CAT mempools
+# Default is 20
+fee-market-window = {{ .Mempool.FeeMarketWindow }}
+
+# summary-gossip-interval, if non-zero, defines how often a summary of the
+# mempool (size and admission priority) is sent to peers, which use it to skip
+# broadcasting transactions that would not be admitted while the mempool is full.
+# All peers must understand the summary message, so it is disabled by default.
+# Only applicable to the priority mempool
+summary-gossip-interval = "{{ .Mempool.SummaryGossipInterval }}"
+
+# max-inflight-checktx is the maximum number of new transactions awaiting their
+# ABCI CheckTx response at any time. Requests are pipelined to the application
+# up to this limit, so ingestion is not capped by the round-trip latency.
+# Only applicable to the priority mempool
+# Default is 1000
+max-inflight-checktx = {{ .Mempool.MaxInFlightCheckTx }}
+
+# max-tx-bytes-by-type limits the size of transactions by type before they are
+# passed to CheckTx, so oversized transactions don't consume ABCI bandwidth.
+# Comma separated list of <type>=<max bytes> pairs, where <type> is either
+# "blob" for blob transactions or a hex encoded prefix of the raw transaction
+# bytes. The longest matching prefix applies. Transactions matching no type
+# are only subject to max_tx_bytes.
+# Example: "blob=2000000,0a=10000"
+max-tx-bytes-by-type = "{{ .Mempool.MaxTxBytesByType }}"
+
+# reap-max-class-percent, if non-zero, is the maximum percentage of the bytes
+# reaped for a block proposal taken by transactions of the same class, so that
+# a single spammer cannot fill the whole candidate set passed to
+# PrepareProposal. Transactions are classified by the sender returned by
+# CheckTx; transactions without a sender are not limited.
+reap-max-class-percent = {{ .Mempool.ReapMaxClassPercent }}
+
+# gossip-fanout, if non-zero, is the number of peers a new transaction is
+# pushed to immediately, trading propagation latency for bandwidth. The other
+# peers get it lazily: the flood mempool pushes it to them after
+# gossip-dedup-window, unless they relayed it to us first, and the CAT mempool
+# announces it to them so that they request it if they still need it.
+# Only applicable to the flood and CAT mempools.
+gossip-fanout = {{ .Mempool.GossipFanout }}
+
+# gossip-delay-jitter is the maximum random delay added before relaying a
+# transaction lazily, so that peers do not all relay it at the same time. No
+# delay if zero.
+# Only applicable to the flood and CAT mempools.
+# Default is 100ms
+gossip-delay-jitter = "{{ .Mempool.GossipDelayJitter }}"
+
+# gossip-dedup-window is the window during which duplicate sends of a
+# transaction to a peer are suppressed. For the flood mempool, it is how long
+# a transaction is withheld from peers outside of gossip-fanout, in case they
+# relay it to us first (e.g. "1s"). For the CAT mempool, it bounds how long we
+# remember that a peer has a transaction, which is otherwise ttl-duration, or
+# an hour if zero.
+# Only applicable to the flood and CAT mempools.
+gossip-dedup-window = "{{ .Mempool.GossipDedupWindow }}"
+
+# max-broadcast-routines caps the number of routines broadcasting transactions,
+# one per peer, bounding the goroutines of nodes with many peers. Peers beyond
+# the cap are sent transactions once a routine returns, e.g. as a peer
+# disconnects, and meanwhile get them from other peers. Persistent and
+# unconditional peers are not capped. 0 means no cap.
+# Only applicable to the flood and priority mempools.
+max-broadcast-routines = {{ .Mempool.MaxBroadcastRoutines }}
+
+# committed-txs-window is the number of heights committed transactions are
+# remembered for. Committed transactions still in the mempool or received from
+# peers within the window, e.g. after falling out of the cache, are purged and
+# logged with their sender instead of being gossiped again. 0 disables it.
+# Default is 5
+committed-txs-window = {{ .Mempool.CommittedTxsWindow }}
+
+# Comma separated list of RPC addresses of full nodes the broadcast_tx RPCs are
+# forwarded to, tried in order until one of them accepts the transaction, e.g.
+# "tcp://10.0.0.1:26657,https://rpc.example.com:443". It lets nodes without a
+# mempool, e.g. RPC or archive nodes, serve the broadcast_tx RPCs without ever
+# storing or gossiping pending transactions.
+# Only applicable to the nop mempool.
+broadcast-proxy-addrs = "{{ .Mempool.BroadcastProxyAddrs }}"
+
 #######################################################
 ###         State Sync Configuration Options        ###
 #######################################################
@@ -468,6 +849,13 @@ trust_height = {{ .StateSync.TrustHeight }}
 trust_hash = "{{ .StateSync.TrustHash }}"
 trust_period = "{{ .StateSync.TrustPeriod }}"
 
+# Restore the most recent snapshot the local application already has (e.g. a
+# snapshot directory copied from another node) instead of discovering snapshots
+# from peers. Chunks are loaded from the application itself and the restored
+# state is still verified against the light client. If no local snapshot can be
+# restored, snapshots are discovered from peers as usual.
+local_snapshot = {{ .StateSync.LocalSnapshot }}
+
 # Time to spend discovering snapshots before initiating a restore.
 discovery_time = "{{ .StateSync.DiscoveryTime }}"
 
@@ -482,6 +870,16 @@ chunk_request_timeout = "{{ .StateSync.ChunkRequestTimeout }}"
 # The number of concurrent chunk fetchers to run (default: 1).
 chunk_fetchers = "{{ .StateSync.ChunkFetchers }}"
 
+# Ask the application to take a snapshot of its state every snapshot_interval
+# heights and/or after the first block of every snapshot_time_interval period
+# of block time (e.g. "6h"), through the snapshot field of RequestCommit.
+# Time-based snapshots keep a steady pace when block times vary. The decision
+# only depends on the blocks, so all the nodes with the same schedule take
+# snapshots at the same heights. 0 disables either schedule, leaving the
+# application to decide on its own.
+snapshot_interval = {{ .StateSync.SnapshotInterval }}
+snapshot_time_interval = "{{ .StateSync.SnapshotTimeInterval }}"
+
 #######################################################
 ###       Block Sync Configuration Options          ###
 #######################################################
@@ -495,6 +893,23 @@ chunk_fetchers = "{{ .StateSync.ChunkFetchers }}"
 #   1) "v0" - the default block sync implementation
 version = "{{ .BlockSync.Version }}"
 
+#######################################################
+###       Block Relay Configuration Options         ###
+#######################################################
+[blockrelay]
+
+# Relay each block committed by this node to the full nodes subscribed to it,
+# right after the commit.
+serve = {{ .BlockRelay.Serve }}
+
+# Follow the chain through the blocks relayed by peers once block sync has
+# caught up, instead of switching to consensus. The node then takes no part
+# in the gossip of votes and proposals. Ignored while the node is a validator.
+subscribe = {{ .BlockRelay.Subscribe }}
+
+# Maximum number of subscribers served at once.
+max_subscribers = {{ .BlockRelay.MaxSubscribers }}
+
 #######################################################
 ###         Consensus Configuration Options         ###
 #######################################################
@@ -507,6 +922,11 @@ only_internal_wal = "{{ .Consensus.OnlyInternalWal }}"
 
 wal_file = "{{ js .Consensus.WalPath }}"
 
+# If set, every entry written to the WAL is also replicated asynchronously
+# to this location, e.g. another disk or a network file system shared with
+# a warm-standby validator. Seed it with a copy of the WAL before enabling.
+wal_replica_file = "{{ js .Consensus.WalReplicaPath }}"
+
 # How long we wait for a proposal block before prevoting nil
 timeout_propose = "{{ .Consensus.TimeoutPropose }}"
 # How much timeout_propose increases with each round
@@ -530,6 +950,14 @@ timeout_commit = "{{ .Consensus.TimeoutCommit }}"
 # So, validators should stop the state machine, wait for some blocks, and then restart the state machine to avoid panic.
 double_sign_check_height = {{ .Consensus.DoubleSignCheckHeight }}
 
+# Validate the internal consistency of the consensus state at runtime: the
+# locked block has a polka, the seen commits are signed by the validator set,
+# and the WAL heights increase. On a violation, "alert" logs an error and
+# increments the consensus_invariant_violations metric, while "halt" also
+# stops the consensus state machine, so that a state machine bug can't corrupt
+# the chain. "off" disables the checks.
+invariant_checks = "{{ .Consensus.InvariantChecks }}"
+
 # Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
 skip_timeout_commit = {{ .Consensus.SkipTimeoutCommit }}
 
@@ -541,6 +969,31 @@ create_empty_blocks_interval = "{{ .Consensus.CreateEmptyBlocksInterval }}"
 peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+# Strategy used to gossip proposal block parts to peers running the same
+# strategy, to compare block propagation designs on testnets. Parts are pushed
+# to peers using another strategy or an older version.
+#   - "push"         => send random parts the peer is missing (default)
+#   - "rarest-first" => send the parts the fewest peers are known to have first
+#   - "pull"         => peers announce the parts they have and request the
+#                       parts they are missing
+gossip_strategy = "{{ .Consensus.GossipStrategy }}"
+
+# Send a compact message instead of the parts of empty proposal blocks, from
+# which peers rebuild the blocks locally. Only used when the lazy_empty_proposals
+# consensus param is enabled. The parts are sent to the peers which did not
+# rebuild the block after lazy_empty_proposal_timeout.
+lazy_empty_proposals = {{ .Consensus.LazyEmptyProposals }}
+lazy_empty_proposal_timeout = "{{ .Consensus.LazyEmptyProposalTimeout }}"
+
+# Testing only, ignored unless the binary is built with the e2e build tag:
+# delay the broadcast of our own prevotes and precommits, adding a jitter of up
+# to test_vote_jitter derived from test_vote_jitter_seed and the height, round
+# and type of the vote, so that timing dependent behaviors are reproducible.
+test_prevote_delay = "{{ .Consensus.TestPrevoteDelay }}"
+test_precommit_delay = "{{ .Consensus.TestPrecommitDelay }}"
+test_vote_jitter = "{{ .Consensus.TestVoteJitter }}"
+test_vote_jitter_seed = {{ .Consensus.TestVoteJitterSeed }}
+
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -552,6 +1005,12 @@ peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 # reindex events in the command-line tool.
 discard_abci_responses = {{ .Storage.DiscardABCIResponses}}
 
+# Budget, in MB, of the in-memory cache of the block metas, commits, validator
+# sets and consensus params read from the block store and state databases, e.g.
+# to serve RPC queries, catching up peers and light clients. The cache is
+# disabled if zero.
+read_cache_size_mb = {{ .Storage.ReadCacheSizeMB }}
+
 #######################################################
 ###   Transaction Indexer Configuration Options     ###
 #######################################################
@@ -572,6 +1031,13 @@ indexer = "{{ .TxIndex.Indexer }}"
 #   postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 psql-conn = "{{ .TxIndex.PsqlConn }}"
 
+# If true, the ResourceUsage report of each height is indexed with the block
+# events under the "resource_usage" event type, e.g. to search for heights with
+# "resource_usage.abci_time_ms > 500". Requires
+# instrumentation.resource_usage_events to be enabled. Only supported by the
+# "kv" indexer.
+index-resource-usage = {{ .TxIndex.IndexResourceUsage }}
+
 #######################################################
 ###       Instrumentation Configuration Options     ###
 #######################################################
@@ -628,4 +1094,26 @@ pyroscope_trace = {{ .Instrumentation.PyroscopeTrace }}
 # inuse_objects, inuse_space, goroutines, mutex_count, mutex_duration,
 # block_count, block_duration.
 pyroscope_profile_types = [{{ range .Instrumentation.PyroscopeProfileTypes }}{{ printf "%q, " . }}{{end}}]
+
+# How often the size, key count and compaction statistics of the node's
+# databases are collected. They are reported as Prometheus metrics and by the
+# /storage_status RPC endpoint. 0 disables the collection.
+storage_metrics_interval = "{{ .Instrumentation.StorageMetricsInterval }}"
+
+# If true, a ResourceUsage event is published after each block is committed,
+# summarizing the bytes gossiped per p2p channel, the time spent in the
+# application, the bytes written to the databases and the mempool churn since
+# the previous block.
+resource_usage_events = {{ .Instrumentation.ResourceUsageEvents }}
+
+# Opt-in telemetry beacon: if set, the node periodically posts its anonymized
+# statistics (version, chain ID, height, peer count and whether it is catching
+# up), signed with its telemetry key, to this http or https endpoint, giving the
+# network coordinators visibility into the upgrade adoption. No IP address,
+# moniker or validator address is reported. Empty disables the beacon.
+telemetry_beacon_url = "{{ .Instrumentation.TelemetryBeaconURL }}"
+
+# How often the statistics are posted to telemetry_beacon_url. Can't be less
+# than 1m.
+telemetry_beacon_interval = "{{ .Instrumentation.TelemetryBeaconInterval }}"
 `
@@ -577,7 +577,7 @@ func (br *ByzantineReactor) AddPeer(peer p2p.Peer) {
 
 	// Create peerState for peer
 	peerState := NewPeerState(peer).SetLogger(br.reactor.Logger)
-	peer.Set(types.PeerStateKey, peerState)
+	types.PeerStateKey.Set(peer.Data(), peerState)
 
 	// Send our state to peer.
 	// If we're syncing, broadcast a RoundStepMessage later upon SwitchToConsensus().
@@ -0,0 +1,123 @@
+package consensus
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/bits"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+)
+
+// eventEmptyBlockRebuilt is fired on the internal event switch when the
+// proposal block is rebuilt from an EmptyBlockMessage.
+const eventEmptyBlockRebuilt = "EmptyBlockRebuilt"
+
+// isEmptyBlock returns whether the block can be sent as an EmptyBlockMessage:
+// it has neither transactions nor evidence, and it isn't the first block of the
+// chain, which peers can't rebuild without a last commit.
+func isEmptyBlock(block *types.Block) bool {
+	return block != nil && len(block.Txs) == 0 && len(block.Evidence.Evidence) == 0 &&
+		block.LastCommit != nil && len(block.LastCommit.Signatures) > 0
+}
+
+// newEmptyBlockMessage returns the EmptyBlockMessage replacing the parts of
+// the empty block proposed in the given round.
+func newEmptyBlockMessage(round int32, block *types.Block) *EmptyBlockMessage {
+	lastCommit := bits.NewBitArray(len(block.LastCommit.Signatures))
+	for i, sig := range block.LastCommit.Signatures {
+		lastCommit.SetIndex(i, sig.BlockIDFlag != types.BlockIDFlagAbsent)
+	}
+	return &EmptyBlockMessage{
+		Height:          block.Height,
+		Round:           round,
+		SquareSize:      block.SquareSize,
+		DataHash:        block.DataHash,
+		ProposerAddress: block.ProposerAddress,
+		LastCommit:      lastCommit,
+	}
+}
+
+// lazyEmptyProposals returns whether empty proposal blocks may be sent to
+// peers as EmptyBlockMessages at the current height.
+func (cs *State) lazyEmptyProposals() bool {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.config.LazyEmptyProposals && cs.state.ConsensusParams.Gossip.LazyEmptyProposals
+}
+
+// acceptsEmptyBlocks returns whether the EmptyBlockMessages of peers are
+// handled at the current height, which the consensus params must allow.
+func (cs *State) acceptsEmptyBlocks() bool {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.state.ConsensusParams.Gossip.LazyEmptyProposals
+}
+
+// rebuildEmptyBlock rebuilds the parts of the empty proposal block described
+// by msg, using the precommits of the previous height we have. It fails if
+// we miss one of the precommits or if the block doesn't match the proposal,
+// in which case the peer sends us the parts after a timeout.
+//
+// The caller must hold cs.mtx.
+func (cs *State) rebuildEmptyBlock(msg *EmptyBlockMessage) (*types.PartSet, error) {
+	if cs.Height != msg.Height {
+		return nil, fmt.Errorf("empty block of height %d, expected %d", msg.Height, cs.Height)
+	}
+	if cs.ProposalBlockParts == nil {
+		return nil, errors.New("no proposal")
+	}
+	if !cs.LastCommit.HasTwoThirdsMajority() {
+		return nil, errors.New("no commit for the previous height")
+	}
+	if cs.LastCommit.Size() != msg.LastCommit.Size() {
+		return nil, fmt.Errorf("last commit of %d validators, expected %d", msg.LastCommit.Size(), cs.LastCommit.Size())
+	}
+
+	lastCommit := cs.LastCommit.MakeExtendedCommit(cs.state.ConsensusParams.ABCI).ToCommit()
+	for i, sig := range lastCommit.Signatures {
+		switch {
+		case !msg.LastCommit.GetIndex(i):
+			lastCommit.Signatures[i] = types.NewCommitSigAbsent()
+		case sig.BlockIDFlag == types.BlockIDFlagAbsent:
+			return nil, fmt.Errorf("missing precommit of validator %d", i)
+		}
+	}
+
+	data := types.NewData(nil, msg.SquareSize, msg.DataHash)
+	block := cs.state.MakeBlock(msg.Height, data, lastCommit, nil, msg.ProposerAddress)
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	if err != nil {
+		return nil, err
+	}
+	if !parts.HasHeader(cs.ProposalBlockParts.Header()) {
+		return nil, errors.New("rebuilt block doesn't match the proposal")
+	}
+	return parts, nil
+}
+
+// addEmptyBlock adds the parts of the proposal block rebuilt from msg, as if
+// the peer had sent them.
+func (cs *State) addEmptyBlock(msg *EmptyBlockMessage, peerID p2p.ID) (added bool, err error) {
+	parts, err := cs.rebuildEmptyBlock(msg)
+	if err != nil {
+		cs.Logger.Debug("failed to rebuild empty block", "height", msg.Height, "round", msg.Round,
+			"peer", peerID, "err", err)
+		return false, nil
+	}
+	for i := 0; i < int(parts.Total()); i++ {
+		partAdded, err := cs.addProposalBlockPart(&BlockPartMessage{
+			Height: msg.Height,
+			Round:  msg.Round,
+			Part:   parts.GetPart(i),
+		}, peerID)
+		if err != nil {
+			return added, err
+		}
+		added = added || partAdded
+	}
+	// tell the peers still waiting for us to rebuild the block, including
+	// when we received its parts before
+	cs.evsw.FireEvent(eventEmptyBlockRebuilt, &cs.RoundState)
+	return added, nil
+}
@@ -0,0 +1,89 @@
+package consensus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/p2p"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestStateRebuildEmptyBlock(t *testing.T) {
+	cs1, vss := randState(4)
+	height, round := cs1.Height, cs1.Round
+
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+
+	// commit the first block, cs1 is its proposer
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	rs := cs1.GetRoundState()
+	signAddVotes(cs1, cmtproto.PrecommitType, rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header(), true, vss[1:]...)
+	height++
+	ensureNewRound(newRoundCh, height, round)
+
+	// vss[1] proposes an empty block
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	proposal, block := decideProposal(ctx, t, cs1, vss[1], height, round)
+	require.True(t, isEmptyBlock(block))
+	msg := newEmptyBlockMessage(round, block)
+	require.NoError(t, msg.ValidateBasic())
+	assert.Equal(t, 4, msg.LastCommit.Size())
+	assert.True(t, msg.LastCommit.IsFull())
+
+	// the block can't be rebuilt before the proposal
+	cs1.mtx.Lock()
+	_, err := cs1.rebuildEmptyBlock(msg)
+	cs1.mtx.Unlock()
+	require.Error(t, err)
+
+	// the message is ignored unless the consensus params allow it
+	peerID := p2p.ID("peer")
+	require.NoError(t, cs1.SetProposal(proposal, peerID))
+	cs1.peerMsgQueue <- msgInfo{msg, peerID}
+	ensureNoNewEventOnChannel(proposalCh)
+
+	cs1.mtx.Lock()
+	cs1.state.ConsensusParams.Gossip.LazyEmptyProposals = true
+	cs1.mtx.Unlock()
+	cs1.peerMsgQueue <- msgInfo{msg, peerID}
+	ensureNewProposal(proposalCh, height, round)
+	rs = cs1.GetRoundState()
+	assert.Equal(t, block.Hash(), rs.ProposalBlock.Hash())
+	assert.Equal(t, proposal.BlockID.PartSetHeader, rs.ProposalBlockParts.Header())
+
+	// a block with other fields doesn't match the proposal
+	otherPubKey, err := vss[2].GetPubKey()
+	require.NoError(t, err)
+	for _, tamper := range []func(*EmptyBlockMessage){
+		func(m *EmptyBlockMessage) { m.SquareSize++ },
+		func(m *EmptyBlockMessage) { m.DataHash = tmhash.Sum([]byte("data")) },
+		func(m *EmptyBlockMessage) { m.ProposerAddress = otherPubKey.Address() },
+		func(m *EmptyBlockMessage) { m.LastCommit.SetIndex(3, false) },
+	} {
+		tampered := newEmptyBlockMessage(round, block)
+		tamper(tampered)
+		cs1.mtx.Lock()
+		_, err := cs1.rebuildEmptyBlock(tampered)
+		cs1.mtx.Unlock()
+		assert.Error(t, err)
+	}
+}
+
+func TestIsEmptyBlock(t *testing.T) {
+	lastCommit := &types.Commit{Height: 1, Signatures: []types.CommitSig{types.NewCommitSigAbsent()}}
+
+	assert.False(t, isEmptyBlock(nil))
+	assert.True(t, isEmptyBlock(types.MakeBlock(2, types.Data{}, lastCommit, nil)))
+	assert.False(t, isEmptyBlock(types.MakeBlock(2, types.MakeData([]types.Tx{[]byte("tx")}), lastCommit, nil)))
+	// the first block has no last commit to rebuild it with
+	assert.False(t, isEmptyBlock(types.MakeBlock(1, types.Data{}, &types.Commit{}, nil)))
+}
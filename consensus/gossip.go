@@ -0,0 +1,175 @@
+package consensus
+
+import (
+	"fmt"
+	"time"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/bits"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+const (
+	// blockPartRequestTimeout is how long a block part requested from a peer
+	// isn't requested from other peers.
+	blockPartRequestTimeout = 500 * time.Millisecond
+
+	maxGossipStrategyNameLength = 64
+)
+
+// GossipStrategy decides which proposal block parts are gossiped to a peer.
+//
+// A node announces the name of its strategy to its peers and uses it with the
+// peers that announced the same name. Block parts are pushed to the other
+// peers, so that new block propagation designs can be compared on testnets
+// without breaking compatibility with other nodes.
+type GossipStrategy interface {
+	// Name identifies the strategy to peers and in metrics.
+	Name() string
+	// Pull reports whether peers announce the parts they have and request the
+	// parts they are missing, instead of having parts pushed to them.
+	Pull() bool
+	// PickBlockPart picks the next part of the proposal block to send to a
+	// peer. It returns false if no part should be sent right now.
+	PickBlockPart(g BlockPartGossip) (index int, ok bool)
+}
+
+// BlockPartGossip holds what a GossipStrategy can use to pick the next block
+// part to send to a peer.
+type BlockPartGossip struct {
+	// Peer is the peer the part is sent to.
+	Peer *PeerState
+	// Missing holds the parts we have and the peer isn't known to have.
+	Missing *bits.BitArray
+	// Wants holds the parts the peer requested. It is nil unless the strategy
+	// pulls.
+	Wants *bits.BitArray
+	// Availability returns, for every part, the number of peers known to
+	// have it.
+	Availability func() []int
+}
+
+// NewGossipStrategy returns the built-in gossip strategy with the given name,
+// one of cfg.GossipStrategyPush, cfg.GossipStrategyRarestFirst or
+// cfg.GossipStrategyPull. An empty name selects push.
+func NewGossipStrategy(name string) (GossipStrategy, error) {
+	switch name {
+	case cfg.GossipStrategyPush, "":
+		return pushGossip{}, nil
+	case cfg.GossipStrategyRarestFirst:
+		return rarestFirstGossip{}, nil
+	case cfg.GossipStrategyPull:
+		return pullGossip{}, nil
+	default:
+		return nil, fmt.Errorf("unknown gossip strategy %q", name)
+	}
+}
+
+// pushGossip sends the peer random parts it is missing. It is the original
+// gossip behaviour and the fallback for peers running another strategy.
+type pushGossip struct{}
+
+func (pushGossip) Name() string { return cfg.GossipStrategyPush }
+
+func (pushGossip) Pull() bool { return false }
+
+func (pushGossip) PickBlockPart(g BlockPartGossip) (int, bool) {
+	return g.Missing.PickRandom()
+}
+
+// rarestFirstGossip sends the parts the peer is missing that the fewest of our
+// peers are known to have, so that every part spreads through the network as
+// early as possible.
+type rarestFirstGossip struct{}
+
+func (rarestFirstGossip) Name() string { return cfg.GossipStrategyRarestFirst }
+
+func (rarestFirstGossip) Pull() bool { return false }
+
+func (rarestFirstGossip) PickBlockPart(g BlockPartGossip) (int, bool) {
+	availability := g.Availability()
+	var rarest []int
+	for i := 0; i < g.Missing.Size(); i++ {
+		if !g.Missing.GetIndex(i) {
+			continue
+		}
+		switch {
+		case len(rarest) == 0 || availability[i] < availability[rarest[0]]:
+			rarest = append(rarest[:0], i)
+		case availability[i] == availability[rarest[0]]:
+			rarest = append(rarest, i)
+		}
+	}
+	if len(rarest) == 0 {
+		return 0, false
+	}
+	return rarest[cmtrand.Intn(len(rarest))], true
+}
+
+// pullGossip only sends the parts the peer requested.
+type pullGossip struct{}
+
+func (pullGossip) Name() string { return cfg.GossipStrategyPull }
+
+func (pullGossip) Pull() bool { return true }
+
+func (pullGossip) PickBlockPart(g BlockPartGossip) (int, bool) {
+	if g.Wants == nil {
+		return 0, false
+	}
+	return g.Missing.And(g.Wants).PickRandom()
+}
+
+//-----------------------------------------------------------------------------
+
+// blockPartRequests tracks the proposal block parts requested from peers
+// using pull-based gossip, so that every part is requested from a single
+// peer at a time.
+type blockPartRequests struct {
+	mtx       cmtsync.Mutex
+	height    int64
+	round     int32
+	requested map[int]blockPartRequest
+}
+
+type blockPartRequest struct {
+	peer p2p.ID
+	at   time.Time
+}
+
+func newBlockPartRequests() *blockPartRequests {
+	return &blockPartRequests{requested: make(map[int]blockPartRequest)}
+}
+
+// claim returns the parts out of candidates that aren't requested from
+// another peer, and records them as requested from the given peer.
+func (r *blockPartRequests) claim(
+	height int64, round int32, peer p2p.ID, candidates *bits.BitArray,
+) *bits.BitArray {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.height != height || r.round != round {
+		r.height, r.round = height, round
+		r.requested = make(map[int]blockPartRequest)
+	}
+
+	now := time.Now()
+	claimed := bits.NewBitArray(candidates.Size())
+	for i := 0; i < candidates.Size(); i++ {
+		if !candidates.GetIndex(i) {
+			continue
+		}
+		req, ok := r.requested[i]
+		if ok && req.peer != peer && now.Sub(req.at) < blockPartRequestTimeout {
+			continue
+		}
+		if !ok || req.peer != peer {
+			r.requested[i] = blockPartRequest{peer: peer, at: now}
+		}
+		claimed.SetIndex(i, true)
+	}
+	return claimed
+}
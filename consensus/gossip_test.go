@@ -0,0 +1,83 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/bits"
+)
+
+func bitArrayOf(size int, indexes ...int) *bits.BitArray {
+	bA := bits.NewBitArray(size)
+	for _, i := range indexes {
+		bA.SetIndex(i, true)
+	}
+	return bA
+}
+
+func TestNewGossipStrategy(t *testing.T) {
+	for _, name := range []string{cfg.GossipStrategyPush, cfg.GossipStrategyRarestFirst, cfg.GossipStrategyPull} {
+		gossip, err := NewGossipStrategy(name)
+		require.NoError(t, err)
+		assert.Equal(t, name, gossip.Name())
+	}
+
+	gossip, err := NewGossipStrategy("")
+	require.NoError(t, err)
+	assert.Equal(t, cfg.GossipStrategyPush, gossip.Name())
+
+	_, err = NewGossipStrategy("flood")
+	require.Error(t, err)
+}
+
+func TestGossipStrategyPickBlockPart(t *testing.T) {
+	availability := func() []int { return []int{3, 1, 2, 1} }
+
+	// rarest-first picks one of the least available parts the peer is missing
+	for i := 0; i < 10; i++ {
+		index, ok := rarestFirstGossip{}.PickBlockPart(BlockPartGossip{
+			Missing:      bitArrayOf(4, 0, 2, 3),
+			Availability: availability,
+		})
+		require.True(t, ok)
+		assert.Equal(t, 3, index)
+	}
+	_, ok := rarestFirstGossip{}.PickBlockPart(BlockPartGossip{
+		Missing:      bitArrayOf(4),
+		Availability: availability,
+	})
+	assert.False(t, ok)
+
+	// pull only sends the parts the peer requested
+	_, ok = pullGossip{}.PickBlockPart(BlockPartGossip{Missing: bitArrayOf(4, 0, 1)})
+	assert.False(t, ok)
+	index, ok := pullGossip{}.PickBlockPart(BlockPartGossip{
+		Missing: bitArrayOf(4, 0, 1),
+		Wants:   bitArrayOf(4, 1, 2),
+	})
+	require.True(t, ok)
+	assert.Equal(t, 1, index)
+}
+
+func TestBlockPartRequestsClaim(t *testing.T) {
+	requests := newBlockPartRequests()
+
+	claimed := requests.claim(1, 0, "a", bitArrayOf(4, 0, 1))
+	assert.Equal(t, bitArrayOf(4, 0, 1).String(), claimed.String())
+
+	// parts requested from another peer aren't requested again until the
+	// request times out
+	claimed = requests.claim(1, 0, "b", bitArrayOf(4, 1, 2))
+	assert.Equal(t, bitArrayOf(4, 2).String(), claimed.String())
+	requests.requested[1] = blockPartRequest{peer: "a", at: time.Now().Add(-blockPartRequestTimeout)}
+	claimed = requests.claim(1, 0, "b", bitArrayOf(4, 1))
+	assert.Equal(t, bitArrayOf(4, 1).String(), claimed.String())
+
+	// requests are reset on a new round
+	claimed = requests.claim(1, 1, "a", bitArrayOf(4, 2))
+	assert.Equal(t, bitArrayOf(4, 2).String(), claimed.String())
+}
@@ -0,0 +1,87 @@
+package consensus
+
+import (
+	"bytes"
+	"fmt"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Invariants of the consensus state checked when invariant_checks is set.
+const (
+	invariantLockedPolka      = "locked_block_polka"
+	invariantCommitSignatures = "commit_signatures"
+	invariantWALHeightOrder   = "wal_height_order"
+)
+
+// checkingInvariants returns whether the invariant checks are enabled.
+func (cs *State) checkingInvariants() bool {
+	switch cs.config.InvariantChecks {
+	case cfg.InvariantChecksAlert, cfg.InvariantChecksHalt:
+		return true
+	}
+	return false
+}
+
+// invariantViolated reports the violation of invariant, panicking with
+// invariant_checks set to halt. The panic is recovered by receiveRoutine,
+// which stops the state machine as for any other consensus failure.
+func (cs *State) invariantViolated(invariant string, err error) {
+	cs.metrics.InvariantViolations.With("invariant", invariant).Add(1)
+	if cs.config.InvariantChecks == cfg.InvariantChecksHalt {
+		panic(fmt.Sprintf("consensus invariant %s violated: %v", invariant, err))
+	}
+	cs.Logger.Error("consensus invariant violated", "invariant", invariant, "err", err,
+		"height", cs.Height, "round", cs.Round, "step", cs.Step)
+}
+
+// checkLockedPolka checks that the locked block, if any, has +2/3 prevotes
+// in the round it was locked in. It's called after each state transition.
+func (cs *State) checkLockedPolka() {
+	if !cs.checkingInvariants() || cs.LockedBlock == nil {
+		return
+	}
+	prevotes := cs.Votes.Prevotes(cs.LockedRound)
+	if prevotes == nil {
+		cs.invariantViolated(invariantLockedPolka,
+			fmt.Errorf("no prevotes for locked round %d", cs.LockedRound))
+		return
+	}
+	blockID, ok := prevotes.TwoThirdsMajority()
+	if !ok || !bytes.Equal(blockID.Hash, cs.LockedBlock.Hash()) {
+		cs.invariantViolated(invariantLockedPolka,
+			fmt.Errorf("locked block %X has no polka in round %d", cs.LockedBlock.Hash(), cs.LockedRound))
+	}
+}
+
+// checkCommitSignatures checks that the commit of the block committed at
+// height is signed by +2/3 of the validator set of the height.
+func (cs *State) checkCommitSignatures(height int64, blockID types.BlockID, commit *types.Commit) {
+	if !cs.checkingInvariants() {
+		return
+	}
+	if commit == nil {
+		commit = cs.blockStore.LoadSeenCommit(height)
+	}
+	if commit == nil {
+		cs.invariantViolated(invariantCommitSignatures, fmt.Errorf("no commit for height %d", height))
+		return
+	}
+	if err := cs.Validators.VerifyCommitLight(cs.state.ChainID, blockID, height, commit); err != nil {
+		cs.invariantViolated(invariantCommitSignatures, err)
+	}
+}
+
+// checkWALEndHeight checks that the heights ended in the WAL increase one at
+// a time, before the end of height is written.
+func (cs *State) checkWALEndHeight(height int64) {
+	if !cs.checkingInvariants() {
+		return
+	}
+	if cs.walEndHeight != 0 && height != cs.walEndHeight+1 {
+		cs.invariantViolated(invariantWALHeightOrder,
+			fmt.Errorf("ending height %d in the WAL after height %d", height, cs.walEndHeight))
+	}
+	cs.walEndHeight = height
+}
@@ -0,0 +1,82 @@
+package consensus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+func TestStateInvariantsHold(t *testing.T) {
+	cs, _ := randState(1)
+	cs.config.InvariantChecks = cfg.InvariantChecksHalt
+	height, round := cs.Height, cs.Round
+
+	newBlockCh := subscribe(cs.eventBus, types.EventQueryNewBlock)
+	startTestRound(cs, height, round)
+
+	// a violation would halt the state machine before the second block
+	ensureNewBlock(newBlockCh, height)
+	ensureNewBlock(newBlockCh, height+1)
+}
+
+func TestStateCheckLockedPolka(t *testing.T) {
+	cs, _ := randState(4)
+	cs.config.InvariantChecks = cfg.InvariantChecksHalt
+
+	block, err := cs.createProposalBlock(context.Background())
+	require.NoError(t, err)
+	cs.LockedRound = 0
+	cs.LockedBlock = block
+	assert.Panics(t, cs.checkLockedPolka)
+
+	cs.config.InvariantChecks = cfg.InvariantChecksAlert
+	assert.NotPanics(t, cs.checkLockedPolka)
+	cs.config.InvariantChecks = cfg.InvariantChecksOff
+	assert.NotPanics(t, cs.checkLockedPolka)
+}
+
+func TestStateCheckCommitSignatures(t *testing.T) {
+	cs, vss := randState(4)
+	cs.config.InvariantChecks = cfg.InvariantChecksHalt
+
+	blockID := types.BlockID{
+		Hash:          tmhash.Sum([]byte("block")),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	}
+	privVals := make([]types.PrivValidator, len(vss))
+	for i, vs := range vss {
+		privVals[i] = vs.PrivValidator
+	}
+	commit, err := test.MakeCommit(blockID, cs.Height, 0, cs.Validators, privVals, cs.state.ChainID, cmttime.Now())
+	require.NoError(t, err)
+	assert.NotPanics(t, func() { cs.checkCommitSignatures(cs.Height, blockID, commit) })
+
+	// signed by less than 2/3 of the validators
+	commit, err = test.MakeCommit(blockID, cs.Height, 0, cs.Validators, privVals[:2], cs.state.ChainID, cmttime.Now())
+	require.NoError(t, err)
+	assert.Panics(t, func() { cs.checkCommitSignatures(cs.Height, blockID, commit) })
+
+	// missing from the block store
+	assert.Panics(t, func() { cs.checkCommitSignatures(cs.Height, blockID, nil) })
+}
+
+func TestStateCheckWALEndHeight(t *testing.T) {
+	cs, _ := randState(1)
+	cs.config.InvariantChecks = cfg.InvariantChecksHalt
+
+	assert.NotPanics(t, func() { cs.checkWALEndHeight(5) })
+	assert.NotPanics(t, func() { cs.checkWALEndHeight(6) })
+	assert.Panics(t, func() { cs.checkWALEndHeight(8) })
+
+	cs.config.InvariantChecks = cfg.InvariantChecksAlert
+	assert.NotPanics(t, func() { cs.checkWALEndHeight(8) })
+	assert.EqualValues(t, 8, cs.walEndHeight)
+}
@@ -198,12 +198,38 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "late_votes",
 			Help:      "LateVotes stores the number of votes that were received by this node that correspond to earlier heights and rounds than this node is currently in.",
 		}, append(labels, "vote_type")).With(labelsAndValues...),
+		GossipBlockPartsSent: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "gossip_block_parts_sent",
+			Help:      "GossipBlockPartsSent is the number of proposal block parts sent to peers, labeled by the gossip strategy used with the peer.",
+		}, append(labels, "strategy")).With(labelsAndValues...),
+		GossipBlockPartsReceived: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "gossip_block_parts_received",
+			Help:      "GossipBlockPartsReceived is the number of proposal block parts received from peers, labeled by the gossip strategy used with the peer.",
+		}, append(labels, "strategy")).With(labelsAndValues...),
+		GossipDuplicateBlockParts: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "gossip_duplicate_block_parts",
+			Help:      "GossipDuplicateBlockParts is the number of proposal block parts received from peers that we already had, labeled by the gossip strategy used with the peer.",
+		}, append(labels, "strategy")).With(labelsAndValues...),
 		StartHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "start_height",
 			Help:      "StartHeight is the height at which metrics began.",
 		}, labels).With(labelsAndValues...),
+		BlockPropagationSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "block_propagation_seconds",
+			Help:      "BlockPropagationSeconds is the time between receiving the first part of a proposal block from a peer and having the complete block, labeled by the proposer of the block.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.01, 10, 10),
+		}, append(labels, "proposer_address")).With(labelsAndValues...),
 		BlockTimeSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -222,6 +248,24 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "timed_out_proposals",
 			Help:      "TimedOutProposals is the number of proposals that failed to be received in time.",
 		}, labels).With(labelsAndValues...),
+		OwnMissedProposals: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "own_missed_proposals",
+			Help:      "OwnMissedProposals is the number of times this node's validator was the proposer but failed to sign its proposal before the propose timeout. It persists across restarts.",
+		}, labels).With(labelsAndValues...),
+		OwnLateVotes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "own_late_votes",
+			Help:      "OwnLateVotes is the number of votes this node's validator failed to sign before the timeout of their step, labeled by vote type. It persists across restarts.",
+		}, append(labels, "vote_type")).With(labelsAndValues...),
+		InvariantViolations: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "invariant_violations",
+			Help:      "InvariantViolations is the number of violations of the invariants of the consensus state found by the invariant checks, labeled by invariant.",
+		}, append(labels, "invariant")).With(labelsAndValues...),
 	}
 }
 
@@ -257,9 +301,16 @@ func NopMetrics() *Metrics {
 		ProposalCreateCount:          discard.NewCounter(),
 		RoundVotingPowerPercent:      discard.NewGauge(),
 		LateVotes:                    discard.NewCounter(),
+		GossipBlockPartsSent:         discard.NewCounter(),
+		GossipBlockPartsReceived:     discard.NewCounter(),
+		GossipDuplicateBlockParts:    discard.NewCounter(),
 		StartHeight:                  discard.NewGauge(),
+		BlockPropagationSeconds:      discard.NewHistogram(),
 		BlockTimeSeconds:             discard.NewGauge(),
 		ApplicationRejectedProposals: discard.NewCounter(),
 		TimedOutProposals:            discard.NewCounter(),
+		OwnMissedProposals:           discard.NewCounter(),
+		OwnLateVotes:                 discard.NewCounter(),
+		InvariantViolations:          discard.NewCounter(),
 	}
 }
@@ -126,14 +126,44 @@ type Metrics struct {
 	// in.
 	LateVotes metrics.Counter `metrics_labels:"vote_type"`
 
+	// GossipBlockPartsSent is the number of proposal block parts sent to
+	// peers, labeled by the gossip strategy used with the peer.
+	GossipBlockPartsSent metrics.Counter `metrics_labels:"strategy"`
+
+	// GossipBlockPartsReceived is the number of proposal block parts received
+	// from peers, labeled by the gossip strategy used with the peer.
+	GossipBlockPartsReceived metrics.Counter `metrics_labels:"strategy"`
+
+	// GossipDuplicateBlockParts is the number of proposal block parts received
+	// from peers that we already had, labeled by the gossip strategy used with
+	// the peer.
+	GossipDuplicateBlockParts metrics.Counter `metrics_labels:"strategy"`
+
 	// StartHeight is the height at which metrics began.
 	StartHeight metrics.Gauge
+	// BlockPropagationSeconds is the time between receiving the first part of
+	// a proposal block from a peer and having the complete block, labeled by
+	// the proposer of the block.
+	BlockPropagationSeconds metrics.Histogram `metrics_labels:"proposer_address" metrics_buckettype:"exprange" metrics_bucketsizes:"0.01, 10, 10"`
 	// BlockTimeSeconds is the duration between this block and the preceding one.
 	BlockTimeSeconds metrics.Gauge
 	// ApplicationRejectedProposals is the number of proposals rejected by the application.
 	ApplicationRejectedProposals metrics.Counter
 	// TimedOutProposals is the number of proposals that failed to be received in time.
 	TimedOutProposals metrics.Counter
+	// OwnMissedProposals is the number of times this node's validator was the
+	// proposer but failed to sign its proposal before the propose timeout.
+	// It persists across restarts.
+	OwnMissedProposals metrics.Counter
+	// OwnLateVotes is the number of votes this node's validator failed to sign
+	// before the timeout of their step, labeled by vote type. It persists
+	// across restarts.
+	OwnLateVotes metrics.Counter `metrics_labels:"vote_type"`
+
+	// InvariantViolations is the number of violations of the invariants of
+	// the consensus state found by the invariant checks, labeled by
+	// invariant.
+	InvariantViolations metrics.Counter `metrics_labels:"invariant"`
 }
 
 func (m *Metrics) MarkProposalProcessed(accepted bool) {
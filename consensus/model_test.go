@@ -0,0 +1,554 @@
+package consensus
+
+import (
+	"context"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// Model-based tests drive the consensus state machine with sequences of
+// proposals, votes and timeouts generated from a seed, in place of its receive
+// routine and timeout ticker, and check after every step that its behavior is
+// allowed by a reference model of the protocol. Run them at length with
+// `make test-model`; a failing run is reproduced with its seed:
+//
+//	go test ./consensus -run TestModel -args -model.seed=<seed> -model.runs=1
+var (
+	modelRuns  = flag.Int("model.runs", 10, "number of runs of the model-based consensus tests")
+	modelSteps = flag.Int("model.steps", 1000, "number of steps of each run of the model-based consensus tests")
+	modelSeed  = flag.Int64("model.seed", 1, "seed of the first run of the model-based consensus tests")
+)
+
+const (
+	// modelValidators is the size of the validator set. The state machine
+	// under test is the first validator; the others are stubs.
+	modelValidators = 4
+	// modelByzantine is the index of the stub which votes arbitrarily,
+	// equivocating and voting for blocks that do not exist. The other stubs
+	// never equivocate.
+	modelByzantine = modelValidators - 1
+	// modelTraceSize is the number of last steps printed when a run fails.
+	modelTraceSize = 50
+)
+
+func TestModel(t *testing.T) {
+	runs := *modelRuns
+	if testing.Short() {
+		runs = 2
+	}
+	for i := 0; i < runs; i++ {
+		seed := *modelSeed + int64(i)
+		t.Run(fmt.Sprintf("seed=%d", seed), func(t *testing.T) {
+			h := newModelHarness(t, seed)
+			for step := 0; step < *modelSteps; step++ {
+				h.step()
+			}
+			t.Logf("reached height %d round %d", h.cs.Height, h.cs.Round)
+		})
+	}
+}
+
+// modelHarness runs a consensus State on events generated from a seed.
+type modelHarness struct {
+	t      *testing.T
+	seed   int64
+	rng    *rand.Rand
+	cs     *State
+	vss    []*validatorStub
+	ticker *modelTicker
+	model  *consensusModel
+
+	// the proposals made for each height and round, and their blocks
+	proposals map[modelRound]types.BlockID
+	blocks    map[string]*types.PartSet
+	// the votes of the honest stubs, which never change their minds
+	stubVotes map[int]map[modelVoteKey]types.BlockID
+
+	height int64
+	round  int32
+	trace  []string
+}
+
+func newModelHarness(t *testing.T, seed int64) *modelHarness {
+	rng := rand.New(rand.NewSource(seed)) //nolint:gosec
+
+	// derive the keys from the seed, since the order of the proposers
+	// depends on them
+	privVals := make([]types.PrivValidator, modelValidators)
+	for i := range privVals {
+		secret := make([]byte, 8)
+		binary.BigEndian.PutUint64(secret, uint64(rng.Int63()))
+		privVals[i] = types.NewMockPVWithParams(ed25519.GenPrivKeyFromSecret(secret), false, false)
+	}
+	sort.Sort(types.PrivValidatorsByAddress(privVals))
+	validators := make([]types.GenesisValidator, modelValidators)
+	for i, pv := range privVals {
+		pubKey, err := pv.GetPubKey()
+		require.NoError(t, err)
+		validators[i] = types.GenesisValidator{PubKey: pubKey, Power: testMinPower}
+	}
+	state, err := sm.MakeGenesisState(&types.GenesisDoc{
+		GenesisTime:     cmttime.Now(),
+		InitialHeight:   1,
+		ChainID:         test.DefaultTestChainID,
+		Validators:      validators,
+		ConsensusParams: test.ConsensusParams(),
+	})
+	require.NoError(t, err)
+
+	cs := newState(state, privVals[0], kvstore.NewInMemoryApplication())
+	cs.SetLogger(log.NewNopLogger())
+	ticker := &modelTicker{}
+	cs.SetTimeoutTicker(ticker)
+
+	vss := make([]*validatorStub, modelValidators)
+	for i := range vss {
+		vss[i] = newValidatorStub(privVals[i], int32(i))
+	}
+
+	h := &modelHarness{
+		t:         t,
+		seed:      seed,
+		rng:       rng,
+		cs:        cs,
+		vss:       vss,
+		ticker:    ticker,
+		model:     newConsensusModel(cs.Validators),
+		proposals: make(map[modelRound]types.BlockID),
+		blocks:    make(map[string]*types.PartSet),
+		stubVotes: make(map[int]map[modelVoteKey]types.BlockID),
+		height:    cs.Height,
+	}
+	t.Cleanup(func() {
+		if err := cs.eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	cs.scheduleRound0(cs.GetRoundState())
+	return h
+}
+
+// step applies a random event: the firing of a pending timeout, a proposal by
+// the proposer of the round if it is a stub, the gossip of a block the state
+// machine is missing, or a vote of a stub. The messages sent by the state
+// machine in response are then processed, and the model checked.
+func (h *modelHarness) step() {
+	switch n := h.rng.Intn(10); {
+	case n < 2:
+		h.fireTimeout()
+	case n < 3:
+		h.propose()
+	case n < 4:
+		h.gossipBlock()
+	default:
+		h.vote()
+	}
+	h.processInternal()
+	h.checkProgress()
+}
+
+func (h *modelHarness) fireTimeout() {
+	ti, ok := h.ticker.pop(h.rng, h.cs.Height)
+	if !ok {
+		return
+	}
+	h.record("timeout %v/%v/%v", ti.Height, ti.Round, ti.Step)
+	h.cs.handleTimeout(ti, *h.cs.GetRoundState())
+}
+
+func (h *modelHarness) propose() {
+	cs := h.cs
+	hr := modelRound{cs.Height, cs.Round}
+	if cs.Step < cstypes.RoundStepPropose || h.proposals[hr].Hash != nil {
+		return
+	}
+	proposer := h.stub(cs.Validators.GetProposer().Address)
+	if proposer == nil {
+		// we are the proposer
+		return
+	}
+
+	// the proposer mostly knows the valid block of the state machine, and
+	// otherwise proposes a new block, which it may lock on
+	block, parts, polRound := cs.ValidBlock, cs.ValidBlockParts, cs.ValidRound
+	if block == nil || h.rng.Intn(10) < 3 {
+		var err error
+		block, err = cs.createProposalBlock(context.Background())
+		require.NoError(h.t, err)
+		// make the block unique, as the blocks of a height are otherwise
+		// identical
+		tx := types.Tx(fmt.Sprintf("model/%X=%d", h.randBytes(8), hr.round))
+		block = cs.state.MakeBlock(block.Height, types.MakeData(append(block.Txs, tx)),
+			block.LastCommit, block.Evidence.Evidence, cs.Validators.GetProposer().Address)
+		parts, err = block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(h.t, err)
+		polRound = -1
+	}
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+	proposal := types.NewProposal(cs.Height, cs.Round, polRound, blockID)
+	p := proposal.ToProto()
+	require.NoError(h.t, proposer.SignProposal(cs.state.ChainID, p))
+	proposal.Signature = p.Signature
+
+	h.proposals[hr] = blockID
+	h.blocks[blockID.Key()] = parts
+	h.record("proposal %v/%v by %d for %v (pol round %d)", hr.height, hr.round, proposer.Index, blockID, proposal.POLRound)
+	h.cs.handleMsg(msgInfo{Msg: &ProposalMessage{proposal}, PeerID: stubPeerID(int(proposer.Index))})
+	h.sendParts(proposer, hr.round, parts)
+}
+
+// gossipBlock sends the state machine the block it waits for to commit, if
+// it was proposed.
+func (h *modelHarness) gossipBlock() {
+	cs := h.cs
+	if cs.Step != cstypes.RoundStepCommit || cs.ProposalBlockParts == nil || cs.ProposalBlockParts.IsComplete() {
+		return
+	}
+	for _, parts := range h.blocks {
+		if parts.HasHeader(cs.ProposalBlockParts.Header()) {
+			h.record("gossip block %v", parts.Header())
+			h.sendParts(h.vss[1+h.rng.Intn(modelValidators-1)], cs.CommitRound, parts)
+			return
+		}
+	}
+}
+
+func (h *modelHarness) randBytes(n int) []byte {
+	b := make([]byte, n)
+	h.rng.Read(b)
+	return b
+}
+
+func (h *modelHarness) sendParts(from *validatorStub, round int32, parts *types.PartSet) {
+	for i := 0; i < int(parts.Total()); i++ {
+		part := &BlockPartMessage{Height: h.cs.Height, Round: round, Part: parts.GetPart(i)}
+		h.cs.handleMsg(msgInfo{Msg: part, PeerID: stubPeerID(int(from.Index))})
+	}
+}
+
+func (h *modelHarness) vote() {
+	index := 1 + h.rng.Intn(modelValidators-1)
+	vs := h.vss[index]
+	key := modelVoteKey{
+		modelRound: modelRound{h.cs.Height, h.cs.Round},
+		voteType:   cmtproto.PrevoteType,
+	}
+	// mostly vote in the current round, sometimes in the previous or next one
+	switch n := h.rng.Intn(10); {
+	case n < 2:
+		key.round++
+	case n < 3 && key.round > 0:
+		key.round--
+	}
+	if h.rng.Intn(2) == 0 {
+		key.voteType = cmtproto.PrecommitType
+	}
+
+	votes := h.stubVotes[index]
+	if votes == nil {
+		votes = make(map[modelVoteKey]types.BlockID)
+		h.stubVotes[index] = votes
+	}
+	blockID, voted := votes[key]
+	if voted && (index != modelByzantine || h.rng.Intn(4) > 0) {
+		// the byzantine stub sometimes equivocates
+		return
+	}
+	{
+		// honest stubs favour the proposal, so that heights get committed
+		n := h.rng.Intn(10)
+		if index != modelByzantine {
+			n /= 2
+		}
+		switch {
+		case n < 4:
+			var ok bool
+			if blockID, ok = h.proposals[key.modelRound]; !ok {
+				// wait for the proposal
+				return
+			}
+		case n < 9 || index != modelByzantine:
+			blockID = types.BlockID{}
+		default:
+			blockID = types.BlockID{
+				Hash:          tmhash.Sum(h.randBytes(8)),
+				PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum(h.randBytes(8))},
+			}
+		}
+		votes[key] = blockID
+	}
+
+	vs.Height, vs.Round = key.height, key.round
+	extEnabled := key.voteType == cmtproto.PrecommitType // enabled from the first height
+	vote := signVote(vs, key.voteType, blockID.Hash, blockID.PartSetHeader, extEnabled)
+	h.record("vote %v by %d for %v", key, index, blockID)
+	h.model.addVote(vote)
+	h.cs.handleMsg(msgInfo{Msg: &VoteMessage{vote}, PeerID: stubPeerID(index)})
+}
+
+// processInternal processes the messages the state machine sent itself, as
+// its receive routine would, checking its votes against the model.
+func (h *modelHarness) processInternal() {
+	for {
+		select {
+		case mi := <-h.cs.internalMsgQueue:
+			if msg, ok := mi.Msg.(*VoteMessage); ok {
+				vote := msg.Vote
+				key := modelVoteKey{modelRound{vote.Height, vote.Round}, vote.Type}
+				h.record("own vote %v for %v", key, vote.BlockID)
+				if err := h.model.checkOwnVote(vote); err != nil {
+					h.fail("%v", err)
+				}
+				h.model.addVote(vote)
+			}
+			if msg, ok := mi.Msg.(*ProposalMessage); ok {
+				hr := modelRound{msg.Proposal.Height, msg.Proposal.Round}
+				h.proposals[hr] = msg.Proposal.BlockID
+				h.record("own proposal %v/%v for %v", hr.height, hr.round, msg.Proposal.BlockID)
+			}
+			h.cs.handleMsg(mi)
+			if parts := h.cs.ProposalBlockParts; parts != nil && parts.IsComplete() {
+				h.blocks[types.BlockID{Hash: h.cs.ProposalBlock.Hash(), PartSetHeader: parts.Header()}.Key()] = parts
+			}
+		case <-h.cs.statsMsgQueue:
+		default:
+			return
+		}
+	}
+}
+
+// checkProgress checks that the state machine only moves forward, and that the
+// blocks it commits were committed by the model.
+func (h *modelHarness) checkProgress() {
+	cs := h.cs
+	switch {
+	case cs.Height < h.height, cs.Height == h.height && cs.Round < h.round:
+		h.fail("went back from %d/%d to %d/%d", h.height, h.round, cs.Height, cs.Round)
+	}
+	for ; h.height < cs.Height; h.height++ {
+		commit := cs.blockStore.LoadSeenCommit(h.height)
+		if commit == nil {
+			h.fail("no commit for height %d", h.height)
+			return
+		}
+		if meta := cs.blockStore.LoadBlockMeta(h.height); meta == nil || !meta.BlockID.Equals(commit.BlockID) {
+			h.fail("stored block of height %d does not match its commit %v", h.height, commit.BlockID)
+		}
+		if !h.model.committed(h.height, commit.BlockID) {
+			h.fail("committed %v at height %d without +2/3 precommits for it", commit.BlockID, h.height)
+		}
+		h.record("committed %v at height %d", commit.BlockID, h.height)
+		h.round = 0
+	}
+	h.round = cs.Round
+}
+
+func (h *modelHarness) stub(address types.Address) *validatorStub {
+	for _, vs := range h.vss[1:] {
+		pubKey, err := vs.GetPubKey()
+		require.NoError(h.t, err)
+		if pubKey.Address().String() == address.String() {
+			return vs
+		}
+	}
+	return nil
+}
+
+func (h *modelHarness) record(format string, args ...interface{}) {
+	h.trace = append(h.trace, fmt.Sprintf(format, args...))
+	if len(h.trace) > modelTraceSize {
+		h.trace = h.trace[1:]
+	}
+}
+
+func (h *modelHarness) fail(format string, args ...interface{}) {
+	h.t.Helper()
+	h.t.Fatalf("%v\n\nreproduce with -model.seed=%d -model.runs=1; last steps:\n%v",
+		fmt.Sprintf(format, args...), h.seed, strings.Join(h.trace, "\n"))
+}
+
+func stubPeerID(index int) p2p.ID {
+	return p2p.ID(fmt.Sprintf("stub%d", index))
+}
+
+// modelTicker holds the scheduled timeouts until the harness fires them, in
+// any order.
+type modelTicker struct {
+	pending []timeoutInfo
+}
+
+var _ TimeoutTicker = (*modelTicker)(nil)
+
+func (*modelTicker) Start() error                     { return nil }
+func (*modelTicker) Stop() error                      { return nil }
+func (*modelTicker) Chan() <-chan timeoutInfo         { return nil }
+func (*modelTicker) SetLogger(log.Logger)             {}
+func (m *modelTicker) ScheduleTimeout(ti timeoutInfo) { m.pending = append(m.pending, ti) }
+
+// pop removes a random timeout of the given height, forgetting those of
+// earlier heights.
+func (m *modelTicker) pop(rng *rand.Rand, height int64) (timeoutInfo, bool) {
+	pending := m.pending[:0]
+	for _, ti := range m.pending {
+		if ti.Height >= height {
+			pending = append(pending, ti)
+		}
+	}
+	m.pending = pending
+	if len(m.pending) == 0 {
+		return timeoutInfo{}, false
+	}
+	i := rng.Intn(len(m.pending))
+	ti := m.pending[i]
+	m.pending = append(m.pending[:i], m.pending[i+1:]...)
+	return ti, true
+}
+
+type modelRound struct {
+	height int64
+	round  int32
+}
+
+type modelVoteKey struct {
+	modelRound
+	voteType cmtproto.SignedMsgType
+}
+
+func (k modelVoteKey) String() string {
+	return fmt.Sprintf("%v/%v/%v", k.height, k.round, k.voteType)
+}
+
+// consensusModel is a reference model of the votes of the network, against
+// which the behavior of the state machine is checked. It counts a validator
+// towards every block it voted for, so that it never misses a quorum the state
+// machine may have seen, whatever the order it received equivocating votes in.
+type consensusModel struct {
+	valSet *types.ValidatorSet
+	// the validators that voted for each block, by vote
+	votes map[modelVoteKey]map[string]map[string]bool
+	// the votes of the state machine
+	own map[modelVoteKey]types.BlockID
+}
+
+func newConsensusModel(valSet *types.ValidatorSet) *consensusModel {
+	return &consensusModel{
+		valSet: valSet,
+		votes:  make(map[modelVoteKey]map[string]map[string]bool),
+		own:    make(map[modelVoteKey]types.BlockID),
+	}
+}
+
+func (m *consensusModel) addVote(vote *types.Vote) {
+	key := modelVoteKey{modelRound{vote.Height, vote.Round}, vote.Type}
+	blocks := m.votes[key]
+	if blocks == nil {
+		blocks = make(map[string]map[string]bool)
+		m.votes[key] = blocks
+	}
+	voters := blocks[vote.BlockID.Key()]
+	if voters == nil {
+		voters = make(map[string]bool)
+		blocks[vote.BlockID.Key()] = voters
+	}
+	voters[string(vote.ValidatorAddress)] = true
+}
+
+// quorum returns whether +2/3 of the voting power cast the given vote for
+// blockID.
+func (m *consensusModel) quorum(key modelVoteKey, blockID types.BlockID) bool {
+	var power int64
+	for address := range m.votes[key][blockID.Key()] {
+		_, val := m.valSet.GetByAddress([]byte(address))
+		power += val.VotingPower
+	}
+	return power*3 > m.valSet.TotalVotingPower()*2
+}
+
+// polka returns whether +2/3 of the voting power prevoted for any one value
+// at the given round.
+func (m *consensusModel) polka(hr modelRound) bool {
+	key := modelVoteKey{hr, cmtproto.PrevoteType}
+	for blockKey := range m.votes[key] {
+		var power int64
+		for address := range m.votes[key][blockKey] {
+			_, val := m.valSet.GetByAddress([]byte(address))
+			power += val.VotingPower
+		}
+		if power*3 > m.valSet.TotalVotingPower()*2 {
+			return true
+		}
+	}
+	return false
+}
+
+// committed returns whether blockID got +2/3 precommits in a round of height.
+func (m *consensusModel) committed(height int64, blockID types.BlockID) bool {
+	for key := range m.votes {
+		if key.height == height && key.voteType == cmtproto.PrecommitType && m.quorum(key, blockID) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkOwnVote checks that the state machine was allowed to cast vote, and
+// records it.
+func (m *consensusModel) checkOwnVote(vote *types.Vote) error {
+	key := modelVoteKey{modelRound{vote.Height, vote.Round}, vote.Type}
+	if prev, ok := m.own[key]; ok && !prev.Equals(vote.BlockID) {
+		return fmt.Errorf("equivocated at %v: voted for %v, then %v", key, prev, vote.BlockID)
+	}
+	m.own[key] = vote.BlockID
+	if vote.BlockID.IsZero() {
+		return nil
+	}
+
+	switch vote.Type {
+	case cmtproto.PrecommitType:
+		// a block is only precommitted once it got a polka
+		if !m.quorum(modelVoteKey{key.modelRound, cmtproto.PrevoteType}, vote.BlockID) {
+			return fmt.Errorf("precommitted %v at %v without a polka for it", vote.BlockID, key)
+		}
+
+	case cmtproto.PrevoteType:
+		// once locked on a block by precommitting it, only that block is
+		// prevoted, until a later polka releases the lock
+		lockRound, locked := int32(-1), types.BlockID{}
+		for k, blockID := range m.own {
+			if k.height == key.height && k.voteType == cmtproto.PrecommitType && k.round < key.round &&
+				!blockID.IsZero() && k.round > lockRound {
+				lockRound, locked = k.round, blockID
+			}
+		}
+		if lockRound < 0 || locked.Equals(vote.BlockID) {
+			return nil
+		}
+		for r := lockRound + 1; r <= key.round; r++ {
+			if m.polka(modelRound{key.height, r}) {
+				return nil
+			}
+		}
+		return fmt.Errorf("prevoted %v at %v while locked on %v since round %d", vote.BlockID, key, locked, lockRound)
+	}
+	return nil
+}
@@ -110,6 +110,44 @@ func MsgToProto(msg Message) (proto.Message, error) {
 
 		pb = vsb
 
+	case *GossipStrategyMessage:
+		pb = &cmtcons.GossipStrategy{
+			Name: msg.Name,
+		}
+
+	case *BlockPartHavesMessage:
+		haves := &cmtcons.BlockPartHaves{
+			Height: msg.Height,
+			Round:  msg.Round,
+		}
+		if parts := msg.Parts.ToProto(); parts != nil {
+			haves.Parts = *parts
+		}
+		pb = haves
+
+	case *BlockPartWantsMessage:
+		wants := &cmtcons.BlockPartWants{
+			Height: msg.Height,
+			Round:  msg.Round,
+		}
+		if parts := msg.Parts.ToProto(); parts != nil {
+			wants.Parts = *parts
+		}
+		pb = wants
+
+	case *EmptyBlockMessage:
+		eb := &cmtcons.EmptyBlock{
+			Height:          msg.Height,
+			Round:           msg.Round,
+			SquareSize:      msg.SquareSize,
+			DataHash:        msg.DataHash,
+			ProposerAddress: msg.ProposerAddress,
+		}
+		if lastCommit := msg.LastCommit.ToProto(); lastCommit != nil {
+			eb.LastCommit = *lastCommit
+		}
+		pb = eb
+
 	default:
 		return nil, fmt.Errorf("consensus: message not recognized: %T", msg)
 	}
@@ -225,6 +263,46 @@ func MsgFromProto(p proto.Message) (Message, error) {
 			BlockID: *bi,
 			Votes:   bits,
 		}
+	case *cmtcons.GossipStrategy:
+		pb = &GossipStrategyMessage{
+			Name: msg.Name,
+		}
+	case *cmtcons.BlockPartHaves:
+		parts := new(bits.BitArray)
+		parts.FromProto(&msg.Parts)
+		if err := parts.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("block part haves: %w", err)
+		}
+		pb = &BlockPartHavesMessage{
+			Height: msg.Height,
+			Round:  msg.Round,
+			Parts:  parts,
+		}
+	case *cmtcons.BlockPartWants:
+		parts := new(bits.BitArray)
+		parts.FromProto(&msg.Parts)
+		if err := parts.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("block part wants: %w", err)
+		}
+		pb = &BlockPartWantsMessage{
+			Height: msg.Height,
+			Round:  msg.Round,
+			Parts:  parts,
+		}
+	case *cmtcons.EmptyBlock:
+		lastCommit := new(bits.BitArray)
+		lastCommit.FromProto(&msg.LastCommit)
+		if err := lastCommit.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("empty block last commit: %w", err)
+		}
+		pb = &EmptyBlockMessage{
+			Height:          msg.Height,
+			Round:           msg.Round,
+			SquareSize:      msg.SquareSize,
+			DataHash:        msg.DataHash,
+			ProposerAddress: msg.ProposerAddress,
+			LastCommit:      lastCommit,
+		}
 	default:
 		return nil, fmt.Errorf("consensus: message not recognized: %T", msg)
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/libs/bits"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	"github.com/cometbft/cometbft/p2p"
@@ -169,6 +170,52 @@ func TestMsgToProto(t *testing.T) {
 			Votes:   *pbBits,
 		},
 
+			false},
+		{"successful GossipStrategy", &GossipStrategyMessage{
+			Name: "pull",
+		}, &cmtcons.GossipStrategy{
+			Name: "pull",
+		},
+
+			false},
+		{"successful BlockPartHaves", &BlockPartHavesMessage{
+			Height: 1,
+			Round:  1,
+			Parts:  bits,
+		}, &cmtcons.BlockPartHaves{
+			Height: 1,
+			Round:  1,
+			Parts:  *pbBits,
+		},
+
+			false},
+		{"successful BlockPartWants", &BlockPartWantsMessage{
+			Height: 1,
+			Round:  1,
+			Parts:  bits,
+		}, &cmtcons.BlockPartWants{
+			Height: 1,
+			Round:  1,
+			Parts:  *pbBits,
+		},
+
+			false},
+		{"successful EmptyBlock", &EmptyBlockMessage{
+			Height:          1,
+			Round:           1,
+			SquareSize:      1,
+			DataHash:        tmhash.Sum([]byte("data")),
+			ProposerAddress: tmhash.SumTruncated([]byte("proposer")),
+			LastCommit:      bits,
+		}, &cmtcons.EmptyBlock{
+			Height:          1,
+			Round:           1,
+			SquareSize:      1,
+			DataHash:        tmhash.Sum([]byte("data")),
+			ProposerAddress: tmhash.SumTruncated([]byte("proposer")),
+			LastCommit:      *pbBits,
+		},
+
 			false},
 		{"failure", nil, &cmtcons.Message{}, true},
 	}
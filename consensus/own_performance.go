@@ -0,0 +1,92 @@
+package consensus
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/libs/tempfile"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+// ownPerformance tracks the OwnPerformance of the local validator, persisting
+// it to a JSON file on every change. Changes are rare, as they mean the
+// validator is degraded. A nil ownPerformance tracks nothing.
+type ownPerformance struct {
+	mtx      cmtsync.Mutex
+	filePath string
+	counts   cstypes.OwnPerformance
+}
+
+// loadOwnPerformance loads the counts from filePath. A missing file holds no
+// counts.
+func loadOwnPerformance(filePath string) (*ownPerformance, error) {
+	p := &ownPerformance{filePath: filePath}
+
+	bz, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, &p.counts); err != nil {
+		return nil, fmt.Errorf("error reading own performance from %v: %w", filePath, err)
+	}
+	return p, nil
+}
+
+// Get returns the current counts.
+func (p *ownPerformance) Get() cstypes.OwnPerformance {
+	if p == nil {
+		return cstypes.OwnPerformance{}
+	}
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.counts
+}
+
+// MarkMissedProposal counts a missed proposal and persists the counts.
+func (p *ownPerformance) MarkMissedProposal() error {
+	if p == nil {
+		return nil
+	}
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.counts.MissedProposals++
+	return p.save()
+}
+
+// MarkLateVote counts a late vote of the given type and persists the counts.
+func (p *ownPerformance) MarkLateVote(msgType cmtproto.SignedMsgType) error {
+	if p == nil {
+		return nil
+	}
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	switch msgType {
+	case cmtproto.PrevoteType:
+		p.counts.LatePrevotes++
+	case cmtproto.PrecommitType:
+		p.counts.LatePrecommits++
+	default:
+		return fmt.Errorf("unexpected vote type %v", msgType)
+	}
+	return p.save()
+}
+
+// CONTRACT: p.mtx is held.
+func (p *ownPerformance) save() error {
+	bz, err := json.MarshalIndent(p.counts, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := cmtos.EnsureDir(filepath.Dir(p.filePath), 0700); err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(p.filePath, bz, 0600)
+}
@@ -0,0 +1,97 @@
+package consensus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+func TestOwnPerformance(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "data", "cs_own_performance.json")
+
+	p, err := loadOwnPerformance(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, cstypes.OwnPerformance{}, p.Get())
+
+	require.NoError(t, p.MarkMissedProposal())
+	require.NoError(t, p.MarkLateVote(cmtproto.PrevoteType))
+	require.NoError(t, p.MarkLateVote(cmtproto.PrecommitType))
+	require.NoError(t, p.MarkLateVote(cmtproto.PrecommitType))
+	require.Error(t, p.MarkLateVote(cmtproto.ProposalType))
+	want := cstypes.OwnPerformance{MissedProposals: 1, LatePrevotes: 1, LatePrecommits: 2}
+	assert.Equal(t, want, p.Get())
+
+	// the counts survive a restart
+	p, err = loadOwnPerformance(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, want, p.Get())
+
+	require.NoError(t, os.WriteFile(filePath, []byte("{"), 0600))
+	_, err = loadOwnPerformance(filePath)
+	require.Error(t, err)
+
+	// a nil tracker counts nothing
+	var nilPerf *ownPerformance
+	require.NoError(t, nilPerf.MarkMissedProposal())
+	assert.Equal(t, cstypes.OwnPerformance{}, nilPerf.Get())
+}
+
+func TestStateOwnLateVotes(t *testing.T) {
+	cs1, _ := randState(1)
+	height, round := cs1.Height, cs1.Round
+
+	// without a prevote timeout, our prevote is always late
+	consensusConfig := *cs1.config
+	consensusConfig.RootDir = t.TempDir()
+	consensusConfig.TimeoutPrevote = 0
+	consensusConfig.TimeoutPrevoteDelta = 0
+	cs1.config = &consensusConfig
+	cs1.loadOwnPerformance()
+
+	pv1, err := cs1.privValidator.GetPubKey()
+	require.NoError(t, err)
+	voteCh := subscribeToVoter(cs1, pv1.Address())
+
+	startTestRound(cs1, height, round)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+
+	perf := cs1.OwnPerformance()
+	assert.EqualValues(t, 1, perf.LatePrevotes)
+	assert.Zero(t, perf.MissedProposals)
+
+	persisted, err := loadOwnPerformance(cs1.config.OwnPerformanceFile())
+	require.NoError(t, err)
+	assert.Equal(t, perf, persisted.Get())
+}
+
+func TestStateOwnMissedProposal(t *testing.T) {
+	cs1, _ := randState(1)
+	height, round := cs1.Height, cs1.Round
+
+	// without a propose timeout, our proposal is always missed
+	consensusConfig := *cs1.config
+	consensusConfig.RootDir = t.TempDir()
+	consensusConfig.TimeoutPropose = 0
+	consensusConfig.TimeoutProposeDelta = 0
+	cs1.config = &consensusConfig
+	cs1.loadOwnPerformance()
+
+	pv1, err := cs1.privValidator.GetPubKey()
+	require.NoError(t, err)
+	voteCh := subscribeToVoter(cs1, pv1.Address())
+
+	startTestRound(cs1, height, round)
+	ensurePrevote(voteCh, height, round)
+
+	require.Eventually(t, func() bool {
+		return cs1.OwnPerformance().MissedProposals > 0
+	}, time.Second, 10*time.Millisecond)
+}
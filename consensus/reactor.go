@@ -8,8 +8,11 @@ import (
 	"sync"
 	"time"
 
+	cfg "github.com/cometbft/cometbft/config"
 	cstypes "github.com/cometbft/cometbft/consensus/types"
+	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/libs/bits"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtevents "github.com/cometbft/cometbft/libs/events"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
@@ -29,6 +32,7 @@ const (
 	DataChannel        = byte(0x21)
 	VoteChannel        = byte(0x22)
 	VoteSetBitsChannel = byte(0x23)
+	GossipChannel      = byte(0x24)
 
 	maxMsgSize = 1048576 // 1MB; NOTE/TODO: keep in sync with types.PartSet sizes.
 
@@ -49,6 +53,11 @@ type Reactor struct {
 	eventBus *types.EventBus
 	rs       *cstypes.RoundState
 
+	gossip       GossipStrategy
+	partRequests *blockPartRequests
+
+	onSwitchToConsensus func() // nil if not set
+
 	Metrics     *Metrics
 	traceClient trace.Tracer
 }
@@ -59,17 +68,24 @@ type ReactorOption func(*Reactor)
 // consensusState.
 func NewReactor(consensusState *State, waitSync bool, options ...ReactorOption) *Reactor {
 	conR := &Reactor{
-		conS:        consensusState,
-		waitSync:    waitSync,
-		rs:          consensusState.GetRoundState(),
-		Metrics:     NopMetrics(),
-		traceClient: trace.NoOpTracer(),
+		conS:         consensusState,
+		waitSync:     waitSync,
+		rs:           consensusState.GetRoundState(),
+		partRequests: newBlockPartRequests(),
+		Metrics:      NopMetrics(),
+		traceClient:  trace.NoOpTracer(),
 	}
 	conR.BaseReactor = *p2p.NewBaseReactor(
 		"Consensus",
 		conR,
 	)
 
+	gossip, err := NewGossipStrategy(consensusState.config.GossipStrategy)
+	if err != nil {
+		panic(err)
+	}
+	conR.gossip = gossip
+
 	for _, option := range options {
 		option(conR)
 	}
@@ -136,7 +152,8 @@ func (conR *Reactor) SwitchToConsensus(state sm.State, skipWAL bool) {
 	if skipWAL {
 		conR.conS.doWALCatchup = false
 	}
-	err := conR.conS.Start()
+	var err error
+	conR.WithProfileLabels(func() { err = conR.conS.Start() })
 	if err != nil {
 		panic(fmt.Sprintf(`Failed to start consensus state: %v
 
@@ -146,6 +163,9 @@ conS:
 conR:
 %+v`, err, conR.conS, conR))
 	}
+	if conR.onSwitchToConsensus != nil {
+		conR.onSwitchToConsensus()
+	}
 }
 
 // GetChannels implements Reactor
@@ -167,6 +187,7 @@ func (conR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			RecvBufferCapacity:  50 * 4096,
 			RecvMessageCapacity: maxMsgSize,
 			MessageType:         &cmtcons.Message{},
+			Compression:         p2p.CompressionSnappy,
 		},
 		{
 			ID:                  VoteChannel,
@@ -184,16 +205,35 @@ func (conR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			RecvMessageCapacity: maxMsgSize,
 			MessageType:         &cmtcons.Message{},
 		},
+		{
+			ID:                  GossipChannel,
+			Priority:            5,
+			SendQueueCapacity:   100,
+			RecvBufferCapacity:  1024,
+			RecvMessageCapacity: maxMsgSize,
+			MessageType:         &cmtcons.Message{},
+		},
 	}
 }
 
 // InitPeer implements Reactor by creating a state for the peer.
 func (conR *Reactor) InitPeer(peer p2p.Peer) p2p.Peer {
 	peerState := NewPeerState(peer).SetLogger(conR.Logger)
-	peer.Set(types.PeerStateKey, peerState)
+	types.PeerStateKey.Set(peer.Data(), peerState)
 	return peer
 }
 
+// GetPeerState returns the state of the peer, set by the consensus reactor
+// when the peer is initialized.
+func GetPeerState(peer p2p.Peer) (*PeerState, bool) {
+	ps, ok := types.PeerStateKey.Get(peer.Data())
+	if !ok {
+		return nil, false
+	}
+	peerState, ok := ps.(*PeerState)
+	return peerState, ok
+}
+
 // AddPeer implements Reactor by spawning multiple gossiping goroutines for the
 // peer.
 func (conR *Reactor) AddPeer(peer p2p.Peer) {
@@ -201,20 +241,29 @@ func (conR *Reactor) AddPeer(peer p2p.Peer) {
 		return
 	}
 
-	peerState, ok := peer.Get(types.PeerStateKey).(*PeerState)
+	peerState, ok := GetPeerState(peer)
 	if !ok {
 		panic(fmt.Sprintf("peer %v has no state", peer))
 	}
 	// Begin routines for this peer.
-	go conR.gossipDataRoutine(peer, peerState)
-	go conR.gossipVotesRoutine(peer, peerState)
-	go conR.queryMaj23Routine(peer, peerState)
+	conR.Go("consensus/gossip_data", func() { conR.gossipDataRoutine(peer, peerState) })
+	conR.Go("consensus/gossip_votes", func() { conR.gossipVotesRoutine(peer, peerState) })
+	conR.Go("consensus/query_maj23", func() { conR.queryMaj23Routine(peer, peerState) })
 
 	// Send our state to peer.
 	// If we're block_syncing, broadcast a RoundStepMessage later upon SwitchToConsensus().
 	if !conR.WaitSync() {
 		conR.sendNewRoundStepMessage(peer)
 	}
+
+	// Announce our gossip strategy, unless we push block parts, which is what
+	// peers do with nodes that don't announce one, e.g. older versions.
+	if conR.gossip.Name() != cfg.GossipStrategyPush {
+		peer.Send(p2p.Envelope{
+			ChannelID: GossipChannel,
+			Message:   &cmtcons.GossipStrategy{Name: conR.gossip.Name()},
+		})
+	}
 }
 
 // RemovePeer is a noop.
@@ -223,7 +272,7 @@ func (conR *Reactor) RemovePeer(p2p.Peer, interface{}) {
 		return
 	}
 	// TODO
-	// ps, ok := peer.Get(PeerStateKey).(*PeerState)
+	// ps, ok := GetPeerState(peer)
 	// if !ok {
 	// 	panic(fmt.Sprintf("Peer %v has no state", peer))
 	// }
@@ -244,12 +293,14 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 	msg, err := MsgFromProto(e.Message)
 	if err != nil {
 		conR.Logger.Error("Error decoding message", "src", e.Src, "chId", e.ChannelID, "err", err)
+		conR.Switch.PeerScorer().RecordInvalidMessage(e.Src.ID(), err)
 		conR.Switch.StopPeerForError(e.Src, err)
 		return
 	}
 
 	if err = msg.ValidateBasic(); err != nil {
 		conR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", e.Message, "err", err)
+		conR.Switch.PeerScorer().RecordInvalidMessage(e.Src.ID(), err)
 		conR.Switch.StopPeerForError(e.Src, err)
 		return
 	}
@@ -257,7 +308,7 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 	conR.Logger.Debug("Receive", "src", e.Src, "chId", e.ChannelID, "msg", msg)
 
 	// Get peer states
-	ps, ok := e.Src.Get(types.PeerStateKey).(*PeerState)
+	ps, ok := GetPeerState(e.Src)
 	if !ok {
 		panic(fmt.Sprintf("Peer %v has no state", e.Src))
 	}
@@ -280,6 +331,7 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 			)
 			if err = msg.ValidateHeight(initialHeight); err != nil {
 				conR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", msg, "err", err)
+				conR.Switch.PeerScorer().RecordInvalidMessage(e.Src.ID(), err)
 				conR.Switch.StopPeerForError(e.Src, err)
 				return
 			}
@@ -392,10 +444,19 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 				schema.Download,
 			)
 		case *BlockPartMessage:
+			conR.recordGossipedBlockPart(ps, msg)
 			ps.SetHasProposalBlockPart(msg.Height, msg.Round, int(msg.Part.Index))
 			conR.Metrics.BlockParts.With("peer_id", string(e.Src.ID())).Add(1)
 			schema.WriteBlockPart(conR.traceClient, msg.Height, msg.Round, msg.Part.Index, false, string(e.Src.ID()), schema.Download)
 			conR.conS.peerMsgQueue <- msgInfo{msg, e.Src.ID()}
+		case *EmptyBlockMessage:
+			if !conR.conS.acceptsEmptyBlocks() {
+				conR.Logger.Debug("Ignoring empty block, lazy empty proposals are disabled", "src", e.Src, "msg", msg)
+				return
+			}
+			// the peer has the whole block
+			ps.SetHasAllProposalBlockParts(msg.Height, msg.Round)
+			conR.conS.peerMsgQueue <- msgInfo{msg, e.Src.ID()}
 		default:
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
@@ -453,6 +514,25 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
 
+	case GossipChannel:
+		switch msg := msg.(type) {
+		case *GossipStrategyMessage:
+			ps.SetGossipStrategy(msg.Name)
+		case *BlockPartHavesMessage:
+			if conR.WaitSync() {
+				return
+			}
+			ps.ApplyBlockPartHavesMessage(msg)
+		case *BlockPartWantsMessage:
+			if conR.WaitSync() {
+				return
+			}
+			ps.ApplyBlockPartWantsMessage(msg)
+		default:
+			// don't punish (leave room for soft upgrades)
+			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+		}
+
 	default:
 		conR.Logger.Error(fmt.Sprintf("Unknown chId %X", e.ChannelID))
 	}
@@ -492,6 +572,13 @@ func (conR *Reactor) subscribeToBroadcastEvents() {
 		conR.Logger.Error("Error adding listener for events", "err", err)
 	}
 
+	if err := conR.conS.evsw.AddListenerForEvent(subscriber, eventEmptyBlockRebuilt,
+		func(data cmtevents.EventData) {
+			conR.broadcastBlockPartHavesMessage(data.(*cstypes.RoundState))
+		}); err != nil {
+		conR.Logger.Error("Error adding listener for events", "err", err)
+	}
+
 	if err := conR.conS.evsw.AddListenerForEvent(subscriber, types.EventVote,
 		func(data cmtevents.EventData) {
 			conR.broadcastHasVoteMessage(data.(*types.Vote))
@@ -545,6 +632,20 @@ func (conR *Reactor) broadcastNewValidBlockMessage(rs *cstypes.RoundState) {
 	)
 }
 
+// broadcastBlockPartHavesMessage tells peers which parts of the proposal block
+// we have, so that the ones which sent it as an EmptyBlockMessage don't send
+// us the parts.
+func (conR *Reactor) broadcastBlockPartHavesMessage(rs *cstypes.RoundState) {
+	conR.Switch.Broadcast(p2p.Envelope{
+		ChannelID: GossipChannel,
+		Message: &cmtcons.BlockPartHaves{
+			Height: rs.Height,
+			Round:  rs.Round,
+			Parts:  *rs.ProposalBlockParts.BitArray().ToProto(),
+		},
+	})
+}
+
 // Broadcasts HasVoteMessage to peers that care.
 func (conR *Reactor) broadcastHasVoteMessage(vote *types.Vote) {
 	msg := &cmtcons.HasVote{
@@ -569,7 +670,7 @@ func (conR *Reactor) broadcastHasVoteMessage(vote *types.Vote) {
 	/*
 		// TODO: Make this broadcast more selective.
 		for _, peer := range conR.Switch.Peers().List() {
-			ps, ok := peer.Get(PeerStateKey).(*PeerState)
+			ps, ok := GetPeerState(peer)
 			if !ok {
 				panic(fmt.Sprintf("Peer %v has no state", peer))
 			}
@@ -653,8 +754,20 @@ OUTER_LOOP:
 		prs := ps.GetRoundState()
 
 		// Send proposal Block parts?
-		if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) {
-			if index, ok := rs.ProposalBlockParts.BitArray().Sub(prs.ProposalBlockParts.Copy()).PickRandom(); ok {
+		if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) && !conR.gossipEmptyBlock(rs, prs, ps, peer) {
+			gossip := conR.gossipStrategy(ps)
+			g := BlockPartGossip{
+				Peer:    ps,
+				Missing: rs.ProposalBlockParts.BitArray().Sub(prs.ProposalBlockParts.Copy()),
+				Availability: func() []int {
+					return conR.blockPartAvailability(prs.ProposalBlockPartSetHeader)
+				},
+			}
+			if gossip.Pull() {
+				conR.pullBlockParts(rs, prs, ps, peer)
+				g.Wants = ps.BlockPartWants(prs.Height, prs.Round)
+			}
+			if index, ok := gossip.PickBlockPart(g); ok {
 				part := rs.ProposalBlockParts.GetPart(index)
 				parts, err := part.ToProto()
 				if err != nil {
@@ -670,6 +783,7 @@ OUTER_LOOP:
 					},
 				}) {
 					ps.SetHasProposalBlockPart(prs.Height, prs.Round, index)
+					conR.Metrics.GossipBlockPartsSent.With("strategy", gossip.Name()).Add(1)
 				}
 				continue OUTER_LOOP
 			}
@@ -763,6 +877,107 @@ OUTER_LOOP:
 	}
 }
 
+// gossipEmptyBlock sends a complete empty proposal block to the peer as an
+// EmptyBlockMessage, if lazy empty proposals are enabled. It returns true if
+// the block parts must not be sent to the peer, while it may still rebuild
+// the block.
+func (conR *Reactor) gossipEmptyBlock(rs *cstypes.RoundState, prs *cstypes.PeerRoundState, ps *PeerState,
+	peer p2p.Peer,
+) bool {
+	if prs.Height != rs.Height || !rs.ProposalBlockParts.IsComplete() || !isEmptyBlock(rs.ProposalBlock) ||
+		prs.ProposalBlockParts == nil || prs.ProposalBlockParts.IsFull() || !conR.conS.lazyEmptyProposals() {
+		return false
+	}
+	sentAt, sent := ps.emptyBlockSentAt(rs.Height, rs.Round)
+	if sent {
+		return time.Since(sentAt) < conR.conS.config.LazyEmptyProposalTimeout
+	}
+	msg, err := MsgToProto(newEmptyBlockMessage(rs.Round, rs.ProposalBlock))
+	if err != nil {
+		panic(err)
+	}
+	conR.Logger.Debug("Sending empty block", "peer", peer, "height", rs.Height, "round", rs.Round)
+	if peer.Send(p2p.Envelope{
+		ChannelID: DataChannel,
+		Message:   msg,
+	}) {
+		ps.setSentEmptyBlock(rs.Height, rs.Round)
+		return true
+	}
+	return false
+}
+
+// gossipStrategy returns the strategy used to gossip block parts to the peer:
+// ours if the peer announced the same one, push otherwise.
+func (conR *Reactor) gossipStrategy(ps *PeerState) GossipStrategy {
+	if ps.GossipStrategy() == conR.gossip.Name() {
+		return conR.gossip
+	}
+	return pushGossip{}
+}
+
+// blockPartAvailability counts, for every part of the proposal block with the
+// given header, the peers known to have it.
+func (conR *Reactor) blockPartAvailability(header types.PartSetHeader) []int {
+	availability := make([]int, header.Total)
+	for _, peer := range conR.Switch.Peers().List() {
+		ps, ok := GetPeerState(peer)
+		if !ok {
+			continue
+		}
+		ps.countProposalBlockParts(header, availability)
+	}
+	return availability
+}
+
+// pullBlockParts announces the block parts we have to a peer using pull-based
+// gossip and requests the parts we are missing that the peer has.
+func (conR *Reactor) pullBlockParts(rs *cstypes.RoundState, prs *cstypes.PeerRoundState, ps *PeerState, peer p2p.Peer) {
+	ours := rs.ProposalBlockParts.BitArray()
+	if ps.shouldSendBlockPartHaves(rs.Height, rs.Round, ours) {
+		if peer.Send(p2p.Envelope{
+			ChannelID: GossipChannel,
+			Message: &cmtcons.BlockPartHaves{
+				Height: rs.Height,
+				Round:  rs.Round,
+				Parts:  *ours.ToProto(),
+			},
+		}) {
+			ps.setSentBlockPartHaves(rs.Height, rs.Round, ours)
+		}
+	}
+
+	if rs.ProposalBlockParts.IsComplete() || prs.ProposalBlockParts == nil {
+		return
+	}
+	wants := conR.partRequests.claim(rs.Height, rs.Round, peer.ID(), prs.ProposalBlockParts.Sub(ours))
+	if wants.IsEmpty() || !ps.shouldSendBlockPartWants(rs.Height, rs.Round, wants) {
+		return
+	}
+	if peer.Send(p2p.Envelope{
+		ChannelID: GossipChannel,
+		Message: &cmtcons.BlockPartWants{
+			Height: rs.Height,
+			Round:  rs.Round,
+			Parts:  *wants.ToProto(),
+		},
+	}) {
+		ps.setSentBlockPartWants(rs.Height, rs.Round, wants)
+	}
+}
+
+// recordGossipedBlockPart updates the gossip metrics for a block part received
+// from a peer, counting the parts we already had as duplicates.
+func (conR *Reactor) recordGossipedBlockPart(ps *PeerState, msg *BlockPartMessage) {
+	strategy := conR.gossipStrategy(ps).Name()
+	conR.Metrics.GossipBlockPartsReceived.With("strategy", strategy).Add(1)
+	rs := conR.getRoundState()
+	if rs.Height == msg.Height && rs.Round == msg.Round &&
+		rs.ProposalBlockParts != nil && rs.ProposalBlockParts.BitArray().GetIndex(int(msg.Part.Index)) {
+		conR.Metrics.GossipDuplicateBlockParts.With("strategy", strategy).Add(1)
+	}
+}
+
 func (conR *Reactor) gossipDataForCatchup(logger log.Logger, rs *cstypes.RoundState,
 	prs *cstypes.PeerRoundState, ps *PeerState, peer p2p.Peer,
 ) {
@@ -1140,7 +1355,7 @@ func (conR *Reactor) peerStatsRoutine() {
 				continue
 			}
 			// Get peer state
-			ps, ok := peer.Get(types.PeerStateKey).(*PeerState)
+			ps, ok := GetPeerState(peer)
 			if !ok {
 				panic(fmt.Sprintf("Peer %v has no state", peer))
 			}
@@ -1176,7 +1391,7 @@ func (conR *Reactor) StringIndented(indent string) string {
 	s := "ConsensusReactor{\n"
 	s += indent + "  " + conR.conS.StringIndented(indent+"  ") + "\n"
 	for _, peer := range conR.Switch.Peers().List() {
-		ps, ok := peer.Get(types.PeerStateKey).(*PeerState)
+		ps, ok := GetPeerState(peer)
 		if !ok {
 			panic(fmt.Sprintf("Peer %v has no state", peer))
 		}
@@ -1195,6 +1410,18 @@ func ReactorTracing(traceClient trace.Tracer) ReactorOption {
 	return func(conR *Reactor) { conR.traceClient = traceClient }
 }
 
+// ReactorGossipStrategy overrides the block part gossip strategy selected in
+// the config.
+func ReactorGossipStrategy(gossip GossipStrategy) ReactorOption {
+	return func(conR *Reactor) { conR.gossip = gossip }
+}
+
+// ReactorOnSwitchToConsensus sets a function called once the consensus state
+// machine is started by SwitchToConsensus.
+func ReactorOnSwitchToConsensus(fn func()) ReactorOption {
+	return func(conR *Reactor) { conR.onSwitchToConsensus = fn }
+}
+
 //-----------------------------------------------------------------------------
 
 var (
@@ -1213,6 +1440,33 @@ type PeerState struct {
 	mtx   sync.Mutex             // NOTE: Modify below using setters, never directly.
 	PRS   cstypes.PeerRoundState `json:"round_state"` // Exposed.
 	Stats *peerStateStats        `json:"stats"`       // Exposed.
+
+	// block part gossip
+	gossipStrategy string
+	wants          blockPartBits // parts the peer requested
+	sentHaves      blockPartBits // parts we last announced to the peer
+	sentHavesAt    time.Time
+	sentWants      blockPartBits // parts we last requested from the peer
+	sentWantsAt    time.Time
+
+	// the empty proposal block we last sent as an EmptyBlockMessage
+	sentEmptyBlockHeight int64
+	sentEmptyBlockRound  int32
+	sentEmptyBlockAt     time.Time
+}
+
+// blockPartBits are block parts of the proposal at a height and round.
+type blockPartBits struct {
+	height int64
+	round  int32
+	parts  *bits.BitArray
+}
+
+func (b blockPartBits) at(height int64, round int32) *bits.BitArray {
+	if b.height != height || b.round != round {
+		return nil
+	}
+	return b.parts
 }
 
 // peerStateStats holds internal statistics for a peer.
@@ -1258,6 +1512,23 @@ func (ps *PeerState) GetRoundState() *cstypes.PeerRoundState {
 	return &prs
 }
 
+// countProposalBlockParts increments the availability of each part of the
+// proposal block with the given part set header that the peer has, without
+// copying its round state.
+func (ps *PeerState) countProposalBlockParts(header types.PartSetHeader, availability []int) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if !ps.PRS.ProposalBlockPartSetHeader.Equals(header) || ps.PRS.ProposalBlockParts == nil {
+		return
+	}
+	for i := range availability {
+		if ps.PRS.ProposalBlockParts.GetIndex(i) {
+			availability[i]++
+		}
+	}
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 func (ps *PeerState) MarshalJSON() ([]byte, error) {
 	ps.mtx.Lock()
@@ -1326,6 +1597,129 @@ func (ps *PeerState) SetHasProposalBlockPart(height int64, round int32, index in
 	ps.PRS.ProposalBlockParts.SetIndex(index, true)
 }
 
+// SetHasAllProposalBlockParts sets all the parts of the proposal block as
+// known for the peer.
+func (ps *PeerState) SetHasAllProposalBlockParts(height int64, round int32) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.PRS.Height != height || ps.PRS.Round != round || ps.PRS.ProposalBlockParts == nil {
+		return
+	}
+
+	ps.PRS.ProposalBlockParts.Update(ps.PRS.ProposalBlockParts.Not().Or(ps.PRS.ProposalBlockParts))
+}
+
+// SetGossipStrategy sets the block part gossip strategy the peer announced.
+func (ps *PeerState) SetGossipStrategy(name string) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.gossipStrategy = name
+}
+
+// GossipStrategy returns the block part gossip strategy the peer announced,
+// or an empty string if it didn't.
+func (ps *PeerState) GossipStrategy() string {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	return ps.gossipStrategy
+}
+
+// ApplyBlockPartHavesMessage marks the block parts the peer announced as
+// known for the peer.
+func (ps *PeerState) ApplyBlockPartHavesMessage(msg *BlockPartHavesMessage) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.PRS.Height != msg.Height || ps.PRS.Round != msg.Round {
+		return
+	}
+	if ps.PRS.ProposalBlockParts == nil || ps.PRS.ProposalBlockParts.Size() != msg.Parts.Size() {
+		return
+	}
+
+	ps.PRS.ProposalBlockParts.Update(ps.PRS.ProposalBlockParts.Or(msg.Parts))
+}
+
+// ApplyBlockPartWantsMessage records the block parts requested by the peer.
+func (ps *PeerState) ApplyBlockPartWantsMessage(msg *BlockPartWantsMessage) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.wants = blockPartBits{height: msg.Height, round: msg.Round, parts: msg.Parts}
+}
+
+// BlockPartWants returns the block parts the peer last requested at the given
+// height and round, or nil if it requested none.
+func (ps *PeerState) BlockPartWants(height int64, round int32) *bits.BitArray {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	return ps.wants.at(height, round)
+}
+
+// shouldSendBlockPartHaves returns true if we got parts since we last
+// announced them to the peer, or if that announcement might have been dropped
+// because the peer didn't know about the proposal yet.
+func (ps *PeerState) shouldSendBlockPartHaves(height int64, round int32, haves *bits.BitArray) bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	sent := ps.sentHaves.at(height, round)
+	return sent == nil || !haves.Sub(sent).IsEmpty() ||
+		time.Since(ps.sentHavesAt) >= blockPartRequestTimeout
+}
+
+func (ps *PeerState) setSentBlockPartHaves(height int64, round int32, haves *bits.BitArray) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.sentHaves = blockPartBits{height: height, round: round, parts: haves}
+	ps.sentHavesAt = time.Now()
+}
+
+// shouldSendBlockPartWants returns true if the parts differ from the ones last
+// requested from the peer, or if that request timed out.
+func (ps *PeerState) shouldSendBlockPartWants(height int64, round int32, wants *bits.BitArray) bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	sent := ps.sentWants.at(height, round)
+	return sent == nil || sent.String() != wants.String() ||
+		time.Since(ps.sentWantsAt) >= blockPartRequestTimeout
+}
+
+func (ps *PeerState) setSentBlockPartWants(height int64, round int32, wants *bits.BitArray) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.sentWants = blockPartBits{height: height, round: round, parts: wants}
+	ps.sentWantsAt = time.Now()
+}
+
+// emptyBlockSentAt returns when the empty proposal block of the height and
+// round was sent to the peer as an EmptyBlockMessage, if it was.
+func (ps *PeerState) emptyBlockSentAt(height int64, round int32) (time.Time, bool) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.sentEmptyBlockHeight != height || ps.sentEmptyBlockRound != round {
+		return time.Time{}, false
+	}
+	return ps.sentEmptyBlockAt, true
+}
+
+func (ps *PeerState) setSentEmptyBlock(height int64, round int32) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.sentEmptyBlockHeight = height
+	ps.sentEmptyBlockRound = round
+	ps.sentEmptyBlockAt = time.Now()
+}
+
 // PickSendVote picks a vote and sends it to the peer.
 // Returns true if vote was sent.
 func (ps *PeerState) PickSendVote(votes types.VoteSetReader) *types.Vote {
@@ -1700,6 +2094,10 @@ func init() {
 	cmtjson.RegisterType(&HasVoteMessage{}, "tendermint/HasVote")
 	cmtjson.RegisterType(&VoteSetMaj23Message{}, "tendermint/VoteSetMaj23")
 	cmtjson.RegisterType(&VoteSetBitsMessage{}, "tendermint/VoteSetBits")
+	cmtjson.RegisterType(&GossipStrategyMessage{}, "tendermint/GossipStrategy")
+	cmtjson.RegisterType(&BlockPartHavesMessage{}, "tendermint/BlockPartHaves")
+	cmtjson.RegisterType(&BlockPartWantsMessage{}, "tendermint/BlockPartWants")
+	cmtjson.RegisterType(&EmptyBlockMessage{}, "tendermint/EmptyBlock")
 }
 
 //-------------------------------------
@@ -1997,3 +2395,125 @@ func (m *VoteSetBitsMessage) String() string {
 }
 
 //-------------------------------------
+
+// GossipStrategyMessage announces the block part gossip strategy a node uses.
+type GossipStrategyMessage struct {
+	Name string
+}
+
+// ValidateBasic performs basic validation.
+func (m *GossipStrategyMessage) ValidateBasic() error {
+	if m.Name == "" {
+		return errors.New("empty Name")
+	}
+	if len(m.Name) > maxGossipStrategyNameLength {
+		return fmt.Errorf("name is too long: %d, max: %d", len(m.Name), maxGossipStrategyNameLength)
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *GossipStrategyMessage) String() string {
+	return fmt.Sprintf("[GossipStrategy %v]", m.Name)
+}
+
+//-------------------------------------
+
+// BlockPartHavesMessage announces the proposal block parts a node has.
+type BlockPartHavesMessage struct {
+	Height int64
+	Round  int32
+	Parts  *bits.BitArray
+}
+
+// ValidateBasic performs basic validation.
+func (m *BlockPartHavesMessage) ValidateBasic() error {
+	return validateBlockPartBits(m.Height, m.Round, m.Parts)
+}
+
+// String returns a string representation.
+func (m *BlockPartHavesMessage) String() string {
+	return fmt.Sprintf("[BlockPartHaves H:%v R:%v BA:%v]", m.Height, m.Round, m.Parts)
+}
+
+//-------------------------------------
+
+// BlockPartWantsMessage requests proposal block parts from a peer.
+type BlockPartWantsMessage struct {
+	Height int64
+	Round  int32
+	Parts  *bits.BitArray
+}
+
+// ValidateBasic performs basic validation.
+func (m *BlockPartWantsMessage) ValidateBasic() error {
+	return validateBlockPartBits(m.Height, m.Round, m.Parts)
+}
+
+// String returns a string representation.
+func (m *BlockPartWantsMessage) String() string {
+	return fmt.Sprintf("[BlockPartWants H:%v R:%v BA:%v]", m.Height, m.Round, m.Parts)
+}
+
+//-------------------------------------
+
+// EmptyBlockMessage replaces the parts of an empty proposal block. The
+// receiver rebuilds the block from its state, the precommits of the previous
+// height it has, and the fields of the message.
+type EmptyBlockMessage struct {
+	Height          int64
+	Round           int32
+	SquareSize      uint64
+	DataHash        cmtbytes.HexBytes
+	ProposerAddress types.Address
+	// LastCommit marks the precommits included in the last commit of the block.
+	LastCommit *bits.BitArray
+}
+
+// ValidateBasic performs basic validation.
+func (m *EmptyBlockMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	if m.Round < 0 {
+		return errors.New("negative Round")
+	}
+	if err := types.ValidateHash(m.DataHash); err != nil {
+		return fmt.Errorf("wrong DataHash: %v", err)
+	}
+	if len(m.ProposerAddress) != crypto.AddressSize {
+		return fmt.Errorf("expected ProposerAddress size to be %d bytes, got %d bytes",
+			crypto.AddressSize, len(m.ProposerAddress))
+	}
+	if m.LastCommit.Size() > types.MaxVotesCount {
+		return fmt.Errorf("lastCommit bit array is too big: %d, max: %d", m.LastCommit.Size(), types.MaxVotesCount)
+	}
+	if err := m.LastCommit.ValidateBasic(); err != nil {
+		return fmt.Errorf("wrong LastCommit: %w", err)
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *EmptyBlockMessage) String() string {
+	return fmt.Sprintf("[EmptyBlock H:%v R:%v LC:%v]", m.Height, m.Round, m.LastCommit)
+}
+
+func validateBlockPartBits(height int64, round int32, parts *bits.BitArray) error {
+	if height < 0 {
+		return errors.New("negative Height")
+	}
+	if round < 0 {
+		return errors.New("negative Round")
+	}
+	if parts.Size() == 0 {
+		return errors.New("empty Parts")
+	}
+	if parts.Size() > int(types.MaxBlockPartsCount) {
+		return fmt.Errorf("parts bit array is too big: %d, max: %d", parts.Size(), types.MaxBlockPartsCount)
+	}
+	if err := parts.ValidateBasic(); err != nil {
+		return fmt.Errorf("wrong Parts: %w", err)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/libs/bits"
 	"github.com/cometbft/cometbft/libs/bytes"
+	cmtevents "github.com/cometbft/cometbft/libs/events"
 	"github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
@@ -121,6 +123,68 @@ func TestReactorBasic(t *testing.T) {
 	})
 }
 
+func TestReactorGossipStrategies(t *testing.T) {
+	testCases := map[string][]string{
+		"rarest-first": {
+			cfg.GossipStrategyRarestFirst, cfg.GossipStrategyRarestFirst,
+			cfg.GossipStrategyRarestFirst, cfg.GossipStrategyRarestFirst,
+		},
+		"pull": {
+			cfg.GossipStrategyPull, cfg.GossipStrategyPull,
+			cfg.GossipStrategyPull, cfg.GossipStrategyPull,
+		},
+		"mixed": {
+			cfg.GossipStrategyPush, cfg.GossipStrategyRarestFirst,
+			cfg.GossipStrategyPull, cfg.GossipStrategyPull,
+		},
+	}
+	for name, strategies := range testCases {
+		strategies := strategies
+		t.Run(name, func(t *testing.T) {
+			N := len(strategies)
+			css, cleanup := randConsensusNet(t, N, "consensus_reactor_test", newMockTickerFunc(true), newKVStore)
+			defer cleanup()
+			for i, cs := range css {
+				cs.config.GossipStrategy = strategies[i]
+			}
+			reactors, blocksSubs, eventBuses := startConsensusNet(t, css, N)
+			defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+
+			// wait till everyone makes a couple of blocks
+			for i := 0; i < 2; i++ {
+				timeoutWaitGroup(N, func(j int) {
+					<-blocksSubs[j].Out()
+				})
+			}
+		})
+	}
+}
+
+func TestReactorLazyEmptyProposals(t *testing.T) {
+	N := 4
+	css, cleanup := randConsensusNet(t, N, "consensus_reactor_test", newMockTickerFunc(true), newKVStore)
+	defer cleanup()
+	var rebuilt atomic.Int32
+	for _, cs := range css {
+		cs.state.ConsensusParams.Gossip.LazyEmptyProposals = true
+		err := cs.evsw.AddListenerForEvent("test", eventEmptyBlockRebuilt, func(cmtevents.EventData) {
+			rebuilt.Add(1)
+		})
+		require.NoError(t, err)
+	}
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, N)
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+
+	// the first block has no last commit, the next ones are rebuilt from
+	// the empty block messages
+	for i := 0; i < 3; i++ {
+		timeoutWaitGroup(N, func(j int) {
+			<-blocksSubs[j].Out()
+		})
+	}
+	assert.Positive(t, rebuilt.Load())
+}
+
 // Ensure we can process blocks with evidence
 func TestReactorWithEvidence(t *testing.T) {
 	nValidators := 4
@@ -430,7 +494,8 @@ func TestReactorRecordsVotesAndBlockParts(t *testing.T) {
 	// Get peer
 	peer := reactors[1].Switch.Peers().List()[0]
 	// Get peer state
-	ps := peer.Get(types.PeerStateKey).(*PeerState)
+	ps, ok := GetPeerState(peer)
+	require.True(t, ok)
 
 	assert.Equal(t, true, ps.VotesSent() > 0, "number of votes sent should have increased")
 	assert.Equal(t, true, ps.BlockPartsSent() > 0, "number of votes sent should have increased")
@@ -1099,6 +1164,65 @@ func TestVoteSetBitsMessageValidateBasic(t *testing.T) {
 	}
 }
 
+func TestBlockPartHavesMessageValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName string
+		height   int64
+		round    int32
+		parts    *bits.BitArray
+		expErr   bool
+	}{
+		{"Valid Message", 1, 0, bits.NewBitArray(1), false},
+		{"Negative Height", -1, 0, bits.NewBitArray(1), true},
+		{"Negative Round", 1, -1, bits.NewBitArray(1), true},
+		{"Empty Parts", 1, 0, bits.NewBitArray(0), true},
+		{"Too Many Parts", 1, 0, bits.NewBitArray(int(types.MaxBlockPartsCount) + 1), true},
+		{"Too Few Elems", 1, 0, &bits.BitArray{Bits: 65, Elems: make([]uint64, 1)}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			haves := &BlockPartHavesMessage{Height: tc.height, Round: tc.round, Parts: tc.parts}
+			wants := &BlockPartWantsMessage{Height: tc.height, Round: tc.round, Parts: tc.parts}
+			assert.Equal(t, tc.expErr, haves.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+			assert.Equal(t, tc.expErr, wants.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
+func TestEmptyBlockMessageValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		messageModify func(*EmptyBlockMessage)
+		expErr        bool
+	}{
+		{"Valid Message", func(msg *EmptyBlockMessage) {}, false},
+		{"Negative Height", func(msg *EmptyBlockMessage) { msg.Height = -1 }, true},
+		{"Negative Round", func(msg *EmptyBlockMessage) { msg.Round = -1 }, true},
+		{"Invalid DataHash", func(msg *EmptyBlockMessage) { msg.DataHash = []byte{0x01} }, true},
+		{"Invalid ProposerAddress", func(msg *EmptyBlockMessage) { msg.ProposerAddress = []byte{0x01} }, true},
+		{"Too Many Votes", func(msg *EmptyBlockMessage) { msg.LastCommit = bits.NewBitArray(types.MaxVotesCount + 1) }, true},
+		{"Too Few Elems", func(msg *EmptyBlockMessage) { msg.LastCommit = &bits.BitArray{Bits: 65, Elems: make([]uint64, 1)} }, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			msg := &EmptyBlockMessage{
+				Height:          1,
+				Round:           0,
+				SquareSize:      1,
+				DataHash:        tmhash.Sum([]byte("data")),
+				ProposerAddress: tmhash.SumTruncated([]byte("proposer")),
+				LastCommit:      bits.NewBitArray(4),
+			}
+			tc.messageModify(msg)
+			assert.Equal(t, tc.expErr, msg.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
 func TestMarshalJSONPeerState(t *testing.T) {
 	ps := NewPeerState(nil)
 	data, err := json.Marshal(ps)
@@ -206,7 +206,8 @@ type Handshaker struct {
 	genDoc       *types.GenesisDoc
 	logger       log.Logger
 
-	nBlocks int // number of blocks applied to the state
+	nBlocks    int   // number of blocks applied to the state
+	haltHeight int64 // height of the block replayed after which the upgrade params halt the node, if any
 }
 
 func NewHandshaker(stateStore sm.Store, state sm.State,
@@ -238,6 +239,13 @@ func (h *Handshaker) NBlocks() int {
 	return h.nBlocks
 }
 
+// HaltHeight returns the height of the block replayed after which the upgrade
+// consensus params halt the node, or 0 if none was, see
+// sm.BlockExecutorWithHaltHeight.
+func (h *Handshaker) HaltHeight() int64 {
+	return h.haltHeight
+}
+
 // TODO: retry the handshake/replay if it fails ?
 func (h *Handshaker) Handshake(proxyApp proxy.AppConns) (string, error) {
 	return h.HandshakeWithContext(context.TODO(), proxyApp)
@@ -542,6 +550,9 @@ func (h *Handshaker) replayBlock(state sm.State, height int64, proxyApp proxy.Ap
 	if err != nil {
 		return sm.State{}, err
 	}
+	if halt := blockExec.HaltHeight(); halt > 0 {
+		h.haltHeight = halt
+	}
 
 	h.nBlocks++
 
@@ -126,6 +126,8 @@ type State struct {
 	wal          WAL
 	replayMode   bool // so we don't log signing errors during replay
 	doWALCatchup bool // determines if we even try to do the catchup
+	// last height ended in the WAL, checked by the invariant checks
+	walEndHeight int64
 
 	// for tests where we want to limit the number of transitions the state makes
 	nSteps int
@@ -150,6 +152,22 @@ type State struct {
 
 	// traceClient is used to trace the state machine.
 	traceClient trace.Tracer
+
+	// counts the proposals and votes of the validator not sent on time
+	ownPerformance *ownPerformance
+	// our signed proposal whose block parts are not all processed yet
+	ownProposal *pendingProposal
+
+	// the WAL repairs made on start, not published yet
+	walRepairs []types.EventDataWALRepaired
+
+	// the proposal block parts whose first part was received from a peer,
+	// and when, to measure the propagation time of the block
+	propagatingParts *types.PartSet
+	propagationStart time.Time
+
+	// the height after which consensus halted for an upgrade, 0 if it did not
+	haltHeight int64
 }
 
 // StateOption sets an optional parameter on the State.
@@ -336,6 +354,8 @@ func (cs *State) OnStart() error {
 	}
 
 	cs.metrics.Height.Set(float64(cs.Height))
+	cs.loadOwnPerformance()
+	cs.warnIgnoredVoteDelays()
 
 	// we need the timeoutRoutine for replay so
 	// we don't block on the tick chan.
@@ -375,22 +395,21 @@ func (cs *State) OnStart() error {
 
 			repairAttempted = true
 
-			// 2) backup original WAL file
-			corruptedFile := fmt.Sprintf("%s.CORRUPTED", cs.config.WalFile())
-			if err := cmtos.CopyFile(cs.config.WalFile(), corruptedFile); err != nil {
+			// 2) repair the WAL, and its replica which holds the same records
+			// and would otherwise keep the corrupted tail before the new ones
+			repaired, err := cs.repairWAL(cs.config.WalFile())
+			if err != nil {
 				return err
 			}
-
-			cs.Logger.Debug("backed up WAL file", "src", cs.config.WalFile(), "dst", corruptedFile)
-
-			// 3) try to repair (WAL file will be overwritten!)
-			if err := repairWalFile(corruptedFile, cs.config.WalFile()); err != nil {
-				cs.Logger.Error("the WAL repair failed", "err", err)
-				return err
+			cs.walRepairs = append(cs.walRepairs, repaired)
+			if replicaFile := cs.config.WalReplicaFile(); replicaFile != "" && cmtos.FileExists(replicaFile) {
+				repaired, err := cs.repairWAL(replicaFile)
+				if err != nil {
+					return err
+				}
+				cs.walRepairs = append(cs.walRepairs, repaired)
 			}
 
-			cs.Logger.Info("successful WAL repair")
-
 			// reload WAL file
 			if err := cs.loadWalFile(); err != nil {
 				return err
@@ -407,6 +426,12 @@ func (cs *State) OnStart() error {
 		return err
 	}
 
+	// The block executor halted for an upgrade while catching up, with block
+	// sync or the handshake replay.
+	if halt := cs.blockExec.HaltHeight(); halt > 0 && halt == cs.state.LastBlockHeight {
+		cs.haltForUpgrade(halt, cs.state.LastBlockTime)
+	}
+
 	// now start the receiveRoutine
 	go cs.receiveRoutine(0)
 
@@ -429,6 +454,73 @@ func (cs *State) startRoutines(maxSteps int) {
 	go cs.receiveRoutine(maxSteps)
 }
 
+// loadOwnPerformance loads the persisted counts of the proposals and votes of
+// the validator which were not sent on time, unless already loaded. A file
+// which cannot be read is logged and the counts start again from zero, as they
+// are not worth failing to start for.
+func (cs *State) loadOwnPerformance() {
+	if cs.ownPerformance != nil {
+		return
+	}
+	p, err := loadOwnPerformance(cs.config.OwnPerformanceFile())
+	if err != nil {
+		cs.Logger.Error("failed to load own performance; counting from zero", "err", err)
+		p = &ownPerformance{filePath: cs.config.OwnPerformanceFile()}
+	}
+	counts := p.Get()
+	cs.metrics.OwnMissedProposals.Add(float64(counts.MissedProposals))
+	cs.metrics.OwnLateVotes.With("vote_type", types.SignedMsgTypeToShortString(cmtproto.PrevoteType)).Add(float64(counts.LatePrevotes))
+	cs.metrics.OwnLateVotes.With("vote_type", types.SignedMsgTypeToShortString(cmtproto.PrecommitType)).Add(float64(counts.LatePrecommits))
+	cs.ownPerformance = p
+}
+
+// OwnPerformance returns the counts of the proposals and votes of the
+// validator which were not sent on time.
+func (cs *State) OwnPerformance() cstypes.OwnPerformance {
+	return cs.ownPerformance.Get()
+}
+
+// markMissedProposal counts a proposal of the validator not sent on time.
+func (cs *State) markMissedProposal(height int64, round int32) {
+	cs.Logger.Error("missed our own proposal", "height", height, "round", round)
+	cs.metrics.OwnMissedProposals.Add(1)
+	if err := cs.ownPerformance.MarkMissedProposal(); err != nil {
+		cs.Logger.Error("failed to persist own performance", "err", err)
+	}
+}
+
+// pendingProposal is a proposal of the validator, signed at the given height
+// and round after deciding on it from start.
+type pendingProposal struct {
+	height int64
+	round  int32
+	start  time.Time
+}
+
+// finishOwnProposal checks that our pending proposal, if any, was sent on
+// time, i.e. that it and all its block parts were processed, and thus made
+// available to the peers, within the propose timeout. It is missed if it is
+// still incomplete once its round is over.
+func (cs *State) finishOwnProposal(complete bool) {
+	p := cs.ownProposal
+	if p == nil || complete && (p.height != cs.Height || p.round != cs.Round) {
+		return
+	}
+	cs.ownProposal = nil
+	if !complete || cmttime.Now().Sub(p.start) > cs.config.Propose(p.round) {
+		cs.markMissedProposal(p.height, p.round)
+	}
+}
+
+// markLateVote counts a vote of the validator not sent on time.
+func (cs *State) markLateVote(msgType cmtproto.SignedMsgType, took time.Duration) {
+	cs.Logger.Info("sent our own vote late", "height", cs.Height, "round", cs.Round, "type", msgType, "took", took)
+	cs.metrics.OwnLateVotes.With("vote_type", types.SignedMsgTypeToShortString(msgType)).Add(1)
+	if err := cs.ownPerformance.MarkLateVote(msgType); err != nil {
+		cs.Logger.Error("failed to persist own performance", "err", err)
+	}
+}
+
 // loadWalFile loads WAL data from file. It overwrites cs.wal.
 func (cs *State) loadWalFile() error {
 	wal, err := cs.OpenWAL(cs.config.WalFile())
@@ -441,6 +533,47 @@ func (cs *State) loadWalFile() error {
 	return nil
 }
 
+// repairWAL backs up the head file of the WAL at walFile, keeping earlier
+// backups, and rewrites it without the corrupted tail.
+func (cs *State) repairWAL(walFile string) (types.EventDataWALRepaired, error) {
+	backupFile := fmt.Sprintf("%s.CORRUPTED.%d", walFile, cmttime.Now().UnixNano())
+	if err := cmtos.CopyFile(walFile, backupFile); err != nil {
+		return types.EventDataWALRepaired{}, err
+	}
+	cs.Logger.Debug("backed up WAL file", "src", walFile, "dst", backupFile)
+
+	// the WAL file is overwritten!
+	kept, dropped, err := repairWalFile(backupFile, walFile)
+	if err != nil {
+		cs.Logger.Error("the WAL repair failed", "file", walFile, "err", err)
+		return types.EventDataWALRepaired{}, err
+	}
+	cs.Logger.Error("repaired the WAL by dropping its corrupted tail; proceeding with partial replay",
+		"file", walFile, "records_kept", kept, "bytes_dropped", dropped, "backup", backupFile)
+	return types.EventDataWALRepaired{
+		Height:       cs.Height,
+		WALFile:      walFile,
+		BackupFile:   backupFile,
+		RecordsKept:  kept,
+		BytesDropped: dropped,
+	}, nil
+}
+
+// publishWALRepairs publishes the WAL repairs made on start. They are only
+// published once consensus runs, rather than on start, so that the
+// subscribers of the node have subscribed by then.
+func (cs *State) publishWALRepairs() {
+	if cs.eventBus == nil {
+		return
+	}
+	for _, repaired := range cs.walRepairs {
+		if err := cs.eventBus.PublishEventWALRepaired(repaired); err != nil {
+			cs.Logger.Error("failed publishing WAL repaired event", "err", err)
+		}
+	}
+	cs.walRepairs = nil
+}
+
 // OnStop implements service.Service.
 func (cs *State) OnStop() {
 	if err := cs.evsw.Stop(); err != nil {
@@ -463,7 +596,15 @@ func (cs *State) Wait() {
 // OpenWAL opens a file to log all consensus messages and timeouts for
 // deterministic accountability.
 func (cs *State) OpenWAL(walFile string) (WAL, error) {
-	wal, err := NewWAL(walFile)
+	var (
+		wal *BaseWAL
+		err error
+	)
+	if replicaFile := cs.config.WalReplicaFile(); replicaFile != "" {
+		wal, err = NewReplicatedWAL(walFile, replicaFile)
+	} else {
+		wal, err = NewWAL(walFile)
+	}
 	if err != nil {
 		cs.Logger.Error("failed to open WAL", "file", walFile, "err", err)
 		return nil, err
@@ -901,6 +1042,7 @@ func (cs *State) receiveRoutine(maxSteps int) {
 func (cs *State) handleMsg(mi msgInfo) {
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
+	defer cs.checkLockedPolka()
 	var (
 		added bool
 		err   error
@@ -908,6 +1050,11 @@ func (cs *State) handleMsg(mi msgInfo) {
 
 	msg, peerID := mi.Msg, mi.PeerID
 
+	if cs.haltHeight > 0 {
+		cs.Logger.Debug("ignoring message; halted for upgrade", "halt_height", cs.haltHeight)
+		return
+	}
+
 	switch msg := msg.(type) {
 	case *ProposalMessage:
 		// will not cause transition.
@@ -933,6 +1080,7 @@ func (cs *State) handleMsg(mi msgInfo) {
 
 		cs.mtx.Lock()
 		if added && cs.ProposalBlockParts.IsComplete() {
+			cs.finishOwnProposal(true)
 			cs.handleCompleteProposal(msg.Height)
 		}
 		if added {
@@ -949,6 +1097,17 @@ func (cs *State) handleMsg(mi msgInfo) {
 			err = nil
 		}
 
+	case *EmptyBlockMessage:
+		if !cs.state.ConsensusParams.Gossip.LazyEmptyProposals {
+			cs.Logger.Debug("ignoring empty block, lazy empty proposals are disabled", "height", msg.Height, "peer", peerID)
+			return
+		}
+		// the block is rebuilt after the proposal the peer sent before it
+		added, err = cs.addEmptyBlock(msg, peerID)
+		if added && cs.ProposalBlockParts.IsComplete() {
+			cs.handleCompleteProposal(msg.Height)
+		}
+
 	case *VoteMessage:
 		// attempt to add the vote and dupeout the validator if its a duplicate signature
 		// if the vote gives us a 2/3-any or 2/3-one, we transition
@@ -1001,6 +1160,7 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 	// the timeout will now cause a state transition
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
+	defer cs.checkLockedPolka()
 
 	switch ti.Step {
 	case cstypes.RoundStepNewHeight:
@@ -1086,6 +1246,13 @@ func (cs *State) enterNewRound(height int64, round int32) {
 		return
 	}
 
+	cs.publishWALRepairs()
+
+	if cs.haltHeight > 0 {
+		logger.Debug("entering new round while halted for upgrade", "halt_height", cs.haltHeight)
+		return
+	}
+
 	if now := cmttime.Now(); cs.StartTime.After(now) {
 		logger.Debug("need to set a buffer and log message here for sanity", "start_time", cs.StartTime, "now", now)
 	}
@@ -1102,6 +1269,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	// Setup new round
 	// we don't fire newStep for this step,
 	// but we fire an event, so update the round step first
+	cs.finishOwnProposal(false)
 	cs.updateRoundStep(round, cstypes.RoundStepNewRound)
 	cs.Validators = validators
 	// If round == 0, we've already reset these upon new height, and meanwhile
@@ -1230,6 +1398,41 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 	var block *types.Block
 	var blockParts *types.PartSet
 
+	// The proposal is missed unless it and all its block parts are processed
+	// before the propose timeout, after which the other validators prevote
+	// nil, see finishOwnProposal.
+	start := cmttime.Now()
+	signed := false
+	defer func() {
+		if cs.replayMode {
+			return
+		}
+		if !signed {
+			cs.markMissedProposal(height, round)
+			return
+		}
+		cs.ownProposal = &pendingProposal{height: height, round: round, start: start}
+	}()
+
+	// Flush the WAL before signing. Otherwise, we may not recompute the same
+	// proposal to sign, and the privValidator will refuse to sign anything.
+	// Nothing else writes to the WAL until the proposal is sent, so the WAL is
+	// flushed while the block and its parts are created, which takes long for
+	// large blocks.
+	flushed := make(chan error, 1)
+	go func() { flushed <- cs.wal.FlushAndSync() }()
+	waited := false
+	waitFlushed := func() {
+		if waited {
+			return
+		}
+		waited = true
+		if err := <-flushed; err != nil {
+			cs.Logger.Error("failed flushing WAL to disk")
+		}
+	}
+	defer waitFlushed()
+
 	// Decide on block
 	if cs.ValidBlock != nil {
 		// If there is valid block, choose that.
@@ -1252,18 +1455,14 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 		}
 	}
 
-	// Flush the WAL. Otherwise, we may not recompute the same proposal to sign,
-	// and the privValidator will refuse to sign anything.
-	if err := cs.wal.FlushAndSync(); err != nil {
-		cs.Logger.Error("failed flushing WAL to disk")
-	}
-
 	// Make proposal
 	propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
+	waitFlushed()
 	proposal := types.NewProposal(height, round, cs.ValidRound, propBlockID)
 	p := proposal.ToProto()
 	if err := cs.privValidator.SignProposal(cs.state.ChainID, p); err == nil {
 		proposal.Signature = p.Signature
+		signed = true
 
 		// send proposal and block parts on internal msg queue
 		cs.sendInternalMessage(msgInfo{&ProposalMessage{proposal}, ""})
@@ -1763,6 +1962,14 @@ func (cs *State) finalizeCommit(height int64) {
 		seenCommit = seenExtendedCommit.ToCommit()
 		if cs.state.ConsensusParams.ABCI.VoteExtensionsEnabled(block.Height) {
 			cs.blockStore.SaveBlockWithExtendedCommit(block, blockParts, seenExtendedCommit)
+			if err := cs.eventBus.PublishEventVoteExtensions(types.EventDataVoteExtensions{
+				Height: block.Height,
+				ExtendedCommitInfo: sm.BuildExtendedCommitInfo(
+					seenExtendedCommit, cs.Validators, cs.state.InitialHeight, cs.state.ConsensusParams.ABCI,
+				),
+			}); err != nil {
+				logger.Error("failed publishing vote extensions", "err", err)
+			}
 		} else {
 			cs.blockStore.SaveBlock(block, blockParts, seenExtendedCommit.ToCommit())
 		}
@@ -1770,6 +1977,7 @@ func (cs *State) finalizeCommit(height int64) {
 		// Happens during replay if we already saved the block but didn't commit
 		logger.Debug("calling finalizeCommit on already stored block", "height", block.Height)
 	}
+	cs.checkCommitSignatures(height, blockID, seenCommit)
 
 	fail.Fail() // XXX
 
@@ -1786,6 +1994,7 @@ func (cs *State) finalizeCommit(height int64) {
 	// Either way, the State should not be resumed until we
 	// successfully call ApplyBlock (ie. later here, or in Handshake after
 	// restart).
+	cs.checkWALEndHeight(height)
 	endMsg := EndHeightMessage{height}
 	if err := cs.wal.WriteSync(endMsg); err != nil { // NOTE: fsync
 		panic(fmt.Sprintf(
@@ -1824,6 +2033,8 @@ func (cs *State) finalizeCommit(height int64) {
 	// must be called before we update state
 	cs.recordMetrics(height, block)
 
+	halt := cs.blockExec.HaltHeight() == height
+
 	// NewHeightStep!
 	cs.updateToState(stateCopy)
 
@@ -1834,6 +2045,11 @@ func (cs *State) finalizeCommit(height int64) {
 		logger.Error("failed to get private validator pubkey", "err", err)
 	}
 
+	if halt {
+		cs.haltForUpgrade(height, block.Time)
+		return
+	}
+
 	// cs.StartTime is already set.
 	// Schedule Round0 to start soon.
 	cs.scheduleRound0(&cs.RoundState)
@@ -1844,6 +2060,29 @@ func (cs *State) finalizeCommit(height int64) {
 	// * cs.StartTime is set to when we will start round0.
 }
 
+// haltForUpgrade stops consensus after the block at height was committed, as
+// set by the upgrade consensus parameters. No round is entered and the
+// messages are ignored from then on, until the node is restarted.
+func (cs *State) haltForUpgrade(height int64, blockTime time.Time) {
+	cs.haltHeight = height
+	cs.Logger.Info("halted consensus for upgrade; the node can be stopped and upgraded",
+		"height", height, "time", blockTime)
+	if err := cs.eventBus.PublishEventUpgradeReady(types.EventDataUpgradeReady{
+		Height: height,
+		Time:   blockTime,
+	}); err != nil {
+		cs.Logger.Error("failed publishing upgrade ready", "err", err)
+	}
+}
+
+// HaltHeight returns the height after which consensus halted for an upgrade,
+// or 0 if it did not.
+func (cs *State) HaltHeight() int64 {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.haltHeight
+}
+
 func (cs *State) recordMetrics(height int64, block *types.Block) {
 	cs.metrics.Validators.Set(float64(cs.Validators.Size()))
 	cs.metrics.ValidatorsPower.Set(float64(cs.Validators.TotalVotingPower()))
@@ -2030,6 +2269,9 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 		// NOTE: we are disregarding possible duplicates above where heights dont match or we're not expecting block parts yet
 		// but between the matches_current = true and false, we have all the info.
 		cs.metrics.DuplicateBlockPart.Add(1)
+	} else if peerID != "" && cs.ProposalBlockParts.Count() == 1 {
+		cs.propagatingParts = cs.ProposalBlockParts
+		cs.propagationStart = time.Now()
 	}
 
 	maxBytes := cs.state.ConsensusParams.Block.MaxBytes
@@ -2059,6 +2301,7 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 		}
 
 		cs.ProposalBlock = block
+		cs.recordBlockPropagation(round, block)
 
 		// NOTE: it's possible to receive complete proposal blocks for future rounds without having the proposal
 		cs.Logger.Info("received complete proposal block", "height", cs.ProposalBlock.Height, "hash", cs.ProposalBlock.Hash())
@@ -2070,6 +2313,21 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 	return added, nil
 }
 
+// recordBlockPropagation records the time it took to receive the parts of the
+// proposal block, from the first one received from a peer to the last one.
+func (cs *State) recordBlockPropagation(round int32, block *types.Block) {
+	if cs.propagatingParts != cs.ProposalBlockParts {
+		return
+	}
+	elapsed := time.Since(cs.propagationStart)
+	cs.propagatingParts = nil
+
+	proposer := block.ProposerAddress.String()
+	cs.metrics.BlockPropagationSeconds.With("proposer_address", proposer).Observe(elapsed.Seconds())
+	schema.WriteBlockPropagation(cs.traceClient, block.Height, round, proposer,
+		cs.ProposalBlockParts.Total(), elapsed)
+}
+
 func (cs *State) handleCompleteProposal(blockHeight int64) {
 	// Update Valid* if we can.
 	prevotes := cs.Votes.Prevotes(cs.Round)
@@ -2474,6 +2732,15 @@ func (cs *State) voteTime() time.Time {
 	return minVoteTime
 }
 
+// voteTimeout returns the timeout of the step of votes of the given type in
+// the current round. Votes signed after it are late.
+func (cs *State) voteTimeout(msgType cmtproto.SignedMsgType) time.Duration {
+	if msgType == cmtproto.PrevoteType {
+		return cs.config.Prevote(cs.Round)
+	}
+	return cs.config.Precommit(cs.Round)
+}
+
 // sign the vote and publish on internalMsgQueue
 // block information is only used to extend votes (precommit only); should be nil in all other cases
 func (cs *State) signAddVote(
@@ -2482,6 +2749,7 @@ func (cs *State) signAddVote(
 	header types.PartSetHeader,
 	block *types.Block,
 ) {
+	start := cmttime.Now()
 	if cs.privValidator == nil { // the node does not have a key
 		return
 	}
@@ -2509,6 +2777,17 @@ func (cs *State) signAddVote(
 		panic(fmt.Errorf("vote extension absence/presence does not match extensions enabled %t!=%t, height %d, type %v",
 			hasExt, extEnabled, vote.Height, vote.Type))
 	}
+	// The vote is late if it is sent, after signing and any delay, past the
+	// timeout of its step.
+	delay := cs.voteDelay(vote)
+	if took := cmttime.Now().Sub(start) + delay; !cs.replayMode && took > cs.voteTimeout(msgType) {
+		cs.markLateVote(msgType, took)
+	}
+	if delay > 0 {
+		cs.Logger.Debug("delaying signed vote", "height", cs.Height, "round", cs.Round, "vote", vote, "delay", delay)
+		time.AfterFunc(delay, func() { cs.sendInternalMessage(msgInfo{&VoteMessage{vote}, ""}) })
+		return
+	}
 	cs.sendInternalMessage(msgInfo{&VoteMessage{vote}, ""})
 	cs.Logger.Debug("signed and pushed vote", "height", cs.Height, "round", cs.Round, "vote", vote)
 }
@@ -2602,23 +2881,26 @@ func CompareHRS(h1 int64, r1 int32, s1 cstypes.RoundStepType, h2 int64, r2 int32
 }
 
 // repairWalFile decodes messages from src (until the decoder errors) and
-// writes them to dst.
-func repairWalFile(src, dst string) error {
+// writes them to dst. It returns the number of messages kept and the number
+// of bytes of src dropped.
+func repairWalFile(src, dst string) (int, int64, error) {
 	in, err := os.Open(src)
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 	defer in.Close()
 
 	out, err := os.Create(dst)
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 	defer out.Close()
 
 	var (
 		dec = NewWALDecoder(in)
 		enc = NewWALEncoder(out)
+
+		kept int
 	)
 
 	// best-case repair (until first error is encountered)
@@ -2630,9 +2912,19 @@ func repairWalFile(src, dst string) error {
 
 		err = enc.Encode(msg)
 		if err != nil {
-			return fmt.Errorf("failed to encode msg: %w", err)
+			return 0, 0, fmt.Errorf("failed to encode msg: %w", err)
 		}
+		kept++
 	}
 
-	return nil
+	inInfo, err := in.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+	outInfo, err := out.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return kept, inInfo.Size() - outInfo.Size(), nil
 }
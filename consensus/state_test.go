@@ -23,6 +23,9 @@ import (
 	"github.com/cometbft/cometbft/libs/protoio"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/libs/trace"
+	"github.com/cometbft/cometbft/libs/trace/schema"
 	p2pmock "github.com/cometbft/cometbft/p2p/mock"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/types"
@@ -410,6 +413,33 @@ func TestStateFullRoundNil(t *testing.T) {
 	ensurePrecommitMatch(t, voteCh, height, round, nil) // precommit
 }
 
+// consensus halts after committing the halt height of the upgrade params
+func TestStateHaltForUpgrade(t *testing.T) {
+	cs, _ := randState(1)
+	height, round := cs.Height, cs.Round
+	cs.state.ConsensusParams.Upgrade.HaltHeight = height
+
+	newRoundCh := subscribe(cs.eventBus, types.EventQueryNewRound)
+	upgradeCh := subscribe(cs.eventBus, types.EventQueryUpgradeReady)
+
+	startTestRound(cs, height, round)
+	ensureNewRound(newRoundCh, height, round)
+
+	select {
+	case msg := <-upgradeCh:
+		upgrade, ok := msg.Data().(types.EventDataUpgradeReady)
+		require.True(t, ok)
+		assert.Equal(t, height, upgrade.Height)
+	case <-time.After(ensureTimeout):
+		t.Fatal("timed out waiting for the upgrade ready event")
+	}
+	assert.Equal(t, height, cs.HaltHeight())
+	assert.Equal(t, height, cs.blockStore.Height())
+
+	// the next height is never started
+	ensureNoNewEventOnChannel(newRoundCh)
+}
+
 // run through propose, prevote, precommit commit with two validators
 // where the first validator has to wait for votes from the second
 func TestStateFullRound2(t *testing.T) {
@@ -1682,6 +1712,7 @@ func TestPrepareProposalReceivesVoteExtensions(t *testing.T) {
 
 	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
 	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	voteExtCh := subscribe(cs1.eventBus, types.EventQueryVoteExtensions)
 	pv1, err := cs1.privValidator.GetPubKey()
 	require.NoError(t, err)
 	addr := pv1.Address()
@@ -1709,6 +1740,28 @@ func TestPrepareProposalReceivesVoteExtensions(t *testing.T) {
 	ensurePrecommitMatch(t, voteCh, height, round, blockID.Hash)
 	incrementHeight(vss[1:]...)
 
+	// the extensions are published once the height is committed
+	select {
+	case msg := <-voteExtCh:
+		data, ok := msg.Data().(types.EventDataVoteExtensions)
+		require.True(t, ok)
+		require.Equal(t, height, data.Height)
+		require.Len(t, data.ExtendedCommitInfo.Votes, len(vss))
+		// late precommits are not part of the commit seen at the time
+		committed := 0
+		for i, vote := range data.ExtendedCommitInfo.Votes {
+			if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+				continue
+			}
+			committed++
+			require.Equal(t, voteExtensions[i], vote.VoteExtension)
+			require.NotEmpty(t, vote.ExtensionSignature)
+		}
+		require.GreaterOrEqual(t, committed, 3)
+	case <-time.After(ensureTimeout):
+		t.Fatal("expected a VoteExtensions event")
+	}
+
 	height++
 	round = 0
 	ensureNewRound(newRoundCh, height, round)
@@ -2618,3 +2671,53 @@ func findBlockSizeLimit(t *testing.T, height, maxBytes int64, cs *State, partSiz
 	require.Fail(t, "We shouldn't hit the end of the loop")
 	return nil, nil
 }
+
+// propagationTracer records the block propagation traces.
+type propagationTracer struct {
+	mtx     cmtsync.Mutex
+	entries []schema.BlockPropagation
+}
+
+func (pt *propagationTracer) Write(e trace.Entry) {
+	if bp, ok := e.(schema.BlockPropagation); ok {
+		pt.mtx.Lock()
+		pt.entries = append(pt.entries, bp)
+		pt.mtx.Unlock()
+	}
+}
+
+func (*propagationTracer) IsCollecting(string) bool { return true }
+func (*propagationTracer) Stop()                    {}
+
+func TestStateBlockPropagation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs1, vss := randState(2)
+	height, round := cs1.Height, cs1.Round
+	tracer := &propagationTracer{}
+	cs1.traceClient = tracer
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+
+	// make the second validator the proposer by incrementing round
+	round++
+	incrementRound(vss[1:]...)
+	proposal, propBlock := decideProposal(ctx, t, cs1, vss[1], height, round)
+	propBlockParts, err := propBlock.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+
+	require.NoError(t, cs1.SetProposalAndBlock(proposal, propBlock, propBlockParts, "some peer"))
+	startTestRound(cs1, height, round)
+	ensureProposal(proposalCh, height, round, proposal.BlockID)
+
+	tracer.mtx.Lock()
+	defer tracer.mtx.Unlock()
+	require.Len(t, tracer.entries, 1)
+	entry := tracer.entries[0]
+	assert.Equal(t, height, entry.Height)
+	assert.Equal(t, round, entry.Round)
+	assert.Equal(t, propBlock.ProposerAddress.String(), entry.Proposer)
+	assert.Equal(t, propBlockParts.Total(), entry.Parts)
+	assert.GreaterOrEqual(t, entry.Duration, int64(0))
+}
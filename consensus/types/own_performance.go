@@ -0,0 +1,13 @@
+package types
+
+// OwnPerformance counts the proposals and votes of the local validator which
+// were not sent on time. The counts persist across restarts.
+type OwnPerformance struct {
+	// MissedProposals is the number of times the validator was the proposer
+	// but failed to sign its proposal before the propose timeout.
+	MissedProposals uint64 `json:"missed_proposals"`
+	// LatePrevotes and LatePrecommits are the number of votes the validator
+	// failed to sign before the timeout of the prevote or precommit step.
+	LatePrevotes   uint64 `json:"late_prevotes"`
+	LatePrecommits uint64 `json:"late_precommits"`
+}
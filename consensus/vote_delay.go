@@ -0,0 +1,55 @@
+package consensus
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// voteDelay returns how long to delay the broadcast of our own vote. It's 0
+// unless the binary is built with the e2e build tag.
+func (cs *State) voteDelay(vote *types.Vote) time.Duration {
+	if !testVoteDelays || cs.replayMode {
+		return 0
+	}
+	return testVoteDelay(cs.config, vote)
+}
+
+// testVoteDelay returns the delay of vote set by the test_*_delay and
+// test_vote_jitter consensus params. The jitter is derived from
+// test_vote_jitter_seed and the height, round and type of the vote, so that a
+// testnet run with the same seed delays the same votes by the same durations.
+func testVoteDelay(config *cfg.ConsensusConfig, vote *types.Vote) time.Duration {
+	var delay time.Duration
+	switch vote.Type {
+	case cmtproto.PrevoteType:
+		delay = config.TestPrevoteDelay
+	case cmtproto.PrecommitType:
+		delay = config.TestPrecommitDelay
+	}
+	if config.TestVoteJitter <= 0 {
+		return delay
+	}
+	var b [24]byte
+	binary.BigEndian.PutUint64(b[0:], uint64(config.TestVoteJitterSeed))
+	binary.BigEndian.PutUint64(b[8:], uint64(vote.Height))
+	binary.BigEndian.PutUint32(b[16:], uint32(vote.Round))
+	binary.BigEndian.PutUint32(b[20:], uint32(vote.Type))
+	sum := sha256.Sum256(b[:])
+	return delay + time.Duration(binary.BigEndian.Uint64(sum[:])%uint64(config.TestVoteJitter+1))
+}
+
+// warnIgnoredVoteDelays warns if vote delays are set in a binary which
+// ignores them.
+func (cs *State) warnIgnoredVoteDelays() {
+	if testVoteDelays {
+		return
+	}
+	if cs.config.TestPrevoteDelay > 0 || cs.config.TestPrecommitDelay > 0 || cs.config.TestVoteJitter > 0 {
+		cs.Logger.Error("test vote delays are ignored, the binary was not built with the e2e build tag")
+	}
+}
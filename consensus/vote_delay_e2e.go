@@ -0,0 +1,8 @@
+//go:build e2e
+// +build e2e
+
+package consensus
+
+// testVoteDelays enables the test vote delays in the binaries built for the
+// e2e tests.
+const testVoteDelays = true
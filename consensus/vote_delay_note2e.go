@@ -0,0 +1,7 @@
+//go:build !e2e
+// +build !e2e
+
+package consensus
+
+// testVoteDelays disables the test vote delays outside of the e2e tests.
+const testVoteDelays = false
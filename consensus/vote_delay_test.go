@@ -0,0 +1,39 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestTestVoteDelay(t *testing.T) {
+	config := cfg.TestConsensusConfig()
+	prevote := &types.Vote{Type: cmtproto.PrevoteType, Height: 10, Round: 1}
+	precommit := &types.Vote{Type: cmtproto.PrecommitType, Height: 10, Round: 1}
+	assert.Zero(t, testVoteDelay(config, prevote))
+
+	config.TestPrevoteDelay = time.Second
+	config.TestPrecommitDelay = 2 * time.Second
+	assert.Equal(t, time.Second, testVoteDelay(config, prevote))
+	assert.Equal(t, 2*time.Second, testVoteDelay(config, precommit))
+
+	// the jitter is bounded, and the same for the same seed and vote
+	config.TestVoteJitter = 100 * time.Millisecond
+	config.TestVoteJitterSeed = 42
+	delay := testVoteDelay(config, prevote)
+	assert.GreaterOrEqual(t, delay, time.Second)
+	assert.LessOrEqual(t, delay, time.Second+config.TestVoteJitter)
+	assert.Equal(t, delay, testVoteDelay(config, prevote))
+
+	delays := make(map[time.Duration]struct{})
+	for seed := int64(0); seed < 10; seed++ {
+		config.TestVoteJitterSeed = seed
+		delays[testVoteDelay(config, prevote)] = struct{}{}
+	}
+	assert.Greater(t, len(delays), 1)
+}
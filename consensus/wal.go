@@ -76,7 +76,7 @@ type WAL interface {
 type BaseWAL struct {
 	service.BaseService
 
-	group *auto.Group
+	group auto.Storage
 
 	enc *WALEncoder
 
@@ -98,13 +98,46 @@ func NewWAL(walFile string, groupOptions ...func(*auto.Group)) (*BaseWAL, error)
 	if err != nil {
 		return nil, err
 	}
+	return NewWALWithStorage(group), nil
+}
+
+// NewReplicatedWAL returns a new write-ahead logger like NewWAL, which
+// additionally replicates all the entries it writes to the group with head at
+// replicaFile, e.g. on another disk or a network file system. Replication is
+// asynchronous, so it never slows down consensus, and it lets a warm-standby
+// validator take over with up-to-date signing state.
+//
+// The replica only receives the entries written from now on. It should be
+// seeded with a copy of the existing WAL before enabling replication.
+func NewReplicatedWAL(walFile, replicaFile string, groupOptions ...func(*auto.Group)) (*BaseWAL, error) {
+	for _, file := range []string{walFile, replicaFile} {
+		if err := cmtos.EnsureDir(filepath.Dir(file), 0o700); err != nil {
+			return nil, fmt.Errorf("failed to ensure WAL directory is in place: %w", err)
+		}
+	}
+
+	group, err := auto.OpenGroup(walFile, groupOptions...)
+	if err != nil {
+		return nil, err
+	}
+	replica, err := auto.OpenGroupReplica(replicaFile, groupOptions...)
+	if err != nil {
+		group.Close()
+		return nil, fmt.Errorf("failed to open WAL replica: %w", err)
+	}
+	return NewWALWithStorage(auto.NewReplicatedGroup(group, replica)), nil
+}
+
+// NewWALWithStorage returns a new write-ahead logger writing to the given
+// storage, which must not be started yet.
+func NewWALWithStorage(group auto.Storage) *BaseWAL {
 	wal := &BaseWAL{
 		group:         group,
 		enc:           NewWALEncoder(group),
 		flushInterval: walDefaultFlushInterval,
 	}
 	wal.BaseService = *service.NewBaseService(nil, "baseWAL", wal)
-	return wal, nil
+	return wal
 }
 
 // SetFlushInterval allows us to override the periodic flush interval for the WAL.
@@ -112,7 +145,7 @@ func (wal *BaseWAL) SetFlushInterval(i time.Duration) {
 	wal.flushInterval = i
 }
 
-func (wal *BaseWAL) Group() *auto.Group {
+func (wal *BaseWAL) Group() auto.Storage {
 	return wal.group
 }
 
@@ -122,7 +155,7 @@ func (wal *BaseWAL) SetLogger(l log.Logger) {
 }
 
 func (wal *BaseWAL) OnStart() error {
-	size, err := wal.group.Head.Size()
+	size, err := wal.group.HeadSize()
 	if err != nil {
 		return err
 	} else if size == 0 {
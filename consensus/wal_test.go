@@ -3,6 +3,7 @@ package consensus
 import (
 	"bytes"
 	"crypto/rand"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -107,6 +108,87 @@ func TestWALEncoderDecoder(t *testing.T) {
 	}
 }
 
+func TestRepairWalFile(t *testing.T) {
+	now := cmttime.Now()
+	msgs := []TimedWALMessage{
+		{Time: now, Msg: EndHeightMessage{0}},
+		{Time: now, Msg: timeoutInfo{Duration: time.Second, Height: 1, Round: 1, Step: types.RoundStepPropose}},
+		{Time: now, Msg: cmttypes.EventDataRoundState{Height: 1, Round: 1, Step: ""}},
+	}
+
+	b := new(bytes.Buffer)
+	enc := NewWALEncoder(b)
+	for _, msg := range msgs {
+		msg := msg
+		require.NoError(t, enc.Encode(&msg))
+	}
+	// a record torn by an unclean shutdown
+	torn := new(bytes.Buffer)
+	require.NoError(t, NewWALEncoder(torn).Encode(&msgs[2]))
+	b.Write(torn.Bytes()[:torn.Len()-3])
+
+	dir := t.TempDir()
+	src, dst := filepath.Join(dir, "wal.CORRUPTED"), filepath.Join(dir, "wal")
+	require.NoError(t, os.WriteFile(src, b.Bytes(), 0600))
+
+	kept, dropped, err := repairWalFile(src, dst)
+	require.NoError(t, err)
+	assert.Equal(t, len(msgs), kept)
+	assert.EqualValues(t, torn.Len()-3, dropped)
+
+	f, err := os.Open(dst)
+	require.NoError(t, err)
+	defer f.Close()
+	dec := NewWALDecoder(f)
+	for _, msg := range msgs {
+		decoded, err := dec.Decode()
+		require.NoError(t, err)
+		assert.Equal(t, msg.Msg, decoded.Msg)
+	}
+	_, err = dec.Decode()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestStateRepairsWAL(t *testing.T) {
+	cs, _ := randState(1)
+	consensusConfig := *cs.config
+	consensusConfig.RootDir = t.TempDir()
+	consensusConfig.WalReplicaPath = "data/cs.wal.replica/wal"
+	cs.config = &consensusConfig
+
+	// the WAL and its replica end with a record torn by an unclean shutdown
+	b := new(bytes.Buffer)
+	msg := TimedWALMessage{Time: cmttime.Now(), Msg: EndHeightMessage{0}}
+	require.NoError(t, NewWALEncoder(b).Encode(&msg))
+	torn := new(bytes.Buffer)
+	require.NoError(t, NewWALEncoder(torn).Encode(&msg))
+	b.Write(torn.Bytes()[:torn.Len()-3])
+	for _, walFile := range []string{consensusConfig.WalFile(), consensusConfig.WalReplicaFile()} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(walFile), 0o700))
+		require.NoError(t, os.WriteFile(walFile, b.Bytes(), 0o600))
+	}
+
+	repairedCh := subscribe(cs.eventBus, cmttypes.EventQueryWALRepaired)
+	require.NoError(t, cs.Start())
+	defer func() {
+		require.NoError(t, cs.Stop())
+		cs.Wait()
+	}()
+
+	// the repairs are published once consensus runs
+	for _, walFile := range []string{consensusConfig.WalFile(), consensusConfig.WalReplicaFile()} {
+		select {
+		case msg := <-repairedCh:
+			repaired := msg.Data().(cmttypes.EventDataWALRepaired)
+			assert.Equal(t, walFile, repaired.WALFile)
+			assert.Equal(t, 1, repaired.RecordsKept)
+			assert.EqualValues(t, torn.Len()-3, repaired.BytesDropped)
+		case <-time.After(5 * time.Second):
+			t.Fatal("WAL repair not published")
+		}
+	}
+}
+
 func TestWALWrite(t *testing.T) {
 	walDir, err := os.MkdirTemp("", "wal")
 	require.NoError(t, err)
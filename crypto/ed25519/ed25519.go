@@ -145,6 +145,20 @@ func GenPrivKeyFromSecret(secret []byte) PrivKey {
 	return PrivKey(ed25519.NewKeyFromSeed(seed))
 }
 
+// GenPrivKeyFromSeed returns the private key derived from the 32 byte seed,
+// as returned by Seed.
+func GenPrivKeyFromSeed(seed []byte) (PrivKey, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid seed length %d, must be %d bytes", len(seed), ed25519.SeedSize)
+	}
+	return PrivKey(ed25519.NewKeyFromSeed(seed)), nil
+}
+
+// Seed returns the 32 byte seed the private key is derived from.
+func (privKey PrivKey) Seed() []byte {
+	return ed25519.PrivateKey(privKey).Seed()
+}
+
 //-------------------------------------
 
 var _ crypto.PubKey = PubKey{}
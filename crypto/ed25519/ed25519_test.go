@@ -28,6 +28,17 @@ func TestSignAndValidateEd25519(t *testing.T) {
 	assert.False(t, pubKey.VerifySignature(msg, sig))
 }
 
+func TestGenPrivKeyFromSeed(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+
+	restored, err := ed25519.GenPrivKeyFromSeed(privKey.Seed())
+	require.NoError(t, err)
+	assert.Equal(t, privKey, restored)
+
+	_, err = ed25519.GenPrivKeyFromSeed(privKey.Seed()[1:])
+	assert.Error(t, err)
+}
+
 func TestBatchSafe(t *testing.T) {
 	v := ed25519.NewBatchVerifier()
 
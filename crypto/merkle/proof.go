@@ -47,6 +47,24 @@ func ProofsFromByteSlices(items [][]byte) (rootHash []byte, proofs []*Proof) {
 	return
 }
 
+// ProofsFromLeafHashes returns the same root and proofs as
+// ProofsFromByteSlices, given the LeafHash of each item instead of the items,
+// so that the leaves can be hashed concurrently.
+func ProofsFromLeafHashes(leafHashes [][]byte) (rootHash []byte, proofs []*Proof) {
+	trails, rootSPN := trailsFromLeafHashes(leafHashes)
+	rootHash = rootSPN.Hash
+	proofs = make([]*Proof, len(leafHashes))
+	for i, trail := range trails {
+		proofs[i] = &Proof{
+			Total:    int64(len(leafHashes)),
+			Index:    int64(i),
+			LeafHash: trail.Hash,
+			Aunts:    trail.FlattenAunts(),
+		}
+	}
+	return
+}
+
 // Verify that the Proof proves the root hash.
 // Check sp.Index/sp.Total manually if needed
 func (sp *Proof) Verify(rootHash []byte, leaf []byte) error {
@@ -250,3 +268,25 @@ func trailsFromByteSlices(items [][]byte) (trails []*ProofNode, root *ProofNode)
 		return append(lefts, rights...), root
 	}
 }
+
+// trailsFromLeafHashes is trailsFromByteSlices given the leaf hashes.
+func trailsFromLeafHashes(leafHashes [][]byte) (trails []*ProofNode, root *ProofNode) {
+	switch len(leafHashes) {
+	case 0:
+		return []*ProofNode{}, &ProofNode{emptyHash(), nil, nil, nil}
+	case 1:
+		trail := &ProofNode{leafHashes[0], nil, nil, nil}
+		return []*ProofNode{trail}, trail
+	default:
+		k := getSplitPoint(int64(len(leafHashes)))
+		lefts, leftRoot := trailsFromLeafHashes(leafHashes[:k])
+		rights, rightRoot := trailsFromLeafHashes(leafHashes[k:])
+		rootHash := innerHash(leftRoot.Hash, rightRoot.Hash)
+		root := &ProofNode{rootHash, nil, nil, nil}
+		leftRoot.Parent = root
+		leftRoot.Right = rightRoot
+		rightRoot.Parent = root
+		rightRoot.Left = leftRoot
+		return append(lefts, rights...), root
+	}
+}
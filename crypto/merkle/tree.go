@@ -26,6 +26,33 @@ func hashFromByteSlices(sha hash.Hash, items [][]byte) []byte {
 	}
 }
 
+// LeafHash returns the hash of the leaf item in the Merkle trees computed by
+// HashFromByteSlices.
+func LeafHash(item []byte) []byte {
+	return leafHash(item)
+}
+
+// HashFromLeafHashes returns the same root as HashFromByteSlices, given the
+// LeafHash of each item instead of the items, so that the leaf hashes of the
+// items which don't change can be reused across trees.
+func HashFromLeafHashes(leafHashes [][]byte) []byte {
+	return hashFromLeafHashes(sha256.New(), leafHashes)
+}
+
+func hashFromLeafHashes(sha hash.Hash, leafHashes [][]byte) []byte {
+	switch len(leafHashes) {
+	case 0:
+		return emptyHash()
+	case 1:
+		return leafHashes[0]
+	default:
+		k := getSplitPoint(int64(len(leafHashes)))
+		left := hashFromLeafHashes(sha, leafHashes[:k])
+		right := hashFromLeafHashes(sha, leafHashes[k:])
+		return innerHashOpt(sha, left, right)
+	}
+}
+
 // HashFromByteSliceIterative is an iterative alternative to
 // HashFromByteSlice motivated by potential performance improvements.
 // (#2611) had suggested that an iterative version of
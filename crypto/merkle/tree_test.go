@@ -115,6 +115,33 @@ func TestHashAlternatives(t *testing.T) {
 	require.Equal(t, rootHash1, rootHash2, "Unmatched root hashes: %X vs %X", rootHash1, rootHash2)
 }
 
+func TestHashFromLeafHashes(t *testing.T) {
+	for _, total := range []int{0, 1, 2, 3, 7, 100} {
+		items := make([][]byte, total)
+		leafHashes := make([][]byte, total)
+		for i := range items {
+			items[i] = cmtrand.Bytes(tmhash.Size)
+			leafHashes[i] = LeafHash(items[i])
+		}
+		assert.Equal(t, HashFromByteSlices(items), HashFromLeafHashes(leafHashes), total)
+	}
+}
+
+func TestProofsFromLeafHashes(t *testing.T) {
+	for _, total := range []int{0, 1, 2, 3, 7, 100} {
+		items := make([][]byte, total)
+		leafHashes := make([][]byte, total)
+		for i := range items {
+			items[i] = cmtrand.Bytes(tmhash.Size)
+			leafHashes[i] = LeafHash(items[i])
+		}
+		rootHash, proofs := ProofsFromByteSlices(items)
+		rootHash2, proofs2 := ProofsFromLeafHashes(leafHashes)
+		assert.Equal(t, rootHash, rootHash2, total)
+		assert.Equal(t, proofs, proofs2, total)
+	}
+}
+
 func BenchmarkHashAlternatives(b *testing.B) {
 	total := 100
 
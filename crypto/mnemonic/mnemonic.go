@@ -0,0 +1,89 @@
+// Package mnemonic encodes secrets as BIP39 mnemonics: sentences of words
+// from the BIP39 English wordlist, ending with a checksum of the secret.
+//
+// Only the encoding of BIP39 is implemented: a mnemonic decodes back to the
+// exact secret it was made from, it isn't stretched into a seed.
+package mnemonic
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+//go:embed english.txt
+var english string
+
+var (
+	wordlist = strings.Fields(english)
+	indices  = func() map[string]int {
+		m := make(map[string]int, len(wordlist))
+		for i, w := range wordlist {
+			m[w] = i
+		}
+		return m
+	}()
+)
+
+const bitsPerWord = 11
+
+// ErrInvalidChecksum is returned when decoding a mnemonic whose checksum
+// doesn't match its words, e.g. because of a typo.
+var ErrInvalidChecksum = errors.New("invalid mnemonic checksum")
+
+// FromEntropy returns the mnemonic encoding the entropy, which must be 16 to
+// 32 bytes long, in steps of 4 bytes.
+func FromEntropy(entropy []byte) (string, error) {
+	if len(entropy) < 16 || len(entropy) > 32 || len(entropy)%4 != 0 {
+		return "", fmt.Errorf("invalid entropy length %d, must be 16 to 32 bytes in steps of 4", len(entropy))
+	}
+	checksumBits := uint(len(entropy) / 4)
+	numWords := (len(entropy)*8 + int(checksumBits)) / bitsPerWord
+
+	// the entropy followed by the first bits of its hash
+	n := new(big.Int).SetBytes(entropy)
+	n.Lsh(n, checksumBits)
+	hash := sha256.Sum256(entropy)
+	n.Or(n, big.NewInt(int64(hash[0]>>(8-checksumBits))))
+
+	words := make([]string, numWords)
+	mask := big.NewInt(1<<bitsPerWord - 1)
+	for i := numWords - 1; i >= 0; i-- {
+		words[i] = wordlist[new(big.Int).And(n, mask).Int64()]
+		n.Rsh(n, bitsPerWord)
+	}
+	return strings.Join(words, " "), nil
+}
+
+// ToEntropy returns the entropy encoded by the mnemonic, after checking its
+// words and checksum.
+func ToEntropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return nil, fmt.Errorf("invalid number of words %d, must be 12 to 24 in steps of 3", len(words))
+	}
+
+	n := new(big.Int)
+	for _, w := range words {
+		i, ok := indices[w]
+		if !ok {
+			return nil, fmt.Errorf("unknown word %q", w)
+		}
+		n.Lsh(n, bitsPerWord)
+		n.Or(n, big.NewInt(int64(i)))
+	}
+
+	checksumBits := uint(len(words) / 3)
+	checksum := new(big.Int).And(n, big.NewInt(1<<checksumBits-1)).Int64()
+	n.Rsh(n, checksumBits)
+	entropy := n.FillBytes(make([]byte, (len(words)*bitsPerWord-int(checksumBits))/8))
+
+	hash := sha256.Sum256(entropy)
+	if int64(hash[0]>>(8-checksumBits)) != checksum {
+		return nil, ErrInvalidChecksum
+	}
+	return entropy, nil
+}
@@ -0,0 +1,74 @@
+package mnemonic
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordlist(t *testing.T) {
+	require.Len(t, wordlist, 2048)
+	require.Len(t, indices, 2048)
+}
+
+// Test vectors of the BIP39 reference implementation.
+func TestVectors(t *testing.T) {
+	vectors := []struct {
+		entropy  string
+		mnemonic string
+	}{
+		{
+			"00000000000000000000000000000000",
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		},
+		{
+			"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			"legal winner thank year wave sausage worth useful legal winner thank yellow",
+		},
+		{
+			"80808080808080808080808080808080",
+			"letter advice cage absurd amount doctor acoustic avoid letter advice cage above",
+		},
+		{
+			"ffffffffffffffffffffffffffffffff",
+			"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong",
+		},
+		{
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			strings.Repeat("abandon ", 23) + "art",
+		},
+		{
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			strings.Repeat("zoo ", 23) + "vote",
+		},
+	}
+	for _, v := range vectors {
+		entropy, err := hex.DecodeString(v.entropy)
+		require.NoError(t, err)
+
+		mnemonic, err := FromEntropy(entropy)
+		require.NoError(t, err)
+		assert.Equal(t, v.mnemonic, mnemonic)
+
+		decoded, err := ToEntropy(v.mnemonic)
+		require.NoError(t, err)
+		assert.Equal(t, entropy, decoded)
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	_, err := FromEntropy(make([]byte, 15))
+	assert.Error(t, err)
+
+	_, err = ToEntropy("abandon abandon abandon")
+	assert.Error(t, err)
+
+	_, err = ToEntropy("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon notaword")
+	assert.Error(t, err)
+
+	_, err = ToEntropy("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon")
+	assert.ErrorIs(t, err, ErrInvalidChecksum)
+}
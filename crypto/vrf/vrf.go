@@ -0,0 +1,105 @@
+// Package vrf implements a verifiable random function over ed25519 keys,
+// using the ECVRF-EDWARDS25519-SHA512-ELL2 suite of the IETF VRF
+// specification.
+//
+// Nothing in consensus uses VRFs yet. The package exists so that forks
+// experimenting with unpredictable proposer selection can build on a single
+// audited primitive.
+package vrf
+
+import (
+	"errors"
+	"fmt"
+
+	voied25519 "github.com/oasisprotocol/curve25519-voi/primitives/ed25519"
+	"github.com/oasisprotocol/curve25519-voi/primitives/ed25519/extra/ecvrf"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+)
+
+const (
+	// ProofSize is the size, in bytes, of a VRF proof.
+	ProofSize = ecvrf.ProofSize
+	// OutputSize is the size, in bytes, of a VRF output.
+	OutputSize = ecvrf.OutputSize
+)
+
+var (
+	// ErrUnsupportedKey is returned for keys other than ed25519.
+	ErrUnsupportedKey = errors.New("unsupported VRF key type")
+	// ErrInvalidProof is returned when a proof does not verify.
+	ErrInvalidProof = errors.New("invalid VRF proof")
+)
+
+// Proof proves that an Output is the VRF of a key on a message.
+type Proof []byte
+
+// Output is the pseudorandom output of the VRF.
+type Output []byte
+
+// Prove computes the VRF proof of privKey on msg. Only ed25519 keys are
+// supported.
+func Prove(privKey crypto.PrivKey, msg []byte) (Proof, error) {
+	sk, ok := privKey.(ed25519.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKey, privKey.Type())
+	}
+	if len(sk) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid ed25519 private key size %d", len(sk))
+	}
+	return ecvrf.Prove(voied25519.PrivateKey(sk), msg), nil
+}
+
+// Verify checks that proof was produced by the private key of pubKey on msg
+// and returns the VRF output.
+func Verify(pubKey crypto.PubKey, proof Proof, msg []byte) (Output, error) {
+	pk, ok := pubKey.(ed25519.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKey, pubKey.Type())
+	}
+	if err := proof.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	valid, output := ecvrf.Verify(voied25519.PublicKey(pk), proof, msg)
+	if !valid {
+		return nil, ErrInvalidProof
+	}
+	return output, nil
+}
+
+// Output returns the VRF output of the proof without verifying it. Only use
+// it on proofs that are known to be valid, e.g. ones produced by Prove.
+func (p Proof) Output() (Output, error) {
+	if err := p.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	output, err := ecvrf.ProofToHash(p)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+	return output, nil
+}
+
+// ValidateBasic performs basic validation.
+func (p Proof) ValidateBasic() error {
+	if len(p) != ProofSize {
+		return fmt.Errorf("expected VRF proof size to be %d bytes, got %d bytes", ProofSize, len(p))
+	}
+	return nil
+}
+
+// ToProto converts Proof to protobuf.
+func (p Proof) ToProto() *cmtcrypto.VRFProof {
+	return &cmtcrypto.VRFProof{Proof: p}
+}
+
+// ProofFromProto converts a protobuf VRFProof to Proof and validates it.
+func ProofFromProto(pb *cmtcrypto.VRFProof) (Proof, error) {
+	if pb == nil {
+		return nil, errors.New("nil VRF proof")
+	}
+	p := Proof(pb.Proof)
+	return p, p.ValidateBasic()
+}
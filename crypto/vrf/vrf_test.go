@@ -0,0 +1,81 @@
+package vrf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+)
+
+func TestProveVerify(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	msg := []byte("proposer/height/10/round/0")
+
+	proof, err := Prove(privKey, msg)
+	require.NoError(t, err)
+	require.Len(t, proof, ProofSize)
+
+	// proving is deterministic
+	proof2, err := Prove(privKey, msg)
+	require.NoError(t, err)
+	assert.Equal(t, proof, proof2)
+
+	output, err := Verify(privKey.PubKey(), proof, msg)
+	require.NoError(t, err)
+	assert.Len(t, output, OutputSize)
+
+	hash, err := proof.Output()
+	require.NoError(t, err)
+	assert.Equal(t, output, hash)
+
+	// wrong message
+	_, err = Verify(privKey.PubKey(), proof, []byte("other"))
+	assert.ErrorIs(t, err, ErrInvalidProof)
+
+	// wrong key
+	_, err = Verify(ed25519.GenPrivKey().PubKey(), proof, msg)
+	assert.ErrorIs(t, err, ErrInvalidProof)
+
+	// tampered proof
+	tampered := append(Proof{}, proof...)
+	tampered[ProofSize-1] ^= 0x01
+	_, err = Verify(privKey.PubKey(), tampered, msg)
+	assert.Error(t, err)
+
+	// truncated proof
+	_, err = Verify(privKey.PubKey(), proof[:ProofSize-1], msg)
+	assert.Error(t, err)
+}
+
+func TestUnsupportedKey(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+
+	_, err := Prove(privKey, []byte("msg"))
+	assert.ErrorIs(t, err, ErrUnsupportedKey)
+
+	_, err = Verify(privKey.PubKey(), make(Proof, ProofSize), []byte("msg"))
+	assert.ErrorIs(t, err, ErrUnsupportedKey)
+}
+
+func TestProofProto(t *testing.T) {
+	proof, err := Prove(ed25519.GenPrivKey(), []byte("msg"))
+	require.NoError(t, err)
+
+	pb := proof.ToProto()
+	bz, err := pb.Marshal()
+	require.NoError(t, err)
+
+	pb.Reset()
+	require.NoError(t, pb.Unmarshal(bz))
+	got, err := ProofFromProto(pb)
+	require.NoError(t, err)
+	assert.Equal(t, proof, got)
+
+	_, err = ProofFromProto(nil)
+	assert.Error(t, err)
+	_, err = ProofFromProto(Proof{0x01}.ToProto())
+	assert.Error(t, err)
+}
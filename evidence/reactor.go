@@ -66,7 +66,7 @@ func (evR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 
 // AddPeer implements Reactor.
 func (evR *Reactor) AddPeer(peer p2p.Peer) {
-	go evR.broadcastEvidenceRoutine(peer)
+	evR.Go("evidence/broadcast", func() { evR.broadcastEvidenceRoutine(peer) })
 }
 
 // Receive implements Reactor.
@@ -75,6 +75,7 @@ func (evR *Reactor) Receive(e p2p.Envelope) {
 	evis, err := evidenceListFromProto(e.Message)
 	if err != nil {
 		evR.Logger.Error("Error decoding message", "src", e.Src, "chId", e.ChannelID, "err", err)
+		evR.Switch.PeerScorer().RecordInvalidMessage(e.Src.ID(), err)
 		evR.Switch.StopPeerForError(e.Src, err)
 		return
 	}
@@ -85,6 +86,7 @@ func (evR *Reactor) Receive(e p2p.Envelope) {
 		case *types.ErrInvalidEvidence:
 			evR.Logger.Error(err.Error())
 			// punish peer
+			evR.Switch.PeerScorer().RecordInvalidMessage(e.Src.ID(), err)
 			evR.Switch.StopPeerForError(e.Src, err)
 			return
 		case nil:
@@ -172,7 +174,7 @@ func (evR Reactor) prepareEvidenceMessage(
 
 	// make sure the peer is up to date
 	evHeight := ev.Height()
-	peerState, ok := peer.Get(types.PeerStateKey).(PeerState)
+	peerState, ok := types.PeerStateKey.Get(peer.Data())
 	if !ok {
 		// Peer does not have a state yet. We set it in the consensus reactor, but
 		// when we add peer in Switch, the order we call reactors#AddPeer is
@@ -213,9 +215,7 @@ func (evR Reactor) prepareEvidenceMessage(
 }
 
 // PeerState describes the state of a peer.
-type PeerState interface {
-	GetHeight() int64
-}
+type PeerState = types.PeerState
 
 // encodemsg takes a array of evidence
 // returns the byte encoding of the List Message
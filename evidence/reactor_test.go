@@ -23,6 +23,7 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/p2p"
 	p2pmocks "github.com/cometbft/cometbft/p2p/mocks"
+	"github.com/cometbft/cometbft/p2p/peerdata"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
@@ -57,7 +58,7 @@ func TestReactorBroadcastEvidence(t *testing.T) {
 	for _, r := range reactors {
 		for _, peer := range r.Switch.Peers().List() {
 			ps := peerState{height}
-			peer.Set(types.PeerStateKey, ps)
+			types.PeerStateKey.Set(peer.Data(), ps)
 		}
 	}
 
@@ -88,14 +89,14 @@ func TestReactorSelectiveBroadcast(t *testing.T) {
 	for _, r := range reactors {
 		for _, peer := range r.Switch.Peers().List() {
 			ps := peerState{height1}
-			peer.Set(types.PeerStateKey, ps)
+			types.PeerStateKey.Set(peer.Data(), ps)
 		}
 	}
 
 	// update the first reactor peer's height to be very small
 	peer := reactors[0].Switch.Peers().List()[0]
 	ps := peerState{height2}
-	peer.Set(types.PeerStateKey, ps)
+	types.PeerStateKey.Set(peer.Data(), ps)
 
 	// send a bunch of valid evidence to the first reactor's evpool
 	evList := sendEvidence(t, pools[0], val, numEvidence)
@@ -137,11 +138,11 @@ func TestReactorsGossipNoCommittedEvidence(t *testing.T) {
 
 	peer := reactors[0].Switch.Peers().List()[0]
 	ps := peerState{height - 2}
-	peer.Set(types.PeerStateKey, ps)
+	types.PeerStateKey.Set(peer.Data(), ps)
 
 	peer = reactors[1].Switch.Peers().List()[0]
 	ps = peerState{height}
-	peer.Set(types.PeerStateKey, ps)
+	types.PeerStateKey.Set(peer.Data(), ps)
 
 	// wait to see that no evidence comes through
 	time.Sleep(300 * time.Millisecond)
@@ -179,7 +180,7 @@ func TestReactorsGossipNoCommittedEvidence(t *testing.T) {
 	pools[1].Update(state, types.EvidenceList{})
 	peer = reactors[0].Switch.Peers().List()[0]
 	ps = peerState{height}
-	peer.Set(types.PeerStateKey, ps)
+	types.PeerStateKey.Set(peer.Data(), ps)
 
 	// wait to see that only two evidence is sent
 	time.Sleep(300 * time.Millisecond)
@@ -214,8 +215,9 @@ func TestReactorBroadcastEvidenceMemoryLeak(t *testing.T) {
 	})).Return(false)
 	quitChan := make(<-chan struct{})
 	p.On("Quit").Return(quitChan)
-	ps := peerState{2}
-	p.On("Get", types.PeerStateKey).Return(ps)
+	data := peerdata.NewStore()
+	types.PeerStateKey.Set(data, peerState{2})
+	p.On("Data").Return(data)
 	p.On("ID").Return("ABC")
 	p.On("String").Return("mock")
 
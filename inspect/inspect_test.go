@@ -269,6 +269,7 @@ func TestBlockResults(t *testing.T) {
 	blockStoreMock.On("Close").Return(nil)
 	blockStoreMock.On("Base").Return(int64(0))
 	blockStoreMock.On("Height").Return(testHeight)
+	blockStoreMock.On("LoadBlockExtendedCommit", testHeight-1).Return(nil)
 	txIndexerMock := &txindexmocks.TxIndexer{}
 	blkIdxMock := &indexermocks.BlockIndexer{}
 	rpcConfig := config.TestRPCConfig()
@@ -316,6 +317,7 @@ func TestCommit(t *testing.T) {
 		Height: testHeight,
 		Round:  testRound,
 	}, nil)
+	blockStoreMock.On("LoadBlockExtendedCommit", testHeight).Return(nil)
 	txIndexerMock := &txindexmocks.TxIndexer{}
 	blkIdxMock := &indexermocks.BlockIndexer{}
 	rpcConfig := config.TestRPCConfig()
@@ -350,6 +352,54 @@ func TestCommit(t *testing.T) {
 	stateStoreMock.AssertExpectations(t)
 }
 
+func TestBlockTime(t *testing.T) {
+	testHeight := int64(1)
+	testTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	stateStoreMock := &statemocks.Store{}
+	stateStoreMock.On("Close").Return(nil)
+	blockStoreMock := &statemocks.BlockStore{}
+	blockStoreMock.On("Close").Return(nil)
+	blockStoreMock.On("Base").Return(int64(0))
+	blockStoreMock.On("Height").Return(testHeight)
+	blockStoreMock.On("LoadBlockMeta", testHeight).Return(&types.BlockMeta{
+		Header: types.Header{Height: testHeight, Time: testTime},
+	}, nil)
+	blockStoreMock.On("LoadSeenCommit", testHeight).Return(&types.Commit{Height: testHeight}, nil)
+	txIndexerMock := &txindexmocks.TxIndexer{}
+	blkIdxMock := &indexermocks.BlockIndexer{}
+	rpcConfig := config.TestRPCConfig()
+	d := inspect.New(rpcConfig, blockStoreMock, stateStoreMock, txIndexerMock, blkIdxMock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+
+	startedWG := &sync.WaitGroup{}
+	startedWG.Add(1)
+	go func() {
+		startedWG.Done()
+		defer wg.Done()
+		require.NoError(t, d.Run(ctx))
+	}()
+	// FIXME: used to induce context switch.
+	// Determine more deterministic method for prompting a context switch
+	startedWG.Wait()
+	requireConnect(t, rpcConfig.ListenAddress, 20)
+	cli, err := httpclient.New(rpcConfig.ListenAddress, "/websocket")
+	require.NoError(t, err)
+	res, err := cli.BlockTime(context.Background(), &testHeight)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, testHeight, res.Height)
+	require.True(t, testTime.Equal(res.Time))
+
+	cancel()
+	wg.Wait()
+
+	blockStoreMock.AssertExpectations(t)
+	stateStoreMock.AssertExpectations(t)
+}
+
 func TestBlockByHash(t *testing.T) {
 	testHeight := int64(1)
 	testHash := []byte("test hash")
@@ -360,7 +410,7 @@ func TestBlockByHash(t *testing.T) {
 	stateStoreMock.On("Close").Return(nil)
 	blockStoreMock := &statemocks.BlockStore{}
 	blockStoreMock.On("Close").Return(nil)
-	blockStoreMock.On("LoadBlockMeta", testHeight).Return(&types.BlockMeta{
+	blockStoreMock.On("LoadBlockMetaByHash", testHash).Return(&types.BlockMeta{
 		BlockID: types.BlockID{
 			Hash: testHash,
 		},
@@ -368,7 +418,7 @@ func TestBlockByHash(t *testing.T) {
 			Height: testHeight,
 		},
 	}, nil)
-	blockStoreMock.On("LoadBlockByHash", testHash).Return(testBlock, nil)
+	blockStoreMock.On("LoadBlock", testHeight).Return(testBlock, nil)
 	txIndexerMock := &txindexmocks.TxIndexer{}
 	blkIdxMock := &indexermocks.BlockIndexer{}
 	rpcConfig := config.TestRPCConfig()
@@ -48,6 +48,12 @@ func Routes(cfg config.RPCConfig, s state.Store, bs state.BlockStore, txidx txin
 		"tx":               server.NewRPCFunc(env.Tx, "hash,prove"),
 		"tx_search":        server.NewRPCFunc(env.TxSearch, "query,prove,page,per_page,order_by"),
 		"block_search":     server.NewRPCFunc(env.BlockSearch, "query,page,per_page,order_by"),
+		"block_time":       server.NewRPCFunc(env.BlockTime, "height"),
+		"signed_block":     server.NewRPCFunc(env.SignedBlock, "height"),
+
+		// celestia data root routes, served from the block store alone
+		"data_commitment":           server.NewRPCFunc(env.DataCommitment, "start,end"),
+		"data_root_inclusion_proof": server.NewRPCFunc(env.DataRootInclusionProof, "height,start,end"),
 	}
 }
 
@@ -0,0 +1,303 @@
+package autofile
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+)
+
+// Storage is the set of Group methods used by writers which log to a group
+// of files, such as the consensus WAL. It allows wrapping a Group, e.g. to
+// replicate its data.
+type Storage interface {
+	service.Service
+	io.Writer
+
+	// FlushAndSync writes any buffered data and fsyncs it.
+	FlushAndSync() error
+	// Buffered returns the size of the currently buffered data.
+	Buffered() int
+	// HeadSize returns the size of the head file.
+	HeadSize() (int64, error)
+	// MinIndex and MaxIndex return the indices of the first and last files.
+	MinIndex() int
+	MaxIndex() int
+	// NewReader returns a reader starting at the file with the given index.
+	NewReader(index int) (*GroupReader, error)
+	// Close closes the head file. The storage must be stopped by this moment.
+	Close()
+	// Wait blocks until all internal goroutines are finished.
+	Wait()
+}
+
+var (
+	_ Storage = (*Group)(nil)
+	_ Storage = (*ReplicatedGroup)(nil)
+)
+
+// HeadSize returns the size of the head file.
+func (g *Group) HeadSize() (int64, error) {
+	return g.Head.Size()
+}
+
+//--------------------------------------------------------------------------------
+
+// Replica is a target, such as another disk, a network file system or an
+// object store, which receives a copy of all the data written to a
+// ReplicatedGroup.
+type Replica interface {
+	// Write appends p to the replica.
+	Write(p []byte) (int, error)
+	// Sync commits the data written so far to stable storage.
+	Sync() error
+	// Close flushes and releases the replica.
+	Close() error
+}
+
+// ErrReplicationQueueFull is returned when the replica could not keep up with
+// the data written to a ReplicatedGroup.
+var ErrReplicationQueueFull = errors.New("replication queue is full")
+
+// defaultReplicationQueueSize is the number of writes which can be pending
+// replication before the replica is given up on.
+const defaultReplicationQueueSize = 10000
+
+type replicationOp struct {
+	data []byte
+	sync bool
+}
+
+// ReplicatedGroup is a Group which asynchronously replicates all the data
+// written to it to a Replica. Writes and syncs complete as soon as they reach
+// the local group; they are then forwarded to the replica in order by a
+// separate routine.
+//
+// The replica is expected to hold a copy of the group's data from the moment
+// the ReplicatedGroup is created. If the replica fails or falls behind by
+// more than the queue size, replication stops for good rather than leaving
+// gaps, so that the replica always holds a consistent prefix of the data.
+type ReplicatedGroup struct {
+	service.BaseService
+
+	group   *Group
+	replica Replica
+
+	queue chan replicationOp
+	done  chan struct{}
+
+	mtx sync.Mutex
+	err error // the error which stopped replication, if any
+}
+
+// ReplicationQueueSize allows you to overwrite the default number of writes
+// which can be pending replication.
+func ReplicationQueueSize(size int) func(*ReplicatedGroup) {
+	return func(rg *ReplicatedGroup) {
+		rg.queue = make(chan replicationOp, size)
+	}
+}
+
+// NewReplicatedGroup returns a ReplicatedGroup which writes to group and
+// replicates to replica. The ReplicatedGroup takes ownership of both.
+func NewReplicatedGroup(group *Group, replica Replica, options ...func(*ReplicatedGroup)) *ReplicatedGroup {
+	rg := &ReplicatedGroup{
+		group:   group,
+		replica: replica,
+		queue:   make(chan replicationOp, defaultReplicationQueueSize),
+		done:    make(chan struct{}),
+	}
+	for _, option := range options {
+		option(rg)
+	}
+	rg.BaseService = *service.NewBaseService(nil, "ReplicatedGroup", rg)
+	return rg
+}
+
+// SetLogger sets the logger of the ReplicatedGroup and the underlying group.
+func (rg *ReplicatedGroup) SetLogger(l log.Logger) {
+	rg.BaseService.SetLogger(l)
+	rg.group.SetLogger(l)
+}
+
+// OnStart implements service.Service by starting the group and the
+// replication routine.
+func (rg *ReplicatedGroup) OnStart() error {
+	if err := rg.group.Start(); err != nil {
+		return err
+	}
+	go rg.replicateRoutine()
+	return nil
+}
+
+// OnStop implements service.Service by stopping the group. The replication
+// routine forwards the pending writes before closing the replica.
+func (rg *ReplicatedGroup) OnStop() {
+	if err := rg.group.Stop(); err != nil {
+		rg.Logger.Error("Error stopping group", "err", err)
+	}
+}
+
+// Wait blocks until the group and the replication routine are finished.
+func (rg *ReplicatedGroup) Wait() {
+	rg.group.Wait()
+	<-rg.done
+}
+
+// Close closes the head file of the group.
+func (rg *ReplicatedGroup) Close() {
+	rg.group.Close()
+}
+
+// Write writes p to the group and queues it for replication.
+func (rg *ReplicatedGroup) Write(p []byte) (int, error) {
+	n, err := rg.group.Write(p)
+	if n > 0 {
+		data := make([]byte, n)
+		copy(data, p[:n])
+		rg.enqueue(replicationOp{data: data})
+	}
+	return n, err
+}
+
+// FlushAndSync flushes and fsyncs the group and queues a sync of the replica.
+func (rg *ReplicatedGroup) FlushAndSync() error {
+	if err := rg.group.FlushAndSync(); err != nil {
+		return err
+	}
+	rg.enqueue(replicationOp{sync: true})
+	return nil
+}
+
+// Buffered returns the size of the data buffered by the group.
+func (rg *ReplicatedGroup) Buffered() int { return rg.group.Buffered() }
+
+// HeadSize returns the size of the head file of the group.
+func (rg *ReplicatedGroup) HeadSize() (int64, error) { return rg.group.HeadSize() }
+
+// MinIndex returns index of the first file in the group.
+func (rg *ReplicatedGroup) MinIndex() int { return rg.group.MinIndex() }
+
+// MaxIndex returns index of the last file in the group.
+func (rg *ReplicatedGroup) MaxIndex() int { return rg.group.MaxIndex() }
+
+// NewReader returns a new reader over the local group.
+// CONTRACT: Caller must close the returned GroupReader.
+func (rg *ReplicatedGroup) NewReader(index int) (*GroupReader, error) {
+	return rg.group.NewReader(index)
+}
+
+// Err returns the error which stopped replication, or nil if the replica is
+// still in sync.
+func (rg *ReplicatedGroup) Err() error {
+	rg.mtx.Lock()
+	defer rg.mtx.Unlock()
+	return rg.err
+}
+
+func (rg *ReplicatedGroup) enqueue(op replicationOp) {
+	if rg.Err() != nil {
+		return
+	}
+	select {
+	case rg.queue <- op:
+	default:
+		rg.fail(ErrReplicationQueueFull)
+	}
+}
+
+// fail stops replication with the given error.
+func (rg *ReplicatedGroup) fail(err error) {
+	rg.mtx.Lock()
+	defer rg.mtx.Unlock()
+	if rg.err != nil {
+		return
+	}
+	rg.err = err
+	rg.Logger.Error("Stopped replicating group; replica is no longer in sync", "group", rg.group.ID, "err", err)
+}
+
+func (rg *ReplicatedGroup) replicateRoutine() {
+	defer close(rg.done)
+	for {
+		select {
+		case op := <-rg.queue:
+			rg.replicate(op)
+		case <-rg.Quit():
+			// forward the writes which were queued before stopping
+			for {
+				select {
+				case op := <-rg.queue:
+					rg.replicate(op)
+				default:
+					rg.replicate(replicationOp{sync: true})
+					if err := rg.replica.Close(); err != nil {
+						rg.Logger.Error("Error closing replica", "err", err)
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+func (rg *ReplicatedGroup) replicate(op replicationOp) {
+	if rg.Err() != nil {
+		return
+	}
+	var err error
+	if op.sync {
+		err = rg.replica.Sync()
+	} else {
+		_, err = rg.replica.Write(op.data)
+	}
+	if err != nil {
+		rg.fail(fmt.Errorf("replicating to replica: %w", err))
+	}
+}
+
+//--------------------------------------------------------------------------------
+
+// GroupReplica is a Replica backed by a Group, e.g. on another disk or a
+// network file system. The replicated files are rotated and pruned like the
+// files of the source group.
+type GroupReplica struct {
+	group *Group
+}
+
+var _ Replica = (*GroupReplica)(nil)
+
+// OpenGroupReplica opens a Group with head at headPath to be used as a
+// Replica.
+func OpenGroupReplica(headPath string, groupOptions ...func(*Group)) (*GroupReplica, error) {
+	group, err := OpenGroup(headPath, groupOptions...)
+	if err != nil {
+		return nil, err
+	}
+	if err := group.Start(); err != nil {
+		group.Close()
+		return nil, err
+	}
+	return &GroupReplica{group: group}, nil
+}
+
+// Write implements Replica.
+func (r *GroupReplica) Write(p []byte) (int, error) {
+	return r.group.Write(p)
+}
+
+// Sync implements Replica.
+func (r *GroupReplica) Sync() error {
+	return r.group.FlushAndSync()
+}
+
+// Close implements Replica.
+func (r *GroupReplica) Close() error {
+	err := r.group.Stop()
+	r.group.Wait()
+	r.group.Close()
+	return err
+}
@@ -0,0 +1,124 @@
+package autofile
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+)
+
+type mockReplica struct {
+	mtx    sync.Mutex
+	data   []byte
+	syncs  int
+	closed bool
+	err    error
+}
+
+func (r *mockReplica) Write(p []byte) (int, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.err != nil {
+		return 0, r.err
+	}
+	r.data = append(r.data, p...)
+	return len(p), nil
+}
+
+func (r *mockReplica) Sync() error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.syncs++
+	return r.err
+}
+
+func (r *mockReplica) Close() error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.closed = true
+	return nil
+}
+
+func TestReplicatedGroup(t *testing.T) {
+	g := createTestGroupWithHeadSizeLimit(t, 0)
+	defer destroyTestGroup(t, g)
+
+	replica := &mockReplica{}
+	rg := NewReplicatedGroup(g, replica)
+	require.NoError(t, rg.Start())
+
+	var written []byte
+	for i := 0; i < 100; i++ {
+		line := []byte(cmtrand.Str(99) + "\n")
+		_, err := rg.Write(line)
+		require.NoError(t, err)
+		written = append(written, line...)
+	}
+	require.NoError(t, rg.FlushAndSync())
+
+	require.NoError(t, rg.Stop())
+	rg.Wait()
+
+	head, err := os.ReadFile(g.Head.Path)
+	require.NoError(t, err)
+	assert.Equal(t, written, head)
+	assert.Equal(t, written, replica.data)
+	assert.True(t, replica.closed)
+	assert.GreaterOrEqual(t, replica.syncs, 1)
+	assert.NoError(t, rg.Err())
+}
+
+func TestReplicatedGroupStopsOnError(t *testing.T) {
+	g := createTestGroupWithHeadSizeLimit(t, 0)
+	defer destroyTestGroup(t, g)
+
+	replica := &mockReplica{err: errors.New("unavailable")}
+	rg := NewReplicatedGroup(g, replica)
+	require.NoError(t, rg.Start())
+
+	// local writes are unaffected by replica failures
+	_, err := rg.Write([]byte("abc\n"))
+	require.NoError(t, err)
+	require.NoError(t, rg.Stop())
+	rg.Wait()
+
+	require.Error(t, rg.Err())
+	assert.True(t, replica.closed)
+}
+
+func TestReplicatedGroupQueueFull(t *testing.T) {
+	g := createTestGroupWithHeadSizeLimit(t, 0)
+	defer destroyTestGroup(t, g)
+
+	// the replication routine is not running before Start, so the queue fills up
+	rg := NewReplicatedGroup(g, &mockReplica{}, ReplicationQueueSize(1))
+	_, err := rg.Write([]byte("a"))
+	require.NoError(t, err)
+	_, err = rg.Write([]byte("b"))
+	require.NoError(t, err)
+	require.ErrorIs(t, rg.Err(), ErrReplicationQueueFull)
+}
+
+func TestGroupReplica(t *testing.T) {
+	g := createTestGroupWithHeadSizeLimit(t, 0)
+	defer destroyTestGroup(t, g)
+
+	replica, err := OpenGroupReplica(g.Dir + "/replica")
+	require.NoError(t, err)
+	rg := NewReplicatedGroup(g, replica)
+	require.NoError(t, rg.Start())
+
+	_, err = rg.Write([]byte("hello\n"))
+	require.NoError(t, err)
+	require.NoError(t, rg.Stop())
+	rg.Wait()
+
+	data, err := os.ReadFile(g.Dir + "/replica")
+	require.NoError(t, err)
+	assert.Equal(t, "hello\n", string(data))
+}
@@ -495,3 +495,19 @@ func (bA *BitArray) FromProto(protoBitArray *cmtprotobits.BitArray) {
 		bA.Elems = protoBitArray.Elems
 	}
 }
+
+// ValidateBasic checks that the number of elements matches the number of
+// bits. A nil bit array is valid.
+func (bA *BitArray) ValidateBasic() error {
+	if bA == nil {
+		return nil
+	}
+	if bA.Bits < 0 {
+		return fmt.Errorf("negative number of bits: %d", bA.Bits)
+	}
+	if expected := (bA.Bits + 63) / 64; len(bA.Elems) != expected {
+		return fmt.Errorf("mismatch between the number of bits %d and the number of elements %d, expected %d elements",
+			bA.Bits, len(bA.Elems), expected)
+	}
+	return nil
+}
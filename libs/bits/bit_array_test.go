@@ -371,6 +371,29 @@ func TestBitArrayProtoBuf(t *testing.T) {
 	}
 }
 
+func TestBitArrayValidateBasic(t *testing.T) {
+	testCases := []struct {
+		msg     string
+		bA      *BitArray
+		expPass bool
+	}{
+		{"nil", nil, true},
+		{"empty", &BitArray{}, true},
+		{"valid", NewBitArray(65), true},
+		{"negative bits", &BitArray{Bits: -1}, false},
+		{"too few elems", &BitArray{Bits: 65, Elems: make([]uint64, 1)}, false},
+		{"too many elems", &BitArray{Bits: 64, Elems: make([]uint64, 2)}, false},
+	}
+	for _, tc := range testCases {
+		err := tc.bA.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
 // Tests that UnmarshalJSON doesn't crash when no bits are passed into the JSON.
 // See issue https://github.com/cometbft/cometbft/issues/2658
 func TestUnmarshalJSONDoesntCrashOnZeroBits(t *testing.T) {
@@ -0,0 +1,262 @@
+// Package profile decodes the profiles written by runtime/pprof, keeping only
+// what is needed to aggregate their samples: the values, labels and stack of
+// each sample.
+package profile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Profile is a decoded pprof profile.
+type Profile struct {
+	// SampleTypes are the types of the values of each sample, e.g. "cpu" or
+	// "alloc_space".
+	SampleTypes []string
+	Samples     []*Sample
+}
+
+// Sample is a sample of a profile.
+type Sample struct {
+	// Values has a value for each of the SampleTypes of the profile.
+	Values []int64
+	// Labels are the string labels of the goroutine the sample was taken in.
+	Labels map[string]string
+	// Stack are the names of the functions of the sample's stack, from the
+	// innermost to the outermost.
+	Stack []string
+}
+
+// Index returns the index of sampleType in the values of the samples, or -1
+// if the profile has no such values.
+func (p *Profile) Index(sampleType string) int {
+	for i, t := range p.SampleTypes {
+		if t == sampleType {
+			return i
+		}
+	}
+	return -1
+}
+
+// Parse decodes a profile, gzipped or not, in the protobuf format written by
+// runtime/pprof.
+func Parse(r io.Reader) (*Profile, error) {
+	bz, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) >= 2 && bz[0] == 0x1f && bz[1] == 0x8b {
+		gz, err := gzip.NewReader(bytes.NewReader(bz))
+		if err != nil {
+			return nil, err
+		}
+		if bz, err = io.ReadAll(gz); err != nil {
+			return nil, fmt.Errorf("decompressing profile: %w", err)
+		}
+	}
+	return decode(bz)
+}
+
+// rawSample is a sample before the indices of the profile are resolved.
+type rawSample struct {
+	locations []uint64
+	values    []int64
+	labels    [][2]int64 // key and value indices in the string table
+}
+
+func decode(b []byte) (*Profile, error) {
+	var (
+		sampleTypes []int64
+		samples     []rawSample
+		locations   = make(map[uint64][]uint64) // location ID -> function IDs
+		functions   = make(map[uint64]int64)    // function ID -> name index
+		strs        []string
+	)
+	err := walk(b, func(num protowire.Number, v []byte, n uint64) error {
+		var err error
+		switch num {
+		case 1:
+			var typ int64
+			err = walk(v, func(num protowire.Number, _ []byte, n uint64) error {
+				if num == 1 {
+					typ = int64(n)
+				}
+				return nil
+			})
+			sampleTypes = append(sampleTypes, typ)
+		case 2:
+			var s rawSample
+			s, err = decodeSample(v)
+			samples = append(samples, s)
+		case 4:
+			var id uint64
+			var funcs []uint64
+			err = walk(v, func(num protowire.Number, v []byte, n uint64) error {
+				switch num {
+				case 1:
+					id = n
+				case 4:
+					return walk(v, func(num protowire.Number, _ []byte, n uint64) error {
+						if num == 1 {
+							funcs = append(funcs, n)
+						}
+						return nil
+					})
+				}
+				return nil
+			})
+			locations[id] = funcs
+		case 5:
+			var id uint64
+			var name int64
+			err = walk(v, func(num protowire.Number, _ []byte, n uint64) error {
+				switch num {
+				case 1:
+					id = n
+				case 2:
+					name = int64(n)
+				}
+				return nil
+			})
+			functions[id] = name
+		case 6:
+			strs = append(strs, string(v))
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	str := func(i int64) (string, error) {
+		if i < 0 || i >= int64(len(strs)) {
+			return "", fmt.Errorf("string index %d out of range", i)
+		}
+		return strs[i], nil
+	}
+	p := &Profile{SampleTypes: make([]string, len(sampleTypes))}
+	for i, typ := range sampleTypes {
+		if p.SampleTypes[i], err = str(typ); err != nil {
+			return nil, err
+		}
+	}
+	for _, raw := range samples {
+		s := &Sample{Values: raw.values}
+		for _, label := range raw.labels {
+			key, err := str(label[0])
+			if err != nil {
+				return nil, err
+			}
+			value, err := str(label[1])
+			if err != nil {
+				return nil, err
+			}
+			if s.Labels == nil {
+				s.Labels = make(map[string]string)
+			}
+			s.Labels[key] = value
+		}
+		for _, loc := range raw.locations {
+			// the functions of a location are inlined in the next ones
+			for _, fn := range locations[loc] {
+				name, err := str(functions[fn])
+				if err != nil {
+					return nil, err
+				}
+				s.Stack = append(s.Stack, name)
+			}
+		}
+		p.Samples = append(p.Samples, s)
+	}
+	return p, nil
+}
+
+func decodeSample(b []byte) (rawSample, error) {
+	var s rawSample
+	err := walk(b, func(num protowire.Number, v []byte, n uint64) error {
+		switch num {
+		case 1:
+			if v == nil {
+				s.locations = append(s.locations, n)
+				return nil
+			}
+			return packed(v, func(n uint64) { s.locations = append(s.locations, n) })
+		case 2:
+			if v == nil {
+				s.values = append(s.values, int64(n))
+				return nil
+			}
+			return packed(v, func(n uint64) { s.values = append(s.values, int64(n)) })
+		case 3:
+			var key, str int64
+			err := walk(v, func(num protowire.Number, _ []byte, n uint64) error {
+				switch num {
+				case 1:
+					key = int64(n)
+				case 2:
+					str = int64(n)
+				}
+				return nil
+			})
+			// numeric labels have no string value
+			if err == nil && str != 0 {
+				s.labels = append(s.labels, [2]int64{key, str})
+			}
+			return err
+		}
+		return nil
+	})
+	return s, err
+}
+
+// walk calls f with each field of the message encoded in b, with the bytes
+// of length-delimited fields or, v being nil, the value of varint fields.
+func walk(b []byte, f func(num protowire.Number, v []byte, n uint64) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		var (
+			v []byte
+			x uint64
+		)
+		switch typ {
+		case protowire.VarintType:
+			x, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			v, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		if typ != protowire.VarintType && typ != protowire.BytesType {
+			continue
+		}
+		if err := f(num, v, x); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// packed calls f with each varint of a packed repeated field.
+func packed(b []byte, f func(uint64)) error {
+	for len(b) > 0 {
+		x, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		f(x)
+		b = b[n:]
+	}
+	return nil
+}
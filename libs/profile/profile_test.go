@@ -0,0 +1,54 @@
+package profile_test
+
+import (
+	"bytes"
+	"context"
+	"runtime/pprof"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/profile"
+)
+
+func blockLabeled(started, done chan struct{}) {
+	close(started)
+	<-done
+}
+
+func TestParse(t *testing.T) {
+	started, done := make(chan struct{}), make(chan struct{})
+	defer close(done)
+	go pprof.Do(context.Background(), pprof.Labels("reactor", "test"), func(context.Context) {
+		blockLabeled(started, done)
+	})
+	<-started
+
+	var buf bytes.Buffer
+	require.NoError(t, pprof.Lookup("goroutine").WriteTo(&buf, 0))
+	p, err := profile.Parse(&buf)
+	require.NoError(t, err)
+	require.Equal(t, 0, p.Index("goroutine"))
+	assert.Equal(t, -1, p.Index("cpu"))
+
+	var found bool
+	for _, s := range p.Samples {
+		if s.Labels["reactor"] != "test" {
+			continue
+		}
+		found = true
+		require.Len(t, s.Values, 1)
+		assert.EqualValues(t, 1, s.Values[0])
+		var inStack bool
+		for _, fn := range s.Stack {
+			inStack = inStack || strings.HasSuffix(fn, "profile_test.blockLabeled")
+		}
+		assert.True(t, inStack, s.Stack)
+	}
+	assert.True(t, found)
+
+	_, err = profile.Parse(bytes.NewReader([]byte{0x0a, 0x10}))
+	assert.Error(t, err)
+}
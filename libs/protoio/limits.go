@@ -0,0 +1,181 @@
+package protoio
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	defaultMaxDepth    = 32
+	defaultMaxRepeated = 1 << 16
+)
+
+// ErrDecodeLimit is returned by UnmarshalLimited when a message exceeds its
+// DecodeLimits.
+var ErrDecodeLimit = errors.New("protobuf decode limit exceeded")
+
+// DecodeLimits bounds the structure of a protobuf message, so that a small
+// crafted message can't make the decoder allocate far more memory than its
+// size, e.g. with a million empty elements in a repeated message field.
+type DecodeLimits struct {
+	// Maximum nesting of messages, the top-level message being at depth 0.
+	MaxDepth int
+	// Maximum number of elements of each repeated or map field of a message.
+	MaxRepeated int
+}
+
+// DefaultDecodeLimits returns the limits used for the zero fields of
+// DecodeLimits.
+func DefaultDecodeLimits() DecodeLimits {
+	return DecodeLimits{
+		MaxDepth:    defaultMaxDepth,
+		MaxRepeated: defaultMaxRepeated,
+	}
+}
+
+// FillDefaults returns the limits with their zero fields set to the default.
+func (l DecodeLimits) FillDefaults() DecodeLimits {
+	if l.MaxDepth == 0 {
+		l.MaxDepth = defaultMaxDepth
+	}
+	if l.MaxRepeated == 0 {
+		l.MaxRepeated = defaultMaxRepeated
+	}
+	return l
+}
+
+// UnmarshalLimited unmarshals bz into msg after checking that the encoded
+// message is within limits, walking its wire format with the descriptor of
+// msg. Messages without a registered descriptor are unmarshaled unchecked.
+func UnmarshalLimited(bz []byte, msg proto.Message, limits DecodeLimits) error {
+	if md := descriptorOf(msg); md != nil {
+		if err := checkLimits(bz, md, limits.FillDefaults(), 0); err != nil {
+			return err
+		}
+	}
+	return proto.Unmarshal(bz, msg)
+}
+
+var (
+	registryOnce sync.Once
+	registry     *protoregistry.Files
+	descriptors  sync.Map // message name -> protoreflect.MessageDescriptor, nil if unknown
+)
+
+// descriptorOf returns the descriptor of msg from the files registered with
+// gogoproto and the protobuf runtime, or nil if it isn't registered.
+func descriptorOf(msg proto.Message) protoreflect.MessageDescriptor {
+	name := proto.MessageName(msg)
+	if md, ok := descriptors.Load(name); ok {
+		return md.(protoreflect.MessageDescriptor)
+	}
+	registryOnce.Do(func() {
+		// an error leaves the registry nil, with all the messages unchecked
+		registry, _ = proto.MergedRegistry()
+	})
+	var md protoreflect.MessageDescriptor
+	if registry != nil && name != "" {
+		if d, err := registry.FindDescriptorByName(protoreflect.FullName(name)); err == nil {
+			md, _ = d.(protoreflect.MessageDescriptor)
+		}
+	}
+	descriptors.Store(name, md)
+	return md
+}
+
+// checkLimits walks the fields of the message md encoded in b, and the
+// messages nested in them.
+func checkLimits(b []byte, md protoreflect.MessageDescriptor, limits DecodeLimits, depth int) error {
+	if depth > limits.MaxDepth {
+		return fmt.Errorf("%w: %s nested deeper than %d", ErrDecodeLimit, md.FullName(), limits.MaxDepth)
+	}
+	var counts map[protowire.Number]int
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		fd := md.Fields().ByNumber(num)
+		if fd == nil || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			b = b[n:]
+			if fd != nil && fd.IsList() {
+				if counts == nil {
+					counts = make(map[protowire.Number]int)
+				}
+				counts[num]++
+				if counts[num] > limits.MaxRepeated {
+					return repeatedError(fd, limits)
+				}
+			}
+			continue
+		}
+
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		if fd.IsList() || fd.IsMap() {
+			elems := 1
+			if fd.IsList() && isPackable(fd.Kind()) {
+				elems = packedLen(v, fd.Kind())
+			}
+			if counts == nil {
+				counts = make(map[protowire.Number]int)
+			}
+			counts[num] += elems
+			if counts[num] > limits.MaxRepeated {
+				return repeatedError(fd, limits)
+			}
+		}
+		if fd.Message() != nil {
+			if err := checkLimits(v, fd.Message(), limits, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func repeatedError(fd protoreflect.FieldDescriptor, limits DecodeLimits) error {
+	return fmt.Errorf("%w: more than %d elements in %s", ErrDecodeLimit, limits.MaxRepeated, fd.FullName())
+}
+
+func isPackable(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind, protoreflect.GroupKind:
+		return false
+	}
+	return true
+}
+
+// packedLen returns the number of elements of a packed repeated field of
+// scalars.
+func packedLen(v []byte, kind protoreflect.Kind) int {
+	switch kind {
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind, protoreflect.FloatKind:
+		return len(v) / 4
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind, protoreflect.DoubleKind:
+		return len(v) / 8
+	}
+	// varints end with a byte without the continuation bit
+	elems := 0
+	for _, c := range v {
+		if c < 0x80 {
+			elems++
+		}
+	}
+	return elems
+}
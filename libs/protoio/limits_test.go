@@ -0,0 +1,62 @@
+package protoio_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/protoio"
+	tmcons "github.com/cometbft/cometbft/proto/tendermint/consensus"
+	bitsproto "github.com/cometbft/cometbft/proto/tendermint/libs/bits"
+	tmp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+func TestUnmarshalLimitedRepeated(t *testing.T) {
+	addrs := &tmp2p.Message{Sum: &tmp2p.Message_PexAddrs{PexAddrs: &tmp2p.PexAddrs{
+		Addrs: make([]tmp2p.NetAddress, 10),
+	}}}
+	bz, err := proto.Marshal(addrs)
+	require.NoError(t, err)
+
+	var msg tmp2p.Message
+	require.NoError(t, protoio.UnmarshalLimited(bz, &msg, protoio.DecodeLimits{MaxRepeated: 10}))
+	assert.Len(t, msg.GetPexAddrs().Addrs, 10)
+	err = protoio.UnmarshalLimited(bz, &msg, protoio.DecodeLimits{MaxRepeated: 9})
+	assert.ErrorIs(t, err, protoio.ErrDecodeLimit)
+
+	// packed scalars are counted by element
+	bits := &bitsproto.BitArray{Bits: 640, Elems: []uint64{1, 1 << 40, 3, 4, 5, 6, 7, 8, 9, 10}}
+	bz, err = proto.Marshal(bits)
+	require.NoError(t, err)
+	require.NoError(t, protoio.UnmarshalLimited(bz, &bitsproto.BitArray{}, protoio.DecodeLimits{MaxRepeated: 10}))
+	err = protoio.UnmarshalLimited(bz, &bitsproto.BitArray{}, protoio.DecodeLimits{MaxRepeated: 9})
+	assert.ErrorIs(t, err, protoio.ErrDecodeLimit)
+}
+
+func TestUnmarshalLimitedDepth(t *testing.T) {
+	// Message > Vote > types.Vote > BlockID > PartSetHeader
+	vote := &tmcons.Message{Sum: &tmcons.Message_Vote{Vote: &tmcons.Vote{Vote: &cmtproto.Vote{
+		Height:    1,
+		Timestamp: time.Unix(1, 0),
+	}}}}
+	bz, err := proto.Marshal(vote)
+	require.NoError(t, err)
+
+	var msg tmcons.Message
+	require.NoError(t, protoio.UnmarshalLimited(bz, &msg, protoio.DecodeLimits{MaxDepth: 4}))
+	assert.EqualValues(t, 1, msg.GetVote().Vote.Height)
+	err = protoio.UnmarshalLimited(bz, &msg, protoio.DecodeLimits{MaxDepth: 3})
+	assert.ErrorIs(t, err, protoio.ErrDecodeLimit)
+}
+
+func TestUnmarshalLimitedMalformed(t *testing.T) {
+	var msg tmcons.Message
+	// a vote field whose length runs past the end of the message
+	err := protoio.UnmarshalLimited([]byte{0x32, 0x10, 0x0a}, &msg, protoio.DefaultDecodeLimits())
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, protoio.ErrDecodeLimit)
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "routines"
+)
+
+//go:generate go run ../../scripts/metricsgen -struct=Metrics
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of goroutines running, by subsystem.
+	Running metrics.Gauge `metrics_labels:"subsystem"`
+	// Number of goroutines not started because their subsystem already ran
+	// as many as allowed.
+	Rejected metrics.Counter `metrics_labels:"subsystem"`
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// routinesPollInterval is how often WaitIdle checks whether goroutines are
+// still running.
+const routinesPollInterval = 10 * time.Millisecond
+
+// Routines tracks the goroutines run by the subsystems of a node, such as the
+// routines its reactors run for each peer, so that their number can be
+// monitored and capped, and those outliving the node detected.
+//
+// A nil *Routines runs goroutines without tracking them.
+type Routines struct {
+	metrics *Metrics
+
+	mtx     cmtsync.Mutex
+	running map[string]int
+	caps    map[string]int
+	queued  map[string][]*queuedRoutine
+}
+
+// queuedRoutine is a function waiting for a goroutine of its subsystem to
+// return, see GoQueued.
+type queuedRoutine struct {
+	f func()
+}
+
+// NewRoutines returns a registry of goroutines reporting to metrics, which may
+// be nil.
+func NewRoutines(metrics *Metrics) *Routines {
+	if metrics == nil {
+		metrics = NopMetrics()
+	}
+	return &Routines{
+		metrics: metrics,
+		running: make(map[string]int),
+		caps:    make(map[string]int),
+		queued:  make(map[string][]*queuedRoutine),
+	}
+}
+
+// SetCap caps the number of goroutines subsystem runs at once. A cap of 0
+// lifts it.
+func (r *Routines) SetCap(subsystem string, max int) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if max <= 0 {
+		delete(r.caps, subsystem)
+		return
+	}
+	r.caps[subsystem] = max
+}
+
+// Go runs f in a new goroutine accounted to subsystem, unless subsystem
+// already runs as many goroutines as its cap allows. It returns whether f was
+// run.
+func (r *Routines) Go(subsystem string, f func()) bool {
+	if r == nil {
+		go f()
+		return true
+	}
+
+	r.mtx.Lock()
+	if max, ok := r.caps[subsystem]; ok && r.running[subsystem] >= max {
+		r.mtx.Unlock()
+		r.metrics.Rejected.With("subsystem", subsystem).Add(1)
+		return false
+	}
+	r.start(subsystem, f)
+	r.mtx.Unlock()
+	return true
+}
+
+// GoQueued runs f in a new goroutine accounted to subsystem, as Go, or, if
+// subsystem already runs as many goroutines as its cap allows, once one of
+// them returns, the functions queued being run in order. The returned function
+// removes f from the queue if it was not run yet.
+func (r *Routines) GoQueued(subsystem string, f func()) (dequeue func()) {
+	if r == nil {
+		go f()
+		return func() {}
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if max, ok := r.caps[subsystem]; !ok || r.running[subsystem] < max {
+		r.start(subsystem, f)
+		return func() {}
+	}
+	r.metrics.Rejected.With("subsystem", subsystem).Add(1)
+	q := &queuedRoutine{f: f}
+	r.queued[subsystem] = append(r.queued[subsystem], q)
+	return func() {
+		r.mtx.Lock()
+		defer r.mtx.Unlock()
+		queued := r.queued[subsystem]
+		for i, qr := range queued {
+			if qr == q {
+				r.queued[subsystem] = append(queued[:i:i], queued[i+1:]...)
+				break
+			}
+		}
+		if len(r.queued[subsystem]) == 0 {
+			delete(r.queued, subsystem)
+		}
+	}
+}
+
+// start runs f in a new goroutine accounted to subsystem.
+//
+// The caller must hold r.mtx.
+func (r *Routines) start(subsystem string, f func()) {
+	r.running[subsystem]++
+	r.metrics.Running.With("subsystem", subsystem).Set(float64(r.running[subsystem]))
+	go func() {
+		defer r.done(subsystem)
+		f()
+	}()
+}
+
+func (r *Routines) done(subsystem string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.running[subsystem]--
+	if queued := r.queued[subsystem]; len(queued) > 0 {
+		// hand the slot over to the first function queued
+		if len(queued) == 1 {
+			delete(r.queued, subsystem)
+		} else {
+			r.queued[subsystem] = queued[1:]
+		}
+		r.start(subsystem, queued[0].f)
+		return
+	}
+	r.metrics.Running.With("subsystem", subsystem).Set(float64(r.running[subsystem]))
+	if r.running[subsystem] == 0 {
+		delete(r.running, subsystem)
+	}
+}
+
+// Running returns the number of goroutines subsystem runs.
+func (r *Routines) Running(subsystem string) int {
+	if r == nil {
+		return 0
+	}
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.running[subsystem]
+}
+
+// WaitIdle waits up to timeout for all goroutines to return, e.g. once their
+// node stopped. It returns the number of goroutines still running by
+// subsystem, which is empty unless some leaked.
+func (r *Routines) WaitIdle(timeout time.Duration) map[string]int {
+	if r == nil {
+		return nil
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		r.mtx.Lock()
+		if len(r.running) == 0 || !time.Now().Before(deadline) {
+			running := make(map[string]int, len(r.running))
+			for subsystem, n := range r.running {
+				running[subsystem] = n
+			}
+			r.mtx.Unlock()
+			return running
+		}
+		r.mtx.Unlock()
+		time.Sleep(routinesPollInterval)
+	}
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutines(t *testing.T) {
+	r := NewRoutines(nil)
+	r.SetCap("capped", 2)
+
+	release := make(chan struct{})
+	block := func() { <-release }
+	require.True(t, r.Go("capped", block))
+	require.True(t, r.Go("capped", block))
+	assert.False(t, r.Go("capped", block), "cap exceeded")
+	require.True(t, r.Go("uncapped", block))
+	assert.Equal(t, 2, r.Running("capped"))
+	assert.Equal(t, 1, r.Running("uncapped"))
+
+	assert.Equal(t, map[string]int{"capped": 2, "uncapped": 1}, r.WaitIdle(20*time.Millisecond))
+
+	close(release)
+	assert.Empty(t, r.WaitIdle(time.Second))
+	assert.Equal(t, 0, r.Running("capped"))
+
+	// the cap applies to running goroutines only
+	r.SetCap("capped", 1)
+	done := make(chan struct{})
+	require.True(t, r.Go("capped", func() { close(done) }))
+	<-done
+	assert.Empty(t, r.WaitIdle(time.Second))
+	require.True(t, r.Go("capped", func() {}))
+
+	// lifting the cap
+	r.SetCap("capped", 0)
+	release = make(chan struct{})
+	for i := 0; i < 3; i++ {
+		require.True(t, r.Go("capped", block))
+	}
+	close(release)
+	assert.Empty(t, r.WaitIdle(time.Second))
+}
+
+func TestRoutinesQueued(t *testing.T) {
+	r := NewRoutines(nil)
+	r.SetCap("capped", 1)
+
+	release := make(chan struct{})
+	r.GoQueued("capped", func() { <-release })
+	ran := make(chan int, 3)
+	r.GoQueued("capped", func() { ran <- 1 })
+	dequeue := r.GoQueued("capped", func() { ran <- 2 })
+	r.GoQueued("capped", func() { ran <- 3 })
+	assert.Equal(t, 1, r.Running("capped"))
+
+	// the queued functions run in order once a goroutine returns, but for
+	// those dequeued
+	dequeue()
+	close(release)
+	assert.Equal(t, 1, <-ran)
+	assert.Equal(t, 3, <-ran)
+	assert.Empty(t, r.WaitIdle(time.Second))
+	assert.Empty(t, ran)
+
+	// dequeuing a function already run does nothing
+	dequeue = r.GoQueued("capped", func() { ran <- 4 })
+	assert.Equal(t, 4, <-ran)
+	dequeue()
+	assert.Empty(t, r.WaitIdle(time.Second))
+}
+
+func TestRoutinesNil(t *testing.T) {
+	var r *Routines
+	done := make(chan struct{})
+	require.True(t, r.Go("any", func() { close(done) }))
+	<-done
+	assert.Equal(t, 0, r.Running("any"))
+	assert.Empty(t, r.WaitIdle(time.Second))
+}
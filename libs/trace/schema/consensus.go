@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"github.com/cometbft/cometbft/libs/trace"
 	"github.com/cometbft/cometbft/types"
 )
@@ -15,6 +17,7 @@ func ConsensusTables() []string {
 		VoteTable,
 		ConsensusStateTable,
 		ProposalTable,
+		BlockPropagationTable,
 	}
 }
 
@@ -248,3 +251,46 @@ func WriteProposal(
 		TransferType: transferType,
 	})
 }
+
+// Schema constants for the "consensus_block_propagation" table.
+const (
+	// BlockPropagationTable is the name of the table that stores the time it
+	// took to receive the proposal blocks.
+	BlockPropagationTable = "consensus_block_propagation"
+)
+
+// BlockPropagation describes schema for the "consensus_block_propagation"
+// table.
+type BlockPropagation struct {
+	Height   int64  `json:"height"`
+	Round    int32  `json:"round"`
+	Proposer string `json:"proposer"`
+	Parts    uint32 `json:"parts"`
+	// Duration is the time between receiving the first part of the block
+	// from a peer and having the complete block, in nanoseconds.
+	Duration int64 `json:"duration"`
+}
+
+// Table returns the table name for the BlockPropagation struct.
+func (BlockPropagation) Table() string {
+	return BlockPropagationTable
+}
+
+// WriteBlockPropagation writes a tracing point for the time it took to
+// receive the parts of a proposal block.
+func WriteBlockPropagation(
+	client trace.Tracer,
+	height int64,
+	round int32,
+	proposer string,
+	parts uint32,
+	duration time.Duration,
+) {
+	client.Write(BlockPropagation{
+		Height:   height,
+		Round:    round,
+		Proposer: proposer,
+		Parts:    parts,
+		Duration: duration.Nanoseconds(),
+	})
+}
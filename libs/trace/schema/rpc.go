@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"net"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/trace"
+)
+
+// RPCTables returns the list of tables that are used for RPC tracing.
+func RPCTables() []string {
+	return []string{
+		RPCRequestTable,
+	}
+}
+
+// Schema constants for the "rpc_request" table.
+const (
+	// RPCRequestTable is the name of the table that stores the RPC requests
+	// served by the node.
+	RPCRequestTable = "rpc_request"
+)
+
+// RPCCallerClass classifies the callers of the RPC by their address.
+type RPCCallerClass string
+
+const (
+	// RPCCallerLocal is a caller on the same host, over the loopback
+	// interface or a unix socket.
+	RPCCallerLocal RPCCallerClass = "local"
+	// RPCCallerPrivate is a caller on a private network.
+	RPCCallerPrivate RPCCallerClass = "private"
+	// RPCCallerPublic is any other caller.
+	RPCCallerPublic RPCCallerClass = "public"
+)
+
+// CallerClassOf returns the class of the caller with the given remote
+// address, as reported by the RPC server.
+func CallerClassOf(remoteAddr string) RPCCallerClass {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	switch {
+	case ip == nil || ip.IsLoopback():
+		// unix sockets have no IP address
+		return RPCCallerLocal
+	case ip.IsPrivate():
+		return RPCCallerPrivate
+	default:
+		return RPCCallerPublic
+	}
+}
+
+// RPCRequest describes schema for the "rpc_request" table.
+type RPCRequest struct {
+	Method string `json:"method"`
+	// Protocol is either "http" or "websocket".
+	Protocol string `json:"protocol"`
+	// Duration is the time spent serving the request, in nanoseconds.
+	Duration int64 `json:"duration"`
+	// ResponseSize is the size of the JSON encoded result, in bytes.
+	ResponseSize int    `json:"response_size"`
+	Error        bool   `json:"error"`
+	CallerClass  string `json:"caller_class"`
+}
+
+// Table returns the table name for the RPCRequest struct.
+func (RPCRequest) Table() string {
+	return RPCRequestTable
+}
+
+// WriteRPCRequest writes a tracing point for an RPC request served by the
+// node.
+func WriteRPCRequest(
+	client trace.Tracer,
+	method, protocol string,
+	duration time.Duration,
+	responseSize int,
+	failed bool,
+	callerClass RPCCallerClass,
+) {
+	client.Write(RPCRequest{
+		Method:       method,
+		Protocol:     protocol,
+		Duration:     duration.Nanoseconds(),
+		ResponseSize: responseSize,
+		Error:        failed,
+		CallerClass:  string(callerClass),
+	})
+}
@@ -0,0 +1,23 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallerClassOf(t *testing.T) {
+	for addr, class := range map[string]RPCCallerClass{
+		"127.0.0.1:26657":    RPCCallerLocal,
+		"[::1]:26657":        RPCCallerLocal,
+		"@":                  RPCCallerLocal,
+		"":                   RPCCallerLocal,
+		"10.0.0.1:41000":     RPCCallerPrivate,
+		"192.168.1.2:41000":  RPCCallerPrivate,
+		"8.8.8.8:41000":      RPCCallerPublic,
+		"[2001:db8::1]:4100": RPCCallerPublic,
+		"8.8.4.4":            RPCCallerPublic,
+	} {
+		assert.Equal(t, class, CallerClassOf(addr), addr)
+	}
+}
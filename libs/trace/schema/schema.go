@@ -15,6 +15,8 @@ func AllTables() []string {
 	tables = append(tables, MempoolTables()...)
 	tables = append(tables, ConsensusTables()...)
 	tables = append(tables, P2PTables()...)
+	tables = append(tables, StateTables()...)
+	tables = append(tables, RPCTables()...)
 	tables = append(tables, ABCITable)
 	return tables
 }
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/libs/trace"
+)
+
+// StateTables returns the list of tables that are used for state tracing.
+func StateTables() []string {
+	return []string{
+		BlockExecutionTable,
+	}
+}
+
+// Schema constants for the "block_execution" table.
+const (
+	// BlockExecutionTable is the name of the table that stores the duration
+	// of each phase of block execution.
+	BlockExecutionTable = "block_execution"
+)
+
+// BlockExecutionPhase describes schema for the "block_execution" table.
+type BlockExecutionPhase struct {
+	Height int64  `json:"height"`
+	Phase  string `json:"phase"`
+	// Duration is the time spent in the phase, in nanoseconds.
+	Duration int64 `json:"duration"`
+}
+
+// Table returns the table name for the BlockExecutionPhase struct.
+func (BlockExecutionPhase) Table() string {
+	return BlockExecutionTable
+}
+
+// WriteBlockExecutionPhase writes a tracing point for the duration of a phase
+// of block execution.
+func WriteBlockExecutionPhase(client trace.Tracer, height int64, phase string, duration time.Duration) {
+	client.Write(BlockExecutionPhase{
+		Height:   height,
+		Phase:    phase,
+		Duration: duration.Nanoseconds(),
+	})
+}
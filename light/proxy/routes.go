@@ -21,6 +21,7 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"health":               rpcserver.NewRPCFunc(makeHealthFunc(c), ""),
 		"status":               rpcserver.NewRPCFunc(makeStatusFunc(c), ""),
 		"net_info":             rpcserver.NewRPCFunc(makeNetInfoFunc(c), ""),
+		"storage_status":       rpcserver.NewRPCFunc(makeStorageStatusFunc(c), ""),
 		"blockchain":           rpcserver.NewRPCFunc(makeBlockchainInfoFunc(c), "minHeight,maxHeight", rpcserver.Cacheable()),
 		"genesis":              rpcserver.NewRPCFunc(makeGenesisFunc(c), "", rpcserver.Cacheable()),
 		"genesis_chunked":      rpcserver.NewRPCFunc(makeGenesisChunkedFunc(c), "", rpcserver.Cacheable()),
@@ -30,6 +31,7 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"block_by_hash":        rpcserver.NewRPCFunc(makeBlockByHashFunc(c), "hash", rpcserver.Cacheable()),
 		"block_results":        rpcserver.NewRPCFunc(makeBlockResultsFunc(c), "height", rpcserver.Cacheable("height")),
 		"commit":               rpcserver.NewRPCFunc(makeCommitFunc(c), "height", rpcserver.Cacheable("height")),
+		"block_time":           rpcserver.NewRPCFunc(makeBlockTimeFunc(c), "height", rpcserver.Cacheable("height")),
 		"tx":                   rpcserver.NewRPCFunc(makeTxFunc(c), "hash,prove", rpcserver.Cacheable()),
 		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by"),
 		"block_search":         rpcserver.NewRPCFunc(makeBlockSearchFunc(c), "query,page,per_page,order_by"),
@@ -39,11 +41,15 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"consensus_params":     rpcserver.NewRPCFunc(makeConsensusParamsFunc(c), "height", rpcserver.Cacheable("height")),
 		"unconfirmed_txs":      rpcserver.NewRPCFunc(makeUnconfirmedTxsFunc(c), "limit"),
 		"num_unconfirmed_txs":  rpcserver.NewRPCFunc(makeNumUnconfirmedTxsFunc(c), ""),
+		"fee_market":           rpcserver.NewRPCFunc(makeFeeMarketFunc(c), ""),
+		"dump_consensus_state_v2": rpcserver.NewRPCFunc(
+			makeDumpConsensusStateV2Func(c), "sections,page,per_page,encoding"),
 
 		// tx broadcast API
 		"broadcast_tx_commit": rpcserver.NewRPCFunc(makeBroadcastTxCommitFunc(c), "tx"),
 		"broadcast_tx_sync":   rpcserver.NewRPCFunc(makeBroadcastTxSyncFunc(c), "tx"),
 		"broadcast_tx_async":  rpcserver.NewRPCFunc(makeBroadcastTxAsyncFunc(c), "tx"),
+		"broadcast_tx_batch":  rpcserver.NewRPCFunc(makeBroadcastTxBatchFunc(c), "txs"),
 
 		// abci API
 		"abci_query": rpcserver.NewRPCFunc(makeABCIQueryFunc(c), "path,data,height,prove"),
@@ -81,6 +87,14 @@ func makeNetInfoFunc(c *lrpc.Client) rpcNetInfoFunc {
 	}
 }
 
+type rpcStorageStatusFunc func(ctx *rpctypes.Context) (*ctypes.ResultStorageStatus, error)
+
+func makeStorageStatusFunc(c *lrpc.Client) rpcStorageStatusFunc {
+	return func(ctx *rpctypes.Context) (*ctypes.ResultStorageStatus, error) {
+		return c.StorageStatus(ctx.Context())
+	}
+}
+
 type rpcBlockchainInfoFunc func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error)
 
 func makeBlockchainInfoFunc(c *lrpc.Client) rpcBlockchainInfoFunc {
@@ -153,6 +167,14 @@ func makeCommitFunc(c *lrpc.Client) rpcCommitFunc {
 	}
 }
 
+type rpcBlockTimeFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultBlockTime, error)
+
+func makeBlockTimeFunc(c *lrpc.Client) rpcBlockTimeFunc {
+	return func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultBlockTime, error) {
+		return c.BlockTime(ctx.Context(), height)
+	}
+}
+
 type rpcTxFunc func(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
 
 func makeTxFunc(c *lrpc.Client) rpcTxFunc {
@@ -218,6 +240,20 @@ func makeDumpConsensusStateFunc(c *lrpc.Client) rpcDumpConsensusStateFunc {
 	}
 }
 
+type rpcDumpConsensusStateV2Func func(ctx *rpctypes.Context, sections string,
+	page, perPage *int, encoding string) (*ctypes.ResultDumpConsensusStateV2, error)
+
+func makeDumpConsensusStateV2Func(c *lrpc.Client) rpcDumpConsensusStateV2Func {
+	return func(
+		ctx *rpctypes.Context,
+		sections string,
+		page, perPage *int,
+		encoding string,
+	) (*ctypes.ResultDumpConsensusStateV2, error) {
+		return c.DumpConsensusStateV2(ctx.Context(), sections, page, perPage, encoding)
+	}
+}
+
 type rpcConsensusStateFunc func(ctx *rpctypes.Context) (*ctypes.ResultConsensusState, error)
 
 func makeConsensusStateFunc(c *lrpc.Client) rpcConsensusStateFunc {
@@ -250,6 +286,14 @@ func makeNumUnconfirmedTxsFunc(c *lrpc.Client) rpcNumUnconfirmedTxsFunc {
 	}
 }
 
+type rpcFeeMarketFunc func(ctx *rpctypes.Context) (*ctypes.ResultFeeMarket, error)
+
+func makeFeeMarketFunc(c *lrpc.Client) rpcFeeMarketFunc {
+	return func(ctx *rpctypes.Context) (*ctypes.ResultFeeMarket, error) {
+		return c.FeeMarket(ctx.Context())
+	}
+}
+
 type rpcBroadcastTxCommitFunc func(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error)
 
 func makeBroadcastTxCommitFunc(c *lrpc.Client) rpcBroadcastTxCommitFunc {
@@ -266,6 +310,14 @@ func makeBroadcastTxSyncFunc(c *lrpc.Client) rpcBroadcastTxSyncFunc {
 	}
 }
 
+type rpcBroadcastTxBatchFunc func(ctx *rpctypes.Context, txs []types.Tx) (*ctypes.ResultBroadcastTxBatch, error)
+
+func makeBroadcastTxBatchFunc(c *lrpc.Client) rpcBroadcastTxBatchFunc {
+	return func(ctx *rpctypes.Context, txs []types.Tx) (*ctypes.ResultBroadcastTxBatch, error) {
+		return c.BroadcastTxBatch(ctx.Context(), txs)
+	}
+}
+
 type rpcBroadcastTxAsyncFunc func(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error)
 
 func makeBroadcastTxAsyncFunc(c *lrpc.Client) rpcBroadcastTxAsyncFunc {
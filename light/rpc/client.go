@@ -201,6 +201,10 @@ func (c *Client) BroadcastTxSync(ctx context.Context, tx types.Tx) (*ctypes.Resu
 	return c.next.BroadcastTxSync(ctx, tx)
 }
 
+func (c *Client) BroadcastTxBatch(ctx context.Context, txs types.Txs) (*ctypes.ResultBroadcastTxBatch, error) {
+	return c.next.BroadcastTxBatch(ctx, txs)
+}
+
 func (c *Client) UnconfirmedTxs(ctx context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error) {
 	return c.next.UnconfirmedTxs(ctx, limit)
 }
@@ -209,6 +213,10 @@ func (c *Client) NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUnconfirm
 	return c.next.NumUnconfirmedTxs(ctx)
 }
 
+func (c *Client) FeeMarket(ctx context.Context) (*ctypes.ResultFeeMarket, error) {
+	return c.next.FeeMarket(ctx)
+}
+
 func (c *Client) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
 	return c.next.CheckTx(ctx, tx)
 }
@@ -217,10 +225,23 @@ func (c *Client) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.next.NetInfo(ctx)
 }
 
+func (c *Client) StorageStatus(ctx context.Context) (*ctypes.ResultStorageStatus, error) {
+	return c.next.StorageStatus(ctx)
+}
+
 func (c *Client) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	return c.next.DumpConsensusState(ctx)
 }
 
+func (c *Client) DumpConsensusStateV2(
+	ctx context.Context,
+	sections string,
+	page, perPage *int,
+	encoding string,
+) (*ctypes.ResultDumpConsensusStateV2, error) {
+	return c.next.DumpConsensusStateV2(ctx, sections, page, perPage, encoding)
+}
+
 func (c *Client) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error) {
 	return c.next.ConsensusState(ctx)
 }
@@ -469,6 +490,172 @@ func (c *Client) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommi
 	}, nil
 }
 
+// BlockTime calls rpcclient#BlockTime and then verifies the header against the
+// trusted header, the last commit against the header and the time against the
+// weighted median of the last commit's precommit timestamps.
+func (c *Client) BlockTime(ctx context.Context, height *int64) (*ctypes.ResultBlockTime, error) {
+	res, err := c.next.BlockTime(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if res.Height <= 0 {
+		return nil, errNegOrZeroHeight
+	}
+	if res.SignedHeader.Header == nil {
+		return nil, errors.New("missing header")
+	}
+
+	// Update the light client if we're behind.
+	l, err := c.updateLightClientIfNeededTo(ctx, &res.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify header.
+	if hH, lH := res.SignedHeader.Hash(), l.Hash(); !bytes.Equal(hH, lH) {
+		return nil, fmt.Errorf("primary header hash does not match trusted header hash. (%X != %X)",
+			hH, lH)
+	}
+	if !res.Time.Equal(l.Time) {
+		return nil, fmt.Errorf("time %v does not match header time %v", res.Time, l.Time)
+	}
+
+	// The first block's time is the genesis time.
+	if l.LastBlockID.IsZero() {
+		if len(res.Timestamps) != 0 {
+			return nil, errors.New("unexpected precommit timestamps for the first block")
+		}
+		return res, nil
+	}
+
+	// Verify the last commit and validators.
+	if res.LastCommit == nil || res.LastValidators == nil {
+		return nil, errors.New("missing last commit or last validators")
+	}
+	if cH, lH := res.LastCommit.Hash(), l.LastCommitHash; !bytes.Equal(cH, lH) {
+		return nil, fmt.Errorf("last commit hash %X does not match header last commit hash %X", cH, lH)
+	}
+	prevHeight := res.Height - 1
+	prev, err := c.updateLightClientIfNeededTo(ctx, &prevHeight)
+	if err != nil {
+		return nil, err
+	}
+	if vH, lH := res.LastValidators.Hash(), prev.ValidatorsHash; !bytes.Equal(vH, lH) {
+		return nil, fmt.Errorf("last validators hash %X does not match trusted validators hash %X", vH, lH)
+	}
+
+	// Verify the time and the timestamps it is computed from.
+	if median := state.MedianTime(res.LastCommit, res.LastValidators); !median.Equal(res.Time) {
+		return nil, fmt.Errorf("time %v does not match median precommit time %v", res.Time, median)
+	}
+	expected := ctypes.PrecommitTimestamps(res.LastCommit, res.LastValidators)
+	if len(expected) != len(res.Timestamps) {
+		return nil, fmt.Errorf("expected %d precommit timestamps, got %d", len(expected), len(res.Timestamps))
+	}
+	for i, ts := range res.Timestamps {
+		if !bytes.Equal(ts.ValidatorAddress, expected[i].ValidatorAddress) ||
+			ts.VotingPower != expected[i].VotingPower ||
+			!ts.Timestamp.Equal(expected[i].Timestamp) {
+			return nil, fmt.Errorf("precommit timestamp #%d does not match last commit", i)
+		}
+	}
+
+	return res, nil
+}
+
+// HeightAtTime calls rpcclient#HeightAtTime and then verifies that the time
+// of the header at the returned height is at or before t, and that the time of
+// the next header, unless it is the latest, is after t.
+func (c *Client) HeightAtTime(ctx context.Context, t time.Time) (*ctypes.ResultHeightAtTime, error) {
+	res, err := c.next.HeightAtTime(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if res.Height <= 0 {
+		return nil, errNegOrZeroHeight
+	}
+
+	// Update the light client if we're behind.
+	l, err := c.updateLightClientIfNeededTo(ctx, &res.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify the times of the header and of the next one.
+	if !res.Time.Equal(l.Time) {
+		return nil, fmt.Errorf("time %v does not match header time %v", res.Time, l.Time)
+	}
+	if l.Time.After(t) {
+		return nil, fmt.Errorf("header time %v is after %v", l.Time, t)
+	}
+	latest, err := c.updateLightClientIfNeededTo(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res.Height < latest.Height {
+		nextHeight := res.Height + 1
+		next, err := c.updateLightClientIfNeededTo(ctx, &nextHeight)
+		if err != nil {
+			return nil, err
+		}
+		if !next.Time.After(t) {
+			return nil, fmt.Errorf("time %v of the next header at height %d is not after %v",
+				next.Time, nextHeight, t)
+		}
+	}
+
+	return res, nil
+}
+
+// BlockPart calls rpcclient#BlockPart and then verifies the PartSetHeader
+// against the trusted commit of the block, and the part against the
+// PartSetHeader.
+func (c *Client) BlockPart(ctx context.Context, height int64, index int) (*ctypes.ResultBlockPart, error) {
+	res, err := c.next.BlockPart(ctx, height, index)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if res.Height != height {
+		return nil, fmt.Errorf("expected part of block at height %d, got %d", height, res.Height)
+	}
+	if res.Part == nil {
+		return nil, errors.New("missing part")
+	}
+	if err := res.PartSetHeader.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid part set header: %w", err)
+	}
+	if err := res.Part.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if int(res.Part.Index) != index || res.Part.Proof.Total != int64(res.PartSetHeader.Total) {
+		return nil, fmt.Errorf("expected part %d of %d, got part %d of %d",
+			index, res.PartSetHeader.Total, res.Part.Index, res.Part.Proof.Total)
+	}
+
+	// Update the light client if we're behind.
+	l, err := c.updateLightClientIfNeededTo(ctx, &res.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify the part set header and the part.
+	if !res.PartSetHeader.Equals(l.Commit.BlockID.PartSetHeader) {
+		return nil, fmt.Errorf("part set header %v does not match trusted part set header %v",
+			res.PartSetHeader, l.Commit.BlockID.PartSetHeader)
+	}
+	if err := res.Part.Proof.Verify(res.PartSetHeader.Hash, res.Part.Bytes); err != nil {
+		return nil, fmt.Errorf("verify part: %w", err)
+	}
+
+	return res, nil
+}
+
 // Tx calls rpcclient#Tx method and then verifies the proof if such was
 // requested.
 func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
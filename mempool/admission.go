@@ -0,0 +1,97 @@
+package mempool
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/cometbft/cometbft/config"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// BlobCheckFunc is an optional hook run on the blobs of blob transactions
+// before CheckTx, e.g. to reject blobs in reserved namespaces.
+type BlobCheckFunc func(blobs []*cmtproto.Blob) error
+
+type prefixLimit struct {
+	prefix   []byte
+	maxBytes int
+}
+
+// AdmissionFilter rejects transactions before they are passed to CheckTx, so
+// that obviously invalid or oversized transactions don't consume ABCI
+// bandwidth. Unlike the PreCheckFunc given to Update, it is not replaced when
+// a block is committed.
+type AdmissionFilter struct {
+	blobMaxBytes int // -1 if blob txs have no dedicated limit
+	limits       []prefixLimit
+	checkBlobs   BlobCheckFunc
+}
+
+// NewAdmissionFilter returns an AdmissionFilter enforcing the
+// max-tx-bytes-by-type limits of cfg.
+func NewAdmissionFilter(cfg *config.MempoolConfig) (*AdmissionFilter, error) {
+	limits, err := cfg.TxBytesLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	f := &AdmissionFilter{blobMaxBytes: -1}
+	for txType, maxBytes := range limits {
+		if txType == config.TxBlobType {
+			f.blobMaxBytes = maxBytes
+			continue
+		}
+		prefix, err := hex.DecodeString(txType)
+		if err != nil {
+			return nil, err
+		}
+		f.limits = append(f.limits, prefixLimit{prefix: prefix, maxBytes: maxBytes})
+	}
+	// the longest matching prefix applies
+	sort.Slice(f.limits, func(i, j int) bool {
+		return len(f.limits[i].prefix) > len(f.limits[j].prefix)
+	})
+	return f, nil
+}
+
+// SetBlobCheck sets the hook run on the blobs of blob transactions. It must
+// be called before the mempool receives transactions.
+func (f *AdmissionFilter) SetBlobCheck(checkBlobs BlobCheckFunc) {
+	f.checkBlobs = checkBlobs
+}
+
+// Check returns an error if tx must not be passed to CheckTx. Blob
+// transactions are only subject to the blob limit and hook, other
+// transactions to the limit of the longest matching prefix. It can be used
+// as a PreCheckFunc.
+func (f *AdmissionFilter) Check(tx types.Tx) error {
+	if f.blobMaxBytes < 0 && f.checkBlobs == nil && len(f.limits) == 0 {
+		return nil
+	}
+
+	if blobTx, isBlobTx := types.UnmarshalBlobTx(tx); isBlobTx {
+		if f.blobMaxBytes >= 0 && len(tx) > f.blobMaxBytes {
+			return fmt.Errorf("blob tx size is too big: %d, max: %d", len(tx), f.blobMaxBytes)
+		}
+		if f.checkBlobs != nil {
+			if err := f.checkBlobs(blobTx.Blobs); err != nil {
+				return fmt.Errorf("invalid blobs: %w", err)
+			}
+		}
+		return nil
+	}
+
+	for _, l := range f.limits {
+		if !bytes.HasPrefix(tx, l.prefix) {
+			continue
+		}
+		if len(tx) > l.maxBytes {
+			return fmt.Errorf("tx size is too big for type %X: %d, max: %d", l.prefix, len(tx), l.maxBytes)
+		}
+		return nil
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package mempool
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	"github.com/cometbft/cometbft/config"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestAdmissionFilter(t *testing.T) {
+	cfg := config.TestMempoolConfig()
+	cfg.MaxTxBytesByType = "blob=100, 0a=10, 0a0b=20"
+	f, err := NewAdmissionFilter(cfg)
+	require.NoError(t, err)
+
+	blob := func(dataLen int, namespace byte) types.Tx {
+		tx, err := types.MarshalBlobTx([]byte{0x0a}, &cmtproto.Blob{
+			NamespaceId: bytes.Repeat([]byte{namespace}, share.NamespaceIDSize),
+			Data:        make([]byte, dataLen),
+		})
+		require.NoError(t, err)
+		return tx
+	}
+
+	testCases := []struct {
+		name    string
+		tx      types.Tx
+		wantErr bool
+	}{
+		{"no matching prefix", bytes.Repeat([]byte{0x01}, 1000), false},
+		{"prefix within limit", append([]byte{0x0a}, make([]byte, 9)...), false},
+		{"prefix over limit", append([]byte{0x0a}, make([]byte, 10)...), true},
+		{"longest prefix applies", append([]byte{0x0a, 0x0b}, make([]byte, 18)...), false},
+		{"longest prefix over limit", append([]byte{0x0a, 0x0b}, make([]byte, 19)...), true},
+		{"blob within limit", blob(10, 1), false},
+		{"blob over limit", blob(100, 1), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := f.Check(tc.tx)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	errReserved := errors.New("reserved namespace")
+	f.SetBlobCheck(func(blobs []*cmtproto.Blob) error {
+		for _, b := range blobs {
+			if b.NamespaceId[0] == 0 {
+				return errReserved
+			}
+		}
+		return nil
+	})
+	assert.NoError(t, f.Check(blob(10, 1)))
+	assert.ErrorIs(t, f.Check(blob(10, 0)), errReserved)
+}
+
+func TestAdmissionFilterDisabled(t *testing.T) {
+	f, err := NewAdmissionFilter(config.TestMempoolConfig())
+	require.NoError(t, err)
+	assert.NoError(t, f.Check(make([]byte, 1<<20)))
+}
@@ -50,11 +50,14 @@ type TxPool struct {
 	config       *config.MempoolConfig
 	proxyAppConn proxy.AppConnMempool
 	metrics      *mempool.Metrics
+	events       types.MempoolEventPublisher
+	reapQuota    *mempool.ReapQuota // nil if reaped classes are not limited
 
 	// these values are modified once per height
 	mtx                  sync.Mutex
 	notifiedTxsAvailable bool
 	txsAvailable         chan struct{} // one value sent per height when mempool is not empty
+	admissionFn          mempool.PreCheckFunc
 	preCheckFn           mempool.PreCheckFunc
 	postCheckFn          mempool.PostCheckFunc
 	height               int64     // the latest height passed to Update
@@ -62,10 +65,17 @@ type TxPool struct {
 
 	// Thread-safe cache of rejected transactions for quick look-up
 	rejectedTxCache *LRUTxCache
-	// Thread-safe cache of evicted transactions for quick look-up
-	evictedTxCache *LRUTxCache
+	// Thread-safe history of evicted transactions and why they were evicted
+	evictedTxs *mempool.EvictedTxs
+	// called with each evicted transaction
+	evictionHook *mempool.EvictionHook
 	// Thread-safe list of transactions peers have seen that we have not yet seen
 	seenByPeersSet *SeenTxSet
+	// Thread-safe tracker of admitted and committed priorities
+	feeMarket *mempool.FeeMarket
+	// Thread-safe record of recently committed transactions, which may have
+	// fallen out of the rejectedTxCache
+	committed *mempool.CommittedTxs
 
 	// Store of wrapped transactions
 	store *store
@@ -91,9 +101,12 @@ func NewTxPool(
 		config:           cfg,
 		proxyAppConn:     proxyAppConn,
 		metrics:          mempool.NopMetrics(),
+		events:           types.NopEventBus{},
 		rejectedTxCache:  NewLRUTxCache(cfg.CacheSize),
-		evictedTxCache:   NewLRUTxCache(cfg.CacheSize / 5),
+		evictedTxs:       mempool.NewEvictedTxs(cfg.CacheSize / 5),
 		seenByPeersSet:   NewSeenTxSet(),
+		feeMarket:        mempool.NewFeeMarket(cfg.FeeMarketWindow),
+		committed:        mempool.NewCommittedTxs(cfg.CommittedTxsWindow),
 		height:           height,
 		preCheckFn:       func(_ types.Tx) error { return nil },
 		postCheckFn:      func(_ types.Tx, _ *abci.ResponseCheckTx) error { return nil },
@@ -116,6 +129,13 @@ func WithPreCheck(f mempool.PreCheckFunc) TxPoolOption {
 	return func(txmp *TxPool) { txmp.preCheckFn = f }
 }
 
+// WithAdmissionFilter sets a filter for the mempool to reject a transaction if
+// f(tx) returns an error. This is executed before the pre-check and CheckTx.
+// Unlike WithPreCheck, it is not overwritten by Update().
+func WithAdmissionFilter(f mempool.PreCheckFunc) TxPoolOption {
+	return func(txmp *TxPool) { txmp.admissionFn = f }
+}
+
 // WithPostCheck sets a filter for the mempool to reject a transaction if
 // f(tx, resp) returns an error. This is executed after CheckTx. It only applies
 // to the first created block. After that, Update overwrites the existing value.
@@ -128,6 +148,24 @@ func WithMetrics(metrics *mempool.Metrics) TxPoolOption {
 	return func(txmp *TxPool) { txmp.metrics = metrics }
 }
 
+// WithEventPublisher sets the publisher notified of every transaction admitted
+// to the mempool.
+func WithEventPublisher(p types.MempoolEventPublisher) TxPoolOption {
+	return func(txmp *TxPool) { txmp.events = p }
+}
+
+// WithReapQuota sets the quota limiting the share of each class of
+// transactions in ReapMaxBytesMaxGas.
+func WithReapQuota(q *mempool.ReapQuota) TxPoolOption {
+	return func(txmp *TxPool) { txmp.reapQuota = q }
+}
+
+// WithEvictionHook sets the hook notified of every transaction evicted from
+// the mempool, when it is full or the transaction expires.
+func WithEvictionHook(h *mempool.EvictionHook) TxPoolOption {
+	return func(txmp *TxPool) { txmp.evictionHook = h }
+}
+
 // Lock locks the mempool, no new transactions can be processed
 func (txmp *TxPool) Lock() {
 	txmp.mtx.Lock()
@@ -146,6 +184,11 @@ func (txmp *TxPool) Size() int { return txmp.store.size() }
 // mempool. It is thread-safe.
 func (txmp *TxPool) SizeBytes() int64 { return txmp.store.totalBytes() }
 
+// MemoryBytes returns the estimated memory used by the valid transactions in
+// the mempool, including their metadata, indexes and cache entries. It is
+// thread-safe.
+func (txmp *TxPool) MemoryBytes() int64 { return txmp.store.totalMemory() }
+
 // FlushAppConn executes FlushSync on the mempool's proxyAppConn.
 //
 // The caller must hold an exclusive mempool lock (by calling txmp.Lock) before
@@ -195,7 +238,29 @@ func (txmp *TxPool) GetTxByKey(txKey types.TxKey) (types.Tx, bool) {
 // WasRecentlyEvicted returns a bool indicating whether the transaction with
 // the specified key was recently evicted and is currently within the cache.
 func (txmp *TxPool) WasRecentlyEvicted(txKey types.TxKey) bool {
-	return txmp.evictedTxCache.Has(txKey)
+	return txmp.evictedTxs.Has(txKey)
+}
+
+// PendingTxInfo returns the priority of the transaction with the specified
+// key and the number of transactions which would be reaped before it.
+func (txmp *TxPool) PendingTxInfo(txKey types.TxKey) (int64, int, bool) {
+	wtx, position := txmp.store.getTxPosition(txKey)
+	if wtx == nil {
+		return 0, 0, false
+	}
+	return wtx.priority, position, true
+}
+
+// EvictionReason returns why the transaction with the specified key was
+// recently evicted.
+func (txmp *TxPool) EvictionReason(txKey types.TxKey) (string, bool) {
+	return txmp.evictedTxs.Reason(txKey)
+}
+
+// FeeMarketStats returns the priorities of the transactions admitted to and
+// committed from the mempool over the configured fee market window.
+func (txmp *TxPool) FeeMarketStats() mempool.FeeMarketStats {
+	return txmp.feeMarket.Stats()
 }
 
 // IsRejectedTx returns true if the transaction was recently rejected and is
@@ -217,7 +282,8 @@ func (txmp *TxPool) CheckToPurgeExpiredTxs() {
 		purgedTxs, numExpired := txmp.store.purgeExpiredTxs(0, expirationAge)
 		// Add the purged transactions to the evicted cache
 		for _, tx := range purgedTxs {
-			txmp.evictedTxCache.Push(tx.key)
+			txmp.evictedTxs.Push(tx.key, mempool.EvictionReasonExpired)
+			txmp.evictionHook.Evicted(tx.tx, mempool.EvictionReasonExpired)
 		}
 		txmp.metrics.EvictedTxs.Add(float64(numExpired))
 		txmp.lastPurgeTime = time.Now()
@@ -310,6 +376,16 @@ func (txmp *TxPool) TryAddNewTx(tx types.Tx, key types.TxKey, txInfo mempool.TxI
 		return nil, ErrTxAlreadyRejected
 	}
 
+	if height, ok := txmp.committed.Height(key); ok {
+		// The transaction was committed but fell out of the rejectedTxCache:
+		// put it back so that it is rejected early from now on.
+		txmp.rejectedTxCache.Push(key)
+		txmp.metrics.StaleTxs.Add(1)
+		txmp.logger.Debug("rejected committed transaction", "txKey", fmt.Sprintf("%X", key),
+			"committedHeight", height, "peerID", txInfo.SenderP2PID)
+		return nil, mempool.ErrTxCommitted
+	}
+
 	if txmp.Has(key) {
 		txmp.metrics.AlreadySeenTxs.Add(1)
 		// The peer has sent us a transaction that we have already seen
@@ -335,6 +411,21 @@ func (txmp *TxPool) TryAddNewTx(tx types.Tx, key types.TxKey, txInfo mempool.TxI
 		return nil, err
 	}
 
+	rsp, err := txmp.checkAndAddTx(tx, key)
+	if err != nil {
+		return rsp, err
+	}
+	// Published once the lock is released, so that a slow subscriber doesn't
+	// hold up the mempool.
+	if err := txmp.events.PublishEventNewMempoolTx(types.NewEventDataNewMempoolTx(tx, rsp)); err != nil {
+		txmp.logger.Error("failed publishing new mempool tx event", "err", err)
+	}
+	return rsp, nil
+}
+
+// checkAndAddTx checks tx with the application and adds it to the mempool if
+// it is valid, holding the mempool lock.
+func (txmp *TxPool) checkAndAddTx(tx types.Tx, key types.TxKey) (*abci.ResponseCheckTx, error) {
 	txmp.mtx.Lock()
 	defer txmp.mtx.Unlock()
 
@@ -368,7 +459,7 @@ func (txmp *TxPool) TryAddNewTx(tx types.Tx, key types.TxKey, txInfo mempool.TxI
 
 	// Now we consider the transaction to be valid. Once a transaction is valid, it
 	// can only become invalid if recheckTx is enabled and RecheckTx returns a non zero code
-	if err := txmp.addNewTransaction(wtx, rsp); err != nil {
+	if err := txmp.addNewTransaction(wtx); err != nil {
 		return nil, err
 	}
 	return rsp, nil
@@ -397,7 +488,8 @@ func (txmp *TxPool) Flush() {
 	txmp.store.reset()
 	txmp.seenByPeersSet.Reset()
 	txmp.rejectedTxCache.Reset()
-	txmp.evictedTxCache.Reset()
+	txmp.evictedTxs.Reset()
+	txmp.committed.Reset()
 	txmp.metrics.EvictedTxs.Add(float64(size))
 	txmp.broadcastMtx.Lock()
 	defer txmp.broadcastMtx.Unlock()
@@ -422,6 +514,7 @@ func (txmp *TxPool) PeerHasTx(peer uint16, txKey types.TxKey) {
 // constraints, the result will also be empty.
 func (txmp *TxPool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	var totalGas, totalBytes int64
+	reaper := txmp.reapQuota.Reaper(maxBytes)
 
 	var keep []types.Tx
 	txmp.store.iterateOrderedTxs(func(w *wrappedTx) bool {
@@ -432,6 +525,10 @@ func (txmp *TxPool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 		if (maxGas >= 0 && totalGas+w.gasWanted > maxGas) || (maxBytes >= 0 && totalBytes+txBytes > maxBytes) {
 			return true
 		}
+		// Skip txs whose class already took its share of maxBytes.
+		if !reaper.Add(w.tx, []byte(w.sender), txBytes) {
+			return true
+		}
 		totalBytes += txBytes
 		totalGas += w.gasWanted
 		keep = append(keep, w.tx)
@@ -499,9 +596,15 @@ func (txmp *TxPool) Update(
 
 	txmp.metrics.SuccessfulTxs.Add(float64(len(blockTxs)))
 	for _, tx := range blockTxs {
+		if wtx := txmp.store.get(tx.Key()); wtx != nil {
+			txmp.feeMarket.RecordIncluded(wtx.priority)
+		}
 		// Regardless of success, remove the transaction from the mempool.
 		txmp.removeTxByKey(tx.Key())
 	}
+	txmp.feeMarket.Commit()
+	txmp.feeMarket.ObserveMetrics(txmp.metrics)
+	txmp.committed.Commit(blockHeight, blockTxs, deliverTxResponses)
 
 	txmp.purgeExpiredTxs(blockHeight)
 
@@ -511,6 +614,7 @@ func (txmp *TxPool) Update(
 	size := txmp.Size()
 	txmp.metrics.Size.Set(float64(size))
 	txmp.metrics.SizeBytes.Set(float64(txmp.SizeBytes()))
+	txmp.metrics.MemoryBytes.Set(float64(txmp.MemoryBytes()))
 	if size > 0 {
 		if txmp.config.Recheck {
 			txmp.recheckTransactions()
@@ -534,21 +638,27 @@ func (txmp *TxPool) Update(
 // transactions are evicted.
 //
 // Finally, the new transaction is added and size stats updated.
-func (txmp *TxPool) addNewTransaction(wtx *wrappedTx, checkTxRes *abci.ResponseCheckTx) error {
+func (txmp *TxPool) addNewTransaction(wtx *wrappedTx) error {
 	// At this point the application has ruled the transaction valid, but the
 	// mempool might be full. If so, find the lowest-priority items with lower
 	// priority than the application assigned to this new one, and evict as many
 	// of them as necessary to make room for tx. If no such items exist, we
 	// discard tx.
-	if !txmp.canAddTx(wtx.size()) {
+	if !txmp.canAddTx(wtx) {
 		victims, victimBytes := txmp.store.getTxsBelowPriority(wtx.priority)
+		var victimMemory int64
+		for _, tx := range victims {
+			victimMemory += tx.memory()
+		}
 
 		// If there are no suitable eviction candidates, or the total size of
 		// those candidates is not enough to make room for the new transaction,
 		// drop the new one.
-		if len(victims) == 0 || victimBytes < wtx.size() {
+		if len(victims) == 0 || victimBytes < wtx.size() || victimMemory < -txmp.availableMemory(wtx) {
 			txmp.metrics.EvictedTxs.Add(1)
-			txmp.evictedTxCache.Push(wtx.key)
+			txmp.feeMarket.RecordEvicted(1)
+			txmp.evictedTxs.Push(wtx.key, mempool.EvictionReasonFull)
+			txmp.evictionHook.Evicted(wtx.tx, mempool.EvictionReasonFull)
 			return fmt.Errorf("rejected valid incoming transaction; mempool is full (%X). Size: (%d:%d)",
 				wtx.key.String(), txmp.Size(), txmp.SizeBytes())
 		}
@@ -571,23 +681,27 @@ func (txmp *TxPool) addNewTransaction(wtx *wrappedTx, checkTxRes *abci.ResponseC
 
 		// Evict as many of the victims as necessary to make room.
 		availableBytes := txmp.availableBytes()
+		availableMemory := txmp.availableMemory(wtx)
 		for _, tx := range victims {
 			txmp.evictTx(tx)
 
 			// We may not need to evict all the eligible transactions.  Bail out
 			// early if we have made enough room.
 			availableBytes += tx.size()
-			if availableBytes >= wtx.size() {
+			availableMemory += tx.memory()
+			if availableBytes >= wtx.size() && availableMemory >= 0 {
 				break
 			}
 		}
 	}
 
 	txmp.store.set(wtx)
+	txmp.feeMarket.RecordAdmitted(wtx.priority)
 
 	txmp.metrics.TxSizeBytes.Observe(float64(wtx.size()))
 	txmp.metrics.Size.Set(float64(txmp.Size()))
 	txmp.metrics.SizeBytes.Set(float64(txmp.SizeBytes()))
+	txmp.metrics.MemoryBytes.Set(float64(txmp.MemoryBytes()))
 	txmp.logger.Debug(
 		"inserted new valid transaction",
 		"priority", wtx.priority,
@@ -601,8 +715,10 @@ func (txmp *TxPool) addNewTransaction(wtx *wrappedTx, checkTxRes *abci.ResponseC
 
 func (txmp *TxPool) evictTx(wtx *wrappedTx) {
 	txmp.store.remove(wtx.key)
-	txmp.evictedTxCache.Push(wtx.key)
+	txmp.evictedTxs.Push(wtx.key, mempool.EvictionReasonPriority)
+	txmp.evictionHook.Evicted(wtx.tx, mempool.EvictionReasonPriority)
 	txmp.metrics.EvictedTxs.Add(1)
+	txmp.feeMarket.RecordEvicted(1)
 	txmp.logger.Debug(
 		"evicted valid existing transaction; mempool full",
 		"old_tx", fmt.Sprintf("%X", wtx.key),
@@ -642,6 +758,7 @@ func (txmp *TxPool) handleRecheckResult(wtx *wrappedTx, checkTxRes *abci.Respons
 	txmp.metrics.FailedTxs.Add(1)
 	txmp.metrics.Size.Set(float64(txmp.Size()))
 	txmp.metrics.SizeBytes.Set(float64(txmp.SizeBytes()))
+	txmp.metrics.MemoryBytes.Set(float64(txmp.MemoryBytes()))
 }
 
 // recheckTransactions initiates re-CheckTx ABCI calls for all the transactions
@@ -687,14 +804,24 @@ func (txmp *TxPool) availableBytes() int64 {
 	return txmp.config.MaxTxsBytes - txmp.SizeBytes()
 }
 
+// availableMemory returns the memory left in the mempool once wtx is added,
+// which is negative if wtx does not fit in the memory cap. It returns zero if
+// the memory is not capped.
+func (txmp *TxPool) availableMemory(wtx *wrappedTx) int64 {
+	if txmp.config.MaxMemoryBytes == 0 {
+		return 0
+	}
+	return txmp.config.MaxMemoryBytes - txmp.MemoryBytes() - wtx.memory()
+}
+
 // canAddTx returns an error if we cannot insert the provided *wrappedTx into
 // the mempool due to mempool configured constraints. Otherwise, nil is
 // returned and the transaction can be inserted into the mempool.
-func (txmp *TxPool) canAddTx(size int64) bool {
+func (txmp *TxPool) canAddTx(wtx *wrappedTx) bool {
 	numTxs := txmp.Size()
 	txBytes := txmp.SizeBytes()
 
-	if numTxs > txmp.config.Size || size+txBytes > txmp.config.MaxTxsBytes {
+	if numTxs > txmp.config.Size || wtx.size()+txBytes > txmp.config.MaxTxsBytes || txmp.availableMemory(wtx) < 0 {
 		return false
 	}
 
@@ -723,7 +850,8 @@ func (txmp *TxPool) purgeExpiredTxs(blockHeight int64) {
 	purgedTxs, numExpired := txmp.store.purgeExpiredTxs(expirationHeight, expirationAge)
 	// Add the purged transactions to the evicted cache
 	for _, tx := range purgedTxs {
-		txmp.evictedTxCache.Push(tx.key)
+		txmp.evictedTxs.Push(tx.key, mempool.EvictionReasonExpired)
+		txmp.evictionHook.Evicted(tx.tx, mempool.EvictionReasonExpired)
 	}
 	txmp.metrics.ExpiredTxs.Add(float64(numExpired))
 
@@ -732,6 +860,10 @@ func (txmp *TxPool) purgeExpiredTxs(blockHeight int64) {
 		// ensure that seenByPeersSet are eventually pruned
 		expirationAge = now.Add(-time.Hour)
 	}
+	if window := txmp.config.GossipDedupWindow; window > 0 && now.Add(-window).After(expirationAge) {
+		// peers are only remembered to have seen a tx for the dedup window
+		expirationAge = now.Add(-window)
+	}
 	txmp.seenByPeersSet.Prune(expirationAge)
 }
 
@@ -752,6 +884,13 @@ func (txmp *TxPool) notifyTxsAvailable() {
 }
 
 func (txmp *TxPool) preCheck(tx types.Tx) error {
+	// the admission filter is never replaced, so it doesn't need the lock
+	if txmp.admissionFn != nil {
+		if err := txmp.admissionFn(tx); err != nil {
+			return err
+		}
+	}
+
 	txmp.mtx.Lock()
 	defer txmp.mtx.Unlock()
 	if txmp.preCheckFn != nil {
@@ -245,6 +245,8 @@ func TestTxPool_Eviction(t *testing.T) {
 	require.True(t, txExists("key1=0000=25"))
 	require.False(t, txExists(bigTx))
 	require.True(t, txmp.WasRecentlyEvicted(types.Tx(bigTx).Key()))
+	reason, _ := txmp.EvictionReason(types.Tx(bigTx).Key())
+	require.Equal(t, mempool.EvictionReasonPriority, reason)
 	require.Equal(t, int64(len("key1=0000=25")), txmp.SizeBytes())
 
 	// Now fill up the rest of the slots with other transactions.
@@ -253,12 +255,20 @@ func TestTxPool_Eviction(t *testing.T) {
 	mustCheckTx(t, txmp, "key4=0003=3")
 	mustCheckTx(t, txmp, "key5=0004=3")
 
+	// key3 is reaped after key1 only.
+	priority, position, ok := txmp.PendingTxInfo(types.Tx("key3=0002=10").Key())
+	require.True(t, ok)
+	require.Equal(t, int64(10), priority)
+	require.Equal(t, 1, position)
+
 	// A new transaction with low priority should be discarded.
 	err = txmp.CheckTx(types.Tx("key6=0005=1"), nil, mempool.TxInfo{})
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "mempool is full")
 	require.False(t, txExists("key6=0005=1"))
 	require.True(t, txmp.WasRecentlyEvicted(types.Tx("key6=0005=1").Key()))
+	reason, _ = txmp.EvictionReason(types.Tx("key6=0005=1").Key())
+	require.Equal(t, mempool.EvictionReasonFull, reason)
 
 	// A new transaction with higher priority should evict key5, which is the
 	// newest of the two transactions with lowest priority.
@@ -307,6 +317,60 @@ func TestTxPool_Eviction(t *testing.T) {
 	require.False(t, txmp.WasRecentlyEvicted(types.Tx("key8=0007=20").Key()))
 }
 
+func TestTxPool_EvictionHook(t *testing.T) {
+	var evicted []string
+	hook := &mempool.EvictionHook{}
+	hook.SetCallback(func(tx types.Tx, reason string) {
+		evicted = append(evicted, string(tx)+" "+reason)
+	})
+	txmp := setup(t, 1000, WithEvictionHook(hook))
+	txmp.config.MaxTxsBytes = int64(len("key2=0001=10"))
+	txmp.config.TTLNumBlocks = 1
+
+	mustCheckTx(t, txmp, "key1=0000=05")
+	mustCheckTx(t, txmp, "key2=0001=10")
+	require.Error(t, txmp.CheckTx(types.Tx("key3=0002=01"), nil, mempool.TxInfo{}))
+
+	txmp.Lock()
+	require.NoError(t, txmp.Update(3, nil, nil, nil, nil))
+	txmp.Unlock()
+
+	require.Equal(t, []string{
+		"key1=0000=05 " + mempool.EvictionReasonPriority,
+		"key3=0002=01 " + mempool.EvictionReasonFull,
+		"key2=0001=10 " + mempool.EvictionReasonExpired,
+	}, evicted)
+}
+
+func TestTxPool_MemoryCap(t *testing.T) {
+	txmp := setup(t, 1000)
+	// All the transactions have the same size and sender size.
+	txMemory := (&wrappedTx{tx: types.Tx("key1=0000=05"), sender: "key1"}).memory()
+	require.Greater(t, txMemory, int64(len("key1=0000=05")))
+	txmp.config.MaxMemoryBytes = 2*txMemory + 1
+
+	mustCheckTx(t, txmp, "key1=0000=05")
+	mustCheckTx(t, txmp, "key2=0001=10")
+	require.Equal(t, 2, txmp.Size())
+	require.Equal(t, 2*txMemory, txmp.MemoryBytes())
+
+	// A new transaction with lower priority does not fit in the memory cap.
+	err := txmp.CheckTx(types.Tx("key3=0002=01"), nil, mempool.TxInfo{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "mempool is full")
+	require.Equal(t, 2, txmp.Size())
+
+	// A new transaction with higher priority evicts the lowest-priority one.
+	mustCheckTx(t, txmp, "key4=0003=20")
+	require.Equal(t, 2, txmp.Size())
+	reason, _ := txmp.EvictionReason(types.Tx("key1=0000=05").Key())
+	require.Equal(t, mempool.EvictionReasonPriority, reason)
+	require.Equal(t, 2*txMemory, txmp.MemoryBytes())
+
+	txmp.Flush()
+	require.Zero(t, txmp.MemoryBytes())
+}
+
 func TestTxPool_Flush(t *testing.T) {
 	txmp := setup(t, 0)
 	txs := checkTxs(t, txmp, 100, 0)
@@ -1,6 +1,7 @@
 package cat
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
 	"time"
@@ -54,6 +55,16 @@ type ReactorOptions struct {
 	// arrive before issuing a new request to a different peer
 	MaxGossipDelay time.Duration
 
+	// GossipFanout is the number of peers a new transaction is sent to in full.
+	// The other peers are sent a SeenTx message and request the transaction if
+	// they need it. 0 sends it to all peers.
+	GossipFanout int
+
+	// GossipDelayJitter is the upper bound of the random delay before a SeenTx
+	// message is broadcast, staggering the broadcasts of the nodes in the network.
+	// No delay if 0.
+	GossipDelayJitter time.Duration
+
 	// TraceClient is the trace client for collecting trace level events
 	TraceClient trace.Tracer
 }
@@ -75,6 +86,14 @@ func (opts *ReactorOptions) VerifyAndComplete() error {
 		return fmt.Errorf("max gossip delay (%d) cannot be negative", opts.MaxGossipDelay)
 	}
 
+	if opts.GossipFanout < 0 {
+		return fmt.Errorf("gossip fanout (%d) cannot be negative", opts.GossipFanout)
+	}
+
+	if opts.GossipDelayJitter < 0 {
+		return fmt.Errorf("gossip delay jitter (%d) cannot be negative", opts.GossipDelayJitter)
+	}
+
 	return nil
 }
 
@@ -167,6 +186,7 @@ func (memR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			Priority:            6,
 			RecvMessageCapacity: txMsg.Size(),
 			MessageType:         &protomem.Message{},
+			Compression:         p2p.CompressionZstd,
 		},
 		{
 			ID:                  MempoolStateChannel,
@@ -234,10 +254,18 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 				memR.Logger.Debug("received new trasaction", "peerID", peerID, "txKey", key)
 			}
 			_, err = memR.mempool.TryAddNewTx(ntx, key, txInfo)
+			if errors.Is(err, mempool.ErrTxCommitted) {
+				// using debug level to avoid flooding when traffic is high
+				memR.Logger.Debug("Peer sent committed tx", "txKey", key, "peer", e.Src)
+				return
+			}
 			if err != nil && err != ErrTxInMempool {
 				memR.Logger.Info("Could not add tx", "txKey", key, "err", err)
 				return
 			}
+			if err == nil {
+				memR.Switch.PeerScorer().RecordUsefulTx(e.Src.ID())
+			}
 			if !memR.opts.ListenOnly {
 				// We broadcast only transactions that we deem valid and actually have in our mempool.
 				memR.broadcastSeenTx(key)
@@ -255,6 +283,7 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 		txKey, err := types.TxKeyFromBytes(msg.TxKey)
 		if err != nil {
 			memR.Logger.Error("peer sent SeenTx with incorrect tx key", "err", err)
+			memR.Switch.PeerScorer().RecordInvalidMessage(e.Src.ID(), err)
 			memR.Switch.StopPeerForError(e.Src, err)
 			return
 		}
@@ -289,6 +318,7 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 		txKey, err := types.TxKeyFromBytes(msg.TxKey)
 		if err != nil {
 			memR.Logger.Error("peer sent WantTx with incorrect tx key", "err", err)
+			memR.Switch.PeerScorer().RecordInvalidMessage(e.Src.ID(), err)
 			memR.Switch.StopPeerForError(e.Src, err)
 			return
 		}
@@ -318,6 +348,8 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 			}
 		}
 
+	// Mempool summaries are only used by the priority mempool.
+	case *protomem.Summary:
 	default:
 		memR.Logger.Error("unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", fmt.Sprintf("%T", msg))
 		memR.Switch.StopPeerForError(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", msg))
@@ -326,9 +358,7 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 }
 
 // PeerState describes the state of a peer.
-type PeerState interface {
-	GetHeight() int64
-}
+type PeerState = types.PeerState
 
 // broadcastSeenTx broadcasts a SeenTx message to all peers unless we
 // know they have already seen the transaction
@@ -344,10 +374,12 @@ func (memR *Reactor) broadcastSeenTx(txKey types.TxKey) {
 
 	// Add jitter to when the node broadcasts it's seen txs to stagger when nodes
 	// in the network broadcast their seenTx messages.
-	time.Sleep(time.Duration(rand.Intn(10)*10) * time.Millisecond) //nolint:gosec
+	if jitter := memR.opts.GossipDelayJitter; jitter > 0 {
+		time.Sleep(time.Duration(rand.Int63n(int64(jitter)))) //nolint:gosec
+	}
 
 	for id, peer := range memR.ids.GetAll() {
-		if p, ok := peer.Get(types.PeerStateKey).(PeerState); ok {
+		if p, ok := types.PeerStateKey.Get(peer.Data()); ok {
 			// make sure peer isn't too far behind. This can happen
 			// if the peer is blocksyncing still and catching up
 			// in which case we just skip sending the transaction
@@ -372,6 +404,8 @@ func (memR *Reactor) broadcastSeenTx(txKey types.TxKey) {
 }
 
 // broadcastNewTx broadcast new transaction to all peers unless we are already sure they have seen the tx.
+// If GossipFanout is set, only that many peers, drawn at random, are sent the
+// transaction; the others are sent a SeenTx message and request it if needed.
 func (memR *Reactor) broadcastNewTx(wtx *wrappedTx) {
 	msg := &protomem.Message{
 		Sum: &protomem.Message_Txs{
@@ -380,9 +414,25 @@ func (memR *Reactor) broadcastNewTx(wtx *wrappedTx) {
 			},
 		},
 	}
+	seenMsg := &protomem.Message{
+		Sum: &protomem.Message_SeenTx{
+			SeenTx: &protomem.SeenTx{
+				TxKey: wtx.key[:],
+			},
+		},
+	}
 
-	for id, peer := range memR.ids.GetAll() {
-		if p, ok := peer.Get(types.PeerStateKey).(PeerState); ok {
+	peers := memR.ids.GetAll()
+	ids := make([]uint16, 0, len(peers))
+	for id := range peers {
+		ids = append(ids, id)
+	}
+	rand.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+
+	sent := 0
+	for _, id := range ids {
+		peer := peers[id]
+		if p, ok := types.PeerStateKey.Get(peer.Data()); ok {
 			// make sure peer isn't too far behind. This can happen
 			// if the peer is blocksyncing still and catching up
 			// in which case we just skip sending the transaction
@@ -396,6 +446,16 @@ func (memR *Reactor) broadcastNewTx(wtx *wrappedTx) {
 			continue
 		}
 
+		if memR.opts.GossipFanout > 0 && sent >= memR.opts.GossipFanout {
+			peer.Send(
+				p2p.Envelope{
+					ChannelID: MempoolStateChannel,
+					Message:   seenMsg,
+				},
+			)
+			continue
+		}
+
 		if peer.Send(
 			p2p.Envelope{
 				ChannelID: mempool.MempoolChannel,
@@ -403,6 +463,7 @@ func (memR *Reactor) broadcastNewTx(wtx *wrappedTx) {
 			},
 		) {
 			memR.mempool.PeerHasTx(id, wtx.key)
+			sent++
 		}
 	}
 }
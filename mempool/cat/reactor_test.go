@@ -24,6 +24,7 @@ import (
 	"github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/mocks"
+	"github.com/cometbft/cometbft/p2p/peerdata"
 	protomem "github.com/cometbft/cometbft/proto/tendermint/mempool"
 	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/types"
@@ -163,6 +164,53 @@ func TestReactorBroadcastsSeenTxAfterReceivingTx(t *testing.T) {
 	peers[1].AssertExpectations(t)
 }
 
+func TestReactorBroadcastNewTxFanout(t *testing.T) {
+	reactor, _ := setupReactor(t)
+	reactor.opts.GossipFanout = 1
+
+	tx := newDefaultTx("hello")
+	key := tx.Key()
+	txEnv := p2p.Envelope{
+		ChannelID: mempool.MempoolChannel,
+		Message: &protomem.Message{
+			Sum: &protomem.Message_Txs{Txs: &protomem.Txs{Txs: [][]byte{tx}}},
+		},
+	}
+	seenEnv := p2p.Envelope{
+		ChannelID: MempoolStateChannel,
+		Message: &protomem.Message{
+			Sum: &protomem.Message_SeenTx{SeenTx: &protomem.SeenTx{TxKey: key[:]}},
+		},
+	}
+
+	peers := genPeers(3)
+	for _, peer := range peers {
+		peer.On("Send", txEnv).Return(true).Maybe()
+		peer.On("Send", seenEnv).Return(true).Maybe()
+		reactor.InitPeer(peer)
+	}
+
+	reactor.broadcastNewTx(newWrappedTx(tx, key, 1, 1, 1, ""))
+
+	// only one peer is sent the tx, the others are told we have it
+	var sentTx, sentSeen int
+	for _, peer := range peers {
+		for _, call := range peer.Calls {
+			if call.Method != "Send" {
+				continue
+			}
+			switch call.Arguments.Get(0).(p2p.Envelope).ChannelID {
+			case mempool.MempoolChannel:
+				sentTx++
+			case MempoolStateChannel:
+				sentSeen++
+			}
+		}
+	}
+	require.Equal(t, 1, sentTx)
+	require.Equal(t, 2, sentSeen)
+}
+
 func TestRemovePeerRequestFromOtherPeer(t *testing.T) {
 	reactor, _ := setupReactor(t)
 
@@ -333,7 +381,7 @@ func makeAndConnectReactors(t *testing.T, config *cfg.Config, n int) []*Reactor
 
 	for _, r := range reactors {
 		for _, peer := range r.Switch.Peers().List() {
-			peer.Set(types.PeerStateKey, peerState{1})
+			types.PeerStateKey.Set(peer.Data(), peerState{1})
 		}
 	}
 	return reactors
@@ -424,6 +472,6 @@ func genPeer() *mocks.Peer {
 	peer := &mocks.Peer{}
 	nodeKey := p2p.NodeKey{PrivKey: ed25519.GenPrivKey()}
 	peer.On("ID").Return(nodeKey.ID())
-	peer.On("Get", types.PeerStateKey).Return(nil).Maybe()
+	peer.On("Data").Return(peerdata.NewStore()).Maybe()
 	return peer
 }
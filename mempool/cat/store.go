@@ -13,6 +13,7 @@ import (
 type store struct {
 	mtx         sync.RWMutex
 	bytes       int64
+	memory      int64
 	orderedTxs  []*wrappedTx
 	txs         map[types.TxKey]*wrappedTx
 	reservedTxs map[types.TxKey]struct{}
@@ -37,6 +38,7 @@ func (s *store) set(wtx *wrappedTx) bool {
 		s.txs[wtx.key] = wtx
 		s.orderTx(wtx)
 		s.bytes += wtx.size()
+		s.memory += wtx.memory()
 		return true
 	}
 	return false
@@ -63,6 +65,7 @@ func (s *store) remove(txKey types.TxKey) bool {
 		return false
 	}
 	s.bytes -= tx.size()
+	s.memory -= tx.memory()
 	if err := s.deleteOrderedTx(tx); err != nil {
 		panic(err)
 	}
@@ -110,6 +113,12 @@ func (s *store) totalBytes() int64 {
 	return s.bytes
 }
 
+func (s *store) totalMemory() int64 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.memory
+}
+
 func (s *store) getAllKeys() []types.TxKey {
 	s.mtx.RLock()
 	defer s.mtx.RUnlock()
@@ -163,6 +172,7 @@ func (s *store) purgeExpiredTxs(expirationHeight int64, expirationAge time.Time)
 	for key, tx := range s.txs {
 		if tx.height < expirationHeight || tx.timestamp.Before(expirationAge) {
 			s.bytes -= tx.size()
+			s.memory -= tx.memory()
 			delete(s.txs, key)
 			purgedTxs = append(purgedTxs, tx)
 			counter++
@@ -175,6 +185,7 @@ func (s *store) reset() {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 	s.bytes = 0
+	s.memory = 0
 	s.txs = make(map[types.TxKey]*wrappedTx)
 	s.orderedTxs = make([]*wrappedTx, 0)
 }
@@ -205,6 +216,22 @@ func (s *store) getTxOrder(tx *wrappedTx) int {
 	})
 }
 
+// getTxPosition returns the transaction with the specified key and the number
+// of transactions ordered before it, or nil if it is not in the store.
+func (s *store) getTxPosition(txKey types.TxKey) (*wrappedTx, int) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	tx, ok := s.txs[txKey]
+	if !ok {
+		return nil, 0
+	}
+	idx := s.getTxOrder(tx) - 1
+	if idx < 0 || s.orderedTxs[idx] != tx {
+		return nil, 0
+	}
+	return tx, idx
+}
+
 func (s *store) iterateOrderedTxs(fn func(tx *wrappedTx) bool) {
 	s.mtx.RLock()
 	defer s.mtx.RUnlock()
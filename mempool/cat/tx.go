@@ -2,7 +2,9 @@ package cat
 
 import (
 	"time"
+	"unsafe"
 
+	"github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -34,3 +36,10 @@ func newWrappedTx(tx types.Tx, key types.TxKey, height, gasWanted, priority int6
 
 // Size reports the size of the raw transaction in bytes.
 func (w *wrappedTx) size() int64 { return int64(len(w.tx)) }
+
+// memory returns the estimated memory used by w in the store, indexed by key
+// and ordered by priority.
+func (w *wrappedTx) memory() int64 {
+	metadata := int64(unsafe.Sizeof(wrappedTx{})) + int64(len(w.sender)) + int64(unsafe.Sizeof(w))
+	return mempool.EntryMemory(len(w.tx), metadata, 1)
+}
@@ -27,6 +27,8 @@ import (
 type CListMempool struct {
 	height   atomic.Int64 // the last block Update()'d to
 	txsBytes atomic.Int64 // total size of mempool, in bytes
+	// estimated memory used by the txs of the mempool, in bytes
+	txsMemory atomic.Int64
 
 	// notify listeners (ie. consensus) when txs are available
 	notifiedTxsAvailable atomic.Bool
@@ -37,8 +39,10 @@ type CListMempool struct {
 	// Exclusive mutex for Update method to prevent concurrent execution of
 	// CheckTx or ReapMaxBytesMaxGas(ReapMaxTxs) methods.
 	updateMtx cmtsync.RWMutex
+	admission PreCheckFunc
 	preCheck  PreCheckFunc
 	postCheck PostCheckFunc
+	reapQuota *ReapQuota // nil if reaped classes are not limited
 
 	txs          *clist.CList // concurrent linked-list of good txs
 	proxyAppConn proxy.AppConnMempool
@@ -54,9 +58,19 @@ type CListMempool struct {
 	// This reduces the pressure on the proxyApp.
 	cache TxCache
 
+	// Txs committed recently, to detect the ones gossiped again.
+	committedTxs *CommittedTxs
+
 	logger  log.Logger
 	metrics *Metrics
 	trace   trace.Tracer
+	events  types.MempoolEventPublisher
+
+	// Events of the added txs waiting to be published, by a single routine at
+	// a time.
+	newTxEventsMtx        cmtsync.Mutex
+	newTxEvents           []types.EventDataNewMempoolTx
+	publishingNewTxEvents bool
 }
 
 var _ Mempool = &CListMempool{}
@@ -80,6 +94,8 @@ func NewCListMempool(
 		logger:       log.NewNopLogger(),
 		metrics:      NopMetrics(),
 		trace:        trace.NoOpTracer(),
+		events:       types.NopEventBus{},
+		committedTxs: NewCommittedTxs(cfg.CommittedTxsWindow),
 	}
 	mp.height.Store(height)
 
@@ -156,6 +172,13 @@ func WithPreCheck(f PreCheckFunc) CListMempoolOption {
 	return func(mem *CListMempool) { mem.preCheck = f }
 }
 
+// WithAdmissionFilter sets a filter for the mempool to reject a tx if f(tx)
+// returns an error. This is ran before the PreCheckFunc and CheckTx, and is
+// not overwritten by Update.
+func WithAdmissionFilter(f PreCheckFunc) CListMempoolOption {
+	return func(mem *CListMempool) { mem.admission = f }
+}
+
 // WithPostCheck sets a filter for the mempool to reject a tx if f(tx) returns
 // false. This is ran after CheckTx. Only applies to the first created block.
 // After that, Update overwrites the existing value.
@@ -168,6 +191,18 @@ func WithMetrics(metrics *Metrics) CListMempoolOption {
 	return func(mem *CListMempool) { mem.metrics = metrics }
 }
 
+// WithEventPublisher sets the publisher notified of every transaction admitted
+// to the mempool.
+func WithEventPublisher(p types.MempoolEventPublisher) CListMempoolOption {
+	return func(mem *CListMempool) { mem.events = p }
+}
+
+// WithReapQuota sets the quota limiting the share of each class of
+// transactions in ReapMaxBytesMaxGas.
+func WithReapQuota(q *ReapQuota) CListMempoolOption {
+	return func(mem *CListMempool) { mem.reapQuota = q }
+}
+
 func WithTraceClient(tc trace.Tracer) CListMempoolOption {
 	return func(txmp *CListMempool) {
 		txmp.trace = tc
@@ -197,6 +232,13 @@ func (mem *CListMempool) SizeBytes() int64 {
 	return mem.txsBytes.Load()
 }
 
+// MemoryBytes returns the estimated memory used by the transactions in the
+// mempool, including their metadata, indexes and cache entries.
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) MemoryBytes() int64 {
+	return mem.txsMemory.Load()
+}
+
 // Lock() must be help by the caller during execution.
 func (mem *CListMempool) FlushAppConn() error {
 	err := mem.proxyAppConn.Flush(context.TODO())
@@ -213,7 +255,9 @@ func (mem *CListMempool) Flush() {
 	defer mem.updateMtx.RUnlock()
 
 	mem.txsBytes.Store(0)
+	mem.txsMemory.Store(0)
 	mem.cache.Reset()
+	mem.committedTxs.Reset()
 
 	mem.removeAllTxs()
 }
@@ -255,7 +299,7 @@ func (mem *CListMempool) CheckTx(
 
 	txSize := len(tx)
 
-	if err := mem.isFull(txSize); err != nil {
+	if err := mem.isFull(txSize, txMemory(txSize, 0)); err != nil {
 		mem.metrics.RejectedTxs.Add(1)
 		return err
 	}
@@ -267,6 +311,12 @@ func (mem *CListMempool) CheckTx(
 		}
 	}
 
+	if mem.admission != nil {
+		if err := mem.admission(tx); err != nil {
+			return ErrPreCheck{Err: err}
+		}
+	}
+
 	if mem.preCheck != nil {
 		if err := mem.preCheck(tx); err != nil {
 			return ErrPreCheck{Err: err}
@@ -292,6 +342,11 @@ func (mem *CListMempool) CheckTx(
 		return ErrTxInCache
 	}
 
+	// The tx is back in the cache, but was committed after falling out of it.
+	if mem.isCommitted(tx.Key(), txInfo) {
+		return ErrTxCommitted
+	}
+
 	reqRes, err := mem.proxyAppConn.CheckTxAsync(context.TODO(), &abci.RequestCheckTx{Tx: tx})
 	if err != nil {
 		panic(fmt.Errorf("CheckTx request for tx %s failed: %w", log.NewLazySprintf("%v", tx.Hash()), err))
@@ -366,10 +421,51 @@ func (mem *CListMempool) reqResCb(
 		// update metrics
 		mem.metrics.Size.Set(float64(mem.Size()))
 		mem.metrics.SizeBytes.Set(float64(mem.SizeBytes()))
+		mem.metrics.MemoryBytes.Set(float64(mem.MemoryBytes()))
 
 		// passed in by the caller of CheckTx, eg. the RPC
 		if externalCb != nil {
-			externalCb(res.GetCheckTx())
+			checkTxRes := res.GetCheckTx()
+			if checkTxRes == nil {
+				checkTxRes = CheckTxFailedResponse(res)
+			}
+			externalCb(checkTxRes)
+		}
+	}
+}
+
+// queueNewTxEvent queues the event of a tx added to the mempool, to be
+// published by a routine of its own. The response callbacks run while CheckTx
+// holds the update lock with a local client, so publishing in place would let
+// a slow subscriber hold up the mempool.
+func (mem *CListMempool) queueNewTxEvent(event types.EventDataNewMempoolTx) {
+	mem.newTxEventsMtx.Lock()
+	defer mem.newTxEventsMtx.Unlock()
+	mem.newTxEvents = append(mem.newTxEvents, event)
+	if !mem.publishingNewTxEvents {
+		mem.publishingNewTxEvents = true
+		go mem.publishNewTxEvents()
+	}
+}
+
+// publishNewTxEvents publishes the queued events, in order, until the queue
+// is empty.
+func (mem *CListMempool) publishNewTxEvents() {
+	for {
+		mem.newTxEventsMtx.Lock()
+		events := mem.newTxEvents
+		mem.newTxEvents = nil
+		if len(events) == 0 {
+			mem.publishingNewTxEvents = false
+			mem.newTxEventsMtx.Unlock()
+			return
+		}
+		mem.newTxEventsMtx.Unlock()
+
+		for _, event := range events {
+			if err := mem.events.PublishEventNewMempoolTx(event); err != nil {
+				mem.logger.Error("failed publishing new mempool tx event", "err", err)
+			}
 		}
 	}
 }
@@ -380,6 +476,7 @@ func (mem *CListMempool) addTx(memTx *mempoolTx) {
 	e := mem.txs.PushBack(memTx)
 	mem.txsMap.Store(memTx.tx.Key(), e)
 	mem.txsBytes.Add(int64(len(memTx.tx)))
+	mem.txsMemory.Add(memTx.memory())
 	mem.metrics.TxSizeBytes.Observe(float64(len(memTx.tx)))
 }
 
@@ -395,6 +492,7 @@ func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
 		var tx types.Tx
 		if memtx, ok := elem.Value.(*mempoolTx); ok {
 			tx = memtx.tx
+			mem.txsMemory.Add(-memtx.memory())
 		}
 		mem.txsBytes.Add(int64(-len(tx)))
 		return nil
@@ -402,15 +500,20 @@ func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
 	return ErrTxNotFound
 }
 
-func (mem *CListMempool) isFull(txSize int) error {
+func (mem *CListMempool) isFull(txSize int, txMemory int64) error {
 	memSize := mem.Size()
 	txsBytes := mem.SizeBytes()
-	if memSize >= mem.config.Size || int64(txSize)+txsBytes > mem.config.MaxTxsBytes {
+	txsMemory := mem.MemoryBytes()
+	maxMemory := mem.config.MaxMemoryBytes
+	if memSize >= mem.config.Size || int64(txSize)+txsBytes > mem.config.MaxTxsBytes ||
+		(maxMemory > 0 && txMemory+txsMemory > maxMemory) {
 		return ErrMempoolIsFull{
-			NumTxs:      memSize,
-			MaxTxs:      mem.config.Size,
-			TxsBytes:    txsBytes,
-			MaxTxsBytes: mem.config.MaxTxsBytes,
+			NumTxs:         memSize,
+			MaxTxs:         mem.config.Size,
+			TxsBytes:       txsBytes,
+			MaxTxsBytes:    mem.config.MaxTxsBytes,
+			MemoryBytes:    txsMemory,
+			MaxMemoryBytes: maxMemory,
 		}
 	}
 
@@ -439,7 +542,7 @@ func (mem *CListMempool) resCbFirstTime(
 		if (r.CheckTx.Code == abci.CodeTypeOK) && postCheckErr == nil {
 			// Check mempool isn't full again to reduce the chance of exceeding the
 			// limits.
-			if err := mem.isFull(len(tx)); err != nil {
+			if err := mem.isFull(len(tx), txMemory(len(tx), len(r.CheckTx.Address))); err != nil {
 				// remove from cache (mempool might have a space later)
 				mem.cache.Remove(tx)
 				// use debug level to avoid spamming logs when traffic is high
@@ -448,6 +551,11 @@ func (mem *CListMempool) resCbFirstTime(
 				return
 			}
 
+			// Check transaction not committed while being checked
+			if mem.isCommitted(types.Tx(tx).Key(), txInfo) {
+				return
+			}
+
 			// Check transaction not already in the mempool
 			if e, ok := mem.txsMap.Load(types.Tx(tx).Key()); ok {
 				memTx := e.(*clist.CElement).Value.(*mempoolTx)
@@ -467,9 +575,12 @@ func (mem *CListMempool) resCbFirstTime(
 				height:    mem.height.Load(),
 				gasWanted: r.CheckTx.GasWanted,
 				tx:        tx,
+				sender:    r.CheckTx.Address,
+				timestamp: time.Now(),
 			}
 			memTx.addSender(txInfo.SenderID)
 			mem.addTx(memTx)
+			mem.queueNewTxEvent(types.NewEventDataNewMempoolTx(tx, r.CheckTx))
 			mem.logger.Debug(
 				"added good transaction",
 				"tx", types.Tx(tx).Hash(),
@@ -496,7 +607,9 @@ func (mem *CListMempool) resCbFirstTime(
 		}
 
 	default:
-		// ignore other messages
+		// the app didn't check the tx, e.g. because its client was stopped,
+		// so it can be submitted again
+		mem.cache.Remove(tx)
 	}
 }
 
@@ -561,29 +674,34 @@ func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	// size per tx, and set the initial capacity based off of that.
 	// txs := make([]types.Tx, 0, cmtmath.MinInt(mem.txs.Len(), max/mem.avgTxSize))
 	txs := make([]types.Tx, 0, mem.txs.Len())
+	reaper := mem.reapQuota.Reaper(maxBytes)
 	for e := mem.txs.Front(); e != nil; e = e.Next() {
 		memTx := e.Value.(*mempoolTx)
 
-		txs = append(txs, memTx.tx)
-
 		dataSize := types.ComputeProtoSizeForTxs([]types.Tx{memTx.tx})
 
 		// Check total size requirement
 		if maxBytes > -1 && runningSize+dataSize > maxBytes {
-			return txs[:len(txs)-1]
+			return txs
 		}
 
-		runningSize += dataSize
-
 		// Check total gas requirement.
 		// If maxGas is negative, skip this check.
 		// Since newTotalGas < masGas, which
 		// must be non-negative, it follows that this won't overflow.
 		newTotalGas := totalGas + memTx.gasWanted
 		if maxGas > -1 && newTotalGas > maxGas {
-			return txs[:len(txs)-1]
+			return txs
+		}
+
+		// Skip txs whose class already took its share of maxBytes.
+		if !reaper.Add(memTx.tx, memTx.sender, dataSize) {
+			continue
 		}
+
+		runningSize += dataSize
 		totalGas = newTotalGas
+		txs = append(txs, memTx.tx)
 	}
 	return txs
 }
@@ -653,6 +771,10 @@ func (mem *CListMempool) Update(
 		}
 	}
 
+	// The committed txs were removed above, and CheckTx rejects them from now
+	// on, so no committed tx is left in the mempool to purge.
+	mem.committedTxs.Commit(height, txs, txResults)
+
 	// Recheck txs left in the mempool to remove them if they became invalid in the new state.
 	if mem.config.Recheck {
 		mem.recheckTxs()
@@ -666,10 +788,28 @@ func (mem *CListMempool) Update(
 	// Update metrics
 	mem.metrics.Size.Set(float64(mem.Size()))
 	mem.metrics.SizeBytes.Set(float64(mem.SizeBytes()))
+	mem.metrics.MemoryBytes.Set(float64(mem.MemoryBytes()))
 
 	return nil
 }
 
+// isCommitted reports whether the tx with the given key, received from
+// txInfo.SenderP2PID, was committed within the committed txs window.
+func (mem *CListMempool) isCommitted(key types.TxKey, txInfo TxInfo) bool {
+	height, ok := mem.committedTxs.Height(key)
+	if !ok {
+		return false
+	}
+	mem.metrics.StaleTxs.Add(1)
+	// use debug level to avoid spamming logs when traffic is high
+	mem.logger.Debug("received committed transaction",
+		"tx", fmt.Sprintf("%X", key),
+		"committedHeight", height,
+		"peerID", txInfo.SenderP2PID,
+	)
+	return true
+}
+
 // recheckTxs sends all transactions in the mempool to the app for re-validation. When the function
 // returns, all recheck responses from the app have been processed.
 func (mem *CListMempool) recheckTxs() {
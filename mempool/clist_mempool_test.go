@@ -271,6 +271,34 @@ func TestMempoolUpdate(t *testing.T) {
 	}
 }
 
+func TestMempoolCommittedTxs(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	// a committed tx which fell out of the cache is rejected, and cached again
+	tx1 := kvstore.NewTxFromID(1)
+	require.NoError(t, mp.Update(1, []types.Tx{tx1}, abciResponses(1, abci.CodeTypeOK), nil, nil))
+	mp.cache.Remove(tx1)
+	assert.Equal(t, ErrTxCommitted, mp.CheckTx(tx1, nil, TxInfo{}))
+	assert.Equal(t, ErrTxInCache, mp.CheckTx(tx1, nil, TxInfo{}))
+
+	// a committed tx in the mempool is removed on Update
+	tx2 := kvstore.NewTxFromID(2)
+	require.NoError(t, mp.CheckTx(tx2, nil, TxInfo{}))
+	require.Equal(t, 1, mp.Size())
+	require.NoError(t, mp.Update(2, []types.Tx{tx2}, abciResponses(1, abci.CodeTypeOK), nil, nil))
+	assert.Zero(t, mp.Size())
+
+	// committed txs are forgotten after the window
+	for h := int64(3); h <= 1+mp.config.CommittedTxsWindow; h++ {
+		require.NoError(t, mp.Update(h, nil, nil, nil, nil))
+	}
+	mp.cache.Remove(tx1)
+	assert.NoError(t, mp.CheckTx(tx1, nil, TxInfo{}))
+}
+
 func TestMempoolUpdateDoesNotPanicWhenApplicationMissedTx(t *testing.T) {
 	var callback abciclient.Callback
 	mockClient := new(abciclimocks.Client)
@@ -324,6 +352,8 @@ func TestMempool_KeepInvalidTxsInCache(t *testing.T) {
 	cc := proxy.NewLocalClientCreator(app)
 	wcfg := config.DefaultConfig()
 	wcfg.Mempool.KeepInvalidTxsInCache = true
+	// recheck committed txs removed from the cache
+	wcfg.Mempool.CommittedTxsWindow = 0
 	mp, cleanup := newMempoolWithAppAndConfig(cc, wcfg)
 	defer cleanup()
 
@@ -662,6 +692,35 @@ func TestMempoolTxsBytes(t *testing.T) {
 	assert.EqualValues(t, 10, mp.SizeBytes())
 }
 
+func TestMempoolMemoryBytes(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+
+	cfg := test.ResetTestRoot("mempool_test")
+
+	cfg.Mempool.MaxMemoryBytes = 2*txMemory(10, 0) + 1
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+
+	// 1. the memory of the txs, metadata and cache entries after CheckTx
+	tx1 := kvstore.NewRandomTx(10)
+	require.NoError(t, mp.CheckTx(tx1, nil, TxInfo{}))
+	tx2 := kvstore.NewRandomTx(10)
+	require.NoError(t, mp.CheckTx(tx2, nil, TxInfo{}))
+	assert.EqualValues(t, 2*txMemory(10, 0), mp.MemoryBytes())
+
+	// 2. ErrMempoolIsFull is returned when the memory cap is reached.
+	err := mp.CheckTx(kvstore.NewRandomTx(10), nil, TxInfo{})
+	if assert.Error(t, err) {
+		assert.IsType(t, ErrMempoolIsFull{}, err)
+	}
+
+	// 3. zero again after the txs are removed by Update
+	err = mp.Update(1, []types.Tx{tx1, tx2}, abciResponses(2, abci.CodeTypeOK), nil, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, mp.MemoryBytes())
+}
+
 func TestMempoolNoCacheOverflow(t *testing.T) {
 	mp, cleanup := newMempoolWithAsyncConnection(t)
 	defer cleanup()
@@ -0,0 +1,92 @@
+package mempool
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+// CommittedTxs is a thread-safe record of the transactions committed over the
+// last heights. The mempools use it to detect committed transactions that
+// are still in the mempool or being gossiped, e.g. because they fell out of
+// the cache, so as to purge them instead of rebroadcasting them.
+type CommittedTxs struct {
+	mtx     cmtsync.Mutex
+	window  int64
+	heights map[types.TxKey]int64
+	blocks  []committedBlock // oldest first
+}
+
+type committedBlock struct {
+	height int64
+	keys   []types.TxKey
+}
+
+// NewCommittedTxs returns a CommittedTxs remembering the transactions
+// committed over the last window heights. A non-positive window disables it.
+func NewCommittedTxs(window int64) *CommittedTxs {
+	return &CommittedTxs{
+		window:  window,
+		heights: make(map[types.TxKey]int64),
+	}
+}
+
+// Commit records the transactions of txs successfully executed at height, and
+// forgets the transactions committed window heights or more before it. Failed
+// transactions are not recorded, as they may be resubmitted.
+func (c *CommittedTxs) Commit(height int64, txs types.Txs, txResults []*abci.ExecTxResult) {
+	if c.window <= 0 {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for len(c.blocks) > 0 && c.blocks[0].height <= height-c.window {
+		for _, key := range c.blocks[0].keys {
+			if c.heights[key] == c.blocks[0].height {
+				delete(c.heights, key)
+			}
+		}
+		c.blocks = c.blocks[1:]
+	}
+	keys := make([]types.TxKey, 0, len(txs))
+	for i, tx := range txs {
+		if txResults[i].Code != abci.CodeTypeOK {
+			continue
+		}
+		key := tx.Key()
+		c.heights[key] = height
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return
+	}
+	c.blocks = append(c.blocks, committedBlock{height: height, keys: keys})
+}
+
+// Height returns the height at which the transaction with the given key was
+// committed, and false if it was not committed within the window.
+func (c *CommittedTxs) Height(key types.TxKey) (int64, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	height, ok := c.heights[key]
+	return height, ok
+}
+
+// Len returns the number of transactions remembered.
+func (c *CommittedTxs) Len() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return len(c.heights)
+}
+
+// Reset forgets all committed transactions.
+func (c *CommittedTxs) Reset() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.heights = make(map[types.TxKey]int64)
+	c.blocks = nil
+}
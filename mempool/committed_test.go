@@ -0,0 +1,41 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestCommittedTxs(t *testing.T) {
+	committed := NewCommittedTxs(2)
+	txs := types.Txs{types.Tx("a"), types.Tx("b")}
+
+	committed.Commit(1, txs, []*abci.ExecTxResult{{Code: abci.CodeTypeOK}, {Code: 1}})
+	height, ok := committed.Height(txs[0].Key())
+	require.True(t, ok)
+	assert.EqualValues(t, 1, height)
+	// failed txs may be resubmitted
+	_, ok = committed.Height(txs[1].Key())
+	assert.False(t, ok)
+
+	// txs are remembered for the window
+	committed.Commit(2, types.Txs{types.Tx("c")}, abciResponses(1, abci.CodeTypeOK))
+	assert.Equal(t, 2, committed.Len())
+	committed.Commit(3, nil, nil)
+	_, ok = committed.Height(txs[0].Key())
+	assert.False(t, ok)
+	_, ok = committed.Height(types.Tx("c").Key())
+	assert.True(t, ok)
+
+	committed.Reset()
+	assert.Zero(t, committed.Len())
+
+	// a zero window remembers nothing
+	disabled := NewCommittedTxs(0)
+	disabled.Commit(1, txs, abciResponses(2, abci.CodeTypeOK))
+	assert.Zero(t, disabled.Len())
+}
@@ -11,6 +11,10 @@ var ErrTxNotFound = errors.New("transaction not found in mempool")
 // ErrTxInCache is returned to the client if we saw tx earlier
 var ErrTxInCache = errors.New("tx already exists in cache")
 
+// ErrTxCommitted is returned if the tx was committed recently, but is no
+// longer in the cache.
+var ErrTxCommitted = errors.New("tx was recently committed")
+
 // ErrRecheckFull is returned when checking if the mempool is full and
 // rechecking is still in progress after a new block was committed.
 var ErrRecheckFull = errors.New("mempool is still rechecking after a new committed block, so it is considered as full")
@@ -34,9 +38,25 @@ type ErrMempoolIsFull struct {
 	TxsBytes    int64
 	MaxTxsBytes int64
 	RecheckFull bool
+
+	// MemoryBytes and MaxMemoryBytes are set if the memory used by the
+	// mempool is capped.
+	MemoryBytes    int64
+	MaxMemoryBytes int64
 }
 
 func (e ErrMempoolIsFull) Error() string {
+	if e.MaxMemoryBytes > 0 {
+		return fmt.Sprintf(
+			"mempool is full: number of txs %d (max: %d), total txs bytes %d (max: %d), memory bytes %d (max: %d)",
+			e.NumTxs,
+			e.MaxTxs,
+			e.TxsBytes,
+			e.MaxTxsBytes,
+			e.MemoryBytes,
+			e.MaxMemoryBytes,
+		)
+	}
 	return fmt.Sprintf(
 		"mempool is full: number of txs %d (max: %d), total txs bytes %d (max: %d)",
 		e.NumTxs,
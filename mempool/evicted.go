@@ -0,0 +1,130 @@
+package mempool
+
+import (
+	"container/list"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Reasons for which a transaction is evicted from the mempool.
+const (
+	// EvictionReasonFull means the transaction was rejected because the
+	// mempool was full of transactions of higher priority.
+	EvictionReasonFull = "mempool full"
+	// EvictionReasonPriority means the transaction was replaced by one of
+	// higher priority.
+	EvictionReasonPriority = "replaced by higher priority tx"
+	// EvictionReasonExpired means the transaction outlived the TTL of the
+	// mempool.
+	EvictionReasonExpired = "expired"
+)
+
+// EvictionCallback is called with each transaction evicted from the mempool
+// and the reason for its eviction, one of the EvictionReason constants, e.g. for
+// the application to release the resources it reserved for the transaction in
+// CheckTx. It is called with the mempool locked: it must return quickly and must
+// not call the mempool.
+type EvictionCallback func(tx types.Tx, reason string)
+
+// EvictionHook passes the transactions evicted from a mempool to a callback,
+// which may be set after the mempool is created. The zero value drops them.
+type EvictionHook struct {
+	mtx      cmtsync.RWMutex
+	callback EvictionCallback
+}
+
+// SetCallback sets the callback called with each evicted transaction.
+func (h *EvictionHook) SetCallback(cb EvictionCallback) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.callback = cb
+}
+
+// Evicted passes an evicted transaction to the callback, if any. It is a no-op
+// on a nil hook.
+func (h *EvictionHook) Evicted(tx types.Tx, reason string) {
+	if h == nil {
+		return
+	}
+	h.mtx.RLock()
+	cb := h.callback
+	h.mtx.RUnlock()
+	if cb != nil {
+		cb(tx, reason)
+	}
+}
+
+// EvictedTxs is a thread-safe, bounded history of the transactions recently
+// evicted from the mempool and of the reason for their eviction. The oldest
+// entries are dropped first.
+type EvictedTxs struct {
+	mtx     cmtsync.Mutex
+	size    int
+	reasons map[types.TxKey]*list.Element
+	list    *list.List
+}
+
+type evictedTx struct {
+	key    types.TxKey
+	reason string
+}
+
+// NewEvictedTxs returns an EvictedTxs remembering up to size transactions.
+func NewEvictedTxs(size int) *EvictedTxs {
+	return &EvictedTxs{
+		size:    size,
+		reasons: make(map[types.TxKey]*list.Element, size),
+		list:    list.New(),
+	}
+}
+
+// Push records that the transaction with the given key was evicted.
+func (e *EvictedTxs) Push(key types.TxKey, reason string) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if elem, ok := e.reasons[key]; ok {
+		elem.Value.(*evictedTx).reason = reason
+		e.list.MoveToBack(elem)
+		return
+	}
+
+	if e.size <= 0 {
+		return
+	}
+	if e.list.Len() >= e.size {
+		front := e.list.Front()
+		delete(e.reasons, front.Value.(*evictedTx).key)
+		e.list.Remove(front)
+	}
+	e.reasons[key] = e.list.PushBack(&evictedTx{key: key, reason: reason})
+}
+
+// Reason returns why the transaction with the given key was evicted, and
+// false if it was not recently evicted.
+func (e *EvictedTxs) Reason(key types.TxKey) (string, bool) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	elem, ok := e.reasons[key]
+	if !ok {
+		return "", false
+	}
+	return elem.Value.(*evictedTx).reason, true
+}
+
+// Has reports whether the transaction with the given key was recently evicted.
+func (e *EvictedTxs) Has(key types.TxKey) bool {
+	_, ok := e.Reason(key)
+	return ok
+}
+
+// Reset forgets all evicted transactions.
+func (e *EvictedTxs) Reset() {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.reasons = make(map[types.TxKey]*list.Element, e.size)
+	e.list.Init()
+}
@@ -0,0 +1,56 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestEvictedTxs(t *testing.T) {
+	evicted := NewEvictedTxs(2)
+	key := func(s string) types.TxKey { return types.Tx(s).Key() }
+
+	evicted.Push(key("a"), EvictionReasonFull)
+	evicted.Push(key("b"), EvictionReasonExpired)
+	reason, ok := evicted.Reason(key("a"))
+	require.True(t, ok)
+	assert.Equal(t, EvictionReasonFull, reason)
+
+	// re-evicting a tx updates its reason and makes it the newest entry
+	evicted.Push(key("a"), EvictionReasonPriority)
+	reason, _ = evicted.Reason(key("a"))
+	assert.Equal(t, EvictionReasonPriority, reason)
+
+	// the oldest entry is dropped when full
+	evicted.Push(key("c"), EvictionReasonFull)
+	assert.False(t, evicted.Has(key("b")))
+	assert.True(t, evicted.Has(key("a")))
+	assert.True(t, evicted.Has(key("c")))
+
+	evicted.Reset()
+	assert.False(t, evicted.Has(key("a")))
+
+	// a zero-sized history remembers nothing
+	empty := NewEvictedTxs(0)
+	empty.Push(key("a"), EvictionReasonFull)
+	assert.False(t, empty.Has(key("a")))
+}
+
+func TestEvictionHook(t *testing.T) {
+	// a nil hook drops the evictions
+	var hook *EvictionHook
+	hook.Evicted(types.Tx("a"), EvictionReasonFull)
+
+	hook = &EvictionHook{}
+	hook.Evicted(types.Tx("a"), EvictionReasonFull)
+
+	var evicted []string
+	hook.SetCallback(func(tx types.Tx, reason string) {
+		evicted = append(evicted, string(tx)+" "+reason)
+	})
+	hook.Evicted(types.Tx("b"), EvictionReasonExpired)
+	assert.Equal(t, []string{"b " + EvictionReasonExpired}, evicted)
+}
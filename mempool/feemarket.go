@@ -0,0 +1,226 @@
+package mempool
+
+import (
+	"slices"
+	"sync"
+)
+
+// DefaultFeeMarketWindow is the number of heights over which the fee market
+// statistics are computed when no window is configured.
+const DefaultFeeMarketWindow = 20
+
+// FeeMarketStats is a snapshot of the priority thresholds observed by the
+// mempool over a rolling window of heights.
+type FeeMarketStats struct {
+	// Window is the configured number of heights the stats are computed over.
+	Window int
+	// Heights is the number of heights currently covered by the stats. It is
+	// smaller than Window until enough blocks have been committed.
+	Heights int
+	// AdmittedTxs is the number of transactions admitted to the mempool.
+	AdmittedTxs int
+	// MinAdmittedPriority is the lowest priority of an admitted transaction.
+	MinAdmittedPriority int64
+	// AdmittedPriorityP50 and AdmittedPriorityP90 are percentiles over the
+	// priorities of the admitted transactions.
+	AdmittedPriorityP50 int64
+	AdmittedPriorityP90 int64
+	// IncludedTxs is the number of mempool transactions committed in a block.
+	IncludedTxs int
+	// MinIncludedPriority is the lowest priority of a committed transaction.
+	MinIncludedPriority int64
+	// EvictedTxs is the number of transactions evicted or rejected because
+	// the mempool was full.
+	EvictedTxs int
+	// EvictionRate is the average number of evicted transactions per height.
+	EvictionRate float64
+}
+
+// maxFeeMarketSamples bounds the number of admitted priorities kept to
+// compute the percentiles, which are computed over the most recent admissions
+// past it.
+const maxFeeMarketSamples = 10000
+
+// feeMarketBucket holds the observations for a single height.
+type feeMarketBucket struct {
+	admitted    int
+	minAdmitted int64
+	included    int
+	minIncluded int64
+	evicted     int
+}
+
+// feeMarketSample is the priority of an admitted transaction, with the
+// number of the height it was admitted at.
+type feeMarketSample struct {
+	priority int64
+	height   int64
+}
+
+// FeeMarket tracks the priorities of transactions admitted to and committed
+// from the mempool over a rolling window of heights. Priority based mempools
+// feed it as transactions move through them so that clients can estimate the
+// priority required to get a transaction admitted and included. It is
+// thread-safe.
+type FeeMarket struct {
+	mtx     sync.Mutex
+	window  int
+	height  int64 // number of the current height, incremented on Commit
+	current feeMarketBucket
+	history []feeMarketBucket // oldest first, at most window-1 entries
+
+	// ring buffer of the last maxFeeMarketSamples admitted priorities
+	samples []feeMarketSample
+	next    int
+
+	observing    bool
+	observeAgain bool
+}
+
+// NewFeeMarket returns a FeeMarket computing stats over the given number of
+// heights. A non-positive window falls back to DefaultFeeMarketWindow.
+func NewFeeMarket(window int) *FeeMarket {
+	if window <= 0 {
+		window = DefaultFeeMarketWindow
+	}
+	return &FeeMarket{window: window}
+}
+
+// RecordAdmitted records that a transaction with the given priority was
+// admitted to the mempool.
+func (fm *FeeMarket) RecordAdmitted(priority int64) {
+	fm.mtx.Lock()
+	defer fm.mtx.Unlock()
+	if fm.current.admitted == 0 || priority < fm.current.minAdmitted {
+		fm.current.minAdmitted = priority
+	}
+	fm.current.admitted++
+
+	sample := feeMarketSample{priority: priority, height: fm.height}
+	if len(fm.samples) < maxFeeMarketSamples {
+		fm.samples = append(fm.samples, sample)
+		return
+	}
+	fm.samples[fm.next] = sample
+	fm.next = (fm.next + 1) % maxFeeMarketSamples
+}
+
+// RecordIncluded records that a mempool transaction with the given priority
+// was committed in a block.
+func (fm *FeeMarket) RecordIncluded(priority int64) {
+	fm.mtx.Lock()
+	defer fm.mtx.Unlock()
+	if fm.current.included == 0 || priority < fm.current.minIncluded {
+		fm.current.minIncluded = priority
+	}
+	fm.current.included++
+}
+
+// RecordEvicted records that n transactions were evicted, or turned away,
+// because the mempool was full.
+func (fm *FeeMarket) RecordEvicted(n int) {
+	fm.mtx.Lock()
+	defer fm.mtx.Unlock()
+	fm.current.evicted += n
+}
+
+// Commit closes the observations of the current height and advances the
+// window. It is expected to be called once per height from the mempool's
+// Update.
+func (fm *FeeMarket) Commit() {
+	fm.mtx.Lock()
+	defer fm.mtx.Unlock()
+	fm.history = append(fm.history, fm.current)
+	if len(fm.history) >= fm.window {
+		fm.history = fm.history[len(fm.history)-fm.window+1:]
+	}
+	fm.current = feeMarketBucket{}
+	fm.height++
+}
+
+// Stats returns the fee market stats over the current window, including the
+// observations of the height in progress. The percentiles are computed over
+// the last maxFeeMarketSamples admitted transactions of the window, after
+// releasing the lock.
+func (fm *FeeMarket) Stats() FeeMarketStats {
+	fm.mtx.Lock()
+	buckets := append(fm.history[:len(fm.history):len(fm.history)], fm.current)
+	stats := FeeMarketStats{
+		Window:  fm.window,
+		Heights: len(buckets),
+	}
+	for _, b := range buckets {
+		if b.admitted > 0 && (stats.AdmittedTxs == 0 || b.minAdmitted < stats.MinAdmittedPriority) {
+			stats.MinAdmittedPriority = b.minAdmitted
+		}
+		stats.AdmittedTxs += b.admitted
+		if b.included > 0 && (stats.IncludedTxs == 0 || b.minIncluded < stats.MinIncludedPriority) {
+			stats.MinIncludedPriority = b.minIncluded
+		}
+		stats.IncludedTxs += b.included
+		stats.EvictedTxs += b.evicted
+	}
+	stats.EvictionRate = float64(stats.EvictedTxs) / float64(stats.Heights)
+
+	oldest := fm.height - int64(len(fm.history))
+	admitted := make([]int64, 0, min(stats.AdmittedTxs, len(fm.samples)))
+	for _, sample := range fm.samples {
+		if sample.height >= oldest {
+			admitted = append(admitted, sample.priority)
+		}
+	}
+	fm.mtx.Unlock()
+
+	if len(admitted) > 0 {
+		slices.Sort(admitted)
+		stats.AdmittedPriorityP50 = percentile(admitted, 50)
+		stats.AdmittedPriorityP90 = percentile(admitted, 90)
+	}
+	return stats
+}
+
+// ObserveMetrics updates the fee market gauges of m from the stats in the
+// background, so that the caller, e.g. the mempool's Update, doesn't wait for
+// the percentiles to be computed. The updates requested while one is running
+// are coalesced.
+func (fm *FeeMarket) ObserveMetrics(m *Metrics) {
+	fm.mtx.Lock()
+	defer fm.mtx.Unlock()
+	if fm.observing {
+		fm.observeAgain = true
+		return
+	}
+	fm.observing = true
+	go fm.observeRoutine(m)
+}
+
+func (fm *FeeMarket) observeRoutine(m *Metrics) {
+	for {
+		m.ObserveFeeMarket(fm.Stats())
+
+		fm.mtx.Lock()
+		if !fm.observeAgain {
+			fm.observing = false
+			fm.mtx.Unlock()
+			return
+		}
+		fm.observeAgain = false
+		fm.mtx.Unlock()
+	}
+}
+
+// percentile returns the nearest-rank percentile p of the sorted values.
+func percentile(sorted []int64, p int) int64 {
+	idx := (len(sorted)*p+99)/100 - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
+
+// ObserveFeeMarket updates the fee market gauges from the given stats.
+func (m *Metrics) ObserveFeeMarket(stats FeeMarketStats) {
+	m.AdmittedPriorityP50.Set(float64(stats.AdmittedPriorityP50))
+	m.AdmittedPriorityP90.Set(float64(stats.AdmittedPriorityP90))
+	m.EvictionRate.Set(stats.EvictionRate)
+}
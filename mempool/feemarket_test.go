@@ -0,0 +1,77 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeMarketStats(t *testing.T) {
+	fm := NewFeeMarket(3)
+
+	stats := fm.Stats()
+	require.Equal(t, 3, stats.Window)
+	require.Equal(t, 1, stats.Heights)
+	require.Zero(t, stats.AdmittedTxs)
+
+	for i := int64(1); i <= 10; i++ {
+		fm.RecordAdmitted(i * 10)
+	}
+	fm.RecordIncluded(70)
+	fm.RecordIncluded(50)
+	fm.RecordEvicted(2)
+	fm.Commit()
+
+	stats = fm.Stats()
+	require.Equal(t, 2, stats.Heights)
+	require.Equal(t, 10, stats.AdmittedTxs)
+	require.EqualValues(t, 10, stats.MinAdmittedPriority)
+	require.EqualValues(t, 50, stats.AdmittedPriorityP50)
+	require.EqualValues(t, 90, stats.AdmittedPriorityP90)
+	require.Equal(t, 2, stats.IncludedTxs)
+	require.EqualValues(t, 50, stats.MinIncludedPriority)
+	require.Equal(t, 2, stats.EvictedTxs)
+	require.Equal(t, 1.0, stats.EvictionRate)
+}
+
+func TestFeeMarketWindow(t *testing.T) {
+	fm := NewFeeMarket(2)
+
+	fm.RecordAdmitted(1)
+	fm.RecordIncluded(1)
+	fm.RecordEvicted(4)
+	fm.Commit()
+
+	fm.RecordAdmitted(100)
+	fm.Commit()
+
+	// the first height has dropped out of the window
+	stats := fm.Stats()
+	require.Equal(t, 2, stats.Heights)
+	require.Equal(t, 1, stats.AdmittedTxs)
+	require.EqualValues(t, 100, stats.MinAdmittedPriority)
+	require.EqualValues(t, 100, stats.AdmittedPriorityP50)
+	require.Zero(t, stats.IncludedTxs)
+	require.Zero(t, stats.MinIncludedPriority)
+	require.Zero(t, stats.EvictedTxs)
+}
+
+func TestFeeMarketSamplesBounded(t *testing.T) {
+	fm := NewFeeMarket(2)
+
+	// the percentiles are over the most recent admissions past the bound,
+	// the counts and minimums over all of them
+	for i := int64(1); i <= 2*maxFeeMarketSamples; i++ {
+		fm.RecordAdmitted(i)
+	}
+	require.Len(t, fm.samples, maxFeeMarketSamples)
+
+	stats := fm.Stats()
+	require.Equal(t, 2*maxFeeMarketSamples, stats.AdmittedTxs)
+	require.EqualValues(t, 1, stats.MinAdmittedPriority)
+	require.EqualValues(t, maxFeeMarketSamples+maxFeeMarketSamples/2, stats.AdmittedPriorityP50)
+}
+
+func TestFeeMarketDefaultWindow(t *testing.T) {
+	require.Equal(t, DefaultFeeMarketWindow, NewFeeMarket(0).Stats().Window)
+}
@@ -0,0 +1,54 @@
+package mempool
+
+import (
+	"container/list"
+	"sync"
+	"unsafe"
+
+	"github.com/cometbft/cometbft/libs/clist"
+	"github.com/cometbft/cometbft/types"
+)
+
+// The memory used by the transactions in a mempool is estimated from the
+// sizes of the structures holding them, as the runtime does not report the
+// heap usage of individual objects. The estimates round allocations up to a
+// multiple of the word size but ignore the size classes of the allocator, use
+// fixed sizes for the runtime structures it does not expose, such as channel
+// headers, and leave out the sets of peers each transaction was received
+// from, which grow after it is admitted. They are not the heap usage of the
+// mempool, which can exceed them.
+const (
+	wordSize = int64(unsafe.Sizeof(uintptr(0)))
+
+	// chanMemory is the size of the header of a channel, which the runtime
+	// does not expose.
+	chanMemory = 96
+
+	// MapEntryMemory is the estimated memory used by an entry of a map from
+	// a transaction key to a pointer, including the overhead of its bucket.
+	MapEntryMemory = int64(unsafe.Sizeof(types.TxKey{})) + 2*wordSize + 1
+
+	// CListElementMemory is the estimated memory used by an element of a
+	// clist.CList, including its wait groups and channels.
+	CListElementMemory = int64(unsafe.Sizeof(clist.CElement{})) +
+		2*int64(unsafe.Sizeof(sync.WaitGroup{})) + 2*chanMemory
+
+	// CacheEntryMemory is the estimated memory used by the entry of a
+	// transaction in the cache of a mempool: its key, boxed in the element of
+	// the LRU list, and its entry in the lookup map.
+	CacheEntryMemory = int64(unsafe.Sizeof(list.Element{})) +
+		int64(unsafe.Sizeof(types.TxKey{})) + MapEntryMemory
+)
+
+// EntryMemory returns the estimated memory used by a transaction of txSize
+// bytes in a mempool, for the memory cap of the mempool: the transaction
+// itself, metadataSize bytes of metadata, numIndexes map entries indexing it
+// and its entry in the cache.
+func EntryMemory(txSize int, metadataSize int64, numIndexes int) int64 {
+	return roundUpToWord(int64(txSize)) + roundUpToWord(metadataSize) +
+		int64(numIndexes)*MapEntryMemory + CacheEntryMemory
+}
+
+func roundUpToWord(n int64) int64 {
+	return (n + wordSize - 1) / wordSize * wordSize
+}
@@ -20,6 +20,15 @@ const (
 	UnknownPeerID uint16 = 0
 
 	MaxActiveIDs = math.MaxUint16
+
+	// BroadcastRoutines is the subsystem of the routines broadcasting txs to
+	// peers, whose number is capped by max-broadcast-routines.
+	BroadcastRoutines = "mempool/broadcast"
+
+	// PersistentBroadcastRoutines is the subsystem of the routines
+	// broadcasting txs to persistent and unconditional peers, which are not
+	// capped.
+	PersistentBroadcastRoutines = "mempool/broadcast/persistent"
 )
 
 //go:generate ../scripts/mockery_generate.sh Mempool
@@ -112,6 +121,26 @@ type Mempool interface {
 	WasRecentlyEvicted(key types.TxKey) bool
 }
 
+// FeeMarketReporter is implemented by mempools which track the priorities of
+// admitted and committed transactions. Used in the RPC endpoint: FeeMarket.
+type FeeMarketReporter interface {
+	// FeeMarketStats returns the fee market stats over the configured window.
+	FeeMarketStats() FeeMarketStats
+}
+
+// TxStatusReporter is implemented by mempools which can describe the status of
+// their transactions in more detail. Used in the RPC endpoint: TxStatus.
+type TxStatusReporter interface {
+	// PendingTxInfo returns the priority of a pending transaction and its
+	// position in the order in which transactions are reaped, and false if
+	// the transaction is not in the mempool.
+	PendingTxInfo(key types.TxKey) (priority int64, position int, ok bool)
+
+	// EvictionReason returns why a recently evicted transaction was evicted,
+	// and false if it was not recently evicted.
+	EvictionReason(key types.TxKey) (string, bool)
+}
+
 // PreCheckFunc is an optional filter executed before CheckTx and rejects
 // transaction if false is returned. An example would be to ensure that a
 // transaction doesn't exceeded the block size.
@@ -156,5 +185,41 @@ func PostCheckMaxGas(maxGas int64) PostCheckFunc {
 	}
 }
 
+// CodeTypeCheckTxFailed and CodeTypeCheckTxStale are the codes of the CheckTx
+// responses reported by the mempool itself, in the CheckTxCodespace codespace,
+// when the application did not respond to the request or responded to it
+// against the state of a height since committed.
+const (
+	CodeTypeCheckTxFailed uint32 = 1
+	CodeTypeCheckTxStale  uint32 = 2
+	CheckTxCodespace             = "mempool"
+)
+
+// CheckTxFailedResponse returns the response reported to the callers of
+// CheckTx when the application responded to the request with res rather than
+// with a CheckTx response, e.g. because its client was stopped.
+func CheckTxFailedResponse(res *abci.Response) *abci.ResponseCheckTx {
+	log := fmt.Sprintf("unexpected response %T to CheckTx", res.GetValue())
+	if exception := res.GetException(); exception != nil {
+		log = exception.Error
+	}
+	return &abci.ResponseCheckTx{
+		Code:      CodeTypeCheckTxFailed,
+		Codespace: CheckTxCodespace,
+		Log:       log,
+	}
+}
+
+// CheckTxStaleResponse returns the response reported to the callers of
+// CheckTx when the application checked the transaction against the state of
+// height, which was committed before the response was handled.
+func CheckTxStaleResponse(height int64) *abci.ResponseCheckTx {
+	return &abci.ResponseCheckTx{
+		Code:      CodeTypeCheckTxStale,
+		Codespace: CheckTxCodespace,
+		Log:       fmt.Sprintf("transaction checked against the state of committed height %d", height),
+	}
+}
+
 // TxKey is the fixed length array key used as an index.
 type TxKey [sha256.Size]byte
@@ -3,15 +3,19 @@ package mempool
 import (
 	"sync"
 	"sync/atomic"
+	"time"
+	"unsafe"
 
 	"github.com/cometbft/cometbft/types"
 )
 
 // mempoolTx is an entry in the mempool
 type mempoolTx struct {
-	height    int64    // height that this tx had been validated in
-	gasWanted int64    // amount of gas this tx states it will require
-	tx        types.Tx // validated by the application
+	height    int64     // height that this tx had been validated in
+	gasWanted int64     // amount of gas this tx states it will require
+	tx        types.Tx  // validated by the application
+	sender    []byte    // address returned by CheckTx, if any
+	timestamp time.Time // time the tx was added to the mempool
 
 	// ids of peers who've sent us this tx (as a map for quick lookups).
 	// senders: PeerID -> bool
@@ -32,3 +36,15 @@ func (memTx *mempoolTx) addSender(senderID uint16) bool {
 	_, added := memTx.senders.LoadOrStore(senderID, true)
 	return added
 }
+
+// memory returns the estimated memory used by the entry.
+func (memTx *mempoolTx) memory() int64 {
+	return txMemory(len(memTx.tx), len(memTx.sender))
+}
+
+// txMemory returns the estimated memory used by the entry of a transaction of
+// txSize bytes with a sender of senderSize bytes, indexed by txsMap.
+func txMemory(txSize, senderSize int) int64 {
+	metadata := int64(unsafe.Sizeof(mempoolTx{})) + int64(senderSize) + CListElementMemory
+	return EntryMemory(txSize, metadata, 1)
+}
@@ -26,6 +26,12 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "size_bytes",
 			Help:      "Total size of the mempool in bytes.",
 		}, labels).With(labelsAndValues...),
+		MemoryBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "memory_bytes",
+			Help:      "Estimated memory used by the transactions in the mempool, including their metadata, indexes and cache entries but not the peers they were received from, in bytes.",
+		}, labels).With(labelsAndValues...),
 		TxSizeBytes: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -52,6 +58,12 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "evicted_txs",
 			Help:      "EvictedTxs defines the number of evicted transactions. These are valid transactions that passed CheckTx and make it into the mempool but later became invalid. metrics:Number of evicted transactions.",
 		}, labels).With(labelsAndValues...),
+		StaleTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "stale_txs",
+			Help:      "StaleTxs defines the number of transactions received or found in the mempool after being committed, despite the cache. Each indicates a committed transaction still being gossiped. metrics:Number of committed transactions received again or found in the mempool.",
+		}, labels).With(labelsAndValues...),
 		RecheckTimes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -94,6 +106,24 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "rerequested_txs",
 			Help:      "RerequestedTxs defines the number of times that a requested tx never received a response in time and a new request was made.",
 		}, labels).With(labelsAndValues...),
+		AdmittedPriorityP50: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "admitted_priority_p50",
+			Help:      "Median priority of the transactions admitted to the mempool over the fee market window.",
+		}, labels).With(labelsAndValues...),
+		AdmittedPriorityP90: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "admitted_priority_p90",
+			Help:      "90th percentile priority of the transactions admitted to the mempool over the fee market window.",
+		}, labels).With(labelsAndValues...),
+		EvictionRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "eviction_rate",
+			Help:      "Average number of transactions evicted per height over the fee market window.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -101,10 +131,12 @@ func NopMetrics() *Metrics {
 	return &Metrics{
 		Size:                      discard.NewGauge(),
 		SizeBytes:                 discard.NewGauge(),
+		MemoryBytes:               discard.NewGauge(),
 		TxSizeBytes:               discard.NewHistogram(),
 		FailedTxs:                 discard.NewCounter(),
 		RejectedTxs:               discard.NewCounter(),
 		EvictedTxs:                discard.NewCounter(),
+		StaleTxs:                  discard.NewCounter(),
 		RecheckTimes:              discard.NewCounter(),
 		ActiveOutboundConnections: discard.NewGauge(),
 		ExpiredTxs:                discard.NewCounter(),
@@ -112,5 +144,8 @@ func NopMetrics() *Metrics {
 		AlreadySeenTxs:            discard.NewCounter(),
 		RequestedTxs:              discard.NewCounter(),
 		RerequestedTxs:            discard.NewCounter(),
+		AdmittedPriorityP50:       discard.NewGauge(),
+		AdmittedPriorityP90:       discard.NewGauge(),
+		EvictionRate:              discard.NewGauge(),
 	}
 }
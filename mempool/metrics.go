@@ -21,6 +21,11 @@ type Metrics struct {
 	// Total size of the mempool in bytes.
 	SizeBytes metrics.Gauge
 
+	// Estimated memory used by the transactions in the mempool, including
+	// their metadata, indexes and cache entries but not the peers they were
+	// received from, in bytes.
+	MemoryBytes metrics.Gauge
+
 	// Histogram of transaction sizes in bytes.
 	TxSizeBytes metrics.Histogram `metrics_buckettype:"exp" metrics_bucketsizes:"1,3,7"`
 
@@ -42,6 +47,12 @@ type Metrics struct {
 	// metrics:Number of evicted transactions.
 	EvictedTxs metrics.Counter
 
+	// StaleTxs defines the number of transactions received or found in the
+	// mempool after being committed, despite the cache. Each indicates a
+	// committed transaction still being gossiped.
+	// metrics:Number of committed transactions received again or found in the mempool.
+	StaleTxs metrics.Counter
+
 	// Number of times transactions are rechecked in the mempool.
 	RecheckTimes metrics.Counter
 
@@ -69,4 +80,16 @@ type Metrics struct {
 	// RerequestedTxs defines the number of times that a requested tx
 	// never received a response in time and a new request was made.
 	RerequestedTxs metrics.Counter
+
+	// Median priority of the transactions admitted to the mempool over the
+	// fee market window.
+	AdmittedPriorityP50 metrics.Gauge `metrics_name:"admitted_priority_p50"`
+
+	// 90th percentile priority of the transactions admitted to the mempool
+	// over the fee market window.
+	AdmittedPriorityP90 metrics.Gauge `metrics_name:"admitted_priority_p90"`
+
+	// Average number of transactions evicted per height over the fee market
+	// window.
+	EvictionRate metrics.Gauge
 }
@@ -3,6 +3,7 @@ package priority
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -13,6 +14,7 @@ import (
 	"github.com/cometbft/cometbft/libs/clist"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/mempool"
+	protomem "github.com/cometbft/cometbft/proto/tendermint/mempool"
 	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/types"
 )
@@ -38,25 +40,43 @@ type TxMempool struct {
 	proxyAppConn proxy.AppConnMempool
 	metrics      *mempool.Metrics
 	cache        mempool.TxCache // seen transactions
+	events       types.MempoolEventPublisher
+	reapQuota    *mempool.ReapQuota // nil if reaped classes are not limited
 
 	// Atomically-updated fields
-	txsBytes int64 // atomic: the total size of all transactions in the mempool, in bytes
+	txsBytes  int64 // atomic: the total size of all transactions in the mempool, in bytes
+	txsMemory int64 // atomic: the estimated memory used by the transactions in the mempool, in bytes
 
 	// Synchronized fields, protected by mtx.
 	mtx                  *sync.RWMutex
 	notifiedTxsAvailable bool
 	txsAvailable         chan struct{} // one value sent per height when mempool is not empty
+	admissionFn          mempool.PreCheckFunc
 	preCheckFn           mempool.PreCheckFunc
 	postCheckFn          mempool.PostCheckFunc
 	height               int64     // the latest height passed to Update
 	lastPurgeTime        time.Time // the last time we attempted to purge transactions via the TTL
 
-	txs        *clist.CList // valid transactions (passed CheckTx)
-	txByKey    map[types.TxKey]*clist.CElement
-	txBySender map[string]*clist.CElement // for sender != ""
-	evictedTxs mempool.TxCache            // for tracking evicted transactions
+	txs          *clist.CList // valid transactions (passed CheckTx)
+	txByKey      map[types.TxKey]*clist.CElement
+	txBySender   map[string]*clist.CElement // for sender != ""
+	orderedTxs   []*WrappedTx               // txs in the order they are reaped in
+	evictedTxs   *mempool.EvictedTxs        // for tracking evicted transactions
+	evictionHook *mempool.EvictionHook      // called with each evicted transaction
+	committed    *mempool.CommittedTxs      // for detecting committed transactions gossiped again
+	feeMarket    *mempool.FeeMarket         // for tracking admitted and committed priorities
+
+	// Pipelining of new transactions to the application.
+	inFlight      chan struct{} // one slot per CheckTx awaiting its response
+	inFlightCount int           // CheckTx requests which were not handled yet, guarded by mtx
+	inFlightCond  *sync.Cond    // signaled, with mtx, once inFlightCount drops to zero or flushing is cleared
+	flushing      bool          // set while FlushAppConn drains the in-flight requests, guarded by mtx
 }
 
+// defaultMaxInFlightCheckTx bounds the number of pending CheckTx requests when
+// the config does not.
+const defaultMaxInFlightCheckTx = 1000
+
 // NewTxMempool constructs a new, empty priority mempool at the specified
 // initial height and using the given config and options.
 func NewTxMempool(
@@ -73,16 +93,25 @@ func NewTxMempool(
 		proxyAppConn: proxyAppConn,
 		metrics:      mempool.NopMetrics(),
 		cache:        mempool.NopTxCache{},
+		events:       types.NopEventBus{},
 		txs:          clist.New(),
 		mtx:          new(sync.RWMutex),
 		height:       height,
 		txByKey:      make(map[types.TxKey]*clist.CElement),
 		txBySender:   make(map[string]*clist.CElement),
+		feeMarket:    mempool.NewFeeMarket(cfg.FeeMarketWindow),
+		committed:    mempool.NewCommittedTxs(cfg.CommittedTxsWindow),
+	}
+	maxInFlight := cfg.MaxInFlightCheckTx
+	if maxInFlight <= 0 {
+		maxInFlight = defaultMaxInFlightCheckTx
 	}
+	txmp.inFlight = make(chan struct{}, maxInFlight)
+	txmp.inFlightCond = sync.NewCond(txmp.mtx)
 	if cfg.CacheSize > 0 {
 		txmp.cache = mempool.NewLRUTxCache(cfg.CacheSize)
-		txmp.evictedTxs = mempool.NewLRUTxCache(cfg.CacheSize / 5)
 	}
+	txmp.evictedTxs = mempool.NewEvictedTxs(cfg.CacheSize / 5)
 
 	for _, opt := range options {
 		opt(txmp)
@@ -98,6 +127,13 @@ func WithPreCheck(f mempool.PreCheckFunc) TxMempoolOption {
 	return func(txmp *TxMempool) { txmp.preCheckFn = f }
 }
 
+// WithAdmissionFilter sets a filter for the mempool to reject a transaction if
+// f(tx) returns an error. This is executed before the pre-check and CheckTx.
+// Unlike WithPreCheck, it is not overwritten by Update().
+func WithAdmissionFilter(f mempool.PreCheckFunc) TxMempoolOption {
+	return func(txmp *TxMempool) { txmp.admissionFn = f }
+}
+
 // WithPostCheck sets a filter for the mempool to reject a transaction if
 // f(tx, resp) returns an error. This is executed after CheckTx. It only applies
 // to the first created block. After that, Update overwrites the existing value.
@@ -110,6 +146,24 @@ func WithMetrics(metrics *mempool.Metrics) TxMempoolOption {
 	return func(txmp *TxMempool) { txmp.metrics = metrics }
 }
 
+// WithEventPublisher sets the publisher notified of every transaction admitted
+// to the mempool.
+func WithEventPublisher(p types.MempoolEventPublisher) TxMempoolOption {
+	return func(txmp *TxMempool) { txmp.events = p }
+}
+
+// WithReapQuota sets the quota limiting the share of each class of
+// transactions in ReapMaxBytesMaxGas.
+func WithReapQuota(q *mempool.ReapQuota) TxMempoolOption {
+	return func(txmp *TxMempool) { txmp.reapQuota = q }
+}
+
+// WithEvictionHook sets the hook notified of every transaction evicted from
+// the mempool, when it is full or the transaction expires.
+func WithEvictionHook(h *mempool.EvictionHook) TxMempoolOption {
+	return func(txmp *TxMempool) { txmp.evictionHook = h }
+}
+
 // Lock obtains a write-lock on the mempool. A caller must be sure to explicitly
 // release the lock when finished.
 func (txmp *TxMempool) Lock() { txmp.mtx.Lock() }
@@ -125,6 +179,10 @@ func (txmp *TxMempool) Size() int { return txmp.txs.Len() }
 // mempool. It is thread-safe.
 func (txmp *TxMempool) SizeBytes() int64 { return atomic.LoadInt64(&txmp.txsBytes) }
 
+// MemoryBytes returns the estimated memory used by the transactions in the
+// mempool, including their metadata, indexes and cache entries.
+func (txmp *TxMempool) MemoryBytes() int64 { return atomic.LoadInt64(&txmp.txsMemory) }
+
 // FlushAppConn executes FlushSync on the mempool's proxyAppConn.
 //
 // The caller must hold an exclusive mempool lock (by calling txmp.Lock) before
@@ -137,9 +195,19 @@ func (txmp *TxMempool) FlushAppConn() error {
 	// semantics of the Mempool interface require the caller to hold it, and we
 	// can't change that without disrupting existing use.
 	txmp.mtx.Unlock()
-	defer txmp.mtx.Lock()
+	err := txmp.proxyAppConn.Flush(context.TODO())
+	txmp.mtx.Lock()
 
-	return txmp.proxyAppConn.Flush(context.TODO())
+	// Wait for the responses which are waiting for the lock to be handled.
+	// New transactions are not admitted meanwhile, so that a steady stream of
+	// them cannot keep the count above zero.
+	txmp.flushing = true
+	for txmp.inFlightCount > 0 {
+		txmp.inFlightCond.Wait()
+	}
+	txmp.flushing = false
+	txmp.inFlightCond.Broadcast()
+	return err
 }
 
 // EnableTxsAvailable enables the mempool to trigger events when transactions
@@ -165,10 +233,15 @@ func (txmp *TxMempool) TxsAvailable() <-chan struct{} { return txmp.txsAvailable
 // - The transaction already exists in the cache.
 // - The proxy connection to the application fails.
 //
-// If tx passes all of the above conditions, it is passed (asynchronously) to
+// If tx passes all of the above conditions, it is passed asynchronously to
 // the application's ABCI CheckTx method and this CheckTx method returns nil.
-// If cb != nil, it is called when the ABCI request completes to report the
-// application response.
+// Up to MaxInFlightCheckTx requests are pipelined to the application; once
+// the window is full, CheckTx blocks until a response frees a slot. The
+// transaction is inserted into the mempool when the response arrives and, if
+// cb != nil, cb is then called to report the application response. If a block
+// is committed while the request is in flight, the transaction is rejected
+// instead, with a CodeTypeCheckTxStale response. New requests are held while
+// FlushAppConn waits for the pending ones.
 //
 // If the application accepts the transaction and the mempool is full, the
 // mempool evicts one or more of the lowest-priority transaction whose priority
@@ -200,12 +273,12 @@ func (txmp *TxMempool) CheckTx(
 
 	txKey := tx.Key()
 
-	// At this point, we need to ensure that passing CheckTx and adding to
-	// the mempool is atomic.
+	// Check for the transaction in the cache. Pushing it reserves the
+	// transaction, so that it is only checked once while in flight.
 	txmp.Lock()
-	defer txmp.Unlock()
-
-	// Check for the transaction in the cache.
+	for txmp.flushing {
+		txmp.inFlightCond.Wait()
+	}
 	if !txmp.cache.Push(tx) {
 		// If the cached transaction is also in the pool, record its sender.
 		if elt, ok := txmp.txByKey[txKey]; ok {
@@ -213,30 +286,118 @@ func (txmp *TxMempool) CheckTx(
 			w := elt.Value.(*WrappedTx)
 			w.SetPeer(txInfo.SenderID)
 		}
+		txmp.Unlock()
 		return mempool.ErrTxInCache
 	}
-
-	// Invoke an ABCI CheckTx for this transaction.
-	rsp, err := txmp.proxyAppConn.CheckTx(context.Background(), &abci.RequestCheckTx{Tx: tx})
-	if err != nil {
-		txmp.cache.Remove(tx)
-		return err
+	// The transaction is back in the cache, but was committed after falling
+	// out of it.
+	if height, ok := txmp.committed.Height(txKey); ok {
+		txmp.Unlock()
+		txmp.metrics.StaleTxs.Add(1)
+		txmp.logger.Debug(
+			"rejected committed transaction",
+			"tx", fmt.Sprintf("%X", txKey),
+			"committed_height", height,
+			"peer_id", txInfo.SenderP2PID,
+		)
+		return mempool.ErrTxCommitted
 	}
 	wtx := &WrappedTx{
 		tx:        tx,
-		hash:      tx.Key(),
+		hash:      txKey,
 		timestamp: time.Now().UTC(),
 		height:    txmp.height,
 	}
+	txmp.inFlightCount++
+	txmp.Unlock()
 	wtx.SetPeer(txInfo.SenderID)
-	// This won't add the transaction if the response code is non zero (i.e. there was an error)
-	txmp.addNewTransaction(wtx, rsp)
-	if cb != nil {
-		cb(rsp)
+
+	// Wait for a slot in the in-flight window. The mempool lock must not be
+	// held here, as completing the pending requests requires it.
+	txmp.inFlight <- struct{}{}
+
+	// Invoke an ABCI CheckTx for this transaction.
+	reqRes, err := txmp.proxyAppConn.CheckTxAsync(context.Background(), &abci.RequestCheckTx{Tx: tx})
+	if err != nil {
+		txmp.Lock()
+		txmp.releaseInFlight()
+		txmp.Unlock()
+		txmp.cache.Remove(tx)
+		return err
 	}
+	reqRes.SetCallback(func(res *abci.Response) {
+		checkTxRes := res.GetCheckTx()
+		if checkTxRes == nil {
+			// e.g. the client was stopped before the application responded
+			checkTxRes = mempool.CheckTxFailedResponse(res)
+		}
+		// The callback may run on the ABCI client's receive routine while
+		// another goroutine holds the mempool lock and waits for a response
+		// queued behind this one, so only handle the response in place if the
+		// lock is free.
+		if txmp.mtx.TryLock() {
+			txmp.handleCheckTxResponse(wtx, checkTxRes, cb)
+			return
+		}
+		go func() {
+			txmp.Lock()
+			txmp.handleCheckTxResponse(wtx, checkTxRes, cb)
+		}()
+	})
 	return nil
 }
 
+// handleCheckTxResponse adds the transaction according to the response of its
+// first ABCI CheckTx, frees its in-flight slot and reports the response to cb.
+//
+// The caller must hold txmp.mtx exclusively; it is released before calling cb.
+func (txmp *TxMempool) handleCheckTxResponse(
+	wtx *WrappedTx,
+	checkTxRes *abci.ResponseCheckTx,
+	cb func(*abci.ResponseCheckTx),
+) {
+	added := false
+	if wtx.height != txmp.height {
+		// A block was committed while the transaction was in flight, so the
+		// response may not reflect the current state and the transaction may
+		// even have been included. Reject it, keeping it in the cache so that
+		// it is not checked again if it was committed.
+		txmp.logger.Debug(
+			"rejected transaction checked against a previous height",
+			"tx", fmt.Sprintf("%X", wtx.tx.Hash()),
+			"height", wtx.height,
+		)
+		checkTxRes = mempool.CheckTxStaleResponse(wtx.height)
+	} else {
+		// This won't add the transaction if the response code is non zero
+		// (i.e. there was an error)
+		added = txmp.addNewTransaction(wtx, checkTxRes)
+	}
+	txmp.releaseInFlight()
+	txmp.Unlock()
+	// Published once the lock is released, so that a slow subscriber doesn't
+	// hold up the mempool.
+	if added {
+		if err := txmp.events.PublishEventNewMempoolTx(types.NewEventDataNewMempoolTx(wtx.tx, checkTxRes)); err != nil {
+			txmp.logger.Error("failed publishing new mempool tx event", "err", err)
+		}
+	}
+	if cb != nil {
+		cb(checkTxRes)
+	}
+}
+
+// releaseInFlight frees the in-flight slot of a handled CheckTx request.
+//
+// The caller must hold txmp.mtx exclusively.
+func (txmp *TxMempool) releaseInFlight() {
+	<-txmp.inFlight
+	txmp.inFlightCount--
+	if txmp.inFlightCount == 0 {
+		txmp.inFlightCond.Broadcast()
+	}
+}
+
 // RemoveTxByKey removes the transaction with the specified key from the
 // mempool. It reports an error if no such transaction exists.  This operation
 // does not remove the transaction from the cache.
@@ -261,7 +422,37 @@ func (txmp *TxMempool) GetTxByKey(txKey types.TxKey) (types.Tx, bool) {
 // WasRecentlyEvicted returns a bool indicating whether the transaction with
 // the specified key was recently evicted and is currently within the evicted cache.
 func (txmp *TxMempool) WasRecentlyEvicted(txKey types.TxKey) bool {
-	return txmp.evictedTxs.HasKey(txKey)
+	return txmp.evictedTxs.Has(txKey)
+}
+
+// PendingTxInfo returns the priority of the transaction with the specified
+// key and the number of transactions which would be reaped before it.
+func (txmp *TxMempool) PendingTxInfo(txKey types.TxKey) (int64, int, bool) {
+	txmp.mtx.RLock()
+	defer txmp.mtx.RUnlock()
+
+	elt, ok := txmp.txByKey[txKey]
+	if !ok {
+		return 0, 0, false
+	}
+	wtx := elt.Value.(*WrappedTx)
+	position := txmp.orderedTxIndex(wtx)
+	if position < 0 {
+		return 0, 0, false
+	}
+	return wtx.priority, position, true
+}
+
+// EvictionReason returns why the transaction with the specified key was
+// recently evicted.
+func (txmp *TxMempool) EvictionReason(txKey types.TxKey) (string, bool) {
+	return txmp.evictedTxs.Reason(txKey)
+}
+
+// FeeMarketStats returns the priorities of the transactions admitted to and
+// committed from the mempool over the configured fee market window.
+func (txmp *TxMempool) FeeMarketStats() mempool.FeeMarketStats {
+	return txmp.feeMarket.Stats()
 }
 
 // removeTxByKey removes the specified transaction key from the mempool.
@@ -271,10 +462,12 @@ func (txmp *TxMempool) removeTxByKey(key types.TxKey) error {
 		w := elt.Value.(*WrappedTx)
 		delete(txmp.txByKey, key)
 		delete(txmp.txBySender, w.sender)
+		txmp.deleteOrderedTx(w)
 		txmp.txs.Remove(elt)
 		elt.DetachPrev()
 		elt.DetachNext()
 		atomic.AddInt64(&txmp.txsBytes, -w.Size())
+		atomic.AddInt64(&txmp.txsMemory, -w.memory())
 		return nil
 	}
 	return fmt.Errorf("transaction %x not found", key)
@@ -286,10 +479,12 @@ func (txmp *TxMempool) removeTxByElement(elt *clist.CElement) {
 	w := elt.Value.(*WrappedTx)
 	delete(txmp.txByKey, w.tx.Key())
 	delete(txmp.txBySender, w.sender)
+	txmp.deleteOrderedTx(w)
 	txmp.txs.Remove(elt)
 	elt.DetachPrev()
 	elt.DetachNext()
 	atomic.AddInt64(&txmp.txsBytes, -w.Size())
+	atomic.AddInt64(&txmp.txsMemory, -w.memory())
 }
 
 // Flush purges the contents of the mempool and the cache, leaving both empty.
@@ -307,6 +502,7 @@ func (txmp *TxMempool) Flush() {
 		cur = next
 	}
 	txmp.cache.Reset()
+	txmp.committed.Reset()
 }
 
 // allEntriesSorted returns a slice of all the transactions currently in the
@@ -329,6 +525,27 @@ func (txmp *TxMempool) allEntriesSorted() []*WrappedTx {
 	return all
 }
 
+// summary returns a compact summary of the mempool to be sent to peers. The
+// mempool is reported as full when it has no room left for another
+// transaction without evicting a lower-priority one.
+func (txmp *TxMempool) summary() *protomem.Summary {
+	txmp.mtx.RLock()
+	defer txmp.mtx.RUnlock()
+
+	numTxs, txBytes := txmp.Size(), txmp.SizeBytes()
+	s := &protomem.Summary{
+		NumTxs:   int64(numTxs),
+		NumBytes: txBytes,
+		Full:     numTxs >= txmp.config.Size || txBytes >= txmp.config.MaxTxsBytes,
+	}
+	for cur, first := txmp.txs.Front(), true; cur != nil; cur, first = cur.Next(), false {
+		if p := cur.Value.(*WrappedTx).Priority(); first || p < s.MinPriority {
+			s.MinPriority = p
+		}
+	}
+	return s
+}
+
 // ReapMaxBytesMaxGas returns a slice of valid transactions that fit within the
 // size and gas constraints. The results are ordered by nonincreasing priority,
 // with ties broken by increasing order of arrival.  Reaping transactions does
@@ -341,6 +558,7 @@ func (txmp *TxMempool) allEntriesSorted() []*WrappedTx {
 // constraints, the result will also be empty.
 func (txmp *TxMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	var totalGas, totalBytes int64
+	reaper := txmp.reapQuota.Reaper(maxBytes)
 
 	var keep []types.Tx //nolint:prealloc
 	for _, w := range txmp.allEntriesSorted() {
@@ -351,6 +569,10 @@ func (txmp *TxMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 		if (maxGas >= 0 && totalGas+w.gasWanted > maxGas) || (maxBytes >= 0 && totalBytes+txBytes > maxBytes) {
 			continue
 		}
+		// Skip txs whose class already took its share of maxBytes.
+		if !reaper.Add(w.tx, []byte(w.Sender()), txBytes) {
+			continue
+		}
 		totalBytes += txBytes
 		totalGas += w.gasWanted
 		keep = append(keep, w.tx)
@@ -431,9 +653,16 @@ func (txmp *TxMempool) Update(
 			txmp.cache.Remove(tx)
 		}
 
+		if elt, ok := txmp.txByKey[tx.Key()]; ok {
+			txmp.feeMarket.RecordIncluded(elt.Value.(*WrappedTx).Priority())
+		}
+
 		// Regardless of success, remove the transaction from the mempool.
 		_ = txmp.removeTxByKey(tx.Key())
 	}
+	txmp.feeMarket.Commit()
+	txmp.feeMarket.ObserveMetrics(txmp.metrics)
+	txmp.committed.Commit(blockHeight, blockTxs, deliverTxResponses)
 
 	txmp.purgeExpiredTxs(blockHeight)
 
@@ -443,6 +672,7 @@ func (txmp *TxMempool) Update(
 	size := txmp.Size()
 	txmp.metrics.Size.Set(float64(size))
 	txmp.metrics.SizeBytes.Set(float64(txmp.SizeBytes()))
+	txmp.metrics.MemoryBytes.Set(float64(txmp.MemoryBytes()))
 	if size > 0 {
 		if txmp.config.Recheck {
 			txmp.recheckTransactions()
@@ -465,8 +695,9 @@ func (txmp *TxMempool) Update(
 // exist, this transaction is logged and dropped; otherwise the selected
 // transactions are evicted.
 //
-// Finally, the new transaction is added and size stats updated.
-func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.ResponseCheckTx) {
+// Finally, the new transaction is added and size stats updated. It reports
+// whether the transaction was added.
+func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.ResponseCheckTx) bool {
 	var err error
 	if txmp.postCheckFn != nil {
 		err = txmp.postCheckFn(wtx.tx, checkTxRes)
@@ -490,7 +721,7 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 			txmp.cache.Remove(wtx.tx)
 		}
 
-		return
+		return false
 	}
 
 	priority := checkTxRes.Priority
@@ -508,7 +739,7 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 				"tx", fmt.Sprintf("%X", w.tx.Hash()),
 				"sender", sender,
 			)
-			return
+			return false
 		}
 	}
 
@@ -518,21 +749,26 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 	// of them as necessary to make room for tx. If no such items exist, we
 	// discard tx.
 
+	wtx.SetGasWanted(checkTxRes.GasWanted)
+	wtx.SetPriority(priority)
+	wtx.SetSender(string(sender))
 	if err := txmp.canAddTx(wtx); err != nil {
 		var victims []*clist.CElement // eligible transactions for eviction
 		var victimBytes int64         // total size of victims
+		var victimMemory int64        // total memory used by victims
 		for cur := txmp.txs.Front(); cur != nil; cur = cur.Next() {
 			cw := cur.Value.(*WrappedTx)
 			if cw.priority < priority {
 				victims = append(victims, cur)
 				victimBytes += cw.Size()
+				victimMemory += cw.memory()
 			}
 		}
 
 		// If there are no suitable eviction candidates, or the total size of
 		// those candidates is not enough to make room for the new transaction,
 		// drop the new one.
-		if len(victims) == 0 || victimBytes < wtx.Size() {
+		if len(victims) == 0 || victimBytes < wtx.Size() || victimMemory < txmp.memoryToFree(wtx) {
 			txmp.cache.Remove(wtx.tx)
 			txmp.logger.Error(
 				"rejected valid incoming transaction; mempool is full",
@@ -540,9 +776,11 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 				"err", err.Error(),
 			)
 			txmp.metrics.EvictedTxs.Add(1)
+			txmp.feeMarket.RecordEvicted(1)
 			// Add it to evicted transactions cache
-			txmp.evictedTxs.Push(wtx.tx)
-			return
+			txmp.evictedTxs.Push(wtx.hash, mempool.EvictionReasonFull)
+			txmp.evictionHook.Evicted(wtx.tx, mempool.EvictionReasonFull)
+			return false
 		}
 
 		txmp.logger.Debug("evicting lower-priority transactions",
@@ -562,7 +800,8 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 		})
 
 		// Evict as many of the victims as necessary to make room.
-		var evictedBytes int64
+		var evictedBytes, evictedMemory int64
+		memoryToFree := txmp.memoryToFree(wtx)
 		for _, vic := range victims {
 			w := vic.Value.(*WrappedTx)
 
@@ -574,25 +813,27 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 			txmp.removeTxByElement(vic)
 			txmp.cache.Remove(w.tx)
 			txmp.metrics.EvictedTxs.Add(1)
+			txmp.feeMarket.RecordEvicted(1)
 			// Add it to evicted transactions cache
-			txmp.evictedTxs.Push(w.tx)
+			txmp.evictedTxs.Push(w.hash, mempool.EvictionReasonPriority)
+			txmp.evictionHook.Evicted(w.tx, mempool.EvictionReasonPriority)
 			// We may not need to evict all the eligible transactions.  Bail out
 			// early if we have made enough room.
 			evictedBytes += w.Size()
-			if evictedBytes >= wtx.Size() {
+			evictedMemory += w.memory()
+			if evictedBytes >= wtx.Size() && evictedMemory >= memoryToFree {
 				break
 			}
 		}
 	}
 
-	wtx.SetGasWanted(checkTxRes.GasWanted)
-	wtx.SetPriority(priority)
-	wtx.SetSender(string(sender))
 	txmp.insertTx(wtx)
+	txmp.feeMarket.RecordAdmitted(priority)
 
 	txmp.metrics.TxSizeBytes.Observe(float64(wtx.Size()))
 	txmp.metrics.Size.Set(float64(txmp.Size()))
 	txmp.metrics.SizeBytes.Set(float64(txmp.SizeBytes()))
+	txmp.metrics.MemoryBytes.Set(float64(txmp.MemoryBytes()))
 	txmp.logger.Debug(
 		"inserted new valid transaction",
 		"priority", wtx.Priority(),
@@ -601,6 +842,7 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 		"num_txs", txmp.Size(),
 	)
 	txmp.notifyTxsAvailable()
+	return true
 }
 
 func (txmp *TxMempool) insertTx(wtx *WrappedTx) {
@@ -609,8 +851,55 @@ func (txmp *TxMempool) insertTx(wtx *WrappedTx) {
 	if s := wtx.Sender(); s != "" {
 		txmp.txBySender[s] = elt
 	}
+	txmp.orderTx(wtx)
 
 	atomic.AddInt64(&txmp.txsBytes, wtx.Size())
+	atomic.AddInt64(&txmp.txsMemory, wtx.memory())
+}
+
+// orderTx inserts wtx in txmp.orderedTxs, after the transactions with a
+// higher priority or the same priority and an earlier arrival.
+// The caller must hold txmp.mtx exclusively.
+func (txmp *TxMempool) orderTx(wtx *WrappedTx) {
+	idx := txmp.getTxOrder(wtx)
+	txmp.orderedTxs = slices.Insert(txmp.orderedTxs, idx, wtx)
+}
+
+// deleteOrderedTx removes wtx from txmp.orderedTxs.
+// The caller must hold txmp.mtx exclusively.
+func (txmp *TxMempool) deleteOrderedTx(wtx *WrappedTx) {
+	if idx := txmp.orderedTxIndex(wtx); idx >= 0 {
+		txmp.orderedTxs = slices.Delete(txmp.orderedTxs, idx, idx+1)
+	}
+}
+
+// orderedTxIndex returns the index of wtx in txmp.orderedTxs, or -1 if it is
+// not there. The caller must hold txmp.mtx.
+func (txmp *TxMempool) orderedTxIndex(wtx *WrappedTx) int {
+	// Transactions which arrived at the same time with the same priority are
+	// ordered by insertion: look for wtx among them, from the last one.
+	for i := txmp.getTxOrder(wtx) - 1; i >= 0; i-- {
+		w := txmp.orderedTxs[i]
+		if w == wtx {
+			return i
+		}
+		if w.priority != wtx.priority || !w.timestamp.Equal(wtx.timestamp) {
+			break
+		}
+	}
+	return -1
+}
+
+// getTxOrder returns the index in txmp.orderedTxs after the last transaction
+// reaped no later than wtx. The caller must hold txmp.mtx.
+func (txmp *TxMempool) getTxOrder(wtx *WrappedTx) int {
+	return sort.Search(len(txmp.orderedTxs), func(i int) bool {
+		w := txmp.orderedTxs[i]
+		if w.priority == wtx.priority {
+			return wtx.timestamp.Before(w.timestamp)
+		}
+		return w.priority < wtx.priority // N.B. higher priorities first
+	})
 }
 
 // handleRecheckResult handles the responses from ABCI CheckTx calls issued
@@ -638,7 +927,12 @@ func (txmp *TxMempool) handleRecheckResult(tx types.Tx, checkTxRes *abci.Respons
 	}
 
 	if checkTxRes.Code == abci.CodeTypeOK && err == nil {
-		wtx.SetPriority(checkTxRes.Priority)
+		if checkTxRes.Priority != wtx.Priority() {
+			// Move the transaction to its new place in the reaping order.
+			txmp.deleteOrderedTx(wtx)
+			wtx.SetPriority(checkTxRes.Priority)
+			txmp.orderTx(wtx)
+		}
 		return // N.B. Size of mempool did not change
 	}
 
@@ -656,6 +950,7 @@ func (txmp *TxMempool) handleRecheckResult(tx types.Tx, checkTxRes *abci.Respons
 	}
 	txmp.metrics.Size.Set(float64(txmp.Size()))
 	txmp.metrics.SizeBytes.Set(float64(txmp.SizeBytes()))
+	txmp.metrics.MemoryBytes.Set(float64(txmp.MemoryBytes()))
 }
 
 // recheckTransactions initiates re-CheckTx ABCI calls for all the transactions
@@ -708,18 +1003,29 @@ func (txmp *TxMempool) canAddTx(wtx *WrappedTx) error {
 	numTxs := txmp.Size()
 	txBytes := txmp.SizeBytes()
 
-	if numTxs >= txmp.config.Size || wtx.Size()+txBytes > txmp.config.MaxTxsBytes {
+	if numTxs >= txmp.config.Size || wtx.Size()+txBytes > txmp.config.MaxTxsBytes || txmp.memoryToFree(wtx) > 0 {
 		return mempool.ErrMempoolIsFull{
-			NumTxs:      numTxs,
-			MaxTxs:      txmp.config.Size,
-			TxsBytes:    txBytes,
-			MaxTxsBytes: txmp.config.MaxTxsBytes,
+			NumTxs:         numTxs,
+			MaxTxs:         txmp.config.Size,
+			TxsBytes:       txBytes,
+			MaxTxsBytes:    txmp.config.MaxTxsBytes,
+			MemoryBytes:    txmp.MemoryBytes(),
+			MaxMemoryBytes: txmp.config.MaxMemoryBytes,
 		}
 	}
 
 	return nil
 }
 
+// memoryToFree returns the memory to free for wtx to fit in the memory cap
+// of the mempool, or zero if it fits or the memory is not capped.
+func (txmp *TxMempool) memoryToFree(wtx *WrappedTx) int64 {
+	if txmp.config.MaxMemoryBytes == 0 {
+		return 0
+	}
+	return max(0, txmp.MemoryBytes()+wtx.memory()-txmp.config.MaxMemoryBytes)
+}
+
 // CheckToPurgeExpiredTxs checks if there has been adequate time since the last time
 // the txpool looped through all transactions and if so, performs a purge of any transaction
 // that has expired according to the TTLDuration. This is thread safe.
@@ -753,7 +1059,8 @@ func (txmp *TxMempool) purgeExpiredTxs(blockHeight int64) {
 			txmp.config.TTLDuration > 0 && now.Sub(w.timestamp) > txmp.config.TTLDuration {
 			txmp.removeTxByElement(cur)
 			txmp.cache.Remove(w.tx)
-			txmp.evictedTxs.Push(w.tx)
+			txmp.evictedTxs.Push(w.hash, mempool.EvictionReasonExpired)
+			txmp.evictionHook.Evicted(w.tx, mempool.EvictionReasonExpired)
 			txmp.metrics.ExpiredTxs.Add(1)
 		}
 		cur = next
@@ -779,6 +1086,13 @@ func (txmp *TxMempool) notifyTxsAvailable() {
 }
 
 func (txmp *TxMempool) preCheck(tx types.Tx) error {
+	// the admission filter is never replaced, so it doesn't need the lock
+	if txmp.admissionFn != nil {
+		if err := txmp.admissionFn(tx); err != nil {
+			return err
+		}
+	}
+
 	txmp.mtx.Lock()
 	defer txmp.mtx.Unlock()
 	if txmp.preCheckFn != nil {
@@ -17,8 +17,10 @@ import (
 	share "github.com/celestiaorg/go-square/v2/share"
 	db "github.com/cometbft/cometbft-db"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	abcicli "github.com/cometbft/cometbft/abci/client"
 	"github.com/cometbft/cometbft/abci/example/kvstore"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/config"
@@ -27,6 +29,7 @@ import (
 	"github.com/cometbft/cometbft/mempool"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/proxy"
+	proxymocks "github.com/cometbft/cometbft/proxy/mocks"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -215,6 +218,28 @@ func TestTxMempool_Size(t *testing.T) {
 	require.Equal(t, int64(2900), txmp.SizeBytes())
 }
 
+func TestTxMempool_Summary(t *testing.T) {
+	txmp := setup(t, 1000)
+	txmp.config.Size = 3
+
+	s := txmp.summary()
+	require.Zero(t, s.NumTxs)
+	require.False(t, s.Full)
+
+	mustCheckTx(t, txmp, "key1=0000=25")
+	mustCheckTx(t, txmp, "key2=0001=5")
+	s = txmp.summary()
+	require.EqualValues(t, 2, s.NumTxs)
+	require.Equal(t, txmp.SizeBytes(), s.NumBytes)
+	require.EqualValues(t, 5, s.MinPriority)
+	require.False(t, s.Full)
+
+	mustCheckTx(t, txmp, "key3=0002=10")
+	s = txmp.summary()
+	require.EqualValues(t, 5, s.MinPriority)
+	require.True(t, s.Full)
+}
+
 func TestTxMempool_Eviction(t *testing.T) {
 	txmp := setup(t, 1000)
 	txmp.config.Size = 5
@@ -248,6 +273,8 @@ func TestTxMempool_Eviction(t *testing.T) {
 	bigTxKey := types.Tx((bigTx)).Key()
 	require.False(t, txmp.cache.HasKey(bigTxKey))
 	require.True(t, txmp.WasRecentlyEvicted(bigTxKey)) // bigTx evicted
+	reason, _ := txmp.EvictionReason(bigTxKey)
+	require.Equal(t, mempool.EvictionReasonPriority, reason)
 	require.Equal(t, int64(len("key1=0000=25")), txmp.SizeBytes())
 
 	// Now fill up the rest of the slots with other transactions.
@@ -256,10 +283,18 @@ func TestTxMempool_Eviction(t *testing.T) {
 	mustCheckTx(t, txmp, "key4=0003=3")
 	mustCheckTx(t, txmp, "key5=0004=3")
 
+	// key3 is reaped after key1 only.
+	priority, position, ok := txmp.PendingTxInfo(types.Tx("key3=0002=10").Key())
+	require.True(t, ok)
+	require.Equal(t, int64(10), priority)
+	require.Equal(t, 1, position)
+
 	// A new transaction with low priority should be discarded.
 	mustCheckTx(t, txmp, "key6=0005=1")
 	require.False(t, txExists("key6=0005=1"))
 	require.True(t, txmp.WasRecentlyEvicted(types.Tx(("key6=0005=1")).Key())) // key6 evicted
+	reason, _ = txmp.EvictionReason(types.Tx("key6=0005=1").Key())
+	require.Equal(t, mempool.EvictionReasonFull, reason)
 
 	// A new transaction with higher priority should evict key5, which is the
 	// newest of the two transactions with lowest priority.
@@ -292,6 +327,65 @@ func TestTxMempool_Eviction(t *testing.T) {
 	require.True(t, txmp.WasRecentlyEvicted(types.Tx(("key9=0008=9")).Key())) // key9 evicted
 	require.False(t, txExists("key7=0006=7"))
 	require.True(t, txmp.WasRecentlyEvicted(types.Tx(("key7=0006=7")).Key())) // key7 evicted
+
+	// The positions follow the evictions.
+	_, position, ok = txmp.PendingTxInfo(types.Tx("key10=0123456789abcdef=11").Key())
+	require.True(t, ok)
+	require.Equal(t, 2, position)
+}
+
+func TestTxMempool_EvictionHook(t *testing.T) {
+	var evicted []string
+	hook := &mempool.EvictionHook{}
+	hook.SetCallback(func(tx types.Tx, reason string) {
+		evicted = append(evicted, string(tx)+" "+reason)
+	})
+	txmp := setup(t, 1000, WithEvictionHook(hook))
+	txmp.config.MaxTxsBytes = int64(len("key2=0001=10"))
+	txmp.config.TTLNumBlocks = 1
+
+	mustCheckTx(t, txmp, "key1=0000=05")
+	mustCheckTx(t, txmp, "key2=0001=10")
+	mustCheckTx(t, txmp, "key3=0002=01")
+
+	txmp.Lock()
+	require.NoError(t, txmp.Update(3, nil, nil, nil, nil))
+	txmp.Unlock()
+
+	require.Equal(t, []string{
+		"key1=0000=05 " + mempool.EvictionReasonPriority,
+		"key3=0002=01 " + mempool.EvictionReasonFull,
+		"key2=0001=10 " + mempool.EvictionReasonExpired,
+	}, evicted)
+}
+
+func TestTxMempool_MemoryCap(t *testing.T) {
+	txmp := setup(t, 1000)
+	// All the transactions have the same size and sender size.
+	txMemory := (&WrappedTx{tx: types.Tx("key1=0000=05"), sender: "key1"}).memory()
+	require.Greater(t, txMemory, int64(len("key1=0000=05")))
+	txmp.config.MaxMemoryBytes = 2*txMemory + 1
+
+	mustCheckTx(t, txmp, "key1=0000=05")
+	mustCheckTx(t, txmp, "key2=0001=10")
+	require.Equal(t, 2, txmp.Size())
+	require.Equal(t, 2*txMemory, txmp.MemoryBytes())
+
+	// A new transaction with lower priority does not fit in the memory cap.
+	mustCheckTx(t, txmp, "key3=0002=01")
+	require.Equal(t, 2, txmp.Size())
+	reason, _ := txmp.EvictionReason(types.Tx("key3=0002=01").Key())
+	require.Equal(t, mempool.EvictionReasonFull, reason)
+
+	// A new transaction with higher priority evicts the lowest-priority one.
+	mustCheckTx(t, txmp, "key4=0003=20")
+	require.Equal(t, 2, txmp.Size())
+	reason, _ = txmp.EvictionReason(types.Tx("key1=0000=05").Key())
+	require.Equal(t, mempool.EvictionReasonPriority, reason)
+	require.Equal(t, 2*txMemory, txmp.MemoryBytes())
+
+	txmp.Flush()
+	require.Zero(t, txmp.MemoryBytes())
 }
 
 func TestTxMempool_Flush(t *testing.T) {
@@ -498,6 +592,128 @@ func TestTxMempool_CheckTxSameSender(t *testing.T) {
 	require.Equal(t, 1, txmp.Size())
 }
 
+func TestTxMempool_CheckTxInFlightWindow(t *testing.T) {
+	txmp := setup(t, 100)
+	require.Equal(t, txmp.config.MaxInFlightCheckTx, cap(txmp.inFlight))
+
+	// occupy every slot of the window
+	for i := 0; i < cap(txmp.inFlight); i++ {
+		txmp.inFlight <- struct{}{}
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- txmp.CheckTx([]byte("sender=key=1"), nil, mempool.TxInfo{})
+	}()
+	select {
+	case <-done:
+		t.Fatal("CheckTx should wait for a free slot")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	<-txmp.inFlight
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("CheckTx should proceed once a slot is free")
+	}
+	require.Equal(t, 1, txmp.Size())
+}
+
+func TestTxMempool_CheckTxResponseAfterUpdate(t *testing.T) {
+	txmp := setup(t, 100)
+	tx := types.Tx("sender=key=1")
+	wtx := &WrappedTx{tx: tx, hash: tx.Key(), height: txmp.height}
+	require.True(t, txmp.cache.Push(tx))
+	txmp.inFlight <- struct{}{}
+	txmp.inFlightCount++
+
+	// a block is committed while the transaction is in flight
+	txmp.Lock()
+	require.NoError(t, txmp.Update(1, nil, nil, nil, nil))
+
+	var res *abci.ResponseCheckTx
+	txmp.handleCheckTxResponse(wtx, &abci.ResponseCheckTx{Code: abci.CodeTypeOK}, func(r *abci.ResponseCheckTx) {
+		res = r
+	})
+	require.NotNil(t, res)
+	require.Equal(t, mempool.CodeTypeCheckTxStale, res.Code)
+	require.Zero(t, txmp.Size())
+	require.True(t, txmp.cache.Has(tx))
+	require.Zero(t, len(txmp.inFlight))
+}
+
+func TestTxMempool_FlushAppConnHoldsNewTxs(t *testing.T) {
+	txmp := setup(t, 100)
+	tx := types.Tx("sender=key=1")
+	wtx := &WrappedTx{tx: tx, hash: tx.Key(), height: txmp.height}
+	require.True(t, txmp.cache.Push(tx))
+	txmp.inFlight <- struct{}{}
+	txmp.inFlightCount++
+
+	flushed := make(chan error, 1)
+	go func() {
+		txmp.Lock()
+		err := txmp.FlushAppConn()
+		txmp.Unlock()
+		flushed <- err
+	}()
+	require.Eventually(t, func() bool {
+		txmp.Lock()
+		defer txmp.Unlock()
+		return txmp.flushing
+	}, time.Second, 10*time.Millisecond)
+
+	// a transaction submitted while flushing waits for the flush to complete
+	done := make(chan error, 1)
+	go func() {
+		done <- txmp.CheckTx([]byte("sender=key=2"), nil, mempool.TxInfo{})
+	}()
+	select {
+	case <-done:
+		t.Fatal("CheckTx should wait for the flush")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	txmp.Lock()
+	txmp.handleCheckTxResponse(wtx, &abci.ResponseCheckTx{Code: abci.CodeTypeOK}, nil)
+	require.NoError(t, <-flushed)
+	require.NoError(t, <-done)
+}
+
+func TestTxMempool_CheckTxFailedResponse(t *testing.T) {
+	cfg := internaltest.ResetTestRoot(strings.ReplaceAll(t.Name(), "/", "|"))
+	t.Cleanup(func() { os.RemoveAll(cfg.RootDir) })
+
+	reqRes := abcicli.NewReqRes(abci.ToRequestCheckTx(&abci.RequestCheckTx{Tx: []byte("sender=key=1")}))
+	appConnMem := &proxymocks.AppConnMempool{}
+	appConnMem.On("Error").Return(nil)
+	appConnMem.On("CheckTxAsync", mock.Anything, mock.Anything).Return(reqRes, nil)
+	appConnMem.On("Flush", mock.Anything).Return(nil)
+	txmp := NewTxMempool(log.TestingLogger(), cfg.Mempool, appConnMem, 0)
+
+	var res *abci.ResponseCheckTx
+	require.NoError(t, txmp.CheckTx([]byte("sender=key=1"), func(r *abci.ResponseCheckTx) {
+		res = r
+	}, mempool.TxInfo{}))
+
+	// the client is stopped before the application responds
+	reqRes.Response = abci.ToResponseException("client stopped")
+	reqRes.InvokeCallback()
+
+	require.NotNil(t, res)
+	assert.Equal(t, mempool.CodeTypeCheckTxFailed, res.Code)
+	assert.Equal(t, "client stopped", res.Log)
+	assert.Zero(t, txmp.Size())
+
+	// and the request is no longer waited for
+	txmp.Lock()
+	require.NoError(t, txmp.FlushAppConn())
+	txmp.Unlock()
+	assert.Zero(t, len(txmp.inFlight))
+}
+
 func TestTxMempool_ConcurrentTxs(t *testing.T) {
 	txmp := setup(t, 100)
 	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
@@ -829,3 +1045,20 @@ func TestConcurrentCheckTxDataRace(t *testing.T) {
 	// Wait for the test goroutine to complete
 	wg.Wait()
 }
+
+func TestTxMempool_AdmissionFilter(t *testing.T) {
+	rejected := types.Tx("rejected=1")
+	txmp := setup(t, 0, WithAdmissionFilter(func(tx types.Tx) error {
+		if bytes.Equal(tx, rejected) {
+			return errors.New("rejected")
+		}
+		return nil
+	}))
+
+	require.True(t, mempool.IsPreCheckError(txmp.CheckTx(rejected, nil, mempool.TxInfo{})))
+
+	// unlike the pre-check, the admission filter survives updates
+	require.NoError(t, txmp.Update(1, nil, nil, nil, nil))
+	require.True(t, mempool.IsPreCheckError(txmp.CheckTx(rejected, nil, mempool.TxInfo{})))
+	require.NoError(t, txmp.CheckTx(types.Tx("accepted=1"), nil, mempool.TxInfo{}))
+}
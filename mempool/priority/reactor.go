@@ -11,10 +11,21 @@ import (
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/peerdata"
 	protomem "github.com/cometbft/cometbft/proto/tendermint/mempool"
 	"github.com/cometbft/cometbft/types"
 )
 
+// peerSummaryKey is the key under which the latest mempool summary received
+// from a peer is stored in the peer's data store.
+var peerSummaryKey = peerdata.Register[peerSummary]("MempoolReactor.summary")
+
+// peerSummary is the latest mempool summary received from a peer.
+type peerSummary struct {
+	summary  *protomem.Summary
+	received time.Time
+}
+
 // Reactor handles mempool tx broadcasting amongst peers.
 // It maintains a map from peer ID to counter, to prevent gossiping txs to the
 // peers you received it from.
@@ -23,6 +34,11 @@ type Reactor struct {
 	config  *cfg.MempoolConfig
 	mempool *TxMempool
 	ids     *mempoolIDs
+
+	// Dequeue the broadcast routines of the peers waiting for one of the
+	// max-broadcast-routines to return.
+	queuedMtx cmtsync.Mutex
+	queued    map[p2p.ID]func()
 }
 
 type mempoolIDs struct {
@@ -94,6 +110,7 @@ func NewReactor(config *cfg.MempoolConfig, mempool *TxMempool) *Reactor {
 		config:  config,
 		mempool: mempool,
 		ids:     newMempoolIDs(),
+		queued:  make(map[p2p.ID]func()),
 	}
 	memR.BaseReactor = *p2p.NewBaseReactor("Mempool", memR)
 	return memR
@@ -131,6 +148,10 @@ func (memR *Reactor) OnStart() error {
 		}()
 	}
 
+	if interval := memR.config.SummaryGossipInterval; interval > 0 {
+		go memR.broadcastSummaryRoutine(interval)
+	}
+
 	return nil
 }
 
@@ -150,21 +171,38 @@ func (memR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			Priority:            5,
 			RecvMessageCapacity: batchMsg.Size(),
 			MessageType:         &protomem.Message{},
+			Compression:         p2p.CompressionZstd,
 		},
 	}
 }
 
 // AddPeer implements Reactor.
 // It starts a broadcast routine ensuring all txs are forwarded to the given peer.
+// Once max-broadcast-routines run, the routine starts when one of them returns,
+// unless the peer is persistent or unconditional.
 func (memR *Reactor) AddPeer(peer p2p.Peer) {
 	if memR.config.Broadcast {
-		go memR.broadcastTxRoutine(peer)
+		broadcast := func() { memR.broadcastTxRoutine(peer) }
+		if peer.IsPersistent() || memR.Switch.IsPeerUnconditional(peer.ID()) {
+			memR.Go(mempool.PersistentBroadcastRoutines, broadcast)
+			return
+		}
+		dequeue := memR.GoQueued(mempool.BroadcastRoutines, broadcast)
+		memR.queuedMtx.Lock()
+		memR.queued[peer.ID()] = dequeue
+		memR.queuedMtx.Unlock()
 	}
 }
 
 // RemovePeer implements Reactor.
 func (memR *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
 	memR.ids.Reclaim(peer)
+	memR.queuedMtx.Lock()
+	if dequeue, ok := memR.queued[peer.ID()]; ok {
+		dequeue()
+		delete(memR.queued, peer.ID())
+	}
+	memR.queuedMtx.Unlock()
 	// broadcast routine checks if peer is gone and returns
 }
 
@@ -190,10 +228,19 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 			err = memR.mempool.CheckTx(ntx, nil, txInfo)
 			if errors.Is(err, mempool.ErrTxInCache) {
 				memR.Logger.Debug("Tx already exists in cache", "tx", ntx.String())
+			} else if errors.Is(err, mempool.ErrTxCommitted) {
+				// using debug level to avoid flooding when traffic is high
+				memR.Logger.Debug("Peer sent committed tx", "tx", log.NewLazySprintf("%X", ntx.Hash()), "peer", e.Src)
 			} else if err != nil {
 				memR.Logger.Info("Could not check tx", "tx", ntx.String(), "err", err)
+			} else if e.Src != nil {
+				memR.Switch.PeerScorer().RecordUsefulTx(e.Src.ID())
 			}
 		}
+	case *protomem.Summary:
+		if e.Src != nil {
+			peerSummaryKey.Set(e.Src.Data(), peerSummary{summary: msg, received: time.Now()})
+		}
 	default:
 		memR.Logger.Error("unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", e.Message)
 		memR.Switch.StopPeerForError(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", e.Message))
@@ -204,9 +251,7 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 }
 
 // PeerState describes the state of a peer.
-type PeerState interface {
-	GetHeight() int64
-}
+type PeerState = types.PeerState
 
 // Send new mempool txs to peer.
 func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
@@ -238,15 +283,9 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 		}
 
 		// Make sure the peer is up to date.
-		peerState, ok := peer.Get(types.PeerStateKey).(PeerState)
+		peerState, ok := types.PeerStateKey.Wait(peer.Data(), peer.Quit())
 		if !ok {
-			// Peer does not have a state yet. We set it in the consensus reactor, but
-			// when we add peer in Switch, the order we call reactors#AddPeer is
-			// different every time due to us using a map. Sometimes other reactors
-			// will be initialized before the consensus reactor. We should wait a few
-			// milliseconds and retry.
-			time.Sleep(mempool.PeerCatchupSleepIntervalMS * time.Millisecond)
-			continue
+			return
 		}
 
 		// Allow for a lag of 1 block.
@@ -258,7 +297,7 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 
 		// NOTE: Transaction batching was disabled due to
 		// https://github.com/tendermint/tendermint/issues/5796
-		if !memTx.HasPeer(peerID) {
+		if !memTx.HasPeer(peerID) && memR.peerAdmits(peer, memTx) {
 			success := peer.Send(p2p.Envelope{
 				ChannelID: mempool.MempoolChannel,
 				Message:   &protomem.Txs{Txs: [][]byte{memTx.tx}},
@@ -287,6 +326,40 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 	}
 }
 
+// broadcastSummaryRoutine sends a summary of the mempool to all peers every
+// interval.
+func (memR *Reactor) broadcastSummaryRoutine(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			memR.Switch.Broadcast(p2p.Envelope{
+				ChannelID: mempool.MempoolChannel,
+				Message:   memR.mempool.summary(),
+			})
+		case <-memR.Quit():
+			return
+		}
+	}
+}
+
+// peerAdmits reports whether the peer is expected to admit the transaction
+// according to the latest mempool summary it sent us. Summaries which were
+// not refreshed for a few intervals are ignored, as are all summaries when
+// summary gossip is disabled.
+func (memR *Reactor) peerAdmits(peer p2p.Peer, memTx *WrappedTx) bool {
+	interval := memR.config.SummaryGossipInterval
+	if interval == 0 {
+		return true
+	}
+	ps, ok := peerSummaryKey.Get(peer.Data())
+	if !ok || !ps.summary.Full || time.Since(ps.received) > 3*interval {
+		return true
+	}
+	return memTx.Priority() > ps.summary.MinPriority
+}
+
 //-----------------------------------------------------------------------------
 // Messages
 
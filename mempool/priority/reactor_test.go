@@ -57,7 +57,7 @@ func TestReactorBroadcastTxsMessage(t *testing.T) {
 	}()
 	for _, r := range reactors {
 		for _, peer := range r.Switch.Peers().List() {
-			peer.Set(types.PeerStateKey, peerState{1})
+			types.PeerStateKey.Set(peer.Data(), peerState{1})
 		}
 	}
 
@@ -126,6 +126,48 @@ func TestLegacyReactorReceiveBasic(t *testing.T) {
 	})
 }
 
+func TestReactorPeerAdmits(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.SummaryGossipInterval = time.Second
+	reactors := makeAndConnectReactors(config, 1)
+	var (
+		reactor = reactors[0]
+		peer    = mock.NewPeer(nil)
+		memTx   = &WrappedTx{priority: 10}
+	)
+	defer func() {
+		err := reactor.Stop()
+		assert.NoError(t, err)
+	}()
+
+	// no summary received yet
+	require.True(t, reactor.peerAdmits(peer, memTx))
+
+	reactor.Receive(p2p.Envelope{
+		ChannelID: mempool.MempoolChannel,
+		Message:   &memproto.Summary{NumTxs: 1, MinPriority: 10, Full: true},
+		Src:       peer,
+	})
+	require.False(t, reactor.peerAdmits(peer, memTx))
+	require.True(t, reactor.peerAdmits(peer, &WrappedTx{priority: 11}))
+
+	reactor.Receive(p2p.Envelope{
+		ChannelID: mempool.MempoolChannel,
+		Message:   &memproto.Summary{NumTxs: 1, MinPriority: 10, Full: false},
+		Src:       peer,
+	})
+	require.True(t, reactor.peerAdmits(peer, memTx))
+
+	// summaries are ignored when summary gossip is disabled
+	reactor.Receive(p2p.Envelope{
+		ChannelID: mempool.MempoolChannel,
+		Message:   &memproto.Summary{NumTxs: 1, MinPriority: 10, Full: true},
+		Src:       peer,
+	})
+	config.Mempool.SummaryGossipInterval = 0
+	require.True(t, reactor.peerAdmits(peer, memTx))
+}
+
 func makeAndConnectReactors(config *cfg.Config, n int) []*Reactor {
 	reactors := make([]*Reactor, n)
 	logger := mempoolLogger()
@@ -3,7 +3,9 @@ package priority
 import (
 	"sync"
 	"time"
+	"unsafe"
 
+	"github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -25,6 +27,19 @@ type WrappedTx struct {
 // Size reports the size of the raw transaction in bytes.
 func (w *WrappedTx) Size() int64 { return int64(len(w.tx)) }
 
+// memory returns the estimated memory used by w in the mempool, indexed by
+// key and, if it has one, by sender, and ordered by priority.
+func (w *WrappedTx) memory() int64 {
+	sender := w.Sender()
+	indexes := 1
+	if sender != "" {
+		indexes++
+	}
+	metadata := int64(unsafe.Sizeof(WrappedTx{})) + int64(len(sender)) + mempool.CListElementMemory +
+		int64(unsafe.Sizeof(w))
+	return mempool.EntryMemory(len(w.tx), metadata, indexes)
+}
+
 // SetPeer adds the specified peer ID as a sender of w.
 func (w *WrappedTx) SetPeer(id uint16) {
 	w.mtx.Lock()
@@ -3,6 +3,9 @@ package mempool
 import (
 	"context"
 	"errors"
+	"math/rand"
+	"slices"
+	"sort"
 	"time"
 
 	"fmt"
@@ -10,6 +13,7 @@ import (
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/clist"
 	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/p2p"
 	protomem "github.com/cometbft/cometbft/proto/tendermint/mempool"
 	"github.com/cometbft/cometbft/types"
@@ -30,6 +34,11 @@ type Reactor struct {
 	// connections for different groups of peers.
 	activePersistentPeersSemaphore    *semaphore.Weighted
 	activeNonPersistentPeersSemaphore *semaphore.Weighted
+
+	// Dequeue the broadcast routines of the peers waiting for one of the
+	// max-broadcast-routines to return.
+	queuedMtx cmtsync.Mutex
+	queued    map[p2p.ID]func()
 }
 
 // NewReactor returns a new Reactor with the given config and mempool.
@@ -38,6 +47,7 @@ func NewReactor(config *cfg.MempoolConfig, mempool *CListMempool) *Reactor {
 		config:  config,
 		mempool: mempool,
 		ids:     newMempoolIDs(),
+		queued:  make(map[p2p.ID]func()),
 	}
 	memR.BaseReactor = *p2p.NewBaseReactor("Mempool", memR)
 	memR.activePersistentPeersSemaphore = semaphore.NewWeighted(int64(memR.config.ExperimentalMaxGossipConnectionsToPersistentPeers))
@@ -82,15 +92,18 @@ func (memR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			Priority:            5,
 			RecvMessageCapacity: batchMsg.Size(),
 			MessageType:         &protomem.Message{},
+			Compression:         p2p.CompressionZstd,
 		},
 	}
 }
 
 // AddPeer implements Reactor.
 // It starts a broadcast routine ensuring all txs are forwarded to the given peer.
+// Once max-broadcast-routines run, the routine starts when one of them returns,
+// unless the peer is persistent or unconditional.
 func (memR *Reactor) AddPeer(peer p2p.Peer) {
 	if memR.config.Broadcast {
-		go func() {
+		broadcast := func() {
 			// Always forward transactions to unconditional peers.
 			if !memR.Switch.IsPeerUnconditional(peer.ID()) {
 				// Depending on the type of peer, we choose a semaphore to limit the gossiping peers.
@@ -125,13 +138,27 @@ func (memR *Reactor) AddPeer(peer p2p.Peer) {
 			memR.mempool.metrics.ActiveOutboundConnections.Add(1)
 			defer memR.mempool.metrics.ActiveOutboundConnections.Add(-1)
 			memR.broadcastTxRoutine(peer)
-		}()
+		}
+		if peer.IsPersistent() || memR.Switch.IsPeerUnconditional(peer.ID()) {
+			memR.Go(PersistentBroadcastRoutines, broadcast)
+			return
+		}
+		dequeue := memR.GoQueued(BroadcastRoutines, broadcast)
+		memR.queuedMtx.Lock()
+		memR.queued[peer.ID()] = dequeue
+		memR.queuedMtx.Unlock()
 	}
 }
 
 // RemovePeer implements Reactor.
 func (memR *Reactor) RemovePeer(peer p2p.Peer, _ interface{}) {
 	memR.ids.Reclaim(peer)
+	memR.queuedMtx.Lock()
+	if dequeue, ok := memR.queued[peer.ID()]; ok {
+		dequeue()
+		delete(memR.queued, peer.ID())
+	}
+	memR.queuedMtx.Unlock()
 	// broadcast routine checks if peer is gone and returns
 }
 
@@ -159,14 +186,21 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 				switch {
 				case errors.Is(err, ErrTxInCache):
 					memR.Logger.Debug("Tx already exists in cache", "tx", ntx.String())
+				case errors.Is(err, ErrTxCommitted):
+					// using debug level to avoid flooding when traffic is high
+					memR.Logger.Debug("Peer sent committed tx", "tx", log.NewLazySprintf("%X", ntx.Hash()), "peer", e.Src)
 				case errors.As(err, &ErrMempoolIsFull{}):
 					// using debug level to avoid flooding when traffic is high
 					memR.Logger.Debug(err.Error())
 				default:
 					memR.Logger.Info("Could not check tx", "tx", ntx.String(), "err", err)
 				}
+			} else if e.Src != nil {
+				memR.Switch.PeerScorer().RecordUsefulTx(e.Src.ID())
 			}
 		}
+	case *protomem.Summary:
+		// mempool summaries are only used by the priority mempool
 	default:
 		memR.Logger.Error("unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", e.Message)
 		memR.Switch.StopPeerForError(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", e.Message))
@@ -177,8 +211,12 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 }
 
 // PeerState describes the state of a peer.
-type PeerState interface {
-	GetHeight() int64
+type PeerState = types.PeerState
+
+// lazyTx is a tx withheld from a peer outside of the fanout until it is due.
+type lazyTx struct {
+	elem *clist.CElement
+	due  time.Time
 }
 
 // Send new mempool txs to peer.
@@ -186,6 +224,23 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 	peerID := memR.ids.GetForPeer(peer)
 	var next *clist.CElement
 
+	// Txs withheld from the peer, sorted by due time. They are pushed once due
+	// while the routine moves on to the next txs, so that they do not hold
+	// back the rest of the queue.
+	var lazy []lazyTx
+	lazyTimer := time.NewTimer(time.Hour)
+	lazyTimer.Stop()
+	defer lazyTimer.Stop()
+	var lazyDue <-chan time.Time
+	sendLazy := func() {
+		lazy = memR.sendLazyTxs(peer, peerID, lazy)
+		lazyDue = nil
+		if len(lazy) > 0 {
+			lazyTimer.Reset(time.Until(lazy[0].due))
+			lazyDue = lazyTimer.C
+		}
+	}
+
 	for {
 		// In case of both next.NextWaitChan() and peer.Quit() are variable at the same time
 		if !memR.IsRunning() || !peer.IsRunning() {
@@ -201,6 +256,9 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 				if next = memR.mempool.TxsFront(); next == nil {
 					continue
 				}
+			case <-lazyDue:
+				sendLazy()
+				continue
 			case <-peer.Quit():
 				return
 			case <-memR.Quit():
@@ -209,15 +267,9 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 		}
 
 		// Make sure the peer is up to date.
-		peerState, ok := peer.Get(types.PeerStateKey).(PeerState)
+		peerState, ok := types.PeerStateKey.Wait(peer.Data(), peer.Quit())
 		if !ok {
-			// Peer does not have a state yet. We set it in the consensus reactor, but
-			// when we add peer in Switch, the order we call reactors#AddPeer is
-			// different every time due to us using a map. Sometimes other reactors
-			// will be initialized before the consensus reactor. We should wait a few
-			// milliseconds and retry.
-			time.Sleep(PeerCatchupSleepIntervalMS * time.Millisecond)
-			continue
+			return
 		}
 
 		// Allow for a lag of 1 block.
@@ -231,26 +283,97 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 		// https://github.com/tendermint/tendermint/issues/5796
 
 		if !memTx.isSender(peerID) {
-			success := peer.Send(p2p.Envelope{
+			// Peers outside of the fanout get the tx once the dedup window
+			// has passed, unless they relay it to us in the meantime.
+			var delay time.Duration
+			if memR.pushLazily() {
+				delay = memR.lazyPushDelay(memTx)
+			}
+			if delay > 0 {
+				lazy = insertLazyTx(lazy, lazyTx{elem: next, due: time.Now().Add(delay)})
+				if lazy[0].elem == next {
+					lazyTimer.Reset(delay)
+					lazyDue = lazyTimer.C
+				}
+			} else {
+				success := peer.Send(p2p.Envelope{
+					ChannelID: MempoolChannel,
+					Message:   &protomem.Txs{Txs: [][]byte{memTx.tx}},
+				})
+				if !success {
+					time.Sleep(PeerCatchupSleepIntervalMS * time.Millisecond)
+					continue
+				}
+			}
+		}
+
+		// Wait for the next tx, pushing the withheld txs as they become due.
+		for waiting := true; waiting; {
+			select {
+			case <-next.NextWaitChan():
+				// see the start of the for loop for nil check
+				next = next.Next()
+				waiting = false
+			case <-lazyDue:
+				sendLazy()
+			case <-peer.Quit():
+				return
+			case <-memR.Quit():
+				return
+			}
+		}
+	}
+}
+
+// insertLazyTx inserts tx into lazy, keeping it sorted by due time.
+func insertLazyTx(lazy []lazyTx, tx lazyTx) []lazyTx {
+	i := sort.Search(len(lazy), func(i int) bool { return lazy[i].due.After(tx.due) })
+	return slices.Insert(lazy, i, tx)
+}
+
+// sendLazyTxs pushes the due txs of lazy to peer, skipping the ones removed
+// from the mempool or relayed to us by the peer since, and returns the txs
+// still withheld. The txs the peer's send queue is too full for are retried
+// later.
+func (memR *Reactor) sendLazyTxs(peer p2p.Peer, peerID uint16, lazy []lazyTx) []lazyTx {
+	now := time.Now()
+	for len(lazy) > 0 && !lazy[0].due.After(now) {
+		memTx := lazy[0].elem.Value.(*mempoolTx)
+		if !lazy[0].elem.Removed() && !memTx.isSender(peerID) {
+			if !peer.TrySend(p2p.Envelope{
 				ChannelID: MempoolChannel,
 				Message:   &protomem.Txs{Txs: [][]byte{memTx.tx}},
-			})
-			if !success {
-				time.Sleep(PeerCatchupSleepIntervalMS * time.Millisecond)
-				continue
+			}) {
+				lazy[0].due = now.Add(PeerCatchupSleepIntervalMS * time.Millisecond)
+				return insertLazyTx(lazy[1:], lazy[0])
 			}
 		}
+		lazy = lazy[1:]
+	}
+	return lazy
+}
 
-		select {
-		case <-next.NextWaitChan():
-			// see the start of the for loop for nil check
-			next = next.Next()
-		case <-peer.Quit():
-			return
-		case <-memR.Quit():
-			return
-		}
+// pushLazily reports whether a new tx should be pushed lazily to a peer,
+// drawing GossipFanout of the peers at random to push it to immediately.
+func (memR *Reactor) pushLazily() bool {
+	fanout := memR.config.GossipFanout
+	if fanout == 0 {
+		return false
+	}
+	numPeers := memR.Switch.Peers().Size()
+	if numPeers <= fanout {
+		return false
+	}
+	return rand.Float64() >= float64(fanout)/float64(numPeers) //nolint:gosec
+}
+
+// lazyPushDelay returns how long to wait before pushing memTx lazily.
+func (memR *Reactor) lazyPushDelay(memTx *mempoolTx) time.Duration {
+	delay := memR.config.GossipDedupWindow - time.Since(memTx.timestamp)
+	if jitter := memR.config.GossipDelayJitter; jitter > 0 {
+		delay += time.Duration(rand.Int63n(int64(jitter))) //nolint:gosec
 	}
+	return delay
 }
 
 // TxsMessage is a Message containing transactions.
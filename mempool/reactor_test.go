@@ -16,6 +16,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/mock"
 	memproto "github.com/cometbft/cometbft/proto/tendermint/mempool"
@@ -55,7 +56,7 @@ func TestReactorBroadcastTxsMessage(t *testing.T) {
 	}()
 	for _, r := range reactors {
 		for _, peer := range r.Switch.Peers().List() {
-			peer.Set(types.PeerStateKey, peerState{1})
+			types.PeerStateKey.Set(peer.Data(), peerState{1})
 		}
 	}
 
@@ -63,6 +64,72 @@ func TestReactorBroadcastTxsMessage(t *testing.T) {
 	waitForTxsOnReactors(t, txs, reactors)
 }
 
+// Txs pushed to a single peer eagerly still reach all peers, through lazy
+// pushes.
+func TestReactorBroadcastTxsGossipFanout(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.GossipFanout = 1
+	config.Mempool.GossipDedupWindow = 50 * time.Millisecond
+	const N = 4
+	reactors, _ := makeAndConnectReactors(config, N)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				assert.NoError(t, err)
+			}
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().List() {
+			types.PeerStateKey.Set(peer.Data(), peerState{1})
+		}
+	}
+
+	txs := addRandomTxs(t, reactors[0].mempool, numTxs, UnknownPeerID)
+	for i, r := range reactors {
+		require.Eventually(t, func() bool {
+			return r.mempool.Size() == len(txs)
+		}, 10*time.Second, 10*time.Millisecond, "reactor %d", i)
+	}
+}
+
+func TestReactorMaxBroadcastRoutines(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.MaxBroadcastRoutines = 1
+	const N = 3
+	reactors, switches := makeAndConnectReactors(config, N)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				assert.NoError(t, err)
+			}
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().List() {
+			types.PeerStateKey.Set(peer.Data(), peerState{1})
+		}
+	}
+	for _, sw := range switches {
+		assert.Equal(t, 1, sw.Routines().Running(BroadcastRoutines))
+	}
+
+	// peers are connected in order, so each reactor only broadcasts to the
+	// first peer it connected to: 2 to 0, and 0 to 1
+	txs := addRandomTxs(t, reactors[2].mempool, numTxs, UnknownPeerID)
+	waitForTxsOnReactors(t, txs, reactors)
+
+	// once 2 disconnects from 0, it broadcasts to 1 instead
+	switches[2].StopPeerGracefully(switches[2].Peers().Get(switches[0].NodeInfo().ID()))
+	require.Eventually(t, func() bool {
+		return switches[2].Peers().Size() == 1 && switches[2].Routines().Running(BroadcastRoutines) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	addRandomTxs(t, reactors[2].mempool, numTxs, UnknownPeerID)
+	require.Eventually(t, func() bool {
+		return reactors[1].mempool.Size() == 2*numTxs
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
 // regression test for https://github.com/tendermint/tendermint/issues/5408
 func TestReactorConcurrency(t *testing.T) {
 	config := cfg.TestConfig()
@@ -77,7 +144,7 @@ func TestReactorConcurrency(t *testing.T) {
 	}()
 	for _, r := range reactors {
 		for _, peer := range r.Switch.Peers().List() {
-			peer.Set(types.PeerStateKey, peerState{1})
+			types.PeerStateKey.Set(peer.Data(), peerState{1})
 		}
 	}
 	var wg sync.WaitGroup
@@ -140,7 +207,7 @@ func TestReactorNoBroadcastToSender(t *testing.T) {
 	}()
 	for _, r := range reactors {
 		for _, peer := range r.Switch.Peers().List() {
-			peer.Set(types.PeerStateKey, peerState{1})
+			types.PeerStateKey.Set(peer.Data(), peerState{1})
 		}
 	}
 
@@ -163,7 +230,7 @@ func TestMempoolReactorMaxTxBytes(t *testing.T) {
 	}()
 	for _, r := range reactors {
 		for _, peer := range r.Switch.Peers().List() {
-			peer.Set(types.PeerStateKey, peerState{1})
+			types.PeerStateKey.Set(peer.Data(), peerState{1})
 		}
 	}
 
@@ -279,7 +346,7 @@ func TestMempoolReactorMaxActiveOutboundConnections(t *testing.T) {
 	}()
 	for _, r := range reactors {
 		for _, peer := range r.Switch.Peers().List() {
-			peer.Set(types.PeerStateKey, peerState{1})
+			types.PeerStateKey.Set(peer.Data(), peerState{1})
 		}
 	}
 
@@ -334,6 +401,9 @@ func makeAndConnectReactors(config *cfg.Config, n int) ([]*Reactor, []*p2p.Switc
 	}
 
 	switches := p2p.MakeConnectedSwitches(config.P2P, n, func(i int, s *p2p.Switch) *p2p.Switch {
+		routines := service.NewRoutines(nil)
+		routines.SetCap(BroadcastRoutines, config.Mempool.MaxBroadcastRoutines)
+		s.SetRoutines(routines)
 		s.AddReactor("MEMPOOL", reactors[i])
 		return s
 
@@ -0,0 +1,87 @@
+package mempool
+
+import (
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/types"
+)
+
+// ReapClassifier assigns a transaction to a class, e.g. its namespace or its
+// sender, whose share of the bytes reaped by ReapMaxBytesMaxGas is limited by
+// a ReapQuota. sender is the address returned by CheckTx, if any.
+// Transactions of the empty class are not limited.
+type ReapClassifier func(tx types.Tx, sender []byte) string
+
+// ClassifyBySender is a ReapClassifier classifying transactions by the sender
+// returned by CheckTx.
+func ClassifyBySender(_ types.Tx, sender []byte) string {
+	return string(sender)
+}
+
+// ClassifyByNamespace is a ReapClassifier classifying blob transactions by the
+// namespace of their first blob and other transactions by sender.
+func ClassifyByNamespace(tx types.Tx, sender []byte) string {
+	if blobTx, isBlobTx := types.UnmarshalBlobTx(tx); isBlobTx && len(blobTx.Blobs) > 0 {
+		return "ns/" + string(blobTx.Blobs[0].NamespaceId)
+	}
+	return string(sender)
+}
+
+// ReapQuota limits the share of the bytes reaped for a block proposal taken
+// by each class of transactions, so that a single spammer cannot fill the
+// whole candidate set passed to PrepareProposal.
+type ReapQuota struct {
+	maxClassPercent int64
+	classify        ReapClassifier
+}
+
+// NewReapQuota returns a ReapQuota enforcing the reap-max-class-percent of
+// cfg, classifying transactions by sender.
+func NewReapQuota(cfg *config.MempoolConfig) *ReapQuota {
+	return &ReapQuota{
+		maxClassPercent: int64(cfg.ReapMaxClassPercent),
+		classify:        ClassifyBySender,
+	}
+}
+
+// SetClassifier sets how transactions are classified. It must be called
+// before the mempool is reaped.
+func (q *ReapQuota) SetClassifier(classify ReapClassifier) {
+	q.classify = classify
+}
+
+// Reaper returns the tracker of a single reap of up to maxBytes. It is nil,
+// admitting all transactions, if no quota applies.
+func (q *ReapQuota) Reaper(maxBytes int64) *ReapQuotaTracker {
+	if q == nil || q.maxClassPercent <= 0 || q.maxClassPercent >= 100 || maxBytes < 0 {
+		return nil
+	}
+	return &ReapQuotaTracker{
+		classify:      q.classify,
+		maxClassBytes: maxBytes * q.maxClassPercent / 100,
+		classBytes:    make(map[string]int64),
+	}
+}
+
+// ReapQuotaTracker tracks the bytes reaped per class during a single reap.
+type ReapQuotaTracker struct {
+	classify      ReapClassifier
+	maxClassBytes int64
+	classBytes    map[string]int64
+}
+
+// Add returns true and accounts for tx if its class has room for txBytes
+// more bytes. A nil tracker admits all transactions.
+func (t *ReapQuotaTracker) Add(tx types.Tx, sender []byte, txBytes int64) bool {
+	if t == nil {
+		return true
+	}
+	class := t.classify(tx, sender)
+	if class == "" {
+		return true
+	}
+	if t.classBytes[class]+txBytes > t.maxClassBytes {
+		return false
+	}
+	t.classBytes[class] += txBytes
+	return true
+}
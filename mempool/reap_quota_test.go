@@ -0,0 +1,84 @@
+package mempool
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestReapQuota(t *testing.T) {
+	cfg := config.TestMempoolConfig()
+	cfg.ReapMaxClassPercent = 50
+	q := NewReapQuota(cfg)
+
+	r := q.Reaper(100)
+	tx := types.Tx("tx")
+	assert.True(t, r.Add(tx, []byte("alice"), 30))
+	assert.True(t, r.Add(tx, []byte("alice"), 20))
+	assert.False(t, r.Add(tx, []byte("alice"), 1), "alice took her share")
+	assert.True(t, r.Add(tx, []byte("bob"), 50))
+	assert.True(t, r.Add(tx, nil, 100), "unclassified txs are not limited")
+
+	// no limit if the reap is unbounded or no quota is configured
+	assert.Nil(t, q.Reaper(-1))
+	assert.True(t, q.Reaper(-1).Add(tx, []byte("alice"), 1000))
+	var nilQuota *ReapQuota
+	assert.Nil(t, nilQuota.Reaper(100))
+	cfg.ReapMaxClassPercent = 0
+	assert.Nil(t, NewReapQuota(cfg).Reaper(100))
+}
+
+func TestClassifyByNamespace(t *testing.T) {
+	blob := func(namespace byte) types.Tx {
+		tx, err := types.MarshalBlobTx([]byte{0x0a}, &cmtproto.Blob{
+			NamespaceId: bytes.Repeat([]byte{namespace}, share.NamespaceIDSize),
+			Data:        []byte{0x01},
+		})
+		require.NoError(t, err)
+		return tx
+	}
+
+	assert.Equal(t, ClassifyByNamespace(blob(1), []byte("alice")), ClassifyByNamespace(blob(1), []byte("bob")))
+	assert.NotEqual(t, ClassifyByNamespace(blob(1), nil), ClassifyByNamespace(blob(2), nil))
+	assert.Equal(t, "alice", ClassifyByNamespace(types.Tx("tx"), []byte("alice")))
+}
+
+func TestReapMaxBytesMaxGasWithQuota(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.ReapMaxClassPercent = 50
+	quota := NewReapQuota(cfg.Mempool)
+	// classify txs by their first byte
+	quota.SetClassifier(func(tx types.Tx, _ []byte) string { return string(tx[:1]) })
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+	mp.reapQuota = quota
+
+	for i := 0; i < 10; i++ {
+		for _, class := range []byte("ab") {
+			tx := append([]byte{class}, []byte(fmt.Sprintf("=%02d", i))...)
+			require.NoError(t, mp.CheckTx(tx, nil, TxInfo{}))
+		}
+	}
+
+	// each tx takes 6 bytes once encoded, so each class is limited to 5 txs
+	txs := mp.ReapMaxBytesMaxGas(60, -1)
+	counts := make(map[byte]int)
+	for _, tx := range txs {
+		counts[tx[0]]++
+	}
+	assert.Equal(t, map[byte]int{'a': 5, 'b': 5}, counts)
+}
@@ -0,0 +1,153 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/trace"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/pex"
+)
+
+// AuxiliaryNetwork is a gossip network which the node joins in addition to
+// the network of its chain, e.g. a public testnet communications channel. It
+// runs under its own Switch, with its own transport, peers and address book,
+// so its peers never reach the reactors of the chain.
+type AuxiliaryNetwork struct {
+	// Network is the identifier peers of the auxiliary network handshake with.
+	// It must differ from the chain ID of the node.
+	Network string
+	// P2P configures the auxiliary Switch. It must listen on a different
+	// address than the main Switch.
+	P2P *cfg.P2PConfig
+	// Reactors are the reactors gossiping over the auxiliary network.
+	Reactors map[string]p2p.Reactor
+}
+
+// auxiliarySwitch is a running auxiliary network.
+type auxiliarySwitch struct {
+	network   string
+	transport *p2p.MultiplexTransport
+	sw        *p2p.Switch
+}
+
+// AuxiliarySwitch makes the node join the given auxiliary network when it
+// starts, under a second Switch.
+func AuxiliarySwitch(aux AuxiliaryNetwork) Option {
+	return func(n *Node) {
+		n.auxNetworks = append(n.auxNetworks, aux)
+	}
+}
+
+// AuxiliarySwitches returns the Switches of the auxiliary networks, keyed by
+// network. They are only available once the node is started.
+func (n *Node) AuxiliarySwitches() map[string]*p2p.Switch {
+	switches := make(map[string]*p2p.Switch, len(n.auxSwitches))
+	for _, aux := range n.auxSwitches {
+		switches[aux.network] = aux.sw
+	}
+	return switches
+}
+
+// startAuxiliarySwitch creates the Switch of the given auxiliary network,
+// starts listening and dials its persistent peers.
+func (n *Node) startAuxiliarySwitch(aux AuxiliaryNetwork) (*auxiliarySwitch, error) {
+	if aux.Network == "" || aux.Network == n.genesisDoc.ChainID {
+		return nil, fmt.Errorf("auxiliary network must differ from the chain ID %q, got %q",
+			n.genesisDoc.ChainID, aux.Network)
+	}
+	if aux.P2P == nil {
+		return nil, errors.New("auxiliary network has no p2p config")
+	}
+	if aux.P2P.ListenAddress == n.config.P2P.ListenAddress {
+		return nil, fmt.Errorf("auxiliary network must listen on a different address than %s",
+			n.config.P2P.ListenAddress)
+	}
+	if err := aux.P2P.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid auxiliary p2p config: %w", err)
+	}
+
+	mainInfo, ok := n.nodeInfo.(p2p.DefaultNodeInfo)
+	if !ok {
+		return nil, errors.New("node info is not of type DefaultNodeInfo")
+	}
+	nodeInfo := mainInfo
+	nodeInfo.Network = aux.Network
+	nodeInfo.Channels = nil
+	nodeInfo.ListenAddr = aux.P2P.ExternalAddress
+	if nodeInfo.ListenAddr == "" {
+		nodeInfo.ListenAddr = aux.P2P.ListenAddress
+	}
+	for _, reactor := range aux.Reactors {
+		for _, chDesc := range reactor.GetChannels() {
+			if !nodeInfo.HasChannel(chDesc.ID) {
+				nodeInfo.Channels = append(nodeInfo.Channels, chDesc.ID)
+			}
+		}
+	}
+	if aux.P2P.PexReactor {
+		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
+	}
+	if err := nodeInfo.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid auxiliary node info: %w", err)
+	}
+
+	logger := n.Logger.With("module", "p2p", "network", aux.Network)
+	transport := p2p.NewMultiplexTransport(nodeInfo, *n.nodeKey, p2p.MConnConfig(aux.P2P), trace.NoOpTracer())
+	if !aux.P2P.AllowDuplicateIP {
+		p2p.MultiplexTransportConnFilters(p2p.ConnDuplicateIPFilter())(transport)
+	}
+	p2p.MultiplexTransportMaxIncomingConnections(aux.P2P.MaxNumInboundPeers)(transport)
+
+	sw := p2p.NewSwitch(aux.P2P, transport)
+	sw.SetLogger(logger)
+	for name, reactor := range aux.Reactors {
+		sw.AddReactor(name, reactor)
+	}
+	sw.SetNodeInfo(nodeInfo)
+	sw.SetNodeKey(n.nodeKey)
+
+	persistentPeers := splitAndTrimEmpty(aux.P2P.PersistentPeers, ",", " ")
+	if err := sw.AddPersistentPeers(persistentPeers); err != nil {
+		return nil, fmt.Errorf("could not add auxiliary persistent peers: %w", err)
+	}
+	addrBook, err := createAddrBookAndSetOnSwitch(aux.P2P, aux.Network, sw, logger, n.nodeKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not create auxiliary addrbook: %w", err)
+	}
+	if aux.P2P.PexReactor {
+		createPEXReactorAndAddToSwitch(addrBook, aux.P2P, sw, n.blockStore, logger)
+	}
+	addrBook.AddPrivateIDs(splitAndTrimEmpty(aux.P2P.PrivatePeerIDs, ",", " "))
+
+	addr, err := p2p.NewNetAddressString(p2p.IDAddressString(n.nodeKey.ID(), aux.P2P.ListenAddress))
+	if err != nil {
+		return nil, err
+	}
+	if err := transport.Listen(*addr); err != nil {
+		return nil, err
+	}
+	if err := sw.Start(); err != nil {
+		_ = transport.Close()
+		return nil, err
+	}
+	if err := sw.DialPeersAsync(persistentPeers); err != nil {
+		_ = sw.Stop()
+		_ = transport.Close()
+		return nil, fmt.Errorf("could not dial auxiliary persistent peers: %w", err)
+	}
+	return &auxiliarySwitch{network: aux.Network, transport: transport, sw: sw}, nil
+}
+
+// stopAuxiliarySwitches stops the Switches of the auxiliary networks.
+func (n *Node) stopAuxiliarySwitches() {
+	for _, aux := range n.auxSwitches {
+		if err := aux.sw.Stop(); err != nil {
+			n.Logger.Error("Error closing auxiliary switch", "network", aux.network, "err", err)
+		}
+		if err := aux.transport.Close(); err != nil {
+			n.Logger.Error("Error closing auxiliary transport", "network", aux.network, "err", err)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Package beacon periodically reports the anonymized statistics of a node,
+// signed with a telemetry key, to an endpoint of the network coordinators, e.g.
+// to follow the adoption of an upgrade without scraping the RPC of every
+// node. It is opt-in, and reports no IP address, moniker or validator
+// address.
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/service"
+)
+
+// postTimeout bounds the time a report is posted in.
+const postTimeout = 30 * time.Second
+
+// Stats are the statistics of a node reported by the beacon.
+type Stats struct {
+	Version    string `json:"version"`
+	ChainID    string `json:"chain_id"`
+	Height     int64  `json:"height"`
+	Peers      int    `json:"peers"`
+	CatchingUp bool   `json:"catching_up"`
+}
+
+// Report is the payload posted by the beacon.
+type Report struct {
+	Stats Stats     `json:"stats"`
+	Time  time.Time `json:"time"`
+}
+
+// SignedReport is the body of the requests of the beacon: the JSON encoding
+// of a Report, and its signature by the telemetry key.
+type SignedReport struct {
+	Report    []byte        `json:"report"`
+	PubKey    crypto.PubKey `json:"pub_key"`
+	Signature []byte        `json:"signature"`
+}
+
+// Verify checks the signature of the report and returns it.
+func (sr *SignedReport) Verify() (Report, error) {
+	var report Report
+	if sr.PubKey == nil {
+		return report, errors.New("missing public key")
+	}
+	if !sr.PubKey.VerifySignature(sr.Report, sr.Signature) {
+		return report, errors.New("invalid signature")
+	}
+	if err := cmtjson.Unmarshal(sr.Report, &report); err != nil {
+		return report, fmt.Errorf("decoding report: %w", err)
+	}
+	return report, nil
+}
+
+// Beacon is a service posting the stats of the node to an endpoint at a
+// regular interval, starting when it starts.
+type Beacon struct {
+	service.BaseService
+
+	url      string
+	interval time.Duration
+	privKey  crypto.PrivKey
+	stats    func() Stats
+	client   *http.Client
+}
+
+// NewBeacon returns a beacon posting the stats returned by the stats
+// function to url every interval, signed with privKey.
+func NewBeacon(url string, interval time.Duration, privKey crypto.PrivKey, stats func() Stats) *Beacon {
+	b := &Beacon{
+		url:      url,
+		interval: interval,
+		privKey:  privKey,
+		stats:    stats,
+		client:   &http.Client{Timeout: postTimeout},
+	}
+	b.BaseService = *service.NewBaseService(nil, "Beacon", b)
+	return b
+}
+
+// OnStart implements service.Service.
+func (b *Beacon) OnStart() error {
+	go b.reportRoutine()
+	return nil
+}
+
+func (b *Beacon) reportRoutine() {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+	for {
+		if err := b.Report(); err != nil {
+			b.Logger.Error("Failed to post the telemetry report", "url", b.url, "err", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-b.Quit():
+			return
+		}
+	}
+}
+
+// Report posts the current stats of the node.
+func (b *Beacon) Report() error {
+	body, err := b.signedReport(Report{Stats: b.stats(), Time: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-b.Quit():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", res.Status)
+	}
+	b.Logger.Debug("Posted the telemetry report", "url", b.url)
+	return nil
+}
+
+func (b *Beacon) signedReport(report Report) ([]byte, error) {
+	bz, err := cmtjson.Marshal(report)
+	if err != nil {
+		return nil, fmt.Errorf("encoding report: %w", err)
+	}
+	sig, err := b.privKey.Sign(bz)
+	if err != nil {
+		return nil, fmt.Errorf("signing report: %w", err)
+	}
+	return cmtjson.Marshal(SignedReport{Report: bz, PubKey: b.privKey.PubKey(), Signature: sig})
+}
@@ -0,0 +1,64 @@
+package beacon_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/node/beacon"
+)
+
+func TestBeacon(t *testing.T) {
+	reports := make(chan beacon.SignedReport, 10)
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var sr beacon.SignedReport
+		require.NoError(t, cmtjson.Unmarshal(body, &sr))
+		reports <- sr
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer srv.Close()
+
+	privKey := ed25519.GenPrivKey()
+	stats := beacon.Stats{Version: "1.2.3", ChainID: "test-chain", Height: 42, Peers: 7, CatchingUp: true}
+	b := beacon.NewBeacon(srv.URL, time.Hour, privKey, func() beacon.Stats { return stats })
+	b.SetLogger(log.TestingLogger())
+
+	// a report is posted when the beacon starts
+	require.NoError(t, b.Start())
+	t.Cleanup(func() { _ = b.Stop() })
+	var sr beacon.SignedReport
+	select {
+	case sr = <-reports:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no report posted")
+	}
+	report, err := sr.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, stats, report.Stats)
+	assert.WithinDuration(t, time.Now(), report.Time, time.Minute)
+	assert.Equal(t, privKey.PubKey(), sr.PubKey)
+
+	// tampered reports are rejected
+	sr.Report = append([]byte{}, sr.Report...)
+	sr.Report[len(sr.Report)-2]++
+	_, err = sr.Verify()
+	assert.Error(t, err)
+
+	status.Store(http.StatusServiceUnavailable)
+	assert.Error(t, b.Report())
+	<-reports
+}
@@ -0,0 +1,98 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// phaseTransitions lists the phases the node can move to from each phase.
+// The node moves to draining from any phase but stopped, e.g. when it fails
+// to start.
+var phaseTransitions = map[types.NodePhase][]types.NodePhase{
+	types.NodePhaseStarting:  {types.NodePhaseSyncing, types.NodePhaseConsensus, types.NodePhaseDraining},
+	types.NodePhaseSyncing:   {types.NodePhaseConsensus, types.NodePhaseDraining},
+	types.NodePhaseConsensus: {types.NodePhaseDraining},
+	types.NodePhaseDraining:  {types.NodePhaseStopped},
+}
+
+// lifecycle is the state machine of the phases of the node, from starting to
+// stopped. Each transition is recorded and published on the event bus, so
+// that orchestrators can follow the node without parsing its logs.
+type lifecycle struct {
+	eventBus *types.EventBus
+	logger   log.Logger
+
+	mtx         cmtsync.RWMutex
+	phase       types.NodePhase
+	transitions []types.EventDataNodePhase
+}
+
+func newLifecycle(eventBus *types.EventBus, logger log.Logger) *lifecycle {
+	return &lifecycle{
+		eventBus: eventBus,
+		logger:   logger,
+		phase:    types.NodePhaseStarting,
+	}
+}
+
+// Phase returns the current phase of the node.
+func (l *lifecycle) Phase() types.NodePhase {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+	return l.phase
+}
+
+// Transitions returns the transitions of the node so far, oldest first.
+func (l *lifecycle) Transitions() []types.EventDataNodePhase {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+	return append([]types.EventDataNodePhase(nil), l.transitions...)
+}
+
+// transition moves the node to phase, or returns an error if it can't be
+// reached from the current phase.
+func (l *lifecycle) transition(phase types.NodePhase) error {
+	l.mtx.Lock()
+	if !l.canTransition(phase) {
+		from := l.phase
+		l.mtx.Unlock()
+		return fmt.Errorf("invalid node phase transition from %s to %s", from, phase)
+	}
+	transition := types.EventDataNodePhase{From: l.phase, To: phase, Time: cmttime.Now()}
+	l.phase = phase
+	l.transitions = append(l.transitions, transition)
+	l.mtx.Unlock()
+
+	l.logger.Info("Node phase changed", "from", transition.From, "to", transition.To)
+	if l.eventBus.IsRunning() {
+		if err := l.eventBus.PublishEventNodePhase(transition); err != nil {
+			l.logger.Error("failed publishing node phase event", "err", err)
+		}
+	}
+	return nil
+}
+
+func (l *lifecycle) canTransition(phase types.NodePhase) bool {
+	for _, to := range phaseTransitions[l.phase] {
+		if to == phase {
+			return true
+		}
+	}
+	return false
+}
+
+// enter moves the node to phase, logging invalid transitions.
+func (l *lifecycle) enter(phase types.NodePhase) {
+	if err := l.transition(phase); err != nil {
+		l.logger.Error("Failed to change node phase", "err", err)
+	}
+}
+
+// Phase returns the current phase of the lifecycle of the node.
+func (n *Node) Phase() types.NodePhase {
+	return n.lifecycle.Phase()
+}
@@ -3,10 +3,16 @@ package node
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/grafana/pyroscope-go"
@@ -15,6 +21,7 @@ import (
 	"github.com/rs/cors"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
+	"github.com/cometbft/cometbft/blockrelay"
 	bc "github.com/cometbft/cometbft/blocksync"
 	cfg "github.com/cometbft/cometbft/config"
 	cs "github.com/cometbft/cometbft/consensus"
@@ -25,19 +32,25 @@ import (
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/libs/trace"
+	"github.com/cometbft/cometbft/libs/trace/schema"
 	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/node/beacon"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/pex"
 	"github.com/cometbft/cometbft/proxy"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	rpccore "github.com/cometbft/cometbft/rpc/core"
 	grpccore "github.com/cometbft/cometbft/rpc/grpc"
 	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/dasampling"
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/state/txindex/null"
 	"github.com/cometbft/cometbft/statesync"
 	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/store/migrate"
 	"github.com/cometbft/cometbft/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
 	"github.com/cometbft/cometbft/version"
@@ -45,6 +58,10 @@ import (
 	_ "net/http/pprof" //nolint: gosec
 )
 
+// routinesStopTimeout is how long stopping the node waits for the goroutines
+// of its reactors to return, before reporting them as leaked.
+const routinesStopTimeout = 5 * time.Second
+
 // Node is the highest level interface to a full CometBFT node.
 // It includes all configuration information and running services.
 type Node struct {
@@ -62,6 +79,10 @@ type Node struct {
 	nodeInfo    p2p.NodeInfo
 	nodeKey     *p2p.NodeKey // our node privkey
 	isListening bool
+	solo        bool // runs consensus alone, without p2p
+
+	auxNetworks []AuxiliaryNetwork // gossip networks joined besides our chain
+	auxSwitches []*auxiliarySwitch
 
 	// services
 	eventBus          *types.EventBus // pub/sub for services
@@ -70,6 +91,9 @@ type Node struct {
 	bcReactor         p2p.Reactor       // for block-syncing
 	mempoolReactor    p2p.Reactor       // for gossipping transactions
 	mempool           mempl.Mempool
+	mempoolAdmission  *mempl.AdmissionFilter  // rejects txs before CheckTx
+	mempoolReapQuota  *mempl.ReapQuota        // limits each tx class in reaped blocks
+	mempoolEvictions  *mempl.EvictionHook     // notified of the evicted txs
 	stateSync         bool                    // whether the node should state sync on startup
 	stateSyncReactor  *statesync.Reactor      // for hosting and restoring state sync snapshots
 	stateSyncProvider statesync.StateProvider // provides state data for bootstrapping a node
@@ -83,6 +107,13 @@ type Node struct {
 	txIndexer         txindex.TxIndexer
 	blockIndexer      indexer.BlockIndexer
 	indexerService    *txindex.IndexerService
+	storageCollector  *store.StorageCollector // nil if storage metrics are disabled
+	telemetryBeacon   *beacon.Beacon          // nil if the telemetry beacon is disabled
+	routines          *service.Routines       // goroutines run by the reactors
+	routineLeakHook   func(map[string]int)    // called with the goroutines leaked on stop
+	txProofProvider   rpccore.TxProofProvider // nil to query the application
+	daSampling        *dasampling.Service     // nil if no sampler is registered
+	lifecycle         *lifecycle              // phases of the node
 	prometheusSrv     *http.Server
 	pprofSrv          *http.Server
 
@@ -103,6 +134,7 @@ type Option func(*Node)
 //
 //   - MEMPOOL
 //   - BLOCKSYNC
+//   - BLOCKRELAY
 //   - CONSENSUS
 //   - EVIDENCE
 //   - PEX
@@ -135,6 +167,14 @@ func CustomReactors(reactors map[string]p2p.Reactor) Option {
 	}
 }
 
+// PeerEventHooks registers callbacks on the node's Switch for peer lifecycle
+// events (handshake, channel negotiation and disconnects).
+func PeerEventHooks(hooks p2p.PeerEventHooks) Option {
+	return func(n *Node) {
+		n.sw.AddPeerEventHooks(hooks)
+	}
+}
+
 // StateProvider overrides the state provider used by state sync to retrieve trusted app hashes and
 // build a State object for bootstrapping the node.
 // WARNING: this interface is considered unstable and subject to change.
@@ -144,6 +184,81 @@ func StateProvider(stateProvider statesync.StateProvider) Option {
 	}
 }
 
+// MempoolBlobCheck sets a hook run on the blobs of every blob transaction
+// before it is passed to CheckTx, e.g. to reject blobs in namespaces the
+// application would never accept.
+func MempoolBlobCheck(checkBlobs mempl.BlobCheckFunc) Option {
+	return func(n *Node) {
+		n.mempoolAdmission.SetBlobCheck(checkBlobs)
+	}
+}
+
+// MempoolReapClassifier sets how reaped transactions are grouped into classes
+// whose share of a block is capped by mempool.reap-max-class-percent. By
+// default, transactions are grouped by sender.
+func MempoolReapClassifier(classify mempl.ReapClassifier) Option {
+	return func(n *Node) {
+		n.mempoolReapQuota.SetClassifier(classify)
+	}
+}
+
+// MempoolEvictionCallback sets a callback run with each transaction evicted
+// from the mempool because it is full or the transaction expired, e.g. for the
+// application to release the nonce or the resources it reserved in CheckTx.
+// Only the priority and cat mempools evict transactions.
+func MempoolEvictionCallback(cb mempl.EvictionCallback) Option {
+	return func(n *Node) {
+		n.mempoolEvictions.SetCallback(cb)
+	}
+}
+
+// TxProofProvider overrides how the RPC proves the inclusion of transactions
+// in the data root of their block. By default, the application is queried.
+func TxProofProvider(provider rpccore.TxProofProvider) Option {
+	return func(n *Node) {
+		n.txProofProvider = provider
+	}
+}
+
+// DASampler registers a data availability sampler, called with the header of
+// each committed block. The blocks it accepts are reported as safe by the
+// RPC, see dasampling.Service.
+func DASampler(sampler dasampling.Sampler) Option {
+	return func(n *Node) {
+		n.daSampling = dasampling.NewService(sampler, n.blockStore, n.eventBus)
+		n.daSampling.SetLogger(n.Logger.With("module", "dasampling"))
+	}
+}
+
+// Solo runs the node as the only validator of a local chain, with p2p
+// disabled and blocks produced as soon as transactions arrive, like setting
+// solo in the config. It is meant for testing applications.
+func Solo() Option {
+	return solo
+}
+
+// solo is the option returned by Solo. It does nothing by itself:
+// NewNodeWithContext looks for it among the options to apply the solo
+// settings before the reactors are constructed with the config.
+func solo(*Node) {}
+
+// hasSolo reports whether the Solo option is among the options.
+func hasSolo(options []Option) bool {
+	soloPtr := reflect.ValueOf(Option(solo)).Pointer()
+	return slices.ContainsFunc(options, func(option Option) bool {
+		return option != nil && reflect.ValueOf(option).Pointer() == soloPtr
+	})
+}
+
+// RoutineLeakHook sets a callback run when the node stops while goroutines
+// of its reactors are still running, with their number by subsystem, e.g. to
+// fail tests. The leak is logged regardless.
+func RoutineLeakHook(hook func(leaked map[string]int)) Option {
+	return func(n *Node) {
+		n.routineLeakHook = hook
+	}
+}
+
 // BootstrapState synchronizes the stores with the application after state sync
 // has been performed offline. It is expected that the block store and state
 // store are empty at the time the function is called.
@@ -301,6 +416,31 @@ func NewNodeWithContext(ctx context.Context,
 	logger log.Logger,
 	options ...Option,
 ) (*Node, error) {
+	config, err := config.ApplyP2PProfile()
+	if err != nil {
+		return nil, err
+	}
+	if hasSolo(options) {
+		config.Solo = true
+	}
+	config.ApplySolo()
+
+	var (
+		openedDBs []openedDB
+		dbWrites  store.WriteCounter
+	)
+	dbProvider = schemaDBProvider(dbProvider, migrate.DefaultRegistry)
+	dbProvider = recordingDBProvider(dbProvider, &openedDBs)
+	var readCache *store.ReadCache
+	if config.Storage.ReadCacheSizeMB > 0 {
+		readCache = store.NewReadCache(config.Storage.ReadCacheSizeMB << 20)
+		dbProvider = readCacheDBProvider(dbProvider, readCache)
+	}
+	if config.Instrumentation.ResourceUsageEvents {
+		// wrapped last so that the storage collector sees the actual databases
+		dbProvider = countingDBProvider(dbProvider, &dbWrites)
+	}
+
 	blockStore, stateDB, err := initDBs(config, dbProvider)
 	if err != nil {
 		return nil, err
@@ -316,9 +456,13 @@ func NewNodeWithContext(ctx context.Context,
 	}
 
 	csMetrics, p2pMetrics, memplMetrics, smMetrics, abciMetrics, bsMetrics, ssMetrics := metricsProvider(genDoc.ChainID)
+	compMetrics := defaultComponentMetrics(config.Instrumentation, genDoc.ChainID)
+	if readCache != nil {
+		readCache.SetMetrics(compMetrics.store)
+	}
 
 	// Create the proxyApp and establish connections to the ABCI app (consensus, mempool, query).
-	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger, abciMetrics)
+	proxyApp, err := createAndStartProxyAppConns(clientCreator, config, logger, abciMetrics, stateStore, blockStore, genDoc)
 	if err != nil {
 		return nil, err
 	}
@@ -342,7 +486,8 @@ func NewNodeWithContext(ctx context.Context,
 	// external signing process.
 	if config.PrivValidatorListenAddr != "" {
 		// FIXME: we should start services inside OnStart
-		privValidator, err = createAndStartPrivValidatorSocketClient(config.PrivValidatorListenAddr, genDoc.ChainID, logger)
+		privValidator, err = createAndStartPrivValidatorSocketClient(
+			config.PrivValidatorListenAddr, genDoc.ChainID, config.PrivValidatorMaxInFlight, logger)
 		if err != nil {
 			return nil, fmt.Errorf("error with private validator socket client: %w", err)
 		}
@@ -364,9 +509,12 @@ func NewNodeWithContext(ctx context.Context,
 	// Create the handshaker, which calls RequestInfo, sets the AppVersion on the state,
 	// and replays any blocks as necessary to sync CometBFT with the app.
 	consensusLogger := logger.With("module", "consensus")
-	var softwareVersion string
+	var (
+		softwareVersion string
+		haltHeight      int64
+	)
 	if !stateSync {
-		softwareVersion, err = doHandshake(ctx, stateStore, state, blockStore, genDoc, eventBus, proxyApp, consensusLogger)
+		softwareVersion, haltHeight, err = doHandshake(ctx, stateStore, state, blockStore, genDoc, eventBus, proxyApp, consensusLogger)
 		if err != nil {
 			return nil, err
 		}
@@ -397,13 +545,43 @@ func NewNodeWithContext(ctx context.Context,
 		return nil, err
 	}
 
-	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger, tracer)
+	mempoolAdmission, err := mempl.NewAdmissionFilter(config.Mempool)
+	if err != nil {
+		return nil, fmt.Errorf("could not create mempool admission filter: %w", err)
+	}
+	mempoolReapQuota := mempl.NewReapQuota(config.Mempool)
+	mempoolEvictions := &mempl.EvictionHook{}
+	mempoolEvents := &countingMempoolEventPublisher{MempoolEventPublisher: eventBus}
+	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, mempoolAdmission, mempoolReapQuota,
+		mempoolEvictions, memplMetrics, mempoolEvents, logger, tracer)
 
 	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateStore, blockStore, logger)
 	if err != nil {
 		return nil, err
 	}
 
+	traffic := &p2p.Traffic{}
+	blockExecOptions := []sm.BlockExecutorOption{
+		sm.BlockExecutorWithMetrics(smMetrics),
+		sm.BlockExecutorWithTracer(tracer),
+		// never prune the heights needed by peers to restore our snapshots
+		sm.BlockExecutorWithRetainHeightLimit(statesync.SnapshotRetainHeight(proxyApp.Snapshot())),
+	}
+	if config.Instrumentation.ResourceUsageEvents {
+		reporter := createResourceUsageReporter(eventBus, traffic, &dbWrites, mempoolEvents, mempool)
+		blockExecOptions = append(blockExecOptions, sm.BlockExecutorWithExecutionHook(reporter.Hook))
+	}
+	if haltHeight > 0 {
+		// the handshake replayed a block after which the node halts for an upgrade
+		blockExecOptions = append(blockExecOptions, sm.BlockExecutorWithHaltHeight(haltHeight))
+	}
+	if schedule := (sm.SnapshotSchedule{
+		Interval:     config.StateSync.SnapshotInterval,
+		TimeInterval: config.StateSync.SnapshotTimeInterval,
+	}); schedule.Enabled() {
+		blockExecOptions = append(blockExecOptions, sm.BlockExecutorWithSnapshotSchedule(schedule))
+	}
+
 	// make block executor for consensus and blocksync reactors to execute blocks
 	blockExec := sm.NewBlockExecutor(
 		stateStore,
@@ -412,8 +590,14 @@ func NewNodeWithContext(ctx context.Context,
 		mempool,
 		evidencePool,
 		blockStore,
-		sm.BlockExecutorWithMetrics(smMetrics),
+		blockExecOptions...,
 	)
+	if indexerService != nil {
+		// profile the indexing of the blocks with their execution
+		indexerService.SetIndexHook(func(height int64, duration time.Duration) {
+			blockExec.ObservePhase(height, sm.PhaseIndex, duration)
+		})
+	}
 
 	offlineStateSyncHeight := int64(0)
 	if blockStore.Height() == 0 {
@@ -428,9 +612,11 @@ func NewNodeWithContext(ctx context.Context,
 		return nil, fmt.Errorf("could not create blocksync reactor: %w", err)
 	}
 
+	lifecycle := newLifecycle(eventBus, logger.With("module", "node"))
 	consensusReactor, consensusState := createConsensusReactor(
 		config, state, blockExec, blockStore, mempool, evidencePool,
 		privValidator, csMetrics, stateSync || blockSync, eventBus, consensusLogger, offlineStateSyncHeight, tracer,
+		func() { lifecycle.enter(types.NodePhaseConsensus) },
 	)
 
 	err = stateStore.SetOfflineStateSyncHeight(0)
@@ -456,23 +642,76 @@ func NewNodeWithContext(ctx context.Context,
 
 	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, proxyApp, tracer)
 
+	subnetFilter, err := p2p.NewSubnetFilter(
+		splitAndTrimEmpty(config.P2P.AllowedSubnets, ",", " "),
+		splitAndTrimEmpty(config.P2P.BlockedSubnets, ",", " "),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subnets: %w", err)
+	}
+	p2p.MultiplexTransportSubnetFilter(subnetFilter)(transport)
+
 	p2pLogger := logger.With("module", "p2p")
 	sw := createSwitch(
-		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
+		config, transport, p2pMetrics, traffic, peerFilters, mempoolReactor, bcReactor,
 		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger, tracer,
 	)
 
+	if config.BlockRelay.Enabled() {
+		sw.AddReactor("BLOCKRELAY", createBlockRelayReactor(config, blockExec, blockStore, eventBus, localAddr, logger))
+	}
+
+	routines := service.NewRoutines(compMetrics.routines)
+	routines.SetCap(mempl.BroadcastRoutines, config.Mempool.MaxBroadcastRoutines)
+	sw.SetRoutines(routines)
+
 	err = sw.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
 	if err != nil {
 		return nil, fmt.Errorf("could not add peers from persistent_peers field: %w", err)
 	}
 
+	if pinningFile := config.P2P.PeerPinningFile(); pinningFile != "" {
+		pins, err := p2p.LoadPeerPins(pinningFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not load peer pins: %w", err)
+		}
+		sw.SetPeerPins(pins)
+	}
+
+	if config.P2P.MaxPeersPerSubnet > 0 || config.P2P.MaxPeersPerASN > 0 {
+		var asns *p2p.ASNTable
+		if asnFile := config.P2P.ASNDatabaseFile(); asnFile != "" {
+			asns, err = p2p.LoadASNTable(asnFile)
+			if err != nil {
+				return nil, fmt.Errorf("could not load ASN database: %w", err)
+			}
+		}
+		sw.SetPeerDiversity(p2p.NewPeerDiversity(config.P2P.MaxPeersPerSubnet, config.P2P.MaxPeersPerASN, asns))
+	}
+
+	if minScore := config.P2P.MinPeerScore; minScore < 0 {
+		sw.SetPeerScorer(p2p.NewPeerScorer(minScore))
+	}
+
+	if threshold := config.P2P.ClockSkewThreshold; threshold > 0 {
+		sw.SetClockSkew(p2p.NewClockSkew(threshold, func(offset time.Duration, skewed bool) {
+			if err := eventBus.PublishEventClockSkew(types.EventDataClockSkew{Offset: offset, Skewed: skewed}); err != nil {
+				p2pLogger.Error("failed publishing clock skew event", "err", err)
+			}
+		}))
+	}
+
 	err = sw.AddUnconditionalPeerIDs(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
 	if err != nil {
 		return nil, fmt.Errorf("could not add peer ids from unconditional_peer_ids field: %w", err)
 	}
 
-	addrBook, err := createAddrBookAndSetOnSwitch(config, sw, p2pLogger, nodeKey)
+	err = sw.SetPriorityPeerIDs(splitAndTrimEmpty(config.P2P.PriorityPeerIDs, ",", " "))
+	if err != nil {
+		return nil, fmt.Errorf("could not add peer ids from priority_peer_ids field: %w", err)
+	}
+
+	addrBook, err := createAddrBookAndSetOnSwitch(config.P2P, genDoc.ChainID, sw, p2pLogger, nodeKey)
 	if err != nil {
 		return nil, fmt.Errorf("could not create addrbook: %w", err)
 	}
@@ -491,12 +730,14 @@ func NewNodeWithContext(ctx context.Context,
 	// Note we currently use the addrBook regardless at least for AddOurAddress
 	var pexReactor *pex.Reactor
 	if config.P2P.PexReactor {
-		pexReactor = createPEXReactorAndAddToSwitch(addrBook, config, sw, logger)
+		pexReactor = createPEXReactorAndAddToSwitch(addrBook, config.P2P, sw, blockStore, logger)
 	}
 
 	// Add private IDs to addrbook to block those peers being added
 	addrBook.AddPrivateIDs(splitAndTrimEmpty(config.P2P.PrivatePeerIDs, ",", " "))
 
+	storageCollector := createStorageCollector(config.Instrumentation, openedDBs, compMetrics.store, logger)
+
 	node := &Node{
 		config:        config,
 		genesisDoc:    genDoc,
@@ -507,12 +748,16 @@ func NewNodeWithContext(ctx context.Context,
 		addrBook:  addrBook,
 		nodeInfo:  nodeInfo,
 		nodeKey:   nodeKey,
+		solo:      config.Solo,
 
 		stateStore:       stateStore,
 		blockStore:       blockStore,
 		bcReactor:        bcReactor,
 		mempoolReactor:   mempoolReactor,
 		mempool:          mempool,
+		mempoolAdmission: mempoolAdmission,
+		mempoolReapQuota: mempoolReapQuota,
+		mempoolEvictions: mempoolEvictions,
 		consensusState:   consensusState,
 		consensusReactor: consensusReactor,
 		stateSyncReactor: stateSyncReactor,
@@ -525,6 +770,9 @@ func NewNodeWithContext(ctx context.Context,
 		indexerService:   indexerService,
 		blockIndexer:     blockIndexer,
 		eventBus:         eventBus,
+		storageCollector: storageCollector,
+		routines:         routines,
+		lifecycle:        lifecycle,
 	}
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
 
@@ -537,6 +785,16 @@ func NewNodeWithContext(ctx context.Context,
 
 // OnStart starts the Node. It implements service.Service.
 func (n *Node) OnStart() error {
+	if n.solo {
+		pubKey, err := n.privValidator.GetPubKey()
+		if err != nil {
+			return fmt.Errorf("can't get pubkey: %w", err)
+		}
+		if !onlyValidatorIsUs(n.consensusState.GetState(), pubKey.Address()) {
+			return errors.New("solo mode requires the node to be the only validator")
+		}
+	}
+
 	now := cmttime.Now()
 	genTime := n.genesisDoc.GenesisTime
 	if genTime.After(now) {
@@ -554,6 +812,18 @@ func (n *Node) OnStart() error {
 		n.prometheusSrv = n.startPrometheusServer()
 	}
 
+	if n.storageCollector != nil {
+		if err := n.storageCollector.Start(); err != nil {
+			return err
+		}
+	}
+
+	if n.daSampling != nil {
+		if err := n.daSampling.Start(); err != nil {
+			return err
+		}
+	}
+
 	// Start the RPC server before the P2P server
 	// so we can eg. receive txs for the first block
 	if n.config.RPC.ListenAddress != "" {
@@ -576,6 +846,16 @@ func (n *Node) OnStart() error {
 		n.pyroscopeTracer = tracer
 	}
 
+	// A solo node has no peers: it only runs consensus.
+	if n.solo {
+		n.Logger.Info("Running in solo mode: p2p is disabled")
+		if err := n.consensusState.Start(); err != nil {
+			return err
+		}
+		n.lifecycle.enter(types.NodePhaseConsensus)
+		return nil
+	}
+
 	// Start the transport.
 	addr, err := p2p.NewNetAddressString(p2p.IDAddressString(n.nodeKey.ID(), n.config.P2P.ListenAddress))
 	if err != nil {
@@ -587,6 +867,12 @@ func (n *Node) OnStart() error {
 
 	n.isListening = true
 
+	// The consensus reactor moves the node to the consensus phase once syncing
+	// is done, which may happen as soon as the switch is started.
+	if n.consensusReactor.WaitSync() {
+		n.lifecycle.enter(types.NodePhaseSyncing)
+	}
+
 	// Start the switch (the P2P server).
 	err = n.sw.Start()
 	if err != nil {
@@ -599,6 +885,14 @@ func (n *Node) OnStart() error {
 		return fmt.Errorf("could not dial peers from persistent_peers field: %w", err)
 	}
 
+	for _, aux := range n.auxNetworks {
+		auxSw, err := n.startAuxiliarySwitch(aux)
+		if err != nil {
+			return fmt.Errorf("failed to join auxiliary network %q: %w", aux.Network, err)
+		}
+		n.auxSwitches = append(n.auxSwitches, auxSw)
+	}
+
 	// Run state sync
 	if n.stateSync {
 		bcR, ok := n.bcReactor.(blockSyncReactor)
@@ -612,6 +906,21 @@ func (n *Node) OnStart() error {
 		}
 	}
 
+	if n.lifecycle.Phase() == types.NodePhaseStarting {
+		n.lifecycle.enter(types.NodePhaseConsensus)
+	}
+
+	// Report the stats once the node is connected to the network
+	if n.config.Instrumentation.TelemetryBeaconURL != "" {
+		n.telemetryBeacon, err = n.createTelemetryBeacon()
+		if err != nil {
+			return err
+		}
+		if err := n.telemetryBeacon.Start(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -620,6 +929,7 @@ func (n *Node) OnStop() {
 	n.BaseService.OnStop()
 
 	n.Logger.Info("Stopping Node")
+	n.lifecycle.enter(types.NodePhaseDraining)
 
 	// first stop the non-reactor services
 	if err := n.eventBus.Stop(); err != nil {
@@ -630,13 +940,42 @@ func (n *Node) OnStop() {
 			n.Logger.Error("Error closing indexerService", "err", err)
 		}
 	}
-	// now stop the reactors
-	if err := n.sw.Stop(); err != nil {
-		n.Logger.Error("Error closing switch", "err", err)
+	if n.storageCollector != nil {
+		if err := n.storageCollector.Stop(); err != nil {
+			n.Logger.Error("Error closing storageCollector", "err", err)
+		}
+	}
+	if n.telemetryBeacon != nil {
+		if err := n.telemetryBeacon.Stop(); err != nil {
+			n.Logger.Error("Error closing telemetryBeacon", "err", err)
+		}
+	}
+
+	if n.daSampling != nil {
+		if err := n.daSampling.Stop(); err != nil {
+			n.Logger.Error("Error closing daSampling", "err", err)
+		}
 	}
+	// now stop the reactors
+	if n.solo {
+		if err := n.consensusState.Stop(); err != nil {
+			n.Logger.Error("Error stopping consensus state", "err", err)
+		}
+	} else {
+		n.stopAuxiliarySwitches()
+		if err := n.sw.Stop(); err != nil {
+			n.Logger.Error("Error closing switch", "err", err)
+		}
 
-	if err := n.transport.Close(); err != nil {
-		n.Logger.Error("Error closing transport", "err", err)
+		if err := n.transport.Close(); err != nil {
+			n.Logger.Error("Error closing transport", "err", err)
+		}
+	}
+	if leaked := n.routines.WaitIdle(routinesStopTimeout); len(leaked) > 0 {
+		n.Logger.Error("Goroutines still running after stopping the reactors", "routines", leaked)
+		if n.routineLeakHook != nil {
+			n.routineLeakHook(leaked)
+		}
 	}
 
 	n.isListening = false
@@ -699,6 +1038,7 @@ func (n *Node) OnStop() {
 			n.Logger.Error("problem closing evidencestore", "err", err)
 		}
 	}
+	n.lifecycle.enter(types.NodePhaseStopped)
 }
 
 // ConfigureRPC makes sure RPC has all the objects it needs to operate.
@@ -725,14 +1065,42 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 		ConsensusReactor: n.consensusReactor,
 		EventBus:         n.eventBus,
 		Mempool:          n.mempool,
+		Lifecycle:        n.lifecycle,
+		EventLimits:      eventLimits(n.config),
 
 		Logger: n.Logger.With("module", "rpc"),
 
 		Config: *n.config.RPC,
 	}
+	if n.storageCollector != nil {
+		rpcCoreEnv.StorageCollector = n.storageCollector
+	}
+	if n.txProofProvider != nil {
+		rpcCoreEnv.TxProofProvider = n.txProofProvider
+	}
+	if n.daSampling != nil {
+		rpcCoreEnv.DASampling = n.daSampling
+	}
+	if br, ok := n.sw.Reactor("BLOCKRELAY").(*blockrelay.Reactor); ok {
+		rpcCoreEnv.BlockRelay = br
+	}
+	for _, addr := range n.config.Mempool.BroadcastProxies() {
+		client, err := rpchttp.New(addr, "/websocket")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create broadcast proxy client for %s: %w", addr, err)
+		}
+		rpcCoreEnv.TxBroadcasters = append(rpcCoreEnv.TxBroadcasters, client)
+	}
 	if err := rpcCoreEnv.InitGenesisChunks(); err != nil {
 		return nil, err
 	}
+	if err := rpcCoreEnv.InitSubscriptionAuth(); err != nil {
+		return nil, err
+	}
+	if err := rpcCoreEnv.InitQueryRoutes(); err != nil {
+		return nil, err
+	}
+	rpcCoreEnv.InitTxRequestIDs()
 	return &rpcCoreEnv, nil
 }
 
@@ -748,6 +1116,9 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 	if n.config.RPC.Unsafe {
 		env.AddUnsafeRoutes(routes)
 	}
+	if n.tracer != nil && n.tracer.IsCollecting(schema.RPCRequestTable) {
+		routes = rpcserver.ObserveCalls(routes, n.traceRPCRequest)
+	}
 
 	config := rpcserver.DefaultConfig()
 	config.MaxRequestBatchSize = n.config.RPC.MaxRequestBatchSize
@@ -761,25 +1132,46 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		config.WriteTimeout = n.config.RPC.TimeoutBroadcastTxCommit + 1*time.Second
 	}
 
+	// The handlers of the virtual hosts are shared by the listen addresses,
+	// for their rate limits to apply to the requests on all of them.
+	rpcLogger := n.Logger.With("module", "rpc-server")
+	vhHandlers := make([]http.Handler, len(n.config.RPC.VirtualHosts))
+	for i, vh := range n.config.RPC.VirtualHosts {
+		vhRoutes := make(map[string]*rpcserver.RPCFunc)
+		for name, route := range routes {
+			if vh.ServesMethod(name) {
+				vhRoutes[name] = route
+			}
+		}
+		vhLogger := rpcLogger.With("vhost", vh.Name)
+		vhHandlers[i] = rpcserver.RateLimitHandler(
+			n.rpcHandler(env, vhRoutes, config, vh.CORSAllowedOrigins, vhLogger),
+			vh.RateLimit,
+			vh.RateBurst,
+		)
+	}
+
 	// we may expose the rpc over both a unix and tcp socket
 	listeners := make([]net.Listener, len(listenAddrs))
 	for i, listenAddr := range listenAddrs {
-		mux := http.NewServeMux()
-		rpcLogger := n.Logger.With("module", "rpc-server")
-		wmLogger := rpcLogger.With("protocol", "websocket")
-		wm := rpcserver.NewWebsocketManager(routes,
-			rpcserver.OnDisconnect(func(remoteAddr string) {
-				err := n.eventBus.UnsubscribeAll(context.Background(), remoteAddr)
-				if err != nil && err != cmtpubsub.ErrSubscriptionNotFound {
-					wmLogger.Error("Failed to unsubscribe addr from events", "addr", remoteAddr, "err", err)
-				}
-			}),
-			rpcserver.ReadLimit(config.MaxBodyBytes),
-			rpcserver.WriteChanCapacity(n.config.RPC.WebSocketWriteBufferSize),
-		)
-		wm.SetLogger(wmLogger)
-		mux.HandleFunc("/websocket", wm.WebsocketHandler)
-		rpcserver.RegisterRPCFuncs(mux, routes, rpcLogger)
+		rootHandler := n.rpcHandler(env, routes, config, n.config.RPC.CORSAllowedOrigins, rpcLogger)
+
+		// the requests on the listen address of a virtual host are all served
+		// by it, otherwise by the virtual host of their Host header, if any
+		hosts := make(map[string]http.Handler)
+		for j, vh := range n.config.RPC.VirtualHosts {
+			if slices.Contains(vh.ListenAddresses, listenAddr) {
+				rootHandler, hosts = vhHandlers[j], nil
+				break
+			}
+			for _, host := range vh.Hosts {
+				hosts[strings.ToLower(host)] = vhHandlers[j]
+			}
+		}
+		if len(hosts) > 0 {
+			rootHandler = virtualHostsHandler(hosts, rootHandler)
+		}
+
 		listener, err := rpcserver.Listen(
 			listenAddr,
 			config.MaxOpenConnections,
@@ -788,15 +1180,6 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 			return nil, err
 		}
 
-		var rootHandler http.Handler = mux
-		if n.config.RPC.IsCorsEnabled() {
-			corsMiddleware := cors.New(cors.Options{
-				AllowedOrigins: n.config.RPC.CORSAllowedOrigins,
-				AllowedMethods: n.config.RPC.CORSAllowedMethods,
-				AllowedHeaders: n.config.RPC.CORSAllowedHeaders,
-			})
-			rootHandler = corsMiddleware.Handler(mux)
-		}
 		if n.config.RPC.IsTLSEnabled() {
 			go func() {
 				if err := rpcserver.ServeTLS(
@@ -857,6 +1240,97 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 	return listeners, nil
 }
 
+// rpcHandler returns the handler serving routes over HTTP and websockets,
+// allowing the cross-domain requests of corsAllowedOrigins.
+func (n *Node) rpcHandler(
+	env *rpccore.Environment,
+	routes map[string]*rpcserver.RPCFunc,
+	config *rpcserver.Config,
+	corsAllowedOrigins []string,
+	rpcLogger log.Logger,
+) http.Handler {
+	mux := http.NewServeMux()
+	wmLogger := rpcLogger.With("protocol", "websocket")
+	wm := rpcserver.NewWebsocketManager(routes,
+		rpcserver.OnDisconnect(func(remoteAddr string) {
+			err := n.eventBus.UnsubscribeAll(context.Background(), remoteAddr)
+			if err != nil && err != cmtpubsub.ErrSubscriptionNotFound {
+				wmLogger.Error("Failed to unsubscribe addr from events", "addr", remoteAddr, "err", err)
+			}
+			env.DropSubscriptionAuth(remoteAddr)
+		}),
+		rpcserver.ReadLimit(config.MaxBodyBytes),
+		rpcserver.WriteChanCapacity(n.config.RPC.WebSocketWriteBufferSize),
+	)
+	wm.SetLogger(wmLogger)
+	mux.HandleFunc("/websocket", wm.WebsocketHandler)
+	rpcserver.RegisterRPCFuncs(mux, routes, rpcLogger)
+
+	if len(corsAllowedOrigins) == 0 {
+		return mux
+	}
+	corsMiddleware := cors.New(cors.Options{
+		AllowedOrigins: corsAllowedOrigins,
+		AllowedMethods: n.config.RPC.CORSAllowedMethods,
+		AllowedHeaders: n.config.RPC.CORSAllowedHeaders,
+	})
+	return corsMiddleware.Handler(mux)
+}
+
+// traceRPCRequest writes a trace of an RPC request served by the node.
+func (n *Node) traceRPCRequest(ctx *rpctypes.Context, method string, duration time.Duration, res rpctypes.RPCResponse) {
+	protocol := "http"
+	if ctx.WSConn != nil {
+		protocol = "websocket"
+	}
+	schema.WriteRPCRequest(n.tracer, method, protocol, duration, len(res.Result), res.Error != nil,
+		schema.CallerClassOf(ctx.RemoteAddr()))
+}
+
+// virtualHostsHandler dispatches the requests to the handler of their Host
+// header, without port. The requests for any other host, or without Host
+// header, are served by defaultHandler.
+func virtualHostsHandler(hosts map[string]http.Handler, defaultHandler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.Host)
+		if err != nil {
+			host = r.Host
+		}
+		host = strings.ToLower(host)
+		if handler, ok := hosts[host]; ok {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		defaultHandler.ServeHTTP(w, r)
+	})
+}
+
+// createTelemetryBeacon returns the beacon reporting the anonymized stats of
+// the node to the telemetry beacon URL, signed with the telemetry key rather
+// than the node key so that the reports can't be linked to the node ID.
+func (n *Node) createTelemetryBeacon() (*beacon.Beacon, error) {
+	telemetryKey, err := p2p.LoadOrGenNodeKey(n.config.TelemetryKeyFile())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load or generate the telemetry key: %w", err)
+	}
+	b := beacon.NewBeacon(
+		n.config.Instrumentation.TelemetryBeaconURL,
+		n.config.Instrumentation.TelemetryBeaconInterval,
+		telemetryKey.PrivKey,
+		func() beacon.Stats {
+			return beacon.Stats{
+				Version:    version.TMCoreSemVer,
+				ChainID:    n.genesisDoc.ChainID,
+				Height:     n.blockStore.Height(),
+				Peers:      n.sw.Peers().Size(),
+				CatchingUp: n.consensusReactor.WaitSync(),
+			}
+		},
+	)
+	b.SetLogger(n.Logger.With("module", "beacon"))
+	return b, nil
+}
+
 // startPrometheusServer starts a Prometheus HTTP server, listening for metrics
 // collectors on addr.
 func (n *Node) startPrometheusServer() *http.Server {
@@ -881,9 +1355,12 @@ func (n *Node) startPrometheusServer() *http.Server {
 
 // starts a ppro
 func (n *Node) startPprofServer() *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/", http.DefaultServeMux)
+	mux.HandleFunc("/debug/reactors", n.serveReactorProfile)
 	srv := &http.Server{
 		Addr:              n.config.RPC.PprofListenAddress,
-		Handler:           nil,
+		Handler:           mux,
 		ReadHeaderTimeout: readHeaderTimeout,
 	}
 	go func() {
@@ -895,6 +1372,32 @@ func (n *Node) startPprofServer() *http.Server {
 	return srv
 }
 
+// defaultReactorProfileSeconds is how long /debug/reactors profiles the node
+// for by default.
+const defaultReactorProfileSeconds = 10
+
+// serveReactorProfile profiles the node for ?seconds (10 by default) and
+// writes the shares of CPU time and allocations of each reactor as JSON.
+func (n *Node) serveReactorProfile(w http.ResponseWriter, r *http.Request) {
+	seconds := defaultReactorProfileSeconds
+	if s := r.URL.Query().Get("seconds"); s != "" {
+		var err error
+		if seconds, err = strconv.Atoi(s); err != nil || seconds <= 0 {
+			http.Error(w, fmt.Sprintf("invalid seconds %q", s), http.StatusBadRequest)
+			return
+		}
+	}
+	profile, err := n.sw.ProfileReactors(r.Context(), time.Duration(seconds)*time.Second)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(profile); err != nil {
+		n.Logger.Error("Failed to write reactor profile", "err", err)
+	}
+}
+
 // Switch returns the Node's Switch.
 func (n *Node) Switch() *p2p.Switch {
 	return n.sw
@@ -968,6 +1471,31 @@ func (n *Node) IsListening() bool {
 	return n.isListening
 }
 
+// SubnetFilter returns the filter of the inbound peer connections by subnet.
+func (n *Node) SubnetFilter() *p2p.SubnetFilter {
+	return n.transport.SubnetFilter()
+}
+
+// PeerBans returns the peers currently banned from the address book.
+func (n *Node) PeerBans() []pex.Ban {
+	return n.addrBook.Bans()
+}
+
+// LatestSeedStatus returns the median status reported by the seeds dialed,
+// or nil if the PEX reactor is disabled or no seed reported its status.
+func (n *Node) LatestSeedStatus() *pex.SeedStatus {
+	if n.pexReactor == nil {
+		return nil
+	}
+	return n.pexReactor.LatestSeedStatus()
+}
+
+// UnbanPeer lifts the ban of the peer with the given ID, returning false if
+// it is not banned.
+func (n *Node) UnbanPeer(id p2p.ID) bool {
+	return n.addrBook.Unban(id)
+}
+
 // NodeInfo returns the Node's Info from the Switch.
 func (n *Node) NodeInfo() p2p.NodeInfo {
 	return n.nodeInfo
@@ -997,7 +1525,7 @@ func makeNodeInfo(
 		Version:       version.TMCoreSemVer,
 		Channels: []byte{
 			bc.BlocksyncChannel,
-			cs.StateChannel, cs.DataChannel, cs.VoteChannel, cs.VoteSetBitsChannel,
+			cs.StateChannel, cs.DataChannel, cs.VoteChannel, cs.VoteSetBitsChannel, cs.GossipChannel,
 			mempl.MempoolChannel,
 			evidence.EvidenceChannel,
 			statesync.SnapshotChannel, statesync.ChunkChannel,
@@ -1007,12 +1535,37 @@ func makeNodeInfo(
 			TxIndex:    txIndexerStatus,
 			RPCAddress: config.RPC.ListenAddress,
 		},
+		MaxPacketMsgPayloadSize: config.P2P.MaxPacketMsgPayloadSize,
 	}
 
 	if config.P2P.PexReactor {
 		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
 	}
 
+	if config.BlockRelay.Enabled() {
+		nodeInfo.Channels = append(nodeInfo.Channels, blockrelay.BlockRelayChannel)
+	}
+
+	if config.P2P.PacketSequence {
+		nodeInfo.Features |= p2p.FeaturePacketSequence
+	}
+
+	if config.P2P.Compression {
+		nodeInfo.Features |= p2p.FeatureCompression
+	}
+
+	if config.P2P.Yamux {
+		nodeInfo.Features |= p2p.FeatureYamux
+	}
+
+	// channels are only registered on established connections when asked to,
+	// so the feature is always advertised
+	nodeInfo.Features |= p2p.FeatureChannelRegistration
+
+	// the status of seeds is only sent when they are asked to, so the
+	// feature is always advertised
+	nodeInfo.Features |= p2p.FeatureSeedStatus
+
 	lAddr := config.P2P.ExternalAddress
 
 	if lAddr == "" {
@@ -3,8 +3,10 @@ package node
 import (
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"syscall"
 	"testing"
@@ -16,22 +18,28 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 
 	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/abci/server"
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/evidence"
 	"github.com/cometbft/cometbft/internal/test"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	"github.com/cometbft/cometbft/libs/service"
 	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/node/beacon"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/conn"
 	p2pmock "github.com/cometbft/cometbft/p2p/mock"
+	"github.com/cometbft/cometbft/p2p/pex"
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/store"
 	"github.com/cometbft/cometbft/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
+	"github.com/cometbft/cometbft/version"
 )
 
 func TestNodeStartStop(t *testing.T) {
@@ -77,6 +85,131 @@ func TestNodeStartStop(t *testing.T) {
 	}
 }
 
+func TestNodeLifecycle(t *testing.T) {
+	config := test.ResetTestRoot("node_lifecycle_test")
+	defer os.RemoveAll(config.RootDir)
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	assert.Equal(t, types.NodePhaseStarting, n.Phase())
+
+	phaseSub, err := n.EventBus().Subscribe(context.Background(), "node_test", types.EventQueryNodePhase)
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+
+	// the only validator doesn't sync
+	select {
+	case msg := <-phaseSub.Out():
+		data := msg.Data().(types.EventDataNodePhase)
+		assert.Equal(t, types.NodePhaseStarting, data.From)
+		assert.Equal(t, types.NodePhaseConsensus, data.To)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the node phase event")
+	}
+	assert.Equal(t, types.NodePhaseConsensus, n.Phase())
+
+	require.NoError(t, n.Stop())
+	var phases []types.NodePhase
+	for _, transition := range n.lifecycle.Transitions() {
+		phases = append(phases, transition.To)
+	}
+	assert.Equal(t, []types.NodePhase{
+		types.NodePhaseConsensus,
+		types.NodePhaseDraining,
+		types.NodePhaseStopped,
+	}, phases)
+	assert.Error(t, n.lifecycle.transition(types.NodePhaseConsensus))
+}
+
+func TestNodeResourceUsageEvents(t *testing.T) {
+	config := test.ResetTestRoot("node_resource_usage_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Instrumentation.ResourceUsageEvents = true
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	usageSub, err := n.EventBus().Subscribe(context.Background(), "node_test", types.EventQueryResourceUsage)
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	defer func() {
+		require.NoError(t, n.Stop())
+	}()
+
+	select {
+	case msg := <-usageSub.Out():
+		usage := msg.Data().(types.EventDataResourceUsage)
+		assert.EqualValues(t, 1, usage.Height)
+		assert.Positive(t, usage.DBWriteBytes)
+	case <-usageSub.Canceled():
+		t.Fatal("usageSub was canceled")
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the resource usage of the first block")
+	}
+}
+
+func TestNodeSolo(t *testing.T) {
+	config := test.ResetTestRoot("node_solo_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Solo = true
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	assert.False(t, n.config.Consensus.CreateEmptyBlocks)
+
+	blocksSub, err := n.EventBus().Subscribe(context.Background(), "node_test", types.EventQueryNewBlock)
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	defer func() {
+		require.NoError(t, n.Stop())
+	}()
+	assert.False(t, n.IsListening())
+	assert.False(t, n.Switch().IsRunning())
+
+	// a block is produced as soon as a tx arrives
+	tx := types.Tx("solo=1")
+	require.NoError(t, n.Mempool().CheckTx(tx, nil, mempl.TxInfo{}))
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case msg := <-blocksSub.Out():
+			block := msg.Data().(types.EventDataNewBlock).Block
+			if block.Txs.Index(tx) != -1 {
+				return
+			}
+		case <-blocksSub.Canceled():
+			t.Fatal("blocksSub was canceled")
+		case <-timeout:
+			t.Fatal("timed out waiting for the tx to be committed")
+		}
+	}
+}
+
+func TestNodeSoloNotOnlyValidator(t *testing.T) {
+	config := test.ResetTestRoot("node_solo_test")
+	defer os.RemoveAll(config.RootDir)
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	require.NoError(t, err)
+	n, err := NewNode(config,
+		privval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()),
+		nodeKey,
+		proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
+		DefaultGenesisDocProviderFunc(config),
+		cfg.DefaultDBProvider,
+		DefaultMetricsProvider(config.Instrumentation),
+		log.TestingLogger(),
+		Solo(),
+	)
+	require.NoError(t, err)
+	// the solo settings are applied before the reactors are built
+	assert.True(t, n.solo)
+	assert.True(t, n.config.Consensus.SkipTimeoutCommit)
+	assert.Nil(t, n.pexReactor)
+	// replace our key with another one, which is not in the validator set
+	n.privValidator = types.NewMockPV()
+	assert.Error(t, n.Start())
+}
+
 func TestSplitAndTrimEmpty(t *testing.T) {
 	testCases := []struct {
 		s        string
@@ -157,6 +290,133 @@ func TestPprofServer(t *testing.T) {
 	assert.Equal(t, 200, resp.StatusCode)
 }
 
+func TestNodeRPCVirtualHosts(t *testing.T) {
+	config := test.ResetTestRoot("node_rpc_vhosts_test")
+	defer os.RemoveAll(config.RootDir)
+	publicAddr, internalAddr := testFreeAddr(t), testFreeAddr(t)
+	config.RPC.ListenAddress = "tcp://" + publicAddr + ",tcp://" + internalAddr
+	config.RPC.VirtualHosts = []*cfg.RPCVirtualHost{
+		{Name: "public", ListenAddresses: []string{"tcp://" + publicAddr}, Methods: []string{"status", "genesis*"}},
+		{Name: "rate-limited", Hosts: []string{"limited.example.com"}, RateLimit: 0.001, RateBurst: 1},
+	}
+	require.NoError(t, config.RPC.ValidateBasic())
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	defer func() {
+		require.NoError(t, n.Stop())
+	}()
+
+	get := func(addr, host, method string) int {
+		req, err := http.NewRequest(http.MethodGet, "http://"+addr+"/"+method, nil)
+		require.NoError(t, err)
+		req.Host = host
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		_, err = io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res.StatusCode
+	}
+
+	// the public listen address only serves its methods
+	assert.Equal(t, http.StatusOK, get(publicAddr, "", "status"))
+	assert.Equal(t, http.StatusOK, get(publicAddr, "", "genesis_chunked?chunk=0"))
+	assert.Equal(t, http.StatusNotFound, get(publicAddr, "", "net_info"))
+
+	// the internal one serves all of them, and rate limits the limited host
+	assert.Equal(t, http.StatusOK, get(internalAddr, "", "net_info"))
+	assert.Equal(t, http.StatusOK, get(internalAddr, "limited.example.com", "net_info"))
+	assert.Equal(t, http.StatusTooManyRequests, get(internalAddr, "limited.example.com:26657", "net_info"))
+	assert.Equal(t, http.StatusOK, get(internalAddr, "other.example.com", "net_info"))
+	assert.Equal(t, http.StatusOK, get(internalAddr, "localhost", "net_info"))
+}
+
+func TestNodeTelemetryBeacon(t *testing.T) {
+	reports := make(chan beacon.SignedReport, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var sr beacon.SignedReport
+		body, err := io.ReadAll(r.Body)
+		if err == nil {
+			err = cmtjson.Unmarshal(body, &sr)
+		}
+		assert.NoError(t, err)
+		select {
+		case reports <- sr:
+		default:
+		}
+	}))
+	defer srv.Close()
+
+	config := test.ResetTestRoot("node_telemetry_beacon_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Instrumentation.TelemetryBeaconURL = srv.URL
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	defer func() {
+		require.NoError(t, n.Stop())
+	}()
+
+	select {
+	case sr := <-reports:
+		report, err := sr.Verify()
+		require.NoError(t, err)
+		// the reports are signed with the telemetry key, not the node key
+		assert.NotEqual(t, n.NodeInfo().ID(), p2p.PubKeyToID(sr.PubKey))
+		telemetryKey, err := p2p.LoadNodeKey(config.TelemetryKeyFile())
+		require.NoError(t, err)
+		assert.Equal(t, telemetryKey.PubKey(), sr.PubKey)
+		assert.Equal(t, version.TMCoreSemVer, report.Stats.Version)
+		assert.Equal(t, n.GenesisDoc().ChainID, report.Stats.ChainID)
+	case <-time.After(10 * time.Second):
+		t.Fatal("no telemetry report posted")
+	}
+}
+
+func TestNodeProxyAppFailover(t *testing.T) {
+	startApp := func() (string, service.Service) {
+		addr := fmt.Sprintf("unix:///tmp/node_failover_%v.sock", cmtrand.Str(6))
+		s := server.NewSocketServer(addr, kvstore.NewInMemoryApplication())
+		s.SetLogger(log.TestingLogger().With("module", "abci-server"))
+		require.NoError(t, s.Start())
+		t.Cleanup(func() { _ = s.Stop() })
+		return addr, s
+	}
+	primaryAddr, primary := startApp()
+	replicaAddr, _ := startApp()
+
+	config := test.ResetTestRoot("node_proxy_app_failover_test")
+	defer os.RemoveAll(config.RootDir)
+	config.ProxyApp = primaryAddr
+	config.ProxyAppFailover = []string{replicaAddr}
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	defer func() {
+		require.NoError(t, n.Stop())
+		require.NoError(t, n.ProxyApp().Stop())
+	}()
+
+	waitForHeight := func(height int64) {
+		require.Eventually(t, func() bool {
+			return n.BlockStore().Height() >= height
+		}, 30*time.Second, 10*time.Millisecond)
+	}
+	waitForHeight(3)
+
+	// the replica, which starts empty, catches up with the chain
+	require.NoError(t, primary.Stop())
+	height := n.BlockStore().Height()
+	waitForHeight(height + 2)
+	res, err := n.ProxyApp().Query().Info(context.Background(), proxy.RequestInfo)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, res.LastBlockHeight, height)
+}
+
 func TestNodeSetPrivValTCP(t *testing.T) {
 	addr := "tcp://" + testFreeAddr(t)
 
@@ -464,6 +724,52 @@ func TestNodeNewNodeCustomReactors(t *testing.T) {
 	assert.Contains(t, channels, cr.Channels[0].ID)
 }
 
+func TestNodeAuxiliarySwitch(t *testing.T) {
+	config := test.ResetTestRoot("node_auxiliary_switch_test")
+	defer os.RemoveAll(config.RootDir)
+
+	auxReactor := p2pmock.NewReactor()
+	auxReactor.Channels = []*conn.ChannelDescriptor{{ID: byte(0x31), Priority: 1}}
+	auxConfig := cfg.TestP2PConfig()
+	auxConfig.RootDir = config.RootDir
+	auxConfig.ListenAddress = "tcp://" + testFreeAddr(t)
+	auxConfig.AddrBook = "config/aux_addrbook.json"
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	require.NoError(t, err)
+
+	n, err := NewNode(config,
+		privval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()),
+		nodeKey,
+		proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
+		DefaultGenesisDocProviderFunc(config),
+		cfg.DefaultDBProvider,
+		DefaultMetricsProvider(config.Instrumentation),
+		log.TestingLogger(),
+		AuxiliarySwitch(AuxiliaryNetwork{
+			Network:  "aux-network",
+			P2P:      auxConfig,
+			Reactors: map[string]p2p.Reactor{"AUX": auxReactor},
+		}),
+	)
+	require.NoError(t, err)
+
+	err = n.Start()
+	require.NoError(t, err)
+	defer n.Stop() //nolint:errcheck // ignore for tests
+
+	auxSw := n.AuxiliarySwitches()["aux-network"]
+	require.NotNil(t, auxSw)
+	assert.True(t, auxReactor.IsRunning())
+	assert.Equal(t, auxReactor, auxSw.Reactor("AUX"))
+	assert.Nil(t, n.Switch().Reactor("AUX"))
+
+	auxInfo := auxSw.NodeInfo().(p2p.DefaultNodeInfo)
+	assert.Equal(t, "aux-network", auxInfo.Network)
+	assert.Equal(t, []byte{0x31, pex.PexChannel}, []byte(auxInfo.Channels))
+	assert.NotEqual(t, n.NodeInfo().(p2p.DefaultNodeInfo).Network, auxInfo.Network)
+}
+
 func state(nVals int, height int64) (sm.State, dbm.DB, []types.PrivValidator) {
 	privVals := make([]types.PrivValidator, nVals)
 	vals := make([]types.GenesisValidator, nVals)
@@ -3,10 +3,17 @@ package node
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"net"
+	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	_ "net/http/pprof" //nolint: gosec // securely exposed on separate, optional port
@@ -14,6 +21,7 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/blockrelay"
 	"github.com/cometbft/cometbft/blocksync"
 	cfg "github.com/cometbft/cometbft/config"
 	cs "github.com/cometbft/cometbft/consensus"
@@ -23,6 +31,7 @@ import (
 
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/libs/trace"
 	"github.com/cometbft/cometbft/light"
 	mempl "github.com/cometbft/cometbft/mempool"
@@ -37,6 +46,7 @@ import (
 	"github.com/cometbft/cometbft/state/indexer/block"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/store/migrate"
 	"github.com/cometbft/cometbft/types"
 	"github.com/cometbft/cometbft/version"
 
@@ -51,13 +61,92 @@ const readHeaderTimeout = 10 * time.Second
 type GenesisDocProvider func() (*types.GenesisDoc, error)
 
 // DefaultGenesisDocProviderFunc returns a GenesisDocProvider that loads
-// the GenesisDoc from the config.GenesisFile() on the filesystem.
+// the GenesisDoc from the config.GenesisFile() on the filesystem. If
+// config.GenesisURL is set, the file is downloaded first if it does not exist.
 func DefaultGenesisDocProviderFunc(config *cfg.Config) GenesisDocProvider {
 	return func() (*types.GenesisDoc, error) {
+		if config.GenesisURL != "" {
+			err := fetchGenesisFile(context.Background(), http.DefaultClient, config)
+			if err != nil {
+				return nil, err
+			}
+		}
 		return types.GenesisDocFromFile(config.GenesisFile())
 	}
 }
 
+// fetchGenesisFile downloads the genesis file from config.GenesisURL to
+// config.GenesisFile(), unless it is already there, and verifies its hash
+// against config.GenesisSHA256. The file is only written once verified, so a
+// file found at the path is one downloaded before, or one provided by the
+// operator, and is checked the same way.
+func fetchGenesisFile(ctx context.Context, client *http.Client, config *cfg.Config) error {
+	expected, err := hex.DecodeString(config.GenesisSHA256)
+	if err != nil || len(expected) != sha256.Size {
+		return errors.New("genesis_sha256 must be a hex encoded SHA-256 hash")
+	}
+	path := config.GenesisFile()
+
+	f, err := os.Open(path)
+	switch {
+	case err == nil:
+		defer f.Close()
+		h := sha256.New()
+		if _, err := io.Copy(h, f); err != nil {
+			return fmt.Errorf("error when hashing genesis file: %w", err)
+		}
+		if actual := h.Sum(nil); !bytes.Equal(expected, actual) {
+			return fmt.Errorf("genesis file %s hash %X does not match genesis_sha256 %X", path, actual, expected)
+		}
+		return nil
+	case !os.IsNotExist(err):
+		return fmt.Errorf("can't open genesis file: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, config.GenesisURL, nil)
+	if err != nil {
+		return fmt.Errorf("invalid genesis_url: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download genesis file: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download genesis file: %s", resp.Status)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create genesis file directory: %w", err)
+	}
+	// Download next to the destination, so that the verified file can be
+	// renamed into place.
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create genesis file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tmp, h), resp.Body); err != nil {
+		return fmt.Errorf("failed to download genesis file: %w", err)
+	}
+	if actual := h.Sum(nil); !bytes.Equal(expected, actual) {
+		return fmt.Errorf("downloaded genesis file hash %X does not match genesis_sha256 %X", actual, expected)
+	}
+	if err := tmp.Sync(); err != nil {
+		return fmt.Errorf("failed to write genesis file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write genesis file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write genesis file: %w", err)
+	}
+	return nil
+}
+
 // Provider takes a config and a logger and returns a ready to go Node.
 type Provider func(*cfg.Config, log.Logger) (*Node, error)
 
@@ -70,10 +159,15 @@ func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 		return nil, fmt.Errorf("failed to load or gen node key %s: %w", config.NodeKeyFile(), err)
 	}
 
+	pv := privval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	if config.PrivValidatorVRF {
+		pv.EnableVRF()
+	}
+
 	return NewNode(config,
-		privval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()),
+		pv,
 		nodeKey,
-		proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
+		defaultClientCreator(config),
 		DefaultGenesisDocProviderFunc(config),
 		cfg.DefaultDBProvider,
 		DefaultMetricsProvider(config.Instrumentation),
@@ -81,6 +175,15 @@ func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 	)
 }
 
+// defaultClientCreator returns the creator of the clients of the ABCI application,
+// failing over to the proxy_app_failover addresses if any.
+func defaultClientCreator(config *cfg.Config) proxy.ClientCreator {
+	if len(config.ProxyAppFailover) > 0 {
+		return proxy.NewFailoverClientCreator(append([]string{config.ProxyApp}, config.ProxyAppFailover...), config.ABCI)
+	}
+	return proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir())
+}
+
 // MetricsProvider returns a consensus, p2p and mempool Metrics.
 type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics, *blocksync.Metrics, *statesync.Metrics)
 
@@ -101,6 +204,30 @@ func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
 	}
 }
 
+// componentMetrics holds the metrics of the node components which are not
+// returned by MetricsProvider, so that its signature stays stable as
+// components are added.
+type componentMetrics struct {
+	store    *store.Metrics
+	routines *service.Metrics
+}
+
+// defaultComponentMetrics returns the componentMetrics built using the
+// Prometheus client library if Prometheus is enabled. Otherwise, it returns
+// no-op metrics.
+func defaultComponentMetrics(config *cfg.InstrumentationConfig, chainID string) *componentMetrics {
+	if config.Prometheus {
+		return &componentMetrics{
+			store:    store.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+			routines: service.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+		}
+	}
+	return &componentMetrics{
+		store:    store.NopMetrics(),
+		routines: service.NopMetrics(),
+	}
+}
+
 type blockSyncReactor interface {
 	SwitchToBlockSync(sm.State) error
 }
@@ -127,8 +254,115 @@ func initDBs(config *cfg.Config, dbProvider cfg.DBProvider) (blockStore *store.B
 	return
 }
 
-func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, logger log.Logger, metrics *proxy.Metrics) (proxy.AppConns, error) {
-	proxyApp := proxy.NewAppConns(clientCreator, metrics)
+// readCacheDBProvider wraps dbProvider and caches the hot values read from
+// the block store and state databases in readCache.
+func readCacheDBProvider(dbProvider cfg.DBProvider, readCache *store.ReadCache) cfg.DBProvider {
+	return func(ctx *cfg.DBContext) (dbm.DB, error) {
+		db, err := dbProvider(ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch ctx.ID {
+		case "blockstore":
+			return readCache.Wrap(db, ctx.ID, store.CacheableKey), nil
+		case "state":
+			return readCache.Wrap(db, ctx.ID, sm.CacheableKey), nil
+		default:
+			return db, nil
+		}
+	}
+}
+
+type openedDB struct {
+	id  string
+	db  dbm.DB
+	dir string
+}
+
+// recordingDBProvider wraps dbProvider and records every database it opens,
+// so that their storage statistics can be collected once the node is set up.
+// The on-disk location is assumed to follow DefaultDBProvider, see
+// cfg.DBPath.
+func recordingDBProvider(dbProvider cfg.DBProvider, opened *[]openedDB) cfg.DBProvider {
+	return func(ctx *cfg.DBContext) (dbm.DB, error) {
+		db, err := dbProvider(ctx)
+		if err != nil {
+			return nil, err
+		}
+		*opened = append(*opened, openedDB{id: ctx.ID, db: db, dir: cfg.DBPath(ctx)})
+		return db, nil
+	}
+}
+
+// schemaDBProvider wraps dbProvider and checks the schema version of every
+// database it opens, stamping the new ones with the latest version.
+func schemaDBProvider(dbProvider cfg.DBProvider, registry *migrate.Registry) cfg.DBProvider {
+	return func(ctx *cfg.DBContext) (dbm.DB, error) {
+		db, err := dbProvider(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := registry.Check(ctx.ID, db); err != nil {
+			_ = db.Close()
+			if errors.Is(err, migrate.ErrMigrationRequired) {
+				return nil, fmt.Errorf("%w, run `cometbft migrate`", err)
+			}
+			return nil, err
+		}
+		return db, nil
+	}
+}
+
+// countingDBProvider wraps dbProvider and counts the bytes written to every
+// database it opens.
+func countingDBProvider(dbProvider cfg.DBProvider, counter *store.WriteCounter) cfg.DBProvider {
+	return func(ctx *cfg.DBContext) (dbm.DB, error) {
+		db, err := dbProvider(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return counter.Wrap(db), nil
+	}
+}
+
+func createStorageCollector(
+	config *cfg.InstrumentationConfig,
+	opened []openedDB,
+	metrics *store.Metrics,
+	logger log.Logger,
+) *store.StorageCollector {
+	if config.StorageMetricsInterval == 0 {
+		return nil
+	}
+	collector := store.NewStorageCollector(config.StorageMetricsInterval, metrics)
+	collector.SetLogger(logger.With("module", "storage"))
+	for _, o := range opened {
+		collector.AddDB(o.id, o.db, o.dir)
+	}
+	return collector
+}
+
+// eventLimits returns the limits on the events of the application.
+func eventLimits(config *cfg.Config) proxy.EventLimits {
+	return proxy.EventLimits{
+		MaxEventsPerTx:              config.ABCIMaxEventsPerTx,
+		MaxEventBytesPerTx:          config.ABCIMaxEventBytesPerTx,
+		MaxEventAttributeKeyBytes:   config.ABCIMaxEventAttributeKeyBytes,
+		MaxEventAttributeValueBytes: config.ABCIMaxEventAttributeValueBytes,
+	}
+}
+
+func createAndStartProxyAppConns(
+	clientCreator proxy.ClientCreator,
+	config *cfg.Config,
+	logger log.Logger,
+	metrics *proxy.Metrics,
+	stateStore sm.Store,
+	blockStore sm.BlockStore,
+	genDoc *types.GenesisDoc,
+) (proxy.AppConns, error) {
+	proxyApp := proxy.NewAppConns(clientCreator, metrics, proxy.WithEventLimits(eventLimits(config)),
+		proxy.WithFailoverHook(failoverHandshake(stateStore, blockStore, genDoc, logger.With("module", "consensus"))))
 	proxyApp.SetLogger(logger.With("module", "proxy"))
 	if err := proxyApp.Start(); err != nil {
 		return nil, fmt.Errorf("error starting proxy app connections: %v", err)
@@ -136,6 +370,39 @@ func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, logger log.L
 	return proxyApp, nil
 }
 
+// failoverHandshake returns the failover hook of the proxy app connections,
+// which replays the committed blocks missing in the application failed over
+// to. The block being committed, if any, is finalized again by the proxy app
+// connections.
+func failoverHandshake(
+	stateStore sm.Store,
+	blockStore sm.BlockStore,
+	genDoc *types.GenesisDoc,
+	consensusLogger log.Logger,
+) func(proxy.AppConns) error {
+	return func(proxyApp proxy.AppConns) error {
+		state, err := stateStore.Load()
+		if err != nil {
+			return fmt.Errorf("cannot load state: %w", err)
+		}
+		handshaker := cs.NewHandshaker(stateStore, state, committedBlockStore{blockStore, state.LastBlockHeight}, genDoc)
+		handshaker.SetLogger(consensusLogger)
+		_, err = handshaker.Handshake(proxyApp)
+		return err
+	}
+}
+
+// committedBlockStore is a block store whose height is the height of the
+// last committed block, hiding a block saved but not committed yet.
+type committedBlockStore struct {
+	sm.BlockStore
+	height int64
+}
+
+func (bs committedBlockStore) Height() int64 {
+	return bs.height
+}
+
 func createAndStartEventBus(logger log.Logger) (*types.EventBus, error) {
 	eventBus := types.NewEventBus()
 	eventBus.SetLogger(logger.With("module", "events"))
@@ -170,6 +437,7 @@ func createAndStartIndexerService(
 
 	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus, false)
 	indexerService.SetLogger(logger.With("module", "txindex"))
+	indexerService.SetIndexResourceUsage(config.Instrumentation.ResourceUsageEvents && config.TxIndex.IndexResourceUsage)
 	if err := indexerService.Start(); err != nil {
 		return nil, nil, nil, err
 	}
@@ -186,15 +454,15 @@ func doHandshake(
 	eventBus types.BlockEventPublisher,
 	proxyApp proxy.AppConns,
 	consensusLogger log.Logger,
-) (string, error) {
+) (softwareVersion string, haltHeight int64, err error) {
 	handshaker := cs.NewHandshaker(stateStore, state, blockStore, genDoc)
 	handshaker.SetLogger(consensusLogger)
 	handshaker.SetEventBus(eventBus)
-	softwareVersion, err := handshaker.HandshakeWithContext(ctx, proxyApp)
+	softwareVersion, err = handshaker.HandshakeWithContext(ctx, proxyApp)
 	if err != nil {
-		return "", fmt.Errorf("error during handshake: %v", err)
+		return "", 0, fmt.Errorf("error during handshake: %v", err)
 	}
-	return softwareVersion, nil
+	return softwareVersion, handshaker.HaltHeight(), nil
 }
 
 func logNodeStartupInfo(state sm.State, pubKey crypto.PubKey, logger, consensusLogger log.Logger) {
@@ -237,7 +505,11 @@ func createMempoolAndMempoolReactor(
 	config *cfg.Config,
 	proxyApp proxy.AppConns,
 	state sm.State,
+	admission *mempl.AdmissionFilter,
+	reapQuota *mempl.ReapQuota,
+	evictionHook *mempl.EvictionHook,
 	memplMetrics *mempl.Metrics,
+	eventBus types.MempoolEventPublisher,
 	logger log.Logger,
 	traceClient trace.Tracer,
 ) (mempl.Mempool, p2p.Reactor) {
@@ -250,6 +522,9 @@ func createMempoolAndMempoolReactor(
 			proxyApp.Mempool(),
 			state.LastBlockHeight,
 			mempl.WithMetrics(memplMetrics),
+			mempl.WithEventPublisher(eventBus),
+			mempl.WithAdmissionFilter(admission.Check),
+			mempl.WithReapQuota(reapQuota),
 			mempl.WithPreCheck(sm.TxPreCheck(state)),
 			mempl.WithPostCheck(sm.TxPostCheck(state)),
 			mempl.WithTraceClient(traceClient),
@@ -276,6 +551,10 @@ func createMempoolAndMempoolReactor(
 			proxyApp.Mempool(),
 			state.LastBlockHeight,
 			priority.WithMetrics(memplMetrics),
+			priority.WithEventPublisher(eventBus),
+			priority.WithAdmissionFilter(admission.Check),
+			priority.WithReapQuota(reapQuota),
+			priority.WithEvictionHook(evictionHook),
 			priority.WithPreCheck(sm.TxPreCheck(state)),
 		)
 		reactor := priority.NewReactor(
@@ -291,6 +570,10 @@ func createMempoolAndMempoolReactor(
 			proxyApp.Mempool(),
 			state.LastBlockHeight,
 			cat.WithMetrics(memplMetrics),
+			cat.WithEventPublisher(eventBus),
+			cat.WithAdmissionFilter(admission.Check),
+			cat.WithReapQuota(reapQuota),
+			cat.WithEvictionHook(evictionHook),
 			cat.WithPreCheck(sm.TxPreCheck(state)),
 			cat.WithPostCheck(sm.TxPostCheck(state)),
 		)
@@ -298,10 +581,12 @@ func createMempoolAndMempoolReactor(
 		reactor, err := cat.NewReactor(
 			mp,
 			&cat.ReactorOptions{
-				ListenOnly:     !config.Mempool.Broadcast,
-				MaxTxSize:      config.Mempool.MaxTxBytes,
-				TraceClient:    traceClient,
-				MaxGossipDelay: config.Mempool.MaxGossipDelay,
+				ListenOnly:        !config.Mempool.Broadcast,
+				MaxTxSize:         config.Mempool.MaxTxBytes,
+				TraceClient:       traceClient,
+				MaxGossipDelay:    config.Mempool.MaxGossipDelay,
+				GossipFanout:      config.Mempool.GossipFanout,
+				GossipDelayJitter: config.Mempool.GossipDelayJitter,
 			},
 		)
 		if err != nil {
@@ -359,6 +644,19 @@ func createBlocksyncReactor(config *cfg.Config,
 	return bcReactor, nil
 }
 
+func createBlockRelayReactor(config *cfg.Config,
+	blockExec *sm.BlockExecutor,
+	blockStore *store.BlockStore,
+	eventBus *types.EventBus,
+	localAddr crypto.Address,
+	logger log.Logger,
+) *blockrelay.Reactor {
+	blockRelayReactor := blockrelay.NewReactor(config.BlockRelay, blockExec, blockStore, localAddr)
+	blockRelayReactor.SetEventBus(eventBus)
+	blockRelayReactor.SetLogger(logger.With("module", "blockrelay"))
+	return blockRelayReactor
+}
+
 func createConsensusReactor(config *cfg.Config,
 	state sm.State,
 	blockExec *sm.BlockExecutor,
@@ -372,6 +670,7 @@ func createConsensusReactor(config *cfg.Config,
 	consensusLogger log.Logger,
 	offlineStateSyncHeight int64,
 	traceClient trace.Tracer,
+	onSwitchToConsensus func(),
 ) (*cs.Reactor, *cs.State) {
 	consensusState := cs.NewState(
 		config.Consensus,
@@ -388,7 +687,11 @@ func createConsensusReactor(config *cfg.Config,
 	if privValidator != nil {
 		consensusState.SetPrivValidator(privValidator)
 	}
-	consensusReactor := cs.NewReactor(consensusState, waitSync, cs.ReactorMetrics(csMetrics), cs.ReactorTracing(traceClient))
+	consensusReactor := cs.NewReactor(consensusState, waitSync,
+		cs.ReactorMetrics(csMetrics),
+		cs.ReactorTracing(traceClient),
+		cs.ReactorOnSwitchToConsensus(onSwitchToConsensus),
+	)
 	consensusReactor.SetLogger(consensusLogger)
 	// services which will be publishing and/or subscribing for messages (events)
 	// consensusReactor will set it on consensusState and blockExecutor
@@ -408,11 +711,17 @@ func createTransport(
 ) {
 	var (
 		mConnConfig = p2p.MConnConfig(config.P2P)
-		transport   = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig, traceClient)
+		transport   *p2p.MultiplexTransport
 		connFilters = []p2p.ConnFilterFunc{}
 		peerFilters = []p2p.PeerFilterFunc{}
 	)
 
+	if config.P2P.Transport == cfg.P2PTransportQUIC {
+		transport = p2p.NewQUICTransport(nodeInfo, *nodeKey, mConnConfig, traceClient)
+	} else {
+		transport = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig, traceClient)
+	}
+
 	if !config.P2P.AllowDuplicateIP {
 		connFilters = append(connFilters, p2p.ConnDuplicateIPFilter())
 	}
@@ -463,12 +772,27 @@ func createTransport(
 	max := config.P2P.MaxNumInboundPeers + len(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
 	p2p.MultiplexTransportMaxIncomingConnections(max)(transport)
 
+	if config.P2P.InboundConnRate > 0 || config.P2P.InboundSubnetConnRate > 0 {
+		p2p.MultiplexTransportConnThrottle(p2p.NewConnThrottle(
+			config.P2P.InboundConnRate,
+			config.P2P.InboundConnBurst,
+			config.P2P.InboundSubnetConnRate,
+			config.P2P.InboundSubnetConnBurst,
+			config.P2P.InboundConnBanDuration,
+		))(transport)
+	}
+
+	if config.P2P.ProxyAddress != "" {
+		p2p.MultiplexTransportProxy(config.P2P.ProxyAddress)(transport)
+	}
+
 	return transport, peerFilters
 }
 
 func createSwitch(config *cfg.Config,
 	transport p2p.Transport,
 	p2pMetrics *p2p.Metrics,
+	traffic *p2p.Traffic,
 	peerFilters []p2p.PeerFilterFunc,
 	mempoolReactor p2p.Reactor,
 	bcReactor p2p.Reactor,
@@ -484,6 +808,7 @@ func createSwitch(config *cfg.Config,
 		config.P2P,
 		transport,
 		p2p.WithMetrics(p2pMetrics),
+		p2p.WithTraffic(traffic),
 		p2p.SwitchPeerFilters(peerFilters...),
 		p2p.WithTracer(traceClient),
 	)
@@ -503,22 +828,58 @@ func createSwitch(config *cfg.Config,
 	return sw
 }
 
-func createAddrBookAndSetOnSwitch(config *cfg.Config, sw *p2p.Switch,
+// countingMempoolEventPublisher counts the transactions admitted to the
+// mempool.
+type countingMempoolEventPublisher struct {
+	types.MempoolEventPublisher
+	added atomic.Int64
+}
+
+func (p *countingMempoolEventPublisher) PublishEventNewMempoolTx(tx types.EventDataNewMempoolTx) error {
+	p.added.Add(1)
+	return p.MempoolEventPublisher.PublishEventNewMempoolTx(tx)
+}
+
+// createResourceUsageReporter returns the reporter publishing the resources
+// used at each height, measured by the given counters.
+func createResourceUsageReporter(
+	eventBus types.ResourceUsageEventPublisher,
+	traffic *p2p.Traffic,
+	dbWrites *store.WriteCounter,
+	mempoolEvents *countingMempoolEventPublisher,
+	mempool mempl.Mempool,
+) *sm.ResourceUsageReporter {
+	return sm.NewResourceUsageReporter(eventBus, sm.ResourceUsageSources{
+		Traffic: func() []types.ChannelUsage {
+			channels := traffic.Channels()
+			usage := make([]types.ChannelUsage, len(channels))
+			for i, ch := range channels {
+				usage[i] = types.ChannelUsage{ID: ch.ID, SentBytes: ch.SentBytes, ReceivedBytes: ch.ReceivedBytes}
+			}
+			return usage
+		},
+		DBWriteBytes:    dbWrites.Written,
+		MempoolTxsAdded: mempoolEvents.added.Load,
+		MempoolSize:     mempool.Size,
+	})
+}
+
+func createAddrBookAndSetOnSwitch(config *cfg.P2PConfig, network string, sw *p2p.Switch,
 	p2pLogger log.Logger, nodeKey *p2p.NodeKey,
 ) (pex.AddrBook, error) {
-	addrBook := pex.NewAddrBook(config.P2P.AddrBookFile(), config.P2P.AddrBookStrict)
-	addrBook.SetLogger(p2pLogger.With("book", config.P2P.AddrBookFile()))
+	addrBook := pex.NewAddrBook(config.AddrBookFile(), config.AddrBookStrict, pex.AddrBookNetwork(network))
+	addrBook.SetLogger(p2pLogger.With("book", config.AddrBookFile()))
 
 	// Add ourselves to addrbook to prevent dialing ourselves
-	if config.P2P.ExternalAddress != "" {
-		addr, err := p2p.NewNetAddressString(p2p.IDAddressString(nodeKey.ID(), config.P2P.ExternalAddress))
+	if config.ExternalAddress != "" {
+		addr, err := p2p.NewNetAddressString(p2p.IDAddressString(nodeKey.ID(), config.ExternalAddress))
 		if err != nil {
 			return nil, fmt.Errorf("p2p.external_address is incorrect: %w", err)
 		}
 		addrBook.AddOurAddress(addr)
 	}
-	if config.P2P.ListenAddress != "" {
-		addr, err := p2p.NewNetAddressString(p2p.IDAddressString(nodeKey.ID(), config.P2P.ListenAddress))
+	if config.ListenAddress != "" {
+		addr, err := p2p.NewNetAddressString(p2p.IDAddressString(nodeKey.ID(), config.ListenAddress))
 		if err != nil {
 			return nil, fmt.Errorf("p2p.laddr is incorrect: %w", err)
 		}
@@ -530,21 +891,33 @@ func createAddrBookAndSetOnSwitch(config *cfg.Config, sw *p2p.Switch,
 	return addrBook, nil
 }
 
-func createPEXReactorAndAddToSwitch(addrBook pex.AddrBook, config *cfg.Config,
-	sw *p2p.Switch, logger log.Logger,
+func createPEXReactorAndAddToSwitch(addrBook pex.AddrBook, config *cfg.P2PConfig,
+	sw *p2p.Switch, blockStore sm.BlockStore, logger log.Logger,
 ) *pex.Reactor {
+	var latestHeader func() *types.Header
+	if config.SeedServeStatus {
+		latestHeader = func() *types.Header {
+			meta := blockStore.LoadBlockMeta(blockStore.Height())
+			if meta == nil {
+				return nil
+			}
+			return &meta.Header
+		}
+	}
 	// TODO persistent peers ? so we can have their DNS addrs saved
 	pexReactor := pex.NewReactor(addrBook,
 		&pex.ReactorConfig{
-			Seeds:    splitAndTrimEmpty(config.P2P.Seeds, ",", " "),
-			SeedMode: config.P2P.SeedMode,
+			Seeds:    splitAndTrimEmpty(config.Seeds, ",", " "),
+			SeedMode: config.SeedMode,
 			// See consensus/reactor.go: blocksToContributeToBecomeGoodPeer 10000
 			// blocks assuming 10s blocks ~ 28 hours.
 			// TODO (melekes): make it dynamic based on the actual block latencies
 			// from the live network.
 			// https://github.com/tendermint/tendermint/issues/3523
 			SeedDisconnectWaitPeriod:     28 * time.Hour,
-			PersistentPeersMaxDialPeriod: config.P2P.PersistentPeersMaxDialPeriod,
+			SeedGracePeriod:              config.SeedGracePeriod,
+			LatestHeader:                 latestHeader,
+			PersistentPeersMaxDialPeriod: config.PersistentPeersMaxDialPeriod,
 		})
 	pexReactor.SetLogger(logger.With("module", "pex"))
 	sw.AddReactor("PEX", pexReactor)
@@ -674,9 +1047,10 @@ func saveGenesisDoc(db dbm.DB, genDoc *types.GenesisDoc) error {
 func createAndStartPrivValidatorSocketClient(
 	listenAddr,
 	chainID string,
+	maxInFlight int,
 	logger log.Logger,
 ) (types.PrivValidator, error) {
-	pve, err := privval.NewSignerListener(listenAddr, logger)
+	pve, err := privval.NewSignerListener(listenAddr, logger, privval.SignerListenerEndpointMaxInFlight(maxInFlight))
 	if err != nil {
 		return nil, fmt.Errorf("failed to start private validator: %w", err)
 	}
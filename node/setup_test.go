@@ -1,6 +1,13 @@
 package node
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
 	"testing"
 
 	dbm "github.com/cometbft/cometbft-db"
@@ -64,3 +71,39 @@ func TestInitDBs(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchGenesisFile(t *testing.T) {
+	genesis := []byte(`{"chain_id":"test-chain"}`)
+	hash := sha256.Sum256(genesis)
+	var requests atomic.Int32
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write(genesis)
+	}))
+	defer srv.Close()
+
+	config := cfg.DefaultConfig()
+	config.SetRoot(t.TempDir())
+	config.GenesisURL = srv.URL
+	ctx := context.Background()
+
+	// a wrong hash fails the download, and leaves no file behind
+	config.GenesisSHA256 = hex.EncodeToString(make([]byte, sha256.Size))
+	require.Error(t, fetchGenesisFile(ctx, srv.Client(), config))
+	_, err := os.Stat(config.GenesisFile())
+	require.True(t, os.IsNotExist(err))
+
+	config.GenesisSHA256 = hex.EncodeToString(hash[:])
+	require.NoError(t, fetchGenesisFile(ctx, srv.Client(), config))
+	bz, err := os.ReadFile(config.GenesisFile())
+	require.NoError(t, err)
+	assert.Equal(t, genesis, bz)
+	assert.EqualValues(t, 2, requests.Load())
+
+	// the cached file is verified, not downloaded again
+	require.NoError(t, fetchGenesisFile(ctx, srv.Client(), config))
+	assert.EqualValues(t, 2, requests.Load())
+	require.NoError(t, os.WriteFile(config.GenesisFile(), []byte("{}"), 0o600))
+	require.Error(t, fetchGenesisFile(ctx, srv.Client(), config))
+	assert.EqualValues(t, 2, requests.Load())
+}
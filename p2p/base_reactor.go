@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"runtime/pprof"
 
 	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/p2p/conn"
@@ -53,6 +54,9 @@ type Reactor interface {
 type BaseReactor struct {
 	service.BaseService // Provides Start, Stop, .Quit
 	Switch              *Switch
+
+	// profiler labels of the reactor, set when it's added to the switch
+	profileLabels context.Context
 }
 
 type ReactorOptions func(*BaseReactor)
@@ -69,6 +73,59 @@ func NewBaseReactor(name string, impl Reactor, opts ...ReactorOptions) *BaseReac
 func (br *BaseReactor) SetSwitch(sw *Switch) {
 	br.Switch = sw
 }
+
+// Go runs f in a new goroutine accounted to subsystem in the registry of the
+// switch, if any. It returns false, without running f, if subsystem already
+// runs as many goroutines as allowed.
+func (br *BaseReactor) Go(subsystem string, f func()) bool {
+	var routines *service.Routines
+	if br.Switch != nil {
+		routines = br.Switch.Routines()
+	}
+	if labels := br.profileLabels; labels != nil {
+		return routines.Go(subsystem, func() {
+			pprof.SetGoroutineLabels(labels)
+			f()
+		})
+	}
+	return routines.Go(subsystem, f)
+}
+
+// GoQueued runs f in a new goroutine accounted to subsystem in the registry of
+// the switch, if any, as Go, or once a goroutine of subsystem returns if it
+// already runs as many goroutines as allowed. The returned function removes f
+// from the queue if it was not run yet.
+func (br *BaseReactor) GoQueued(subsystem string, f func()) (dequeue func()) {
+	var routines *service.Routines
+	if br.Switch != nil {
+		routines = br.Switch.Routines()
+	}
+	if labels := br.profileLabels; labels != nil {
+		return routines.GoQueued(subsystem, func() {
+			pprof.SetGoroutineLabels(labels)
+			f()
+		})
+	}
+	return routines.GoQueued(subsystem, f)
+}
+
+// WithProfileLabels runs f with the profiler labels of the reactor, which the
+// goroutines f starts inherit. The switch already does so when it starts the
+// reactor, adds peers to it and passes it messages; this is for the services
+// the reactor starts at other times, e.g. when switching from state sync to
+// block sync.
+func (br *BaseReactor) WithProfileLabels(f func()) {
+	runWithProfileLabels(br.profileLabels, f)
+}
+
+func (br *BaseReactor) setProfileLabels(name string) {
+	br.profileLabels = pprof.WithLabels(context.Background(), pprof.Labels(ReactorProfileLabel, name))
+}
+
+func (br *BaseReactor) profileLabelsContext() context.Context {
+	return br.profileLabels
+}
+
 func (*BaseReactor) GetChannels() []*conn.ChannelDescriptor { return nil }
 func (*BaseReactor) AddPeer(Peer)                           {}
 func (*BaseReactor) RemovePeer(Peer, interface{})           {}
@@ -0,0 +1,97 @@
+package p2p
+
+import (
+	"sort"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// minClockSkewPeers is the number of peers whose clock offset must be known
+// before the local clock is judged skewed, so that a few peers with wrong
+// clocks cannot make us believe ours is.
+const minClockSkewPeers = 3
+
+// ClockSkew estimates the skew of the local clock as the median of the clock
+// offsets of the connected peers, measured during ping/pong, and reports when
+// it goes over or back under a threshold.
+type ClockSkew struct {
+	mtx       cmtsync.Mutex
+	threshold time.Duration
+	offsets   map[ID]time.Duration
+	offset    time.Duration
+	skewed    bool
+
+	onChange func(offset time.Duration, skewed bool)
+}
+
+// NewClockSkew returns a ClockSkew calling onChange, if not nil, whenever the
+// local clock becomes skewed by more than threshold or stops being so.
+func NewClockSkew(threshold time.Duration, onChange func(offset time.Duration, skewed bool)) *ClockSkew {
+	return &ClockSkew{
+		threshold: threshold,
+		offsets:   make(map[ID]time.Duration),
+		onChange:  onChange,
+	}
+}
+
+// Record records the offset of the clock of the given peer relative to ours.
+// It returns true if the local clock became skewed or stopped being so.
+func (cs *ClockSkew) Record(id ID, offset time.Duration) bool {
+	cs.mtx.Lock()
+	cs.offsets[id] = offset
+	changed := cs.update()
+	offset, skewed := cs.offset, cs.skewed
+	cs.mtx.Unlock()
+
+	if changed && cs.onChange != nil {
+		cs.onChange(offset, skewed)
+	}
+	return changed
+}
+
+// Remove forgets the offset of the given peer.
+func (cs *ClockSkew) Remove(id ID) {
+	cs.mtx.Lock()
+	if _, ok := cs.offsets[id]; !ok {
+		cs.mtx.Unlock()
+		return
+	}
+	delete(cs.offsets, id)
+	changed := cs.update()
+	offset, skewed := cs.offset, cs.skewed
+	cs.mtx.Unlock()
+
+	if changed && cs.onChange != nil {
+		cs.onChange(offset, skewed)
+	}
+}
+
+// Status returns the estimated offset of the local clock relative to the
+// peers, positive if the peers are ahead of us, and whether it exceeds the
+// threshold.
+func (cs *ClockSkew) Status() (time.Duration, bool) {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+	return cs.offset, cs.skewed
+}
+
+// update recomputes the offset and returns whether skewed changed. It must be
+// called with the lock held.
+func (cs *ClockSkew) update() bool {
+	offsets := make([]time.Duration, 0, len(cs.offsets))
+	for _, offset := range cs.offsets {
+		offsets = append(offsets, offset)
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+	cs.offset = 0
+	if len(offsets) > 0 {
+		cs.offset = offsets[len(offsets)/2]
+	}
+
+	skewed := len(offsets) >= minClockSkewPeers && (cs.offset > cs.threshold || cs.offset < -cs.threshold)
+	changed := skewed != cs.skewed
+	cs.skewed = skewed
+	return changed
+}
@@ -0,0 +1,49 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClockSkew(t *testing.T) {
+	var changes []bool
+	cs := NewClockSkew(time.Second, func(_ time.Duration, skewed bool) {
+		changes = append(changes, skewed)
+	})
+
+	// too few peers to judge the local clock
+	assert.False(t, cs.Record("a", time.Minute))
+	assert.False(t, cs.Record("b", time.Minute))
+	offset, skewed := cs.Status()
+	assert.Equal(t, time.Minute, offset)
+	assert.False(t, skewed)
+
+	// a single peer with a wrong clock does not move the median
+	assert.True(t, cs.Record("c", -time.Hour))
+	offset, skewed = cs.Status()
+	assert.Equal(t, time.Minute, offset)
+	assert.True(t, skewed)
+
+	// skew in either direction counts
+	cs.Record("a", -time.Minute)
+	cs.Record("b", -time.Minute)
+	offset, skewed = cs.Status()
+	assert.Equal(t, -time.Minute, offset)
+	assert.True(t, skewed)
+
+	cs.Record("a", 10*time.Millisecond)
+	cs.Record("b", 10*time.Millisecond)
+	_, skewed = cs.Status()
+	assert.False(t, skewed)
+
+	cs.Record("a", time.Minute)
+	cs.Record("b", time.Minute)
+	cs.Remove("c")
+	_, skewed = cs.Status()
+	assert.False(t, skewed, "too few peers left")
+	cs.Remove("unknown")
+
+	assert.Equal(t, []bool{true, false, true, false}, changes)
+}
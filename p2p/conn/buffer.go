@@ -0,0 +1,95 @@
+package conn
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// defaultBufferSize is the initial capacity of the pooled buffers, which
+	// fits most of the messages received.
+	defaultBufferSize = 4096
+	// maxPooledBufferSize is the capacity above which buffers are not
+	// returned to the pool, so that a few large messages, e.g. blocks, do not
+	// keep memory in use.
+	maxPooledBufferSize = 1024 * 1024
+)
+
+var bufferPool = sync.Pool{
+	New: func() any { return &Buffer{bz: make([]byte, 0, defaultBufferSize)} },
+}
+
+// Buffer is a reference-counted message received on a connection. Its memory
+// is taken from a pool and returned to it once the buffer is released by all
+// its holders, saving the allocation of a slice per message received.
+//
+// The receiver of a Buffer holds one reference to it, which it must release
+// once it is done with the message. Holders passing the buffer on, e.g. to
+// another goroutine, must retain it first.
+type Buffer struct {
+	bz   []byte
+	off  int // start of the message in bz
+	refs atomic.Int32
+}
+
+// getBuffer returns an empty buffer from the pool, with one reference.
+func getBuffer() *Buffer {
+	b := bufferPool.Get().(*Buffer)
+	b.bz = b.bz[:0]
+	b.off = 0
+	b.refs.Store(1)
+	return b
+}
+
+// Bytes returns the message. It must not be used once the buffer is
+// released.
+func (b *Buffer) Bytes() []byte {
+	return b.bz[b.off:]
+}
+
+// Len returns the size of the message.
+func (b *Buffer) Len() int {
+	return len(b.bz) - b.off
+}
+
+// Retain adds a reference to the buffer, to be released by its new holder.
+func (b *Buffer) Retain() {
+	if b.refs.Add(1) <= 1 {
+		panic("conn: retain of released buffer")
+	}
+}
+
+// Release removes a reference to the buffer, returning it to the pool once
+// it has no references left.
+func (b *Buffer) Release() {
+	switch refs := b.refs.Add(-1); {
+	case refs < 0:
+		panic("conn: release of released buffer")
+	case refs == 0 && cap(b.bz) <= maxPooledBufferSize:
+		bufferPool.Put(b)
+	}
+}
+
+// append appends data to the message, growing the buffer as needed.
+func (b *Buffer) append(data []byte) {
+	b.bz = append(b.bz, data...)
+}
+
+// decompress returns the message of b, received on a connection with
+// compression enabled, decompressed. It takes over the reference to b.
+func (b *Buffer) decompress(maxSize int) (*Buffer, error) {
+	if msg := b.Bytes(); len(msg) > 0 && Compression(msg[0]) == CompressionNone {
+		// skip the header of the message sent as is
+		b.off++
+		return b, nil
+	}
+	defer b.Release()
+	out := getBuffer()
+	bz, err := decompressMsg(out.bz, b.Bytes(), maxSize)
+	if err != nil {
+		out.Release()
+		return nil, err
+	}
+	out.bz = bz
+	return out, nil
+}
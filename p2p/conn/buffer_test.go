@@ -0,0 +1,110 @@
+package conn
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"math"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/protoio"
+	tmp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
+)
+
+func TestBuffer(t *testing.T) {
+	b := getBuffer()
+	b.append([]byte("hello "))
+	b.append([]byte("world"))
+	assert.Equal(t, []byte("hello world"), b.Bytes())
+	assert.Equal(t, 11, b.Len())
+
+	b.Retain()
+	b.Release()
+	assert.Equal(t, []byte("hello world"), b.Bytes())
+	b.Release()
+	assert.Panics(t, b.Release)
+	assert.Panics(t, b.Retain)
+
+	// buffers are reset when taken from the pool
+	b = getBuffer()
+	assert.Zero(t, b.Len())
+	b.Release()
+}
+
+func TestBufferDecompress(t *testing.T) {
+	msg := bytes.Repeat([]byte("celestia blob "), 100)
+	for _, c := range []Compression{CompressionNone, CompressionSnappy, CompressionZstd} {
+		b := getBuffer()
+		b.append(compressMsg(c, msg))
+		decompressed, err := b.decompress(len(msg))
+		require.NoError(t, err, c)
+		assert.Equal(t, msg, decompressed.Bytes(), c)
+		decompressed.Release()
+
+		b = getBuffer()
+		b.append(compressMsg(c, msg))
+		_, err = b.decompress(len(msg) - 1)
+		if c != CompressionNone {
+			require.ErrorIs(t, err, ErrDecompressedMessageTooLarge, c)
+		}
+	}
+}
+
+func TestPacketReader(t *testing.T) {
+	packets := []*tmp2p.Packet{
+		mustWrapPacket(&tmp2p.PacketMsg{ChannelID: 0x01, EOF: true, Data: []byte("data")}),
+		mustWrapPacket(&tmp2p.PacketMsg{ChannelID: 0xff, Data: []byte{}, Sequence: math.MaxUint64}),
+		mustWrapPacket(&tmp2p.PacketMsg{ChannelID: 0x20, EOF: true, Data: bytes.Repeat([]byte{0x01}, 4096)}),
+		mustWrapPacket(&tmp2p.PacketPing{}),
+		mustWrapPacket(&tmp2p.PacketChannel{ChannelID: 0x30, Registered: true}),
+	}
+	var buf bytes.Buffer
+	w := protoio.NewDelimitedWriter(&buf)
+	for _, packet := range packets {
+		_, err := w.WriteMsg(packet)
+		require.NoError(t, err)
+	}
+
+	r := newPacketReader(bufio.NewReader(&buf), 5000)
+	for _, expected := range packets {
+		var packet tmp2p.Packet
+		_, err := r.read(&packet)
+		require.NoError(t, err)
+		assert.Equal(t, expected.String(), packet.String())
+	}
+	var packet tmp2p.Packet
+	_, err := r.read(&packet)
+	require.ErrorIs(t, err, io.EOF)
+
+	// packets larger than the maximum size are rejected
+	_, err = w.WriteMsg(packets[2])
+	require.NoError(t, err)
+	_, err = newPacketReader(bufio.NewReader(&buf), 4096).read(&packet)
+	require.Error(t, err)
+}
+
+func TestReadMsg(t *testing.T) {
+	data := bytes.Repeat([]byte{0x01}, 3*msgReadChunkSize+10)
+	msg, err := ReadMsg(bytes.NewReader(data), len(data))
+	require.NoError(t, err)
+	assert.Equal(t, data, msg)
+
+	msg, err = ReadMsg(bytes.NewReader(nil), 0)
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+
+	_, err = ReadMsg(bytes.NewReader(nil), 10)
+	require.ErrorIs(t, err, io.EOF)
+
+	// a message announced much larger than the bytes sent is not allocated
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err = ReadMsg(bytes.NewReader(data[:10]), 100<<20)
+	runtime.ReadMemStats(&after)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(1<<20))
+}
@@ -0,0 +1,119 @@
+package conn
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression is the algorithm compressing the messages of a channel on the
+// wire, on connections where compression is enabled.
+type Compression byte
+
+const (
+	// CompressionNone sends the messages as is.
+	CompressionNone Compression = iota
+	// CompressionSnappy favors speed over ratio, e.g. for latency sensitive
+	// messages like block parts.
+	CompressionSnappy
+	// CompressionZstd favors ratio over speed, e.g. for transactions.
+	CompressionZstd
+)
+
+// ErrDecompressedMessageTooLarge is returned when a compressed message
+// received exceeds the capacity of its channel once decompressed.
+var ErrDecompressedMessageTooLarge = errors.New("decompressed message exceeds available capacity")
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionSnappy:
+		return "snappy"
+	case CompressionZstd:
+		return "zstd"
+	default:
+		return fmt.Sprintf("Compression(%d)", byte(c))
+	}
+}
+
+var (
+	// EncodeAll and DecodeAll can be called concurrently. DecodeAll never
+	// decodes more than the capacity of its destination, so that messages
+	// made of several frames can't exceed the size declared by the first one.
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecodeAllCapLimit(true))
+)
+
+// compressMsg returns the message as sent on a connection with compression
+// enabled: one byte identifying its compression, followed by the message
+// compressed with c, or as is if it does not get smaller.
+func compressMsg(c Compression, msg []byte) []byte {
+	var compressed []byte
+	switch c {
+	case CompressionSnappy:
+		buf := make([]byte, 1+snappy.MaxEncodedLen(len(msg)))
+		buf[0] = byte(c)
+		compressed = buf[:1+len(snappy.Encode(buf[1:], msg))]
+	case CompressionZstd:
+		compressed = zstdEncoder.EncodeAll(msg, []byte{byte(c)})
+	}
+	if compressed == nil || len(compressed) > len(msg) {
+		return append([]byte{byte(CompressionNone)}, msg...)
+	}
+	return compressed
+}
+
+// decompressMsg returns the message sent as msg on a connection with
+// compression enabled, failing if it is larger than maxSize bytes. The message
+// is decompressed in the memory of dst if it is large enough, and returned as
+// is, without its header, if it is not compressed.
+func decompressMsg(dst, msg []byte, maxSize int) ([]byte, error) {
+	if len(msg) == 0 {
+		return nil, errors.New("missing compression header")
+	}
+	c, payload := Compression(msg[0]), msg[1:]
+	switch c {
+	case CompressionNone:
+		return payload, nil
+	case CompressionSnappy:
+		n, err := snappy.DecodedLen(payload)
+		if err != nil {
+			return nil, fmt.Errorf("snappy: %w", err)
+		}
+		if n > maxSize {
+			return nil, fmt.Errorf("%w: %v < %v", ErrDecompressedMessageTooLarge, maxSize, n)
+		}
+		if cap(dst) < n {
+			dst = make([]byte, n)
+		}
+		decoded, err := snappy.Decode(dst[:n], payload)
+		if err != nil {
+			return nil, fmt.Errorf("snappy: %w", err)
+		}
+		return decoded, nil
+	case CompressionZstd:
+		var header zstd.Header
+		if err := header.Decode(payload); err != nil {
+			return nil, fmt.Errorf("zstd: %w", err)
+		}
+		if !header.HasFCS || header.FrameContentSize > uint64(maxSize) {
+			return nil, fmt.Errorf("%w: %v < %v", ErrDecompressedMessageTooLarge, maxSize, header.FrameContentSize)
+		}
+		if uint64(cap(dst)) < header.FrameContentSize {
+			dst = make([]byte, 0, header.FrameContentSize)
+		}
+		decoded, err := zstdDecoder.DecodeAll(payload, dst[:0:header.FrameContentSize])
+		if errors.Is(err, zstd.ErrDecoderSizeExceeded) {
+			return nil, fmt.Errorf("%w: %v < %v", ErrDecompressedMessageTooLarge, header.FrameContentSize, len(decoded))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("zstd: %w", err)
+		}
+		return decoded, nil
+	default:
+		return nil, fmt.Errorf("unknown compression %v", c)
+	}
+}
@@ -0,0 +1,62 @@
+package conn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressMsg(t *testing.T) {
+	compressible := bytes.Repeat([]byte("celestia blob "), 1000)
+	random := make([]byte, 1000)
+	_, err := rand.Read(random)
+	require.NoError(t, err)
+
+	for _, c := range []Compression{CompressionNone, CompressionSnappy, CompressionZstd} {
+		t.Run(c.String(), func(t *testing.T) {
+			compressed := compressMsg(c, compressible)
+			assert.Equal(t, byte(c), compressed[0])
+			if c != CompressionNone {
+				assert.Less(t, len(compressed), len(compressible)/10)
+			}
+			msg, err := decompressMsg(nil, compressed, len(compressible))
+			require.NoError(t, err)
+			assert.Equal(t, compressible, msg)
+
+			// larger than the capacity once decompressed
+			_, err = decompressMsg(nil, compressed, len(compressible)-1)
+			if c == CompressionNone {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrDecompressedMessageTooLarge)
+			}
+
+			// incompressible messages are sent as is
+			compressed = compressMsg(c, random)
+			assert.Equal(t, byte(CompressionNone), compressed[0])
+			msg, err = decompressMsg(nil, compressed, len(random))
+			require.NoError(t, err)
+			assert.Equal(t, random, msg)
+
+			msg, err = decompressMsg(nil, compressMsg(c, nil), 0)
+			require.NoError(t, err)
+			assert.Empty(t, msg)
+		})
+	}
+
+	_, err = decompressMsg(nil, nil, 10)
+	require.Error(t, err)
+	_, err = decompressMsg(nil, []byte{0xff, 0x01}, 10)
+	require.Error(t, err)
+	_, err = decompressMsg(nil, []byte{byte(CompressionZstd), 0x01, 0x02}, 10)
+	require.Error(t, err)
+
+	// frames following the first one can't exceed the size it declares
+	frame := zstdEncoder.EncodeAll(bytes.Repeat([]byte{0}, 1000), nil)
+	msg := append(compressMsg(CompressionZstd, compressible), frame...)
+	_, err = decompressMsg(nil, msg, 10*len(compressible))
+	require.ErrorIs(t, err, ErrDecompressedMessageTooLarge)
+}
@@ -47,9 +47,28 @@ const (
 	defaultPongTimeout         = 45 * time.Second
 )
 
+// ErrPacketOutOfSequence is returned when a PacketMsg is not numbered
+// consecutively with the previous one, i.e. it was replayed, reordered or
+// dropped.
+var ErrPacketOutOfSequence = errors.New("packet out of sequence")
+
+// ErrChannelRecvRateExceeded is returned when a peer sends messages on a
+// channel faster than allowed by MConnConfig.ChannelRecvLimits.
+var ErrChannelRecvRateExceeded = errors.New("channel receive rate exceeded")
+
+// ErrPongTimeout is returned when the peer does not answer a ping in time.
+var ErrPongTimeout = errors.New("pong timeout")
+
+// ErrChannelRegistrationUnsupported is returned when registering or
+// unregistering a channel on a connection without channel registration.
+var ErrChannelRegistrationUnsupported = errors.New("channel registration not supported by the connection")
+
 type (
-	receiveCbFunc func(chID byte, msgBytes []byte)
-	errorCbFunc   func(interface{})
+	receiveCbFunc       func(chID byte, msgBytes []byte)
+	receiveBufferCbFunc func(chID byte, msg *Buffer)
+	errorCbFunc         func(interface{})
+	clockOffsetCbFunc   func(offset, rtt time.Duration)
+	channelUpdateCbFunc func(chID byte, registered bool)
 )
 
 /*
@@ -75,7 +94,8 @@ request times out.  The message `msg` is serialized using Protobuf.
 `TrySend(chID, msgBytes)` is a nonblocking call that returns false if the
 channel's queue is full.
 
-Inbound message bytes are handled with an onReceive callback function.
+Inbound message bytes are handled with an onReceive callback function, or
+passed in pooled buffers to the callback set with SetReceiveBufferHandler.
 */
 type MConnection struct {
 	service.BaseService
@@ -87,9 +107,8 @@ type MConnection struct {
 	recvMonitor   *flow.Monitor
 	send          chan struct{}
 	pong          chan struct{}
-	channels      []*Channel
-	channelsIdx   map[byte]*Channel
 	onReceive     receiveCbFunc
+	onReceiveBuf  receiveBufferCbFunc
 	onError       errorCbFunc
 	errored       uint32
 	config        MConnConfig
@@ -106,6 +125,20 @@ type MConnection struct {
 	// are safe to call concurrently.
 	stopMtx cmtsync.Mutex
 
+	// channelsMtx guards the channels, which change when channels are
+	// registered or unregistered on the running connection. channels is
+	// replaced rather than modified, so that it can be iterated unlocked.
+	channelsMtx cmtsync.RWMutex
+	channels    []*Channel
+	channelsIdx map[byte]*Channel
+	// channels unregistered, on which the packets still sent by the peer
+	// are dropped
+	unregistered map[byte]bool
+	// PacketChannels to send, informing the peer of the channels registered
+	// or unregistered
+	channelUpdates  []*tmp2p.PacketChannel
+	onChannelUpdate channelUpdateCbFunc
+
 	flushTimer *timer.ThrottleTimer // flush writes as necessary but throttled.
 	pingTimer  *time.Ticker         // send pings periodically
 
@@ -113,10 +146,22 @@ type MConnection struct {
 	pongTimer     *time.Timer
 	pongTimeoutCh chan bool // true - timeout, false - peer sent pong
 
+	pingSentAt    int64 // atomic: unix nano time the last ping was sent at
+	onClockOffset clockOffsetCbFunc
+
 	chStatsTimer *time.Ticker // update channel stats periodically
 
+	// sequence numbers of the last PacketMsgs sent and received, if
+	// config.PacketSequence is set. Only accessed by the send and receive
+	// routines respectively.
+	sendSequence uint64
+	recvSequence uint64
+
 	created time.Time // time of creation
 
+	// adapts the send rate, if config.AdaptiveSendRate is set
+	sendRate *sendRateController
+
 	_maxPacketMsgSize int
 }
 
@@ -125,6 +170,12 @@ type MConnConfig struct {
 	SendRate int64 `mapstructure:"send_rate"`
 	RecvRate int64 `mapstructure:"recv_rate"`
 
+	// AdaptiveSendRate adapts the send rate, starting from SendRate, to the
+	// capacity of the peer to receive, within [MinSendRate, MaxSendRate].
+	AdaptiveSendRate bool  `mapstructure:"adaptive_send_rate"`
+	MinSendRate      int64 `mapstructure:"min_send_rate"`
+	MaxSendRate      int64 `mapstructure:"max_send_rate"`
+
 	// Maximum payload size
 	MaxPacketMsgPayloadSize int `mapstructure:"max_packet_msg_payload_size"`
 
@@ -137,6 +188,40 @@ type MConnConfig struct {
 	// Maximum wait time for pongs
 	PongTimeout time.Duration `mapstructure:"pong_timeout"`
 
+	// PacketSequence numbers the PacketMsgs sent and requires the PacketMsgs
+	// received to be numbered consecutively, rejecting replayed and reordered
+	// frames. Both ends of the connection must enable it, which the transport
+	// does when both peers advertise the packet sequence feature.
+	PacketSequence bool `mapstructure:"packet_sequence"`
+
+	// Compression compresses the messages sent on the channels whose
+	// descriptor sets a Compression, and prefixes every message with the
+	// compression used. Both ends of the connection must enable it, which
+	// the transport does when both peers advertise the compression feature.
+	Compression bool `mapstructure:"compression"`
+
+	// ChannelRegistration allows registering and unregistering channels on
+	// the running connection, informing the peer with a PacketChannel. Both
+	// ends of the connection must enable it, which the transport does when
+	// both peers advertise the channel registration feature.
+	ChannelRegistration bool `mapstructure:"channel_registration"`
+
+	// Yamux multiplexes the channels over the streams of a yamux session,
+	// with a YamuxConnection instead of an MConnection. Both ends of the
+	// connection must enable it, which the transport does when both peers
+	// advertise the yamux feature.
+	Yamux bool `mapstructure:"yamux"`
+
+	// Limits on the messages received on each channel, by channel ID. A
+	// message is always accepted if it is the first one received on the
+	// channel for a second, whatever its size.
+	ChannelRecvLimits map[byte]config.ChannelRecvLimit `mapstructure:"channel_recv_limits"`
+
+	// ThrottleChannelRecv stops reading from the connection until the rate of
+	// a channel exceeding its limit is back under it, instead of failing the
+	// connection with ErrChannelRecvRateExceeded.
+	ThrottleChannelRecv bool `mapstructure:"throttle_channel_recv"`
+
 	// Fuzz connection
 	TestFuzz       bool                   `mapstructure:"test_fuzz"`
 	TestFuzzConfig *config.FuzzConnConfig `mapstructure:"test_fuzz_config"`
@@ -206,6 +291,11 @@ func NewMConnectionWithConfig(
 	}
 	mconn.channels = channels
 	mconn.channelsIdx = channelsIdx
+	mconn.unregistered = map[byte]bool{}
+
+	if config.AdaptiveSendRate {
+		mconn.sendRate = newSendRateController(config.SendRate, config.MinSendRate, config.MaxSendRate)
+	}
 
 	mconn.BaseService = *service.NewBaseService(nil, "MConnection", mconn)
 
@@ -217,7 +307,7 @@ func NewMConnectionWithConfig(
 
 func (c *MConnection) SetLogger(l log.Logger) {
 	c.BaseService.SetLogger(l)
-	for _, ch := range c.channels {
+	for _, ch := range c.channelList() {
 		ch.SetLogger(l)
 	}
 }
@@ -363,13 +453,13 @@ func (c *MConnection) Send(chID byte, msgBytes []byte) bool {
 	c.Logger.Debug("Send", "channel", chID, "conn", c, "msgBytes", log.NewLazySprintf("%X", msgBytes))
 
 	// Send message to channel.
-	channel, ok := c.channelsIdx[chID]
+	channel, ok := c.channel(chID)
 	if !ok {
 		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
 		return false
 	}
 
-	success := channel.sendBytes(msgBytes)
+	success := channel.sendBytes(c.encodeMsg(channel, msgBytes))
 	if success {
 		// Wake up sendRoutine if necessary
 		select {
@@ -392,13 +482,13 @@ func (c *MConnection) TrySend(chID byte, msgBytes []byte) bool {
 	c.Logger.Debug("TrySend", "channel", chID, "conn", c, "msgBytes", log.NewLazySprintf("%X", msgBytes))
 
 	// Send message to channel.
-	channel, ok := c.channelsIdx[chID]
+	channel, ok := c.channel(chID)
 	if !ok {
 		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
 		return false
 	}
 
-	ok = channel.trySendBytes(msgBytes)
+	ok = channel.trySendBytes(c.encodeMsg(channel, msgBytes))
 	if ok {
 		// Wake up sendRoutine if necessary
 		select {
@@ -410,6 +500,14 @@ func (c *MConnection) TrySend(chID byte, msgBytes []byte) bool {
 	return ok
 }
 
+// encodeMsg returns the bytes sent for a message on the channel.
+func (c *MConnection) encodeMsg(channel *Channel, msgBytes []byte) []byte {
+	if !c.config.Compression {
+		return msgBytes
+	}
+	return compressMsg(channel.desc.Compression, msgBytes)
+}
+
 // CanSend returns true if you can send more data onto the chID, false
 // otherwise. Use only as a heuristic.
 func (c *MConnection) CanSend(chID byte) bool {
@@ -417,7 +515,7 @@ func (c *MConnection) CanSend(chID byte) bool {
 		return false
 	}
 
-	channel, ok := c.channelsIdx[chID]
+	channel, ok := c.channel(chID)
 	if !ok {
 		c.Logger.Error(fmt.Sprintf("Unknown channel %X", chID))
 		return false
@@ -442,11 +540,15 @@ FOR_LOOP:
 			// something is written to .bufConnWriter.
 			c.flush()
 		case <-c.chStatsTimer.C:
-			for _, channel := range c.channels {
+			for _, channel := range c.channelList() {
 				channel.updateStats()
 			}
+			if c.sendRate != nil {
+				c.sendRate.update(c.sendMonitor.Status().Bytes, c.isSendPending())
+			}
 		case <-c.pingTimer.C:
 			c.Logger.Debug("Send Ping")
+			atomic.StoreInt64(&c.pingSentAt, time.Now().UnixNano())
 			_n, err = protoWriter.WriteMsg(mustWrapPacket(&tmp2p.PacketPing{}))
 			if err != nil {
 				c.Logger.Error("Failed to send PacketPing", "err", err)
@@ -464,13 +566,14 @@ FOR_LOOP:
 		case timeout := <-c.pongTimeoutCh:
 			if timeout {
 				c.Logger.Debug("Pong timeout")
-				err = errors.New("pong timeout")
+				err = ErrPongTimeout
 			} else {
 				c.stopPongTimer()
 			}
 		case <-c.pong:
 			c.Logger.Debug("Send Pong")
-			_n, err = protoWriter.WriteMsg(mustWrapPacket(&tmp2p.PacketPong{}))
+			now := time.Now()
+			_n, err = protoWriter.WriteMsg(mustWrapPacket(&tmp2p.PacketPong{Time: &now}))
 			if err != nil {
 				c.Logger.Error("Failed to send PacketPong", "err", err)
 				break SELECTION
@@ -480,6 +583,13 @@ FOR_LOOP:
 		case <-c.quitSendRoutine:
 			break FOR_LOOP
 		case <-c.send:
+			// Inform the peer of the channels registered before sending
+			// messages on them.
+			err = c.sendChannelUpdates(protoWriter)
+			if err != nil {
+				c.Logger.Error("Failed to send PacketChannel", "err", err)
+				break SELECTION
+			}
 			// Send some PacketMsgs
 			eof := c.sendSomePacketMsgs(protoWriter)
 			if !eof {
@@ -512,12 +622,32 @@ func (c *MConnection) sendSomePacketMsgs(w protoio.Writer) bool {
 	// Block until .sendMonitor says we can write.
 	// Once we're ready we send more than we asked for,
 	// but amortized it should even out.
-	c.sendMonitor.Limit(c._maxPacketMsgSize, c.config.SendRate, true)
+	c.sendMonitor.Limit(c._maxPacketMsgSize, c.currentSendRate(), true)
 
 	// Now send some PacketMsgs.
 	return c.sendBatchPacketMsgs(w, numBatchPacketMsgs)
 }
 
+// currentSendRate returns the rate at which packets can be sent, in
+// bytes/second, adapted to the peer if config.AdaptiveSendRate is set.
+func (c *MConnection) currentSendRate() int64 {
+	if c.sendRate != nil {
+		return c.sendRate.Rate()
+	}
+	return c.config.SendRate
+}
+
+// isSendPending returns true if any channel has data to send. Not
+// goroutine-safe, only called by the send routine.
+func (c *MConnection) isSendPending() bool {
+	for _, channel := range c.channelList() {
+		if channel.isSendPending() {
+			return true
+		}
+	}
+	return false
+}
+
 // Returns true if messages from channels were exhausted.
 func (c *MConnection) sendBatchPacketMsgs(w protoio.Writer, batchSize int) bool {
 	// Send a batch of PacketMsgs.
@@ -528,7 +658,7 @@ func (c *MConnection) sendBatchPacketMsgs(w protoio.Writer, batchSize int) bool
 		}
 	}()
 	for i := 0; i < batchSize; i++ {
-		channel := selectChannelToGossipOn(c.channels)
+		channel := selectChannelToGossipOn(c.channelList())
 		// nothing to send across any channel.
 		if channel == nil {
 			return true
@@ -572,7 +702,12 @@ func selectChannelToGossipOn(channels []*Channel) *Channel {
 // returns (num_bytes_written, error_occurred).
 func (c *MConnection) sendPacketMsgOnChannel(w protoio.Writer, sendChannel *Channel) (int, bool) {
 	// Make & send a PacketMsg from this channel
-	n, err := sendChannel.writePacketMsgTo(w)
+	var sequence uint64
+	if c.config.PacketSequence {
+		c.sendSequence++
+		sequence = c.sendSequence
+	}
+	n, err := sendChannel.writePacketMsgTo(w, sequence)
 	if err != nil {
 		c.Logger.Error("Failed to write PacketMsg", "err", err)
 		c.stopForError(err)
@@ -590,7 +725,8 @@ func (c *MConnection) sendPacketMsgOnChannel(w protoio.Writer, sendChannel *Chan
 func (c *MConnection) recvRoutine() {
 	defer c._recover()
 
-	protoReader := protoio.NewDelimitedReader(c.bufConnReader, c._maxPacketMsgSize)
+	packetReader := newPacketReader(c.bufConnReader, c._maxPacketMsgSize)
+	defer packetReader.release()
 
 FOR_LOOP:
 	for {
@@ -614,7 +750,7 @@ FOR_LOOP:
 		// Read packet type
 		var packet tmp2p.Packet
 
-		_n, err := protoReader.ReadMsg(&packet)
+		_n, err := packetReader.read(&packet)
 		c.recvMonitor.Update(_n)
 		if err != nil {
 			// stopServices was invoked and we are shutting down
@@ -649,22 +785,51 @@ FOR_LOOP:
 			}
 		case *tmp2p.Packet_PacketPong:
 			c.Logger.Debug("Receive Pong")
+			c.recordClockOffset(pkt.PacketPong.Time)
 			select {
 			case c.pongTimeoutCh <- false:
 			default:
 				// never block
 			}
+		case *tmp2p.Packet_PacketChannel:
+			if !c.config.ChannelRegistration || pkt.PacketChannel.ChannelID < 0 || pkt.PacketChannel.ChannelID > math.MaxUint8 {
+				err := fmt.Errorf("unexpected channel update %v", pkt.PacketChannel)
+				c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
+				c.stopForError(err)
+				break FOR_LOOP
+			}
+			c.Logger.Debug("Receive PacketChannel", "chID", pkt.PacketChannel.ChannelID, "registered", pkt.PacketChannel.Registered)
+			if c.onChannelUpdate != nil {
+				c.onChannelUpdate(byte(pkt.PacketChannel.ChannelID), pkt.PacketChannel.Registered)
+			}
 		case *tmp2p.Packet_PacketMsg:
 			channelID := byte(pkt.PacketMsg.ChannelID)
-			channel, ok := c.channelsIdx[channelID]
-			if pkt.PacketMsg.ChannelID < 0 || pkt.PacketMsg.ChannelID > math.MaxUint8 || !ok || channel == nil {
+			channel, ok := c.channel(channelID)
+			dropped := !ok && c.isUnregistered(channelID)
+			if pkt.PacketMsg.ChannelID < 0 || pkt.PacketMsg.ChannelID > math.MaxUint8 || (!ok && !dropped) || (ok && channel == nil) {
 				err := fmt.Errorf("unknown channel %X", pkt.PacketMsg.ChannelID)
 				c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
 				c.stopForError(err)
 				break FOR_LOOP
 			}
+			if c.config.PacketSequence {
+				if pkt.PacketMsg.Sequence != c.recvSequence+1 {
+					err := fmt.Errorf("%w: expected %d, got %d",
+						ErrPacketOutOfSequence, c.recvSequence+1, pkt.PacketMsg.Sequence)
+					c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
+					c.stopForError(err)
+					break FOR_LOOP
+				}
+				c.recvSequence++
+			}
+			if dropped {
+				// sent by the peer before it learnt that the channel was
+				// unregistered
+				c.Logger.Debug("Dropping packet of unregistered channel", "chID", channelID)
+				continue
+			}
 
-			msgBytes, err := channel.recvPacketMsg(*pkt.PacketMsg)
+			msg, err := channel.recvPacketMsg(*pkt.PacketMsg)
 			if err != nil {
 				if c.IsRunning() {
 					c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
@@ -672,10 +837,40 @@ FOR_LOOP:
 				}
 				break FOR_LOOP
 			}
-			if msgBytes != nil {
-				c.Logger.Debug("Received bytes", "chID", channelID, "msgBytes", msgBytes)
+			if msg != nil && c.config.Compression {
+				msg, err = msg.decompress(channel.desc.RecvMessageCapacity)
+				if err != nil {
+					c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
+					c.stopForError(err)
+					break FOR_LOOP
+				}
+			}
+			if msg != nil {
+				if wait := channel.recvRateWait(msg.Len(), time.Now()); wait > 0 {
+					if !c.config.ThrottleChannelRecv {
+						msg.Release()
+						err := fmt.Errorf("%w: channel %X", ErrChannelRecvRateExceeded, channelID)
+						c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
+						c.stopForError(err)
+						break FOR_LOOP
+					}
+					select {
+					case <-time.After(wait):
+					case <-c.quitRecvRoutine:
+						msg.Release()
+						break FOR_LOOP
+					}
+					channel.recvRateWait(msg.Len(), time.Now())
+				}
+				c.Logger.Debug("Received bytes", "chID", channelID, "msgBytes", msg.Bytes())
 				// NOTE: This means the reactor.Receive runs in the same thread as the p2p recv routine
-				c.onReceive(channelID, msgBytes)
+				if c.onReceiveBuf != nil {
+					c.onReceiveBuf(channelID, msg)
+				} else {
+					// the message is handed over to onReceive, so the buffer
+					// is left to the garbage collector
+					c.onReceive(channelID, msg.Bytes())
+				}
 			}
 		default:
 			err := fmt.Errorf("unknown message type %v", reflect.TypeOf(packet))
@@ -703,21 +898,43 @@ func (c *MConnection) stopPongTimer() {
 
 // maxPacketMsgSize returns a maximum size of PacketMsg
 func (c *MConnection) maxPacketMsgSize() int {
-	bz, err := proto.Marshal(mustWrapPacket(&tmp2p.PacketMsg{
+	packet := &tmp2p.PacketMsg{
 		ChannelID: 0x01,
 		EOF:       true,
 		Data:      make([]byte, c.config.MaxPacketMsgPayloadSize),
-	}))
+	}
+	if c.config.PacketSequence {
+		packet.Sequence = math.MaxUint64
+	}
+	bz, err := proto.Marshal(mustWrapPacket(packet))
 	if err != nil {
 		panic(err)
 	}
 	return len(bz)
 }
 
+// NegotiateMaxPacketMsgPayloadSize returns the maximum payload size of the
+// packets of a connection, given ours and the one advertised by the peer
+// during the handshake: the smaller of both, so that neither side sends
+// packets the other rejects as too large. Ours is kept if the peer doesn't
+// advertise its size, i.e. theirs is 0. The result is never less than
+// config.MinMaxPacketMsgPayloadSize.
+func NegotiateMaxPacketMsgPayloadSize(ours, theirs int) int {
+	size := ours
+	if theirs > 0 && theirs < ours {
+		size = theirs
+	}
+	if size < config.MinMaxPacketMsgPayloadSize {
+		return config.MinMaxPacketMsgPayloadSize
+	}
+	return size
+}
+
 type ConnectionStatus struct {
 	Duration    time.Duration
 	SendMonitor flow.Status
 	RecvMonitor flow.Status
+	SendRate    int64 // current send rate, in bytes/second
 	Channels    []ChannelStatus
 }
 
@@ -729,13 +946,166 @@ type ChannelStatus struct {
 	RecentlySent      int64
 }
 
+// SetClockOffsetHandler sets the callback receiving the clock offset of the
+// peer, estimated from the time in its pongs, and the round trip time of the
+// ping. It must be called before the connection is started.
+func (c *MConnection) SetClockOffsetHandler(onClockOffset clockOffsetCbFunc) {
+	c.onClockOffset = onClockOffset
+}
+
+// recordClockOffset estimates the offset of the clock of the peer, assuming
+// it answered halfway through the round trip of the last ping.
+func (c *MConnection) recordClockOffset(peerTime *time.Time) {
+	sentAt := atomic.SwapInt64(&c.pingSentAt, 0)
+	if sentAt == 0 {
+		return
+	}
+	rtt := time.Since(time.Unix(0, sentAt))
+	if c.sendRate != nil {
+		c.sendRate.recordRTT(rtt)
+	}
+	if c.onClockOffset == nil || peerTime == nil {
+		// older peers do not send their time
+		return
+	}
+	c.onClockOffset(peerTime.Sub(time.Unix(0, sentAt))-rtt/2, rtt)
+}
+
+// SetReceiveBufferHandler sets the callback receiving the messages instead of
+// onReceive, in buffers taken from a pool: the callback must release each
+// buffer once done with the message, e.g. once decoded, so that the memory is
+// reused for the next messages. It must be called before the connection is
+// started.
+func (c *MConnection) SetReceiveBufferHandler(onReceive receiveBufferCbFunc) {
+	c.onReceiveBuf = onReceive
+}
+
+// SetChannelUpdateHandler sets the callback informed of the channels
+// registered or unregistered by the peer on the running connection. It must
+// be called before the connection is started.
+func (c *MConnection) SetChannelUpdateHandler(onChannelUpdate channelUpdateCbFunc) {
+	c.onChannelUpdate = onChannelUpdate
+}
+
+// RegisterChannel adds a channel to the running connection, e.g. for a
+// reactor added after the connection was established, and informs the peer.
+// Messages received on the channel before the peer registers it too fail
+// the connection on its side, so the peer must be informed out of band,
+// e.g. by its channel update handler, before sending on it.
+func (c *MConnection) RegisterChannel(desc *ChannelDescriptor) error {
+	if !c.config.ChannelRegistration {
+		return ErrChannelRegistrationUnsupported
+	}
+
+	c.channelsMtx.Lock()
+	if _, ok := c.channelsIdx[desc.ID]; ok {
+		c.channelsMtx.Unlock()
+		return fmt.Errorf("channel %X already registered", desc.ID)
+	}
+	channel := newChannel(c, *desc)
+	channel.SetLogger(c.Logger)
+	channels := make([]*Channel, len(c.channels), len(c.channels)+1)
+	copy(channels, c.channels)
+	c.channels = append(channels, channel)
+	c.channelsIdx[desc.ID] = channel
+	delete(c.unregistered, desc.ID)
+	c.channelUpdates = append(c.channelUpdates, &tmp2p.PacketChannel{ChannelID: int32(desc.ID), Registered: true})
+	c.channelsMtx.Unlock()
+
+	c.wakeSendRoutine()
+	return nil
+}
+
+// UnregisterChannel removes a channel from the running connection and
+// informs the peer. The messages queued on the channel are discarded, and
+// the packets the peer sends on it until it is informed are dropped.
+func (c *MConnection) UnregisterChannel(chID byte) error {
+	if !c.config.ChannelRegistration {
+		return ErrChannelRegistrationUnsupported
+	}
+
+	c.channelsMtx.Lock()
+	if _, ok := c.channelsIdx[chID]; !ok {
+		c.channelsMtx.Unlock()
+		return fmt.Errorf("channel %X not registered", chID)
+	}
+	channels := make([]*Channel, 0, len(c.channels)-1)
+	for _, channel := range c.channels {
+		if channel.desc.ID != chID {
+			channels = append(channels, channel)
+		}
+	}
+	c.channels = channels
+	delete(c.channelsIdx, chID)
+	c.unregistered[chID] = true
+	c.channelUpdates = append(c.channelUpdates, &tmp2p.PacketChannel{ChannelID: int32(chID), Registered: false})
+	c.channelsMtx.Unlock()
+
+	c.wakeSendRoutine()
+	return nil
+}
+
+func (c *MConnection) wakeSendRoutine() {
+	select {
+	case c.send <- struct{}{}:
+	default:
+	}
+}
+
+// channel returns the registered channel with the given ID.
+func (c *MConnection) channel(chID byte) (*Channel, bool) {
+	c.channelsMtx.RLock()
+	defer c.channelsMtx.RUnlock()
+	channel, ok := c.channelsIdx[chID]
+	return channel, ok
+}
+
+// channelList returns the registered channels. The slice must not be
+// modified.
+func (c *MConnection) channelList() []*Channel {
+	c.channelsMtx.RLock()
+	defer c.channelsMtx.RUnlock()
+	return c.channels
+}
+
+func (c *MConnection) isUnregistered(chID byte) bool {
+	c.channelsMtx.RLock()
+	defer c.channelsMtx.RUnlock()
+	return c.unregistered[chID]
+}
+
+// sendChannelUpdates sends the pending PacketChannels.
+func (c *MConnection) sendChannelUpdates(w protoio.Writer) error {
+	c.channelsMtx.Lock()
+	updates := c.channelUpdates
+	c.channelUpdates = nil
+	c.channelsMtx.Unlock()
+
+	n := 0
+	for _, update := range updates {
+		c.Logger.Debug("Send PacketChannel", "chID", update.ChannelID, "registered", update.Registered)
+		_n, err := w.WriteMsg(mustWrapPacket(update))
+		n += _n
+		if err != nil {
+			return err
+		}
+	}
+	if n > 0 {
+		c.sendMonitor.Update(n)
+		c.flush()
+	}
+	return nil
+}
+
 func (c *MConnection) Status() ConnectionStatus {
 	var status ConnectionStatus
 	status.Duration = time.Since(c.created)
 	status.SendMonitor = c.sendMonitor.Status()
 	status.RecvMonitor = c.recvMonitor.Status()
-	status.Channels = make([]ChannelStatus, len(c.channels))
-	for i, channel := range c.channels {
+	status.SendRate = c.currentSendRate()
+	channels := c.channelList()
+	status.Channels = make([]ChannelStatus, len(channels))
+	for i, channel := range channels {
 		channel := channel
 		status.Channels[i] = ChannelStatus{
 			ID:                channel.desc.ID,
@@ -757,6 +1127,16 @@ type ChannelDescriptor struct {
 	RecvBufferCapacity  int
 	RecvMessageCapacity int
 	MessageType         proto.Message
+
+	// Compression of the messages sent on the channel, on connections with
+	// compression enabled. The messages received are decompressed whatever
+	// their compression.
+	Compression Compression
+
+	// Limits on the structure of the messages received on the channel,
+	// checked before they are unmarshaled. Zero fields use the defaults of
+	// protoio.DefaultDecodeLimits.
+	DecodeLimits protoio.DecodeLimits
 }
 
 func (chDesc ChannelDescriptor) FillDefaults() (filled ChannelDescriptor) {
@@ -773,17 +1153,66 @@ func (chDesc ChannelDescriptor) FillDefaults() (filled ChannelDescriptor) {
 	return
 }
 
+// BufferSize estimates the memory, in bytes, used by the send queue and the
+// receive buffer of a channel with this descriptor, counting every message in
+// the send queue as one packet payload of maxPacketMsgPayloadSize bytes.
+func (chDesc ChannelDescriptor) BufferSize(maxPacketMsgPayloadSize int) int64 {
+	chDesc = chDesc.FillDefaults()
+	return int64(chDesc.SendQueueCapacity)*int64(maxPacketMsgPayloadSize) + int64(chDesc.RecvBufferCapacity)
+}
+
+// SizeChannelBuffers returns copies of the channel descriptors with their
+// SendQueueCapacity and RecvBufferCapacity scaled so that the buffers of all
+// the channels of a connection, as estimated by BufferSize, fit in budget
+// bytes. The capacities of the descriptors are kept as relative weights.
+//
+// Every channel keeps room for at least one message in its send queue and
+// one packet payload in its receive buffer, so the result may exceed a very
+// small budget. Receive buffers are never larger than RecvMessageCapacity.
+func SizeChannelBuffers(
+	chDescs []*ChannelDescriptor, budget int64, maxPacketMsgPayloadSize int,
+) []*ChannelDescriptor {
+	var declared int64
+	for _, chDesc := range chDescs {
+		declared += chDesc.BufferSize(maxPacketMsgPayloadSize)
+	}
+
+	scale := func(capacity, minimum int) int {
+		scaled := int(float64(capacity) * float64(budget) / float64(declared))
+		if scaled < minimum {
+			return minimum
+		}
+		return scaled
+	}
+
+	sized := make([]*ChannelDescriptor, len(chDescs))
+	for i, chDesc := range chDescs {
+		filled := chDesc.FillDefaults()
+		if declared > 0 {
+			filled.SendQueueCapacity = scale(filled.SendQueueCapacity, 1)
+			filled.RecvBufferCapacity = scale(filled.RecvBufferCapacity, maxPacketMsgPayloadSize)
+			if filled.RecvBufferCapacity > filled.RecvMessageCapacity {
+				filled.RecvBufferCapacity = filled.RecvMessageCapacity
+			}
+		}
+		sized[i] = &filled
+	}
+	return sized
+}
+
 // TODO: lowercase.
 // NOTE: not goroutine-safe.
 type Channel struct {
 	conn          *MConnection
 	desc          ChannelDescriptor
 	sendQueue     chan []byte
-	sendQueueSize int32 // atomic.
-	recving       []byte
+	sendQueueSize int32   // atomic.
+	recving       *Buffer // nil between messages
 	sending       []byte
 	recentlySent  int64 // exponential moving average
 
+	recvLimiter ChannelRecvLimiter
+
 	maxPacketMsgPayloadSize int
 
 	Logger log.Logger
@@ -798,7 +1227,7 @@ func newChannel(conn *MConnection, desc ChannelDescriptor) *Channel {
 		conn:                    conn,
 		desc:                    desc,
 		sendQueue:               make(chan []byte, desc.SendQueueCapacity),
-		recving:                 make([]byte, 0, desc.RecvBufferCapacity),
+		recvLimiter:             ChannelRecvLimiter{limit: conn.config.ChannelRecvLimits[desc.ID]},
 		maxPacketMsgPayloadSize: conn.config.MaxPacketMsgPayloadSize,
 	}
 }
@@ -807,6 +1236,49 @@ func (ch *Channel) SetLogger(l log.Logger) {
 	ch.Logger = l
 }
 
+// recvRateWait counts a received message of size bytes against the receive
+// limits of the channel and returns zero, or returns how long to wait before
+// the message can be counted without exceeding the limits.
+// Not goroutine-safe
+func (ch *Channel) recvRateWait(size int, now time.Time) time.Duration {
+	return ch.recvLimiter.Wait(size, now)
+}
+
+// ChannelRecvLimiter counts the messages received on a channel against its
+// limits, over one-second windows. Not goroutine-safe.
+type ChannelRecvLimiter struct {
+	limit       config.ChannelRecvLimit
+	windowStart time.Time
+	windowMsgs  int
+	windowBytes int64
+}
+
+// NewChannelRecvLimiter returns a limiter of the messages received on a
+// channel to limit.
+func NewChannelRecvLimiter(limit config.ChannelRecvLimit) *ChannelRecvLimiter {
+	return &ChannelRecvLimiter{limit: limit}
+}
+
+// Wait counts a received message of size bytes against the limits and
+// returns zero, or returns how long to wait before the message can be counted
+// without exceeding the limits.
+func (l *ChannelRecvLimiter) Wait(size int, now time.Time) time.Duration {
+	if l.limit.MsgsPerSecond == 0 && l.limit.BytesPerSecond == 0 {
+		return 0
+	}
+	if now.Sub(l.windowStart) >= time.Second {
+		l.windowStart, l.windowMsgs, l.windowBytes = now, 0, 0
+	}
+	if l.windowMsgs > 0 &&
+		((l.limit.MsgsPerSecond > 0 && l.windowMsgs+1 > l.limit.MsgsPerSecond) ||
+			(l.limit.BytesPerSecond > 0 && l.windowBytes+int64(size) > l.limit.BytesPerSecond)) {
+		return l.windowStart.Add(time.Second).Sub(now)
+	}
+	l.windowMsgs++
+	l.windowBytes += int64(size)
+	return 0
+}
+
 // Queues message to send to this channel.
 // Goroutine-safe
 // Times out (and returns false) after defaultSendTimeout
@@ -875,10 +1347,12 @@ func (ch *Channel) nextPacketMsg() tmp2p.PacketMsg {
 	return packet
 }
 
-// Writes next PacketMsg to w and updates c.recentlySent.
+// Writes next PacketMsg to w, numbered with sequence, and updates
+// c.recentlySent.
 // Not goroutine-safe.
-func (ch *Channel) writePacketMsgTo(w protoio.Writer) (n int, err error) {
+func (ch *Channel) writePacketMsgTo(w protoio.Writer, sequence uint64) (n int, err error) {
 	packet := ch.nextPacketMsg()
+	packet.Sequence = sequence
 	n, err = w.WriteMsg(mustWrapPacket(&packet))
 	if err != nil {
 		return 0, err
@@ -887,26 +1361,28 @@ func (ch *Channel) writePacketMsgTo(w protoio.Writer) (n int, err error) {
 	return n, nil
 }
 
-// Handles incoming PacketMsgs. It returns a message bytes if message is
-// complete. NOTE message bytes may change on next call to recvPacketMsg.
+// Handles incoming PacketMsgs. It returns the message, with one reference to
+// be released by the caller, if it is complete.
 // Not goroutine-safe
-func (ch *Channel) recvPacketMsg(packet tmp2p.PacketMsg) ([]byte, error) {
+func (ch *Channel) recvPacketMsg(packet tmp2p.PacketMsg) (*Buffer, error) {
 	ch.Logger.Debug("Read PacketMsg", "conn", ch.conn, "packet", packet)
-	recvCap, recvReceived := ch.desc.RecvMessageCapacity, len(ch.recving)+len(packet.Data)
+	if ch.recving == nil {
+		ch.recving = getBuffer()
+	}
+	recvCap, recvReceived := ch.desc.RecvMessageCapacity, ch.recving.Len()+len(packet.Data)
+	if ch.conn.config.Compression {
+		// the compression header of a message sent as is
+		recvCap++
+	}
 	if recvCap < recvReceived {
 		return nil, fmt.Errorf("received message exceeds available capacity: %v < %v", recvCap, recvReceived)
 	}
-	ch.recving = append(ch.recving, packet.Data...)
+	ch.recving.append(packet.Data)
 	if packet.EOF {
-		msgBytes := make([]byte, len(ch.recving))
-		copy(msgBytes, ch.recving)
-
-		// clear the slice without re-allocating.
-		// http://stackoverflow.com/questions/16971741/how-do-you-clear-a-slice-in-go
-		//   suggests this could be a memory leak, but we might as well keep the memory for the channel until it closes,
-		//	at which point the recving slice stops being used and should be garbage collected
-		ch.recving = ch.recving[:0] // make([]byte, 0, ch.desc.RecvBufferCapacity)
-		return msgBytes, nil
+		// hand the buffer over, the next message being received in another
+		msg := ch.recving
+		ch.recving = nil
+		return msg, nil
 	}
 	return nil, nil
 }
@@ -947,6 +1423,12 @@ func mustWrapPacket(pb proto.Message) *tmp2p.Packet {
 				PacketMsg: pb,
 			},
 		}
+	case *tmp2p.PacketChannel:
+		msg = tmp2p.Packet{
+			Sum: &tmp2p.Packet_PacketChannel{
+				PacketChannel: pb,
+			},
+		}
 	default:
 		panic(fmt.Errorf("unknown packet type %T", pb))
 	}
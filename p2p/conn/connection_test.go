@@ -1,6 +1,7 @@
 package conn
 
 import (
+	"bytes"
 	"encoding/hex"
 	"net"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/libs/protoio"
 	tmp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
@@ -148,6 +150,45 @@ func TestMConnectionReceive(t *testing.T) {
 	}
 }
 
+func TestMConnectionReceiveBuffer(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	receivedCh := make(chan *Buffer)
+	errorsCh := make(chan interface{})
+	onError := func(r interface{}) {
+		errorsCh <- r
+	}
+	mconn1 := createMConnectionWithCallbacks(client, nil, onError)
+	mconn1.SetReceiveBufferHandler(func(chID byte, msg *Buffer) {
+		receivedCh <- msg
+	})
+	err := mconn1.Start()
+	require.Nil(t, err)
+	defer mconn1.Stop() //nolint:errcheck // ignore for tests
+
+	mconn2 := createTestMConnection(server)
+	err = mconn2.Start()
+	require.Nil(t, err)
+	defer mconn2.Stop() //nolint:errcheck // ignore for tests
+
+	// messages spanning several packets are reassembled in one buffer
+	for _, msg := range [][]byte{[]byte("Cyclops"), bytes.Repeat([]byte("Cyclops"), 1000)} {
+		assert.True(t, mconn2.Send(0x01, msg))
+
+		select {
+		case received := <-receivedCh:
+			assert.Equal(t, msg, received.Bytes())
+			received.Release()
+		case err := <-errorsCh:
+			t.Fatalf("Expected %s, got %+v", msg, err)
+		case <-time.After(500 * time.Millisecond):
+			t.Fatalf("Did not receive %s message in 500ms", msg)
+		}
+	}
+}
+
 func TestMConnectionStatus(t *testing.T) {
 	server, client := NetPipe()
 	defer server.Close()
@@ -302,6 +343,43 @@ func TestMConnectionMultiplePings(t *testing.T) {
 	assert.True(t, mconn.IsRunning())
 }
 
+func TestMConnectionPongClockOffset(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	mconn := createTestMConnection(client)
+	offsetCh := make(chan time.Duration, 1)
+	mconn.SetClockOffsetHandler(func(offset, _ time.Duration) {
+		offsetCh <- offset
+	})
+	err := mconn.Start()
+	require.Nil(t, err)
+	defer mconn.Stop() //nolint:errcheck // ignore for tests
+
+	go func() {
+		protoReader := protoio.NewDelimitedReader(server, maxPingPongPacketSize)
+		protoWriter := protoio.NewDelimitedWriter(server)
+
+		// read ping
+		var pkt tmp2p.Packet
+		_, err := protoReader.ReadMsg(&pkt)
+		require.NoError(t, err)
+
+		// respond with a pong from a clock an hour ahead
+		peerTime := time.Now().Add(time.Hour)
+		_, err = protoWriter.WriteMsg(mustWrapPacket(&tmp2p.PacketPong{Time: &peerTime}))
+		require.NoError(t, err)
+	}()
+
+	select {
+	case offset := <-offsetCh:
+		assert.InDelta(t, time.Hour, offset, float64(mconn.config.PongTimeout))
+	case <-time.After(time.Second):
+		t.Fatal("Expected the clock offset of the peer")
+	}
+}
+
 func TestMConnectionPingPongs(t *testing.T) {
 	// check that we are not leaking any go-routines
 	defer leaktest.CheckTimeout(t, 10*time.Second)()
@@ -525,6 +603,335 @@ func TestMConnectionReadErrorLongMessage(t *testing.T) {
 	assert.True(t, expectSend(chOnErr), "msg too long")
 }
 
+func TestMConnectionPacketSequence(t *testing.T) {
+	cfg := DefaultMConnConfig()
+	cfg.PacketSequence = true
+	chDescs := []*ChannelDescriptor{{ID: 0x01, Priority: 1, SendQueueCapacity: 1}}
+	newServer := func(conn net.Conn) (*MConnection, chan []byte, chan interface{}) {
+		receivedCh := make(chan []byte)
+		errorsCh := make(chan interface{}, 1)
+		mconn := NewMConnectionWithConfig(conn, chDescs, func(chID byte, msgBytes []byte) {
+			receivedCh <- msgBytes
+		}, func(r interface{}) {
+			errorsCh <- r
+		}, cfg)
+		mconn.SetLogger(log.TestingLogger())
+		require.NoError(t, mconn.Start())
+		return mconn, receivedCh, errorsCh
+	}
+
+	t.Run("numbered packets are received", func(t *testing.T) {
+		server, client := NetPipe()
+		defer server.Close()
+		defer client.Close()
+
+		mconnServer, receivedCh, errorsCh := newServer(server)
+		defer mconnServer.Stop() //nolint:errcheck // ignore for tests
+		mconnClient := NewMConnectionWithConfig(client, chDescs, func(byte, []byte) {}, func(interface{}) {}, cfg)
+		mconnClient.SetLogger(log.TestingLogger())
+		require.NoError(t, mconnClient.Start())
+		defer mconnClient.Stop() //nolint:errcheck // ignore for tests
+
+		for _, msg := range [][]byte{[]byte("Wasp"), []byte("Hulk")} {
+			assert.True(t, mconnClient.Send(0x01, msg))
+			select {
+			case received := <-receivedCh:
+				assert.Equal(t, msg, received)
+			case err := <-errorsCh:
+				t.Fatalf("Expected %s, got %+v", msg, err)
+			case <-time.After(500 * time.Millisecond):
+				t.Fatalf("Did not receive %s message in 500ms", msg)
+			}
+		}
+	})
+
+	t.Run("replayed packet is rejected", func(t *testing.T) {
+		server, client := NetPipe()
+		defer server.Close()
+		defer client.Close()
+
+		mconnServer, receivedCh, errorsCh := newServer(server)
+		defer mconnServer.Stop() //nolint:errcheck // ignore for tests
+		protoWriter := protoio.NewDelimitedWriter(client)
+
+		packet := tmp2p.PacketMsg{ChannelID: 0x01, EOF: true, Data: []byte("Hulk"), Sequence: 1}
+		_, err := protoWriter.WriteMsg(mustWrapPacket(&packet))
+		require.NoError(t, err)
+		assert.Equal(t, packet.Data, <-receivedCh)
+
+		_, err = protoWriter.WriteMsg(mustWrapPacket(&packet))
+		require.NoError(t, err)
+		select {
+		case err := <-errorsCh:
+			assert.ErrorIs(t, err.(error), ErrPacketOutOfSequence)
+		case <-receivedCh:
+			t.Fatal("Replayed packet was received")
+		case <-time.After(time.Second):
+			t.Fatal("Did not reject the replayed packet in 1s")
+		}
+	})
+}
+
+func TestNegotiateMaxPacketMsgPayloadSize(t *testing.T) {
+	assert.Equal(t, 1024, NegotiateMaxPacketMsgPayloadSize(1024, 4096))
+	assert.Equal(t, 1024, NegotiateMaxPacketMsgPayloadSize(4096, 1024))
+	assert.Equal(t, 4096, NegotiateMaxPacketMsgPayloadSize(4096, 4096))
+	// the peer doesn't advertise its size
+	assert.Equal(t, 4096, NegotiateMaxPacketMsgPayloadSize(4096, 0))
+	// the size is never below the minimum
+	assert.Equal(t, config.MinMaxPacketMsgPayloadSize, NegotiateMaxPacketMsgPayloadSize(4096, 1))
+}
+
+func TestMConnectionNegotiatedMaxPacketMsgPayloadSize(t *testing.T) {
+	chDescs := []*ChannelDescriptor{{ID: 0x01, Priority: 1, SendQueueCapacity: 1}}
+	clientSize, serverSize := 4096, 1024
+	msg := make([]byte, 3*clientSize)
+
+	for _, negotiate := range []bool{false, true} {
+		server, client := NetPipe()
+
+		serverCfg, clientCfg := DefaultMConnConfig(), DefaultMConnConfig()
+		serverCfg.MaxPacketMsgPayloadSize, clientCfg.MaxPacketMsgPayloadSize = serverSize, clientSize
+		if negotiate {
+			serverCfg.MaxPacketMsgPayloadSize = NegotiateMaxPacketMsgPayloadSize(serverSize, clientSize)
+			clientCfg.MaxPacketMsgPayloadSize = NegotiateMaxPacketMsgPayloadSize(clientSize, serverSize)
+		}
+
+		receivedCh := make(chan []byte)
+		errorsCh := make(chan interface{}, 1)
+		mconnServer := NewMConnectionWithConfig(server, chDescs, func(chID byte, msgBytes []byte) {
+			receivedCh <- msgBytes
+		}, func(r interface{}) {
+			errorsCh <- r
+		}, serverCfg)
+		mconnServer.SetLogger(log.TestingLogger())
+		require.NoError(t, mconnServer.Start())
+		mconnClient := NewMConnectionWithConfig(client, chDescs, func(byte, []byte) {}, func(interface{}) {}, clientCfg)
+		mconnClient.SetLogger(log.TestingLogger())
+		require.NoError(t, mconnClient.Start())
+
+		assert.True(t, mconnClient.Send(0x01, msg))
+		select {
+		case received := <-receivedCh:
+			assert.True(t, negotiate, "Packets larger than the server's size were received")
+			assert.Equal(t, msg, received)
+		case err := <-errorsCh:
+			assert.False(t, negotiate, "Expected the message, got %+v", err)
+		case <-time.After(time.Second):
+			t.Fatal("Did not receive the message nor an error in 1s")
+		}
+
+		_ = mconnClient.Stop()
+		_ = mconnServer.Stop()
+		server.Close()
+		client.Close()
+	}
+}
+
+func TestMConnectionCompression(t *testing.T) {
+	msg := bytes.Repeat([]byte("celestia blob "), 1000)
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 1, SendQueueCapacity: 1, RecvMessageCapacity: len(msg), Compression: CompressionZstd},
+		{ID: 0x02, Priority: 1, SendQueueCapacity: 1, RecvMessageCapacity: len(msg)},
+	}
+
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	cfg := DefaultMConnConfig()
+	cfg.Compression = true
+	type received struct {
+		chID byte
+		msg  []byte
+	}
+	receivedCh := make(chan received)
+	errorsCh := make(chan interface{}, 1)
+	mconnServer := NewMConnectionWithConfig(server, chDescs, func(chID byte, msgBytes []byte) {
+		receivedCh <- received{chID, msgBytes}
+	}, func(r interface{}) {
+		errorsCh <- r
+	}, cfg)
+	mconnServer.SetLogger(log.TestingLogger())
+	require.NoError(t, mconnServer.Start())
+	defer mconnServer.Stop() //nolint:errcheck // ignore for tests
+	mconnClient := NewMConnectionWithConfig(client, chDescs, func(byte, []byte) {}, func(interface{}) {}, cfg)
+	mconnClient.SetLogger(log.TestingLogger())
+	require.NoError(t, mconnClient.Start())
+	defer mconnClient.Stop() //nolint:errcheck // ignore for tests
+
+	for _, chID := range []byte{0x01, 0x02} {
+		sent := mconnClient.Status().SendMonitor.Bytes
+		assert.True(t, mconnClient.Send(chID, msg))
+		select {
+		case r := <-receivedCh:
+			assert.Equal(t, chID, r.chID)
+			assert.Equal(t, msg, r.msg)
+		case err := <-errorsCh:
+			t.Fatalf("Expected the message, got %+v", err)
+		case <-time.After(time.Second):
+			t.Fatal("Did not receive the message nor an error in 1s")
+		}
+		sent = mconnClient.Status().SendMonitor.Bytes - sent
+		if chID == 0x01 {
+			assert.Less(t, sent, int64(len(msg)/10), "the message was not compressed")
+		} else {
+			assert.Greater(t, sent, int64(len(msg)), "the message was compressed")
+		}
+	}
+}
+
+func TestMConnectionRegisterChannel(t *testing.T) {
+	chDescs := []*ChannelDescriptor{{ID: 0x01, Priority: 1, SendQueueCapacity: 1}}
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	type channelUpdate struct {
+		chID       byte
+		registered bool
+	}
+	newMConn := func(conn net.Conn, cfg MConnConfig) (*MConnection, chan []byte, chan channelUpdate, chan interface{}) {
+		receivedCh := make(chan []byte, 1)
+		updatesCh := make(chan channelUpdate, 1)
+		errorsCh := make(chan interface{}, 1)
+		mconn := NewMConnectionWithConfig(conn, chDescs, func(_ byte, msgBytes []byte) {
+			receivedCh <- msgBytes
+		}, func(r interface{}) {
+			errorsCh <- r
+		}, cfg)
+		mconn.SetChannelUpdateHandler(func(chID byte, registered bool) {
+			updatesCh <- channelUpdate{chID, registered}
+		})
+		mconn.SetLogger(log.TestingLogger())
+		require.NoError(t, mconn.Start())
+		t.Cleanup(func() { _ = mconn.Stop() })
+		return mconn, receivedCh, updatesCh, errorsCh
+	}
+	cfg := DefaultMConnConfig()
+	require.ErrorIs(t, NewMConnectionWithConfig(server, chDescs, nil, nil, cfg).RegisterChannel(&ChannelDescriptor{ID: 0x02}),
+		ErrChannelRegistrationUnsupported)
+
+	cfg.ChannelRegistration = true
+	mconnServer, serverReceivedCh, serverUpdatesCh, serverErrorsCh := newMConn(server, cfg)
+	mconnClient, _, clientUpdatesCh, clientErrorsCh := newMConn(client, cfg)
+
+	// the channel is registered on both sides
+	newChDesc := &ChannelDescriptor{ID: 0x02, Priority: 1, SendQueueCapacity: 1}
+	require.NoError(t, mconnClient.RegisterChannel(newChDesc))
+	require.Error(t, mconnClient.RegisterChannel(newChDesc))
+	assert.Equal(t, channelUpdate{0x02, true}, receiveWithin(t, serverUpdatesCh))
+	require.NoError(t, mconnServer.RegisterChannel(newChDesc))
+	assert.Equal(t, channelUpdate{0x02, true}, receiveWithin(t, clientUpdatesCh))
+	assert.True(t, mconnClient.Send(0x02, []byte("hello")))
+	assert.Equal(t, []byte("hello"), receiveWithin(t, serverReceivedCh))
+	assert.Len(t, mconnClient.Status().Channels, 2)
+
+	// the packets sent on a channel being unregistered are dropped
+	require.NoError(t, mconnClient.UnregisterChannel(0x02))
+	require.Error(t, mconnClient.UnregisterChannel(0x02))
+	assert.False(t, mconnClient.Send(0x02, []byte("hello")))
+	assert.True(t, mconnServer.Send(0x02, []byte("dropped")))
+	assert.Equal(t, channelUpdate{0x02, false}, receiveWithin(t, serverUpdatesCh))
+	require.NoError(t, mconnServer.UnregisterChannel(0x02))
+	assert.Equal(t, channelUpdate{0x02, false}, receiveWithin(t, clientUpdatesCh))
+
+	assert.True(t, mconnClient.Send(0x01, []byte("still connected")))
+	assert.Equal(t, []byte("still connected"), receiveWithin(t, serverReceivedCh))
+	assert.Empty(t, serverErrorsCh)
+	assert.Empty(t, clientErrorsCh)
+}
+
+// receiveWithin returns the next value received on ch, failing the test if
+// none is received in a second.
+func receiveWithin[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(time.Second):
+		t.Fatal("nothing received in 1s")
+	}
+	var zero T
+	return zero
+}
+
+func TestMConnectionChannelRecvLimits(t *testing.T) {
+	chDescs := []*ChannelDescriptor{{ID: 0x01, Priority: 1, SendQueueCapacity: 1}}
+	newServer := func(conn net.Conn, throttle bool) (*MConnection, chan []byte, chan interface{}) {
+		cfg := DefaultMConnConfig()
+		cfg.ChannelRecvLimits = map[byte]config.ChannelRecvLimit{0x01: {MsgsPerSecond: 2}}
+		cfg.ThrottleChannelRecv = throttle
+		receivedCh := make(chan []byte)
+		errorsCh := make(chan interface{}, 1)
+		mconn := NewMConnectionWithConfig(conn, chDescs, func(chID byte, msgBytes []byte) {
+			receivedCh <- msgBytes
+		}, func(r interface{}) {
+			errorsCh <- r
+		}, cfg)
+		mconn.SetLogger(log.TestingLogger())
+		require.NoError(t, mconn.Start())
+		return mconn, receivedCh, errorsCh
+	}
+	sendMsgs := func(client net.Conn, n int) {
+		protoWriter := protoio.NewDelimitedWriter(client)
+		go func() {
+			for i := 0; i < n; i++ {
+				packet := tmp2p.PacketMsg{ChannelID: 0x01, EOF: true, Data: []byte("Hulk")}
+				if _, err := protoWriter.WriteMsg(mustWrapPacket(&packet)); err != nil {
+					return
+				}
+			}
+		}()
+	}
+
+	t.Run("disconnect", func(t *testing.T) {
+		server, client := NetPipe()
+		defer server.Close()
+		defer client.Close()
+
+		mconnServer, receivedCh, errorsCh := newServer(server, false)
+		defer mconnServer.Stop() //nolint:errcheck // ignore for tests
+		sendMsgs(client, 3)
+
+		for i := 0; i < 2; i++ {
+			assert.Equal(t, []byte("Hulk"), <-receivedCh)
+		}
+		select {
+		case err := <-errorsCh:
+			assert.ErrorIs(t, err.(error), ErrChannelRecvRateExceeded)
+		case <-receivedCh:
+			t.Fatal("Message over the limit was received")
+		case <-time.After(time.Second):
+			t.Fatal("Did not disconnect in 1s")
+		}
+	})
+
+	t.Run("throttle", func(t *testing.T) {
+		server, client := NetPipe()
+		defer server.Close()
+		defer client.Close()
+
+		mconnServer, receivedCh, errorsCh := newServer(server, true)
+		defer mconnServer.Stop() //nolint:errcheck // ignore for tests
+		sendMsgs(client, 3)
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			select {
+			case received := <-receivedCh:
+				assert.Equal(t, []byte("Hulk"), received)
+			case err := <-errorsCh:
+				t.Fatalf("Expected a message, got %+v", err)
+			case <-time.After(2 * time.Second):
+				t.Fatal("Did not receive the message in 2s")
+			}
+		}
+		// the third message waited for the next window
+		assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+	})
+}
+
 func TestMConnectionReadErrorUnknownMsgType(t *testing.T) {
 	chOnErr := make(chan struct{})
 	mconnClient, mconnServer := newClientAndServerConnsForReadErrors(t, chOnErr)
@@ -631,3 +1038,36 @@ func stopAll(t *testing.T, stoppers ...stopper) func() {
 		}
 	}
 }
+
+func TestSizeChannelBuffers(t *testing.T) {
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, SendQueueCapacity: 100, RecvBufferCapacity: 100 * 1024, RecvMessageCapacity: 1024 * 1024},
+		{ID: 0x02, SendQueueCapacity: 10, RecvBufferCapacity: 4096, RecvMessageCapacity: 8192},
+	}
+	declared := chDescs[0].BufferSize(1024) + chDescs[1].BufferSize(1024)
+	require.EqualValues(t, 100*1024+100*1024+10*1024+4096, declared)
+
+	// halving the budget halves the capacities
+	sized := SizeChannelBuffers(chDescs, declared/2, 1024)
+	require.Len(t, sized, 2)
+	assert.Equal(t, 50, sized[0].SendQueueCapacity)
+	assert.Equal(t, 50*1024, sized[0].RecvBufferCapacity)
+	assert.Equal(t, 5, sized[1].SendQueueCapacity)
+	assert.Equal(t, 2048, sized[1].RecvBufferCapacity)
+	assert.LessOrEqual(t, sized[0].BufferSize(1024)+sized[1].BufferSize(1024), declared/2)
+	// the descriptors passed in are left untouched
+	assert.Equal(t, 100, chDescs[0].SendQueueCapacity)
+
+	// receive buffers don't grow beyond the largest message
+	sized = SizeChannelBuffers(chDescs, declared*4, 1024)
+	assert.Equal(t, 400, sized[0].SendQueueCapacity)
+	assert.Equal(t, 400*1024, sized[0].RecvBufferCapacity)
+	assert.Equal(t, 8192, sized[1].RecvBufferCapacity)
+
+	// channels always keep room for a message and a packet
+	sized = SizeChannelBuffers(chDescs, 1, 1024)
+	for _, chDesc := range sized {
+		assert.Equal(t, 1, chDesc.SendQueueCapacity)
+		assert.Equal(t, 1024, chDesc.RecvBufferCapacity)
+	}
+}
@@ -228,9 +228,8 @@ func (c *evilConn) signChallenge() []byte {
 		recvAead:        recvAead,
 		sendAead:        sendAead,
 		recvFrame:       make([]byte, totalFrameSize),
-		recvSealedFrame: make([]byte, totalFrameSize+aeadSizeOverhead),
+		recvSealedFrame: make([]byte, sealedFrameSize),
 		sendFrame:       make([]byte, totalFrameSize),
-		sendSealedFrame: make([]byte, totalFrameSize+aeadSizeOverhead),
 	}
 	c.buffer = b
 
@@ -0,0 +1,172 @@
+package conn
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	tmp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
+)
+
+// packetOverheadSize bounds the size of a PacketMsg wrapped in a Packet,
+// besides its data.
+const packetOverheadSize = 32
+
+// packetBufferPool holds the buffers packets are read into, shared by the
+// connections so that the idle ones, waiting for their next packet, do not
+// hold one.
+var packetBufferPool = sync.Pool{
+	New: func() any {
+		bz := make([]byte, 0, defaultMaxPacketMsgPayloadSize+packetOverheadSize)
+		return &bz
+	},
+}
+
+// packetReader reads the varint-delimited packets of a connection. Unlike
+// protoio.ReadMsg, it decodes PacketMsgs in place: the data of the PacketMsg
+// read refers to the buffer of the packet, valid until the next read, and the
+// PacketMsg itself is reused by the next read.
+type packetReader struct {
+	r       *bufio.Reader
+	maxSize int
+	buf     *[]byte // buffer of the last packet read, if any
+
+	msg     tmp2p.PacketMsg
+	wrapper tmp2p.Packet_PacketMsg
+}
+
+func newPacketReader(r *bufio.Reader, maxSize int) *packetReader {
+	pr := &packetReader{r: r, maxSize: maxSize}
+	pr.wrapper.PacketMsg = &pr.msg
+	return pr
+}
+
+// read reads the next packet into packet, returning the number of bytes read.
+func (pr *packetReader) read(packet *tmp2p.Packet) (int, error) {
+	pr.release()
+
+	l, err := binary.ReadUvarint(pr.r)
+	if err != nil {
+		return 0, err
+	}
+	n := protowire.SizeVarint(l)
+	if l > uint64(pr.maxSize) {
+		return n, fmt.Errorf("message exceeds max size (%v > %v)", l, pr.maxSize)
+	}
+	length := int(l)
+
+	pr.buf = packetBufferPool.Get().(*[]byte)
+	if cap(*pr.buf) < length {
+		*pr.buf = make([]byte, length)
+	}
+	bz := (*pr.buf)[:length]
+	nr, err := io.ReadFull(pr.r, bz)
+	n += nr
+	if err != nil {
+		return n, err
+	}
+
+	// Fast path for the packets consisting of a single PacketMsg, i.e. all
+	// the packets sent by the peers.
+	if num, typ, tagLen := protowire.ConsumeTag(bz); num == 3 && typ == protowire.BytesType {
+		if v, vLen := protowire.ConsumeBytes(bz[tagLen:]); vLen > 0 && tagLen+vLen == len(bz) {
+			if err := unmarshalPacketMsg(v, &pr.msg); err != nil {
+				return n, err
+			}
+			packet.Sum = &pr.wrapper
+			return n, nil
+		}
+	}
+	return n, packet.Unmarshal(bz)
+}
+
+// release returns the buffer of the last packet read to the pool.
+func (pr *packetReader) release() {
+	if pr.buf != nil {
+		packetBufferPool.Put(pr.buf)
+		pr.buf = nil
+	}
+}
+
+// msgReadChunkSize bounds the buffer ReadMsg allocates for a message before
+// its bytes arrive.
+const msgReadChunkSize = 64 * 1024
+
+// ReadMsg reads a message of size bytes from r, as io.ReadFull. Rather than
+// allocated upfront, the buffer of the message grows as its bytes arrive, as
+// MConnection assembles messages from their packets, so that a peer announcing
+// messages up to the capacity of a channel only makes us allocate the bytes it
+// actually sends.
+func ReadMsg(r io.Reader, size int) ([]byte, error) {
+	msg := make([]byte, 0, min(size, msgReadChunkSize))
+	for len(msg) < size {
+		if len(msg) == cap(msg) {
+			msg = slices.Grow(msg, min(size-len(msg), len(msg)))
+		}
+		n, err := io.ReadFull(r, msg[len(msg):min(size, cap(msg))])
+		msg = msg[:len(msg)+n]
+		if err != nil {
+			if errors.Is(err, io.EOF) && len(msg) > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+// unmarshalPacketMsg decodes bz into msg as PacketMsg.Unmarshal, but without
+// copying the data, which refers to bz.
+func unmarshalPacketMsg(bz []byte, msg *tmp2p.PacketMsg) error {
+	*msg = tmp2p.PacketMsg{}
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return fmt.Errorf("packet msg: %w", protowire.ParseError(n))
+		}
+		bz = bz[n:]
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(bz)
+			if n < 0 {
+				return fmt.Errorf("packet msg channel id: %w", protowire.ParseError(n))
+			}
+			msg.ChannelID = int32(v)
+			bz = bz[n:]
+		case num == 2 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(bz)
+			if n < 0 {
+				return fmt.Errorf("packet msg eof: %w", protowire.ParseError(n))
+			}
+			msg.EOF = v != 0
+			bz = bz[n:]
+		case num == 3 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(bz)
+			if n < 0 {
+				return fmt.Errorf("packet msg data: %w", protowire.ParseError(n))
+			}
+			msg.Data = v
+			bz = bz[n:]
+		case num == 4 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(bz)
+			if n < 0 {
+				return fmt.Errorf("packet msg sequence: %w", protowire.ParseError(n))
+			}
+			msg.Sequence = v
+			bz = bz[n:]
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, bz)
+			if n < 0 {
+				return fmt.Errorf("packet msg field %d: %w", num, protowire.ParseError(n))
+			}
+			bz = bz[n:]
+		}
+	}
+	return nil
+}
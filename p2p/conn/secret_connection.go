@@ -11,6 +11,7 @@ import (
 	"io"
 	"math"
 	"net"
+	"sync"
 	"time"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
@@ -37,6 +38,11 @@ const (
 	aeadSizeOverhead = 16 // overhead of poly 1305 authentication tag
 	aeadKeySize      = chacha20poly1305.KeySize
 	aeadNonceSize    = chacha20poly1305.NonceSize
+	sealedFrameSize  = totalFrameSize + aeadSizeOverhead
+
+	// maxBatchFrames is the maximum number of sealed frames passed to the
+	// underlying connection in a single write.
+	maxBatchFrames = 64
 
 	labelEphemeralLowerPublicKey = "EPHEMERAL_LOWER_PUBLIC_KEY"
 	labelEphemeralUpperPublicKey = "EPHEMERAL_UPPER_PUBLIC_KEY"
@@ -48,6 +54,15 @@ var (
 	ErrSmallOrderRemotePubKey = errors.New("detected low order point from remote peer")
 
 	secretConnKeyAndChallengeGen = []byte("TENDERMINT_SECRET_CONNECTION_KEY_AND_CHALLENGE_GEN")
+
+	// sendBatchPool holds the buffers frames are sealed into before being
+	// written, so that idle connections do not each hold on to one.
+	sendBatchPool = sync.Pool{
+		New: func() interface{} {
+			batch := make([]byte, maxBatchFrames*sealedFrameSize)
+			return &batch
+		},
+	}
 )
 
 // SecretConnection implements net.Conn.
@@ -81,10 +96,9 @@ type SecretConnection struct {
 	recvFrame       []byte
 	recvSealedFrame []byte
 
-	sendMtx         cmtsync.Mutex
-	sendNonce       *[aeadNonceSize]byte
-	sendFrame       []byte
-	sendSealedFrame []byte
+	sendMtx   cmtsync.Mutex
+	sendNonce *[aeadNonceSize]byte
+	sendFrame []byte
 }
 
 // MakeSecretConnection performs handshake and returns a new authenticated
@@ -154,9 +168,8 @@ func MakeSecretConnection(conn io.ReadWriteCloser, locPrivKey crypto.PrivKey) (*
 		recvAead:        recvAead,
 		sendAead:        sendAead,
 		recvFrame:       make([]byte, totalFrameSize),
-		recvSealedFrame: make([]byte, aeadSizeOverhead+totalFrameSize),
+		recvSealedFrame: make([]byte, sealedFrameSize),
 		sendFrame:       make([]byte, totalFrameSize),
-		sendSealedFrame: make([]byte, aeadSizeOverhead+totalFrameSize),
 	}
 
 	// Sign the challenge bytes for authentication.
@@ -194,38 +207,43 @@ func (sc *SecretConnection) RemotePubKey() crypto.PubKey {
 func (sc *SecretConnection) Write(data []byte) (n int, err error) {
 	sc.sendMtx.Lock()
 	defer sc.sendMtx.Unlock()
-	sealedFrame, frame := sc.sendSealedFrame, sc.sendFrame
+	frame := sc.sendFrame
+
+	// Frames are sealed back to back into a pooled buffer, so that up to
+	// maxBatchFrames frames are written with a single call to the underlying
+	// connection.
+	batchPtr := sendBatchPool.Get().(*[]byte)
+	defer sendBatchPool.Put(batchPtr)
+	batch := *batchPtr
 
 	for 0 < len(data) {
-		if err := func() error {
-			var chunk []byte
-			if dataMaxSize < len(data) {
-				chunk = data[:dataMaxSize]
-				data = data[dataMaxSize:]
-			} else {
-				chunk = data
-				data = nil
+		var batchLen, batchDataLen int
+		for 0 < len(data) && batchLen < len(batch) {
+			chunk := data
+			if dataMaxSize < len(chunk) {
+				chunk = chunk[:dataMaxSize]
 			}
-			chunkLength := len(chunk)
-			binary.LittleEndian.PutUint32(frame, uint32(chunkLength))
+			data = data[len(chunk):]
+			binary.LittleEndian.PutUint32(frame, uint32(len(chunk)))
 			copy(frame[dataLenSize:], chunk)
 
 			// encrypt the frame
-			sc.sendAead.Seal(sealedFrame[:0], sc.sendNonce[:], frame, nil)
+			sc.sendAead.Seal(batch[batchLen:batchLen], sc.sendNonce[:], frame, nil)
 			incrNonce(sc.sendNonce)
 			// end encryption
 
-			_, err = sc.conn.Write(sealedFrame)
-			if err != nil {
-				return err
-			}
-			n += len(chunk)
-			return nil
-		}(); err != nil {
-			return n, err
+			batchLen += sealedFrameSize
+			batchDataLen += len(chunk)
 		}
+
+		written, err := sc.conn.Write(batch[:batchLen])
+		if err != nil {
+			// only count the data of the frames sent in full
+			return n + min(written/sealedFrameSize*dataMaxSize, batchDataLen), err
+		}
+		n += batchDataLen
 	}
-	return n, err
+	return n, nil
 }
 
 // CONTRACT: data smaller than dataMaxSize is read atomically.
@@ -266,8 +284,9 @@ func (sc *SecretConnection) Read(data []byte) (n int, err error) {
 	var chunk = frame[dataLenSize : dataLenSize+chunkLength]
 	n = copy(data, chunk)
 	if n < len(chunk) {
-		sc.recvBuffer = make([]byte, len(chunk)-n)
-		copy(sc.recvBuffer, chunk[n:])
+		// recvFrame is not overwritten until recvBuffer is drained, so the
+		// rest of the chunk is read off it without copying.
+		sc.recvBuffer = chunk[n:]
 	}
 	return n, err
 }
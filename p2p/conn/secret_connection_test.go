@@ -107,6 +107,31 @@ func TestConcurrentRead(t *testing.T) {
 	}
 }
 
+func TestSecretConnectionBatchedWrite(t *testing.T) {
+	fooSecConn, barSecConn := makeSecretConnPair(t)
+	// spans several batches, ending with a partial frame
+	data := cmtrand.Bytes(3*maxBatchFrames*dataMaxSize + 100)
+
+	go func() {
+		n, err := fooSecConn.Write(data)
+		assert.NoError(t, err)
+		assert.Equal(t, len(data), n)
+	}()
+
+	// read in chunks not aligned with frames
+	got := make([]byte, 0, len(data))
+	buf := make([]byte, 700)
+	for len(got) < len(data) {
+		n, err := barSecConn.Read(buf)
+		require.NoError(t, err)
+		got = append(got, buf[:n]...)
+	}
+	assert.Equal(t, data, got)
+
+	require.NoError(t, fooSecConn.Close())
+	require.NoError(t, barSecConn.Close())
+}
+
 func TestSecretConnectionReadWrite(t *testing.T) {
 	fooConn, barConn := makeKVStoreConnPair()
 	fooWrites, barWrites := []string{}, []string{}
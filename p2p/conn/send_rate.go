@@ -0,0 +1,116 @@
+package conn
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// sendRateIncrease is the factor applied to the send rate when the
+	// connection drains at the rate, i.e. the rate is what limits it.
+	sendRateIncrease = 1.25
+	// sendRateDecrease is the factor applied to the send rate when the
+	// round-trip time of a ping shows the peer or the link is congested.
+	sendRateDecrease = 0.75
+	// sendRateSaturation is the part of the send rate above which the
+	// connection is considered limited by the rate.
+	sendRateSaturation = 0.9
+	// rttCongestionFactor is the factor of the minimal round-trip time above
+	// which a round-trip time is considered inflated by queueing.
+	rttCongestionFactor = 2
+)
+
+// sendRateController adapts the send rate of a connection to the capacity
+// of the peer to receive, within [min, max]. It increases the rate while the
+// connection drains at the rate, and decreases it when the data pending is
+// drained well below the rate, e.g. because the peer does not read fast
+// enough, or when the round-trip time of a ping is inflated compared to the
+// minimal one observed. Only the send routine updates it, except for the
+// round-trip times recorded by the receive routine.
+type sendRateController struct {
+	min  int64
+	max  int64
+	rate int64 // atomic
+
+	minRTT int64 // atomic, in nanoseconds, 0 until the first pong
+
+	sentBytes int64 // bytes sent at the last update
+	updatedAt time.Time
+}
+
+func newSendRateController(rate, min, max int64) *sendRateController {
+	return &sendRateController{
+		min:       min,
+		max:       max,
+		rate:      clampSendRate(rate, min, max),
+		updatedAt: time.Now(),
+	}
+}
+
+func clampSendRate(rate, min, max int64) int64 {
+	if rate < min {
+		return min
+	}
+	if rate > max {
+		return max
+	}
+	return rate
+}
+
+// Rate returns the current send rate, in bytes/second.
+func (src *sendRateController) Rate() int64 {
+	return atomic.LoadInt64(&src.rate)
+}
+
+func (src *sendRateController) setRate(rate int64) {
+	atomic.StoreInt64(&src.rate, clampSendRate(rate, src.min, src.max))
+}
+
+// update adjusts the rate to the bytes sent on the connection since the
+// previous update, given whether data is still pending on its channels.
+func (src *sendRateController) update(sentBytes int64, pending bool) {
+	now := time.Now()
+	elapsed := now.Sub(src.updatedAt).Seconds()
+	sent := sentBytes - src.sentBytes
+	src.sentBytes, src.updatedAt = sentBytes, now
+	if elapsed <= 0 {
+		return
+	}
+
+	rate := src.Rate()
+	drainRate := int64(float64(sent) / elapsed)
+	switch {
+	case drainRate >= int64(float64(rate)*sendRateSaturation):
+		src.setRate(int64(float64(rate) * sendRateIncrease))
+	case pending && drainRate < rate/2:
+		// the peer drains the connection slower than the rate: send a bit
+		// faster than it drains, halving the rate at most.
+		target := drainRate + drainRate/4
+		if target < rate/2 {
+			target = rate / 2
+		}
+		src.setRate(target)
+	}
+}
+
+// recordRTT records the round-trip time of a ping, decreasing the rate if it
+// is inflated compared to the minimal round-trip time observed. The minimal
+// round-trip time creeps up with the samples above it, so that a lasting
+// change of route does not keep the rate at its minimum.
+func (src *sendRateController) recordRTT(rtt time.Duration) {
+	if rtt <= 0 {
+		return
+	}
+	minRTT := time.Duration(atomic.LoadInt64(&src.minRTT))
+	if minRTT == 0 || rtt < minRTT {
+		atomic.StoreInt64(&src.minRTT, int64(rtt))
+		return
+	}
+	if rtt > rttCongestionFactor*minRTT {
+		src.setRate(int64(float64(src.Rate()) * sendRateDecrease))
+	}
+	if creep := minRTT + minRTT/8; creep < rtt {
+		rtt = creep
+	}
+	atomic.StoreInt64(&src.minRTT, int64(rtt))
+}
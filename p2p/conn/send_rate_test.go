@@ -0,0 +1,63 @@
+package conn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// updateAfter updates src as if sent bytes were sent over the last second.
+func updateAfter(src *sendRateController, sent int64, pending bool) {
+	src.updatedAt = time.Now().Add(-time.Second)
+	src.update(src.sentBytes+sent, pending)
+}
+
+func TestSendRateControllerClampsInitialRate(t *testing.T) {
+	assert.EqualValues(t, 100, newSendRateController(10, 100, 1000).Rate())
+	assert.EqualValues(t, 1000, newSendRateController(5000, 100, 1000).Rate())
+	assert.EqualValues(t, 500, newSendRateController(500, 100, 1000).Rate())
+}
+
+func TestSendRateControllerIncreasesWhenSaturated(t *testing.T) {
+	src := newSendRateController(1000, 100, 2000)
+
+	updateAfter(src, 1000, true)
+	assert.Greater(t, src.Rate(), int64(1000))
+
+	for i := 0; i < 10; i++ {
+		updateAfter(src, src.Rate(), true)
+	}
+	assert.EqualValues(t, 2000, src.Rate(), "rate must not exceed the max")
+}
+
+func TestSendRateControllerDecreasesWhenDrainingSlowly(t *testing.T) {
+	src := newSendRateController(1000, 100, 2000)
+
+	// idle connection: the rate is kept
+	updateAfter(src, 10, false)
+	assert.EqualValues(t, 1000, src.Rate())
+
+	// pending data drained well below the rate: the rate is at most halved
+	updateAfter(src, 10, true)
+	assert.EqualValues(t, 500, src.Rate())
+
+	updateAfter(src, 200, true)
+	assert.EqualValues(t, 250, src.Rate())
+
+	for i := 0; i < 10; i++ {
+		updateAfter(src, 0, true)
+	}
+	assert.EqualValues(t, 100, src.Rate(), "rate must not go below the min")
+}
+
+func TestSendRateControllerDecreasesOnInflatedRTT(t *testing.T) {
+	src := newSendRateController(1000, 100, 2000)
+
+	src.recordRTT(10 * time.Millisecond)
+	src.recordRTT(15 * time.Millisecond)
+	assert.EqualValues(t, 1000, src.Rate())
+
+	src.recordRTT(100 * time.Millisecond)
+	assert.EqualValues(t, 750, src.Rate())
+}
@@ -0,0 +1,522 @@
+package conn
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/yamux"
+
+	flow "github.com/cometbft/cometbft/libs/flowrate"
+	"github.com/cometbft/cometbft/libs/service"
+)
+
+// yamuxFlushTimeout bounds the time FlushStop waits for the peer to read the
+// messages flushed and close the connection.
+const yamuxFlushTimeout = 5 * time.Second
+
+// YamuxConnection multiplexes the channels of a peer over the streams of a
+// yamux session, as MConnection does over a single framed stream. Each
+// channel is carried by a stream in each direction, opened with the first
+// message sent on the channel, starting with the channel ID and on which the
+// messages are delimited by their varint length. A peer opening a second
+// stream for a channel, or more streams than there are channels, is
+// disconnected.
+//
+// The streams are flow controlled independently: a channel whose messages
+// the peer is slow to process only holds back its own sends, and does not
+// delay the messages of the other channels. The send and receive rates of
+// the connection, and the receive rates of the channels, are limited as
+// configured, as by MConnection. Both ends of the connection must use it,
+// which the transport does when both peers advertise the yamux feature.
+//
+// Unlike MConnection, which receives all the messages of a peer from a single
+// routine, the messages of each channel are received by a routine of their
+// own: they are received in order within a channel, but the receive callback
+// is called concurrently for different channels, as it is for different
+// peers.
+type YamuxConnection struct {
+	service.BaseService
+
+	conn        net.Conn
+	outbound    bool
+	session     *yamux.Session // set on start
+	channels    map[byte]*yamuxChannel
+	channelList []*yamuxChannel
+	onReceive   receiveCbFunc
+	onError     errorCbFunc
+	config      MConnConfig
+	errored     atomic.Bool
+
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+	created     time.Time
+
+	stopMtx  sync.Mutex
+	quit     chan struct{} // closed on stop
+	flushing chan struct{} // closed by FlushStop, for the queues to be drained
+	sendWg   sync.WaitGroup
+
+	sendStreams atomic.Int32 // streams opened to send
+	recvStreams atomic.Int32 // streams of the peer being read
+}
+
+type yamuxChannel struct {
+	desc          ChannelDescriptor
+	sendQueue     chan []byte
+	sendQueueSize atomic.Int32
+	recentlySent  atomic.Int64 // exponential moving average
+	receiving     atomic.Bool  // set once a stream of the peer is read
+	recvLimiter   ChannelRecvLimiter
+}
+
+// NewYamuxConnection returns a connection multiplexing the channels
+// described over conn, which must be authenticated and encrypted, e.g. a
+// SecretConnection. The dialer of conn must set outbound. onReceive is called
+// with the messages received, concurrently from a routine per channel.
+func NewYamuxConnection(
+	conn net.Conn,
+	outbound bool,
+	chDescs []*ChannelDescriptor,
+	onReceive receiveCbFunc,
+	onError errorCbFunc,
+	config MConnConfig,
+) *YamuxConnection {
+	c := &YamuxConnection{
+		conn:        conn,
+		outbound:    outbound,
+		channels:    make(map[byte]*yamuxChannel, len(chDescs)),
+		onReceive:   onReceive,
+		onError:     onError,
+		config:      config,
+		sendMonitor: flow.New(0, 0),
+		recvMonitor: flow.New(0, 0),
+		created:     time.Now(),
+		quit:        make(chan struct{}),
+		flushing:    make(chan struct{}),
+	}
+	for _, desc := range chDescs {
+		desc := desc.FillDefaults()
+		ch := &yamuxChannel{
+			desc:        desc,
+			sendQueue:   make(chan []byte, desc.SendQueueCapacity),
+			recvLimiter: ChannelRecvLimiter{limit: config.ChannelRecvLimits[desc.ID]},
+		}
+		c.channels[desc.ID] = ch
+		c.channelList = append(c.channelList, ch)
+	}
+	c.BaseService = *service.NewBaseService(nil, "YamuxConnection", c)
+	return c
+}
+
+// OnStart implements BaseService.
+func (c *YamuxConnection) OnStart() error {
+	if err := c.BaseService.OnStart(); err != nil {
+		return err
+	}
+
+	yConfig := yamux.DefaultConfig()
+	yConfig.LogOutput = io.Discard
+	// The session is kept alive, and closed if the peer is unresponsive, by
+	// the pings of yamux.
+	yConfig.EnableKeepAlive = c.config.PingInterval > 0
+	if c.config.PingInterval > 0 {
+		yConfig.KeepAliveInterval = c.config.PingInterval
+	}
+	if c.config.PongTimeout > 0 {
+		yConfig.ConnectionWriteTimeout = c.config.PongTimeout
+	}
+	var err error
+	if c.outbound {
+		c.session, err = yamux.Client(c.conn, yConfig)
+	} else {
+		c.session, err = yamux.Server(c.conn, yConfig)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, ch := range c.channelList {
+		c.sendWg.Add(1)
+		go c.sendRoutine(ch)
+	}
+	go c.acceptRoutine()
+	go c.statsRoutine()
+	return nil
+}
+
+// stopServices closes quit, returning true if it was already closed.
+func (c *YamuxConnection) stopServices() (alreadyStopped bool) {
+	c.stopMtx.Lock()
+	defer c.stopMtx.Unlock()
+	select {
+	case <-c.quit:
+		return true
+	default:
+	}
+	c.BaseService.OnStop()
+	close(c.quit)
+	return false
+}
+
+// FlushStop stops the connection, as OnStop, once all the messages queued by
+// successful Send calls are sent and read by the peer, or after
+// yamuxFlushTimeout.
+func (c *YamuxConnection) FlushStop() {
+	c.stopMtx.Lock()
+	select {
+	case <-c.quit:
+		c.stopMtx.Unlock()
+		return
+	case <-c.flushing:
+		c.stopMtx.Unlock()
+		return
+	default:
+	}
+	close(c.flushing)
+	c.stopMtx.Unlock()
+
+	// The send routines drain the queues and close their streams, which the
+	// peer stops for once it read them.
+	c.sendWg.Wait()
+	if c.sendStreams.Load() > 0 {
+		select {
+		case <-c.session.CloseChan():
+		case <-time.After(yamuxFlushTimeout):
+		}
+	}
+
+	c.stopServices()
+	c.close()
+}
+
+// OnStop implements BaseService.
+func (c *YamuxConnection) OnStop() {
+	if c.stopServices() {
+		return
+	}
+	c.close()
+}
+
+// close closes the session, along with the underlying connection.
+func (c *YamuxConnection) close() {
+	if c.session != nil {
+		_ = c.session.Close()
+	} else {
+		_ = c.conn.Close()
+	}
+}
+
+func (c *YamuxConnection) String() string {
+	return fmt.Sprintf("YamuxConn{%v}", c.conn.RemoteAddr())
+}
+
+// Send queues a message to be sent on the channel, waiting up to
+// defaultSendTimeout for room in the queue. Returns false if the message was
+// not queued.
+func (c *YamuxConnection) Send(chID byte, msgBytes []byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+	ch, ok := c.channels[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+	select {
+	case ch.sendQueue <- msgBytes:
+		ch.sendQueueSize.Add(1)
+		return true
+	case <-time.After(defaultSendTimeout):
+		c.Logger.Debug("Send failed", "channel", chID, "conn", c)
+		return false
+	}
+}
+
+// TrySend queues a message to be sent on the channel, if there is room in
+// the queue. Returns false if the message was not queued.
+func (c *YamuxConnection) TrySend(chID byte, msgBytes []byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+	ch, ok := c.channels[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+	select {
+	case ch.sendQueue <- msgBytes:
+		ch.sendQueueSize.Add(1)
+		return true
+	default:
+		return false
+	}
+}
+
+// CanSend returns true if there is room in the queue of the channel. Use
+// only as a heuristic.
+func (c *YamuxConnection) CanSend(chID byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+	ch, ok := c.channels[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Unknown channel %X", chID))
+		return false
+	}
+	return int(ch.sendQueueSize.Load()) < ch.desc.SendQueueCapacity
+}
+
+// Status returns the status of the connection and of its channels.
+func (c *YamuxConnection) Status() ConnectionStatus {
+	status := ConnectionStatus{
+		Duration:    time.Since(c.created),
+		SendMonitor: c.sendMonitor.Status(),
+		RecvMonitor: c.recvMonitor.Status(),
+		Channels:    make([]ChannelStatus, len(c.channelList)),
+	}
+	for i, ch := range c.channelList {
+		status.Channels[i] = ChannelStatus{
+			ID:                ch.desc.ID,
+			SendQueueCapacity: cap(ch.sendQueue),
+			SendQueueSize:     int(ch.sendQueueSize.Load()),
+			Priority:          ch.desc.Priority,
+			RecentlySent:      ch.recentlySent.Load(),
+		}
+	}
+	return status
+}
+
+// sendRoutine writes the messages queued on a channel to its stream.
+func (c *YamuxConnection) sendRoutine(ch *yamuxChannel) {
+	defer c.sendWg.Done()
+	var (
+		st  *yamux.Stream
+		buf []byte
+		err error
+	)
+	defer func() {
+		if st != nil {
+			// the peer reads the stream to its end
+			_ = st.Close()
+		}
+	}()
+
+	for {
+		var msg []byte
+		select {
+		case msg = <-ch.sendQueue:
+		case <-c.flushing:
+			for {
+				select {
+				case msg = <-ch.sendQueue:
+				default:
+					return
+				}
+				if st, buf, err = c.sendMsg(ch, st, buf, msg); err != nil {
+					return
+				}
+			}
+		case <-c.quit:
+			return
+		}
+		if st, buf, err = c.sendMsg(ch, st, buf, msg); err != nil {
+			c.stopForError(err)
+			return
+		}
+	}
+}
+
+// sendMsg writes msg to the stream st of the channel, opening it if nil.
+// buf is reused to encode the message.
+func (c *YamuxConnection) sendMsg(
+	ch *yamuxChannel,
+	st *yamux.Stream,
+	buf []byte,
+	msg []byte,
+) (*yamux.Stream, []byte, error) {
+	ch.sendQueueSize.Add(-1)
+	buf = buf[:0]
+	if st == nil {
+		var err error
+		st, err = c.session.OpenStream()
+		if err != nil {
+			return nil, buf, err
+		}
+		c.sendStreams.Add(1)
+		buf = append(buf, ch.desc.ID)
+	}
+	if c.config.Compression {
+		msg = compressMsg(ch.desc.Compression, msg)
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(msg)))
+	buf = append(buf, msg...)
+
+	for bz := buf; len(bz) > 0; {
+		n := c.sendMonitor.Limit(len(bz), c.config.SendRate, true)
+		n, err := st.Write(bz[:n])
+		c.sendMonitor.Update(n)
+		ch.recentlySent.Add(int64(n))
+		if err != nil {
+			return st, buf, err
+		}
+		bz = bz[n:]
+	}
+	return st, buf, nil
+}
+
+// acceptRoutine accepts the streams opened by the peer, at most one per
+// channel.
+func (c *YamuxConnection) acceptRoutine() {
+	for accepted := 0; ; accepted++ {
+		st, err := c.session.AcceptStream()
+		if err != nil {
+			c.stopForRecvError(err)
+			return
+		}
+		if accepted == len(c.channels) {
+			_ = st.Close()
+			c.stopForError(fmt.Errorf("peer opened more than %d streams", len(c.channels)))
+			return
+		}
+		c.recvStreams.Add(1)
+		go c.recvRoutine(st)
+	}
+}
+
+// recvRoutine reads the messages of a channel from a stream of the peer.
+func (c *YamuxConnection) recvRoutine(st *yamux.Stream) {
+	defer c._recover()
+
+	r := bufio.NewReader(&yamuxRateLimitedReader{r: st, m: c.recvMonitor, rate: c.config.RecvRate})
+	chID, err := r.ReadByte()
+	if err != nil {
+		c.stopForRecvError(err)
+		return
+	}
+	ch, ok := c.channels[chID]
+	if !ok {
+		c.stopForError(fmt.Errorf("unknown channel %X", chID))
+		return
+	}
+	if !ch.receiving.CompareAndSwap(false, true) {
+		c.stopForError(fmt.Errorf("second stream for channel %X", chID))
+		return
+	}
+
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				// The peer closes its streams as it stops, once they are
+				// flushed.
+				if c.recvStreams.Add(-1) == 0 {
+					c.stopForRecvError(io.EOF)
+				}
+				return
+			}
+			c.stopForRecvError(err)
+			return
+		}
+		// compressed messages are prefixed with their compression
+		maxSize := uint64(ch.desc.RecvMessageCapacity)
+		if c.config.Compression {
+			maxSize++
+		}
+		if size > maxSize || size > math.MaxInt32 {
+			c.stopForError(fmt.Errorf("message of %d bytes exceeds the capacity of channel %X", size, chID))
+			return
+		}
+		msg, err := ReadMsg(r, int(size))
+		if err != nil {
+			c.stopForRecvError(err)
+			return
+		}
+		if c.config.Compression {
+			if msg, err = decompressMsg(nil, msg, ch.desc.RecvMessageCapacity); err != nil {
+				c.stopForError(fmt.Errorf("decompressing message of channel %X: %w", chID, err))
+				return
+			}
+		}
+		if wait := ch.recvLimiter.Wait(len(msg), time.Now()); wait > 0 {
+			if !c.config.ThrottleChannelRecv {
+				c.stopForError(fmt.Errorf("%w: channel %X", ErrChannelRecvRateExceeded, chID))
+				return
+			}
+			select {
+			case <-time.After(wait):
+			case <-c.quit:
+				return
+			}
+			ch.recvLimiter.Wait(len(msg), time.Now())
+		}
+		c.onReceive(chID, msg)
+	}
+}
+
+// statsRoutine decays the amount of data recently sent on the channels.
+func (c *YamuxConnection) statsRoutine() {
+	ticker := time.NewTicker(updateStats)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, ch := range c.channelList {
+				ch.recentlySent.Store(int64(float64(ch.recentlySent.Load()) * 0.8))
+			}
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// stopForRecvError stops the connection for an error receiving from the
+// peer, unless it is stopping, the errors being caused by the stop.
+func (c *YamuxConnection) stopForRecvError(err error) {
+	select {
+	case <-c.quit:
+	case <-c.flushing:
+	default:
+		c.stopForError(err)
+	}
+}
+
+// Catch panics of the receive callback.
+func (c *YamuxConnection) _recover() {
+	if r := recover(); r != nil {
+		c.Logger.Error("YamuxConnection panicked", "err", r, "stack", string(debug.Stack()))
+		c.stopForError(fmt.Errorf("recovered from panic: %v", r))
+	}
+}
+
+func (c *YamuxConnection) stopForError(r any) {
+	if err := c.Stop(); err != nil && !errors.Is(err, service.ErrAlreadyStopped) {
+		c.Logger.Error("Error stopping connection", "err", err)
+	}
+	if c.errored.CompareAndSwap(false, true) && c.onError != nil {
+		c.onError(r)
+	}
+}
+
+// yamuxRateLimitedReader reads at the rate limit of a monitor shared by the
+// streams of a connection.
+type yamuxRateLimitedReader struct {
+	r    io.Reader
+	m    *flow.Monitor
+	rate int64
+}
+
+func (r *yamuxRateLimitedReader) Read(b []byte) (int, error) {
+	n := r.m.Limit(len(b), r.rate, true)
+	n, err := r.r.Read(b[:n])
+	r.m.Update(n)
+	return n, err
+}
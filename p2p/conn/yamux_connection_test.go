@@ -0,0 +1,210 @@
+package conn
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/yamux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+type yamuxReceived struct {
+	mtx  sync.Mutex
+	msgs map[byte][]string
+}
+
+func (r *yamuxReceived) onReceive(chID byte, msgBytes []byte) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.msgs[chID] = append(r.msgs[chID], string(msgBytes))
+}
+
+func (r *yamuxReceived) get(chID byte) []string {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return append([]string(nil), r.msgs[chID]...)
+}
+
+func startYamuxConnections(
+	t *testing.T,
+	chDescs []*ChannelDescriptor,
+	onReceive receiveCbFunc,
+	onError errorCbFunc,
+	config MConnConfig,
+) (sender, receiver *YamuxConnection) {
+	t.Helper()
+	client, server := net.Pipe()
+	sender = NewYamuxConnection(client, true, chDescs, func(byte, []byte) {}, func(any) {}, config)
+	receiver = NewYamuxConnection(server, false, chDescs, onReceive, onError, config)
+	for _, c := range []*YamuxConnection{sender, receiver} {
+		c.SetLogger(log.TestingLogger())
+		require.NoError(t, c.Start())
+		t.Cleanup(func() { _ = c.Stop() })
+	}
+	return sender, receiver
+}
+
+func TestYamuxConnection(t *testing.T) {
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 1, RecvMessageCapacity: 1024},
+		{ID: 0x02, Priority: 1, RecvMessageCapacity: 1024},
+	}
+	errors := make(chan any, 1)
+	recv := &yamuxReceived{msgs: make(map[byte][]string)}
+	sender, _ := startYamuxConnections(t, chDescs, recv.onReceive, func(r any) { errors <- r }, DefaultMConnConfig())
+
+	assert.True(t, sender.CanSend(0x01))
+	assert.False(t, sender.Send(0x03, []byte("unknown channel")))
+	for _, msg := range []string{"a", "b", "c"} {
+		assert.True(t, sender.Send(0x01, []byte(msg)))
+	}
+	assert.True(t, sender.TrySend(0x02, []byte("d")))
+	require.Eventually(t, func() bool {
+		return len(recv.get(0x01)) == 3 && len(recv.get(0x02)) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"a", "b", "c"}, recv.get(0x01))
+	assert.Equal(t, []string{"d"}, recv.get(0x02))
+	assert.Equal(t, 2, len(sender.Status().Channels))
+
+	// the messages queued are received before the connection is closed
+	for i := 0; i < 100; i++ {
+		assert.True(t, sender.Send(0x01, []byte("e")))
+	}
+	sender.FlushStop()
+	assert.Len(t, recv.get(0x01), 103)
+	select {
+	case err := <-errors:
+		// the receiver stops as the sender closed its streams
+		assert.Equal(t, io.EOF, err)
+	case <-time.After(time.Second):
+		t.Fatal("receiver did not stop")
+	}
+}
+
+func TestYamuxConnectionChannelIsolation(t *testing.T) {
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 1, RecvMessageCapacity: 1 << 20},
+		{ID: 0x02, Priority: 1, RecvMessageCapacity: 1024},
+	}
+	var (
+		unblock  = make(chan struct{})
+		received = make(chan byte, 10)
+	)
+	onReceive := func(chID byte, _ []byte) {
+		if chID == 0x01 {
+			// the reactor of the channel is stuck
+			<-unblock
+		}
+		received <- chID
+	}
+	sender, _ := startYamuxConnections(t, chDescs, onReceive, func(any) {}, DefaultMConnConfig())
+	t.Cleanup(func() { close(unblock) })
+
+	// more than the stream window of the first channel is sent, so that its
+	// stream is held back
+	large := bytes.Repeat([]byte{0xff}, 512*1024)
+	for i := 0; i < 2; i++ {
+		assert.True(t, sender.Send(0x01, large))
+	}
+	assert.True(t, sender.Send(0x02, []byte("not held back")))
+	select {
+	case chID := <-received:
+		assert.EqualValues(t, 0x02, chID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message held back by another channel")
+	}
+}
+
+func TestYamuxConnectionCompression(t *testing.T) {
+	chDescs := []*ChannelDescriptor{{ID: 0x01, Priority: 1, RecvMessageCapacity: 1024, Compression: CompressionZstd}}
+	config := DefaultMConnConfig()
+	config.Compression = true
+	recv := &yamuxReceived{msgs: make(map[byte][]string)}
+	sender, _ := startYamuxConnections(t, chDescs, recv.onReceive, func(any) {}, config)
+
+	msg := string(bytes.Repeat([]byte("compressible "), 50))
+	assert.True(t, sender.Send(0x01, []byte(msg)))
+	require.Eventually(t, func() bool {
+		return len(recv.get(0x01)) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, msg, recv.get(0x01)[0])
+	assert.Less(t, sender.Status().SendMonitor.Bytes, int64(len(msg)))
+}
+
+func TestYamuxConnectionRecvMessageCapacity(t *testing.T) {
+	chDescs := []*ChannelDescriptor{{ID: 0x01, Priority: 1, RecvMessageCapacity: 8}}
+	errors := make(chan any, 1)
+	sender, _ := startYamuxConnections(t, chDescs, func(byte, []byte) {}, func(r any) { errors <- r }, DefaultMConnConfig())
+
+	assert.True(t, sender.Send(0x01, []byte("too large message")))
+	select {
+	case err := <-errors:
+		assert.ErrorContains(t, err.(error), "exceeds the capacity")
+	case <-time.After(time.Second):
+		t.Fatal("receiver did not stop")
+	}
+}
+
+func TestYamuxConnectionSecondStream(t *testing.T) {
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 1, RecvMessageCapacity: 1024},
+		{ID: 0x02, Priority: 1, RecvMessageCapacity: 1024},
+	}
+	client, server := net.Pipe()
+	errors := make(chan any, 1)
+	msgs := make(chan []byte, 2)
+	receiver := NewYamuxConnection(server, false, chDescs, func(_ byte, msg []byte) { msgs <- msg },
+		func(r any) { errors <- r }, DefaultMConnConfig())
+	receiver.SetLogger(log.TestingLogger())
+	require.NoError(t, receiver.Start())
+	t.Cleanup(func() { _ = receiver.Stop() })
+
+	yConfig := yamux.DefaultConfig()
+	yConfig.LogOutput = io.Discard
+	session, err := yamux.Client(client, yConfig)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+
+	// the peer opens two streams for the channel
+	st, err := session.OpenStream()
+	require.NoError(t, err)
+	_, err = st.Write([]byte{0x01, 1, 'a'})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("a"), <-msgs)
+	st, err = session.OpenStream()
+	require.NoError(t, err)
+	// the receiver may close the session as soon as the stream is accepted
+	_, _ = st.Write([]byte{0x01, 1, 'a'})
+	select {
+	case err := <-errors:
+		assert.ErrorContains(t, err.(error), "second stream")
+	case <-time.After(time.Second):
+		t.Fatal("receiver did not stop")
+	}
+	assert.Empty(t, msgs)
+}
+
+func TestYamuxConnectionChannelRecvLimits(t *testing.T) {
+	chDescs := []*ChannelDescriptor{{ID: 0x01, Priority: 1, RecvMessageCapacity: 1024}}
+	mconnConfig := DefaultMConnConfig()
+	mconnConfig.ChannelRecvLimits = map[byte]config.ChannelRecvLimit{0x01: {MsgsPerSecond: 1}}
+	errors := make(chan any, 1)
+	sender, _ := startYamuxConnections(t, chDescs, func(byte, []byte) {}, func(r any) { errors <- r }, mconnConfig)
+
+	assert.True(t, sender.Send(0x01, []byte("a")))
+	assert.True(t, sender.Send(0x01, []byte("b")))
+	select {
+	case err := <-errors:
+		assert.ErrorIs(t, err.(error), ErrChannelRecvRateExceeded)
+	case <-time.After(time.Second):
+		t.Fatal("receiver did not stop")
+	}
+}
@@ -0,0 +1,138 @@
+package p2p
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// connThrottlePruneInterval is the interval at which the buckets of the
+// sources which can burst again, and the expired bans, are forgotten.
+const connThrottlePruneInterval = time.Minute
+
+// connBucket holds the connection attempts a source can still make.
+type connBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// connLimit limits the connection attempts of each source to rate per second,
+// allowing bursts of up to burst attempts. It is disabled if rate is 0.
+type connLimit struct {
+	rate    float64
+	burst   int
+	buckets map[string]*connBucket
+}
+
+// take takes a token from the bucket of source, returning false if empty.
+func (l *connLimit) take(source string, now time.Time) bool {
+	if l.rate <= 0 {
+		return true
+	}
+	b, ok := l.buckets[source]
+	if !ok {
+		b = &connBucket{tokens: float64(l.burst), last: now}
+		l.buckets[source] = b
+	}
+	if l.refill(b, now) < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// refill adds the tokens accrued since the last attempt to b, up to the
+// burst, and returns them.
+func (l *connLimit) refill(b *connBucket, now time.Time) float64 {
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > float64(l.burst) {
+		b.tokens = float64(l.burst)
+	}
+	b.last = now
+	return b.tokens
+}
+
+func (l *connLimit) prune(now time.Time) {
+	for source, b := range l.buckets {
+		if l.refill(b, now) >= float64(l.burst) {
+			delete(l.buckets, source)
+		}
+	}
+}
+
+// ConnThrottle limits the rate of the inbound connection attempts from each
+// IP and from each /24 IPv4 or /48 IPv6 subnet. A source exceeding its rate
+// is banned for the ban duration, during which all its attempts are rejected.
+type ConnThrottle struct {
+	banDuration time.Duration
+
+	mtx       cmtsync.Mutex
+	ip        connLimit
+	subnet    connLimit
+	bans      map[string]time.Time // until when each source is banned
+	lastPrune time.Time
+}
+
+// NewConnThrottle returns a ConnThrottle limiting the attempts of each IP to
+// ipRate per second with bursts of up to ipBurst, and of each subnet to
+// subnetRate per second with bursts of up to subnetBurst. A rate of 0
+// disables the corresponding limit, and a ban duration of 0 disables the bans.
+func NewConnThrottle(
+	ipRate float64,
+	ipBurst int,
+	subnetRate float64,
+	subnetBurst int,
+	banDuration time.Duration,
+) *ConnThrottle {
+	return &ConnThrottle{
+		banDuration: banDuration,
+		ip:          connLimit{rate: ipRate, burst: ipBurst, buckets: make(map[string]*connBucket)},
+		subnet:      connLimit{rate: subnetRate, burst: subnetBurst, buckets: make(map[string]*connBucket)},
+		bans:        make(map[string]time.Time),
+		lastPrune:   time.Now(),
+	}
+}
+
+// Allow records a connection attempt from ip at now, and returns an error if
+// it is rejected.
+func (ct *ConnThrottle) Allow(ip net.IP, now time.Time) error {
+	ct.mtx.Lock()
+	defer ct.mtx.Unlock()
+
+	if now.Sub(ct.lastPrune) >= connThrottlePruneInterval {
+		ct.ip.prune(now)
+		ct.subnet.prune(now)
+		for source, until := range ct.bans {
+			if !now.Before(until) {
+				delete(ct.bans, source)
+			}
+		}
+		ct.lastPrune = now
+	}
+
+	ipSource, subnetSource := ip.String(), peerSubnet(ip).String()
+	for _, source := range []string{ipSource, subnetSource} {
+		if until, ok := ct.bans[source]; ok && now.Before(until) {
+			return fmt.Errorf("%v is banned until %v", source, until)
+		}
+	}
+
+	if !ct.ip.take(ipSource, now) {
+		return ct.ban(ipSource, now)
+	}
+	if !ct.subnet.take(subnetSource, now) {
+		return ct.ban(subnetSource, now)
+	}
+	return nil
+}
+
+func (ct *ConnThrottle) ban(source string, now time.Time) error {
+	if ct.banDuration <= 0 {
+		return fmt.Errorf("%v exceeded the inbound connection rate", source)
+	}
+	until := now.Add(ct.banDuration)
+	ct.bans[source] = until
+	return fmt.Errorf("%v exceeded the inbound connection rate; banned until %v", source, until)
+}
@@ -0,0 +1,52 @@
+package p2p
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnThrottle(t *testing.T) {
+	var (
+		now = time.Now()
+		ip1 = net.ParseIP("192.0.2.1")
+		ip2 = net.ParseIP("192.0.2.2")
+		ip3 = net.ParseIP("198.51.100.1")
+	)
+	ct := NewConnThrottle(1, 2, 1, 3, time.Minute)
+
+	// bursts are allowed
+	assert.NoError(t, ct.Allow(ip1, now))
+	assert.NoError(t, ct.Allow(ip1, now))
+
+	// exceeding the rate of the IP bans it
+	assert.Error(t, ct.Allow(ip1, now))
+	assert.Error(t, ct.Allow(ip1, now.Add(30*time.Second)))
+
+	// another IP of the subnet exceeds the rate of the subnet, banning it
+	assert.NoError(t, ct.Allow(ip2, now))
+	assert.Error(t, ct.Allow(ip2, now))
+	assert.Error(t, ct.Allow(ip2, now.Add(30*time.Second)))
+
+	// other subnets are not throttled
+	assert.NoError(t, ct.Allow(ip3, now))
+
+	// the bans expire
+	assert.NoError(t, ct.Allow(ip1, now.Add(2*time.Minute)))
+	assert.NoError(t, ct.Allow(ip2, now.Add(2*time.Minute)))
+}
+
+func TestConnThrottleNoBan(t *testing.T) {
+	var (
+		now = time.Now()
+		ip  = net.ParseIP("2001:db8::1")
+	)
+	ct := NewConnThrottle(1, 1, 0, 0, 0)
+
+	assert.NoError(t, ct.Allow(ip, now))
+	assert.Error(t, ct.Allow(ip, now))
+	// the IP can connect again once a token accrued
+	assert.NoError(t, ct.Allow(ip, now.Add(time.Second)))
+}
@@ -189,3 +189,45 @@ type ErrCurrentlyDialingOrExistingAddress struct {
 func (e ErrCurrentlyDialingOrExistingAddress) Error() string {
 	return fmt.Sprintf("connection with %s has been established or dialed", e.Addr)
 }
+
+// ErrPeerPinMismatch indicates that a persistent peer address is pinned to a
+// different node ID, i.e. the peer's node key changed.
+type ErrPeerPinMismatch struct {
+	Addr     *NetAddress
+	PinnedID ID
+}
+
+func (e ErrPeerPinMismatch) Error() string {
+	return fmt.Sprintf(
+		"address %s is pinned to peer %s, not %s; remove the pin to accept the new identity",
+		e.Addr.DialString(), e.PinnedID, e.Addr.ID,
+	)
+}
+
+// ErrPeerDiversity indicates that a peer is refused because too many peers
+// already share its subnet or autonomous system.
+type ErrPeerDiversity struct {
+	IP     net.IP
+	Subnet *net.IPNet // set if the subnet limit is reached
+	ASN    uint32     // set if the ASN limit is reached
+	Count  int
+}
+
+func (e ErrPeerDiversity) Error() string {
+	if e.Subnet != nil {
+		return fmt.Sprintf("already have %d peers in subnet %v of %v", e.Count, e.Subnet, e.IP)
+	}
+	return fmt.Sprintf("already have %d peers in AS%d of %v", e.Count, e.ASN, e.IP)
+}
+
+// ErrPeerScoreTooLow indicates that a peer is refused or disconnected because
+// it scores below the minimum, or below a peer it is evicted for.
+type ErrPeerScoreTooLow struct {
+	ID    ID
+	Score float64
+	Min   float64
+}
+
+func (e ErrPeerScoreTooLow) Error() string {
+	return fmt.Sprintf("peer %s scores %.2f, below %.2f", e.ID, e.Score, e.Min)
+}
@@ -56,6 +56,36 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "message_send_bytes_total",
 			Help:      "Number of bytes of each message type sent.",
 		}, append(labels, "message_type", "chID", "peer_id")).With(labelsAndValues...),
+		PeerPinMismatches: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_pin_mismatches",
+			Help:      "Number of dials to persistent peers refused because their address is pinned to a different node ID.",
+		}, labels).With(labelsAndValues...),
+		PeerDiversityRejections: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_diversity_rejections",
+			Help:      "Number of peers refused because too many peers share their subnet or autonomous system.",
+		}, labels).With(labelsAndValues...),
+		PeerScoreRejections: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_score_rejections",
+			Help:      "Number of peers disconnected, or not dialed or accepted, because of their low score.",
+		}, labels).With(labelsAndValues...),
+		ClockOffsetSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "clock_offset_seconds",
+			Help:      "Estimated offset of the local clock relative to the clocks of the peers, in seconds. Positive if the peers are ahead.",
+		}, labels).With(labelsAndValues...),
+		ClockSkewed: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "clock_skewed",
+			Help:      "Whether the local clock is skewed by more than the configured threshold (1) or not (0).",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -68,5 +98,10 @@ func NopMetrics() *Metrics {
 		NumTxs:                   discard.NewGauge(),
 		MessageReceiveBytesTotal: discard.NewCounter(),
 		MessageSendBytesTotal:    discard.NewCounter(),
+		PeerPinMismatches:        discard.NewCounter(),
+		PeerDiversityRejections:  discard.NewCounter(),
+		PeerScoreRejections:      discard.NewCounter(),
+		ClockOffsetSeconds:       discard.NewGauge(),
+		ClockSkewed:              discard.NewGauge(),
 	}
 }
@@ -40,6 +40,21 @@ type Metrics struct {
 	MessageReceiveBytesTotal metrics.Counter `metrics_labels:"message_type,chID,peer_id"`
 	// Number of bytes of each message type sent.
 	MessageSendBytesTotal metrics.Counter `metrics_labels:"message_type,chID,peer_id"`
+	// Number of dials to persistent peers refused because their address is
+	// pinned to a different node ID.
+	PeerPinMismatches metrics.Counter
+	// Number of peers refused because too many peers share their subnet or
+	// autonomous system.
+	PeerDiversityRejections metrics.Counter
+	// Number of peers disconnected, or not dialed or accepted, because of
+	// their low score.
+	PeerScoreRejections metrics.Counter
+	// Estimated offset of the local clock relative to the clocks of the
+	// peers, in seconds. Positive if the peers are ahead.
+	ClockOffsetSeconds metrics.Gauge
+	// Whether the local clock is skewed by more than the configured threshold
+	// (1) or not (0).
+	ClockSkewed metrics.Gauge
 }
 
 type metricsLabelCache struct {
@@ -7,6 +7,7 @@ import (
 	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/conn"
+	"github.com/cometbft/cometbft/p2p/peerdata"
 )
 
 type Peer struct {
@@ -14,7 +15,7 @@ type Peer struct {
 	ip                   net.IP
 	id                   p2p.ID
 	addr                 *p2p.NetAddress
-	kv                   map[string]interface{}
+	data                 *peerdata.Store
 	Outbound, Persistent bool
 }
 
@@ -33,7 +34,7 @@ func NewPeer(ip net.IP) *Peer {
 		ip:   ip,
 		id:   nodeKey.ID(),
 		addr: netAddr,
-		kv:   make(map[string]interface{}),
+		data: peerdata.NewStore(),
 	}
 	mp.BaseService = service.NewBaseService(nil, "MockPeer", mp)
 	if err := mp.Start(); err != nil {
@@ -55,20 +56,14 @@ func (mp *Peer) Status() conn.ConnectionStatus { return conn.ConnectionStatus{}
 func (mp *Peer) ID() p2p.ID                    { return mp.id }
 func (mp *Peer) IsOutbound() bool              { return mp.Outbound }
 func (mp *Peer) IsPersistent() bool            { return mp.Persistent }
-func (mp *Peer) Get(key string) interface{} {
-	if value, ok := mp.kv[key]; ok {
-		return value
-	}
-	return nil
-}
+func (mp *Peer) Data() *peerdata.Store         { return mp.data }
+func (mp *Peer) RemoteIP() net.IP              { return mp.ip }
+func (mp *Peer) SocketAddr() *p2p.NetAddress   { return mp.addr }
+func (mp *Peer) RemoteAddr() net.Addr          { return &net.TCPAddr{IP: mp.ip, Port: 8800} }
+func (mp *Peer) CloseConn() error              { return nil }
+func (mp *Peer) SetRemovalFailed()             {}
+func (mp *Peer) GetRemovalFailed() bool        { return false }
+func (*Peer) HasIPChanged() bool               { return false }
 
-func (mp *Peer) Set(key string, value interface{}) {
-	mp.kv[key] = value
-}
-func (mp *Peer) RemoteIP() net.IP            { return mp.ip }
-func (mp *Peer) SocketAddr() *p2p.NetAddress { return mp.addr }
-func (mp *Peer) RemoteAddr() net.Addr        { return &net.TCPAddr{IP: mp.ip, Port: 8800} }
-func (mp *Peer) CloseConn() error            { return nil }
-func (mp *Peer) SetRemovalFailed()           {}
-func (mp *Peer) GetRemovalFailed() bool      { return false }
-func (*Peer) HasIPChanged() bool             { return false }
+func (mp *Peer) RegisterChannel(*conn.ChannelDescriptor, p2p.Reactor) error { return nil }
+func (mp *Peer) UnregisterChannel(byte) error                               { return nil }
@@ -11,6 +11,8 @@ import (
 	net "net"
 
 	p2p "github.com/cometbft/cometbft/p2p"
+
+	peerdata "github.com/cometbft/cometbft/p2p/peerdata"
 )
 
 // Peer is an autogenerated mock type for the Peer type
@@ -36,31 +38,31 @@ func (_m *Peer) CloseConn() error {
 	return r0
 }
 
-// FlushStop provides a mock function with no fields
-func (_m *Peer) FlushStop() {
-	_m.Called()
-}
-
-// Get provides a mock function with given fields: _a0
-func (_m *Peer) Get(_a0 string) interface{} {
-	ret := _m.Called(_a0)
+// Data provides a mock function with no fields
+func (_m *Peer) Data() *peerdata.Store {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for Data")
 	}
 
-	var r0 interface{}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(_a0)
+	var r0 *peerdata.Store
+	if rf, ok := ret.Get(0).(func() *peerdata.Store); ok {
+		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*peerdata.Store)
 		}
 	}
 
 	return r0
 }
 
+// FlushStop provides a mock function with no fields
+func (_m *Peer) FlushStop() {
+	_m.Called()
+}
+
 // GetRemovalFailed provides a mock function with no fields
 func (_m *Peer) GetRemovalFailed() bool {
 	ret := _m.Called()
@@ -250,6 +252,24 @@ func (_m *Peer) Quit() <-chan struct{} {
 	return r0
 }
 
+// RegisterChannel provides a mock function with given fields: _a0, _a1
+func (_m *Peer) RegisterChannel(_a0 *conn.ChannelDescriptor, _a1 p2p.Reactor) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterChannel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*conn.ChannelDescriptor, p2p.Reactor) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoteAddr provides a mock function with no fields
 func (_m *Peer) RemoteAddr() net.Addr {
 	ret := _m.Called()
@@ -326,11 +346,6 @@ func (_m *Peer) Send(_a0 p2p.Envelope) bool {
 	return r0
 }
 
-// Set provides a mock function with given fields: _a0, _a1
-func (_m *Peer) Set(_a0 string, _a1 interface{}) {
-	_m.Called(_a0, _a1)
-}
-
 // SetLogger provides a mock function with given fields: _a0
 func (_m *Peer) SetLogger(_a0 log.Logger) {
 	_m.Called(_a0)
@@ -451,6 +466,24 @@ func (_m *Peer) TrySend(_a0 p2p.Envelope) bool {
 	return r0
 }
 
+// UnregisterChannel provides a mock function with given fields: chID
+func (_m *Peer) UnregisterChannel(chID byte) error {
+	ret := _m.Called(chID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnregisterChannel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(byte) error); ok {
+		r0 = rf(chID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewPeer creates a new instance of Peer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPeer(t interface {
@@ -5,6 +5,7 @@
 package p2p
 
 import (
+	"encoding/base32"
 	"encoding/hex"
 	"errors"
 	"flag"
@@ -26,6 +27,9 @@ type NetAddress struct {
 	ID   ID     `json:"id"`
 	IP   net.IP `json:"ip"`
 	Port uint16 `json:"port"`
+	// Onion is the hostname of the Tor onion service of the peer, reachable
+	// only through a proxy. IP is nil if it is set.
+	Onion string `json:"onion,omitempty"`
 }
 
 // IDAddressString returns id@hostPort. It strips the leading
@@ -35,16 +39,24 @@ func IDAddressString(id ID, protocolHostPort string) string {
 	return fmt.Sprintf("%s@%s", id, hostPort)
 }
 
-// NewNetAddress returns a new NetAddress using the provided TCP
-// address. When testing, other net.Addr (except TCP) will result in
-// using 0.0.0.0:0. When normal run, other net.Addr (except TCP) will
+// NewNetAddress returns a new NetAddress using the provided TCP or UDP
+// address. When testing, other net.Addr (except TCP and UDP) will result in
+// using 0.0.0.0:0. When normal run, other net.Addr (except TCP and UDP) will
 // panic. Panics if ID is invalid.
 // TODO: socks proxies?
 func NewNetAddress(id ID, addr net.Addr) *NetAddress {
-	tcpAddr, ok := addr.(*net.TCPAddr)
-	if !ok {
+	var (
+		ip   net.IP
+		port int
+	)
+	switch addr := addr.(type) {
+	case *net.TCPAddr:
+		ip, port = addr.IP, addr.Port
+	case *net.UDPAddr: // QUIC connections
+		ip, port = addr.IP, addr.Port
+	default:
 		if flag.Lookup("test.v") == nil { // normal run
-			panic(fmt.Sprintf("Only TCPAddrs are supported. Got: %v", addr))
+			panic(fmt.Sprintf("Only TCPAddrs and UDPAddrs are supported. Got: %v", addr))
 		}
 		// in testing
 		netAddr := NewNetAddressIPPort(net.IP("127.0.0.1"), 0)
@@ -56,16 +68,14 @@ func NewNetAddress(id ID, addr net.Addr) *NetAddress {
 		panic(fmt.Sprintf("Invalid ID %v: %v (addr: %v)", id, err, addr))
 	}
 
-	ip := tcpAddr.IP
-	port := uint16(tcpAddr.Port)
-	na := NewNetAddressIPPort(ip, port)
+	na := NewNetAddressIPPort(ip, uint16(port))
 	na.ID = id
 	return na
 }
 
 // NewNetAddressString returns a new NetAddress using the provided address in
 // the form of "ID@IP:Port".
-// Also resolves the host if host is not an IP.
+// Also resolves the host if host is not an IP nor an onion address.
 // Errors are of type ErrNetAddressXxx where Xxx is in (NoID, Invalid, Lookup)
 func NewNetAddressString(addr string) (*NetAddress, error) {
 	addrWithoutProtocol := removeProtocolIfDefined(addr)
@@ -92,6 +102,19 @@ func NewNetAddressString(addr string) (*NetAddress, error) {
 			errors.New("host is empty")}
 	}
 
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, ErrNetAddressInvalid{portStr, err}
+	}
+
+	if onion := strings.ToLower(host); isOnion(onion) {
+		// onion addresses must not be resolved, which would leak them
+		if err := validateOnion(onion); err != nil {
+			return nil, ErrNetAddressInvalid{addrWithoutProtocol, err}
+		}
+		return &NetAddress{ID: id, Port: uint16(port), Onion: onion}, nil
+	}
+
 	ip := net.ParseIP(host)
 	if ip == nil {
 		ips, err := net.LookupIP(host)
@@ -101,11 +124,6 @@ func NewNetAddressString(addr string) (*NetAddress, error) {
 		ip = ips[0]
 	}
 
-	port, err := strconv.ParseUint(portStr, 10, 16)
-	if err != nil {
-		return nil, ErrNetAddressInvalid{portStr, err}
-	}
-
 	na := NewNetAddressIPPort(ip, uint16(port))
 	na.ID = id
 	return na, nil
@@ -137,14 +155,25 @@ func NewNetAddressIPPort(ip net.IP, port uint16) *NetAddress {
 }
 
 // NetAddressFromProto converts a Protobuf NetAddress into a native struct.
+// The IP of onion addresses holds their hostname.
 func NetAddressFromProto(pb tmp2p.NetAddress) (*NetAddress, error) {
+	if pb.Port >= 1<<16 {
+		return nil, fmt.Errorf("invalid port number %v", pb.Port)
+	}
+	if isOnion(pb.IP) {
+		if err := validateOnion(pb.IP); err != nil {
+			return nil, err
+		}
+		return &NetAddress{
+			ID:    ID(pb.ID),
+			Port:  uint16(pb.Port),
+			Onion: pb.IP,
+		}, nil
+	}
 	ip := net.ParseIP(pb.IP)
 	if ip == nil {
 		return nil, fmt.Errorf("invalid IP address %v", pb.IP)
 	}
-	if pb.Port >= 1<<16 {
-		return nil, fmt.Errorf("invalid port number %v", pb.Port)
-	}
 	return &NetAddress{
 		ID:   ID(pb.ID),
 		IP:   ip,
@@ -180,7 +209,7 @@ func NetAddressesToProto(nas []*NetAddress) []tmp2p.NetAddress {
 func (na *NetAddress) ToProto() tmp2p.NetAddress {
 	return tmp2p.NetAddress{
 		ID:   string(na.ID),
-		IP:   na.IP.String(),
+		IP:   na.host(),
 		Port: uint32(na.Port),
 	}
 }
@@ -226,11 +255,19 @@ func (na *NetAddress) DialString() string {
 		return "<nil-NetAddress>"
 	}
 	return net.JoinHostPort(
-		na.IP.String(),
+		na.host(),
 		strconv.FormatUint(uint64(na.Port), 10),
 	)
 }
 
+// host returns the onion hostname of onion addresses, the IP otherwise.
+func (na *NetAddress) host() string {
+	if na.IsOnion() {
+		return na.Onion
+	}
+	return na.IP.String()
+}
+
 // Dial calls net.Dial on the address.
 func (na *NetAddress) Dial() (net.Conn, error) {
 	conn, err := net.Dial("tcp", na.DialString())
@@ -266,6 +303,9 @@ func (na *NetAddress) Valid() error {
 		return fmt.Errorf("invalid ID: %w", err)
 	}
 
+	if na.IsOnion() {
+		return validateOnion(na.Onion)
+	}
 	if na.IP == nil {
 		return errors.New("no IP")
 	}
@@ -397,6 +437,35 @@ func (na *NetAddress) RFC6052() bool     { return rfc6052.Contains(na.IP) }
 func (na *NetAddress) RFC6145() bool     { return rfc6145.Contains(na.IP) }
 func (na *NetAddress) OnionCatTor() bool { return onionCatNet.Contains(na.IP) }
 
+// IsOnion returns true if the address is a Tor onion service address.
+func (na *NetAddress) IsOnion() bool { return na.Onion != "" }
+
+// onionV3Len is the length of the hostname of v3 onion services, without the
+// ".onion" suffix: the base32 encoding of their 32 byte public key, a 2 byte
+// checksum and the version byte.
+const onionV3Len = 56
+
+func isOnion(host string) bool {
+	return strings.HasSuffix(host, ".onion")
+}
+
+// validateOnion returns an error if host is not the hostname of a v3 onion
+// service.
+func validateOnion(host string) error {
+	name := strings.TrimSuffix(host, ".onion")
+	if len(name) != onionV3Len {
+		return fmt.Errorf("onion address %v is not a v3 onion address", host)
+	}
+	decoded, err := base32.StdEncoding.DecodeString(strings.ToUpper(name))
+	if err != nil {
+		return fmt.Errorf("invalid onion address %v: %w", host, err)
+	}
+	if version := decoded[len(decoded)-1]; version != 3 {
+		return fmt.Errorf("onion address %v has version %d, expected 3", host, version)
+	}
+	return nil
+}
+
 func removeProtocolIfDefined(addr string) string {
 	if strings.Contains(addr, "://") {
 		return strings.Split(addr, "://")[1]
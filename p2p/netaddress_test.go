@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"net"
+	"strings"
 	"sync"
 	"testing"
 
@@ -42,9 +43,17 @@ func TestNewNetAddress(t *testing.T) {
 	addr := NewNetAddress("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", tcpAddr)
 	assert.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", addr.String())
 
+	// UDPAddrs are the addresses of QUIC connections
+	udpAddr := &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8000}
+	assert.Panics(t, func() {
+		NewNetAddress("", udpAddr)
+	})
+	addr = NewNetAddress("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", udpAddr)
+	assert.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8000", addr.String())
+
 	assert.NotPanics(t, func() {
-		NewNetAddress("", &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8000})
-	}, "Calling NewNetAddress with UDPAddr should not panic in testing")
+		NewNetAddress("", &net.UnixAddr{Name: "cometbft.sock", Net: "unix"})
+	}, "Calling NewNetAddress with UnixAddr should not panic in testing")
 }
 
 func TestNewNetAddressString(t *testing.T) {
@@ -124,6 +133,37 @@ func TestNewNetAddressString(t *testing.T) {
 	}
 }
 
+func TestOnionNetAddress(t *testing.T) {
+	const onion = "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion"
+
+	addr, err := NewNetAddressString("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@" + strings.ToUpper(onion) + ":26656")
+	require.NoError(t, err)
+	assert.True(t, addr.IsOnion())
+	assert.Nil(t, addr.IP)
+	assert.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@"+onion+":26656", addr.String())
+	assert.NoError(t, addr.Valid())
+	assert.True(t, addr.Routable())
+	assert.False(t, addr.Local())
+
+	pb := addr.ToProto()
+	assert.Equal(t, onion, pb.IP)
+	decoded, err := NetAddressFromProto(pb)
+	require.NoError(t, err)
+	assert.Equal(t, addr, decoded)
+
+	for _, invalid := range []string{
+		"3g2upl4pq6kufc4m.onion", // v2
+		"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczaa.onion", // version 0
+		"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzcza1.onion", // not base32
+	} {
+		_, err := NewNetAddressString("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@" + invalid + ":26656")
+		assert.Error(t, err, invalid)
+		pb.IP = invalid
+		_, err = NetAddressFromProto(pb)
+		assert.Error(t, err, invalid)
+	}
+}
+
 func TestNewNetAddressStrings(t *testing.T) {
 	addrs, errs := NewNetAddressStrings([]string{
 		"127.0.0.1:8080",
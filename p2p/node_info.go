@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/cometbft/cometbft/config"
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtstrings "github.com/cometbft/cometbft/libs/strings"
 	tmp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
@@ -71,6 +72,32 @@ func NewProtocolVersion(p2p, block, app uint64) ProtocolVersion {
 
 //-------------------------------------------------------------
 
+// Features are bits of DefaultNodeInfo.Features, advertising the optional
+// protocol features supported by a node. A feature is used on a connection
+// only if both peers advertise it.
+const (
+	// FeaturePacketSequence numbers the packets sent over the connection so
+	// that replayed and reordered packets are rejected, independently of the
+	// ordering provided by the secret connection.
+	FeaturePacketSequence uint64 = 1 << iota
+	// FeatureCompression compresses the messages of the channels that
+	// select a compression in their descriptor.
+	FeatureCompression
+	// FeatureChannelRegistration allows registering and unregistering
+	// channels on established connections, e.g. for reactors added after
+	// the node started.
+	FeatureChannelRegistration
+	// FeatureYamux multiplexes the channels over the streams of a yamux
+	// session, each channel being flow controlled independently, instead of
+	// over the single framed stream of an MConnection.
+	FeatureYamux
+	// FeatureSeedStatus allows seeds to send their latest height and header
+	// to the peers connecting to them.
+	FeatureSeedStatus
+)
+
+//-------------------------------------------------------------
+
 // Assert DefaultNodeInfo satisfies NodeInfo
 var _ NodeInfo = DefaultNodeInfo{}
 
@@ -93,6 +120,12 @@ type DefaultNodeInfo struct {
 	// ASCIIText fields
 	Moniker string               `json:"moniker"` // arbitrary moniker
 	Other   DefaultNodeInfoOther `json:"other"`   // other application specific data
+
+	Features uint64 `json:"features"` // bitset of the optional features supported
+
+	// maximum payload size of the packets, negotiated with the peers. 0 if
+	// not advertised.
+	MaxPacketMsgPayloadSize int `json:"max_packet_msg_payload_size"`
 }
 
 // DefaultNodeInfoOther is the misc. applcation specific data
@@ -129,7 +162,11 @@ func (info DefaultNodeInfo) Validate() error {
 		return err
 	}
 
-	// Network is validated in CompatibleWith.
+	// Network must be set so that peers of different chains never connect.
+	// It is matched against ours in CompatibleWith.
+	if !cmtstrings.IsASCIIText(info.Network) || cmtstrings.ASCIITrim(info.Network) == "" {
+		return fmt.Errorf("info.Network must be valid ASCII text without tabs, but got %q", info.Network)
+	}
 
 	// Validate Version
 	if len(info.Version) > 0 &&
@@ -170,6 +207,12 @@ func (info DefaultNodeInfo) Validate() error {
 		return fmt.Errorf("info.Other.RPCAddress=%v must be valid ASCII text without tabs", rpcAddr)
 	}
 
+	// 0 if not advertised
+	if info.MaxPacketMsgPayloadSize != 0 && info.MaxPacketMsgPayloadSize < config.MinMaxPacketMsgPayloadSize {
+		return fmt.Errorf("info.MaxPacketMsgPayloadSize can't be less than %d, got %v",
+			config.MinMaxPacketMsgPayloadSize, info.MaxPacketMsgPayloadSize)
+	}
+
 	return nil
 }
 
@@ -227,6 +270,11 @@ func (info DefaultNodeInfo) HasChannel(chID byte) bool {
 	return bytes.Contains(info.Channels, []byte{chID})
 }
 
+// HasFeature returns true if the node advertises the given feature.
+func (info DefaultNodeInfo) HasFeature(feature uint64) bool {
+	return info.Features&feature != 0
+}
+
 func (info DefaultNodeInfo) ToProto() *tmp2p.DefaultNodeInfo {
 
 	dni := new(tmp2p.DefaultNodeInfo)
@@ -246,6 +294,8 @@ func (info DefaultNodeInfo) ToProto() *tmp2p.DefaultNodeInfo {
 		TxIndex:    info.Other.TxIndex,
 		RPCAddress: info.Other.RPCAddress,
 	}
+	dni.Features = info.Features
+	dni.MaxPacketMsgPayloadSize = int64(info.MaxPacketMsgPayloadSize)
 
 	return dni
 }
@@ -270,6 +320,8 @@ func DefaultNodeInfoFromToProto(pb *tmp2p.DefaultNodeInfo) (DefaultNodeInfo, err
 			TxIndex:    pb.Other.TxIndex,
 			RPCAddress: pb.Other.RPCAddress,
 		},
+		Features:                pb.Features,
+		MaxPacketMsgPayloadSize: int(pb.MaxPacketMsgPayloadSize),
 	}
 
 	return dni, nil
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/cometbft/cometbft/crypto/ed25519"
 )
@@ -42,6 +43,10 @@ func TestNodeInfoValidate(t *testing.T) {
 		{"Invalid NetAddress", func(ni *DefaultNodeInfo) { ni.ListenAddr = "not-an-address" }, true},
 		{"Good NetAddress", func(ni *DefaultNodeInfo) { ni.ListenAddr = "0.0.0.0:26656" }, false},
 
+		{"Non-ASCII Network", func(ni *DefaultNodeInfo) { ni.Network = nonASCII }, true},
+		{"Empty space Network", func(ni *DefaultNodeInfo) { ni.Network = emptySpace }, true},
+		{"Empty Network", func(ni *DefaultNodeInfo) { ni.Network = "" }, true},
+
 		{"Non-ASCII Version", func(ni *DefaultNodeInfo) { ni.Version = nonASCII }, true},
 		{"Empty tab Version", func(ni *DefaultNodeInfo) { ni.Version = emptyTab }, true},
 		{"Empty space Version", func(ni *DefaultNodeInfo) { ni.Version = emptySpace }, true},
@@ -64,6 +69,11 @@ func TestNodeInfoValidate(t *testing.T) {
 		{"Empty space RPCAddress", func(ni *DefaultNodeInfo) { ni.Other.RPCAddress = emptySpace }, true},
 		{"Empty RPCAddress", func(ni *DefaultNodeInfo) { ni.Other.RPCAddress = "" }, false},
 		{"Good RPCAddress", func(ni *DefaultNodeInfo) { ni.Other.RPCAddress = "0.0.0.0:26657" }, false},
+
+		{"Negative MaxPacketMsgPayloadSize", func(ni *DefaultNodeInfo) { ni.MaxPacketMsgPayloadSize = -1 }, true},
+		{"Too small MaxPacketMsgPayloadSize", func(ni *DefaultNodeInfo) { ni.MaxPacketMsgPayloadSize = 1 }, true},
+		{"No MaxPacketMsgPayloadSize", func(ni *DefaultNodeInfo) { ni.MaxPacketMsgPayloadSize = 0 }, false},
+		{"Good MaxPacketMsgPayloadSize", func(ni *DefaultNodeInfo) { ni.MaxPacketMsgPayloadSize = 1024 }, false},
 	}
 
 	nodeKey := NodeKey{PrivKey: ed25519.GenPrivKey()}
@@ -126,3 +136,33 @@ func TestNodeInfoCompatible(t *testing.T) {
 		assert.Error(t, ni1.CompatibleWith(ni))
 	}
 }
+
+func TestNodeInfoFeatures(t *testing.T) {
+	nodeKey1 := NodeKey{PrivKey: ed25519.GenPrivKey()}
+	nodeKey2 := NodeKey{PrivKey: ed25519.GenPrivKey()}
+	ni1 := testNodeInfo(nodeKey1.ID(), "testing").(DefaultNodeInfo)
+	ni2 := testNodeInfo(nodeKey2.ID(), "testing").(DefaultNodeInfo)
+
+	ni1.Features = FeaturePacketSequence
+	ni1.MaxPacketMsgPayloadSize = 4096
+	assert.True(t, ni1.HasFeature(FeaturePacketSequence))
+	assert.False(t, ni2.HasFeature(FeaturePacketSequence))
+	assert.False(t, negotiated(ni1, ni2, FeaturePacketSequence))
+
+	ni2.Features = FeaturePacketSequence
+	assert.True(t, negotiated(ni1, ni2, FeaturePacketSequence))
+	assert.False(t, negotiated(ni1, ni2, FeatureCompression))
+
+	ni1.Features |= FeatureCompression
+	ni2.Features |= FeatureCompression
+	assert.True(t, negotiated(ni1, ni2, FeatureCompression))
+	assert.False(t, negotiated(ni1, ni2, FeatureChannelRegistration))
+	assert.False(t, negotiated(ni1, ni2, FeatureYamux))
+	assert.False(t, negotiated(ni1, ni2, FeatureSeedStatus))
+	// peers advertising a feature are still compatible with the others
+	assert.NoError(t, ni1.CompatibleWith(testNodeInfo(nodeKey2.ID(), "testing")))
+
+	ni, err := DefaultNodeInfoFromToProto(ni1.ToProto())
+	require.NoError(t, err)
+	assert.Equal(t, ni1, ni)
+}
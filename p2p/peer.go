@@ -4,17 +4,21 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"runtime/pprof"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 
-	"github.com/cometbft/cometbft/libs/cmap"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/protoio"
 	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/libs/trace"
 	"github.com/cometbft/cometbft/libs/trace/schema"
 
 	cmtconn "github.com/cometbft/cometbft/p2p/conn"
+	"github.com/cometbft/cometbft/p2p/peerdata"
+	"github.com/cometbft/cometbft/p2p/quic"
 )
 
 //go:generate ../scripts/mockery_generate.sh Peer
@@ -42,8 +46,16 @@ type Peer interface {
 	Send(Envelope) bool
 	TrySend(Envelope) bool
 
-	Set(string, interface{})
-	Get(string) interface{}
+	// RegisterChannel adds a channel, whose messages are received by
+	// reactor, to the established connection and informs the peer.
+	RegisterChannel(*cmtconn.ChannelDescriptor, Reactor) error
+	// UnregisterChannel removes a channel from the established connection
+	// and informs the peer.
+	UnregisterChannel(chID byte) error
+
+	// Data returns the metadata store of the peer, where reactors keep their
+	// per-peer state.
+	Data() *peerdata.Store
 
 	SetRemovalFailed()
 	GetRemovalFailed() bool
@@ -92,7 +104,8 @@ func (pc peerConn) ID() ID {
 	return PubKeyToID(pc.conn.(*cmtconn.SecretConnection).RemotePubKey())
 }
 
-// Return the IP from the connection RemoteAddr
+// Return the IP from the connection RemoteAddr. Onion peers, dialed through a
+// proxy, have no IP.
 func (pc peerConn) RemoteIP() net.IP {
 	if pc.ip != nil {
 		return pc.ip
@@ -102,6 +115,9 @@ func (pc peerConn) RemoteIP() net.IP {
 	if err != nil {
 		panic(err)
 	}
+	if isOnion(host) {
+		return nil
+	}
 
 	ips, err := net.LookupIP(host)
 	if err != nil {
@@ -113,6 +129,31 @@ func (pc peerConn) RemoteIP() net.IP {
 	return pc.ip
 }
 
+// multiplexConn multiplexes the channels of a peer over its connection: a
+// cmtconn.MConnection, a cmtconn.YamuxConnection for the peers negotiating
+// yamux, or a quic.MConnection for the peers connected over QUIC.
+type multiplexConn interface {
+	service.Service
+	FlushStop()
+	Send(chID byte, msgBytes []byte) bool
+	TrySend(chID byte, msgBytes []byte) bool
+	CanSend(chID byte) bool
+	Status() cmtconn.ConnectionStatus
+}
+
+// channelRegistrar is implemented by the multiplex connections on which
+// channels can be registered and unregistered once established.
+type channelRegistrar interface {
+	RegisterChannel(desc *cmtconn.ChannelDescriptor) error
+	UnregisterChannel(chID byte) error
+}
+
+// registeredChannel is a channel registered on the established connection.
+type registeredChannel struct {
+	desc    *cmtconn.ChannelDescriptor
+	reactor Reactor
+}
+
 // peer implements Peer.
 //
 // Before using a peer, you will need to perform a handshake on connection.
@@ -121,20 +162,27 @@ type peer struct {
 
 	// raw peerConn and the multiplex connection
 	peerConn
-	mconn *cmtconn.MConnection
+	mconn multiplexConn
 
 	// peer's node info and the channel it knows about
-	// channels = nodeInfo.Channels
+	// channels = nodeInfo.Channels, updated with the channels the peer
+	// registers or unregisters on the connection
 	// cached to avoid copying nodeInfo in hasChannel
-	nodeInfo NodeInfo
-	channels []byte
+	nodeInfo    NodeInfo
+	channelsMtx cmtsync.RWMutex
+	channels    []byte
+	// our channels registered on the established connection, by ID
+	registered map[byte]registeredChannel
 
 	// User data
-	Data *cmap.CMap
+	data *peerdata.Store
 
 	metrics       *Metrics
 	metricsTicker *time.Ticker
 	mlc           *metricsLabelCache
+	traffic       *Traffic    // nil if traffic is not counted
+	scorer        *PeerScorer // nil if peers are not scored
+	stats         *peerStats
 
 	// When removal of a peer fails, we set this flag
 	removalAttemptFailed bool
@@ -150,6 +198,20 @@ func WithPeerTracer(t trace.Tracer) PeerOption {
 	}
 }
 
+func withPongHandler(onPong func(p Peer, offset, rtt time.Duration)) PeerOption {
+	return func(p *peer) {
+		// the clock offset and round trip time are measured by the pings of
+		// MConnections
+		mconn, ok := p.mconn.(*cmtconn.MConnection)
+		if onPong == nil || !ok {
+			return
+		}
+		mconn.SetClockOffsetHandler(func(offset, rtt time.Duration) {
+			onPong(p, offset, rtt)
+		})
+	}
+}
+
 func newPeer(
 	pc peerConn,
 	mConfig cmtconn.MConnConfig,
@@ -165,22 +227,49 @@ func newPeer(
 		peerConn:      pc,
 		nodeInfo:      nodeInfo,
 		channels:      nodeInfo.(DefaultNodeInfo).Channels,
-		Data:          cmap.NewCMap(),
+		registered:    make(map[byte]registeredChannel),
+		data:          peerdata.NewStore(),
 		metricsTicker: time.NewTicker(metricsTickerDuration),
 		metrics:       NopMetrics(),
 		mlc:           mlc,
+		stats:         newPeerStats(),
 		traceClient:   trace.NoOpTracer(),
 	}
 
-	p.mconn = createMConnection(
-		pc.conn,
-		p,
-		reactorsByCh,
-		msgTypeByChID,
-		chDescs,
-		onPeerError,
-		mConfig,
-	)
+	if qc, ok := pc.conn.(*quic.Conn); ok {
+		p.mconn = createQUICMConnection(
+			qc,
+			p,
+			reactorsByCh,
+			msgTypeByChID,
+			chDescs,
+			onPeerError,
+			mConfig,
+		)
+	} else if mConfig.Yamux {
+		p.mconn = createYamuxConnection(
+			pc.conn,
+			pc.outbound,
+			p,
+			reactorsByCh,
+			msgTypeByChID,
+			chDescs,
+			onPeerError,
+			mConfig,
+		)
+	} else {
+		mconn := createMConnection(
+			pc.conn,
+			p,
+			reactorsByCh,
+			msgTypeByChID,
+			chDescs,
+			onPeerError,
+			mConfig,
+		)
+		mconn.SetChannelUpdateHandler(p.updateChannel)
+		p.mconn = mconn
+	}
 	p.BaseService = *service.NewBaseService(nil, "Peer", p)
 	for _, option := range options {
 		option(p)
@@ -299,6 +388,13 @@ func (p *peer) Status() cmtconn.ConnectionStatus {
 	return p.mconn.Status()
 }
 
+// MessageStats returns the counts and bytes of the messages sent to and
+// received from the peer since the connection started, per channel and per
+// message type.
+func (p *peer) MessageStats() PeerMessageStats {
+	return p.stats.get()
+}
+
 // Send msg bytes to the channel identified by chID byte. Returns false if the
 // send queue is full after timeout, specified by MConnection.
 func (p *peer) Send(e Envelope) bool {
@@ -333,26 +429,26 @@ func (p *peer) send(chID byte, msg proto.Message, sendFunc func(byte, []byte) bo
 			"chID", fmt.Sprintf("%#x", chID),
 		}
 		p.metrics.PeerSendBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+		p.traffic.addSent(chID, len(msgBytes))
+		p.stats.addSent(chID, metricLabelValue, len(msgBytes))
 		labels = append(labels, "message_type", metricLabelValue)
 		p.metrics.MessageSendBytesTotal.With(labels...).Add(float64(len(msgBytes)))
 	}
 	return res
 }
 
-// Get the data for a given key.
-func (p *peer) Get(key string) interface{} {
-	return p.Data.Get(key)
-}
-
-// Set sets the data for the given key.
-func (p *peer) Set(key string, data interface{}) {
-	p.Data.Set(key, data)
+// Data returns the metadata store of the peer.
+func (p *peer) Data() *peerdata.Store {
+	return p.data
 }
 
 // hasChannel returns true if the peer reported
 // knowing about the given chID.
 func (p *peer) hasChannel(chID byte) bool {
-	for _, ch := range p.channels {
+	p.channelsMtx.RLock()
+	channels := p.channels
+	p.channelsMtx.RUnlock()
+	for _, ch := range channels {
 		if ch == chID {
 			return true
 		}
@@ -364,11 +460,81 @@ func (p *peer) hasChannel(chID byte) bool {
 		"channel",
 		chID,
 		"channels",
-		p.channels,
+		channels,
 	)
 	return false
 }
 
+// updateChannel records a channel registered or unregistered by the peer on
+// the connection.
+func (p *peer) updateChannel(chID byte, registered bool) {
+	p.channelsMtx.Lock()
+	defer p.channelsMtx.Unlock()
+	// copied, as the channels are shared with the node info
+	channels := make([]byte, 0, len(p.channels)+1)
+	for _, ch := range p.channels {
+		if ch != chID {
+			channels = append(channels, ch)
+		}
+	}
+	if registered {
+		channels = append(channels, chID)
+	}
+	p.channels = channels
+}
+
+// RegisterChannel implements Peer. The messages received on the channel are
+// only sent to reactor once the peer registered it too.
+func (p *peer) RegisterChannel(desc *cmtconn.ChannelDescriptor, reactor Reactor) error {
+	registrar, ok := p.mconn.(channelRegistrar)
+	if !ok {
+		return cmtconn.ErrChannelRegistrationUnsupported
+	}
+
+	// Recorded first, so that the messages are received as soon as the
+	// channel is registered.
+	p.channelsMtx.Lock()
+	if _, ok := p.registered[desc.ID]; ok {
+		p.channelsMtx.Unlock()
+		return fmt.Errorf("channel %X already registered", desc.ID)
+	}
+	p.registered[desc.ID] = registeredChannel{desc: desc, reactor: reactor}
+	p.channelsMtx.Unlock()
+
+	if err := registrar.RegisterChannel(desc); err != nil {
+		p.channelsMtx.Lock()
+		delete(p.registered, desc.ID)
+		p.channelsMtx.Unlock()
+		return err
+	}
+	return nil
+}
+
+// UnregisterChannel implements Peer.
+func (p *peer) UnregisterChannel(chID byte) error {
+	registrar, ok := p.mconn.(channelRegistrar)
+	if !ok {
+		return cmtconn.ErrChannelRegistrationUnsupported
+	}
+	if err := registrar.UnregisterChannel(chID); err != nil {
+		return err
+	}
+
+	p.channelsMtx.Lock()
+	delete(p.registered, chID)
+	p.channelsMtx.Unlock()
+	return nil
+}
+
+// registeredChannel returns the channel with the given ID registered on the
+// established connection.
+func (p *peer) registeredChannel(chID byte) (registeredChannel, bool) {
+	p.channelsMtx.RLock()
+	defer p.channelsMtx.RUnlock()
+	ch, ok := p.registered[chID]
+	return ch, ok
+}
+
 // CloseConn closes original connection. Used for cleaning up in cases where the peer had not been started at all.
 func (p *peer) CloseConn() error {
 	return p.peerConn.conn.Close()
@@ -406,6 +572,18 @@ func (p *peer) CanSend(chID byte) bool {
 
 //---------------------------------------------------
 
+func withTraffic(traffic *Traffic) PeerOption {
+	return func(p *peer) {
+		p.traffic = traffic
+	}
+}
+
+func withPeerScorer(scorer *PeerScorer) PeerOption {
+	return func(p *peer) {
+		p.scorer = scorer
+	}
+}
+
 func PeerMetrics(metrics *Metrics) PeerOption {
 	return func(p *peer) {
 		p.metrics = metrics
@@ -445,18 +623,108 @@ func createMConnection(
 	config cmtconn.MConnConfig,
 ) *cmtconn.MConnection {
 
-	onReceive := func(chID byte, msgBytes []byte) {
-		reactor := reactorsByCh[chID]
+	receive := newReceiveFunc(p, reactorsByCh, msgTypeByChID, chDescs)
+	// The messages are decoded into new ones, so their buffers are released
+	// once the reactors received them.
+	onReceive := func(chID byte, buf *cmtconn.Buffer) {
+		defer buf.Release()
+		receive(chID, buf.Bytes())
+	}
+
+	onError := func(r interface{}) {
+		onPeerError(p, r)
+	}
+
+	mconn := cmtconn.NewMConnectionWithConfig(
+		conn,
+		chDescs,
+		nil,
+		onError,
+		config,
+	)
+	mconn.SetReceiveBufferHandler(onReceive)
+	return mconn
+}
+
+func createQUICMConnection(
+	conn *quic.Conn,
+	p *peer,
+	reactorsByCh map[byte]Reactor,
+	msgTypeByChID map[byte]proto.Message,
+	chDescs []*cmtconn.ChannelDescriptor,
+	onPeerError func(Peer, interface{}),
+	config cmtconn.MConnConfig,
+) *quic.MConnection {
+	onError := func(r interface{}) {
+		onPeerError(p, r)
+	}
+	return quic.NewMConnection(
+		conn,
+		chDescs,
+		newReceiveFunc(p, reactorsByCh, msgTypeByChID, chDescs),
+		onError,
+		config,
+	)
+}
+
+func createYamuxConnection(
+	conn net.Conn,
+	outbound bool,
+	p *peer,
+	reactorsByCh map[byte]Reactor,
+	msgTypeByChID map[byte]proto.Message,
+	chDescs []*cmtconn.ChannelDescriptor,
+	onPeerError func(Peer, interface{}),
+	config cmtconn.MConnConfig,
+) *cmtconn.YamuxConnection {
+	onError := func(r interface{}) {
+		onPeerError(p, r)
+	}
+	return cmtconn.NewYamuxConnection(
+		conn,
+		outbound,
+		chDescs,
+		newReceiveFunc(p, reactorsByCh, msgTypeByChID, chDescs),
+		onError,
+		config,
+	)
+}
+
+// newReceiveFunc returns the function decoding the messages received from
+// the peer and passing them to the reactors. It panics on invalid messages,
+// the panics being recovered by the connection, which calls onPeerError.
+func newReceiveFunc(
+	p *peer,
+	reactorsByCh map[byte]Reactor,
+	msgTypeByChID map[byte]proto.Message,
+	chDescs []*cmtconn.ChannelDescriptor,
+) func(chID byte, msgBytes []byte) {
+	decodeLimits := make(map[byte]protoio.DecodeLimits, len(chDescs))
+	for _, desc := range chDescs {
+		decodeLimits[desc.ID] = desc.DecodeLimits
+	}
+
+	return func(chID byte, msgBytes []byte) {
+		reactor, mt, limits := reactorsByCh[chID], msgTypeByChID[chID], decodeLimits[chID]
+		if reactor == nil {
+			// a channel registered on the established connection
+			if ch, ok := p.registeredChannel(chID); ok {
+				reactor, mt, limits = ch.reactor, ch.desc.MessageType, ch.desc.DecodeLimits
+			}
+		}
 		if reactor == nil {
+			err := fmt.Errorf("Unknown channel %X", chID) //nolint:stylecheck
+			p.scorer.RecordInvalidMessage(p.ID(), err)
 			// Note that its ok to panic here as it's caught in the conn._recover,
 			// which does onPeerError.
-			panic(fmt.Sprintf("Unknown channel %X", chID))
+			panic(err)
 		}
-		mt := msgTypeByChID[chID]
 		msg := proto.Clone(mt)
-		err := proto.Unmarshal(msgBytes, msg)
+		err := protoio.UnmarshalLimited(msgBytes, msg, limits)
 		if err != nil {
-			panic(fmt.Errorf("unmarshaling message: %s into type: %s", err, reflect.TypeOf(mt)))
+			err = fmt.Errorf("unmarshaling message: %s into type: %s", err, reflect.TypeOf(mt))
+			p.scorer.RecordInvalidMessage(p.ID(), err)
+			panic(err)
 		}
 		labels := []string{
 			"peer_id", string(p.ID()),
@@ -465,28 +733,25 @@ func createMConnection(
 		if w, ok := msg.(Unwrapper); ok {
 			msg, err = w.Unwrap()
 			if err != nil {
-				panic(fmt.Errorf("unwrapping message: %s", err))
+				err = fmt.Errorf("unwrapping message: %s", err)
+				p.scorer.RecordInvalidMessage(p.ID(), err)
+				panic(err)
 			}
 		}
 		schema.WriteReceivedBytes(p.traceClient, string(p.ID()), chID, len(msgBytes))
 		p.metrics.PeerReceiveBytesTotal.With(labels...).Add(float64(len(msgBytes)))
-		p.metrics.MessageReceiveBytesTotal.With(append(labels, "message_type", p.mlc.ValueToMetricLabel(msg))...).Add(float64(len(msgBytes)))
+		p.traffic.addReceived(chID, len(msgBytes))
+		metricLabelValue := p.mlc.ValueToMetricLabel(msg)
+		p.stats.addReceived(chID, metricLabelValue, len(msgBytes))
+		p.metrics.MessageReceiveBytesTotal.With(append(labels, "message_type", metricLabelValue)...).Add(float64(len(msgBytes)))
+		if labels := profileLabelsOf(reactor); labels != nil {
+			pprof.SetGoroutineLabels(labels)
+			defer pprof.SetGoroutineLabels(p2pProfileLabels)
+		}
 		reactor.Receive(Envelope{
 			ChannelID: chID,
 			Src:       p,
 			Message:   msg,
 		})
 	}
-
-	onError := func(r interface{}) {
-		onPeerError(p, r)
-	}
-
-	return cmtconn.NewMConnectionWithConfig(
-		conn,
-		chDescs,
-		onReceive,
-		onError,
-		config,
-	)
 }
@@ -0,0 +1,194 @@
+package p2p
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Sizes of the subnets whose peers count towards the same subnet limit.
+const (
+	peerSubnetBitsIPv4 = 24
+	peerSubnetBitsIPv6 = 48
+)
+
+// PeerDiversity limits the number of peers sharing a subnet or an autonomous
+// system, so that a provider concentrating many nodes in one hosting network
+// cannot take up all of our peer slots.
+type PeerDiversity struct {
+	maxPerSubnet int
+	maxPerASN    int
+	asns         *ASNTable // nil if peers are not limited per ASN
+}
+
+// NewPeerDiversity returns a PeerDiversity allowing at most maxPerSubnet peers
+// per subnet and maxPerASN peers per autonomous system, as resolved using
+// asns. A limit of zero disables it.
+func NewPeerDiversity(maxPerSubnet, maxPerASN int, asns *ASNTable) *PeerDiversity {
+	if asns == nil {
+		maxPerASN = 0
+	}
+	return &PeerDiversity{
+		maxPerSubnet: maxPerSubnet,
+		maxPerASN:    maxPerASN,
+		asns:         asns,
+	}
+}
+
+// Check returns ErrPeerDiversity if adding a peer with the given IP to peers
+// would exceed the subnet or ASN limit. exempt reports the peers not counting
+// towards the limits.
+func (pd *PeerDiversity) Check(peers IPeerSet, ip net.IP, exempt func(Peer) bool) error {
+	subnet := peerSubnet(ip)
+	asn, hasASN := uint32(0), false
+	if pd.maxPerASN > 0 {
+		asn, hasASN = pd.asns.Lookup(ip)
+	}
+
+	sameSubnet, sameASN := 0, 0
+	for _, p := range peers.List() {
+		if exempt(p) {
+			continue
+		}
+		peerIP := p.RemoteIP()
+		if pd.maxPerSubnet > 0 && subnet.Contains(peerIP) {
+			sameSubnet++
+		}
+		if hasASN {
+			if peerASN, ok := pd.asns.Lookup(peerIP); ok && peerASN == asn {
+				sameASN++
+			}
+		}
+	}
+
+	if pd.maxPerSubnet > 0 && sameSubnet >= pd.maxPerSubnet {
+		return ErrPeerDiversity{IP: ip, Subnet: subnet, Count: sameSubnet}
+	}
+	if hasASN && sameASN >= pd.maxPerASN {
+		return ErrPeerDiversity{IP: ip, ASN: asn, Count: sameASN}
+	}
+	return nil
+}
+
+// peerSubnet returns the subnet ip counts towards.
+func peerSubnet(ip net.IP) *net.IPNet {
+	if ip4 := ip.To4(); ip4 != nil {
+		mask := net.CIDRMask(peerSubnetBitsIPv4, 8*net.IPv4len)
+		return &net.IPNet{IP: ip4.Mask(mask), Mask: mask}
+	}
+	mask := net.CIDRMask(peerSubnetBitsIPv6, 8*net.IPv6len)
+	return &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+}
+
+// ASNTable maps IP networks to the autonomous systems announcing them.
+type ASNTable struct {
+	ranges []asnRange // sorted by first address, non-overlapping
+}
+
+type asnRange struct {
+	first, last net.IP // 16-byte form
+	asn         uint32
+}
+
+// LoadASNTable loads an ASNTable from a CSV file in the format of the
+// GeoLite2 ASN CSV database: one "<network CIDR>,<ASN>[,...]" record per line.
+// Header lines, whose first field is "network", are skipped, so that the IPv4
+// and IPv6 databases can be concatenated.
+func LoadASNTable(filePath string) (*ASNTable, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	t, err := readASNTable(bufio.NewReader(f))
+	if err != nil {
+		return nil, fmt.Errorf("error reading ASN database from %v: %w", filePath, err)
+	}
+	return t, nil
+}
+
+func readASNTable(r io.Reader) (*ASNTable, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	t := &ASNTable{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 2 {
+			line, _ := cr.FieldPos(0)
+			return nil, fmt.Errorf("line %d: expected <network CIDR>,<ASN>", line)
+		}
+		if strings.TrimSpace(record[0]) == "network" {
+			continue
+		}
+		_, network, err := net.ParseCIDR(strings.TrimSpace(record[0]))
+		if err != nil {
+			line, _ := cr.FieldPos(0)
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		asn, err := strconv.ParseUint(strings.TrimSpace(record[1]), 10, 32)
+		if err != nil {
+			line, _ := cr.FieldPos(1)
+			return nil, fmt.Errorf("line %d: invalid ASN %q", line, record[1])
+		}
+		t.ranges = append(t.ranges, asnRange{
+			first: network.IP.To16(),
+			last:  lastIP(network),
+			asn:   uint32(asn),
+		})
+	}
+
+	sort.Slice(t.ranges, func(i, j int) bool {
+		return bytes.Compare(t.ranges[i].first, t.ranges[j].first) < 0
+	})
+	for i := 1; i < len(t.ranges); i++ {
+		if bytes.Compare(t.ranges[i].first, t.ranges[i-1].last) <= 0 {
+			return nil, fmt.Errorf("networks %v and %v overlap", t.ranges[i-1].first, t.ranges[i].first)
+		}
+	}
+	return t, nil
+}
+
+// Lookup returns the autonomous system announcing ip, if known.
+func (t *ASNTable) Lookup(ip net.IP) (uint32, bool) {
+	ip = ip.To16()
+	if ip == nil {
+		return 0, false
+	}
+	// the last range starting at or before ip
+	i := sort.Search(len(t.ranges), func(i int) bool {
+		return bytes.Compare(t.ranges[i].first, ip) > 0
+	}) - 1
+	if i < 0 || bytes.Compare(ip, t.ranges[i].last) > 0 {
+		return 0, false
+	}
+	return t.ranges[i].asn, true
+}
+
+// lastIP returns the last address of network, in 16-byte form.
+func lastIP(network *net.IPNet) net.IP {
+	first := network.IP.To16()
+	last := make(net.IP, net.IPv6len)
+	offset := net.IPv6len - len(network.Mask)
+	copy(last, first)
+	for i, b := range network.Mask {
+		last[offset+i] |= ^b
+	}
+	return last
+}
@@ -0,0 +1,87 @@
+package p2p
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testASNDatabase = `network,autonomous_system_number,autonomous_system_organization
+1.0.0.0/24,13335,CLOUDFLARENET
+1.0.4.0/22,38803,GTELECOM
+network,autonomous_system_number,autonomous_system_organization
+2001:db8::/32,64500,DOCUMENTATION
+`
+
+func TestASNTable(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "asn.csv")
+	require.NoError(t, os.WriteFile(file, []byte(testASNDatabase), 0o600))
+	asns, err := LoadASNTable(file)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		ip  string
+		asn uint32
+		ok  bool
+	}{
+		{"1.0.0.0", 13335, true},
+		{"1.0.0.255", 13335, true},
+		{"1.0.1.0", 0, false},
+		{"1.0.4.1", 38803, true},
+		{"1.0.7.255", 38803, true},
+		{"1.0.8.0", 0, false},
+		{"0.255.255.255", 0, false},
+		{"2001:db8:1::1", 64500, true},
+		{"2001:db9::1", 0, false},
+	}
+	for _, tc := range testCases {
+		asn, ok := asns.Lookup(net.ParseIP(tc.ip))
+		assert.Equal(t, tc.ok, ok, tc.ip)
+		assert.Equal(t, tc.asn, asn, tc.ip)
+	}
+
+	_, err = readASNTable(strings.NewReader("1.0.0.0/24,x\n"))
+	assert.Error(t, err)
+	_, err = readASNTable(strings.NewReader("1.0.0.0/24,1\n1.0.0.128/25,2\n"))
+	assert.Error(t, err, "overlapping networks")
+}
+
+func TestPeerDiversityCheck(t *testing.T) {
+	asns, err := readASNTable(strings.NewReader(testASNDatabase))
+	require.NoError(t, err)
+
+	peers := NewPeerSet()
+	exempt := newMockPeer(net.ParseIP("1.0.0.3"))
+	for _, p := range []Peer{
+		newMockPeer(net.ParseIP("1.0.0.1")),
+		newMockPeer(net.ParseIP("1.0.4.1")),
+		newMockPeer(net.ParseIP("1.0.5.1")),
+		exempt,
+	} {
+		require.NoError(t, peers.Add(p))
+	}
+	isExempt := func(p Peer) bool { return p == exempt }
+
+	// 1 peer in 1.0.0.0/24 besides the exempt one
+	pd := NewPeerDiversity(2, 0, nil)
+	assert.NoError(t, pd.Check(peers, net.ParseIP("1.0.0.2"), isExempt))
+	pd = NewPeerDiversity(1, 0, nil)
+	err = pd.Check(peers, net.ParseIP("1.0.0.2"), isExempt)
+	require.ErrorAs(t, err, &ErrPeerDiversity{})
+	assert.Equal(t, "1.0.0.0/24", err.(ErrPeerDiversity).Subnet.String())
+	assert.NoError(t, pd.Check(peers, net.ParseIP("1.0.1.1"), isExempt))
+
+	// 2 peers in AS38803, in different subnets
+	pd = NewPeerDiversity(0, 2, asns)
+	err = pd.Check(peers, net.ParseIP("1.0.6.1"), isExempt)
+	require.ErrorAs(t, err, &ErrPeerDiversity{})
+	assert.Equal(t, uint32(38803), err.(ErrPeerDiversity).ASN)
+	assert.NoError(t, pd.Check(peers, net.ParseIP("1.0.0.2"), isExempt))
+	// unknown ASN
+	assert.NoError(t, pd.Check(peers, net.ParseIP("8.8.8.8"), isExempt))
+}
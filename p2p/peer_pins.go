@@ -0,0 +1,76 @@
+package p2p
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/libs/tempfile"
+)
+
+// PeerPins pins the IDs of persistent peers to their addresses on first use,
+// so that a persistent peer whose node key changes is refused instead of
+// silently connecting to a different identity. Pins are persisted in a JSON
+// file mapping dial addresses to node IDs; remove an entry to accept a new
+// identity.
+type PeerPins struct {
+	mtx      cmtsync.Mutex
+	filePath string
+	pins     map[string]ID
+}
+
+// LoadPeerPins loads the pins from filePath. A missing file holds no pins.
+func LoadPeerPins(filePath string) (*PeerPins, error) {
+	pp := &PeerPins{
+		filePath: filePath,
+		pins:     make(map[string]ID),
+	}
+
+	bz, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return pp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, &pp.pins); err != nil {
+		return nil, fmt.Errorf("error reading peer pins from %v: %w", filePath, err)
+	}
+	for addr, id := range pp.pins {
+		if err := validateID(id); err != nil {
+			return nil, fmt.Errorf("invalid pin for %v: %w", addr, err)
+		}
+	}
+	return pp, nil
+}
+
+// Check returns ErrPeerPinMismatch if the address is pinned to an ID other
+// than addr.ID.
+func (pp *PeerPins) Check(addr *NetAddress) error {
+	pp.mtx.Lock()
+	defer pp.mtx.Unlock()
+
+	if pinned, ok := pp.pins[addr.DialString()]; ok && pinned != addr.ID {
+		return ErrPeerPinMismatch{Addr: addr, PinnedID: pinned}
+	}
+	return nil
+}
+
+// Pin pins addr.ID to the address unless it is already pinned, persisting the
+// new pin. It must only be called once the peer authenticated as addr.ID.
+func (pp *PeerPins) Pin(addr *NetAddress) error {
+	pp.mtx.Lock()
+	defer pp.mtx.Unlock()
+
+	if _, ok := pp.pins[addr.DialString()]; ok {
+		return nil
+	}
+	pp.pins[addr.DialString()] = addr.ID
+
+	bz, err := json.MarshalIndent(pp.pins, "", "\t")
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(pp.filePath, bz, 0600)
+}
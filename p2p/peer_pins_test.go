@@ -0,0 +1,77 @@
+package p2p
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+)
+
+func TestPeerPins(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "peer_pins.json")
+
+	pins, err := LoadPeerPins(file)
+	require.NoError(t, err)
+
+	id := PubKeyToID(ed25519.GenPrivKey().PubKey())
+	otherID := PubKeyToID(ed25519.GenPrivKey().PubKey())
+	addr, err := NewNetAddressString(IDAddressString(id, "127.0.0.1:26656"))
+	require.NoError(t, err)
+	changedAddr, err := NewNetAddressString(IDAddressString(otherID, "127.0.0.1:26656"))
+	require.NoError(t, err)
+
+	// nothing pinned yet
+	require.NoError(t, pins.Check(addr))
+	require.NoError(t, pins.Check(changedAddr))
+
+	require.NoError(t, pins.Pin(addr))
+	require.NoError(t, pins.Check(addr))
+	err = pins.Check(changedAddr)
+	require.ErrorAs(t, err, &ErrPeerPinMismatch{})
+	assert.Equal(t, id, err.(ErrPeerPinMismatch).PinnedID)
+
+	// existing pins are not replaced
+	require.NoError(t, pins.Pin(changedAddr))
+	require.Error(t, pins.Check(changedAddr))
+
+	// pins are persisted
+	pins, err = LoadPeerPins(file)
+	require.NoError(t, err)
+	require.NoError(t, pins.Check(addr))
+	require.Error(t, pins.Check(changedAddr))
+}
+
+func TestSwitchRefusesPinnedPersistentPeerWithChangedID(t *testing.T) {
+	sw := MakeSwitch(cfg, 1, initSwitchFunc)
+	pins, err := LoadPeerPins(filepath.Join(t.TempDir(), "peer_pins.json"))
+	require.NoError(t, err)
+	sw.SetPeerPins(pins)
+	require.NoError(t, sw.Start())
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	defer rp.Stop()
+
+	// first use pins the peer's ID
+	require.NoError(t, sw.AddPersistentPeers([]string{rp.Addr().String()}))
+	require.NoError(t, sw.DialPeerWithAddress(rp.Addr()))
+	require.NoError(t, pins.Check(rp.Addr()))
+
+	// the peer's node key changed
+	changedAddr, err := NewNetAddressString(
+		IDAddressString(PubKeyToID(ed25519.GenPrivKey().PubKey()), rp.Addr().DialString()))
+	require.NoError(t, err)
+	require.NoError(t, sw.AddPersistentPeers([]string{changedAddr.String()}))
+
+	err = sw.DialPeerWithAddress(changedAddr)
+	require.ErrorAs(t, err, &ErrPeerPinMismatch{})
+	assert.Nil(t, sw.Peers().Get(changedAddr.ID))
+}
@@ -0,0 +1,293 @@
+package p2p
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p/conn"
+)
+
+const (
+	// Weights of the events in the score of a peer.
+	usefulBlockWeight    = 1.0
+	usefulTxWeight       = 0.1
+	invalidMessageWeight = -20.0
+	disconnectWeight     = -5.0
+
+	// maxUsefulScore caps the score earned by delivering blocks and
+	// transactions, so that a peer cannot build up enough credit to absorb
+	// its invalid messages.
+	maxUsefulScore = 100.0
+
+	// peerScoreHalfLife is the time after which an event weighs half as much
+	// in the score, so that peers recover from past misbehavior.
+	peerScoreHalfLife = 10 * time.Minute
+
+	// Round trip times above latencyThreshold lower the score by
+	// latencyWeight per second, up to maxLatencyPenalty.
+	latencyThreshold  = 100 * time.Millisecond
+	latencyWeight     = -10.0
+	maxLatencyPenalty = 20.0
+
+	// maxScoredPeers is the number of peers whose scores are kept, the least
+	// recently updated being forgotten first.
+	maxScoredPeers = 1000
+
+	// maxDroppedPeers is the number of dropped peers remembered.
+	maxDroppedPeers = 100
+)
+
+// PeerScore is the score of a peer, along with the events it derives from.
+// Counts are totals since the node started, while the score weighs recent
+// events more.
+type PeerScore struct {
+	Score           float64       `json:"score"`
+	UsefulBlocks    uint64        `json:"useful_blocks"`
+	UsefulTxs       uint64        `json:"useful_txs"`
+	InvalidMessages uint64        `json:"invalid_messages"`
+	Disconnects     uint64        `json:"disconnects"`
+	Latency         time.Duration `json:"latency"`
+	// Last invalid message received from the peer, if any.
+	LastInvalidMessage string `json:"last_invalid_message,omitempty"`
+}
+
+// DroppedPeer is a peer the switch disconnected from because of an error.
+type DroppedPeer struct {
+	ID     ID        `json:"id"`
+	Time   time.Time `json:"time"`
+	Reason string    `json:"reason"`
+	Score  PeerScore `json:"score"`
+}
+
+type peerScore struct {
+	PeerScore
+	events  float64 // decayed sum of the weights of the events
+	useful  float64 // decayed sum of the weights of the useful events
+	updated time.Time
+}
+
+// decay decays the score to now.
+func (s *peerScore) decay(now time.Time) {
+	factor := math.Exp2(-now.Sub(s.updated).Seconds() / peerScoreHalfLife.Seconds())
+	s.events *= factor
+	s.useful *= factor
+	s.updated = now
+}
+
+func (s *peerScore) score() float64 {
+	penalty := 0.0
+	if s.Latency > latencyThreshold {
+		penalty = math.Min(-latencyWeight*(s.Latency-latencyThreshold).Seconds(), maxLatencyPenalty)
+	}
+	return s.events - penalty
+}
+
+// addUseful adds the weight of a useful event, up to maxUsefulScore.
+func (s *peerScore) addUseful(weight float64) {
+	if s.useful+weight > maxUsefulScore {
+		weight = math.Max(maxUsefulScore-s.useful, 0)
+	}
+	s.useful += weight
+	s.events += weight
+}
+
+// PeerScorer scores the peers by the blocks and transactions they deliver,
+// their invalid messages, how often they are disconnected for misbehaving and
+// their latency, and tells which peers score below a minimum. Scores are kept
+// across reconnections. The methods of a nil PeerScorer do nothing, so that peer
+// scoring can be disabled.
+type PeerScorer struct {
+	mtx      cmtsync.Mutex
+	minScore float64
+	scores   map[ID]*peerScore
+	dropped  []DroppedPeer // oldest first
+	now      func() time.Time
+
+	// called when the score of a peer falls below minScore
+	onBelowMin func(id ID, score PeerScore)
+}
+
+// NewPeerScorer returns a PeerScorer judging peers scoring below minScore,
+// which must be negative, as too low.
+func NewPeerScorer(minScore float64) *PeerScorer {
+	return &PeerScorer{
+		minScore: minScore,
+		scores:   make(map[ID]*peerScore),
+		now:      time.Now,
+	}
+}
+
+// RecordUsefulBlock records that the peer delivered a block.
+func (ps *PeerScorer) RecordUsefulBlock(id ID) {
+	ps.record(id, func(s *peerScore) {
+		s.UsefulBlocks++
+		s.addUseful(usefulBlockWeight)
+	})
+}
+
+// RecordUsefulTx records that the peer delivered a new transaction.
+func (ps *PeerScorer) RecordUsefulTx(id ID) {
+	ps.record(id, func(s *peerScore) {
+		s.UsefulTxs++
+		s.addUseful(usefulTxWeight)
+	})
+}
+
+// RecordInvalidMessage records that the peer sent an invalid message.
+func (ps *PeerScorer) RecordInvalidMessage(id ID, err error) {
+	ps.record(id, func(s *peerScore) {
+		s.InvalidMessages++
+		s.events += invalidMessageWeight
+		if err != nil {
+			s.LastInvalidMessage = err.Error()
+		}
+	})
+}
+
+// RecordLatency records the round trip time of a ping to the peer.
+func (ps *PeerScorer) RecordLatency(id ID, rtt time.Duration) {
+	ps.record(id, func(s *peerScore) {
+		if s.Latency == 0 {
+			s.Latency = rtt
+		} else {
+			// moving average, smoothing out the outliers
+			s.Latency = (4*s.Latency + rtt) / 5
+		}
+	})
+}
+
+// RecordDisconnect records that the switch disconnected from the peer
+// because of the given error, remembering it among the dropped peers. Only
+// the disconnects caused by the misbehavior of the peer lower its score.
+func (ps *PeerScorer) RecordDisconnect(id ID, reason interface{}) {
+	if ps == nil {
+		return
+	}
+	ps.record(id, func(s *peerScore) {
+		s.Disconnects++
+		if isMisbehavior(reason) {
+			s.events += disconnectWeight
+		}
+	})
+
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	if len(ps.dropped) == maxDroppedPeers {
+		ps.dropped = append(ps.dropped[:0], ps.dropped[1:]...)
+	}
+	ps.dropped = append(ps.dropped, DroppedPeer{
+		ID:     id,
+		Time:   ps.now(),
+		Reason: fmt.Sprintf("%v", reason),
+		Score:  ps.scoreLocked(id),
+	})
+}
+
+// isMisbehavior returns false if the peer was disconnected for the given reason
+// because its connection failed or the node evicted it, rather than because of
+// its behavior.
+func isMisbehavior(reason interface{}) bool {
+	err, ok := reason.(error)
+	if !ok {
+		return true
+	}
+	var (
+		scoreErr ErrPeerScoreTooLow
+		netErr   net.Error
+	)
+	switch {
+	case errors.As(err, &scoreErr),
+		errors.Is(err, io.EOF),
+		errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, net.ErrClosed),
+		errors.As(err, &netErr),
+		errors.Is(err, conn.ErrPongTimeout):
+		return false
+	}
+	return true
+}
+
+// Score returns the score of the peer. Unknown peers score zero.
+func (ps *PeerScorer) Score(id ID) PeerScore {
+	if ps == nil {
+		return PeerScore{}
+	}
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	return ps.scoreLocked(id)
+}
+
+// IsBelowMin returns true if the peer scores below the minimum.
+func (ps *PeerScorer) IsBelowMin(id ID) bool {
+	if ps == nil {
+		return false
+	}
+	return ps.Score(id).Score < ps.minScore
+}
+
+// Dropped returns the peers dropped recently, oldest first.
+func (ps *PeerScorer) Dropped() []DroppedPeer {
+	if ps == nil {
+		return nil
+	}
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	return append([]DroppedPeer(nil), ps.dropped...)
+}
+
+func (ps *PeerScorer) scoreLocked(id ID) PeerScore {
+	s, ok := ps.scores[id]
+	if !ok {
+		return PeerScore{}
+	}
+	s.decay(ps.now())
+	score := s.PeerScore
+	score.Score = s.score()
+	return score
+}
+
+// record applies update to the score of the peer, and calls onBelowMin if
+// the score fell below the minimum.
+func (ps *PeerScorer) record(id ID, update func(*peerScore)) {
+	if ps == nil {
+		return
+	}
+	ps.mtx.Lock()
+	s, ok := ps.scores[id]
+	if !ok {
+		ps.pruneLocked()
+		s = &peerScore{updated: ps.now()}
+		ps.scores[id] = s
+	}
+	s.decay(ps.now())
+	before := s.score()
+	update(s)
+	score := s.PeerScore
+	score.Score = s.score()
+	onBelowMin := ps.onBelowMin
+	ps.mtx.Unlock()
+
+	if onBelowMin != nil && score.Score < ps.minScore && before >= ps.minScore {
+		onBelowMin(id, score)
+	}
+}
+
+// pruneLocked forgets the least recently updated peer if the scores of
+// maxScoredPeers peers are kept.
+func (ps *PeerScorer) pruneLocked() {
+	if len(ps.scores) < maxScoredPeers {
+		return
+	}
+	var oldest ID
+	for id, s := range ps.scores {
+		if oldest == "" || s.updated.Before(ps.scores[oldest].updated) {
+			oldest = id
+		}
+	}
+	delete(ps.scores, oldest)
+}
@@ -0,0 +1,121 @@
+package p2p
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/p2p/conn"
+)
+
+func TestPeerScorer(t *testing.T) {
+	now := time.Now()
+	ps := NewPeerScorer(-50)
+	ps.now = func() time.Time { return now }
+
+	var below []ID
+	ps.onBelowMin = func(id ID, _ PeerScore) { below = append(below, id) }
+
+	assert.Equal(t, PeerScore{}, ps.Score("a"))
+
+	ps.RecordUsefulBlock("a")
+	ps.RecordUsefulTx("a")
+	score := ps.Score("a")
+	assert.InDelta(t, usefulBlockWeight+usefulTxWeight, score.Score, 1e-9)
+	assert.EqualValues(t, 1, score.UsefulBlocks)
+	assert.EqualValues(t, 1, score.UsefulTxs)
+
+	// the credit earned by useful messages is capped
+	for i := 0; i < 2*maxUsefulScore; i++ {
+		ps.RecordUsefulBlock("a")
+	}
+	assert.InDelta(t, maxUsefulScore, ps.Score("a").Score, 1e-9)
+
+	// slow peers score lower
+	ps.RecordLatency("b", latencyThreshold+time.Second)
+	assert.InDelta(t, latencyWeight, ps.Score("b").Score, 1e-9)
+	assert.Equal(t, latencyThreshold+time.Second, ps.Score("b").Latency)
+
+	ps.RecordInvalidMessage("b", errors.New("bad message"))
+	ps.RecordDisconnect("b", "bad message")
+	score = ps.Score("b")
+	assert.InDelta(t, latencyWeight+invalidMessageWeight+disconnectWeight, score.Score, 1e-9)
+	assert.EqualValues(t, 1, score.InvalidMessages)
+	assert.EqualValues(t, 1, score.Disconnects)
+	assert.Equal(t, "bad message", score.LastInvalidMessage)
+	assert.False(t, ps.IsBelowMin("b"))
+	assert.Empty(t, below)
+
+	// onBelowMin is called once, when the score falls below the minimum
+	ps.RecordInvalidMessage("b", nil)
+	ps.RecordInvalidMessage("b", nil)
+	assert.True(t, ps.IsBelowMin("b"))
+	assert.Equal(t, []ID{"b"}, below)
+
+	// events weigh less as time passes
+	before := ps.Score("b").Score
+	now = now.Add(peerScoreHalfLife)
+	assert.InDelta(t, (before-latencyWeight)/2+latencyWeight, ps.Score("b").Score, 1e-9)
+	assert.False(t, ps.IsBelowMin("b"))
+
+	dropped := ps.Dropped()
+	require.Len(t, dropped, 1)
+	assert.Equal(t, ID("b"), dropped[0].ID)
+	assert.Equal(t, "bad message", dropped[0].Reason)
+	assert.EqualValues(t, 1, dropped[0].Score.Disconnects)
+}
+
+func TestPeerScorerBounds(t *testing.T) {
+	ps := NewPeerScorer(-50)
+	for i := 0; i < maxDroppedPeers+10; i++ {
+		ps.RecordDisconnect(ID(rune('a'+i)), i)
+	}
+	dropped := ps.Dropped()
+	require.Len(t, dropped, maxDroppedPeers)
+	assert.Equal(t, "10", dropped[0].Reason)
+
+	for i := 0; i < maxScoredPeers+10; i++ {
+		ps.RecordUsefulTx(ID(rune(i)))
+	}
+	assert.Len(t, ps.scores, maxScoredPeers)
+}
+
+func TestPeerScorerNil(t *testing.T) {
+	var ps *PeerScorer
+	ps.RecordUsefulBlock("a")
+	ps.RecordUsefulTx("a")
+	ps.RecordInvalidMessage("a", errors.New("bad message"))
+	ps.RecordLatency("a", time.Second)
+	ps.RecordDisconnect("a", "bad message")
+	assert.Equal(t, PeerScore{}, ps.Score("a"))
+	assert.False(t, ps.IsBelowMin("a"))
+	assert.Empty(t, ps.Dropped())
+}
+
+func TestPeerScorerBenignDisconnects(t *testing.T) {
+	now := time.Now()
+	ps := NewPeerScorer(-50)
+	ps.now = func() time.Time { return now }
+	for _, reason := range []interface{}{
+		io.EOF,
+		fmt.Errorf("read: %w", io.ErrUnexpectedEOF),
+		conn.ErrPongTimeout,
+		&net.OpError{Op: "read", Err: errors.New("connection reset by peer")},
+		ErrPeerScoreTooLow{ID: "a", Score: -60, Min: -50},
+	} {
+		ps.RecordDisconnect("a", reason)
+	}
+	score := ps.Score("a")
+	assert.Zero(t, score.Score)
+	assert.EqualValues(t, 5, score.Disconnects)
+	assert.Len(t, ps.Dropped(), 5)
+
+	ps.RecordDisconnect("a", conn.ErrPacketOutOfSequence)
+	assert.InDelta(t, disconnectWeight, ps.Score("a").Score, 1e-9)
+}
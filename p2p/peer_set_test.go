@@ -9,6 +9,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/p2p/peerdata"
 )
 
 // mockPeer for testing the PeerSet
@@ -26,8 +27,7 @@ func (mp *mockPeer) Status() ConnectionStatus { return ConnectionStatus{} }
 func (mp *mockPeer) ID() ID                   { return mp.id }
 func (mp *mockPeer) IsOutbound() bool         { return false }
 func (mp *mockPeer) IsPersistent() bool       { return true }
-func (mp *mockPeer) Get(s string) interface{} { return s }
-func (mp *mockPeer) Set(string, interface{})  {}
+func (mp *mockPeer) Data() *peerdata.Store    { return nil }
 func (mp *mockPeer) RemoteIP() net.IP         { return mp.ip }
 func (mp *mockPeer) SocketAddr() *NetAddress  { return nil }
 func (mp *mockPeer) RemoteAddr() net.Addr     { return &net.TCPAddr{IP: mp.ip, Port: 8800} }
@@ -37,6 +37,9 @@ func (mp *mockPeer) GetRemovalFailed() bool   { return false }
 
 func (mp *mockPeer) HasIPChanged() bool { return false }
 
+func (mp *mockPeer) RegisterChannel(*ChannelDescriptor, Reactor) error { return nil }
+func (mp *mockPeer) UnregisterChannel(byte) error                      { return nil }
+
 // Returns a mock peer
 func newMockPeer(ip net.IP) *mockPeer {
 	if ip == nil {
@@ -0,0 +1,106 @@
+package p2p
+
+import (
+	"sort"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// MessageStats counts the messages of a channel, or of a message type on a
+// channel, exchanged with a peer.
+type MessageStats struct {
+	Channel       byte   `json:"channel"`
+	MessageType   string `json:"message_type,omitempty"`
+	SentMsgs      uint64 `json:"sent_msgs"`
+	SentBytes     uint64 `json:"sent_bytes"`
+	ReceivedMsgs  uint64 `json:"received_msgs"`
+	ReceivedBytes uint64 `json:"received_bytes"`
+}
+
+// PeerMessageStats breaks down the messages exchanged with a peer since the
+// connection started, per channel and per message type.
+type PeerMessageStats struct {
+	Channels     []MessageStats `json:"channels"`
+	MessageTypes []MessageStats `json:"message_types"`
+}
+
+type messageTypeKey struct {
+	chID    byte
+	msgType string
+}
+
+// peerStats counts the messages exchanged with a peer.
+type peerStats struct {
+	mtx          cmtsync.Mutex
+	channels     map[byte]*MessageStats
+	messageTypes map[messageTypeKey]*MessageStats
+}
+
+func newPeerStats() *peerStats {
+	return &peerStats{
+		channels:     make(map[byte]*MessageStats),
+		messageTypes: make(map[messageTypeKey]*MessageStats),
+	}
+}
+
+func (ps *peerStats) addSent(chID byte, msgType string, n int) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	for _, s := range ps.statsLocked(chID, msgType) {
+		s.SentMsgs++
+		s.SentBytes += uint64(n)
+	}
+}
+
+func (ps *peerStats) addReceived(chID byte, msgType string, n int) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	for _, s := range ps.statsLocked(chID, msgType) {
+		s.ReceivedMsgs++
+		s.ReceivedBytes += uint64(n)
+	}
+}
+
+// statsLocked returns the stats of the channel and of the message type on
+// the channel.
+func (ps *peerStats) statsLocked(chID byte, msgType string) [2]*MessageStats {
+	ch, ok := ps.channels[chID]
+	if !ok {
+		ch = &MessageStats{Channel: chID}
+		ps.channels[chID] = ch
+	}
+	key := messageTypeKey{chID: chID, msgType: msgType}
+	mt, ok := ps.messageTypes[key]
+	if !ok {
+		mt = &MessageStats{Channel: chID, MessageType: msgType}
+		ps.messageTypes[key] = mt
+	}
+	return [2]*MessageStats{ch, mt}
+}
+
+// get returns the stats ordered by channel, then by message type.
+func (ps *peerStats) get() PeerMessageStats {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	stats := PeerMessageStats{
+		Channels:     make([]MessageStats, 0, len(ps.channels)),
+		MessageTypes: make([]MessageStats, 0, len(ps.messageTypes)),
+	}
+	for _, s := range ps.channels {
+		stats.Channels = append(stats.Channels, *s)
+	}
+	for _, s := range ps.messageTypes {
+		stats.MessageTypes = append(stats.MessageTypes, *s)
+	}
+	sort.Slice(stats.Channels, func(i, j int) bool {
+		return stats.Channels[i].Channel < stats.Channels[j].Channel
+	})
+	sort.Slice(stats.MessageTypes, func(i, j int) bool {
+		a, b := stats.MessageTypes[i], stats.MessageTypes[j]
+		if a.Channel != b.Channel {
+			return a.Channel < b.Channel
+		}
+		return a.MessageType < b.MessageType
+	})
+	return stats
+}
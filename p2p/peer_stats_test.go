@@ -0,0 +1,30 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerStats(t *testing.T) {
+	ps := newPeerStats()
+	assert.Equal(t, PeerMessageStats{Channels: []MessageStats{}, MessageTypes: []MessageStats{}}, ps.get())
+
+	ps.addSent(0x30, "mempool_Txs", 100)
+	ps.addReceived(0x30, "mempool_Txs", 50)
+	ps.addReceived(0x30, "mempool_Txs", 70)
+	ps.addReceived(0x20, "consensus_Vote", 10)
+	ps.addSent(0x20, "consensus_NewRoundStep", 5)
+
+	assert.Equal(t, PeerMessageStats{
+		Channels: []MessageStats{
+			{Channel: 0x20, SentMsgs: 1, SentBytes: 5, ReceivedMsgs: 1, ReceivedBytes: 10},
+			{Channel: 0x30, SentMsgs: 1, SentBytes: 100, ReceivedMsgs: 2, ReceivedBytes: 120},
+		},
+		MessageTypes: []MessageStats{
+			{Channel: 0x20, MessageType: "consensus_NewRoundStep", SentMsgs: 1, SentBytes: 5},
+			{Channel: 0x20, MessageType: "consensus_Vote", ReceivedMsgs: 1, ReceivedBytes: 10},
+			{Channel: 0x30, MessageType: "mempool_Txs", SentMsgs: 1, SentBytes: 100, ReceivedMsgs: 2, ReceivedBytes: 120},
+		},
+	}, ps.get())
+}
@@ -73,6 +73,38 @@ func TestPeerSend(t *testing.T) {
 
 	assert.True(p.CanSend(testCh))
 	assert.True(p.Send(Envelope{ChannelID: testCh, Message: &p2p.Message{}}))
+
+	stats := p.MessageStats()
+	require.Len(stats.Channels, 1)
+	assert.EqualValues(1, stats.Channels[0].SentMsgs)
+	require.Len(stats.MessageTypes, 1)
+	assert.Equal("p2p_Message", stats.MessageTypes[0].MessageType)
+}
+
+func TestPeerUpdateChannel(t *testing.T) {
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	t.Cleanup(rp.Stop)
+
+	p, err := createOutboundPeerAndPerformHandshake(rp.Addr(), cfg, cmtconn.DefaultMConnConfig())
+	require.NoError(t, err)
+	nodeInfoChannels := append([]byte{}, p.NodeInfo().(DefaultNodeInfo).Channels...)
+
+	// channels registered and unregistered by the peer on the connection
+	assert.False(t, p.hasChannel(0x42))
+	p.updateChannel(0x42, true)
+	assert.True(t, p.hasChannel(0x42))
+	assert.True(t, p.hasChannel(testCh))
+	p.updateChannel(testCh, false)
+	assert.False(t, p.hasChannel(testCh))
+	assert.Equal(t, nodeInfoChannels, []byte(p.NodeInfo().(DefaultNodeInfo).Channels))
+
+	// our channels are only registered on connections supporting it
+	desc := &cmtconn.ChannelDescriptor{ID: 0x42, MessageType: &p2p.Message{}}
+	err = p.RegisterChannel(desc, NewTestReactor(nil, false))
+	assert.ErrorIs(t, err, cmtconn.ErrChannelRegistrationUnsupported)
+	_, ok := p.registeredChannel(desc.ID)
+	assert.False(t, ok)
 }
 
 func createOutboundPeerAndPerformHandshake(
@@ -0,0 +1,145 @@
+// Package peerdata implements the metadata store of peers, where reactors
+// keep per-peer state and share it with each other.
+//
+// Values are stored under typed keys, registered once by the reactor owning
+// them:
+//
+//	var peerStateKey = peerdata.Register[*PeerState]("ConsensusReactor.peerState")
+//
+//	peerStateKey.Set(peer.Data(), ps)
+//	ps, ok := peerStateKey.Get(peer.Data())
+//
+// A Store is safe for concurrent use. Every value has a version, incremented
+// each time it is set, and readers can wait for a value to be set instead of
+// polling for it.
+package peerdata
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	registryMtx sync.Mutex
+	registry    = make(map[string]string) // key name -> value type
+)
+
+// Key identifies a value of type T in the metadata store of peers.
+type Key[T any] struct {
+	name string
+}
+
+// Register returns the key of the values of type T stored under name. It
+// panics if the name is already registered, so that two reactors can't store
+// different values under the same key. Keys are meant to be registered at
+// package initialization.
+func Register[T any](name string) Key[T] {
+	registryMtx.Lock()
+	defer registryMtx.Unlock()
+
+	if typ, ok := registry[name]; ok {
+		panic(fmt.Sprintf("peer data key %q already registered for %s", name, typ))
+	}
+	var zero T
+	registry[name] = fmt.Sprintf("%T", &zero)[1:]
+	return Key[T]{name: name}
+}
+
+// Name returns the name the key was registered with.
+func (k Key[T]) Name() string {
+	return k.name
+}
+
+// Get returns the value of the key in the store, and whether it is set.
+func (k Key[T]) Get(s *Store) (T, bool) {
+	value, _, ok := s.get(k.name)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return value.(T), true
+}
+
+// Set sets the value of the key in the store and returns its new version.
+func (k Key[T]) Set(s *Store, value T) uint64 {
+	return s.set(k.name, value)
+}
+
+// Version returns the number of times the value of the key was set in the
+// store, zero if it never was.
+func (k Key[T]) Version(s *Store) uint64 {
+	_, version, _ := s.get(k.name)
+	return version
+}
+
+// Wait returns the value of the key in the store, waiting for it to be set
+// if needed. It returns false if quit is closed first.
+func (k Key[T]) Wait(s *Store, quit <-chan struct{}) (T, bool) {
+	for {
+		changed := s.changed()
+		if value, ok := k.Get(s); ok {
+			return value, true
+		}
+		select {
+		case <-changed:
+		case <-quit:
+			var zero T
+			return zero, false
+		}
+	}
+}
+
+type entry struct {
+	value   any
+	version uint64
+}
+
+// Store is the metadata store of a peer. The zero value is ready to use.
+type Store struct {
+	mtx     sync.RWMutex
+	entries map[string]entry
+	// closed and replaced every time a value is set
+	changedCh chan struct{}
+}
+
+// NewStore returns an empty store.
+func NewStore() *Store {
+	return &Store{}
+}
+
+func (s *Store) get(name string) (any, uint64, bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	e, ok := s.entries[name]
+	return e.value, e.version, ok
+}
+
+func (s *Store) set(name string, value any) uint64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.entries == nil {
+		s.entries = make(map[string]entry)
+	}
+	e := s.entries[name]
+	e.value = value
+	e.version++
+	s.entries[name] = e
+	if s.changedCh != nil {
+		close(s.changedCh)
+		s.changedCh = nil
+	}
+	return e.version
+}
+
+// changed returns a channel closed the next time a value is set.
+func (s *Store) changed() <-chan struct{} {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.changedCh == nil {
+		s.changedCh = make(chan struct{})
+	}
+	return s.changedCh
+}
@@ -0,0 +1,79 @@
+package peerdata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	heightKey = Register[int64]("test.height")
+	nameKey   = Register[string]("test.name")
+)
+
+func TestRegisterDuplicate(t *testing.T) {
+	assert.Equal(t, "test.height", heightKey.Name())
+	assert.Panics(t, func() { Register[int64]("test.height") })
+	assert.Panics(t, func() { Register[string]("test.height") })
+}
+
+func TestKeyGetSet(t *testing.T) {
+	s := NewStore()
+
+	_, ok := heightKey.Get(s)
+	assert.False(t, ok)
+	assert.Zero(t, heightKey.Version(s))
+
+	assert.EqualValues(t, 1, heightKey.Set(s, 10))
+	assert.EqualValues(t, 2, heightKey.Set(s, 11))
+	height, ok := heightKey.Get(s)
+	require.True(t, ok)
+	assert.EqualValues(t, 11, height)
+	assert.EqualValues(t, 2, heightKey.Version(s))
+
+	// keys don't share values
+	_, ok = nameKey.Get(s)
+	assert.False(t, ok)
+	nameKey.Set(s, "peer")
+	name, _ := nameKey.Get(s)
+	assert.Equal(t, "peer", name)
+	assert.EqualValues(t, 2, heightKey.Version(s))
+
+	// the zero value is ready to use
+	var zero Store
+	heightKey.Set(&zero, 1)
+	height, ok = heightKey.Get(&zero)
+	assert.True(t, ok)
+	assert.EqualValues(t, 1, height)
+}
+
+func TestKeyWait(t *testing.T) {
+	s := NewStore()
+	quit := make(chan struct{})
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		// setting another key doesn't wake the waiter up for good
+		nameKey.Set(s, "peer")
+		time.Sleep(10 * time.Millisecond)
+		heightKey.Set(s, 5)
+	}()
+	height, ok := heightKey.Wait(s, quit)
+	require.True(t, ok)
+	assert.EqualValues(t, 5, height)
+
+	// a value already set is returned right away
+	height, ok = heightKey.Wait(s, quit)
+	require.True(t, ok)
+	assert.EqualValues(t, 5, height)
+
+	// closing quit stops the wait
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(quit)
+	}()
+	_, ok = Register[bool]("test.unset").Wait(s, quit)
+	assert.False(t, ok)
+}
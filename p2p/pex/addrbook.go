@@ -5,12 +5,15 @@
 package pex
 
 import (
+	"encoding/base32"
 	"encoding/binary"
 	"fmt"
 	"hash"
 	"math"
 	"math/rand"
 	"net"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -64,9 +67,17 @@ type AddrBook interface {
 	MarkGood(p2p.ID)
 	MarkAttempt(*p2p.NetAddress)
 	MarkBad(*p2p.NetAddress, time.Duration) // Move peer to bad peers list
+	// Move peer to bad peers list, recording why it was banned
+	MarkBadWithReason(addr *p2p.NetAddress, banTime time.Duration, reason string)
 	// Add bad peers back to addrBook
 	ReinstateBadPeers()
 
+	// Bans returns the peers currently banned.
+	Bans() []Ban
+	// Unban adds a banned peer back to the address book, returning false if
+	// it is not banned.
+	Unban(p2p.ID) bool
+
 	IsGood(*p2p.NetAddress) bool
 	IsBanned(*p2p.NetAddress) bool
 
@@ -83,6 +94,14 @@ type AddrBook interface {
 
 var _ AddrBook = (*addrBook)(nil)
 
+// Ban is a peer banned from the address book, which is not dialed nor
+// accepted until the ban expires.
+type Ban struct {
+	Addr   *p2p.NetAddress
+	Reason string
+	Until  time.Time
+}
+
 // addrBook - concurrency safe peer address manager.
 // Implements AddrBook.
 type addrBook struct {
@@ -100,12 +119,20 @@ type addrBook struct {
 	nOld       int
 	nNew       int
 
+	// addresses of other networks found in the address book file, which are
+	// kept on disk but never used
+	otherNetworks map[string]*addrBookJSON
+
 	// immutable after creation
+	network           string // chain ID the addresses belong to
 	filePath          string
 	key               string // random prefix for bucket placement
 	routabilityStrict bool
 	hasher            hash.Hash64
 
+	// serializes the writes of the bans file
+	bansMtx cmtsync.Mutex
+
 	wg sync.WaitGroup
 }
 
@@ -118,18 +145,36 @@ func mustNewHasher() hash.Hash64 {
 	return hasher
 }
 
+// AddrBookOption sets an optional parameter on the address book.
+type AddrBookOption func(*addrBook)
+
+// AddrBookNetwork partitions the address book by network. Only the addresses
+// which were learned on the given network (chain ID) are used; the addresses
+// of other networks found in the address book file are left untouched, so
+// that several networks can share an address book file and a node can switch
+// networks without dialing peers of the previous one.
+func AddrBookNetwork(network string) AddrBookOption {
+	return func(a *addrBook) {
+		a.network = network
+	}
+}
+
 // NewAddrBook creates a new address book.
 // Use Start to begin processing asynchronous address updates.
-func NewAddrBook(filePath string, routabilityStrict bool) AddrBook {
+func NewAddrBook(filePath string, routabilityStrict bool, options ...AddrBookOption) AddrBook {
 	am := &addrBook{
 		rand:              cmtrand.NewRand(),
 		ourAddrs:          make(map[string]struct{}),
 		privateIDs:        make(map[p2p.ID]struct{}),
 		addrLookup:        make(map[p2p.ID]*knownAddress),
 		badPeers:          make(map[p2p.ID]*knownAddress),
+		otherNetworks:     make(map[string]*addrBookJSON),
 		filePath:          filePath,
 		routabilityStrict: routabilityStrict,
 	}
+	for _, option := range options {
+		option(am)
+	}
 	am.init()
 	am.BaseService = *service.NewBaseService(nil, "AddrBook", am)
 	return am
@@ -158,6 +203,7 @@ func (a *addrBook) OnStart() error {
 		return err
 	}
 	a.loadFromFile(a.filePath)
+	a.loadBans(a.bansFilePath())
 
 	// wg.Add to ensure that any invocation of .Wait()
 	// later on will wait for saveRoutine to terminate.
@@ -228,6 +274,34 @@ func (a *addrBook) RemoveAddress(addr *p2p.NetAddress) {
 	a.removeAddress(addr)
 }
 
+// Bans implements AddrBook.
+func (a *addrBook) Bans() []Ban {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	bans := make([]Ban, 0, len(a.badPeers))
+	for _, ka := range a.badPeers {
+		if ka.isBanned() {
+			bans = append(bans, Ban{Addr: ka.Addr, Reason: ka.BanReason, Until: ka.LastBanTime})
+		}
+	}
+	sort.Slice(bans, func(i, j int) bool { return bans[i].Addr.ID < bans[j].Addr.ID })
+	return bans
+}
+
+// Unban implements AddrBook.
+func (a *addrBook) Unban(id p2p.ID) bool {
+	a.mtx.Lock()
+	ka, ok := a.badPeers[id]
+	reinstated := ok && a.reinstateBadPeer(ka)
+	a.mtx.Unlock()
+
+	if reinstated {
+		a.saveBans()
+	}
+	return reinstated
+}
+
 // IsGood returns true if peer was ever marked as good and haven't
 // done anything wrong since then.
 func (a *addrBook) IsGood(addr *p2p.NetAddress) bool {
@@ -353,12 +427,22 @@ func (a *addrBook) MarkAttempt(addr *p2p.NetAddress) {
 // MarkBad implements AddrBook. Kicks address out from book, places
 // the address in the badPeers pool.
 func (a *addrBook) MarkBad(addr *p2p.NetAddress, banTime time.Duration) {
-	a.mtx.Lock()
-	defer a.mtx.Unlock()
+	a.MarkBadWithReason(addr, banTime, "")
+}
 
-	if a.addBadPeer(addr, banTime) {
+// MarkBadWithReason implements AddrBook. Like MarkBad, and records the reason
+// of the ban, which is persisted with it.
+func (a *addrBook) MarkBadWithReason(addr *p2p.NetAddress, banTime time.Duration, reason string) {
+	a.mtx.Lock()
+	banned := a.addBadPeer(addr, banTime, reason)
+	if banned {
 		a.removeAddress(addr)
 	}
+	a.mtx.Unlock()
+
+	if banned {
+		a.saveBans()
+	}
 }
 
 // ReinstateBadPeers removes bad peers from ban list and places them into a new
@@ -371,20 +455,7 @@ func (a *addrBook) ReinstateBadPeers() {
 		if ka.isBanned() {
 			continue
 		}
-
-		bucket, err := a.calcNewBucket(ka.Addr, ka.Src)
-		if err != nil {
-			a.Logger.Error("Failed to calculate new bucket (bad peer won't be reinstantiated)",
-				"addr", ka.Addr, "err", err)
-			continue
-		}
-
-		if err := a.addToNewBucket(ka, bucket); err != nil {
-			a.Logger.Error("Error adding peer to new bucket", "err", err)
-		}
-		delete(a.badPeers, ka.ID())
-
-		a.Logger.Info("Reinstated address", "addr", ka.Addr)
+		a.reinstateBadPeer(ka)
 	}
 }
 
@@ -809,7 +880,31 @@ func (a *addrBook) removeAddress(addr *p2p.NetAddress) {
 	a.removeFromAllBuckets(ka)
 }
 
-func (a *addrBook) addBadPeer(addr *p2p.NetAddress, banTime time.Duration) bool {
+// reinstateBadPeer removes ka from the ban list and places it into a new
+// bucket, returning false if it could not.
+func (a *addrBook) reinstateBadPeer(ka *knownAddress) bool {
+	bucket, err := a.calcNewBucket(ka.Addr, ka.Src)
+	if err != nil {
+		a.Logger.Error("Failed to calculate new bucket (bad peer won't be reinstantiated)",
+			"addr", ka.Addr, "err", err)
+		return false
+	}
+
+	// The peer was removed from all buckets when banned, so it starts over
+	// as a new address.
+	ka.BucketType = bucketTypeNew
+	ka.LastBanTime = time.Time{}
+	ka.BanReason = ""
+	if err := a.addToNewBucket(ka, bucket); err != nil {
+		a.Logger.Error("Error adding peer to new bucket", "err", err)
+	}
+	delete(a.badPeers, ka.ID())
+
+	a.Logger.Info("Reinstated address", "addr", ka.Addr)
+	return true
+}
+
+func (a *addrBook) addBadPeer(addr *p2p.NetAddress, banTime time.Duration, reason string) bool {
 	// check it exists in addrbook
 	ka := a.addrLookup[addr.ID]
 	// check address is not already there
@@ -819,9 +914,9 @@ func (a *addrBook) addBadPeer(addr *p2p.NetAddress, banTime time.Duration) bool
 
 	if _, alreadyBadPeer := a.badPeers[addr.ID]; !alreadyBadPeer {
 		// add to bad peer list
-		ka.ban(banTime)
+		ka.ban(banTime, reason)
 		a.badPeers[addr.ID] = ka
-		a.Logger.Info("Add address to blacklist", "addr", addr)
+		a.Logger.Info("Add address to blacklist", "addr", addr, "reason", reason)
 	}
 	return true
 }
@@ -898,6 +993,16 @@ func groupKeyFor(na *p2p.NetAddress, routabilityStrict bool) string {
 		return "unroutable"
 	}
 
+	if na.IsOnion() {
+		// as for OnionCat addresses, group is keyed off the first 4 bits of
+		// the onion key.
+		key, err := base32.StdEncoding.DecodeString(strings.ToUpper(strings.TrimSuffix(na.Onion, ".onion")))
+		if err != nil || len(key) == 0 {
+			return "tor"
+		}
+		return fmt.Sprintf("tor:%d", key[0]&((1<<4)-1))
+	}
+
 	if ipv4 := na.IP.To4(); ipv4 != nil {
 		return na.IP.Mask(net.CIDRMask(16, 32)).String()
 	}
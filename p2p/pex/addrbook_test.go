@@ -92,6 +92,47 @@ func TestAddrBookSaveLoad(t *testing.T) {
 	assert.Equal(t, 100, book.Size())
 }
 
+func TestAddrBookNetworkPartitions(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	newBook := func(network string) AddrBook {
+		book := NewAddrBook(fname, true, AddrBookNetwork(network))
+		book.SetLogger(log.TestingLogger())
+		require.NoError(t, book.Start())
+		return book
+	}
+	addAddrs := func(book AddrBook, n int) {
+		for _, addrSrc := range randNetAddressPairs(t, n) {
+			require.NoError(t, book.AddAddress(addrSrc.addr, addrSrc.src))
+		}
+		book.Save()
+	}
+
+	// an address book written before partitioning belongs to any network
+	book := NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+	addAddrs(book, 10)
+
+	book = newBook("chain-a")
+	assert.Equal(t, 10, book.Size())
+	book.Save()
+
+	// addresses of another network are kept aside
+	book = newBook("chain-b")
+	assert.True(t, book.Empty())
+	addAddrs(book, 20)
+
+	book = newBook("chain-a")
+	assert.Equal(t, 10, book.Size())
+	addAddrs(book, 5)
+
+	book = newBook("chain-b")
+	assert.Equal(t, 20, book.Size())
+	book = newBook("chain-a")
+	assert.Equal(t, 15, book.Size())
+}
+
 func TestAddrBookLookup(t *testing.T) {
 	fname := createTempFileName("addrbook_test")
 	defer deleteTempFile(fname)
@@ -439,6 +480,61 @@ func TestBanBadPeers(t *testing.T) {
 	assert.False(t, book.IsGood(addr))
 }
 
+func TestBansSaveLoad(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	book := NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+
+	addr, other := randIPv4Address(t), randIPv4Address(t)
+	require.NoError(t, book.AddAddress(addr, addr))
+	require.NoError(t, book.AddAddress(other, other))
+	// Save the address book before the ban, as on a crash before the next
+	// periodic save.
+	book.Save()
+	book.MarkBadWithReason(addr, time.Hour, "invalid addresses")
+	book.MarkBadWithReason(other, time.Hour, "unsolicited addresses")
+
+	book = NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+	require.NoError(t, book.Start())
+	defer book.Stop() //nolint:errcheck // ignore for tests
+
+	assert.True(t, book.IsBanned(addr))
+	assert.False(t, book.HasAddress(addr))
+	assert.Zero(t, book.Size())
+
+	bans := book.Bans()
+	require.Len(t, bans, 2)
+	for _, ban := range bans {
+		switch ban.Addr.ID {
+		case addr.ID:
+			assert.Equal(t, "invalid addresses", ban.Reason)
+		case other.ID:
+			assert.Equal(t, "unsolicited addresses", ban.Reason)
+		default:
+			t.Fatalf("unexpected ban of %v", ban.Addr)
+		}
+		assert.True(t, ban.Until.After(time.Now()))
+	}
+
+	// Unbanning is persisted too.
+	assert.True(t, book.Unban(addr.ID))
+	assert.False(t, book.Unban(addr.ID))
+	assert.False(t, book.IsBanned(addr))
+	assert.True(t, book.HasAddress(addr))
+
+	book = NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+	require.NoError(t, book.Start())
+	defer book.Stop() //nolint:errcheck // ignore for tests
+
+	assert.False(t, book.IsBanned(addr))
+	assert.True(t, book.IsBanned(other))
+	assert.Len(t, book.Bans(), 1)
+}
+
 func TestAddrBookEmpty(t *testing.T) {
 	fname := createTempFileName("addrbook_test")
 	defer deleteTempFile(fname)
@@ -708,6 +804,12 @@ func TestAddrBookGroupKey(t *testing.T) {
 		key := groupKeyFor(p2p.NewNetAddressIPPort(nip, 26656), true)
 		assert.Equal(t, tc.expKey, key, "#%d", i)
 	}
+
+	// onion addresses
+	addr, err := p2p.NewNetAddressString(
+		"deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion:26656")
+	require.NoError(t, err)
+	assert.Equal(t, "tor:13", groupKeyFor(addr, true))
 }
 
 func assertMOldAndNNewAddrsInSelection(t *testing.T, m, n int, addrs []*p2p.NetAddress, book *addrBook) {
@@ -734,6 +836,11 @@ func deleteTempFile(fname string) {
 	if err != nil {
 		panic(err)
 	}
+	// Remove the bans saved alongside the address book, if any.
+	err = os.Remove(fname + "_bans.json")
+	if err != nil && !os.IsNotExist(err) {
+		panic(err)
+	}
 }
 
 func createAddrBookWithMOldAndNNewAddrs(t *testing.T, nOld, nNew int) (book *addrBook, fname string) {
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/cometbft/cometbft/libs/tempfile"
 )
@@ -13,9 +15,18 @@ import (
 type addrBookJSON struct {
 	Key   string          `json:"key"`
 	Addrs []*knownAddress `json:"addrs"`
+	// Network is the chain ID the addresses belong to. It is empty for
+	// address books which are not partitioned by network.
+	Network string `json:"network,omitempty"`
+	// OtherNetworks holds the partitions of the other networks sharing the
+	// file, keyed by chain ID.
+	OtherNetworks map[string]*addrBookJSON `json:"other_networks,omitempty"`
 }
 
 func (a *addrBook) saveToFile(filePath string) {
+	// Runs once a.mtx is released.
+	defer a.saveBans()
+
 	a.mtx.Lock()
 	defer a.mtx.Unlock()
 
@@ -26,8 +37,12 @@ func (a *addrBook) saveToFile(filePath string) {
 		addrs = append(addrs, ka)
 	}
 	aJSON := &addrBookJSON{
-		Key:   a.key,
-		Addrs: addrs,
+		Key:     a.key,
+		Addrs:   addrs,
+		Network: a.network,
+	}
+	if len(a.otherNetworks) > 0 {
+		aJSON.OtherNetworks = a.otherNetworks
 	}
 
 	jsonBytes, err := json.MarshalIndent(aJSON, "", "\t")
@@ -41,6 +56,71 @@ func (a *addrBook) saveToFile(filePath string) {
 	}
 }
 
+// bansJSON holds the banned peers, which are kept out of the address book.
+type bansJSON struct {
+	Bans []*knownAddress `json:"bans"`
+}
+
+// bansFilePath returns the path of the file the bans are persisted to, next
+// to the address book file: addrbook.json bans are saved in addrbook_bans.json.
+func (a *addrBook) bansFilePath() string {
+	return strings.TrimSuffix(a.filePath, filepath.Ext(a.filePath)) + "_bans.json"
+}
+
+// saveBans persists the banned peers. It must be called without a.mtx held,
+// as only the snapshot of the bans is taken under it, not the write.
+func (a *addrBook) saveBans() {
+	// Writes are serialized so that the last snapshot taken is the last one
+	// written.
+	a.bansMtx.Lock()
+	defer a.bansMtx.Unlock()
+
+	a.mtx.Lock()
+	bans := make([]*knownAddress, 0, len(a.badPeers))
+	for _, ka := range a.badPeers {
+		bans = append(bans, ka)
+	}
+	jsonBytes, err := json.MarshalIndent(&bansJSON{Bans: bans}, "", "\t")
+	a.mtx.Unlock()
+	if err != nil {
+		a.Logger.Error("Failed to save bans to file", "err", err)
+		return
+	}
+	filePath := a.bansFilePath()
+	if err := tempfile.WriteFileAtomic(filePath, jsonBytes, 0644); err != nil {
+		a.Logger.Error("Failed to save bans to file", "file", filePath, "err", err)
+	}
+}
+
+// loadBans restores the banned peers, removing them from the address book.
+// Returns false if file does not exist.
+// cmn.Panics if file is corrupt.
+func (a *addrBook) loadBans(filePath string) bool {
+	jsonBytes, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return false
+	}
+	if err != nil {
+		panic(fmt.Sprintf("Error opening file %s: %v", filePath, err))
+	}
+	bJSON := &bansJSON{}
+	if err := json.Unmarshal(jsonBytes, bJSON); err != nil {
+		panic(fmt.Sprintf("Error reading file %s: %v", filePath, err))
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	for _, ka := range bJSON.Bans {
+		// The address book may have been saved before the peer was banned.
+		if known, ok := a.addrLookup[ka.ID()]; ok {
+			a.removeFromAllBuckets(known)
+		}
+		ka.Buckets = nil
+		a.badPeers[ka.ID()] = ka
+	}
+	return true
+}
+
 // Returns false if file does not exist.
 // cmn.Panics if file is corrupt.
 func (a *addrBook) loadFromFile(filePath string) bool {
@@ -63,6 +143,25 @@ func (a *addrBook) loadFromFile(filePath string) bool {
 		panic(fmt.Sprintf("Error reading file %s: %v", filePath, err))
 	}
 
+	// Pick the partition of our network, keeping the other ones aside. Address
+	// books written before partitioning are assumed to belong to our network.
+	partitions := aJSON.OtherNetworks
+	aJSON.OtherNetworks = nil
+	if partitions == nil {
+		partitions = make(map[string]*addrBookJSON)
+	}
+	if aJSON.Network == "" {
+		aJSON.Network = a.network
+	}
+	partitions[aJSON.Network] = aJSON
+	aJSON, ok := partitions[a.network]
+	delete(partitions, a.network)
+	a.otherNetworks = partitions
+	if !ok {
+		a.Logger.Info("No addresses for our network in AddrBook file", "network", a.network, "file", filePath)
+		return true
+	}
+
 	// Restore all the fields...
 	// Restore the key
 	a.key = aJSON.Key
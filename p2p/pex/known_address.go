@@ -17,6 +17,7 @@ type knownAddress struct {
 	LastAttempt time.Time       `json:"last_attempt"`
 	LastSuccess time.Time       `json:"last_success"`
 	LastBanTime time.Time       `json:"last_ban_time"`
+	BanReason   string          `json:"ban_reason,omitempty"`
 }
 
 func newKnownAddress(addr *p2p.NetAddress, src *p2p.NetAddress) *knownAddress {
@@ -55,9 +56,10 @@ func (ka *knownAddress) markGood() {
 	ka.LastSuccess = now
 }
 
-func (ka *knownAddress) ban(banTime time.Duration) {
+func (ka *knownAddress) ban(banTime time.Duration, reason string) {
 	if ka.LastBanTime.Before(time.Now().Add(banTime)) {
 		ka.LastBanTime = time.Now().Add(banTime)
+		ka.BanReason = reason
 	}
 }
 
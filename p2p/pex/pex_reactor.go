@@ -3,6 +3,7 @@ package pex
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/conn"
 	tmp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
+	"github.com/cometbft/cometbft/types"
 )
 
 type Peer = p2p.Peer
@@ -96,6 +98,9 @@ type Reactor struct {
 
 	// seed/crawled mode fields
 	crawlPeerInfos map[p2p.ID]crawlPeerInfo
+
+	seedStatusMtx sync.Mutex
+	seedStatuses  map[p2p.ID]*SeedStatus // latest status received from each seed
 }
 
 func (r *Reactor) minReceiveRequestInterval() time.Duration {
@@ -114,6 +119,15 @@ type ReactorConfig struct {
 	// disconnecting.
 	SeedDisconnectWaitPeriod time.Duration
 
+	// Time to keep the inbound peers connected, in seed mode, after
+	// answering their request for addresses. If zero, they are disconnected
+	// right away.
+	SeedGracePeriod time.Duration
+
+	// LatestHeader returns the latest header of the node, if any. If set, it
+	// is sent, in seed mode, to the inbound peers along with its height.
+	LatestHeader func() *types.Header
+
 	// Maximum pause when redialing a persistent peer (if zero, exponential backoff is used)
 	PersistentPeersMaxDialPeriod time.Duration
 
@@ -137,6 +151,7 @@ func NewReactor(b AddrBook, config *ReactorConfig) *Reactor {
 		requestsSent:         cmap.NewCMap(),
 		lastReceivedRequests: cmap.NewCMap(),
 		crawlPeerInfos:       make(map[p2p.ID]crawlPeerInfo),
+		seedStatuses:         make(map[p2p.ID]*SeedStatus),
 	}
 	r.BaseReactor = *p2p.NewBaseReactor("PEX", r)
 	return r
@@ -199,6 +214,10 @@ func (r *Reactor) AddPeer(p Peer) {
 			r.RequestAddrs(p)
 		}
 	} else {
+		if r.config.SeedMode {
+			r.sendStatus(p)
+		}
+
 		// inbound peer is its own source
 		addr, err := p.NodeInfo().NetAddress()
 		if err != nil {
@@ -248,7 +267,7 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 		// 2) limit the output size
 
 		// If we're a seed and this is an inbound peer,
-		// respond once and disconnect.
+		// respond once and disconnect, after the grace period if any.
 		if r.config.SeedMode && !e.Src.IsOutbound() {
 			id := string(e.Src.ID())
 			v := r.lastReceivedRequests.Get(id)
@@ -261,17 +280,13 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 
 			// Send addrs and disconnect
 			r.SendAddrs(e.Src, r.book.GetSelectionWithBias(biasToSelectNewPeers))
-			go func() {
-				// In a go-routine so it doesn't block .Receive.
-				e.Src.FlushStop()
-				r.Switch.StopPeerGracefully(e.Src)
-			}()
+			go r.disconnectSeedPeer(e.Src)
 
 		} else {
 			// Check we're not receiving requests too frequently.
 			if err := r.receiveRequest(e.Src); err != nil {
 				r.Switch.StopPeerForError(e.Src, err)
-				r.book.MarkBad(e.Src.SocketAddr(), defaultBanTime)
+				r.book.MarkBadWithReason(e.Src.SocketAddr(), defaultBanTime, "too many address requests")
 				return
 			}
 			r.SendAddrs(e.Src, r.book.GetSelection())
@@ -281,19 +296,27 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 		// If we asked for addresses, add them to the book
 		addrs, err := p2p.NetAddressesFromProto(msg.Addrs)
 		if err != nil {
+			r.Switch.PeerScorer().RecordInvalidMessage(e.Src.ID(), err)
 			r.Switch.StopPeerForError(e.Src, err)
-			r.book.MarkBad(e.Src.SocketAddr(), defaultBanTime)
+			r.book.MarkBadWithReason(e.Src.SocketAddr(), defaultBanTime, "invalid addresses")
 			return
 		}
 		err = r.ReceiveAddrs(addrs, e.Src)
 		if err != nil {
 			r.Switch.StopPeerForError(e.Src, err)
 			if err == ErrUnsolicitedList {
-				r.book.MarkBad(e.Src.SocketAddr(), defaultBanTime)
+				r.book.MarkBadWithReason(e.Src.SocketAddr(), defaultBanTime, "unsolicited addresses")
 			}
 			return
 		}
 
+	case *tmp2p.PexStatus:
+		if err := r.receiveStatus(msg, e.Src); err != nil {
+			r.Switch.PeerScorer().RecordInvalidMessage(e.Src.ID(), err)
+			r.Switch.StopPeerForError(e.Src, err)
+			return
+		}
+
 	default:
 		r.Logger.Error(fmt.Sprintf("Unknown message type %T", msg))
 	}
@@ -397,6 +420,89 @@ func (r *Reactor) SendAddrs(p Peer, netAddrs []*p2p.NetAddress) {
 	p.Send(e)
 }
 
+// SeedStatus is the latest height of a seed, and its header if it sent it.
+// Neither is verified.
+type SeedStatus struct {
+	Peer   p2p.ID        `json:"peer"`
+	Height int64         `json:"height,string"`
+	Header *types.Header `json:"header,omitempty"`
+}
+
+// sendStatus sends, in seed mode, the latest height and header of the node to
+// the peer if it supports it.
+func (r *Reactor) sendStatus(p Peer) {
+	if r.config.LatestHeader == nil {
+		return
+	}
+	if ni, ok := p.NodeInfo().(p2p.DefaultNodeInfo); !ok || !ni.HasFeature(p2p.FeatureSeedStatus) {
+		return
+	}
+	header := r.config.LatestHeader()
+	if header == nil {
+		return
+	}
+	p.Send(p2p.Envelope{
+		ChannelID: PexChannel,
+		Message:   &tmp2p.PexStatus{Height: header.Height, Header: header.ToProto()},
+	})
+}
+
+// receiveStatus records the status of a seed. It is only accepted from the
+// seeds the node dialed.
+func (r *Reactor) receiveStatus(msg *tmp2p.PexStatus, src Peer) error {
+	if !src.IsOutbound() || !r.isSeed(src.ID()) {
+		return errors.New("status from a peer which is not a seed dialed")
+	}
+	if msg.Height < 0 {
+		return fmt.Errorf("negative height %d in status", msg.Height)
+	}
+	status := &SeedStatus{Peer: src.ID(), Height: msg.Height}
+	if msg.Header != nil {
+		header, err := types.HeaderFromProto(msg.Header)
+		if err != nil {
+			return fmt.Errorf("invalid header in status: %w", err)
+		}
+		if header.Height != msg.Height {
+			return fmt.Errorf("status header height %d doesn't match height %d", header.Height, msg.Height)
+		}
+		status.Header = &header
+	}
+
+	r.Logger.Info("Received seed status", "peer", src, "height", status.Height)
+	r.seedStatusMtx.Lock()
+	defer r.seedStatusMtx.Unlock()
+	r.seedStatuses[src.ID()] = status
+	return nil
+}
+
+// isSeed returns true if id is the ID of one of the seeds of the node.
+func (r *Reactor) isSeed(id p2p.ID) bool {
+	for _, addr := range r.seedAddrs {
+		if addr.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// LatestSeedStatus returns the status of median height among the latest
+// statuses received from the seeds, or nil if none was received. As the
+// statuses are not verified, a single seed can't make the node believe the
+// network is ahead of the other seeds.
+func (r *Reactor) LatestSeedStatus() *SeedStatus {
+	r.seedStatusMtx.Lock()
+	defer r.seedStatusMtx.Unlock()
+	if len(r.seedStatuses) == 0 {
+		return nil
+	}
+	statuses := make([]*SeedStatus, 0, len(r.seedStatuses))
+	for _, status := range r.seedStatuses {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Height < statuses[j].Height })
+	return statuses[(len(statuses)-1)/2]
+}
+
 // SetEnsurePeersPeriod sets period to ensure peers connected.
 func (r *Reactor) SetEnsurePeersPeriod(d time.Duration) {
 	r.ensurePeersPeriod = d
@@ -477,6 +583,10 @@ func (r *Reactor) ensurePeers(ensurePeersPeriodElapsed bool) {
 		if r.Switch.IsDialingOrExistingAddress(try) {
 			continue
 		}
+		if r.Switch.PeerScorer().IsBelowMin(try.ID) && !r.Switch.IsPeerPersistent(try) {
+			// wait for the score of the peer to recover
+			continue
+		}
 		// TODO: consider moving some checks from toDial into here
 		// so we don't even consider dialing peers that we want to wait
 		// before dialing again, or have dialed too many times already
@@ -536,7 +646,7 @@ func (r *Reactor) dialAttemptsInfo(addr *p2p.NetAddress) (attempts int, lastDial
 func (r *Reactor) dialPeer(addr *p2p.NetAddress) error {
 	attempts, lastDialed := r.dialAttemptsInfo(addr)
 	if !r.Switch.IsPeerPersistent(addr) && attempts > maxAttemptsToDial {
-		r.book.MarkBad(addr, defaultBanTime)
+		r.book.MarkBadWithReason(addr, defaultBanTime, "too many dial attempts")
 		return errMaxAttemptsToDial{}
 	}
 
@@ -553,7 +663,9 @@ func (r *Reactor) dialPeer(addr *p2p.NetAddress) error {
 
 	err := r.Switch.DialPeerWithAddress(addr)
 	if err != nil {
-		if _, ok := err.(p2p.ErrCurrentlyDialingOrExistingAddress); ok {
+		switch err.(type) {
+		case p2p.ErrCurrentlyDialingOrExistingAddress, p2p.ErrPeerDiversity:
+			// not a failed attempt, the address may be dialed later
 			return err
 		}
 
@@ -731,6 +843,26 @@ func (r *Reactor) cleanupCrawlPeerInfos() {
 	}
 }
 
+// disconnectSeedPeer disconnects, in seed mode, an inbound peer whose request
+// for addresses was answered, once the grace period has elapsed. It runs in a
+// go-routine so it doesn't block .Receive.
+func (r *Reactor) disconnectSeedPeer(p Peer) {
+	if r.config.SeedGracePeriod > 0 {
+		timer := time.NewTimer(r.config.SeedGracePeriod)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-r.Quit():
+			return
+		}
+		if !r.Switch.Peers().Has(p.ID()) {
+			return
+		}
+	}
+	p.FlushStop()
+	r.Switch.StopPeerGracefully(p)
+}
+
 // attemptDisconnects checks if we've been with each peer long enough to disconnect
 func (r *Reactor) attemptDisconnects() {
 	for _, peer := range r.Switch.Peers().List() {
@@ -748,7 +880,7 @@ func markAddrInBookBasedOnErr(addr *p2p.NetAddress, book AddrBook, err error) {
 	// TODO: detect more "bad peer" scenarios
 	switch err.(type) {
 	case p2p.ErrSwitchAuthenticationFailure:
-		book.MarkBad(addr, defaultBanTime)
+		book.MarkBadWithReason(addr, defaultBanTime, "authentication failure")
 	default:
 		book.MarkAttempt(addr)
 	}
@@ -3,6 +3,7 @@ package pex
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"testing"
@@ -13,10 +14,14 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/mock"
 	tmp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
 )
 
 var cfg *config.P2PConfig
@@ -493,6 +498,123 @@ func TestPEXReactorSeedModeFlushStop(t *testing.T) {
 	}
 }
 
+func TestPEXReactorSeedModeGracePeriod(t *testing.T) {
+	r, book := createReactor(&ReactorConfig{SeedMode: true, SeedGracePeriod: 200 * time.Millisecond})
+	defer teardownReactor(book)
+
+	sw := createSwitchAndAddReactors(r)
+	sw.SetAddrBook(book)
+
+	peer := mock.NewPeer(nil)
+	p2p.AddPeerToSwitchPeerSet(sw, peer)
+
+	// the request is answered and the peer kept connected for the grace period
+	r.Receive(p2p.Envelope{ChannelID: PexChannel, Src: peer, Message: &tmp2p.PexRequest{}})
+	time.Sleep(50 * time.Millisecond)
+	assert.True(t, sw.Peers().Has(peer.ID()))
+
+	// further requests are ignored
+	r.Receive(p2p.Envelope{ChannelID: PexChannel, Src: peer, Message: &tmp2p.PexRequest{}})
+	assert.True(t, sw.Peers().Has(peer.ID()))
+	assert.False(t, book.IsBanned(peer.SocketAddr()))
+
+	assert.Eventually(t, func() bool { return !sw.Peers().Has(peer.ID()) }, time.Second, 10*time.Millisecond)
+}
+
+// statusPeer is a mock peer advertising the seed status feature and recording
+// the messages sent to it.
+type statusPeer struct {
+	*mock.Peer
+	sent []p2p.Envelope
+}
+
+func (sp *statusPeer) NodeInfo() p2p.NodeInfo {
+	ni := sp.Peer.NodeInfo().(p2p.DefaultNodeInfo)
+	ni.Features |= p2p.FeatureSeedStatus
+	return ni
+}
+
+func (sp *statusPeer) Send(e p2p.Envelope) bool {
+	sp.sent = append(sp.sent, e)
+	return true
+}
+
+func testHeader(height int64) *types.Header {
+	return &types.Header{
+		Version:         cmtversion.Consensus{Block: version.BlockProtocol},
+		ChainID:         "test-chain",
+		Height:          height,
+		Time:            time.Now(),
+		ProposerAddress: crypto.AddressHash([]byte("proposer")),
+	}
+}
+
+func TestPEXReactorSeedStatus(t *testing.T) {
+	header := testHeader(10)
+	seed, seedBook := createReactor(&ReactorConfig{
+		SeedMode:     true,
+		LatestHeader: func() *types.Header { return header },
+	})
+	defer teardownReactor(seedBook)
+	createSwitchAndAddReactors(seed)
+
+	// the status is only sent to the inbound peers supporting it
+	peer := &statusPeer{Peer: mock.NewPeer(nil)}
+	seed.AddPeer(peer)
+	require.Len(t, peer.sent, 1)
+	msg := peer.sent[0].Message.(*tmp2p.PexStatus)
+	assert.EqualValues(t, 10, msg.Height)
+
+	outbound := &statusPeer{Peer: mock.NewPeer(nil)}
+	outbound.Outbound = true
+	seed.AddPeer(outbound)
+	for _, e := range outbound.sent {
+		assert.IsType(t, &tmp2p.PexRequest{}, e.Message)
+	}
+
+	r, book := createReactor(&ReactorConfig{})
+	defer teardownReactor(book)
+	sw := createSwitchAndAddReactors(r)
+	sw.SetAddrBook(book)
+	assert.Nil(t, r.LatestSeedStatus())
+
+	seedPeer := func() *mock.Peer {
+		p := mock.NewPeer(nil)
+		p.Outbound = true
+		r.seedAddrs = append(r.seedAddrs, p.SocketAddr())
+		p2p.AddPeerToSwitchPeerSet(sw, p)
+		return p
+	}
+	seeds := []*mock.Peer{seedPeer(), seedPeer(), seedPeer()}
+
+	// the status is only accepted from the seeds dialed
+	other := mock.NewPeer(nil)
+	other.Outbound = true
+	p2p.AddPeerToSwitchPeerSet(sw, other)
+	r.Receive(p2p.Envelope{ChannelID: PexChannel, Src: other, Message: msg})
+	assert.False(t, sw.Peers().Has(other.ID()))
+	assert.Nil(t, r.LatestSeedStatus())
+
+	r.Receive(p2p.Envelope{ChannelID: PexChannel, Src: seeds[0], Message: msg})
+	status := r.LatestSeedStatus()
+	require.NotNil(t, status)
+	assert.Equal(t, seeds[0].ID(), status.Peer)
+	assert.EqualValues(t, 10, status.Height)
+	assert.Equal(t, header.Hash(), status.Header.Hash())
+
+	// a single seed can't pin the status to an unverified height
+	r.Receive(p2p.Envelope{ChannelID: PexChannel, Src: seeds[1], Message: &tmp2p.PexStatus{Height: math.MaxInt64}})
+	assert.EqualValues(t, 10, r.LatestSeedStatus().Height)
+	r.Receive(p2p.Envelope{ChannelID: PexChannel, Src: seeds[2], Message: &tmp2p.PexStatus{Height: 12}})
+	assert.EqualValues(t, 12, r.LatestSeedStatus().Height)
+
+	// a header not matching the height is rejected
+	invalid := &tmp2p.PexStatus{Height: 11, Header: testHeader(12).ToProto()}
+	r.Receive(p2p.Envelope{ChannelID: PexChannel, Src: seeds[0], Message: invalid})
+	assert.False(t, sw.Peers().Has(seeds[0].ID()))
+	assert.EqualValues(t, 12, r.LatestSeedStatus().Height)
+}
+
 func TestPEXReactorDoesNotAddPrivatePeersToAddrBook(t *testing.T) {
 	peer := p2p.CreateRandomPeer(false)
 
@@ -702,6 +824,7 @@ func TestPexVectors(t *testing.T) {
 	}{
 		{"PexRequest", &tmp2p.PexRequest{}, "0a00"},
 		{"PexAddrs", &tmp2p.PexAddrs{Addrs: []tmp2p.NetAddress{addr}}, "12130a110a013112093132372e302e302e31188247"},
+		{"PexStatus", &tmp2p.PexStatus{Height: 1}, "1a020801"},
 	}
 
 	for _, tc := range testCases {
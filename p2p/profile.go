@@ -0,0 +1,242 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"runtime/pprof"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/profile"
+)
+
+const (
+	// ReactorProfileLabel is the profiler label set on the goroutines of the
+	// reactors, to the name they were added to the switch with, and on the
+	// goroutines of the switch and the peer connections, to "p2p".
+	ReactorProfileLabel = "reactor"
+
+	p2pProfileLabel = "p2p"
+	// otherProfileLabel accounts the samples of all the other goroutines.
+	otherProfileLabel = "other"
+)
+
+var p2pProfileLabels = pprof.WithLabels(context.Background(), pprof.Labels(ReactorProfileLabel, p2pProfileLabel))
+
+// profileLabeled is implemented by the reactors embedding BaseReactor.
+type profileLabeled interface {
+	setProfileLabels(name string)
+	profileLabelsContext() context.Context
+}
+
+// profileLabelsOf returns the profiler labels of reactor, or nil if it has
+// none.
+func profileLabelsOf(reactor Reactor) context.Context {
+	if r, ok := reactor.(profileLabeled); ok {
+		return r.profileLabelsContext()
+	}
+	return nil
+}
+
+// runWithProfileLabels runs f in a new goroutine with the profiler labels of
+// ctx, and waits for it to return. The goroutines f starts inherit the
+// labels, whatever the labels of the caller, which are left unchanged. If ctx
+// is nil, f is run directly.
+func runWithProfileLabels(ctx context.Context, f func()) {
+	if ctx == nil {
+		f()
+		return
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		pprof.SetGoroutineLabels(ctx)
+		f()
+	}()
+	<-done
+}
+
+// ReactorUsage is the share of the resources used by a reactor over a
+// profiling interval.
+type ReactorUsage struct {
+	Reactor    string  `json:"reactor"`
+	CPUSeconds float64 `json:"cpu_seconds"`
+	CPUShare   float64 `json:"cpu_share"`
+	AllocBytes int64   `json:"alloc_bytes"`
+	AllocShare float64 `json:"alloc_share"`
+}
+
+// ReactorProfile summarizes the resources used by each reactor over a
+// profiling interval, from the most CPU intensive to the least.
+type ReactorProfile struct {
+	Seconds  float64         `json:"seconds"`
+	Reactors []*ReactorUsage `json:"reactors"`
+}
+
+// ProfileReactors profiles the node for d, or until ctx is done, and returns
+// the shares of CPU time and allocated bytes of each reactor.
+//
+// CPU time is attributed by the profiler labels of the goroutines (see
+// ReactorProfileLabel). Allocations, which the runtime doesn't label, are
+// attributed to the reactor of the innermost function of their stack
+// belonging to the package of a reactor. The samples of the switch and the
+// peer connections are reported as "p2p", and the rest as "other".
+//
+// It fails if a CPU profile is already being taken, e.g. by
+// /debug/pprof/profile.
+func (sw *Switch) ProfileReactors(ctx context.Context, d time.Duration) (*ReactorProfile, error) {
+	before, err := allocsProfile()
+	if err != nil {
+		return nil, err
+	}
+	var cpu bytes.Buffer
+	if err := pprof.StartCPUProfile(&cpu); err != nil {
+		return nil, fmt.Errorf("starting CPU profile: %w", err)
+	}
+	start := time.Now()
+	select {
+	case <-time.After(d):
+	case <-ctx.Done():
+	}
+	pprof.StopCPUProfile()
+	elapsed := time.Since(start)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	after, err := allocsProfile()
+	if err != nil {
+		return nil, err
+	}
+	cpuProfile, err := profile.Parse(&cpu)
+	if err != nil {
+		return nil, fmt.Errorf("parsing CPU profile: %w", err)
+	}
+
+	usages := make(map[string]*ReactorUsage)
+	usage := func(reactor string) *ReactorUsage {
+		u, ok := usages[reactor]
+		if !ok {
+			u = &ReactorUsage{Reactor: reactor}
+			usages[reactor] = u
+		}
+		return u
+	}
+	for name := range sw.reactors {
+		usage(name)
+	}
+
+	var cpuTotal int64
+	if i := cpuProfile.Index("cpu"); i >= 0 {
+		for _, s := range cpuProfile.Samples {
+			reactor := s.Labels[ReactorProfileLabel]
+			if reactor == "" {
+				reactor = otherProfileLabel
+			}
+			usage(reactor).CPUSeconds += time.Duration(s.Values[i]).Seconds()
+			cpuTotal += s.Values[i]
+		}
+	}
+
+	var allocTotal int64
+	packages := sw.reactorPackages()
+	for stack, space := range allocDeltas(before, after) {
+		reactor := otherProfileLabel
+		for _, fn := range strings.Split(stack, "\n") {
+			if name, ok := packages[funcPackage(fn)]; ok {
+				reactor = name
+				break
+			}
+		}
+		usage(reactor).AllocBytes += space
+		allocTotal += space
+	}
+
+	p := &ReactorProfile{Seconds: elapsed.Seconds()}
+	for _, u := range usages {
+		if cpuTotal > 0 {
+			u.CPUShare = u.CPUSeconds / time.Duration(cpuTotal).Seconds()
+		}
+		if allocTotal > 0 {
+			u.AllocShare = float64(u.AllocBytes) / float64(allocTotal)
+		}
+		p.Reactors = append(p.Reactors, u)
+	}
+	sort.Slice(p.Reactors, func(i, j int) bool {
+		if p.Reactors[i].CPUSeconds != p.Reactors[j].CPUSeconds {
+			return p.Reactors[i].CPUSeconds > p.Reactors[j].CPUSeconds
+		}
+		return p.Reactors[i].Reactor < p.Reactors[j].Reactor
+	})
+	return p, nil
+}
+
+// reactorPackages maps the packages of the reactors and of the switch to
+// their names.
+func (sw *Switch) reactorPackages() map[string]string {
+	packages := map[string]string{
+		reflect.TypeOf(Switch{}).PkgPath():           p2pProfileLabel,
+		reflect.TypeOf(Switch{}).PkgPath() + "/conn": p2pProfileLabel,
+	}
+	for name, reactor := range sw.reactors {
+		t := reflect.TypeOf(reactor)
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		packages[t.PkgPath()] = name
+	}
+	return packages
+}
+
+// funcPackage returns the package of the function named fn in a profile,
+// e.g. "github.com/cometbft/cometbft/p2p" for
+// "github.com/cometbft/cometbft/p2p.(*Switch).OnStart".
+func funcPackage(fn string) string {
+	slash := strings.LastIndex(fn, "/") + 1
+	if dot := strings.Index(fn[slash:], "."); dot >= 0 {
+		return fn[:slash+dot]
+	}
+	return fn
+}
+
+func allocsProfile() (*profile.Profile, error) {
+	var buf bytes.Buffer
+	if err := pprof.Lookup("allocs").WriteTo(&buf, 0); err != nil {
+		return nil, fmt.Errorf("writing allocs profile: %w", err)
+	}
+	p, err := profile.Parse(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("parsing allocs profile: %w", err)
+	}
+	return p, nil
+}
+
+// allocSpace returns the bytes allocated since the program started by each
+// stack of an allocs profile, the names of its functions from the innermost
+// being joined by newlines.
+func allocSpace(p *profile.Profile) map[string]int64 {
+	space := make(map[string]int64)
+	i := p.Index("alloc_space")
+	if i < 0 {
+		return space
+	}
+	for _, s := range p.Samples {
+		space[strings.Join(s.Stack, "\n")] += s.Values[i]
+	}
+	return space
+}
+
+// allocDeltas returns the bytes allocated by each stack between two allocs
+// profiles.
+func allocDeltas(before, after *profile.Profile) map[string]int64 {
+	prev := allocSpace(before)
+	deltas := make(map[string]int64)
+	for stack, space := range allocSpace(after) {
+		if delta := space - prev[stack]; delta > 0 {
+			deltas[stack] = delta
+		}
+	}
+	return deltas
+}
@@ -0,0 +1,82 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+// busyReactor spins a goroutine started by OnStart until it stops.
+type busyReactor struct {
+	BaseReactor
+}
+
+func (r *busyReactor) OnStart() error {
+	go func() {
+		for {
+			select {
+			case <-r.Quit():
+				return
+			default:
+			}
+			for i := 0; i < 1e5; i++ {
+				_ = i * i
+			}
+		}
+	}()
+	return nil
+}
+
+func TestSwitchProfileReactors(t *testing.T) {
+	busy := &busyReactor{}
+	busy.BaseReactor = *NewBaseReactor("Busy", busy)
+	busy.SetLogger(log.TestingLogger())
+	sw := MakeSwitch(cfg, 1, func(_ int, sw *Switch) *Switch {
+		sw.AddReactor("BUSY", busy)
+		sw.AddReactor("IDLE", NewTestReactor(nil, false))
+		return sw
+	})
+	require.NoError(t, sw.Start())
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	p, err := sw.ProfileReactors(context.Background(), 500*time.Millisecond)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.5, p.Seconds, 0.2)
+	require.NotEmpty(t, p.Reactors)
+	assert.Equal(t, "BUSY", p.Reactors[0].Reactor)
+	assert.Greater(t, p.Reactors[0].CPUShare, 0.5)
+	var names []string
+	for _, u := range p.Reactors {
+		names = append(names, u.Reactor)
+	}
+	assert.Contains(t, names, "IDLE")
+
+	// a single CPU profile can be taken at once
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := sw.ProfileReactors(ctx, time.Minute)
+		assert.ErrorIs(t, err, context.Canceled)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	_, err = sw.ProfileReactors(context.Background(), time.Millisecond)
+	assert.Error(t, err)
+	cancel()
+	<-done
+}
+
+func TestFuncPackage(t *testing.T) {
+	assert.Equal(t, "github.com/cometbft/cometbft/p2p", funcPackage("github.com/cometbft/cometbft/p2p.(*Switch).OnStart"))
+	assert.Equal(t, "github.com/cometbft/cometbft/p2p/conn", funcPackage("github.com/cometbft/cometbft/p2p/conn.(*MConnection).recvRoutine.func1"))
+	assert.Equal(t, "runtime", funcPackage("runtime.mallocgc"))
+}
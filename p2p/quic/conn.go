@@ -0,0 +1,393 @@
+// Package quic implements the connections between peers over QUIC.
+//
+// Nodes listen and dial from a single UDP socket. The connections are
+// encrypted and authenticated by TLS 1.3, each node presenting a certificate
+// of its node key. On top of a connection, a bidirectional control stream,
+// opened by the dialer, carries the handshake of the nodes, and each channel
+// is carried by a unidirectional stream in each direction, so that a slow
+// channel does not hold back the others.
+//
+// Connections to peers dialed before are resumed in 0-RTT: the handshake of
+// the nodes is sent along with the first packet, saving a round trip.
+package quic
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	quicgo "github.com/quic-go/quic-go"
+
+	"github.com/cometbft/cometbft/crypto"
+)
+
+// maxChannels is the number of channels a peer can open a stream for.
+const maxChannels = 256
+
+// Config is the configuration of the QUIC connections of a node.
+type Config struct {
+	// Time allowed for the QUIC handshake.
+	HandshakeTimeout time.Duration
+	// Period of the keep-alive packets sent on idle connections.
+	KeepAlivePeriod time.Duration
+	// Time after which a connection without traffic is closed.
+	IdleTimeout time.Duration
+	// Maximum number of connections accepted and open at the same time.
+	// Zero means no limit.
+	MaxIncomingConnections int
+}
+
+// Listener accepts the connections of the peers, and dials them, on a UDP
+// socket. It implements net.Listener.
+type Listener struct {
+	udpConn  *net.UDPConn
+	tr       *quicgo.Transport
+	ln       *quicgo.EarlyListener
+	tlsConf  *tls.Config
+	quicConf *quicgo.Config
+
+	// slots of the connections accepted, nil if they are not limited
+	slots     chan struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+var _ net.Listener = (*Listener)(nil)
+
+// Listen listens on the UDP address addr for the connections of the peers of
+// the node with the given key.
+func Listen(addr string, privKey crypto.PrivKey, config Config) (*Listener, error) {
+	tlsConf, err := newTLSConfig(privKey)
+	if err != nil {
+		return nil, err
+	}
+	udpAddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	udpConn, err := net.ListenUDP("udp", udpAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Listener{
+		udpConn: udpConn,
+		tr:      &quicgo.Transport{Conn: udpConn},
+		tlsConf: tlsConf,
+		quicConf: &quicgo.Config{
+			HandshakeIdleTimeout:  config.HandshakeTimeout,
+			MaxIdleTimeout:        config.IdleTimeout,
+			KeepAlivePeriod:       config.KeepAlivePeriod,
+			MaxIncomingStreams:    1, // the control stream
+			MaxIncomingUniStreams: maxChannels,
+			Allow0RTT:             true,
+		},
+		closed: make(chan struct{}),
+	}
+	if config.MaxIncomingConnections > 0 {
+		l.slots = make(chan struct{}, config.MaxIncomingConnections)
+	}
+	l.ln, err = l.tr.ListenEarly(l.tlsConf, l.quicConf)
+	if err != nil {
+		_ = l.tr.Close()
+		_ = udpConn.Close()
+		return nil, err
+	}
+	return l, nil
+}
+
+// Accept returns the next connection accepted, as a *Conn. The identity of
+// the peer is verified once the QUIC handshake completes, see
+// Conn.RemotePubKey.
+func (l *Listener) Accept() (net.Conn, error) {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-l.closed:
+			return nil, net.ErrClosed
+		}
+	}
+	qc, err := l.ln.Accept(context.Background())
+	if err != nil {
+		l.release()
+		return nil, err
+	}
+	return newConn(qc, false, l.release), nil
+}
+
+func (l *Listener) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// Dial dials the node with the given ID at the UDP address addr, from the
+// socket of the listener. Sessions are resumed by ID, the connections to
+// nodes dialed before being established in 0-RTT. The identity of the peer
+// is verified once the QUIC handshake completes, see Conn.RemotePubKey.
+func (l *Listener) Dial(ctx context.Context, addr string, id string) (*Conn, error) {
+	udpAddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	tlsConf := l.tlsConf.Clone()
+	tlsConf.ServerName = id
+	qc, err := l.tr.DialEarly(ctx, udpAddr, tlsConf, l.quicConf)
+	if err != nil {
+		return nil, err
+	}
+	return newConn(qc, true, nil), nil
+}
+
+// Close closes the listener and the socket, along with all the connections.
+func (l *Listener) Close() error {
+	l.closeOnce.Do(func() { close(l.closed) })
+	err := l.tr.Close()
+	if cerr := l.udpConn.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Addr returns the UDP address of the listener.
+func (l *Listener) Addr() net.Addr {
+	return l.ln.Addr()
+}
+
+// Conn is a QUIC connection to a peer. It implements net.Conn over the
+// control stream of the connection, which the nodes handshake on.
+type Conn struct {
+	qc        *quicgo.Conn
+	outbound  bool
+	release   func()
+	closeOnce sync.Once
+
+	mtx           sync.Mutex
+	stream        *quicgo.Stream // control stream, opened on first use
+	readDeadline  time.Time
+	writeDeadline time.Time
+	// data written on the control stream before the handshake completed,
+	// sent in 0-RTT, to be written again if the peer rejects it
+	early []byte
+}
+
+var _ net.Conn = (*Conn)(nil)
+
+func newConn(qc *quicgo.Conn, outbound bool, release func()) *Conn {
+	return &Conn{qc: qc, outbound: outbound, release: release}
+}
+
+// RemotePubKey waits for the QUIC handshake to complete and returns the key
+// the peer authenticated with.
+func (c *Conn) RemotePubKey() (crypto.PubKey, error) {
+	c.mtx.Lock()
+	deadline := c.readDeadline
+	c.mtx.Unlock()
+	ctx, cancel := deadlineContext(deadline)
+	defer cancel()
+	select {
+	case <-c.qc.HandshakeComplete():
+	case <-c.qc.Context().Done():
+		return nil, context.Cause(c.qc.Context())
+	case <-ctx.Done():
+		return nil, fmt.Errorf("handshake: %w", ctx.Err())
+	}
+	return pubKeyOf(c.qc.ConnectionState().TLS)
+}
+
+// Used0RTT returns true if the connection was established in 0-RTT. It is
+// only meaningful once the QUIC handshake completed.
+func (c *Conn) Used0RTT() bool {
+	return c.qc.ConnectionState().Used0RTT
+}
+
+// Read reads from the control stream.
+func (c *Conn) Read(b []byte) (int, error) {
+	st, err := c.controlStream()
+	if err != nil {
+		return 0, err
+	}
+	n, err := st.Read(b)
+	if errors.Is(err, quicgo.Err0RTTRejected) {
+		if err := c.reconnect(st); err != nil {
+			return 0, err
+		}
+		return c.Read(b)
+	}
+	return n, err
+}
+
+// Write writes to the control stream.
+func (c *Conn) Write(b []byte) (int, error) {
+	c.mtx.Lock()
+	st, err := c.controlStreamLocked()
+	if err == nil && c.outbound && !c.handshakeComplete() {
+		if len(c.early) == 0 {
+			go c.replayIfRejected(st)
+		}
+		c.early = append(c.early, b...)
+	}
+	c.mtx.Unlock()
+	if err != nil {
+		return 0, err
+	}
+	n, err := st.Write(b)
+	if errors.Is(err, quicgo.Err0RTTRejected) {
+		// b is written again by reconnect
+		if err := c.reconnect(st); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	return n, err
+}
+
+// Close closes the connection.
+func (c *Conn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		err = c.qc.CloseWithError(0, "")
+		if c.release != nil {
+			c.release()
+		}
+	})
+	return err
+}
+
+// LocalAddr returns the local UDP address.
+func (c *Conn) LocalAddr() net.Addr {
+	return c.qc.LocalAddr()
+}
+
+// RemoteAddr returns the UDP address of the peer.
+func (c *Conn) RemoteAddr() net.Addr {
+	return c.qc.RemoteAddr()
+}
+
+// SetDeadline sets the deadlines of the control stream, and of the QUIC
+// handshake awaited by RemotePubKey.
+func (c *Conn) SetDeadline(t time.Time) error {
+	if err := c.SetReadDeadline(t); err != nil {
+		return err
+	}
+	return c.SetWriteDeadline(t)
+}
+
+// SetReadDeadline sets the read deadline of the control stream.
+func (c *Conn) SetReadDeadline(t time.Time) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.readDeadline = t
+	if c.stream != nil {
+		return c.stream.SetReadDeadline(t)
+	}
+	return nil
+}
+
+// SetWriteDeadline sets the write deadline of the control stream.
+func (c *Conn) SetWriteDeadline(t time.Time) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.writeDeadline = t
+	if c.stream != nil {
+		return c.stream.SetWriteDeadline(t)
+	}
+	return nil
+}
+
+func (c *Conn) controlStream() (*quicgo.Stream, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.controlStreamLocked()
+}
+
+// controlStreamLocked returns the control stream, opening it on the
+// outbound connections and accepting it on the inbound ones.
+func (c *Conn) controlStreamLocked() (*quicgo.Stream, error) {
+	if c.stream != nil {
+		return c.stream, nil
+	}
+	var (
+		st  *quicgo.Stream
+		err error
+	)
+	if c.outbound {
+		st, err = c.qc.OpenStream()
+	} else {
+		ctx, cancel := deadlineContext(c.readDeadline)
+		defer cancel()
+		st, err = c.qc.AcceptStream(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	_ = st.SetReadDeadline(c.readDeadline)
+	_ = st.SetWriteDeadline(c.writeDeadline)
+	c.stream = st
+	return st, nil
+}
+
+// reconnect resumes the connection after the peer rejected the 0-RTT data,
+// writing the data sent in 0-RTT on a new control stream. failed is the
+// stream the rejection was reported on.
+func (c *Conn) reconnect(failed *quicgo.Stream) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.stream != failed {
+		// already resumed
+		return nil
+	}
+	ctx, cancel := deadlineContext(c.readDeadline)
+	defer cancel()
+	if _, err := c.qc.NextConnection(ctx); err != nil {
+		return err
+	}
+	c.stream = nil
+	st, err := c.controlStreamLocked()
+	if err != nil {
+		return err
+	}
+	_, err = st.Write(c.early)
+	c.early = nil
+	return err
+}
+
+// replayIfRejected waits for the handshake to complete and resumes the
+// connection if the peer rejected the 0-RTT data written on st, which it
+// waits for.
+func (c *Conn) replayIfRejected(st *quicgo.Stream) {
+	select {
+	case <-c.qc.HandshakeComplete():
+	case <-c.qc.Context().Done():
+		return
+	}
+	if c.Used0RTT() {
+		c.mtx.Lock()
+		c.early = nil
+		c.mtx.Unlock()
+		return
+	}
+	if err := c.reconnect(st); err != nil {
+		_ = c.Close()
+	}
+}
+
+func (c *Conn) handshakeComplete() bool {
+	select {
+	case <-c.qc.HandshakeComplete():
+		return true
+	default:
+		return false
+	}
+}
+
+func deadlineContext(deadline time.Time) (context.Context, context.CancelFunc) {
+	if deadline.IsZero() {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithDeadline(context.Background(), deadline)
+}
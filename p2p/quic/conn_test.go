@@ -0,0 +1,292 @@
+package quic
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p/conn"
+)
+
+var testConfig = Config{
+	HandshakeTimeout: time.Second,
+	KeepAlivePeriod:  time.Second,
+	IdleTimeout:      5 * time.Second,
+}
+
+func listen(t *testing.T, privKey crypto.PrivKey) *Listener {
+	t.Helper()
+	l, err := Listen("127.0.0.1:0", privKey, testConfig)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	return l
+}
+
+// connect dials the node listening on server from client, and returns both
+// ends of the connection once the peers exchanged a message on the control
+// stream.
+func connect(t *testing.T, client, server *Listener) (*Conn, *Conn) {
+	t.Helper()
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		c, err := server.Accept()
+		if err != nil {
+			t.Error(err)
+		}
+		accepted <- c
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	dialed, err := client.Dial(ctx, server.Addr().String(), "server")
+	require.NoError(t, err)
+	_, err = dialed.Write([]byte("ping"))
+	require.NoError(t, err)
+
+	in := (<-accepted).(*Conn)
+	require.NoError(t, in.SetDeadline(time.Now().Add(time.Second)))
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(in, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(buf))
+	_, err = in.Write([]byte("pong"))
+	require.NoError(t, err)
+	_, err = io.ReadFull(dialed, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "pong", string(buf))
+	return dialed, in
+}
+
+func TestConnIdentity(t *testing.T) {
+	clientKey, serverKey := ed25519.GenPrivKey(), ed25519.GenPrivKey()
+	client, server := listen(t, clientKey), listen(t, serverKey)
+
+	dialed, accepted := connect(t, client, server)
+	pubKey, err := dialed.RemotePubKey()
+	require.NoError(t, err)
+	assert.Equal(t, serverKey.PubKey(), pubKey)
+	pubKey, err = accepted.RemotePubKey()
+	require.NoError(t, err)
+	assert.Equal(t, clientKey.PubKey(), pubKey)
+
+	_, err = Listen("127.0.0.1:0", secp256k1.GenPrivKey(), testConfig)
+	require.Error(t, err)
+}
+
+func TestConnResumption(t *testing.T) {
+	client, server := listen(t, ed25519.GenPrivKey()), listen(t, ed25519.GenPrivKey())
+
+	dialed, accepted := connect(t, client, server)
+	assert.False(t, dialed.Used0RTT())
+	require.NoError(t, dialed.Close())
+	require.NoError(t, accepted.Close())
+
+	// the session of the first connection is resumed in 0-RTT
+	dialed, _ = connect(t, client, server)
+	_, err := dialed.RemotePubKey()
+	require.NoError(t, err)
+	assert.True(t, dialed.Used0RTT())
+
+	// the handshake is sent again to a restarted server, rejecting 0-RTT
+	addr := server.Addr().String()
+	require.NoError(t, server.Close())
+	server, err = Listen(addr, ed25519.GenPrivKey(), testConfig)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = server.Close() })
+	dialed, _ = connect(t, client, server)
+	assert.False(t, dialed.Used0RTT())
+}
+
+func TestListenerMaxIncomingConnections(t *testing.T) {
+	config := testConfig
+	config.MaxIncomingConnections = 1
+	server, err := Listen("127.0.0.1:0", ed25519.GenPrivKey(), config)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = server.Close() })
+	client := listen(t, ed25519.GenPrivKey())
+
+	_, accepted := connect(t, client, server)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c, err := server.Accept()
+		if err == nil {
+			_ = c.Close()
+		}
+	}()
+	select {
+	case <-done:
+		t.Fatal("accepted a connection over the limit")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// the slot of a closed connection is released
+	require.NoError(t, accepted.Close())
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = client.Dial(ctx, server.Addr().String(), "server")
+	require.NoError(t, err)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("connection not accepted")
+	}
+}
+
+type received struct {
+	mtx  sync.Mutex
+	msgs map[byte][]string
+}
+
+func (r *received) onReceive(chID byte, msgBytes []byte) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.msgs[chID] = append(r.msgs[chID], string(msgBytes))
+}
+
+func (r *received) get(chID byte) []string {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return append([]string(nil), r.msgs[chID]...)
+}
+
+func TestMConnection(t *testing.T) {
+	client, server := listen(t, ed25519.GenPrivKey()), listen(t, ed25519.GenPrivKey())
+	dialed, accepted := connect(t, client, server)
+
+	chDescs := []*conn.ChannelDescriptor{
+		{ID: 0x01, Priority: 1, RecvMessageCapacity: 1024},
+		{ID: 0x02, Priority: 1, RecvMessageCapacity: 1024},
+	}
+	errors := make(chan any, 2)
+	onError := func(r any) { errors <- r }
+	recv := &received{msgs: make(map[byte][]string)}
+	sender := NewMConnection(dialed, chDescs, func(byte, []byte) {}, onError, conn.DefaultMConnConfig())
+	receiver := NewMConnection(accepted, chDescs, recv.onReceive, onError, conn.DefaultMConnConfig())
+	for _, c := range []*MConnection{sender, receiver} {
+		c.SetLogger(log.TestingLogger())
+		require.NoError(t, c.Start())
+	}
+	t.Cleanup(func() {
+		_ = receiver.Stop()
+	})
+
+	assert.True(t, sender.CanSend(0x01))
+	assert.False(t, sender.Send(0x03, []byte("unknown channel")))
+	for _, msg := range []string{"a", "b", "c"} {
+		assert.True(t, sender.Send(0x01, []byte(msg)))
+	}
+	assert.True(t, sender.TrySend(0x02, []byte("d")))
+	require.Eventually(t, func() bool {
+		return len(recv.get(0x01)) == 3 && len(recv.get(0x02)) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"a", "b", "c"}, recv.get(0x01))
+	assert.Equal(t, []string{"d"}, recv.get(0x02))
+	assert.Equal(t, 2, len(sender.Status().Channels))
+
+	// the messages queued are received before the connection is closed
+	for i := 0; i < 100; i++ {
+		assert.True(t, sender.Send(0x01, []byte("e")))
+	}
+	sender.FlushStop()
+	assert.Len(t, recv.get(0x01), 103)
+	select {
+	case err := <-errors:
+		// the receiver stops as the sender closed its streams
+		assert.Equal(t, io.EOF, err)
+	case <-time.After(time.Second):
+		t.Fatal("receiver did not stop")
+	}
+}
+
+func TestMConnectionRecvMessageCapacity(t *testing.T) {
+	client, server := listen(t, ed25519.GenPrivKey()), listen(t, ed25519.GenPrivKey())
+	dialed, accepted := connect(t, client, server)
+
+	chDescs := []*conn.ChannelDescriptor{{ID: 0x01, Priority: 1, RecvMessageCapacity: 8}}
+	errors := make(chan any, 1)
+	sender := NewMConnection(dialed, chDescs, func(byte, []byte) {}, func(any) {}, conn.DefaultMConnConfig())
+	receiver := NewMConnection(accepted, chDescs, func(byte, []byte) {}, func(r any) { errors <- r }, conn.DefaultMConnConfig())
+	for _, c := range []*MConnection{sender, receiver} {
+		c.SetLogger(log.TestingLogger())
+		require.NoError(t, c.Start())
+		t.Cleanup(func() { _ = c.Stop() })
+	}
+
+	assert.True(t, sender.Send(0x01, []byte("too large message")))
+	select {
+	case err := <-errors:
+		assert.ErrorContains(t, err.(error), "exceeds the capacity")
+	case <-time.After(time.Second):
+		t.Fatal("receiver did not stop")
+	}
+}
+
+func TestMConnectionSecondStream(t *testing.T) {
+	client, server := listen(t, ed25519.GenPrivKey()), listen(t, ed25519.GenPrivKey())
+	dialed, accepted := connect(t, client, server)
+
+	chDescs := []*conn.ChannelDescriptor{{ID: 0x01, Priority: 1, RecvMessageCapacity: 1024}}
+	errors := make(chan any, 1)
+	msgs := make(chan []byte, 2)
+	receiver := NewMConnection(accepted, chDescs, func(_ byte, msg []byte) { msgs <- msg },
+		func(r any) { errors <- r }, conn.DefaultMConnConfig())
+	receiver.SetLogger(log.TestingLogger())
+	require.NoError(t, receiver.Start())
+	t.Cleanup(func() { _ = receiver.Stop() })
+
+	// the peer opens two streams for the channel
+	for i := 0; i < 2; i++ {
+		st, err := dialed.qc.OpenUniStreamSync(context.Background())
+		require.NoError(t, err)
+		_, err = st.Write([]byte{0x01, 1, 'a'})
+		require.NoError(t, err)
+		if i == 0 {
+			assert.Equal(t, []byte("a"), <-msgs)
+		}
+	}
+	select {
+	case err := <-errors:
+		assert.ErrorContains(t, err.(error), "second stream")
+	case <-time.After(time.Second):
+		t.Fatal("receiver did not stop")
+	}
+	assert.Empty(t, msgs)
+}
+
+func TestMConnectionChannelRecvLimits(t *testing.T) {
+	client, server := listen(t, ed25519.GenPrivKey()), listen(t, ed25519.GenPrivKey())
+	dialed, accepted := connect(t, client, server)
+
+	chDescs := []*conn.ChannelDescriptor{{ID: 0x01, Priority: 1, RecvMessageCapacity: 1024}}
+	recvConfig := conn.DefaultMConnConfig()
+	recvConfig.ChannelRecvLimits = map[byte]config.ChannelRecvLimit{0x01: {MsgsPerSecond: 1}}
+	errors := make(chan any, 1)
+	sender := NewMConnection(dialed, chDescs, func(byte, []byte) {}, func(any) {}, conn.DefaultMConnConfig())
+	receiver := NewMConnection(accepted, chDescs, func(byte, []byte) {}, func(r any) { errors <- r }, recvConfig)
+	for _, c := range []*MConnection{sender, receiver} {
+		c.SetLogger(log.TestingLogger())
+		require.NoError(t, c.Start())
+		t.Cleanup(func() { _ = c.Stop() })
+	}
+
+	assert.True(t, sender.Send(0x01, []byte("a")))
+	assert.True(t, sender.Send(0x01, []byte("b")))
+	select {
+	case err := <-errors:
+		assert.ErrorIs(t, err.(error), conn.ErrChannelRecvRateExceeded)
+	case <-time.After(time.Second):
+		t.Fatal("receiver did not stop")
+	}
+}
@@ -0,0 +1,477 @@
+package quic
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	quicgo "github.com/quic-go/quic-go"
+
+	flow "github.com/cometbft/cometbft/libs/flowrate"
+	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/p2p/conn"
+)
+
+const (
+	sendTimeout = 10 * time.Second
+	updateStats = 2 * time.Second
+
+	// flushTimeout bounds the time FlushStop waits for the peer to read the
+	// messages flushed and close the connection.
+	flushTimeout = 5 * time.Second
+)
+
+// ReceiveFunc is called with the messages received on a channel.
+type ReceiveFunc func(chID byte, msgBytes []byte)
+
+// ErrorFunc is called with the error the connection stopped for.
+type ErrorFunc func(any)
+
+// MConnection multiplexes the channels of a peer over the streams of a QUIC
+// connection, as conn.MConnection does over a TCP connection. Each channel
+// is carried by a unidirectional stream in each direction, opened with the
+// first message sent on the channel, starting with the channel ID and on
+// which the messages are delimited by their varint length. A peer opening a
+// second stream for a channel is disconnected, so that the messages of a
+// channel are received by a single routine.
+//
+// As the streams are independent, the messages of different channels are
+// received concurrently, and a message delayed on a channel does not delay
+// the messages of the other channels. The streams are flow and congestion
+// controlled by QUIC; the send and receive rates of the connection, and the
+// receive rates of the channels, are limited as configured.
+type MConnection struct {
+	service.BaseService
+
+	conn        *Conn
+	channels    map[byte]*channel
+	channelList []*channel
+	onReceive   ReceiveFunc
+	onError     ErrorFunc
+	config      conn.MConnConfig
+	errored     atomic.Bool
+
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+	created     time.Time
+
+	// canceled on stop, aborting the streams being opened or accepted
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	stopMtx  sync.Mutex
+	quit     chan struct{} // closed on stop
+	flushing chan struct{} // closed by FlushStop, for the queues to be drained
+	sendWg   sync.WaitGroup
+
+	sendStreams atomic.Int32 // streams opened to send
+	recvStreams atomic.Int32 // streams of the peer being read
+}
+
+type channel struct {
+	desc          conn.ChannelDescriptor
+	sendQueue     chan []byte
+	sendQueueSize atomic.Int32
+	recentlySent  atomic.Int64 // exponential moving average
+
+	receiving   atomic.Bool // set once a stream of the peer carries the channel
+	recvLimiter *conn.ChannelRecvLimiter
+}
+
+// NewMConnection returns a connection multiplexing the channels described
+// over the QUIC connection c. onReceive is called with the messages
+// received, from a routine per channel.
+func NewMConnection(
+	c *Conn,
+	chDescs []*conn.ChannelDescriptor,
+	onReceive ReceiveFunc,
+	onError ErrorFunc,
+	config conn.MConnConfig,
+) *MConnection {
+	ctx, cancel := context.WithCancel(context.Background())
+	mconn := &MConnection{
+		conn:        c,
+		channels:    make(map[byte]*channel, len(chDescs)),
+		onReceive:   onReceive,
+		onError:     onError,
+		config:      config,
+		sendMonitor: flow.New(0, 0),
+		recvMonitor: flow.New(0, 0),
+		created:     time.Now(),
+		ctx:         ctx,
+		cancel:      cancel,
+		quit:        make(chan struct{}),
+		flushing:    make(chan struct{}),
+	}
+	for _, desc := range chDescs {
+		desc := desc.FillDefaults()
+		ch := &channel{
+			desc:        desc,
+			sendQueue:   make(chan []byte, desc.SendQueueCapacity),
+			recvLimiter: conn.NewChannelRecvLimiter(config.ChannelRecvLimits[desc.ID]),
+		}
+		mconn.channels[desc.ID] = ch
+		mconn.channelList = append(mconn.channelList, ch)
+	}
+	mconn.BaseService = *service.NewBaseService(nil, "QUICMConnection", mconn)
+	return mconn
+}
+
+// OnStart implements BaseService.
+func (c *MConnection) OnStart() error {
+	if err := c.BaseService.OnStart(); err != nil {
+		return err
+	}
+	for _, ch := range c.channelList {
+		c.sendWg.Add(1)
+		go c.sendRoutine(ch)
+	}
+	go c.acceptRoutine()
+	go c.statsRoutine()
+	return nil
+}
+
+// stopServices closes quit, returning true if it was already closed.
+func (c *MConnection) stopServices() (alreadyStopped bool) {
+	c.stopMtx.Lock()
+	defer c.stopMtx.Unlock()
+	select {
+	case <-c.quit:
+		return true
+	default:
+	}
+	c.BaseService.OnStop()
+	close(c.quit)
+	c.cancel()
+	return false
+}
+
+// FlushStop stops the connection, as OnStop, once all the messages queued by
+// successful Send calls are sent and read by the peer, or after flushTimeout.
+func (c *MConnection) FlushStop() {
+	c.stopMtx.Lock()
+	select {
+	case <-c.quit:
+		c.stopMtx.Unlock()
+		return
+	case <-c.flushing:
+		c.stopMtx.Unlock()
+		return
+	default:
+	}
+	close(c.flushing)
+	c.stopMtx.Unlock()
+
+	// The send routines drain the queues and close their streams, which the
+	// peer stops for once it read them.
+	c.sendWg.Wait()
+	if c.sendStreams.Load() > 0 {
+		select {
+		case <-c.conn.qc.Context().Done():
+		case <-time.After(flushTimeout):
+		}
+	}
+
+	c.stopServices()
+	_ = c.conn.Close()
+}
+
+// OnStop implements BaseService.
+func (c *MConnection) OnStop() {
+	if c.stopServices() {
+		return
+	}
+	_ = c.conn.Close()
+}
+
+func (c *MConnection) String() string {
+	return fmt.Sprintf("QUICMConn{%v}", c.conn.RemoteAddr())
+}
+
+// Send queues a message to be sent on the channel, waiting up to
+// sendTimeout for room in the queue. Returns false if the message was not
+// queued.
+func (c *MConnection) Send(chID byte, msgBytes []byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+	ch, ok := c.channels[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+	select {
+	case ch.sendQueue <- msgBytes:
+		ch.sendQueueSize.Add(1)
+		return true
+	case <-time.After(sendTimeout):
+		c.Logger.Debug("Send failed", "channel", chID, "conn", c)
+		return false
+	}
+}
+
+// TrySend queues a message to be sent on the channel, if there is room in
+// the queue. Returns false if the message was not queued.
+func (c *MConnection) TrySend(chID byte, msgBytes []byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+	ch, ok := c.channels[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+	select {
+	case ch.sendQueue <- msgBytes:
+		ch.sendQueueSize.Add(1)
+		return true
+	default:
+		return false
+	}
+}
+
+// CanSend returns true if there is room in the queue of the channel. Use
+// only as a heuristic.
+func (c *MConnection) CanSend(chID byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+	ch, ok := c.channels[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Unknown channel %X", chID))
+		return false
+	}
+	return int(ch.sendQueueSize.Load()) < ch.desc.SendQueueCapacity
+}
+
+// Status returns the status of the connection and of its channels.
+func (c *MConnection) Status() conn.ConnectionStatus {
+	status := conn.ConnectionStatus{
+		Duration:    time.Since(c.created),
+		SendMonitor: c.sendMonitor.Status(),
+		RecvMonitor: c.recvMonitor.Status(),
+		Channels:    make([]conn.ChannelStatus, len(c.channelList)),
+	}
+	for i, ch := range c.channelList {
+		status.Channels[i] = conn.ChannelStatus{
+			ID:                ch.desc.ID,
+			SendQueueCapacity: cap(ch.sendQueue),
+			SendQueueSize:     int(ch.sendQueueSize.Load()),
+			Priority:          ch.desc.Priority,
+			RecentlySent:      ch.recentlySent.Load(),
+		}
+	}
+	return status
+}
+
+// sendRoutine writes the messages queued on a channel to its stream.
+func (c *MConnection) sendRoutine(ch *channel) {
+	defer c.sendWg.Done()
+	var (
+		st  *quicgo.SendStream
+		buf []byte
+		err error
+	)
+	defer func() {
+		if st != nil {
+			// the peer reads the stream to its end
+			_ = st.Close()
+		}
+	}()
+
+	for {
+		var msg []byte
+		select {
+		case msg = <-ch.sendQueue:
+		case <-c.flushing:
+			for {
+				select {
+				case msg = <-ch.sendQueue:
+				default:
+					return
+				}
+				if st, buf, err = c.sendMsg(ch, st, buf, msg); err != nil {
+					return
+				}
+			}
+		case <-c.quit:
+			return
+		}
+		if st, buf, err = c.sendMsg(ch, st, buf, msg); err != nil {
+			c.stopForError(err)
+			return
+		}
+	}
+}
+
+// sendMsg writes msg to the stream st of the channel, opening it if nil.
+// buf is reused to encode the message.
+func (c *MConnection) sendMsg(
+	ch *channel,
+	st *quicgo.SendStream,
+	buf []byte,
+	msg []byte,
+) (*quicgo.SendStream, []byte, error) {
+	ch.sendQueueSize.Add(-1)
+	buf = buf[:0]
+	if st == nil {
+		var err error
+		st, err = c.conn.qc.OpenUniStreamSync(c.ctx)
+		if err != nil {
+			return nil, buf, err
+		}
+		c.sendStreams.Add(1)
+		buf = append(buf, ch.desc.ID)
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(msg)))
+	buf = append(buf, msg...)
+
+	for bz := buf; len(bz) > 0; {
+		n := c.sendMonitor.Limit(len(bz), c.config.SendRate, true)
+		n, err := st.Write(bz[:n])
+		c.sendMonitor.Update(n)
+		ch.recentlySent.Add(int64(n))
+		if err != nil {
+			return st, buf, err
+		}
+		bz = bz[n:]
+	}
+	return st, buf, nil
+}
+
+// acceptRoutine accepts the streams opened by the peer.
+func (c *MConnection) acceptRoutine() {
+	for {
+		st, err := c.conn.qc.AcceptUniStream(c.ctx)
+		if err != nil {
+			c.stopForRecvError(err)
+			return
+		}
+		c.recvStreams.Add(1)
+		go c.recvRoutine(st)
+	}
+}
+
+// recvRoutine reads the messages of a channel from a stream of the peer.
+func (c *MConnection) recvRoutine(st *quicgo.ReceiveStream) {
+	defer c._recover()
+
+	r := bufio.NewReader(&rateLimitedReader{r: st, m: c.recvMonitor, rate: c.config.RecvRate})
+	chID, err := r.ReadByte()
+	if err != nil {
+		c.stopForRecvError(err)
+		return
+	}
+	ch, ok := c.channels[chID]
+	if !ok {
+		c.stopForError(fmt.Errorf("unknown channel %X", chID))
+		return
+	}
+	if !ch.receiving.CompareAndSwap(false, true) {
+		c.stopForError(fmt.Errorf("second stream for channel %X", chID))
+		return
+	}
+
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			if errors.Is(err, io.EOF) && c.recvStreams.Add(-1) == 0 {
+				// The peer closes its streams as it stops, once they are
+				// flushed.
+				c.stopForRecvError(io.EOF)
+				return
+			}
+			c.stopForRecvError(err)
+			return
+		}
+		if size > uint64(ch.desc.RecvMessageCapacity) || size > math.MaxInt32 {
+			c.stopForError(fmt.Errorf("message of %d bytes exceeds the capacity of channel %X", size, chID))
+			return
+		}
+		msg, err := conn.ReadMsg(r, int(size))
+		if err != nil {
+			c.stopForRecvError(err)
+			return
+		}
+		if wait := ch.recvLimiter.Wait(len(msg), time.Now()); wait > 0 {
+			if !c.config.ThrottleChannelRecv {
+				c.stopForError(fmt.Errorf("%w: channel %X", conn.ErrChannelRecvRateExceeded, chID))
+				return
+			}
+			select {
+			case <-time.After(wait):
+			case <-c.quit:
+				return
+			}
+			ch.recvLimiter.Wait(len(msg), time.Now())
+		}
+		c.onReceive(chID, msg)
+	}
+}
+
+// statsRoutine decays the amount of data recently sent on the channels.
+func (c *MConnection) statsRoutine() {
+	ticker := time.NewTicker(updateStats)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, ch := range c.channelList {
+				ch.recentlySent.Store(int64(float64(ch.recentlySent.Load()) * 0.8))
+			}
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// stopForRecvError stops the connection for an error receiving from the
+// peer, unless it is stopping, the errors being caused by the stop.
+func (c *MConnection) stopForRecvError(err error) {
+	select {
+	case <-c.quit:
+	case <-c.flushing:
+	default:
+		c.stopForError(err)
+	}
+}
+
+// Catch panics of the receive callback.
+func (c *MConnection) _recover() {
+	if r := recover(); r != nil {
+		c.Logger.Error("MConnection panicked", "err", r, "stack", string(debug.Stack()))
+		c.stopForError(fmt.Errorf("recovered from panic: %v", r))
+	}
+}
+
+func (c *MConnection) stopForError(r any) {
+	if err := c.Stop(); err != nil && !errors.Is(err, service.ErrAlreadyStopped) {
+		c.Logger.Error("Error stopping connection", "err", err)
+	}
+	if c.errored.CompareAndSwap(false, true) && c.onError != nil {
+		c.onError(r)
+	}
+}
+
+// rateLimitedReader reads at the rate limit of a monitor shared by the
+// streams of a connection.
+type rateLimitedReader struct {
+	r    io.Reader
+	m    *flow.Monitor
+	rate int64
+}
+
+func (r *rateLimitedReader) Read(b []byte) (int, error) {
+	n := r.m.Limit(len(b), r.rate, true)
+	n, err := r.r.Read(b[:n])
+	r.m.Update(n)
+	return n, err
+}
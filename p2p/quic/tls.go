@@ -0,0 +1,91 @@
+package quic
+
+import (
+	stded25519 "crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+)
+
+// alpnProtocol is the application protocol negotiated on the connections.
+const alpnProtocol = "cometbft-p2p/1"
+
+// sessionCacheSize is the number of peers whose TLS sessions are kept to
+// resume connections to them in 0-RTT.
+const sessionCacheSize = 1024
+
+// newTLSConfig returns the TLS configuration of a node with the given key.
+// Nodes present a self-signed certificate of their key, and peers are
+// identified by the key of their certificate rather than by a certificate
+// authority.
+func newTLSConfig(privKey crypto.PrivKey) (*tls.Config, error) {
+	key, ok := privKey.(ed25519.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported node key type %s, QUIC requires an ed25519 key", privKey.Type())
+	}
+	stdKey := stded25519.PrivateKey(key)
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(100, 0, 0),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, stdKey.Public(), stdKey)
+	if err != nil {
+		return nil, fmt.Errorf("creating certificate: %w", err)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{cert}, PrivateKey: stdKey}},
+		MinVersion:   tls.VersionTLS13,
+		NextProtos:   []string{alpnProtocol},
+		ClientAuth:   tls.RequireAnyClientCert,
+		// The certificates are not issued by an authority, the identity of
+		// the peer is checked by verifyPeerCertificate and the caller.
+		InsecureSkipVerify:    true, //nolint:gosec
+		VerifyPeerCertificate: verifyPeerCertificate,
+		ClientSessionCache:    tls.NewLRUClientSessionCache(sessionCacheSize),
+	}, nil
+}
+
+// verifyPeerCertificate checks that the peer presented a single valid
+// certificate of an ed25519 key, signed by that key.
+func verifyPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) != 1 {
+		return fmt.Errorf("expected one certificate, got %d", len(rawCerts))
+	}
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return err
+	}
+	if _, ok := cert.PublicKey.(stded25519.PublicKey); !ok {
+		return errors.New("certificate key is not an ed25519 key")
+	}
+	if now := time.Now(); now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return errors.New("certificate expired or not yet valid")
+	}
+	return cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature)
+}
+
+// pubKeyOf returns the key of the peer certificate of a TLS connection.
+func pubKeyOf(state tls.ConnectionState) (crypto.PubKey, error) {
+	if len(state.PeerCertificates) == 0 {
+		return nil, errors.New("no peer certificate")
+	}
+	key, ok := state.PeerCertificates[0].PublicKey.(stded25519.PublicKey)
+	if !ok {
+		return nil, errors.New("peer certificate key is not an ed25519 key")
+	}
+	return ed25519.PubKey(key), nil
+}
@@ -0,0 +1,152 @@
+package p2p
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// SubnetFilter rejects the inbound connections from the blocked subnets, and
+// from outside the allowed subnets unless none is allowed. Blocked subnets
+// take precedence over the allowed ones. Subnets can be allowed and blocked
+// at runtime.
+type SubnetFilter struct {
+	mtx     cmtsync.RWMutex
+	allowed []*net.IPNet
+	blocked []*net.IPNet
+}
+
+// NewSubnetFilter returns a SubnetFilter allowing and blocking the given
+// subnets, in CIDR notation or as single IPs.
+func NewSubnetFilter(allowed, blocked []string) (*SubnetFilter, error) {
+	sf := &SubnetFilter{}
+	for _, subnet := range allowed {
+		if err := sf.Allow(subnet); err != nil {
+			return nil, err
+		}
+	}
+	for _, subnet := range blocked {
+		if err := sf.Block(subnet); err != nil {
+			return nil, err
+		}
+	}
+	return sf, nil
+}
+
+// Allow adds the subnet to the allowed subnets.
+func (sf *SubnetFilter) Allow(subnet string) error {
+	return sf.add(&sf.allowed, subnet)
+}
+
+// Block adds the subnet to the blocked subnets.
+func (sf *SubnetFilter) Block(subnet string) error {
+	return sf.add(&sf.blocked, subnet)
+}
+
+// Disallow removes the subnet from the allowed subnets.
+func (sf *SubnetFilter) Disallow(subnet string) error {
+	return sf.remove(&sf.allowed, subnet)
+}
+
+// Unblock removes the subnet from the blocked subnets.
+func (sf *SubnetFilter) Unblock(subnet string) error {
+	return sf.remove(&sf.blocked, subnet)
+}
+
+// AllowedSubnets returns the allowed subnets, in CIDR notation.
+func (sf *SubnetFilter) AllowedSubnets() []string {
+	sf.mtx.RLock()
+	defer sf.mtx.RUnlock()
+	return subnetStrings(sf.allowed)
+}
+
+// BlockedSubnets returns the blocked subnets, in CIDR notation.
+func (sf *SubnetFilter) BlockedSubnets() []string {
+	sf.mtx.RLock()
+	defer sf.mtx.RUnlock()
+	return subnetStrings(sf.blocked)
+}
+
+// Check returns an error if connections from ip are rejected.
+func (sf *SubnetFilter) Check(ip net.IP) error {
+	sf.mtx.RLock()
+	defer sf.mtx.RUnlock()
+	for _, subnet := range sf.blocked {
+		if subnet.Contains(ip) {
+			return fmt.Errorf("ip %v is in blocked subnet %v", ip, subnet)
+		}
+	}
+	if len(sf.allowed) == 0 {
+		return nil
+	}
+	for _, subnet := range sf.allowed {
+		if subnet.Contains(ip) {
+			return nil
+		}
+	}
+	return fmt.Errorf("ip %v is not in an allowed subnet", ip)
+}
+
+func (sf *SubnetFilter) add(subnets *[]*net.IPNet, subnet string) error {
+	network, err := ParseSubnet(subnet)
+	if err != nil {
+		return err
+	}
+	sf.mtx.Lock()
+	defer sf.mtx.Unlock()
+	if indexSubnet(*subnets, network) < 0 {
+		*subnets = append(*subnets, network)
+	}
+	return nil
+}
+
+func (sf *SubnetFilter) remove(subnets *[]*net.IPNet, subnet string) error {
+	network, err := ParseSubnet(subnet)
+	if err != nil {
+		return err
+	}
+	sf.mtx.Lock()
+	defer sf.mtx.Unlock()
+	if i := indexSubnet(*subnets, network); i >= 0 {
+		*subnets = append((*subnets)[:i], (*subnets)[i+1:]...)
+	}
+	return nil
+}
+
+// ParseSubnet parses a subnet in CIDR notation, or a single IP.
+func ParseSubnet(subnet string) (*net.IPNet, error) {
+	if !strings.Contains(subnet, "/") {
+		ip := net.ParseIP(subnet)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid subnet %q", subnet)
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(8*net.IPv4len, 8*net.IPv4len)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(8*net.IPv6len, 8*net.IPv6len)}, nil
+	}
+	_, network, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subnet %q: %w", subnet, err)
+	}
+	return network, nil
+}
+
+func indexSubnet(subnets []*net.IPNet, subnet *net.IPNet) int {
+	for i, s := range subnets {
+		if s.String() == subnet.String() {
+			return i
+		}
+	}
+	return -1
+}
+
+func subnetStrings(subnets []*net.IPNet) []string {
+	strs := make([]string, len(subnets))
+	for i, subnet := range subnets {
+		strs[i] = subnet.String()
+	}
+	return strs
+}
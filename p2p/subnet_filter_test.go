@@ -0,0 +1,40 @@
+package p2p
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubnetFilter(t *testing.T) {
+	sf, err := NewSubnetFilter(nil, nil)
+	require.NoError(t, err)
+	assert.NoError(t, sf.Check(net.ParseIP("10.1.2.3")), "all subnets are allowed by default")
+
+	require.NoError(t, sf.Allow("10.0.0.0/8"))
+	require.NoError(t, sf.Allow("10.1.2.3/8")) // same subnet
+	require.NoError(t, sf.Allow("2001:db8::1"))
+	require.NoError(t, sf.Block("10.1.0.0/16"))
+	assert.Equal(t, []string{"10.0.0.0/8", "2001:db8::1/128"}, sf.AllowedSubnets())
+	assert.Equal(t, []string{"10.1.0.0/16"}, sf.BlockedSubnets())
+
+	assert.NoError(t, sf.Check(net.ParseIP("10.2.0.1")))
+	assert.NoError(t, sf.Check(net.ParseIP("2001:db8::1")))
+	assert.Error(t, sf.Check(net.ParseIP("10.1.0.1")), "blocked subnets take precedence")
+	assert.Error(t, sf.Check(net.ParseIP("192.0.2.1")), "not allowed")
+	assert.Error(t, sf.Check(net.ParseIP("2001:db8::2")), "not allowed")
+
+	require.NoError(t, sf.Unblock("10.1.0.0/16"))
+	assert.NoError(t, sf.Check(net.ParseIP("10.1.0.1")))
+	require.NoError(t, sf.Disallow("10.0.0.0/8"))
+	require.NoError(t, sf.Disallow("2001:db8::1"))
+	assert.Empty(t, sf.AllowedSubnets())
+	assert.NoError(t, sf.Check(net.ParseIP("192.0.2.1")))
+
+	assert.Error(t, sf.Allow("10.0.0.0/33"))
+	assert.Error(t, sf.Block("10.0.0"))
+	_, err = NewSubnetFilter([]string{"10.0.0.0/8"}, []string{"not a subnet"})
+	assert.Error(t, err)
+}
@@ -3,6 +3,8 @@ package p2p
 import (
 	"fmt"
 	"math"
+	"net"
+	"runtime/pprof"
 	"sync"
 	"time"
 
@@ -40,7 +42,13 @@ func MConnConfig(cfg *config.P2PConfig) conn.MConnConfig {
 	mConfig.FlushThrottle = cfg.FlushThrottleTimeout
 	mConfig.SendRate = cfg.SendRate
 	mConfig.RecvRate = cfg.RecvRate
+	mConfig.AdaptiveSendRate = cfg.AdaptiveSendRate
+	mConfig.MinSendRate = cfg.MinSendRate
+	mConfig.MaxSendRate = cfg.MaxSendRate
 	mConfig.MaxPacketMsgPayloadSize = cfg.MaxPacketMsgPayloadSize
+	// validated in ValidateBasic
+	mConfig.ChannelRecvLimits, _ = cfg.ChannelRecvLimits()
+	mConfig.ThrottleChannelRecv = cfg.ChannelRecvRateLimitAction != config.ChannelRecvRateLimitDisconnect
 	mConfig.TestFuzz = cfg.TestFuzz
 	mConfig.TestFuzzConfig = cfg.TestFuzzConfig
 	return mConfig
@@ -65,6 +73,25 @@ type AddrBook interface {
 // fully setup.
 type PeerFilterFunc func(IPeerSet, Peer) error
 
+// PeerEventHooks are callbacks the Switch invokes on peer lifecycle events,
+// so that applications embedding the Switch can build their own peer policies
+// without modifying p2p internals. Nil hooks are skipped.
+//
+// Hooks are called synchronously from the routine handling the peer and must
+// not block.
+type PeerEventHooks struct {
+	// OnHandshakeComplete is called once the handshake with a peer succeeded
+	// and before the peer filters run. Returning an error rejects the peer.
+	OnHandshakeComplete func(Peer) error
+	// OnChannelsNegotiated is called after the peer was added, with the
+	// channels shared by both sides and our channels the peer doesn't know
+	// about.
+	OnChannelsNegotiated func(p Peer, common, unsupported []byte)
+	// OnDisconnect is called after the peer was removed. The reason is the
+	// one given to StopPeerForError, or nil for graceful disconnects.
+	OnDisconnect func(p Peer, reason interface{})
+}
+
 //-----------------------------------------------------------------------------
 
 // Switch handles peer connections and exposes an API to receive incoming messages
@@ -88,16 +115,31 @@ type Switch struct {
 	// peers addresses with whom we'll maintain constant connection
 	persistentPeersAddrs []*NetAddress
 	unconditionalPeerIDs map[ID]struct{}
+	peerPins             *PeerPins         // nil if pinning is disabled
+	clockSkew            *ClockSkew        // nil if clock skew checks are disabled
+	peerDiversity        *PeerDiversity    // nil if peers are not limited per subnet or ASN
+	peerScorer           *PeerScorer       // nil if peer scoring is disabled
+	routines             *service.Routines // nil if goroutines are not tracked
+
+	priorityMtx     sync.RWMutex
+	priorityPeerIDs map[ID]struct{}
+
+	// channels registered on the established connections of the peers, and
+	// of the peers added later
+	registeredMtx sync.RWMutex
+	registered    map[byte]registeredChannel
 
 	transport Transport
 
 	filterTimeout time.Duration
 	peerFilters   []PeerFilterFunc
+	peerHooks     []PeerEventHooks
 
 	rng *rand.Rand // seed for randomizing dial times and orders
 
 	metrics     *Metrics
 	mlc         *metricsLabelCache
+	traffic     *Traffic
 	traceClient trace.Tracer
 }
 
@@ -131,7 +173,10 @@ func NewSwitch(
 		filterTimeout:        defaultFilterTimeout,
 		persistentPeersAddrs: make([]*NetAddress, 0),
 		unconditionalPeerIDs: make(map[ID]struct{}),
+		priorityPeerIDs:      make(map[ID]struct{}),
+		registered:           make(map[byte]registeredChannel),
 		mlc:                  newMetricsLabelCache(),
+		traffic:              &Traffic{},
 		traceClient:          trace.NoOpTracer(),
 	}
 
@@ -157,11 +202,21 @@ func SwitchPeerFilters(filters ...PeerFilterFunc) SwitchOption {
 	return func(sw *Switch) { sw.peerFilters = filters }
 }
 
+// SwitchPeerEventHooks registers hooks for peer lifecycle events.
+func SwitchPeerEventHooks(hooks ...PeerEventHooks) SwitchOption {
+	return func(sw *Switch) { sw.peerHooks = append(sw.peerHooks, hooks...) }
+}
+
 // WithMetrics sets the metrics.
 func WithMetrics(metrics *Metrics) SwitchOption {
 	return func(sw *Switch) { sw.metrics = metrics }
 }
 
+// WithTraffic sets the Traffic counting the bytes sent and received by peers.
+func WithTraffic(traffic *Traffic) SwitchOption {
+	return func(sw *Switch) { sw.traffic = traffic }
+}
+
 // WithTracer sets the tracer.
 func WithTracer(tracer trace.Tracer) SwitchOption {
 	return func(sw *Switch) { sw.traceClient = tracer }
@@ -184,10 +239,19 @@ func (sw *Switch) AddReactor(name string, reactor Reactor) Reactor {
 		sw.msgTypeByChID[chID] = chDesc.MessageType
 	}
 	sw.reactors[name] = reactor
+	if r, ok := reactor.(profileLabeled); ok {
+		r.setProfileLabels(name)
+	}
 	reactor.SetSwitch(sw)
 	return reactor
 }
 
+// AddPeerEventHooks registers hooks for peer lifecycle events.
+// NOTE: Not goroutine safe.
+func (sw *Switch) AddPeerEventHooks(hooks PeerEventHooks) {
+	sw.peerHooks = append(sw.peerHooks, hooks)
+}
+
 // RemoveReactor removes the given Reactor from the Switch.
 // NOTE: Not goroutine safe.
 func (sw *Switch) RemoveReactor(name string, reactor Reactor) {
@@ -206,6 +270,54 @@ func (sw *Switch) RemoveReactor(name string, reactor Reactor) {
 	reactor.SetSwitch(nil)
 }
 
+// RegisterChannel registers the channel on the established connections of
+// the peers, and of the peers added later, so that a reactor added after the
+// switch started can receive and send messages on it. The peers are informed
+// of the channel over the connection; those whose connection does not
+// support channel registration are skipped.
+func (sw *Switch) RegisterChannel(desc *conn.ChannelDescriptor, reactor Reactor) error {
+	if sw.reactorsByCh[desc.ID] != nil {
+		return fmt.Errorf("channel %X already has reactor %v", desc.ID, sw.reactorsByCh[desc.ID])
+	}
+	sw.registeredMtx.Lock()
+	if _, ok := sw.registered[desc.ID]; ok {
+		sw.registeredMtx.Unlock()
+		return fmt.Errorf("channel %X already registered", desc.ID)
+	}
+	sw.registered[desc.ID] = registeredChannel{desc: desc, reactor: reactor}
+	sw.registeredMtx.Unlock()
+
+	for _, p := range sw.peers.List() {
+		sw.registerChannel(p, desc, reactor)
+	}
+	return nil
+}
+
+// UnregisterChannel unregisters a channel registered with RegisterChannel
+// from the established connections of the peers.
+func (sw *Switch) UnregisterChannel(chID byte) error {
+	sw.registeredMtx.Lock()
+	if _, ok := sw.registered[chID]; !ok {
+		sw.registeredMtx.Unlock()
+		return fmt.Errorf("channel %X not registered", chID)
+	}
+	delete(sw.registered, chID)
+	sw.registeredMtx.Unlock()
+
+	for _, p := range sw.peers.List() {
+		if err := p.UnregisterChannel(chID); err != nil {
+			sw.Logger.Debug("Failed to unregister channel", "chID", chID, "peer", p, "err", err)
+		}
+	}
+	return nil
+}
+
+func (sw *Switch) registerChannel(p Peer, desc *conn.ChannelDescriptor, reactor Reactor) {
+	if err := p.RegisterChannel(desc, reactor); err != nil {
+		sw.Logger.Debug("Failed to register channel", "chID", desc.ID, "peer", p, "err", err)
+	}
+}
+
 // Reactors returns a map of reactors registered on the switch.
 // NOTE: Not goroutine safe.
 func (sw *Switch) Reactors() map[string]Reactor {
@@ -241,16 +353,22 @@ func (sw *Switch) SetNodeKey(nodeKey *NodeKey) {
 
 // OnStart implements BaseService. It starts all the reactors and peers.
 func (sw *Switch) OnStart() error {
+	sw.sizeChannelBuffers()
+
 	// Start reactors
 	for _, reactor := range sw.reactors {
-		err := reactor.Start()
+		var err error
+		runWithProfileLabels(profileLabelsOf(reactor), func() { err = reactor.Start() })
 		if err != nil {
 			return fmt.Errorf("failed to start %v: %w", reactor, err)
 		}
 	}
 
 	// Start accepting Peers.
-	go sw.acceptRoutine()
+	go func() {
+		pprof.SetGoroutineLabels(p2pProfileLabels)
+		sw.acceptRoutine()
+	}()
 
 	return nil
 }
@@ -305,18 +423,17 @@ func (sw *Switch) Broadcast(e Envelope) chan bool {
 }
 
 // NumPeers returns the count of outbound/inbound and outbound-dialing peers.
-// unconditional peers are not counted here.
+// unconditional and priority peers are not counted here.
 func (sw *Switch) NumPeers() (outbound, inbound, dialing int) {
 	peers := sw.peers.List()
 	for _, peer := range peers {
+		if sw.IsPeerUnconditional(peer.ID()) || sw.IsPeerPriority(peer.ID()) {
+			continue
+		}
 		if peer.IsOutbound() {
-			if !sw.IsPeerUnconditional(peer.ID()) {
-				outbound++
-			}
+			outbound++
 		} else {
-			if !sw.IsPeerUnconditional(peer.ID()) {
-				inbound++
-			}
+			inbound++
 		}
 	}
 	dialing = sw.dialing.Size()
@@ -392,8 +509,14 @@ func (sw *Switch) StopPeerGracefully(peer Peer) {
 
 func (sw *Switch) stopAndRemovePeer(peer Peer, reason interface{}) {
 	sw.transport.Cleanup(peer)
+	if sw.clockSkew != nil {
+		sw.clockSkew.Remove(peer.ID())
+	}
 	if err := peer.Stop(); err != nil {
 		sw.Logger.Error("error while stopping peer", "error", err) // TODO: should return error to be handled accordingly
+	} else if reason != nil {
+		// recorded once, with the reason of whoever stopped the peer first
+		sw.peerScorer.RecordDisconnect(peer.ID(), reason)
 	}
 	schema.WritePeerUpdate(sw.traceClient, string(peer.ID()), schema.PeerDisconnect, fmt.Sprintf("%v", reason))
 	for _, reactor := range sw.reactors {
@@ -411,6 +534,12 @@ func (sw *Switch) stopAndRemovePeer(peer Peer, reason interface{}) {
 		// We keep this message here as information to the developer.
 		sw.Logger.Debug("error on peer removal", ",", "peer", peer.ID())
 	}
+
+	for _, hooks := range sw.peerHooks {
+		if hooks.OnDisconnect != nil {
+			hooks.OnDisconnect(peer, reason)
+		}
+	}
 }
 
 // reconnectToPeer tries to reconnect to the addr, first repeatedly
@@ -442,6 +571,8 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 			return // success
 		} else if _, ok := err.(ErrCurrentlyDialingOrExistingAddress); ok {
 			return
+		} else if _, ok := err.(ErrPeerPinMismatch); ok {
+			return
 		}
 
 		sw.Logger.Info("Error reconnecting to peer. Trying again", "tries", i, "err", err, "addr", addr)
@@ -466,6 +597,8 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 			return // success
 		} else if _, ok := err.(ErrCurrentlyDialingOrExistingAddress); ok {
 			return
+		} else if _, ok := err.(ErrPeerPinMismatch); ok {
+			return
 		}
 		sw.Logger.Info("Error reconnecting to peer. Trying again", "tries", i, "err", err, "addr", addr)
 	}
@@ -477,6 +610,177 @@ func (sw *Switch) SetAddrBook(addrBook AddrBook) {
 	sw.addrBook = addrBook
 }
 
+// SetPeerPins sets the pins checked before dialing persistent peers.
+// It should be called before starting the switch.
+func (sw *Switch) SetPeerPins(pins *PeerPins) {
+	sw.peerPins = pins
+}
+
+// SetClockSkew sets the estimator fed with the clock offsets of the peers.
+// It should be called before starting the switch.
+func (sw *Switch) SetClockSkew(clockSkew *ClockSkew) {
+	sw.clockSkew = clockSkew
+}
+
+// SetPeerDiversity sets the limits on the number of peers sharing a subnet or
+// an autonomous system, enforced when accepting and dialing peers.
+func (sw *Switch) SetPeerDiversity(pd *PeerDiversity) {
+	sw.peerDiversity = pd
+}
+
+// checkPeerDiversity returns an error if a peer with the given IP would exceed
+// the subnet or ASN limits. Persistent, unconditional and priority peers are
+// exempt, as are onion addresses, which have no IP.
+func (sw *Switch) checkPeerDiversity(id ID, ip net.IP, persistent bool) error {
+	if sw.peerDiversity == nil || ip == nil || persistent || sw.isPeerExemptFromLimits(id) {
+		return nil
+	}
+	err := sw.peerDiversity.Check(sw.peers, ip, func(p Peer) bool {
+		return p.IsPersistent() || sw.isPeerExemptFromLimits(p.ID())
+	})
+	if err != nil {
+		sw.metrics.PeerDiversityRejections.Add(1)
+	}
+	return err
+}
+
+// SetPeerScorer sets the scorer of the peers, whose score is checked when
+// accepting and dialing peers. Peers whose score falls below the minimum are
+// disconnected. It should be called before starting the switch.
+func (sw *Switch) SetPeerScorer(ps *PeerScorer) {
+	sw.peerScorer = ps
+	if ps != nil {
+		ps.onBelowMin = sw.stopPeerForScore
+	}
+}
+
+// PeerScorer returns the scorer of the peers, for reactors to report the
+// useful and invalid messages of the peers. It returns nil, whose methods do
+// nothing, if peer scoring is disabled or the reactor was not added to a
+// switch.
+func (sw *Switch) PeerScorer() *PeerScorer {
+	if sw == nil {
+		return nil
+	}
+	return sw.peerScorer
+}
+
+// checkPeerScore returns an error if the peer with the given ID scores below
+// the minimum. Persistent, unconditional and priority peers are exempt.
+func (sw *Switch) checkPeerScore(id ID, persistent bool) error {
+	if sw.peerScorer == nil || persistent || sw.isPeerExemptFromLimits(id) {
+		return nil
+	}
+	if score := sw.peerScorer.Score(id).Score; score < sw.peerScorer.minScore {
+		sw.metrics.PeerScoreRejections.Add(1)
+		return ErrPeerScoreTooLow{ID: id, Score: score, Min: sw.peerScorer.minScore}
+	}
+	return nil
+}
+
+// stopPeerForScore disconnects from the peer whose score fell below the
+// minimum, unless it is exempt.
+func (sw *Switch) stopPeerForScore(id ID, score PeerScore) {
+	peer := sw.peers.Get(id)
+	if peer == nil || peer.IsPersistent() || sw.isPeerExemptFromLimits(id) {
+		return
+	}
+	sw.metrics.PeerScoreRejections.Add(1)
+	sw.StopPeerForError(peer, ErrPeerScoreTooLow{ID: id, Score: score.Score, Min: sw.peerScorer.minScore})
+}
+
+// evictInboundPeerFor disconnects from the inbound peer scoring the lowest to
+// make room for p, if that peer scores below the minimum score and below p.
+// Well behaved peers are not
+// evicted for scoring lower than p on their latency alone. Persistent,
+// unconditional and priority peers are never evicted. It returns true if a
+// peer was evicted.
+func (sw *Switch) evictInboundPeerFor(p Peer) bool {
+	if sw.peerScorer == nil {
+		return false
+	}
+	var (
+		worst      Peer
+		worstScore float64
+	)
+	for _, other := range sw.peers.List() {
+		if other.IsOutbound() || other.IsPersistent() || sw.isPeerExemptFromLimits(other.ID()) {
+			continue
+		}
+		if score := sw.peerScorer.Score(other.ID()).Score; worst == nil || score < worstScore {
+			worst, worstScore = other, score
+		}
+	}
+	score := sw.peerScorer.Score(p.ID()).Score
+	if worst == nil || worstScore >= sw.peerScorer.minScore || worstScore >= score {
+		return false
+	}
+	sw.Logger.Info("Evicting the inbound peer with the lowest score", "peer", worst, "score", worstScore, "for", p.ID())
+	sw.metrics.PeerScoreRejections.Add(1)
+	sw.StopPeerForError(worst, ErrPeerScoreTooLow{ID: worst.ID(), Score: worstScore, Min: sw.peerScorer.minScore})
+	return true
+}
+
+func (sw *Switch) isPeerExemptFromLimits(id ID) bool {
+	return sw.IsPeerUnconditional(id) || sw.IsPeerPriority(id)
+}
+
+// SetRoutines sets the registry tracking the goroutines run by the reactors.
+// It should be called before starting the switch.
+func (sw *Switch) SetRoutines(routines *service.Routines) {
+	sw.routines = routines
+}
+
+// Routines returns the registry tracking the goroutines run by the reactors,
+// or nil if they are not tracked.
+func (sw *Switch) Routines() *service.Routines {
+	return sw.routines
+}
+
+// Traffic returns the bytes sent and received over each channel.
+func (sw *Switch) Traffic() *Traffic {
+	return sw.traffic
+}
+
+// ClockSkew returns the estimated offset of the local clock relative to the
+// peers and whether it exceeds the configured threshold. It returns false if
+// clock skew checks are disabled.
+func (sw *Switch) ClockSkew() (time.Duration, bool) {
+	if sw.clockSkew == nil {
+		return 0, false
+	}
+	return sw.clockSkew.Status()
+}
+
+func (sw *Switch) recordClockOffset(peer Peer, offset time.Duration) {
+	changed := sw.clockSkew.Record(peer.ID(), offset)
+	localOffset, skewed := sw.clockSkew.Status()
+	sw.metrics.ClockOffsetSeconds.Set(localOffset.Seconds())
+	if !changed {
+		return
+	}
+	if skewed {
+		sw.metrics.ClockSkewed.Set(1)
+		sw.Logger.Error("Local clock is skewed relative to peers; check the system clock",
+			"offset", localOffset, "threshold", sw.clockSkew.threshold)
+	} else {
+		sw.metrics.ClockSkewed.Set(0)
+		sw.Logger.Info("Local clock is back in sync with peers", "offset", localOffset)
+	}
+}
+
+func (sw *Switch) pongHandler() func(Peer, time.Duration, time.Duration) {
+	if sw.clockSkew == nil && sw.peerScorer == nil {
+		return nil
+	}
+	return func(peer Peer, offset, rtt time.Duration) {
+		if sw.clockSkew != nil {
+			sw.recordClockOffset(peer, offset)
+		}
+		sw.peerScorer.RecordLatency(peer.ID(), rtt)
+	}
+}
+
 // MarkPeerAsGood marks the given peer as good when it did something useful
 // like contributed to consensus.
 func (sw *Switch) MarkPeerAsGood(peer Peer) {
@@ -598,7 +902,7 @@ func (sw *Switch) randomSleep(interval time.Duration) {
 func (sw *Switch) IsDialingOrExistingAddress(addr *NetAddress) bool {
 	return sw.dialing.Has(string(addr.ID)) ||
 		sw.peers.Has(addr.ID) ||
-		(!sw.config.AllowDuplicateIP && sw.peers.HasIP(addr.IP))
+		(!sw.config.AllowDuplicateIP && addr.IP != nil && sw.peers.HasIP(addr.IP))
 }
 
 // AddPersistentPeers allows you to set persistent peers. It ignores
@@ -634,6 +938,35 @@ func (sw *Switch) AddUnconditionalPeerIDs(ids []string) error {
 	return nil
 }
 
+// SetPriorityPeerIDs replaces the set of high-priority peers, typically the
+// other validators. Priority peers bypass the inbound and outbound peer
+// limits without taking up slots, and their connections get send and receive
+// rates multiplied by PriorityPeerRateMultiplier. It may be called at any
+// time, e.g. when the validator set changes; rates of already established
+// connections are left untouched.
+func (sw *Switch) SetPriorityPeerIDs(ids []string) error {
+	priorityPeerIDs := make(map[ID]struct{}, len(ids))
+	for i, id := range ids {
+		err := validateID(ID(id))
+		if err != nil {
+			return fmt.Errorf("wrong ID #%d: %w", i, err)
+		}
+		priorityPeerIDs[ID(id)] = struct{}{}
+	}
+
+	sw.priorityMtx.Lock()
+	sw.priorityPeerIDs = priorityPeerIDs
+	sw.priorityMtx.Unlock()
+	return nil
+}
+
+func (sw *Switch) IsPeerPriority(id ID) bool {
+	sw.priorityMtx.RLock()
+	defer sw.priorityMtx.RUnlock()
+	_, ok := sw.priorityPeerIDs[id]
+	return ok
+}
+
 func (sw *Switch) AddPrivatePeerIDs(ids []string) error {
 	validIDs := make([]string, 0, len(ids))
 	for i, id := range ids {
@@ -667,7 +1000,13 @@ func (sw *Switch) acceptRoutine() {
 			msgTypeByChID: sw.msgTypeByChID,
 			metrics:       sw.metrics,
 			mlc:           sw.mlc,
+			traffic:       sw.traffic,
 			isPersistent:  sw.IsPeerPersistent,
+
+			isPriority:             sw.IsPeerPriority,
+			priorityRateMultiplier: sw.config.PriorityPeerRateMultiplier,
+			onPong:                 sw.pongHandler(),
+			scorer:                 sw.peerScorer,
 		})
 		if err != nil {
 			switch err := err.(type) {
@@ -716,10 +1055,23 @@ func (sw *Switch) acceptRoutine() {
 			break
 		}
 
-		if !sw.IsPeerUnconditional(p.NodeInfo().ID()) {
-			// Ignore connection if we already have enough peers.
+		if err := sw.checkPeerScore(p.ID(), p.IsPersistent()); err != nil {
+			sw.Logger.Info(
+				"Ignoring inbound connection: peer score too low",
+				"address", p.SocketAddr(),
+				"err", err,
+			)
+
+			sw.transport.Cleanup(p)
+
+			continue
+		}
+
+		if !sw.IsPeerUnconditional(p.NodeInfo().ID()) && !sw.IsPeerPriority(p.NodeInfo().ID()) {
+			// Ignore connection if we already have enough peers, unless a
+			// peer scoring lower can be evicted for it.
 			_, in, _ := sw.NumPeers()
-			if in >= sw.config.MaxNumInboundPeers {
+			if in >= sw.config.MaxNumInboundPeers && !sw.evictInboundPeerFor(p) {
 				sw.Logger.Info(
 					"Ignoring inbound connection: already have enough inbound peers",
 					"address", p.SocketAddr(),
@@ -734,6 +1086,18 @@ func (sw *Switch) acceptRoutine() {
 
 		}
 
+		if err := sw.checkPeerDiversity(p.ID(), p.RemoteIP(), p.IsPersistent()); err != nil {
+			sw.Logger.Info(
+				"Ignoring inbound connection: peer diversity limit reached",
+				"address", p.SocketAddr(),
+				"err", err,
+			)
+
+			sw.transport.Cleanup(p)
+
+			continue
+		}
+
 		if err := sw.addPeer(p); err != nil {
 			sw.transport.Cleanup(p)
 			if p.IsRunning() {
@@ -759,6 +1123,27 @@ func (sw *Switch) addOutboundPeerWithConfig(
 ) error {
 	sw.Logger.Debug("Dialing peer", "address", addr)
 
+	pinned := sw.peerPins != nil && sw.IsPeerPersistent(addr)
+	if pinned {
+		if err := sw.peerPins.Check(addr); err != nil {
+			// the peer's identity changed, don't retry until the operator
+			// removes the pin
+			sw.Logger.Error("Refusing to dial persistent peer with a changed node ID", "err", err)
+			sw.metrics.PeerPinMismatches.Add(1)
+			return err
+		}
+	}
+
+	if err := sw.checkPeerDiversity(addr.ID, addr.IP, sw.IsPeerPersistent(addr)); err != nil {
+		sw.Logger.Debug("Not dialing peer: peer diversity limit reached", "address", addr, "err", err)
+		return err
+	}
+
+	if err := sw.checkPeerScore(addr.ID, sw.IsPeerPersistent(addr)); err != nil {
+		sw.Logger.Debug("Not dialing peer: peer score too low", "address", addr, "err", err)
+		return err
+	}
+
 	// XXX(xla): Remove the leakage of test concerns in implementation.
 	if cfg.TestDialFail {
 		go sw.reconnectToPeer(addr)
@@ -773,6 +1158,12 @@ func (sw *Switch) addOutboundPeerWithConfig(
 		msgTypeByChID: sw.msgTypeByChID,
 		metrics:       sw.metrics,
 		mlc:           sw.mlc,
+		traffic:       sw.traffic,
+
+		isPriority:             sw.IsPeerPriority,
+		priorityRateMultiplier: cfg.PriorityPeerRateMultiplier,
+		onPong:                 sw.pongHandler(),
+		scorer:                 sw.peerScorer,
 	})
 	if err != nil {
 		if e, ok := err.(ErrRejected); ok {
@@ -803,6 +1194,13 @@ func (sw *Switch) addOutboundPeerWithConfig(
 		return err
 	}
 
+	// the dial authenticated the peer as addr.ID
+	if pinned {
+		if err := sw.peerPins.Pin(addr); err != nil {
+			sw.Logger.Error("Failed to pin persistent peer", "addr", addr, "err", err)
+		}
+	}
+
 	return nil
 }
 
@@ -837,6 +1235,15 @@ func (sw *Switch) filterPeer(p Peer) error {
 // addPeer starts up the Peer and adds it to the Switch. Error is returned if
 // the peer is filtered out or failed to start or can't be added.
 func (sw *Switch) addPeer(p Peer) error {
+	for _, hooks := range sw.peerHooks {
+		if hooks.OnHandshakeComplete == nil {
+			continue
+		}
+		if err := hooks.OnHandshakeComplete(p); err != nil {
+			return ErrRejected{id: p.ID(), err: err, isFiltered: true}
+		}
+	}
+
 	if err := sw.filterPeer(p); err != nil {
 		return err
 	}
@@ -859,7 +1266,8 @@ func (sw *Switch) addPeer(p Peer) error {
 	// Start the peer's send/recv routines.
 	// Must start it before adding it to the peer set
 	// to prevent Start and Stop from being called concurrently.
-	err := p.Start()
+	var err error
+	runWithProfileLabels(p2pProfileLabels, func() { err = p.Start() })
 	if err != nil {
 		// Should never happen
 		sw.Logger.Error("Error starting peer", "err", err, "peer", p)
@@ -881,12 +1289,74 @@ func (sw *Switch) addPeer(p Peer) error {
 	sw.metrics.Peers.Add(float64(1))
 	schema.WritePeerUpdate(sw.traceClient, string(p.ID()), schema.PeerJoin, "")
 
+	// Register the channels registered since the switch started.
+	sw.registeredMtx.RLock()
+	for _, ch := range sw.registered {
+		sw.registerChannel(p, ch.desc, ch.reactor)
+	}
+	sw.registeredMtx.RUnlock()
+
 	// Start all the reactor protocols on the peer.
 	for _, reactor := range sw.reactors {
-		reactor.AddPeer(p)
+		runWithProfileLabels(profileLabelsOf(reactor), func() { reactor.AddPeer(p) })
+	}
+
+	if len(sw.peerHooks) > 0 {
+		common, unsupported := sw.negotiatedChannels(p)
+		for _, hooks := range sw.peerHooks {
+			if hooks.OnChannelsNegotiated != nil {
+				hooks.OnChannelsNegotiated(p, common, unsupported)
+			}
+		}
 	}
 
 	sw.Logger.Debug("Added peer", "peer", p)
 
 	return nil
 }
+
+// sizeChannelBuffers fits the channel buffers of every peer connection in the
+// configured budget, if any, and logs the resulting capacities.
+func (sw *Switch) sizeChannelBuffers() {
+	maxPacketMsgPayloadSize := sw.config.MaxPacketMsgPayloadSize
+	if maxPacketMsgPayloadSize <= 0 {
+		maxPacketMsgPayloadSize = conn.DefaultMConnConfig().MaxPacketMsgPayloadSize
+	}
+
+	if budget := sw.config.ChannelBufferBudget; budget > 0 {
+		connections := int64(sw.config.MaxNumInboundPeers + sw.config.MaxNumOutboundPeers)
+		if connections < 1 {
+			connections = 1
+		}
+		sw.chDescs = conn.SizeChannelBuffers(sw.chDescs, budget/connections, maxPacketMsgPayloadSize)
+		sw.Logger.Info("Sized channel buffers from memory budget",
+			"budget", budget, "connections", connections, "per_connection", budget/connections)
+	}
+
+	var total int64
+	for _, chDesc := range sw.chDescs {
+		size := chDesc.BufferSize(maxPacketMsgPayloadSize)
+		total += size
+		filled := chDesc.FillDefaults()
+		sw.Logger.Info("Channel buffers",
+			"channel", fmt.Sprintf("%#x", chDesc.ID),
+			"send_queue_capacity", filled.SendQueueCapacity,
+			"recv_buffer_capacity", filled.RecvBufferCapacity,
+			"bytes", size)
+	}
+	sw.Logger.Info("Channel buffers per connection", "bytes", total)
+}
+
+// negotiatedChannels splits our channels into those the peer reported
+// knowing about and those it didn't.
+func (sw *Switch) negotiatedChannels(p Peer) (common, unsupported []byte) {
+	peerInfo, ok := p.NodeInfo().(DefaultNodeInfo)
+	for _, chDesc := range sw.chDescs {
+		if ok && peerInfo.HasChannel(chDesc.ID) {
+			common = append(common, chDesc.ID)
+		} else {
+			unsupported = append(unsupported, chDesc.ID)
+		}
+	}
+	return common, unsupported
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/p2p/conn"
 	p2pproto "github.com/cometbft/cometbft/proto/tendermint/p2p"
@@ -368,6 +369,190 @@ func assertNoPeersAfterTimeout(t *testing.T, sw *Switch, timeout time.Duration)
 	}
 }
 
+func TestSwitchChannelBufferBudget(t *testing.T) {
+	p2pCfg := *cfg
+	p2pCfg.MaxNumInboundPeers, p2pCfg.MaxNumOutboundPeers = 3, 1
+	chDescs := []*conn.ChannelDescriptor{
+		{ID: byte(0x00), Priority: 10, SendQueueCapacity: 100, RecvBufferCapacity: 100 * 1024},
+		{ID: byte(0x01), Priority: 10, SendQueueCapacity: 10, RecvBufferCapacity: 10 * 1024},
+	}
+	var declared int64
+	for _, chDesc := range chDescs {
+		declared += chDesc.BufferSize(p2pCfg.MaxPacketMsgPayloadSize)
+	}
+	p2pCfg.ChannelBufferBudget = 4 * declared / 2
+
+	sw := MakeSwitch(&p2pCfg, 1, func(_ int, sw *Switch) *Switch {
+		sw.AddReactor("foo", NewTestReactor(chDescs, true))
+		return sw
+	})
+	require.NoError(t, sw.Start())
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// every connection gets half of the declared capacities
+	require.Len(t, sw.chDescs, 2)
+	assert.Equal(t, 50, sw.chDescs[0].SendQueueCapacity)
+	assert.Equal(t, 50*1024, sw.chDescs[0].RecvBufferCapacity)
+	assert.Equal(t, 5, sw.chDescs[1].SendQueueCapacity)
+	assert.Equal(t, 5*1024, sw.chDescs[1].RecvBufferCapacity)
+}
+
+func TestSwitchPeerEventHooks(t *testing.T) {
+	var (
+		handshakes  = make(chan ID, 1)
+		channels    = make(chan [2][]byte, 1)
+		disconnects = make(chan interface{}, 1)
+		stopErr     = fmt.Errorf("some err")
+		switches    = MakeConnectedSwitches(cfg, 2, func(i int, sw *Switch) *Switch {
+			if i == 1 {
+				// the peer only knows about the channels of reactor foo
+				sw.AddReactor("foo", NewTestReactor([]*conn.ChannelDescriptor{
+					{ID: byte(0x00), Priority: 10, MessageType: &p2pproto.Message{}},
+					{ID: byte(0x01), Priority: 10, MessageType: &p2pproto.Message{}},
+				}, true))
+				return sw
+			}
+			sw.AddPeerEventHooks(PeerEventHooks{
+				OnHandshakeComplete: func(p Peer) error {
+					handshakes <- p.ID()
+					return nil
+				},
+				OnChannelsNegotiated: func(_ Peer, common, unsupported []byte) {
+					channels <- [2][]byte{common, unsupported}
+				},
+				OnDisconnect: func(_ Peer, reason interface{}) {
+					disconnects <- reason
+				},
+			})
+			return initSwitchFunc(i, sw)
+		}, Connect2Switches)
+		sw1, sw2 = switches[0], switches[1]
+	)
+	t.Cleanup(func() {
+		if err := sw1.Stop(); err != nil {
+			t.Error(err)
+		}
+		if err := sw2.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	assert.Equal(t, sw2.NodeInfo().ID(), <-handshakes)
+	negotiated := <-channels
+	assert.ElementsMatch(t, []byte{0x00, 0x01}, negotiated[0])
+	assert.ElementsMatch(t, []byte{0x02, 0x03}, negotiated[1])
+
+	sw1.StopPeerForError(sw1.Peers().List()[0], stopErr)
+	assert.Equal(t, stopErr, <-disconnects)
+}
+
+func TestSwitchPeerEventHooksReject(t *testing.T) {
+	sw := MakeSwitch(
+		cfg,
+		1,
+		initSwitchFunc,
+		SwitchPeerEventHooks(PeerEventHooks{
+			OnHandshakeComplete: func(Peer) error { return fmt.Errorf("denied") },
+		}),
+	)
+	err := sw.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// simulate remote peer
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	t.Cleanup(rp.Stop)
+
+	p, err := sw.transport.Dial(*rp.Addr(), peerConfig{
+		chDescs:      sw.chDescs,
+		onPeerError:  sw.StopPeerForError,
+		isPersistent: sw.IsPeerPersistent,
+		reactorsByCh: sw.reactorsByCh,
+	})
+	require.NoError(t, err)
+
+	err = sw.addPeer(p)
+	rejected, ok := err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	assert.True(t, rejected.IsFiltered())
+}
+
+// channelsPeer records the channels registered on its connection.
+type channelsPeer struct {
+	*mockPeer
+	mtx      cmtsync.Mutex
+	channels map[byte]Reactor
+}
+
+func newChannelsPeer(ip net.IP) *channelsPeer {
+	p := &channelsPeer{mockPeer: newMockPeer(ip), channels: make(map[byte]Reactor)}
+	p.BaseService = *service.NewBaseService(nil, "MockPeer", p)
+	return p
+}
+
+func (p *channelsPeer) RegisterChannel(desc *ChannelDescriptor, reactor Reactor) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if _, ok := p.channels[desc.ID]; ok {
+		return fmt.Errorf("channel %X already registered", desc.ID)
+	}
+	p.channels[desc.ID] = reactor
+	return nil
+}
+
+func (p *channelsPeer) UnregisterChannel(chID byte) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	delete(p.channels, chID)
+	return nil
+}
+
+func (p *channelsPeer) channel(chID byte) Reactor {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.channels[chID]
+}
+
+func TestSwitchRegisterChannel(t *testing.T) {
+	sw := MakeSwitch(cfg, 1, initSwitchFunc)
+	require.NoError(t, sw.Start())
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	desc := &conn.ChannelDescriptor{ID: 0x42, Priority: 1, MessageType: &p2pproto.Message{}}
+	reactor := NewTestReactor([]*conn.ChannelDescriptor{desc}, true)
+
+	// registered on the peers already added
+	p1 := newChannelsPeer(net.IP{127, 0, 0, 1})
+	require.NoError(t, sw.addPeer(p1))
+	require.NoError(t, sw.RegisterChannel(desc, reactor))
+	assert.Equal(t, reactor, p1.channel(desc.ID))
+	assert.Error(t, sw.RegisterChannel(desc, reactor))
+	assert.Error(t, sw.RegisterChannel(&conn.ChannelDescriptor{ID: 0x00}, reactor))
+
+	// and on the peers added later
+	p2 := newChannelsPeer(net.IP{127, 0, 0, 2})
+	require.NoError(t, sw.addPeer(p2))
+	assert.Equal(t, reactor, p2.channel(desc.ID))
+
+	require.NoError(t, sw.UnregisterChannel(desc.ID))
+	assert.Nil(t, p1.channel(desc.ID))
+	assert.Nil(t, p2.channel(desc.ID))
+	assert.Error(t, sw.UnregisterChannel(desc.ID))
+}
+
 func TestSwitchStopsNonPersistentPeerOnError(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
 
@@ -465,6 +650,48 @@ func TestSwitchStopPeerForError(t *testing.T) {
 	assert.EqualValues(t, 0, peersMetricValue())
 }
 
+func TestSwitchPeerScore(t *testing.T) {
+	sw := MakeSwitch(cfg, 1, initSwitchFunc)
+	sw.SetPeerScorer(NewPeerScorer(-50))
+	require.NoError(t, sw.Start())
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	defer rp.Stop()
+
+	require.NoError(t, sw.DialPeerWithAddress(rp.Addr()))
+	p := sw.Peers().Get(rp.ID())
+	require.NotNil(t, p)
+
+	// the peer is disconnected once its score falls below the minimum
+	for i := 0; i < 3; i++ {
+		sw.PeerScorer().RecordInvalidMessage(rp.ID(), errors.New("bad message"))
+	}
+	assertNoPeersAfterTimeout(t, sw, 100*time.Millisecond)
+	assert.False(t, p.IsRunning())
+
+	dropped := sw.PeerScorer().Dropped()
+	require.Len(t, dropped, 1)
+	assert.Equal(t, rp.ID(), dropped[0].ID)
+	assert.Contains(t, dropped[0].Reason, "below -50.00")
+	assert.Equal(t, "bad message", dropped[0].Score.LastInvalidMessage)
+
+	// and not dialed again until its score recovers
+	err := sw.DialPeerWithAddress(rp.Addr())
+	var tooLow ErrPeerScoreTooLow
+	require.ErrorAs(t, err, &tooLow)
+	assert.Equal(t, rp.ID(), tooLow.ID)
+
+	// unless it is persistent
+	require.NoError(t, sw.AddPersistentPeers([]string{rp.Addr().String()}))
+	require.NoError(t, sw.DialPeerWithAddress(rp.Addr()))
+}
+
 func TestSwitchReconnectsToOutboundPersistentPeer(t *testing.T) {
 	sw := MakeSwitch(cfg, 1, initSwitchFunc)
 	err := sw.Start()
@@ -689,6 +916,52 @@ func TestSwitchAcceptRoutine(t *testing.T) {
 	}
 }
 
+func TestSwitchAcceptRoutinePriorityPeers(t *testing.T) {
+	p2pCfg := *cfg
+	p2pCfg.MaxNumInboundPeers = 1
+
+	priorityPeer := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: &p2pCfg}
+	priorityPeer.Start()
+	defer priorityPeer.Stop()
+
+	sw := MakeSwitch(&p2pCfg, 1, initSwitchFunc)
+	require.Error(t, sw.SetPriorityPeerIDs([]string{"not-an-id"}))
+	require.NoError(t, sw.SetPriorityPeerIDs([]string{string(priorityPeer.ID())}))
+	require.NoError(t, sw.Start())
+	t.Cleanup(func() {
+		require.NoError(t, sw.Stop())
+	})
+
+	keepOpen := func(c net.Conn) {
+		one := make([]byte, 1)
+		for {
+			if _, err := c.Read(one); err != nil {
+				return
+			}
+		}
+	}
+
+	// fill the only inbound slot
+	peer := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: &p2pCfg}
+	peer.Start()
+	defer peer.Stop()
+	c, err := peer.Dial(sw.NetAddress())
+	require.NoError(t, err)
+	go keepOpen(c)
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, 1, sw.Peers().Size())
+
+	// the priority peer is accepted despite the limit and takes no slot
+	c, err = priorityPeer.Dial(sw.NetAddress())
+	require.NoError(t, err)
+	go keepOpen(c)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 2, sw.Peers().Size())
+	assert.True(t, sw.IsPeerPriority(priorityPeer.ID()))
+	_, in, _ := sw.NumPeers()
+	assert.Equal(t, 1, in)
+}
+
 type errorTransport struct {
 	acceptErr error
 }
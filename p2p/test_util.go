@@ -43,6 +43,7 @@ func CreateRandomPeer(outbound bool) Peer {
 		nodeInfo: mockNodeInfo{netAddr},
 		mconn:    &conn.MConnection{},
 		metrics:  NopMetrics(),
+		stats:    newPeerStats(),
 	}
 	p.SetLogger(log.TestingLogger().With("peer", addr))
 	return p
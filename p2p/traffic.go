@@ -0,0 +1,46 @@
+package p2p
+
+import (
+	"sync/atomic"
+)
+
+// Traffic counts the bytes of the messages sent and received over each
+// channel since the node started, across all peers. The zero value is ready
+// to use.
+type Traffic struct {
+	sent     [256]atomic.Int64
+	received [256]atomic.Int64
+}
+
+// ChannelTraffic is the traffic of a channel.
+type ChannelTraffic struct {
+	ID            byte
+	SentBytes     int64
+	ReceivedBytes int64
+}
+
+func (t *Traffic) addSent(chID byte, n int) {
+	if t != nil {
+		t.sent[chID].Add(int64(n))
+	}
+}
+
+func (t *Traffic) addReceived(chID byte, n int) {
+	if t != nil {
+		t.received[chID].Add(int64(n))
+	}
+}
+
+// Channels returns the traffic of each channel used so far, ordered by
+// channel ID.
+func (t *Traffic) Channels() []ChannelTraffic {
+	var channels []ChannelTraffic
+	for i := range t.sent {
+		sent, received := t.sent[i].Load(), t.received[i].Load()
+		if sent == 0 && received == 0 {
+			continue
+		}
+		channels = append(channels, ChannelTraffic{ID: byte(i), SentBytes: sent, ReceivedBytes: received})
+	}
+	return channels
+}
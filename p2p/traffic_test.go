@@ -0,0 +1,22 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraffic(t *testing.T) {
+	var traffic Traffic
+	traffic.addSent(0x30, 10)
+	traffic.addReceived(0x20, 5)
+	traffic.addSent(0x30, 15)
+
+	assert.Equal(t, []ChannelTraffic{
+		{ID: 0x20, ReceivedBytes: 5},
+		{ID: 0x30, SentBytes: 25},
+	}, traffic.Channels())
+
+	var nilTraffic *Traffic
+	nilTraffic.addSent(0x30, 10)
+}
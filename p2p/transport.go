@@ -2,11 +2,13 @@ package p2p
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"time"
 
 	"golang.org/x/net/netutil"
+	"golang.org/x/net/proxy"
 
 	"github.com/cosmos/gogoproto/proto"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/cometbft/cometbft/libs/protoio"
 	"github.com/cometbft/cometbft/libs/trace"
 	"github.com/cometbft/cometbft/p2p/conn"
+	"github.com/cometbft/cometbft/p2p/quic"
 	tmp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
 )
 
@@ -21,6 +24,10 @@ const (
 	defaultDialTimeout      = time.Second
 	defaultFilterTimeout    = 5 * time.Second
 	defaultHandshakeTimeout = 3 * time.Second
+
+	// proxyDialTimeout is higher than defaultDialTimeout since dialing through
+	// Tor takes building a circuit first.
+	proxyDialTimeout = 30 * time.Second
 )
 
 // IPResolver is a behavior subset of net.Resolver.
@@ -55,6 +62,19 @@ type peerConfig struct {
 	msgTypeByChID map[byte]proto.Message
 	metrics       *Metrics
 	mlc           *metricsLabelCache
+	traffic       *Traffic
+
+	// isPriority tells if the peer with the given ID is a priority peer, whose
+	// connection rates are multiplied by priorityRateMultiplier.
+	isPriority             func(ID) bool
+	priorityRateMultiplier int64
+
+	// onPong, if set, is called with the offset of the clock of the peer and
+	// the round trip time each time it answers a ping.
+	onPong func(p Peer, offset, rtt time.Duration)
+
+	// scorer, if set, records the invalid messages of the peer.
+	scorer *PeerScorer
 }
 
 // Transport emits and connects to Peers. The implementation of Peer is left to
@@ -135,20 +155,50 @@ func MultiplexTransportMaxIncomingConnections(n int) MultiplexTransportOption {
 	return func(mt *MultiplexTransport) { mt.maxIncomingConnections = n }
 }
 
+// MultiplexTransportSubnetFilter sets the filter of the inbound connections by
+// subnet, applied before the other connection filters and the handshake.
+func MultiplexTransportSubnetFilter(sf *SubnetFilter) MultiplexTransportOption {
+	return func(mt *MultiplexTransport) { mt.subnetFilter = sf }
+}
+
+// MultiplexTransportConnThrottle sets the limit of the rate of the inbound
+// connection attempts, which are closed right after being accepted when
+// throttled, before the other filters and the handshake.
+func MultiplexTransportConnThrottle(ct *ConnThrottle) MultiplexTransportOption {
+	return func(mt *MultiplexTransport) { mt.connThrottle = ct }
+}
+
+// MultiplexTransportProxy sets the address of a SOCKS5 proxy, such as Tor,
+// the peers are dialed through. Onion addresses can only be dialed through a
+// proxy. It is ignored by the QUIC transport.
+func MultiplexTransportProxy(addr string) MultiplexTransportOption {
+	return func(mt *MultiplexTransport) { mt.proxyAddr = addr }
+}
+
 // MultiplexTransport accepts and dials tcp connections and upgrades them to
-// multiplexed peers.
+// multiplexed peers. Created by NewQUICTransport, it accepts and dials QUIC
+// connections instead, on which the channels of the peers are multiplexed
+// over QUIC streams.
 type MultiplexTransport struct {
 	netAddr                NetAddress
 	listener               net.Listener
 	maxIncomingConnections int // see MaxIncomingConnections
 
+	// isQUIC is set for the QUIC transport, whose listener, set by Listen,
+	// the peers are also dialed from.
+	isQUIC       bool
+	quicListener *quic.Listener
+
 	acceptc chan accept
 	closec  chan struct{}
 
 	// Lookup table for duplicate ip and id checks.
-	conns       ConnSet
-	connFilters []ConnFilterFunc
+	conns        ConnSet
+	connFilters  []ConnFilterFunc
+	subnetFilter *SubnetFilter // of the inbound connections, if any
+	connThrottle *ConnThrottle // of the inbound connections, if any
 
+	proxyAddr        string // SOCKS5 proxy dialing the peers, if any
 	dialTimeout      time.Duration
 	filterTimeout    time.Duration
 	handshakeTimeout time.Duration
@@ -190,6 +240,21 @@ func NewMultiplexTransport(
 	}
 }
 
+// NewQUICTransport returns a transport connecting to the peers over QUIC.
+// The connections are encrypted and authenticated by TLS with the node key,
+// which must be an ed25519 key, and each channel of a peer is carried by its
+// own QUIC streams. See the quic package.
+func NewQUICTransport(
+	nodeInfo NodeInfo,
+	nodeKey NodeKey,
+	mConfig conn.MConnConfig,
+	tracer trace.Tracer,
+) *MultiplexTransport {
+	mt := NewMultiplexTransport(nodeInfo, nodeKey, mConfig, tracer)
+	mt.isQUIC = true
+	return mt
+}
+
 // NetAddress implements Transport.
 func (mt *MultiplexTransport) NetAddress() NetAddress {
 	return mt.netAddr
@@ -218,29 +283,54 @@ func (mt *MultiplexTransport) Dial(
 	addr NetAddress,
 	cfg peerConfig,
 ) (Peer, error) {
-	c, err := addr.DialTimeout(mt.dialTimeout)
+	var (
+		c       net.Conn
+		err     error
+		proxied = mt.proxyAddr != "" && !mt.isQUIC
+	)
+	switch {
+	case addr.IsOnion() && !proxied:
+		return nil, fmt.Errorf("can't dial onion address %v without a proxy", addr)
+	case mt.isQUIC:
+		c, err = mt.dialQUIC(addr)
+	case proxied:
+		c, err = mt.dialProxy(addr)
+	default:
+		c, err = addr.DialTimeout(mt.dialTimeout)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	if mt.mConfig.TestFuzz {
+	if mt.mConfig.TestFuzz && !mt.isQUIC {
 		// so we have time to do peer handshakes and get set up.
 		c = FuzzConnAfterFromConfig(c, 10*time.Second, mt.mConfig.TestFuzzConfig)
 	}
 
 	// TODO(xla): Evaluate if we should apply filters if we explicitly dial.
-	if err := mt.filterConn(c); err != nil {
+	if proxied {
+		// The connections through the proxy all come from the proxy, so
+		// filter on the dialed IP instead, if any.
+		var ips []net.IP
+		if addr.IP != nil {
+			ips = []net.IP{addr.IP}
+		}
+		err = mt.filterConnIPs(c, ips)
+	} else {
+		err = mt.filterConn(c, false)
+	}
+	if err != nil {
 		return nil, err
 	}
 
-	secretConn, nodeInfo, err := mt.upgrade(c, &addr)
+	upgraded, nodeInfo, err := mt.upgrade(c, &addr)
 	if err != nil {
 		return nil, err
 	}
 
 	cfg.outbound = true
 
-	p := mt.wrapPeer(secretConn, nodeInfo, cfg, &addr)
+	p := mt.wrapPeer(upgraded, nodeInfo, cfg, &addr)
 
 	return p, nil
 }
@@ -258,6 +348,10 @@ func (mt *MultiplexTransport) Close() error {
 
 // Listen implements transportLifecycle.
 func (mt *MultiplexTransport) Listen(addr NetAddress) error {
+	if mt.isQUIC {
+		return mt.listenQUIC(addr)
+	}
+
 	ln, err := net.Listen("tcp", addr.DialString())
 	if err != nil {
 		return err
@@ -275,6 +369,73 @@ func (mt *MultiplexTransport) Listen(addr NetAddress) error {
 	return nil
 }
 
+// listenQUIC listens for QUIC connections on the UDP port of addr.
+func (mt *MultiplexTransport) listenQUIC(addr NetAddress) error {
+	ln, err := quic.Listen(addr.DialString(), mt.nodeKey.PrivKey, quic.Config{
+		HandshakeTimeout:       mt.handshakeTimeout,
+		KeepAlivePeriod:        mt.mConfig.PingInterval,
+		IdleTimeout:            mt.mConfig.PingInterval + mt.mConfig.PongTimeout,
+		MaxIncomingConnections: mt.maxIncomingConnections,
+	})
+	if err != nil {
+		return err
+	}
+
+	mt.netAddr = addr
+	mt.listener = ln
+	mt.quicListener = ln
+
+	go mt.acceptPeers()
+
+	return nil
+}
+
+// dialQUIC dials addr from the socket of the QUIC listener.
+func (mt *MultiplexTransport) dialQUIC(addr NetAddress) (net.Conn, error) {
+	if mt.quicListener == nil {
+		return nil, errors.New("quic transport is not listening")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), mt.dialTimeout)
+	defer cancel()
+	c, err := mt.quicListener.Dial(ctx, addr.DialString(), string(addr.ID))
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// dialProxy dials addr through the SOCKS5 proxy, which resolves the hostname
+// of onion addresses.
+func (mt *MultiplexTransport) dialProxy(addr NetAddress) (net.Conn, error) {
+	dialer, err := proxy.SOCKS5("tcp", mt.proxyAddr, nil, &net.Dialer{})
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), proxyDialTimeout)
+	defer cancel()
+	c, err := dialer.(proxy.ContextDialer).DialContext(ctx, "tcp", addr.DialString())
+	if err != nil {
+		return nil, err
+	}
+	return &proxiedConn{Conn: c, remoteAddr: proxiedAddr(addr.DialString())}, nil
+}
+
+// proxiedConn is a connection dialed through a proxy. It reports the dialed
+// address as its remote address, rather than the address of the proxy all
+// the connections through it share.
+type proxiedConn struct {
+	net.Conn
+	remoteAddr proxiedAddr
+}
+
+func (c *proxiedConn) RemoteAddr() net.Addr { return c.remoteAddr }
+
+// proxiedAddr is the host:port dialed through a proxy.
+type proxiedAddr string
+
+func (a proxiedAddr) Network() string { return "tcp" }
+func (a proxiedAddr) String() string  { return string(a) }
+
 // AddChannel registers a channel to nodeInfo.
 // NOTE: NodeInfo must be of type DefaultNodeInfo else channels won't be updated
 // This is a bit messy at the moment but is cleaned up in the following version
@@ -306,6 +467,15 @@ func (mt *MultiplexTransport) acceptPeers() {
 			return
 		}
 
+		// Throttled connections are dropped without further work, nor
+		// reporting them, as they are expected in numbers.
+		if mt.connThrottle != nil {
+			if ip := connRemoteIP(c); ip != nil && mt.connThrottle.Allow(ip, time.Now()) != nil {
+				_ = c.Close()
+				continue
+			}
+		}
+
 		// Connection upgrade and filtering should be asynchronous to avoid
 		// Head-of-line blocking[0].
 		// Reference:  https://github.com/tendermint/tendermint/issues/2047
@@ -330,23 +500,22 @@ func (mt *MultiplexTransport) acceptPeers() {
 			}()
 
 			var (
-				nodeInfo   NodeInfo
-				secretConn *conn.SecretConnection
-				netAddr    *NetAddress
+				nodeInfo NodeInfo
+				upgraded net.Conn
+				netAddr  *NetAddress
 			)
 
-			err := mt.filterConn(c)
+			err := mt.filterConn(c, true)
 			if err == nil {
-				secretConn, nodeInfo, err = mt.upgrade(c, nil)
+				upgraded, nodeInfo, err = mt.upgrade(c, nil)
 				if err == nil {
-					addr := c.RemoteAddr()
-					id := PubKeyToID(secretConn.RemotePubKey())
-					netAddr = NewNetAddress(id, addr)
+					// the ID of the connection, checked by upgrade
+					netAddr = NewNetAddress(nodeInfo.ID(), c.RemoteAddr())
 				}
 			}
 
 			select {
-			case mt.acceptc <- accept{netAddr, secretConn, nodeInfo, err}:
+			case mt.acceptc <- accept{netAddr, upgraded, nodeInfo, err}:
 				// Make the upgraded peer available.
 			case <-mt.closec:
 				// Give up if the transport was closed.
@@ -370,7 +539,32 @@ func (mt *MultiplexTransport) cleanup(c net.Conn) error {
 	return c.Close()
 }
 
-func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
+func (mt *MultiplexTransport) filterConn(c net.Conn, inbound bool) error {
+	// Resolve ips for incoming conn.
+	ips, err := resolveIPs(mt.resolver, c)
+	if err != nil {
+		_ = c.Close()
+		return err
+	}
+	if inbound && mt.subnetFilter != nil {
+		for _, ip := range ips {
+			if err := mt.subnetFilter.Check(ip); err != nil {
+				_ = c.Close()
+				return ErrRejected{conn: c, err: err, isFiltered: true}
+			}
+		}
+	}
+	return mt.filterConnIPs(c, ips)
+}
+
+// SubnetFilter returns the filter of the inbound connections by subnet, nil
+// if none is set.
+func (mt *MultiplexTransport) SubnetFilter() *SubnetFilter {
+	return mt.subnetFilter
+}
+
+// filterConnIPs filters the connection c from the given IPs.
+func (mt *MultiplexTransport) filterConnIPs(c net.Conn, ips []net.IP) (err error) {
 	defer func() {
 		if err != nil {
 			_ = c.Close()
@@ -382,12 +576,6 @@ func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
 		return ErrRejected{conn: c, isDuplicate: true}
 	}
 
-	// Resolve ips for incoming conn.
-	ips, err := resolveIPs(mt.resolver, c)
-	if err != nil {
-		return err
-	}
-
 	errc := make(chan error, len(mt.connFilters))
 
 	for _, f := range mt.connFilters {
@@ -413,27 +601,56 @@ func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
 	return nil
 }
 
+// upgrade authenticates the peer and exchanges the node infos, returning the
+// connection to multiplex the channels of the peer over: a SecretConnection
+// over TCP connections, or the QUIC connection itself.
 func (mt *MultiplexTransport) upgrade(
 	c net.Conn,
 	dialedAddr *NetAddress,
-) (secretConn *conn.SecretConnection, nodeInfo NodeInfo, err error) {
+) (upgraded net.Conn, nodeInfo NodeInfo, err error) {
 	defer func() {
 		if err != nil {
 			_ = mt.cleanup(c)
 		}
 	}()
 
-	secretConn, err = upgradeSecretConn(c, mt.handshakeTimeout, mt.nodeKey.PrivKey)
-	if err != nil {
-		return nil, nil, ErrRejected{
-			conn:          c,
-			err:           fmt.Errorf("secret conn failed: %v", err),
-			isAuthFailure: true,
+	var connID ID
+	quicConn, isQUIC := c.(*quic.Conn)
+	if isQUIC {
+		// QUIC connections are encrypted and authenticated by TLS. The node
+		// infos are exchanged before the key of the peer is checked, for ours
+		// to be sent in 0-RTT to the peers dialed before.
+		nodeInfo, err = handshake(c, mt.handshakeTimeout, mt.nodeInfo)
+		if err != nil {
+			return nil, nil, ErrRejected{
+				conn:          c,
+				err:           fmt.Errorf("handshake failed: %v", err),
+				isAuthFailure: true,
+			}
+		}
+		connID, err = quicConnID(quicConn, mt.handshakeTimeout)
+		if err != nil {
+			return nil, nil, ErrRejected{
+				conn:          c,
+				err:           fmt.Errorf("quic handshake failed: %v", err),
+				isAuthFailure: true,
+			}
+		}
+		upgraded = c
+	} else {
+		secretConn, err := upgradeSecretConn(c, mt.handshakeTimeout, mt.nodeKey.PrivKey)
+		if err != nil {
+			return nil, nil, ErrRejected{
+				conn:          c,
+				err:           fmt.Errorf("secret conn failed: %v", err),
+				isAuthFailure: true,
+			}
 		}
+		connID = PubKeyToID(secretConn.RemotePubKey())
+		upgraded = secretConn
 	}
 
 	// For outgoing conns, ensure connection key matches dialed key.
-	connID := PubKeyToID(secretConn.RemotePubKey())
 	if dialedAddr != nil {
 		if dialedID := dialedAddr.ID; connID != dialedID {
 			return nil, nil, ErrRejected{
@@ -449,12 +666,14 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	nodeInfo, err = handshake(secretConn, mt.handshakeTimeout, mt.nodeInfo)
-	if err != nil {
-		return nil, nil, ErrRejected{
-			conn:          c,
-			err:           fmt.Errorf("handshake failed: %v", err),
-			isAuthFailure: true,
+	if !isQUIC {
+		nodeInfo, err = handshake(upgraded, mt.handshakeTimeout, mt.nodeInfo)
+		if err != nil {
+			return nil, nil, ErrRejected{
+				conn:          c,
+				err:           fmt.Errorf("handshake failed: %v", err),
+				isAuthFailure: true,
+			}
 		}
 	}
 
@@ -480,10 +699,17 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	// Reject self.
+	// Reject self. The remote address of connections dialed through a proxy
+	// isn't an IP, so the dialed address is used on outbound connections.
 	if mt.nodeInfo.ID() == nodeInfo.ID() {
+		var selfAddr NetAddress
+		if dialedAddr != nil {
+			selfAddr = *dialedAddr
+		} else {
+			selfAddr = *NewNetAddress(nodeInfo.ID(), c.RemoteAddr())
+		}
 		return nil, nil, ErrRejected{
-			addr:   *NewNetAddress(nodeInfo.ID(), c.RemoteAddr()),
+			addr:   selfAddr,
 			conn:   c,
 			id:     nodeInfo.ID(),
 			isSelf: true,
@@ -499,7 +725,7 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	return secretConn, nodeInfo, nil
+	return upgraded, nodeInfo, nil
 }
 
 func (mt *MultiplexTransport) wrapPeer(
@@ -521,6 +747,22 @@ func (mt *MultiplexTransport) wrapPeer(
 		}
 	}
 
+	mConfig := mt.mConfig
+	if cfg.isPriority != nil && cfg.priorityRateMultiplier > 1 && cfg.isPriority(ni.ID()) {
+		mConfig.SendRate *= cfg.priorityRateMultiplier
+		mConfig.RecvRate *= cfg.priorityRateMultiplier
+		mConfig.MinSendRate *= cfg.priorityRateMultiplier
+		mConfig.MaxSendRate *= cfg.priorityRateMultiplier
+	}
+	mConfig.PacketSequence = negotiated(mt.nodeInfo, ni, FeaturePacketSequence)
+	mConfig.Compression = negotiated(mt.nodeInfo, ni, FeatureCompression)
+	mConfig.ChannelRegistration = negotiated(mt.nodeInfo, ni, FeatureChannelRegistration)
+	mConfig.Yamux = negotiated(mt.nodeInfo, ni, FeatureYamux)
+	if theirInfo, ok := ni.(DefaultNodeInfo); ok {
+		mConfig.MaxPacketMsgPayloadSize = conn.NegotiateMaxPacketMsgPayloadSize(
+			mConfig.MaxPacketMsgPayloadSize, theirInfo.MaxPacketMsgPayloadSize)
+	}
+
 	peerConn := newPeerConn(
 		cfg.outbound,
 		persistent,
@@ -530,7 +772,7 @@ func (mt *MultiplexTransport) wrapPeer(
 
 	p := newPeer(
 		peerConn,
-		mt.mConfig,
+		mConfig,
 		ni,
 		cfg.reactorsByCh,
 		cfg.msgTypeByChID,
@@ -539,11 +781,27 @@ func (mt *MultiplexTransport) wrapPeer(
 		cfg.mlc,
 		PeerMetrics(cfg.metrics),
 		WithPeerTracer(mt.tracer),
+		withPongHandler(cfg.onPong),
+		withTraffic(cfg.traffic),
+		withPeerScorer(cfg.scorer),
 	)
 
 	return p
 }
 
+// negotiated returns true if both our node and the peer advertise feature.
+func negotiated(ours, theirs NodeInfo, feature uint64) bool {
+	ourInfo, ok := ours.(DefaultNodeInfo)
+	if !ok {
+		return false
+	}
+	theirInfo, ok := theirs.(DefaultNodeInfo)
+	if !ok {
+		return false
+	}
+	return ourInfo.HasFeature(feature) && theirInfo.HasFeature(feature)
+}
+
 func handshake(
 	c net.Conn,
 	timeout time.Duration,
@@ -603,6 +861,34 @@ func upgradeSecretConn(
 	return sc, sc.SetDeadline(time.Time{})
 }
 
+// quicConnID waits for the QUIC handshake of c to complete and returns the ID
+// of the key the peer authenticated with.
+func quicConnID(c *quic.Conn, timeout time.Duration) (ID, error) {
+	if err := c.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return "", err
+	}
+	pubKey, err := c.RemotePubKey()
+	if err != nil {
+		return "", err
+	}
+	return PubKeyToID(pubKey), c.SetDeadline(time.Time{})
+}
+
+// connRemoteIP returns the IP of the remote end of c, or nil if it has none.
+func connRemoteIP(c net.Conn) net.IP {
+	switch addr := c.RemoteAddr().(type) {
+	case *net.TCPAddr:
+		return addr.IP
+	case *net.UDPAddr:
+		return addr.IP
+	}
+	host, _, err := net.SplitHostPort(c.RemoteAddr().String())
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(host)
+}
+
 func resolveIPs(resolver IPResolver, c net.Conn) ([]net.IP, error) {
 	host, _, err := net.SplitHostPort(c.RemoteAddr().String())
 	if err != nil {
@@ -2,15 +2,22 @@ package p2p
 
 import (
 	"fmt"
+	"io"
 	"math/rand"
 	"net"
 	"reflect"
 	"runtime"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/libs/protoio"
 	"github.com/cometbft/cometbft/libs/trace"
 	"github.com/cometbft/cometbft/p2p/conn"
@@ -464,6 +471,187 @@ func TestTransportMultiplexDialRejectWrongID(t *testing.T) {
 	}
 }
 
+func TestTransportMultiplexDialProxy(t *testing.T) {
+	const onion = "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion"
+
+	mt := testSetupMultiplexTransport(t)
+	proxyAddr, requests := testSOCKS5Proxy(t, mt.listener.Addr().String())
+
+	var (
+		pv     = ed25519.GenPrivKey()
+		dialer = newMultiplexTransport(
+			testNodeInfo(PubKeyToID(pv.PubKey()), defaultNodeName),
+			NodeKey{
+				PrivKey: pv,
+			},
+		)
+		addr = NetAddress{ID: mt.nodeKey.ID(), Port: 26656, Onion: onion}
+	)
+
+	// onion addresses can't be dialed directly
+	_, err := dialer.Dial(addr, peerConfig{})
+	require.Error(t, err)
+
+	MultiplexTransportProxy(proxyAddr)(dialer)
+	p, err := dialer.Dial(addr, peerConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, onion+":26656", <-requests)
+	assert.Equal(t, &addr, p.SocketAddr())
+
+	// connections through the proxy are filtered on the dialed address
+	ip := NewNetAddressIPPort(net.IPv4(1, 2, 3, 4), 26656)
+	ip.ID = mt.nodeKey.ID()
+	MultiplexTransportConnFilters(ConnDuplicateIPFilter())(dialer)
+	_, err = dialer.Dial(*ip, peerConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3.4:26656", <-requests)
+	_, err = dialer.Dial(*ip, peerConfig{})
+	require.Error(t, err)
+	assert.Equal(t, "1.2.3.4:26656", <-requests)
+}
+
+func TestTransportMultiplexDialProxyRejectSelf(t *testing.T) {
+	const onion = "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion"
+
+	mt := testSetupMultiplexTransport(t)
+	proxyAddr, requests := testSOCKS5Proxy(t, mt.listener.Addr().String())
+	MultiplexTransportProxy(proxyAddr)(mt)
+
+	addr := NetAddress{ID: mt.nodeKey.ID(), Port: 26656, Onion: onion}
+	_, err := mt.Dial(addr, peerConfig{})
+	assert.Equal(t, onion+":26656", <-requests)
+	var e ErrRejected
+	require.ErrorAs(t, err, &e)
+	assert.True(t, e.IsSelf())
+	assert.Equal(t, addr, e.Addr())
+}
+
+// testSOCKS5Proxy starts a SOCKS5 proxy connecting all the requests to
+// target, and returns its address and the requested addresses.
+func testSOCKS5Proxy(t *testing.T, target string) (string, <-chan string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	requests := make(chan string, 10)
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				// greeting: version, methods; no authentication
+				buf := make([]byte, 256)
+				if _, err := io.ReadFull(c, buf[:2]); err != nil {
+					return
+				}
+				if _, err := io.ReadFull(c, buf[:buf[1]]); err != nil {
+					return
+				}
+				if _, err := c.Write([]byte{5, 0}); err != nil {
+					return
+				}
+				// request: version, CONNECT, reserved, address type
+				if _, err := io.ReadFull(c, buf[:4]); err != nil {
+					return
+				}
+				var host string
+				switch buf[3] {
+				case 1:
+					if _, err := io.ReadFull(c, buf[:net.IPv4len]); err != nil {
+						return
+					}
+					host = net.IP(buf[:net.IPv4len]).String()
+				case 3:
+					if _, err := io.ReadFull(c, buf[:1]); err != nil {
+						return
+					}
+					n := buf[0]
+					if _, err := io.ReadFull(c, buf[:n]); err != nil {
+						return
+					}
+					host = string(buf[:n])
+				default:
+					return
+				}
+				if _, err := io.ReadFull(c, buf[:2]); err != nil {
+					return
+				}
+				requests <- net.JoinHostPort(host, strconv.Itoa(int(buf[0])<<8|int(buf[1])))
+
+				tc, err := net.Dial("tcp", target)
+				if err != nil {
+					return
+				}
+				defer tc.Close()
+				if _, err := c.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0}); err != nil {
+					return
+				}
+				go func() { _, _ = io.Copy(tc, c) }()
+				_, _ = io.Copy(c, tc)
+			}()
+		}
+	}()
+	return ln.Addr().String(), requests
+}
+
+func TestTransportMultiplexSubnetFilter(t *testing.T) {
+	var (
+		pv = ed25519.GenPrivKey()
+		id = PubKeyToID(pv.PubKey())
+		mt = newMultiplexTransport(testNodeInfo(id, "transport"), NodeKey{PrivKey: pv})
+	)
+	sf, err := NewSubnetFilter(nil, []string{"127.0.0.0/8"})
+	require.NoError(t, err)
+	MultiplexTransportSubnetFilter(sf)(mt)
+	laddr, err := NewNetAddressString(IDAddressString(id, "127.0.0.1:0"))
+	require.NoError(t, err)
+	require.NoError(t, mt.Listen(*laddr))
+	addr := NewNetAddress(id, mt.listener.Addr())
+
+	errc := make(chan error)
+	go testDialer(*addr, errc)
+	require.Error(t, <-errc)
+
+	_, err = mt.Accept(peerConfig{})
+	require.Error(t, err)
+	e, ok := err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	assert.True(t, e.IsFiltered())
+
+	// unblocked at runtime
+	require.NoError(t, sf.Unblock("127.0.0.0/8"))
+	go testDialer(*addr, errc)
+	_, err = mt.Accept(peerConfig{})
+	require.NoError(t, err)
+	require.NoError(t, <-errc)
+}
+
+func TestTransportMultiplexConnThrottle(t *testing.T) {
+	var (
+		pv = ed25519.GenPrivKey()
+		id = PubKeyToID(pv.PubKey())
+		mt = newMultiplexTransport(testNodeInfo(id, "transport"), NodeKey{PrivKey: pv})
+	)
+	MultiplexTransportConnThrottle(NewConnThrottle(0.001, 1, 0, 0, time.Hour))(mt)
+	laddr, err := NewNetAddressString(IDAddressString(id, "127.0.0.1:0"))
+	require.NoError(t, err)
+	require.NoError(t, mt.Listen(*laddr))
+	addr := NewNetAddress(id, mt.listener.Addr())
+
+	errc := make(chan error)
+	go testDialer(*addr, errc)
+	_, err = mt.Accept(peerConfig{})
+	require.NoError(t, err)
+	require.NoError(t, <-errc)
+
+	// the second attempt exceeds the burst and is dropped
+	go testDialer(*addr, errc)
+	require.Error(t, <-errc)
+}
+
 func TestTransportMultiplexRejectIncompatible(t *testing.T) {
 	mt := testSetupMultiplexTransport(t)
 
@@ -637,6 +825,133 @@ func TestTransportAddChannel(t *testing.T) {
 	}
 }
 
+func TestQUICTransport(t *testing.T) {
+	newTransport := func() *MultiplexTransport {
+		pv := ed25519.GenPrivKey()
+		id := PubKeyToID(pv.PubKey())
+		mt := NewQUICTransport(
+			testNodeInfo(id, "quic"), NodeKey{PrivKey: pv}, conn.DefaultMConnConfig(), trace.NoOpTracer(),
+		)
+		addr, err := NewNetAddressString(IDAddressString(id, "127.0.0.1:0"))
+		require.NoError(t, err)
+		require.NoError(t, mt.Listen(*addr))
+		t.Cleanup(func() { _ = mt.Close() })
+		return mt
+	}
+	dialer, listener := newTransport(), newTransport()
+
+	chDescs := []*conn.ChannelDescriptor{{ID: testCh, Priority: 1}}
+	reactor := NewTestReactor(chDescs, true)
+	cfg := peerConfig{
+		chDescs:       chDescs,
+		onPeerError:   func(Peer, interface{}) {},
+		reactorsByCh:  map[byte]Reactor{testCh: reactor},
+		msgTypeByChID: map[byte]proto.Message{testCh: &tmp2p.Message{}},
+		metrics:       NopMetrics(),
+		mlc:           newMetricsLabelCache(),
+	}
+
+	accepted := make(chan Peer, 1)
+	go func() {
+		p, err := listener.Accept(cfg)
+		if err != nil {
+			t.Error(err)
+		}
+		accepted <- p
+	}()
+	addr := NewNetAddress(listener.nodeKey.ID(), listener.listener.Addr())
+	out, err := dialer.Dial(*addr, cfg)
+	require.NoError(t, err)
+	in := <-accepted
+	require.NotNil(t, in)
+	assert.Equal(t, listener.nodeKey.ID(), out.ID())
+	assert.Equal(t, dialer.nodeKey.ID(), in.ID())
+	assert.Equal(t, dialer.listener.Addr().String(), in.RemoteAddr().String())
+
+	for _, p := range []Peer{out, in} {
+		require.NoError(t, p.Start())
+		t.Cleanup(func() { _ = p.Stop() })
+	}
+	assert.True(t, out.Send(Envelope{ChannelID: testCh, Message: &tmp2p.PexRequest{}}))
+	require.Eventually(t, func() bool {
+		return len(reactor.getMsgs(testCh)) == 1
+	}, time.Second, 10*time.Millisecond)
+	stats := in.(*peer).MessageStats()
+	require.Len(t, stats.MessageTypes, 1)
+	assert.Equal(t, "p2p_PexRequest", stats.MessageTypes[0].MessageType)
+	assert.EqualValues(t, 1, stats.MessageTypes[0].ReceivedMsgs)
+
+	// the key of the peer must match the ID dialed
+	wrongID := PubKeyToID(ed25519.GenPrivKey().PubKey())
+	_, err = newTransport().Dial(*NewNetAddress(wrongID, listener.listener.Addr()), cfg)
+	var rejected ErrRejected
+	require.ErrorAs(t, err, &rejected)
+	assert.True(t, rejected.IsAuthFailure())
+}
+
+func TestTransportYamux(t *testing.T) {
+	newTransport := func(features uint64) *MultiplexTransport {
+		pv := ed25519.GenPrivKey()
+		id := PubKeyToID(pv.PubKey())
+		ni := testNodeInfo(id, "yamux").(DefaultNodeInfo)
+		ni.Features = features
+		mt := NewMultiplexTransport(ni, NodeKey{PrivKey: pv}, conn.DefaultMConnConfig(), trace.NoOpTracer())
+		addr, err := NewNetAddressString(IDAddressString(id, "127.0.0.1:0"))
+		require.NoError(t, err)
+		require.NoError(t, mt.Listen(*addr))
+		t.Cleanup(func() { _ = mt.Close() })
+		return mt
+	}
+
+	chDescs := []*conn.ChannelDescriptor{{ID: testCh, Priority: 1}}
+	reactor := NewTestReactor(chDescs, true)
+	cfg := peerConfig{
+		chDescs:       chDescs,
+		onPeerError:   func(Peer, interface{}) {},
+		reactorsByCh:  map[byte]Reactor{testCh: reactor},
+		msgTypeByChID: map[byte]proto.Message{testCh: &tmp2p.Message{}},
+		metrics:       NopMetrics(),
+		mlc:           newMetricsLabelCache(),
+	}
+	connect := func(dialer, listener *MultiplexTransport) (out, in Peer) {
+		accepted := make(chan Peer, 1)
+		go func() {
+			p, err := listener.Accept(cfg)
+			if err != nil {
+				t.Error(err)
+			}
+			accepted <- p
+		}()
+		out, err := dialer.Dial(*NewNetAddress(listener.nodeKey.ID(), listener.listener.Addr()), cfg)
+		require.NoError(t, err)
+		in = <-accepted
+		require.NotNil(t, in)
+		for _, p := range []Peer{out, in} {
+			p.SetLogger(log.TestingLogger())
+			require.NoError(t, p.Start())
+			t.Cleanup(func() { _ = p.Stop() })
+		}
+		return out, in
+	}
+
+	// yamux is used if both peers advertise it
+	out, in := connect(newTransport(FeatureYamux), newTransport(FeatureYamux))
+	assert.IsType(t, &conn.YamuxConnection{}, out.(*peer).mconn)
+	assert.IsType(t, &conn.YamuxConnection{}, in.(*peer).mconn)
+	assert.True(t, out.Send(Envelope{ChannelID: testCh, Message: &tmp2p.PexRequest{}}))
+	require.Eventually(t, func() bool {
+		return len(reactor.getMsgs(testCh)) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	out, in = connect(newTransport(FeatureYamux), newTransport(0))
+	assert.IsType(t, &conn.MConnection{}, out.(*peer).mconn)
+	assert.IsType(t, &conn.MConnection{}, in.(*peer).mconn)
+	assert.True(t, out.Send(Envelope{ChannelID: testCh, Message: &tmp2p.PexRequest{}}))
+	require.Eventually(t, func() bool {
+		return len(reactor.getMsgs(testCh)) == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
 // create listener
 func testSetupMultiplexTransport(t *testing.T) *MultiplexTransport {
 	var (
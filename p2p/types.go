@@ -10,6 +10,14 @@ import (
 type ChannelDescriptor = conn.ChannelDescriptor
 type ConnectionStatus = conn.ConnectionStatus
 
+type Compression = conn.Compression
+
+const (
+	CompressionNone   = conn.CompressionNone
+	CompressionSnappy = conn.CompressionSnappy
+	CompressionZstd   = conn.CompressionZstd
+)
+
 type UnprocessedEnvelope struct {
 	Src       IntrospectivePeer
 	Message   []byte
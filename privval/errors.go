@@ -22,6 +22,9 @@ var (
 	ErrWriteTimeout       = errors.New("endpoint write timed out")
 )
 
+// ErrVRFDisabled is returned by FilePV.ProveVRF unless VRF support was enabled.
+var ErrVRFDisabled = errors.New("VRF support is disabled")
+
 // RemoteSignerError allows (remote) validators to include meaningful error
 // descriptions in their reply.
 type RemoteSignerError struct {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/vrf"
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtos "github.com/cometbft/cometbft/libs/os"
@@ -149,6 +150,8 @@ func (lss *FilePVLastSignState) Save() {
 
 //-------------------------------------------------------------------------------
 
+var _ types.VRFPrivValidator = (*FilePV)(nil)
+
 // FilePV implements PrivValidator using data persisted to disk
 // to prevent double signing.
 // NOTE: the directories containing pv.Key.filePath and pv.LastSignState.filePath must already exist.
@@ -157,6 +160,8 @@ func (lss *FilePVLastSignState) Save() {
 type FilePV struct {
 	Key           FilePVKey
 	LastSignState FilePVLastSignState
+
+	vrfEnabled bool
 }
 
 // NewFilePV generates a new validator from the given key and paths.
@@ -275,6 +280,25 @@ func (pv *FilePV) SignProposal(chainID string, proposal *cmtproto.Proposal) erro
 	return nil
 }
 
+// EnableVRF allows ProveVRF to use the validator key. EXPERIMENTAL, see
+// config.BaseConfig.PrivValidatorVRF.
+func (pv *FilePV) EnableVRF() {
+	pv.vrfEnabled = true
+}
+
+// ProveVRF computes the VRF proof of the validator key on msg. It fails
+// unless EnableVRF was called. Implements VRFPrivValidator.
+func (pv *FilePV) ProveVRF(msg []byte) (vrf.Proof, error) {
+	if !pv.vrfEnabled {
+		return nil, ErrVRFDisabled
+	}
+	proof, err := vrf.Prove(pv.Key.PrivKey, msg)
+	if err != nil {
+		return nil, fmt.Errorf("error computing VRF proof: %w", err)
+	}
+	return proof, nil
+}
+
 // Save persists the FilePV to disk.
 func (pv *FilePV) Save() {
 	pv.Key.Save()
@@ -12,6 +12,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/crypto/vrf"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -236,6 +237,25 @@ func TestSignProposal(t *testing.T) {
 	assert.Equal(sig, proposal.Signature)
 }
 
+func TestProveVRF(t *testing.T) {
+	privVal, _, _ := newTestFilePV(t)
+	msg := []byte("msg")
+
+	// disabled by default
+	_, err := privVal.ProveVRF(msg)
+	require.ErrorIs(t, err, ErrVRFDisabled)
+
+	privVal.EnableVRF()
+	proof, err := privVal.ProveVRF(msg)
+	require.NoError(t, err)
+
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	output, err := vrf.Verify(pubKey, proof, msg)
+	require.NoError(t, err)
+	assert.Len(t, output, vrf.OutputSize)
+}
+
 func TestDifferByTimestamp(t *testing.T) {
 	tempKeyFile, err := os.CreateTemp("", "priv_validator_key_")
 	require.Nil(t, err)
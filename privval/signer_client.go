@@ -90,7 +90,9 @@ func (sc *SignerClient) GetPubKey() (crypto.PubKey, error) {
 	return pk, nil
 }
 
-// SignVote requests a remote signer to sign a vote
+// SignVote requests a remote signer to sign a vote. The extension of a
+// non-nil precommit is signed in the same round-trip, the response carrying
+// both signatures.
 func (sc *SignerClient) SignVote(chainID string, vote *cmtproto.Vote) error {
 	response, err := sc.endpoint.SendRequest(mustWrapMsg(&privvalproto.SignVoteRequest{Vote: vote, ChainId: chainID}))
 	if err != nil {
@@ -2,6 +2,7 @@ package privval
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -198,6 +199,60 @@ func TestSignerVote(t *testing.T) {
 	}
 }
 
+func TestSignerVoteExtension(t *testing.T) {
+	for _, tc := range getSignerTestCases(t) {
+		var (
+			requestsMtx sync.Mutex
+			requests    int
+		)
+		tc.signerServer.SetRequestHandler(func(
+			privVal types.PrivValidator,
+			req privvalproto.Message,
+			chainID string,
+		) (privvalproto.Message, error) {
+			if req.GetSignVoteRequest() != nil {
+				requestsMtx.Lock()
+				requests++
+				requestsMtx.Unlock()
+			}
+			return DefaultValidationRequestHandler(privVal, req, chainID)
+		})
+
+		tc := tc
+		t.Cleanup(func() {
+			if err := tc.signerServer.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+		t.Cleanup(func() {
+			if err := tc.signerClient.Close(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		hash := cmtrand.Bytes(tmhash.Size)
+		vote := &types.Vote{
+			Type:             cmtproto.PrecommitType,
+			Height:           1,
+			BlockID:          types.BlockID{Hash: hash, PartSetHeader: types.PartSetHeader{Hash: hash, Total: 2}},
+			Timestamp:        time.Now(),
+			ValidatorAddress: cmtrand.Bytes(crypto.AddressSize),
+			Extension:        []byte("extension"),
+		}
+		have := vote.ToProto()
+		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have))
+
+		// the vote and its extension are signed in a single round-trip
+		requestsMtx.Lock()
+		assert.Equal(t, 1, requests)
+		requestsMtx.Unlock()
+		pubKey, err := tc.mockPV.GetPubKey()
+		require.NoError(t, err)
+		assert.True(t, pubKey.VerifySignature(types.VoteSignBytes(tc.chainID, have), have.Signature))
+		assert.True(t, pubKey.VerifySignature(types.VoteExtensionSignBytes(tc.chainID, have), have.ExtensionSignature))
+	}
+}
+
 func TestSignerVoteResetDeadline(t *testing.T) {
 	for _, tc := range getSignerTestCases(t) {
 		ts := time.Now()
@@ -436,3 +491,79 @@ func TestSignerUnexpectedResponse(t *testing.T) {
 		assert.EqualError(t, e, "empty response")
 	}
 }
+
+func TestSignerPipelinedRequests(t *testing.T) {
+	for _, tc := range getSignerTestCases(t) {
+		SignerListenerEndpointMaxInFlight(2)(tc.signerClient.endpoint)
+		tc.signerServer.SetMaxConcurrentRequests(2)
+
+		// Only respond once both sign requests were received, which requires
+		// them to be in flight and handled concurrently.
+		var (
+			arrivedMtx sync.Mutex
+			arrived    int
+			bothCh     = make(chan struct{})
+		)
+		tc.signerServer.SetRequestHandler(func(
+			privVal types.PrivValidator,
+			req privvalproto.Message,
+			chainID string,
+		) (privvalproto.Message, error) {
+			if req.GetPingRequest() == nil {
+				arrivedMtx.Lock()
+				arrived++
+				if arrived == 2 {
+					close(bothCh)
+				}
+				arrivedMtx.Unlock()
+
+				select {
+				case <-bothCh:
+				case <-time.After(testTimeoutReadWrite / 2):
+				}
+			}
+			return DefaultValidationRequestHandler(privVal, req, chainID)
+		})
+
+		tc := tc
+		t.Cleanup(func() {
+			if err := tc.signerServer.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+		t.Cleanup(func() {
+			if err := tc.signerClient.Close(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		hash := cmtrand.Bytes(tmhash.Size)
+		blockID := types.BlockID{Hash: hash, PartSetHeader: types.PartSetHeader{Hash: hash, Total: 2}}
+		proposal := &types.Proposal{Type: cmtproto.ProposalType, Height: 1, BlockID: blockID, Timestamp: time.Now()}
+		vote := &types.Vote{
+			Type:             cmtproto.PrevoteType,
+			Height:           1,
+			BlockID:          blockID,
+			Timestamp:        time.Now(),
+			ValidatorAddress: cmtrand.Bytes(crypto.AddressSize),
+		}
+		wantProposal, wantVote := proposal.ToProto(), vote.ToProto()
+		require.NoError(t, tc.mockPV.SignProposal(tc.chainID, wantProposal))
+		require.NoError(t, tc.mockPV.SignVote(tc.chainID, wantVote))
+
+		haveProposal, haveVote := proposal.ToProto(), vote.ToProto()
+		errCh := make(chan error, 2)
+		go func() { errCh <- tc.signerClient.SignProposal(tc.chainID, haveProposal) }()
+		go func() { errCh <- tc.signerClient.SignVote(tc.chainID, haveVote) }()
+		require.NoError(t, <-errCh)
+		require.NoError(t, <-errCh)
+
+		select {
+		case <-bothCh:
+		default:
+			t.Fatal("sign requests were not in flight concurrently")
+		}
+		assert.Equal(t, wantProposal.Signature, haveProposal.Signature)
+		assert.Equal(t, wantVote.Signature, haveVote.Signature)
+	}
+}
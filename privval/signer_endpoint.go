@@ -21,6 +21,11 @@ type signerEndpoint struct {
 	connMtx cmtsync.Mutex
 	conn    net.Conn
 
+	// writeMtx serializes writes, so that messages written by concurrent
+	// requests or responses don't interleave. The connection is not locked
+	// during I/O, allowing a message to be written while another is read.
+	writeMtx cmtsync.Mutex
+
 	timeoutReadWrite time.Duration
 }
 
@@ -79,21 +84,19 @@ func (se *signerEndpoint) DropConnection() {
 
 // ReadMessage reads a message from the endpoint
 func (se *signerEndpoint) ReadMessage() (msg privvalproto.Message, err error) {
-	se.connMtx.Lock()
-	defer se.connMtx.Unlock()
-
-	if !se.isConnected() {
+	conn := se.currentConnection()
+	if conn == nil {
 		return msg, fmt.Errorf("endpoint is not connected: %w", ErrNoConnection)
 	}
 	// Reset read deadline
 	deadline := time.Now().Add(se.timeoutReadWrite)
 
-	err = se.conn.SetReadDeadline(deadline)
+	err = conn.SetReadDeadline(deadline)
 	if err != nil {
 		return
 	}
 	const maxRemoteSignerMsgSize = 1024 * 10
-	protoReader := protoio.NewDelimitedReader(se.conn, maxRemoteSignerMsgSize)
+	protoReader := protoio.NewDelimitedReader(conn, maxRemoteSignerMsgSize)
 	_, err = protoReader.ReadMsg(&msg)
 	if _, ok := err.(timeoutError); ok {
 		if err != nil {
@@ -103,7 +106,7 @@ func (se *signerEndpoint) ReadMessage() (msg privvalproto.Message, err error) {
 		}
 
 		se.Logger.Debug("Dropping [read]", "obj", se)
-		se.dropConnection()
+		se.dropConnectionIf(conn)
 	}
 
 	return
@@ -111,18 +114,19 @@ func (se *signerEndpoint) ReadMessage() (msg privvalproto.Message, err error) {
 
 // WriteMessage writes a message from the endpoint
 func (se *signerEndpoint) WriteMessage(msg privvalproto.Message) (err error) {
-	se.connMtx.Lock()
-	defer se.connMtx.Unlock()
+	se.writeMtx.Lock()
+	defer se.writeMtx.Unlock()
 
-	if !se.isConnected() {
+	conn := se.currentConnection()
+	if conn == nil {
 		return fmt.Errorf("endpoint is not connected: %w", ErrNoConnection)
 	}
 
-	protoWriter := protoio.NewDelimitedWriter(se.conn)
+	protoWriter := protoio.NewDelimitedWriter(conn)
 
 	// Reset read deadline
 	deadline := time.Now().Add(se.timeoutReadWrite)
-	err = se.conn.SetWriteDeadline(deadline)
+	err = conn.SetWriteDeadline(deadline)
 	if err != nil {
 		return
 	}
@@ -134,12 +138,27 @@ func (se *signerEndpoint) WriteMessage(msg privvalproto.Message) (err error) {
 		} else {
 			err = fmt.Errorf("empty error: %w", ErrWriteTimeout)
 		}
-		se.dropConnection()
+		se.dropConnectionIf(conn)
 	}
 
 	return
 }
 
+func (se *signerEndpoint) currentConnection() net.Conn {
+	se.connMtx.Lock()
+	defer se.connMtx.Unlock()
+	return se.conn
+}
+
+// dropConnectionIf drops conn unless it has already been replaced.
+func (se *signerEndpoint) dropConnectionIf(conn net.Conn) {
+	se.connMtx.Lock()
+	defer se.connMtx.Unlock()
+	if se.conn == conn {
+		se.dropConnection()
+	}
+}
+
 func (se *signerEndpoint) isConnected() bool {
 	return se.conn != nil
 }
@@ -23,6 +23,22 @@ func SignerListenerEndpointTimeoutReadWrite(timeout time.Duration) SignerListene
 	return func(sl *SignerListenerEndpoint) { sl.signerEndpoint.timeoutReadWrite = timeout }
 }
 
+// SignerListenerEndpointMaxInFlight sets the maximum number of requests that
+// may await a response from the external signing process at the same time.
+// Values greater than 1 tag each request with an ID, allowing e.g. a proposal
+// and a vote to be signed concurrently by signers that support it. Signers
+// that don't echo request IDs are still served, in request order.
+//
+// Default: 1
+func SignerListenerEndpointMaxInFlight(n int) SignerListenerEndpointOption {
+	return func(sl *SignerListenerEndpoint) {
+		sl.maxInFlight = n
+		if n > 1 {
+			sl.inFlight = make(chan struct{}, n)
+		}
+	}
+}
+
 // SignerListenerEndpoint listens for an external process to dial in and keeps
 // the connection alive by dropping and reconnecting.
 //
@@ -41,6 +57,18 @@ type SignerListenerEndpoint struct {
 	pingInterval    time.Duration
 
 	instanceMtx cmtsync.Mutex // Ensures instance public methods access, i.e. SendRequest
+
+	maxInFlight   int
+	inFlight      chan struct{} // semaphore limiting the requests in flight
+	readCh        chan struct{} // held by the request reading responses
+	lastRequestID atomic.Uint64
+	pendingMtx    cmtsync.Mutex
+	pending       map[uint64]chan pendingResult
+}
+
+type pendingResult struct {
+	res *privvalproto.Message
+	err error
 }
 
 // NewSignerListenerEndpoint returns an instance of SignerListenerEndpoint.
@@ -52,6 +80,9 @@ func NewSignerListenerEndpoint(
 	sl := &SignerListenerEndpoint{
 		listener:      listener,
 		timeoutAccept: defaultTimeoutAcceptSeconds * time.Second,
+		maxInFlight:   1,
+		readCh:        make(chan struct{}, 1),
+		pending:       make(map[uint64]chan pendingResult),
 	}
 
 	sl.BaseService = *service.NewBaseService(logger, "SignerListenerEndpoint", sl)
@@ -107,6 +138,10 @@ func (sl *SignerListenerEndpoint) WaitForConnection(maxWait time.Duration) error
 
 // SendRequest ensures there is a connection, sends a request and waits for a response
 func (sl *SignerListenerEndpoint) SendRequest(request privvalproto.Message) (*privvalproto.Message, error) {
+	if sl.maxInFlight > 1 {
+		return sl.sendPipelinedRequest(request)
+	}
+
 	sl.instanceMtx.Lock()
 	defer sl.instanceMtx.Unlock()
 
@@ -131,6 +166,112 @@ func (sl *SignerListenerEndpoint) SendRequest(request privvalproto.Message) (*pr
 	return &res, nil
 }
 
+// sendPipelinedRequest sends a request tagged with a new request ID and waits
+// for the matching response. No background reader is needed: while requests
+// are in flight, one of them reads the responses and hands them to their
+// requests.
+func (sl *SignerListenerEndpoint) sendPipelinedRequest(request privvalproto.Message) (*privvalproto.Message, error) {
+	sl.inFlight <- struct{}{}
+	defer func() { <-sl.inFlight }()
+
+	id := sl.lastRequestID.Add(1)
+	request.RequestId = id
+	resCh := make(chan pendingResult, 1)
+
+	err := func() error {
+		sl.instanceMtx.Lock()
+		defer sl.instanceMtx.Unlock()
+
+		if err := sl.ensureConnection(sl.timeoutAccept); err != nil {
+			return err
+		}
+
+		sl.pendingMtx.Lock()
+		sl.pending[id] = resCh
+		sl.pendingMtx.Unlock()
+
+		if err := sl.WriteMessage(request); err != nil {
+			sl.pendingMtx.Lock()
+			delete(sl.pending, id)
+			sl.pendingMtx.Unlock()
+			return err
+		}
+		return nil
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		select {
+		case r := <-resCh:
+			return sl.requestDone(r)
+		case sl.readCh <- struct{}{}:
+			// The response may have been read while waiting for readCh.
+			select {
+			case r := <-resCh:
+				<-sl.readCh
+				return sl.requestDone(r)
+			default:
+			}
+
+			res, err := sl.ReadMessage()
+			if err != nil {
+				sl.failPending(err)
+			} else {
+				sl.dispatchResponse(&res)
+			}
+			<-sl.readCh
+		}
+	}
+}
+
+func (sl *SignerListenerEndpoint) requestDone(r pendingResult) (*privvalproto.Message, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	// Reset pingTimer to avoid sending unnecessary pings.
+	sl.pingTimer.Reset(sl.pingInterval)
+	return r.res, nil
+}
+
+// dispatchResponse hands res to the request it answers. Responses without a
+// request ID come from signers serving requests in order, and answer the
+// oldest pending request.
+func (sl *SignerListenerEndpoint) dispatchResponse(res *privvalproto.Message) {
+	sl.pendingMtx.Lock()
+	defer sl.pendingMtx.Unlock()
+
+	id := res.RequestId
+	if id == 0 {
+		for pendingID := range sl.pending {
+			if id == 0 || pendingID < id {
+				id = pendingID
+			}
+		}
+	}
+
+	resCh, ok := sl.pending[id]
+	if !ok {
+		sl.Logger.Error("SignerListener: Dropping response to unknown request", "request_id", res.RequestId)
+		return
+	}
+	delete(sl.pending, id)
+	resCh <- pendingResult{res: res}
+}
+
+// failPending fails all pending requests with err. Their responses can no
+// longer be matched once reading from the connection failed.
+func (sl *SignerListenerEndpoint) failPending(err error) {
+	sl.pendingMtx.Lock()
+	defer sl.pendingMtx.Unlock()
+
+	for id, resCh := range sl.pending {
+		delete(sl.pending, id)
+		resCh <- pendingResult{err: err}
+	}
+}
+
 func (sl *SignerListenerEndpoint) ensureConnection(maxWait time.Duration) error {
 	if sl.IsConnected() {
 		return nil
@@ -13,6 +13,7 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	cmtnet "github.com/cometbft/cometbft/libs/net"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	privvalproto "github.com/cometbft/cometbft/proto/tendermint/privval"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -322,3 +323,30 @@ func (l *testListener) Accept() (net.Conn, error) {
 
 	return nil, nil // Note this doesn't actually return a valid connection, it just doesn't error.
 }
+
+func TestSignerListenerEndpointDispatchResponse(t *testing.T) {
+	sl := NewSignerListenerEndpoint(log.TestingLogger(), &testListener{})
+
+	resChs := make([]chan pendingResult, 3)
+	for i := range resChs {
+		resChs[i] = make(chan pendingResult, 1)
+		sl.pending[uint64(i+1)] = resChs[i]
+	}
+
+	// responses with an ID answer their request
+	sl.dispatchResponse(&privvalproto.Message{RequestId: 2})
+	require.Len(t, resChs[1], 1)
+
+	// responses without one answer the oldest pending request
+	sl.dispatchResponse(&privvalproto.Message{})
+	require.Len(t, resChs[0], 1)
+
+	// responses to unknown requests are dropped
+	sl.dispatchResponse(&privvalproto.Message{RequestId: 2})
+	require.Len(t, resChs[2], 0)
+
+	sl.failPending(ErrNoConnection)
+	r := <-resChs[2]
+	assert.ErrorIs(t, r.err, ErrNoConnection)
+	assert.Empty(t, sl.pending)
+}
@@ -24,6 +24,10 @@ type SignerServer struct {
 
 	handlerMtx               cmtsync.Mutex
 	validationRequestHandler ValidationRequestHandlerFunc
+
+	// requestSem limits the requests handled concurrently. It is nil if
+	// requests are handled one at a time.
+	requestSem chan struct{}
 }
 
 func NewSignerServer(endpoint *SignerDialerEndpoint, chainID string, privVal types.PrivValidator) *SignerServer {
@@ -58,6 +62,18 @@ func (ss *SignerServer) SetRequestHandler(validationRequestHandler ValidationReq
 	ss.validationRequestHandler = validationRequestHandler
 }
 
+// SetMaxConcurrentRequests allows up to n requests carrying a request ID to be
+// handled concurrently, which lowers the latency of signers backed by slow
+// devices such as HSMs. The PrivValidator must then be safe for concurrent
+// use, which FilePV is not. It must be called before the server is started.
+func (ss *SignerServer) SetMaxConcurrentRequests(n int) {
+	if n > 1 {
+		ss.requestSem = make(chan struct{}, n)
+	} else {
+		ss.requestSem = nil
+	}
+}
+
 func (ss *SignerServer) servicePendingRequest() {
 	if !ss.IsRunning() {
 		return // Ignore error from closing.
@@ -71,17 +87,35 @@ func (ss *SignerServer) servicePendingRequest() {
 		return
 	}
 
-	var res privvalproto.Message
-	{
-		// limit the scope of the lock
-		ss.handlerMtx.Lock()
-		defer ss.handlerMtx.Unlock()
-		res, err = ss.validationRequestHandler(ss.privVal, req, ss.chainID)
-		if err != nil {
-			// only log the error; we'll reply with an error in res
-			ss.Logger.Error("SignerServer: handleMessage", "err", err)
-		}
+	// Requests without an ID come from clients expecting responses in
+	// request order.
+	if ss.requestSem == nil || req.RequestId == 0 {
+		ss.handleRequest(req)
+		return
+	}
+
+	select {
+	case ss.requestSem <- struct{}{}:
+	case <-ss.Quit():
+		return
+	}
+	go func() {
+		defer func() { <-ss.requestSem }()
+		ss.handleRequest(req)
+	}()
+}
+
+func (ss *SignerServer) handleRequest(req privvalproto.Message) {
+	ss.handlerMtx.Lock()
+	handler := ss.validationRequestHandler
+	ss.handlerMtx.Unlock()
+
+	res, err := handler(ss.privVal, req, ss.chainID)
+	if err != nil {
+		// only log the error; we'll reply with an error in res
+		ss.Logger.Error("SignerServer: handleMessage", "err", err)
 	}
+	res.RequestId = req.RequestId
 
 	err = ss.endpoint.WriteMessage(res)
 	if err != nil {
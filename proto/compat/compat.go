@@ -0,0 +1,56 @@
+// Package compat converts the gogoproto messages of CometBFT, used by its Go
+// APIs, to and from their protobuf-go counterparts generated in the api
+// directory, for the projects which can't depend on gogoproto.
+//
+// Both messages share the same wire format, which the conversions go through.
+package compat
+
+import (
+	"fmt"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// ToV2 converts the gogoproto message m to its protobuf-go counterpart T, e.g.
+//
+//	block, err := compat.ToV2[*typesv2.Block](pbBlock)
+func ToV2[T proto.Message](m gogoproto.Message) (T, error) {
+	var dst T
+	dst = dst.ProtoReflect().Type().New().Interface().(T)
+	if err := checkNames(gogoproto.MessageName(m), dst); err != nil {
+		return dst, err
+	}
+	bz, err := gogoproto.Marshal(m)
+	if err != nil {
+		return dst, fmt.Errorf("marshaling %s: %w", gogoproto.MessageName(m), err)
+	}
+	if err := proto.Unmarshal(bz, dst); err != nil {
+		return dst, fmt.Errorf("unmarshaling %s: %w", gogoproto.MessageName(m), err)
+	}
+	return dst, nil
+}
+
+// FromV2 converts the protobuf-go message m to its gogoproto counterpart dst.
+func FromV2(m proto.Message, dst gogoproto.Message) error {
+	if err := checkNames(gogoproto.MessageName(dst), m); err != nil {
+		return err
+	}
+	bz, err := proto.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("marshaling %s: %w", gogoproto.MessageName(dst), err)
+	}
+	if err := gogoproto.Unmarshal(bz, dst); err != nil {
+		return fmt.Errorf("unmarshaling %s: %w", gogoproto.MessageName(dst), err)
+	}
+	return nil
+}
+
+// checkNames checks that the gogoproto message named name and the protobuf-go
+// message m are counterparts.
+func checkNames(name string, m proto.Message) error {
+	if v2Name := string(m.ProtoReflect().Descriptor().FullName()); name != v2Name {
+		return fmt.Errorf("can't convert between %s and %s", name, v2Name)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package compat_test
+
+import (
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	abciv2 "github.com/cometbft/cometbft/api/tendermint/abci"
+	typesv2 "github.com/cometbft/cometbft/api/tendermint/types"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/proto/compat"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// testBlock returns a block of numTxs transactions of txSize bytes.
+func testBlock(t testing.TB, numTxs, txSize int) *cmtproto.Block {
+	valSet, privVals := types.RandValidatorSet(4, 10)
+	blockID := test.MakeBlockID()
+	commit, err := test.MakeCommit(blockID, 1, 0, valSet, privVals, "test-chain", time.Now())
+	require.NoError(t, err)
+	txs := make([]types.Tx, numTxs)
+	for i := range txs {
+		txs[i] = make(types.Tx, txSize)
+		txs[i][0] = byte(i)
+	}
+	block := types.MakeBlock(2, types.Data{Txs: txs}, commit, nil)
+	block.ProposerAddress = valSet.Proposer.Address
+	pb, err := block.ToProto()
+	require.NoError(t, err)
+	return pb
+}
+
+func TestBlockRoundTrip(t *testing.T) {
+	pb := testBlock(t, 10, 100)
+
+	v2, err := compat.ToV2[*typesv2.Block](pb)
+	require.NoError(t, err)
+	assert.Equal(t, pb.Header.Height, v2.Header.Height)
+	assert.Equal(t, pb.Header.Time.UnixNano(), v2.Header.Time.AsTime().UnixNano())
+	assert.Len(t, v2.Data.Txs, 10)
+	assert.Len(t, v2.LastCommit.Signatures, 4)
+
+	gogoBz, err := gogoproto.Marshal(pb)
+	require.NoError(t, err)
+	v2Bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(v2)
+	require.NoError(t, err)
+	assert.Equal(t, gogoBz, v2Bz)
+
+	back := new(cmtproto.Block)
+	require.NoError(t, compat.FromV2(v2, back))
+	backBz, err := gogoproto.Marshal(back)
+	require.NoError(t, err)
+	assert.Equal(t, gogoBz, backBz)
+}
+
+func TestABCIRoundTrip(t *testing.T) {
+	res := &abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{{
+			Code: 1,
+			Log:  "failed",
+			Events: []abci.Event{{
+				Type:       "transfer",
+				Attributes: []abci.EventAttribute{{Key: "amount", Value: "10", Index: true}},
+			}},
+		}},
+		AppHash: []byte("app_hash"),
+	}
+
+	v2, err := compat.ToV2[*abciv2.ResponseFinalizeBlock](res)
+	require.NoError(t, err)
+	assert.Equal(t, "transfer", v2.TxResults[0].Events[0].Type)
+	assert.True(t, v2.TxResults[0].Events[0].Attributes[0].Index)
+
+	back := new(abci.ResponseFinalizeBlock)
+	require.NoError(t, compat.FromV2(v2, back))
+	assert.Equal(t, res, back)
+}
+
+func TestMismatchedMessages(t *testing.T) {
+	_, err := compat.ToV2[*typesv2.Header](&cmtproto.Block{})
+	assert.Error(t, err)
+	assert.Error(t, compat.FromV2(&typesv2.Header{}, &cmtproto.Block{}))
+}
+
+func BenchmarkBlockMarshal(b *testing.B) {
+	pb := testBlock(b, 1000, 250)
+	v2, err := compat.ToV2[*typesv2.Block](pb)
+	require.NoError(b, err)
+
+	b.Run("gogoproto", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = gogoproto.Marshal(pb)
+		}
+	})
+	b.Run("protobuf-go", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = proto.Marshal(v2)
+		}
+	})
+}
+
+func BenchmarkBlockUnmarshal(b *testing.B) {
+	pb := testBlock(b, 1000, 250)
+	bz, err := gogoproto.Marshal(pb)
+	require.NoError(b, err)
+
+	b.Run("gogoproto", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = gogoproto.Unmarshal(bz, new(cmtproto.Block))
+		}
+	})
+	b.Run("protobuf-go", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = proto.Unmarshal(bz, new(typesv2.Block))
+		}
+	})
+}
+
+func BenchmarkBlockConversion(b *testing.B) {
+	pb := testBlock(b, 1000, 250)
+	v2, err := compat.ToV2[*typesv2.Block](pb)
+	require.NoError(b, err)
+
+	b.Run("ToV2", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = compat.ToV2[*typesv2.Block](pb)
+		}
+	})
+	b.Run("FromV2", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = compat.FromV2(v2, new(cmtproto.Block))
+		}
+	})
+}
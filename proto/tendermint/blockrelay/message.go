@@ -0,0 +1,49 @@
+package blockrelay
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cometbft/cometbft/p2p"
+)
+
+var _ p2p.Wrapper = &Subscribe{}
+var _ p2p.Wrapper = &Unsubscribe{}
+var _ p2p.Wrapper = &Block{}
+
+func (m *Subscribe) Wrap() proto.Message {
+	bm := &Message{}
+	bm.Sum = &Message_Subscribe{Subscribe: m}
+	return bm
+}
+
+func (m *Unsubscribe) Wrap() proto.Message {
+	bm := &Message{}
+	bm.Sum = &Message_Unsubscribe{Unsubscribe: m}
+	return bm
+}
+
+func (m *Block) Wrap() proto.Message {
+	bm := &Message{}
+	bm.Sum = &Message_Block{Block: m}
+	return bm
+}
+
+// Unwrap implements the p2p Wrapper interface and unwraps a wrapped block
+// relay message.
+func (m *Message) Unwrap() (proto.Message, error) {
+	switch msg := m.Sum.(type) {
+	case *Message_Subscribe:
+		return m.GetSubscribe(), nil
+
+	case *Message_Unsubscribe:
+		return m.GetUnsubscribe(), nil
+
+	case *Message_Block:
+		return m.GetBlock(), nil
+
+	default:
+		return nil, fmt.Errorf("unknown message: %T", msg)
+	}
+}
@@ -17,6 +17,10 @@ var _ p2p.Wrapper = &NewValidBlock{}
 var _ p2p.Wrapper = &NewRoundStep{}
 var _ p2p.Wrapper = &HasVote{}
 var _ p2p.Wrapper = &BlockPart{}
+var _ p2p.Wrapper = &GossipStrategy{}
+var _ p2p.Wrapper = &BlockPartHaves{}
+var _ p2p.Wrapper = &BlockPartWants{}
+var _ p2p.Wrapper = &EmptyBlock{}
 
 func (m *VoteSetBits) Wrap() proto.Message {
 	cm := &Message{}
@@ -73,6 +77,30 @@ func (m *NewRoundStep) Wrap() proto.Message {
 	return cm
 }
 
+func (m *GossipStrategy) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_GossipStrategy{GossipStrategy: m}
+	return cm
+}
+
+func (m *BlockPartHaves) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_BlockPartHaves{BlockPartHaves: m}
+	return cm
+}
+
+func (m *BlockPartWants) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_BlockPartWants{BlockPartWants: m}
+	return cm
+}
+
+func (m *EmptyBlock) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_EmptyBlock{EmptyBlock: m}
+	return cm
+}
+
 // Unwrap implements the p2p Wrapper interface and unwraps a wrapped consensus
 // proto message.
 func (m *Message) Unwrap() (proto.Message, error) {
@@ -104,6 +132,18 @@ func (m *Message) Unwrap() (proto.Message, error) {
 	case *Message_VoteSetBits:
 		return m.GetVoteSetBits(), nil
 
+	case *Message_GossipStrategy:
+		return m.GetGossipStrategy(), nil
+
+	case *Message_BlockPartHaves:
+		return m.GetBlockPartHaves(), nil
+
+	case *Message_BlockPartWants:
+		return m.GetBlockPartWants(), nil
+
+	case *Message_EmptyBlock:
+		return m.GetEmptyBlock(), nil
+
 	default:
 		return nil, fmt.Errorf("unknown message: %T", msg)
 	}
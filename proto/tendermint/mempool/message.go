@@ -32,6 +32,13 @@ func (m *WantTx) Wrap() proto.Message {
 	return mm
 }
 
+// Wrap implements the p2p Wrapper interface and wraps a mempool summary message.
+func (m *Summary) Wrap() proto.Message {
+	mm := &Message{}
+	mm.Sum = &Message_Summary{Summary: m}
+	return mm
+}
+
 // Unwrap implements the p2p Wrapper interface and unwraps a wrapped mempool
 // message.
 func (m *Message) Unwrap() (proto.Message, error) {
@@ -43,6 +50,9 @@ func (m *Message) Unwrap() (proto.Message, error) {
 
 	case *Message_WantTx:
 		return m.GetWantTx(), nil
+
+	case *Message_Summary:
+		return m.GetSummary(), nil
 	default:
 		return nil, fmt.Errorf("unknown message: %T", msg)
 	}
@@ -154,12 +154,86 @@ func (m *WantTx) GetTxKey() []byte {
 	return nil
 }
 
+// Summary is a compact summary of a node's mempool which is periodically sent
+// to peers so that they can skip broadcasting transactions which would not be
+// admitted.
+type Summary struct {
+	NumTxs   int64 `protobuf:"varint,1,opt,name=num_txs,json=numTxs,proto3" json:"num_txs,omitempty"`
+	NumBytes int64 `protobuf:"varint,2,opt,name=num_bytes,json=numBytes,proto3" json:"num_bytes,omitempty"`
+	// min_priority is the lowest priority of a transaction in the mempool. When
+	// the mempool is full, transactions need a higher priority to be admitted.
+	MinPriority int64 `protobuf:"varint,3,opt,name=min_priority,json=minPriority,proto3" json:"min_priority,omitempty"`
+	Full        bool  `protobuf:"varint,4,opt,name=full,proto3" json:"full,omitempty"`
+}
+
+func (m *Summary) Reset()         { *m = Summary{} }
+func (m *Summary) String() string { return proto.CompactTextString(m) }
+func (*Summary) ProtoMessage()    {}
+func (*Summary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2af51926fdbcbc05, []int{3}
+}
+func (m *Summary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Summary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Summary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Summary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Summary.Merge(m, src)
+}
+func (m *Summary) XXX_Size() int {
+	return m.Size()
+}
+func (m *Summary) XXX_DiscardUnknown() {
+	xxx_messageInfo_Summary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Summary proto.InternalMessageInfo
+
+func (m *Summary) GetNumTxs() int64 {
+	if m != nil {
+		return m.NumTxs
+	}
+	return 0
+}
+
+func (m *Summary) GetNumBytes() int64 {
+	if m != nil {
+		return m.NumBytes
+	}
+	return 0
+}
+
+func (m *Summary) GetMinPriority() int64 {
+	if m != nil {
+		return m.MinPriority
+	}
+	return 0
+}
+
+func (m *Summary) GetFull() bool {
+	if m != nil {
+		return m.Full
+	}
+	return false
+}
+
 type Message struct {
 	// Types that are valid to be assigned to Sum:
 	//
 	//	*Message_Txs
 	//	*Message_SeenTx
 	//	*Message_WantTx
+	//	*Message_Summary
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -167,7 +241,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2af51926fdbcbc05, []int{3}
+	return fileDescriptor_2af51926fdbcbc05, []int{4}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,10 +285,14 @@ type Message_SeenTx struct {
 type Message_WantTx struct {
 	WantTx *WantTx `protobuf:"bytes,3,opt,name=want_tx,json=wantTx,proto3,oneof" json:"want_tx,omitempty"`
 }
+type Message_Summary struct {
+	Summary *Summary `protobuf:"bytes,4,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
+}
 
-func (*Message_Txs) isMessage_Sum()    {}
-func (*Message_SeenTx) isMessage_Sum() {}
-func (*Message_WantTx) isMessage_Sum() {}
+func (*Message_Txs) isMessage_Sum()     {}
+func (*Message_SeenTx) isMessage_Sum()  {}
+func (*Message_WantTx) isMessage_Sum()  {}
+func (*Message_Summary) isMessage_Sum() {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -244,12 +322,20 @@ func (m *Message) GetWantTx() *WantTx {
 	return nil
 }
 
+func (m *Message) GetSummary() *Summary {
+	if x, ok := m.GetSum().(*Message_Summary); ok {
+		return x.Summary
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Message_Txs)(nil),
 		(*Message_SeenTx)(nil),
 		(*Message_WantTx)(nil),
+		(*Message_Summary)(nil),
 	}
 }
 
@@ -257,31 +343,37 @@ func init() {
 	proto.RegisterType((*Txs)(nil), "tendermint.mempool.Txs")
 	proto.RegisterType((*SeenTx)(nil), "tendermint.mempool.SeenTx")
 	proto.RegisterType((*WantTx)(nil), "tendermint.mempool.WantTx")
+	proto.RegisterType((*Summary)(nil), "tendermint.mempool.Summary")
 	proto.RegisterType((*Message)(nil), "tendermint.mempool.Message")
 }
 
 func init() { proto.RegisterFile("tendermint/mempool/types.proto", fileDescriptor_2af51926fdbcbc05) }
 
 var fileDescriptor_2af51926fdbcbc05 = []byte{
-	// 274 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x49, 0xcd, 0x4b,
-	0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0xcf, 0x4d, 0xcd, 0x2d, 0xc8, 0xcf, 0xcf, 0xd1, 0x2f,
-	0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x42, 0xc8, 0xeb, 0x41,
-	0xe5, 0x95, 0xc4, 0xb9, 0x98, 0x43, 0x2a, 0x8a, 0x85, 0x04, 0xb8, 0x98, 0x4b, 0x2a, 0x8a, 0x25,
-	0x18, 0x15, 0x98, 0x35, 0x78, 0x82, 0x40, 0x4c, 0x25, 0x79, 0x2e, 0xb6, 0xe0, 0xd4, 0xd4, 0xbc,
-	0x90, 0x0a, 0x21, 0x51, 0x2e, 0xb6, 0x92, 0x8a, 0xf8, 0xec, 0xd4, 0x4a, 0x09, 0x46, 0x05, 0x46,
-	0x0d, 0x9e, 0x20, 0xd6, 0x92, 0x0a, 0xef, 0xd4, 0x4a, 0x90, 0x82, 0xf0, 0xc4, 0xbc, 0x12, 0xdc,
-	0x0a, 0x56, 0x33, 0x72, 0xb1, 0xfb, 0xa6, 0x16, 0x17, 0x27, 0xa6, 0xa7, 0x0a, 0x69, 0xc3, 0xcc,
-	0x67, 0xd4, 0xe0, 0x36, 0x12, 0xd7, 0xc3, 0x74, 0x88, 0x5e, 0x48, 0x45, 0xb1, 0x07, 0x03, 0xd8,
-	0x6a, 0x21, 0x53, 0x2e, 0xf6, 0xe2, 0xd4, 0xd4, 0xbc, 0xf8, 0x92, 0x0a, 0x09, 0x26, 0xb0, 0x06,
-	0x29, 0x6c, 0x1a, 0x20, 0xae, 0xf3, 0x60, 0x08, 0x62, 0x2b, 0x86, 0xb8, 0xd3, 0x94, 0x8b, 0xbd,
-	0x3c, 0x31, 0xaf, 0x04, 0xa4, 0x8d, 0x19, 0xb7, 0x36, 0x88, 0x9b, 0x41, 0xda, 0xca, 0xc1, 0x2c,
-	0x27, 0x56, 0x2e, 0xe6, 0xe2, 0xd2, 0x5c, 0x27, 0xff, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0x88, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f,
-	0xce, 0xcf, 0x4d, 0x2d, 0x49, 0x4a, 0x2b, 0x41, 0x30, 0xc0, 0x41, 0xab, 0x8f, 0x19, 0xf2, 0x49,
-	0x6c, 0x60, 0x19, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0x7b, 0xde, 0x37, 0x96, 0x01,
-	0x00, 0x00,
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xc1, 0x6a, 0xea, 0x40,
+	0x14, 0xcd, 0xbc, 0x68, 0xe2, 0x9b, 0xb8, 0x78, 0x0c, 0x3c, 0x0c, 0x15, 0x52, 0xeb, 0x2a, 0x50,
+	0x48, 0xc0, 0x22, 0xdd, 0xbb, 0x12, 0x4a, 0x69, 0x89, 0x42, 0xa1, 0x9b, 0x90, 0xd8, 0xd1, 0x86,
+	0x3a, 0x93, 0x90, 0xb9, 0xa9, 0xc9, 0x5f, 0xf4, 0xb3, 0xba, 0x74, 0xd9, 0x65, 0xd1, 0x7e, 0x48,
+	0x99, 0x89, 0xe2, 0x42, 0xdd, 0x9d, 0x99, 0x73, 0x0e, 0xe7, 0xde, 0xc3, 0xc5, 0x0e, 0x50, 0xfe,
+	0x42, 0x73, 0x96, 0x70, 0xf0, 0x19, 0x65, 0x59, 0x9a, 0x2e, 0x7d, 0xa8, 0x32, 0x2a, 0xbc, 0x2c,
+	0x4f, 0x21, 0x25, 0xe4, 0xc0, 0x7b, 0x3b, 0xbe, 0xdf, 0xc1, 0xfa, 0xb4, 0x14, 0xe4, 0x1f, 0xd6,
+	0xa1, 0x14, 0x36, 0xea, 0xe9, 0x6e, 0x3b, 0x90, 0xb0, 0x7f, 0x89, 0x8d, 0x09, 0xa5, 0x7c, 0x5a,
+	0x92, 0xff, 0xd8, 0x80, 0x32, 0x7c, 0xa3, 0x95, 0x8d, 0x7a, 0xc8, 0x6d, 0x07, 0x4d, 0x28, 0xef,
+	0x68, 0x25, 0x05, 0x4f, 0x11, 0x87, 0xf3, 0x82, 0x77, 0x6c, 0x4e, 0x0a, 0xc6, 0xa2, 0xbc, 0x22,
+	0x1d, 0x6c, 0xf2, 0x82, 0x85, 0x75, 0x04, 0x72, 0xf5, 0xc0, 0xe0, 0x05, 0x93, 0xb9, 0x5d, 0xfc,
+	0x57, 0x12, 0x71, 0x05, 0x54, 0xd8, 0x7f, 0x14, 0xd5, 0xe2, 0x05, 0x1b, 0xc9, 0x37, 0xb9, 0xc2,
+	0x6d, 0x96, 0xf0, 0x30, 0xcb, 0x93, 0x34, 0x4f, 0xa0, 0xb2, 0x75, 0xc5, 0x5b, 0x2c, 0xe1, 0x8f,
+	0xbb, 0x2f, 0x42, 0x70, 0x63, 0x5e, 0x2c, 0x97, 0x76, 0xa3, 0x87, 0xdc, 0x56, 0xa0, 0x70, 0xff,
+	0x07, 0x61, 0xf3, 0x9e, 0x0a, 0x11, 0x2d, 0x28, 0xb9, 0xde, 0xef, 0x85, 0x5c, 0x6b, 0xd0, 0xf1,
+	0x8e, 0x0b, 0xf0, 0xa6, 0xa5, 0x18, 0x6b, 0x6a, 0x65, 0x32, 0xc4, 0xa6, 0xa0, 0x94, 0x87, 0x50,
+	0xaa, 0x51, 0xac, 0xc1, 0xc5, 0x29, 0x43, 0xdd, 0xca, 0x58, 0x0b, 0x0c, 0xa1, 0x90, 0xb4, 0xad,
+	0x22, 0x0e, 0xd2, 0xa6, 0x9f, 0xb7, 0xd5, 0x5d, 0x49, 0xdb, 0x4a, 0x21, 0x72, 0x8b, 0x4d, 0x51,
+	0xd7, 0xa3, 0xa6, 0xb7, 0x06, 0xdd, 0x93, 0x69, 0xb5, 0x64, 0xac, 0x05, 0x7b, 0xf5, 0xa8, 0x89,
+	0x75, 0x51, 0xb0, 0xd1, 0xc3, 0xe7, 0xc6, 0x41, 0xeb, 0x8d, 0x83, 0xbe, 0x37, 0x0e, 0xfa, 0xd8,
+	0x3a, 0xda, 0x7a, 0xeb, 0x68, 0x5f, 0x5b, 0x47, 0x7b, 0x1e, 0x2e, 0x12, 0x78, 0x2d, 0x62, 0x6f,
+	0x96, 0x32, 0x7f, 0x96, 0x32, 0x0a, 0xf1, 0x1c, 0x0e, 0x40, 0xdd, 0x82, 0x7f, 0x7c, 0x2a, 0xb1,
+	0xa1, 0x98, 0x9b, 0xdf, 0x01, 0x00, 0xdd, 0x24, 0x6a, 0x85, 0x47, 0x02, 0x00, 0x00,
 }
 
 func (m *Txs) Marshal() (dAtA []byte, err error) {
@@ -376,6 +468,54 @@ func (m *WantTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Summary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Summary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Summary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Full {
+		i--
+		if m.Full {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinPriority != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinPriority))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NumBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumTxs != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NumTxs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Message) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -471,6 +611,27 @@ func (m *Message_WantTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_Summary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_Summary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Summary != nil {
+		{
+			size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -523,6 +684,27 @@ func (m *WantTx) Size() (n int) {
 	return n
 }
 
+func (m *Summary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumTxs != 0 {
+		n += 1 + sovTypes(uint64(m.NumTxs))
+	}
+	if m.NumBytes != 0 {
+		n += 1 + sovTypes(uint64(m.NumBytes))
+	}
+	if m.MinPriority != 0 {
+		n += 1 + sovTypes(uint64(m.MinPriority))
+	}
+	if m.Full {
+		n += 2
+	}
+	return n
+}
+
 func (m *Message) Size() (n int) {
 	if m == nil {
 		return 0
@@ -571,6 +753,18 @@ func (m *Message_WantTx) Size() (n int) {
 	}
 	return n
 }
+func (m *Message_Summary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Summary != nil {
+		l = m.Summary.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -828,6 +1022,133 @@ func (m *WantTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Summary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Summary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Summary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+			}
+			m.NumTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTxs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBytes", wireType)
+			}
+			m.NumBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriority", wireType)
+			}
+			m.MinPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPriority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Full = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Message) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -962,6 +1283,41 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_WantTx{v}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Summary{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_Summary{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -8,15 +8,19 @@ import (
 	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -60,7 +64,11 @@ func (m *PacketPing) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PacketPing proto.InternalMessageInfo
 
+// PacketPong answers a PacketPing. time is the clock of the sender when
+// answering, used to estimate the clock offset between peers. It is unset by
+// older nodes.
 type PacketPong struct {
+	Time *time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time,omitempty"`
 }
 
 func (m *PacketPong) Reset()         { *m = PacketPong{} }
@@ -96,10 +104,21 @@ func (m *PacketPong) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PacketPong proto.InternalMessageInfo
 
+func (m *PacketPong) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// PacketMsg is a chunk of a message sent over a channel. sequence numbers the
+// PacketMsgs sent over the connection, starting at 1, if both peers advertise
+// the packet sequence feature, and is unset otherwise.
 type PacketMsg struct {
 	ChannelID int32  `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	EOF       bool   `protobuf:"varint,2,opt,name=eof,proto3" json:"eof,omitempty"`
 	Data      []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Sequence  uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
 func (m *PacketMsg) Reset()         { *m = PacketMsg{} }
@@ -156,12 +175,74 @@ func (m *PacketMsg) GetData() []byte {
 	return nil
 }
 
+func (m *PacketMsg) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// PacketChannel informs the peer that a channel was registered on the
+// connection, or unregistered, after the connection was established. It is
+// only sent if both peers advertise the channel registration feature.
+type PacketChannel struct {
+	ChannelID  int32 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Registered bool  `protobuf:"varint,2,opt,name=registered,proto3" json:"registered,omitempty"`
+}
+
+func (m *PacketChannel) Reset()         { *m = PacketChannel{} }
+func (m *PacketChannel) String() string { return proto.CompactTextString(m) }
+func (*PacketChannel) ProtoMessage()    {}
+func (*PacketChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22474b5527c8fa9f, []int{3}
+}
+func (m *PacketChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketChannel.Merge(m, src)
+}
+func (m *PacketChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketChannel proto.InternalMessageInfo
+
+func (m *PacketChannel) GetChannelID() int32 {
+	if m != nil {
+		return m.ChannelID
+	}
+	return 0
+}
+
+func (m *PacketChannel) GetRegistered() bool {
+	if m != nil {
+		return m.Registered
+	}
+	return false
+}
+
 type Packet struct {
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*Packet_PacketPing
 	//	*Packet_PacketPong
 	//	*Packet_PacketMsg
+	//	*Packet_PacketChannel
 	Sum isPacket_Sum `protobuf_oneof:"sum"`
 }
 
@@ -169,7 +250,7 @@ func (m *Packet) Reset()         { *m = Packet{} }
 func (m *Packet) String() string { return proto.CompactTextString(m) }
 func (*Packet) ProtoMessage()    {}
 func (*Packet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_22474b5527c8fa9f, []int{3}
+	return fileDescriptor_22474b5527c8fa9f, []int{4}
 }
 func (m *Packet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,10 +294,14 @@ type Packet_PacketPong struct {
 type Packet_PacketMsg struct {
 	PacketMsg *PacketMsg `protobuf:"bytes,3,opt,name=packet_msg,json=packetMsg,proto3,oneof" json:"packet_msg,omitempty"`
 }
+type Packet_PacketChannel struct {
+	PacketChannel *PacketChannel `protobuf:"bytes,4,opt,name=packet_channel,json=packetChannel,proto3,oneof" json:"packet_channel,omitempty"`
+}
 
-func (*Packet_PacketPing) isPacket_Sum() {}
-func (*Packet_PacketPong) isPacket_Sum() {}
-func (*Packet_PacketMsg) isPacket_Sum()  {}
+func (*Packet_PacketPing) isPacket_Sum()    {}
+func (*Packet_PacketPong) isPacket_Sum()    {}
+func (*Packet_PacketMsg) isPacket_Sum()     {}
+func (*Packet_PacketChannel) isPacket_Sum() {}
 
 func (m *Packet) GetSum() isPacket_Sum {
 	if m != nil {
@@ -246,12 +331,20 @@ func (m *Packet) GetPacketMsg() *PacketMsg {
 	return nil
 }
 
+func (m *Packet) GetPacketChannel() *PacketChannel {
+	if x, ok := m.GetSum().(*Packet_PacketChannel); ok {
+		return x.PacketChannel
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Packet) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Packet_PacketPing)(nil),
 		(*Packet_PacketPong)(nil),
 		(*Packet_PacketMsg)(nil),
+		(*Packet_PacketChannel)(nil),
 	}
 }
 
@@ -264,7 +357,7 @@ func (m *AuthSigMessage) Reset()         { *m = AuthSigMessage{} }
 func (m *AuthSigMessage) String() string { return proto.CompactTextString(m) }
 func (*AuthSigMessage) ProtoMessage()    {}
 func (*AuthSigMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_22474b5527c8fa9f, []int{4}
+	return fileDescriptor_22474b5527c8fa9f, []int{5}
 }
 func (m *AuthSigMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,6 +404,7 @@ func init() {
 	proto.RegisterType((*PacketPing)(nil), "tendermint.p2p.PacketPing")
 	proto.RegisterType((*PacketPong)(nil), "tendermint.p2p.PacketPong")
 	proto.RegisterType((*PacketMsg)(nil), "tendermint.p2p.PacketMsg")
+	proto.RegisterType((*PacketChannel)(nil), "tendermint.p2p.PacketChannel")
 	proto.RegisterType((*Packet)(nil), "tendermint.p2p.Packet")
 	proto.RegisterType((*AuthSigMessage)(nil), "tendermint.p2p.AuthSigMessage")
 }
@@ -318,32 +412,39 @@ func init() {
 func init() { proto.RegisterFile("tendermint/p2p/conn.proto", fileDescriptor_22474b5527c8fa9f) }
 
 var fileDescriptor_22474b5527c8fa9f = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4d, 0x8f, 0xd3, 0x30,
-	0x10, 0x8d, 0xc9, 0x6e, 0x97, 0x4e, 0xcb, 0x0a, 0x59, 0x1c, 0xda, 0x6a, 0x95, 0x56, 0x3d, 0xf5,
-	0x80, 0x12, 0x11, 0x6e, 0x20, 0x0e, 0x84, 0x0f, 0xb1, 0xaa, 0x2a, 0xaa, 0x70, 0xe3, 0x12, 0xe5,
-	0xc3, 0xeb, 0x58, 0xdd, 0xd8, 0x56, 0xed, 0x1c, 0xf2, 0x2f, 0xf8, 0x59, 0xcb, 0xad, 0x47, 0x4e,
-	0x15, 0x4a, 0xff, 0x08, 0x4a, 0x5c, 0x68, 0x2a, 0xb1, 0xb7, 0xf7, 0x66, 0xfc, 0x66, 0xde, 0x93,
-	0x07, 0xc6, 0x9a, 0xf0, 0x8c, 0x6c, 0x0b, 0xc6, 0xb5, 0x27, 0x7d, 0xe9, 0xa5, 0x82, 0x73, 0x57,
-	0x6e, 0x85, 0x16, 0xf8, 0xfa, 0xd4, 0x72, 0xa5, 0x2f, 0x27, 0x2f, 0xa8, 0xa0, 0xa2, 0x6d, 0x79,
-	0x0d, 0x32, 0xaf, 0x26, 0x37, 0x9d, 0x01, 0xe9, 0xb6, 0x92, 0x5a, 0x78, 0x1b, 0x52, 0x29, 0xd3,
-	0x9d, 0x0f, 0x01, 0xd6, 0x71, 0xba, 0x21, 0x7a, 0xcd, 0x38, 0xed, 0x30, 0xc1, 0xe9, 0x3c, 0x87,
-	0xbe, 0x61, 0x2b, 0x45, 0xf1, 0x4b, 0x80, 0x34, 0x8f, 0x39, 0x27, 0xf7, 0x11, 0xcb, 0x46, 0x68,
-	0x86, 0x16, 0x97, 0xc1, 0xb3, 0x7a, 0x3f, 0xed, 0x7f, 0x30, 0xd5, 0xdb, 0x8f, 0x61, 0xff, 0xf8,
-	0xe0, 0x36, 0xc3, 0x63, 0xb0, 0x89, 0xb8, 0x1b, 0x3d, 0x99, 0xa1, 0xc5, 0xd3, 0xe0, 0xaa, 0xde,
-	0x4f, 0xed, 0x4f, 0x5f, 0x3f, 0x87, 0x4d, 0x0d, 0x63, 0xb8, 0xc8, 0x62, 0x1d, 0x8f, 0xec, 0x19,
-	0x5a, 0x0c, 0xc3, 0x16, 0xcf, 0x7f, 0x22, 0xe8, 0x99, 0x55, 0xf8, 0x1d, 0x0c, 0x64, 0x8b, 0x22,
-	0xc9, 0x38, 0x6d, 0x17, 0x0d, 0xfc, 0x89, 0x7b, 0x1e, 0xd5, 0x3d, 0x79, 0xfe, 0x62, 0x85, 0x20,
-	0xff, 0xb1, 0xae, 0x5c, 0x70, 0xda, 0x1a, 0x78, 0x5c, 0x2e, 0xce, 0xe4, 0x82, 0x53, 0xfc, 0x06,
-	0x8e, 0x2c, 0x2a, 0x14, 0x6d, 0x2d, 0x0e, 0xfc, 0xf1, 0xff, 0xd5, 0x2b, 0xd5, 0x88, 0xfb, 0xf2,
-	0x2f, 0x09, 0x2e, 0xc1, 0x56, 0x65, 0x31, 0x8f, 0xe0, 0xfa, 0x7d, 0xa9, 0xf3, 0x6f, 0x8c, 0xae,
-	0x88, 0x52, 0x31, 0x25, 0xf8, 0x2d, 0x5c, 0xc9, 0x32, 0x89, 0x36, 0xa4, 0x3a, 0xc6, 0xb9, 0xe9,
-	0x4e, 0x34, 0x7f, 0xe2, 0xae, 0xcb, 0xe4, 0x9e, 0xa5, 0x4b, 0x52, 0x05, 0x17, 0x0f, 0xfb, 0xa9,
-	0x15, 0xf6, 0x64, 0x99, 0x2c, 0x49, 0x85, 0x9f, 0x83, 0xad, 0x98, 0x09, 0x32, 0x0c, 0x1b, 0x18,
-	0x2c, 0x1f, 0x6a, 0x07, 0xed, 0x6a, 0x07, 0xfd, 0xae, 0x1d, 0xf4, 0xe3, 0xe0, 0x58, 0xbb, 0x83,
-	0x63, 0xfd, 0x3a, 0x38, 0xd6, 0xf7, 0x57, 0x94, 0xe9, 0xbc, 0x4c, 0xdc, 0x54, 0x14, 0x5e, 0x2a,
-	0x0a, 0xa2, 0x93, 0x3b, 0x7d, 0x02, 0xe6, 0x32, 0xce, 0xcf, 0x29, 0xe9, 0xb5, 0xd5, 0xd7, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xa9, 0xf9, 0x75, 0xae, 0x67, 0x02, 0x00, 0x00,
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x8f, 0xd2, 0x40,
+	0x18, 0xee, 0x2c, 0x2c, 0x0b, 0x2f, 0x1f, 0x31, 0x8d, 0x07, 0x20, 0x6b, 0x4b, 0x7a, 0xe2, 0x60,
+	0xda, 0x88, 0x17, 0xa3, 0xf1, 0x60, 0x55, 0xb2, 0x1b, 0x42, 0x24, 0xd5, 0x93, 0x89, 0x21, 0x6d,
+	0x19, 0x86, 0x06, 0x3a, 0x33, 0x32, 0xd3, 0x03, 0x77, 0x7f, 0xc0, 0xfa, 0xaf, 0x38, 0xee, 0xd1,
+	0x13, 0x1a, 0xf8, 0x23, 0xa6, 0x9d, 0x2e, 0x94, 0x64, 0x63, 0xe2, 0xed, 0xfd, 0x7a, 0x9e, 0xe7,
+	0xed, 0xf3, 0x76, 0xa0, 0x23, 0x31, 0x9d, 0xe1, 0x75, 0x1c, 0x51, 0xe9, 0xf0, 0x01, 0x77, 0x42,
+	0x46, 0xa9, 0xcd, 0xd7, 0x4c, 0x32, 0xbd, 0x75, 0x6a, 0xd9, 0x7c, 0xc0, 0xbb, 0x4f, 0x09, 0x23,
+	0x2c, 0x6b, 0x39, 0x69, 0xa4, 0xa6, 0xba, 0x26, 0x61, 0x8c, 0xac, 0xb0, 0x93, 0x65, 0x41, 0x32,
+	0x77, 0x64, 0x14, 0x63, 0x21, 0xfd, 0x98, 0xe7, 0x03, 0xd7, 0x05, 0x85, 0x70, 0xbd, 0xe1, 0x92,
+	0x39, 0x4b, 0xbc, 0x11, 0xaa, 0x6b, 0x35, 0x00, 0x26, 0x7e, 0xb8, 0xc4, 0x72, 0x12, 0x51, 0x62,
+	0x0d, 0x8f, 0x19, 0xa3, 0x44, 0x7f, 0x05, 0xe5, 0x94, 0xac, 0x8d, 0x7a, 0xa8, 0x5f, 0x1f, 0x74,
+	0x6d, 0xa5, 0x64, 0x3f, 0x28, 0xd9, 0x5f, 0x1e, 0x94, 0xdc, 0xea, 0x76, 0x67, 0xa2, 0xbb, 0xdf,
+	0x26, 0xf2, 0x32, 0x84, 0xf5, 0x03, 0x41, 0x4d, 0x11, 0x8d, 0x05, 0xd1, 0x9f, 0x03, 0x84, 0x0b,
+	0x9f, 0x52, 0xbc, 0x9a, 0x46, 0xb3, 0x8c, 0xed, 0xd2, 0x6d, 0xee, 0x77, 0x66, 0xed, 0xbd, 0xaa,
+	0xde, 0x7e, 0xf0, 0x6a, 0xf9, 0xc0, 0xed, 0x4c, 0xef, 0x40, 0x09, 0xb3, 0x79, 0xfb, 0xa2, 0x87,
+	0xfa, 0x55, 0xf7, 0x6a, 0xbf, 0x33, 0x4b, 0x1f, 0x3f, 0x0d, 0xbd, 0xb4, 0xa6, 0xeb, 0x50, 0x9e,
+	0xf9, 0xd2, 0x6f, 0x97, 0x7a, 0xa8, 0xdf, 0xf0, 0xb2, 0x58, 0xef, 0x42, 0x55, 0xe0, 0xef, 0x09,
+	0xa6, 0x21, 0x6e, 0x97, 0x7b, 0xa8, 0x5f, 0xf6, 0x8e, 0xb9, 0xf5, 0x0d, 0x9a, 0x6a, 0x8b, 0x5c,
+	0xe8, 0x3f, 0x37, 0x31, 0x00, 0xd6, 0x98, 0x44, 0x42, 0xe2, 0x35, 0x9e, 0xa9, 0x85, 0xbc, 0x42,
+	0xc5, 0xfa, 0x79, 0x01, 0x15, 0xc5, 0xaf, 0xbf, 0x85, 0x3a, 0xcf, 0xa2, 0x29, 0x8f, 0x28, 0x39,
+	0x3a, 0x76, 0x7e, 0x41, 0xfb, 0xe4, 0xf4, 0x8d, 0xe6, 0x01, 0x3f, 0x66, 0x45, 0x38, 0xa3, 0xa4,
+	0x7d, 0xf1, 0x4f, 0x38, 0x3b, 0x83, 0xa7, 0x87, 0x7a, 0x0d, 0x79, 0x36, 0x8d, 0x05, 0xc9, 0xdc,
+	0xa9, 0x0f, 0x3a, 0x8f, 0xa3, 0xc7, 0x22, 0x05, 0xd7, 0xf8, 0xf1, 0x38, 0x43, 0x68, 0xe5, 0xd8,
+	0xfc, 0xc3, 0x33, 0x17, 0xeb, 0x83, 0x67, 0x8f, 0xe3, 0x73, 0xa3, 0x6e, 0x34, 0xaf, 0xc9, 0x8b,
+	0x05, 0xf7, 0x12, 0x4a, 0x22, 0x89, 0xad, 0x29, 0xb4, 0xde, 0x25, 0x72, 0xf1, 0x39, 0x22, 0x63,
+	0x2c, 0x84, 0x4f, 0xb0, 0xfe, 0x06, 0xae, 0x78, 0x12, 0x4c, 0x97, 0x78, 0x93, 0xdb, 0x72, 0x5d,
+	0x64, 0x56, 0x7f, 0xa4, 0x3d, 0x49, 0x82, 0x55, 0x14, 0x8e, 0xf0, 0xc6, 0x2d, 0x6f, 0x77, 0xa6,
+	0xe6, 0x55, 0x78, 0x12, 0x8c, 0xf0, 0x46, 0x7f, 0x02, 0x25, 0x11, 0x29, 0x43, 0x1a, 0x5e, 0x1a,
+	0xba, 0xa3, 0xed, 0xde, 0x40, 0xf7, 0x7b, 0x03, 0xfd, 0xd9, 0x1b, 0xe8, 0xee, 0x60, 0x68, 0xf7,
+	0x07, 0x43, 0xfb, 0x75, 0x30, 0xb4, 0xaf, 0x2f, 0x48, 0x24, 0x17, 0x49, 0x60, 0x87, 0x2c, 0x76,
+	0x42, 0x16, 0x63, 0x19, 0xcc, 0xe5, 0x29, 0x50, 0x0f, 0xe7, 0xfc, 0xb5, 0x05, 0x95, 0xac, 0xfa,
+	0xf2, 0xef, 0x00, 0x48, 0x8f, 0x9a, 0x0d, 0x86, 0x03, 0x00, 0x00,
 }
 
 func (m *PacketPing) Marshal() (dAtA []byte, err error) {
@@ -389,6 +490,16 @@ func (m *PacketPong) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Time != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintConn(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -412,6 +523,11 @@ func (m *PacketMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintConn(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -437,6 +553,44 @@ func (m *PacketMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PacketChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Registered {
+		i--
+		if m.Registered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChannelID != 0 {
+		i = encodeVarintConn(dAtA, i, uint64(m.ChannelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Packet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -532,6 +686,27 @@ func (m *Packet_PacketMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Packet_PacketChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Packet_PacketChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PacketChannel != nil {
+		{
+			size, err := m.PacketChannel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConn(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
 func (m *AuthSigMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -598,6 +773,10 @@ func (m *PacketPong) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovConn(uint64(l))
+	}
 	return n
 }
 
@@ -617,6 +796,24 @@ func (m *PacketMsg) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovConn(uint64(l))
 	}
+	if m.Sequence != 0 {
+		n += 1 + sovConn(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *PacketChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelID != 0 {
+		n += 1 + sovConn(uint64(m.ChannelID))
+	}
+	if m.Registered {
+		n += 2
+	}
 	return n
 }
 
@@ -668,6 +865,18 @@ func (m *Packet_PacketMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *Packet_PacketChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PacketChannel != nil {
+		l = m.PacketChannel.Size()
+		n += 1 + l + sovConn(uint64(l))
+	}
+	return n
+}
 func (m *AuthSigMessage) Size() (n int) {
 	if m == nil {
 		return 0
@@ -768,6 +977,42 @@ func (m *PacketPong) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: PacketPong: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConn(dAtA[iNdEx:])
@@ -891,6 +1136,114 @@ func (m *PacketMsg) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			m.ChannelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Registered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConn(dAtA[iNdEx:])
@@ -1046,6 +1399,41 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Packet_PacketMsg{v}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PacketChannel{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Packet_PacketChannel{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConn(dAtA[iNdEx:])
@@ -18,6 +18,12 @@ func (m *PexRequest) Wrap() proto.Message {
 	return pm
 }
 
+func (m *PexStatus) Wrap() proto.Message {
+	pm := &Message{}
+	pm.Sum = &Message_PexStatus{PexStatus: m}
+	return pm
+}
+
 // Unwrap implements the p2p Wrapper interface and unwraps a wrapped PEX
 // message.
 func (m *Message) Unwrap() (proto.Message, error) {
@@ -26,6 +32,8 @@ func (m *Message) Unwrap() (proto.Message, error) {
 		return msg.PexRequest, nil
 	case *Message_PexAddrs:
 		return msg.PexAddrs, nil
+	case *Message_PexStatus:
+		return msg.PexStatus, nil
 	default:
 		return nil, fmt.Errorf("unknown pex message: %T", msg)
 	}
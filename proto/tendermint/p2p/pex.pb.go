@@ -5,6 +5,7 @@ package p2p
 
 import (
 	fmt "fmt"
+	types "github.com/cometbft/cometbft/proto/tendermint/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -103,10 +104,65 @@ func (m *PexAddrs) GetAddrs() []NetAddress {
 	return nil
 }
 
+// PexStatus is sent by seeds to the peers connecting to them, if asked to,
+// informing them of the latest height of the seed and of its header.
+type PexStatus struct {
+	Height int64         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Header *types.Header `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (m *PexStatus) Reset()         { *m = PexStatus{} }
+func (m *PexStatus) String() string { return proto.CompactTextString(m) }
+func (*PexStatus) ProtoMessage()    {}
+func (*PexStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81c2f011fd13be57, []int{2}
+}
+func (m *PexStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PexStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PexStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PexStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PexStatus.Merge(m, src)
+}
+func (m *PexStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PexStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PexStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PexStatus proto.InternalMessageInfo
+
+func (m *PexStatus) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PexStatus) GetHeader() *types.Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
 type Message struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Message_PexRequest
 	//	*Message_PexAddrs
+	//	*Message_PexStatus
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -114,7 +170,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81c2f011fd13be57, []int{2}
+	return fileDescriptor_81c2f011fd13be57, []int{3}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,9 +211,13 @@ type Message_PexRequest struct {
 type Message_PexAddrs struct {
 	PexAddrs *PexAddrs `protobuf:"bytes,2,opt,name=pex_addrs,json=pexAddrs,proto3,oneof" json:"pex_addrs,omitempty"`
 }
+type Message_PexStatus struct {
+	PexStatus *PexStatus `protobuf:"bytes,3,opt,name=pex_status,json=pexStatus,proto3,oneof" json:"pex_status,omitempty"`
+}
 
 func (*Message_PexRequest) isMessage_Sum() {}
 func (*Message_PexAddrs) isMessage_Sum()   {}
+func (*Message_PexStatus) isMessage_Sum()  {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -180,42 +240,55 @@ func (m *Message) GetPexAddrs() *PexAddrs {
 	return nil
 }
 
+func (m *Message) GetPexStatus() *PexStatus {
+	if x, ok := m.GetSum().(*Message_PexStatus); ok {
+		return x.PexStatus
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Message_PexRequest)(nil),
 		(*Message_PexAddrs)(nil),
+		(*Message_PexStatus)(nil),
 	}
 }
 
 func init() {
 	proto.RegisterType((*PexRequest)(nil), "tendermint.p2p.PexRequest")
 	proto.RegisterType((*PexAddrs)(nil), "tendermint.p2p.PexAddrs")
+	proto.RegisterType((*PexStatus)(nil), "tendermint.p2p.PexStatus")
 	proto.RegisterType((*Message)(nil), "tendermint.p2p.Message")
 }
 
 func init() { proto.RegisterFile("tendermint/p2p/pex.proto", fileDescriptor_81c2f011fd13be57) }
 
 var fileDescriptor_81c2f011fd13be57 = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x28, 0x49, 0xcd, 0x4b,
-	0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x30, 0x2a, 0xd0, 0x2f, 0x48, 0xad, 0xd0, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x43, 0xc8, 0xe8, 0x15, 0x18, 0x15, 0x48, 0x49, 0xa1, 0xa9,
-	0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x86, 0xa8, 0x95, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5,
-	0x41, 0x2c, 0x88, 0xa8, 0x12, 0x0f, 0x17, 0x57, 0x40, 0x6a, 0x45, 0x50, 0x6a, 0x61, 0x69, 0x6a,
-	0x71, 0x89, 0x92, 0x13, 0x17, 0x47, 0x40, 0x6a, 0x85, 0x63, 0x4a, 0x4a, 0x51, 0xb1, 0x90, 0x19,
-	0x17, 0x6b, 0x22, 0x88, 0x21, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0x6a, 0x97,
-	0x9e, 0x5f, 0x6a, 0x09, 0x48, 0x61, 0x6a, 0x71, 0xb1, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41,
-	0x10, 0xe5, 0x4a, 0x1d, 0x8c, 0x5c, 0xec, 0xbe, 0xa9, 0xc5, 0xc5, 0x89, 0xe9, 0xa9, 0x42, 0xb6,
-	0x5c, 0xdc, 0x05, 0xa9, 0x15, 0xf1, 0x45, 0x10, 0xe3, 0x25, 0x18, 0x15, 0x18, 0xb1, 0x99, 0x84,
-	0x70, 0x80, 0x07, 0x43, 0x10, 0x57, 0x01, 0x9c, 0x27, 0x64, 0xce, 0xc5, 0x09, 0xd2, 0x0e, 0x71,
-	0x06, 0x13, 0x58, 0xb3, 0x04, 0x16, 0xcd, 0x60, 0xf7, 0x7a, 0x30, 0x04, 0x71, 0x14, 0x40, 0xd9,
-	0x4e, 0xac, 0x5c, 0xcc, 0xc5, 0xa5, 0xb9, 0x4e, 0xde, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0x65, 0x98, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f,
-	0x9c, 0x9f, 0x9b, 0x5a, 0x92, 0x94, 0x56, 0x82, 0x60, 0x40, 0x42, 0x09, 0x35, 0x2c, 0x93, 0xd8,
-	0xc0, 0xa2, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x02, 0xad, 0x52, 0xe1, 0x8e, 0x01, 0x00,
-	0x00,
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x9d, 0x79, 0x79, 0xed, 0x6b, 0x6f, 0x1f, 0x6f, 0x11, 0x1e, 0x12, 0x83, 0xc4, 0x92, 0x55,
+	0x57, 0x89, 0x46, 0x50, 0x10, 0x5c, 0x98, 0x55, 0x40, 0x94, 0x32, 0xe2, 0xc6, 0x4d, 0x49, 0x9b,
+	0x6b, 0xda, 0x45, 0x9a, 0x31, 0x33, 0x81, 0xf8, 0x17, 0x7e, 0x94, 0x8b, 0x2e, 0xbb, 0x74, 0x25,
+	0xd2, 0xfe, 0x88, 0x64, 0xa6, 0xb5, 0xad, 0x74, 0x77, 0xef, 0x3d, 0xe7, 0xdc, 0x39, 0x67, 0x2e,
+	0x58, 0x12, 0xa7, 0x09, 0x16, 0xd9, 0x64, 0x2a, 0x7d, 0x1e, 0x70, 0x9f, 0x63, 0xe5, 0xf1, 0x22,
+	0x97, 0xb9, 0xf9, 0x6f, 0x83, 0x78, 0x3c, 0xe0, 0xb6, 0xfd, 0x83, 0x29, 0x5f, 0x38, 0x0a, 0xcd,
+	0xb5, 0x8f, 0xb6, 0x30, 0x35, 0xdf, 0x41, 0xff, 0xa7, 0x79, 0x9a, 0xab, 0xd2, 0xaf, 0x2b, 0x3d,
+	0x75, 0xff, 0x02, 0xf4, 0xb1, 0x62, 0xf8, 0x5c, 0xa2, 0x90, 0x6e, 0x08, 0xad, 0x3e, 0x56, 0xd7,
+	0x49, 0x52, 0x08, 0xf3, 0x1c, 0x1a, 0x71, 0x5d, 0x58, 0xb4, 0x6b, 0xf4, 0x3a, 0x81, 0xed, 0xed,
+	0x3a, 0xf1, 0xee, 0x50, 0xd6, 0x44, 0x14, 0x22, 0xfc, 0x3d, 0xfb, 0x38, 0x26, 0x4c, 0xd3, 0xdd,
+	0x07, 0x68, 0xf7, 0xb1, 0xba, 0x97, 0xb1, 0x2c, 0x85, 0x79, 0x00, 0xcd, 0x31, 0x4e, 0xd2, 0xb1,
+	0xb4, 0x68, 0x97, 0xf6, 0x0c, 0xb6, 0xea, 0xcc, 0x93, 0x7a, 0x1e, 0x27, 0x58, 0x58, 0xbf, 0xba,
+	0xb4, 0xd7, 0x09, 0xac, 0xed, 0xed, 0xda, 0x75, 0xa4, 0x70, 0xb6, 0xe2, 0xb9, 0x6f, 0x14, 0xfe,
+	0xdc, 0xa2, 0x10, 0x71, 0x8a, 0xe6, 0x15, 0x74, 0x38, 0x56, 0x83, 0x42, 0xbb, 0x56, 0xab, 0xf7,
+	0x18, 0xdc, 0xe4, 0x8a, 0x08, 0x03, 0xfe, 0xdd, 0x99, 0x17, 0xd0, 0xae, 0xe5, 0x3a, 0xdd, 0x9e,
+	0xf7, 0x57, 0x62, 0xf5, 0x0d, 0x11, 0x61, 0x2d, 0xbe, 0xfe, 0x92, 0x4b, 0xa8, 0xd7, 0x0c, 0x84,
+	0xca, 0x66, 0x19, 0x4a, 0x79, 0xb8, 0x47, 0xa9, 0xc3, 0x47, 0x84, 0xb5, 0xf9, 0xba, 0x09, 0x1b,
+	0x60, 0x88, 0x32, 0x0b, 0x6f, 0x66, 0x0b, 0x87, 0xce, 0x17, 0x0e, 0xfd, 0x5c, 0x38, 0xf4, 0x75,
+	0xe9, 0x90, 0xf9, 0xd2, 0x21, 0xef, 0x4b, 0x87, 0x3c, 0x9e, 0xa6, 0x13, 0x39, 0x2e, 0x87, 0xde,
+	0x28, 0xcf, 0xfc, 0x51, 0x9e, 0xa1, 0x1c, 0x3e, 0xc9, 0x4d, 0xa1, 0x0f, 0xb7, 0x7b, 0xfc, 0x61,
+	0x53, 0x4d, 0xcf, 0xbe, 0x06, 0x00, 0xbe, 0x65, 0x35, 0x41, 0x3f, 0x02, 0x00, 0x00,
 }
 
 func (m *PexRequest) Marshal() (dAtA []byte, err error) {
@@ -278,6 +351,46 @@ func (m *PexAddrs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PexStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PexStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PexStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintPex(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Message) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -352,6 +465,27 @@ func (m *Message_PexAddrs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_PexStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_PexStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PexStatus != nil {
+		{
+			size, err := m.PexStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintPex(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPex(v)
 	base := offset
@@ -387,6 +521,22 @@ func (m *PexAddrs) Size() (n int) {
 	return n
 }
 
+func (m *PexStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovPex(uint64(m.Height))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPex(uint64(l))
+	}
+	return n
+}
+
 func (m *Message) Size() (n int) {
 	if m == nil {
 		return 0
@@ -423,6 +573,18 @@ func (m *Message_PexAddrs) Size() (n int) {
 	}
 	return n
 }
+func (m *Message_PexStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PexStatus != nil {
+		l = m.PexStatus.Size()
+		n += 1 + l + sovPex(uint64(l))
+	}
+	return n
+}
 
 func sovPex(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -564,6 +726,111 @@ func (m *PexAddrs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PexStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PexStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &types.Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Message) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -663,6 +930,41 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_PexAddrs{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PexStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PexStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_PexStatus{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPex(dAtA[iNdEx:])
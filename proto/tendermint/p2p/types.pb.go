@@ -152,6 +152,13 @@ type DefaultNodeInfo struct {
 	Channels        []byte               `protobuf:"bytes,6,opt,name=channels,proto3" json:"channels,omitempty"`
 	Moniker         string               `protobuf:"bytes,7,opt,name=moniker,proto3" json:"moniker,omitempty"`
 	Other           DefaultNodeInfoOther `protobuf:"bytes,8,opt,name=other,proto3" json:"other"`
+	// features is a bitset of the optional protocol features supported by the
+	// node.
+	Features uint64 `protobuf:"varint,9,opt,name=features,proto3" json:"features,omitempty"`
+	// max_packet_msg_payload_size is the maximum payload size of the packets
+	// the node sends and receives. The peers of a connection use the smaller
+	// of their sizes. Not advertised by the nodes predating the negotiation.
+	MaxPacketMsgPayloadSize int64 `protobuf:"varint,10,opt,name=max_packet_msg_payload_size,json=maxPacketMsgPayloadSize,proto3" json:"max_packet_msg_payload_size,omitempty"`
 }
 
 func (m *DefaultNodeInfo) Reset()         { *m = DefaultNodeInfo{} }
@@ -243,6 +250,20 @@ func (m *DefaultNodeInfo) GetOther() DefaultNodeInfoOther {
 	return DefaultNodeInfoOther{}
 }
 
+func (m *DefaultNodeInfo) GetFeatures() uint64 {
+	if m != nil {
+		return m.Features
+	}
+	return 0
+}
+
+func (m *DefaultNodeInfo) GetMaxPacketMsgPayloadSize() int64 {
+	if m != nil {
+		return m.MaxPacketMsgPayloadSize
+	}
+	return 0
+}
+
 type DefaultNodeInfoOther struct {
 	TxIndex    string `protobuf:"bytes,1,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
 	RPCAddress string `protobuf:"bytes,2,opt,name=rpc_address,json=rpcAddress,proto3" json:"rpc_address,omitempty"`
@@ -305,38 +326,41 @@ func init() {
 func init() { proto.RegisterFile("tendermint/p2p/types.proto", fileDescriptor_c8a29e659aeca578) }
 
 var fileDescriptor_c8a29e659aeca578 = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x3d, 0x8f, 0xda, 0x40,
-	0x10, 0xc5, 0xc6, 0x7c, 0xdc, 0x10, 0x8e, 0xcb, 0x0a, 0x45, 0x3e, 0x0a, 0x1b, 0xa1, 0x14, 0x54,
-	0xa0, 0x90, 0x2a, 0x5d, 0x42, 0x68, 0x50, 0xa4, 0x8b, 0xb5, 0x8a, 0x52, 0xa4, 0x41, 0xe0, 0x5d,
-	0x60, 0x85, 0xd9, 0x5d, 0xad, 0xf7, 0x12, 0xf2, 0x2f, 0xf2, 0xb3, 0xae, 0xbc, 0x32, 0x95, 0x15,
-	0x99, 0x32, 0x7f, 0x22, 0xf2, 0xae, 0x2f, 0xc7, 0xa1, 0xeb, 0xe6, 0xcd, 0x9b, 0x99, 0x37, 0xf3,
-	0x34, 0xd0, 0xd3, 0x94, 0x13, 0xaa, 0xf6, 0x8c, 0xeb, 0xb1, 0x9c, 0xc8, 0xb1, 0xfe, 0x29, 0x69,
-	0x3a, 0x92, 0x4a, 0x68, 0x81, 0x2e, 0x1f, 0xb9, 0x91, 0x9c, 0xc8, 0x5e, 0x77, 0x23, 0x36, 0xc2,
-	0x50, 0xe3, 0x22, 0xb2, 0x55, 0x83, 0x08, 0xe0, 0x86, 0xea, 0x0f, 0x84, 0x28, 0x9a, 0xa6, 0xe8,
-	0x15, 0xb8, 0x8c, 0xf8, 0x4e, 0xdf, 0x19, 0x5e, 0x4c, 0xeb, 0x79, 0x16, 0xba, 0xf3, 0x19, 0x76,
-	0x19, 0x31, 0x79, 0xe9, 0xbb, 0x27, 0xf9, 0x08, 0xbb, 0x4c, 0x22, 0x04, 0x9e, 0x14, 0x4a, 0xfb,
-	0xd5, 0xbe, 0x33, 0x6c, 0x63, 0x13, 0x0f, 0xbe, 0x40, 0x27, 0x2a, 0x46, 0xc7, 0x22, 0xf9, 0x4a,
-	0x55, 0xca, 0x04, 0x47, 0xd7, 0x50, 0x95, 0x13, 0x69, 0xe6, 0x7a, 0xd3, 0x46, 0x9e, 0x85, 0xd5,
-	0x68, 0x12, 0xe1, 0x22, 0x87, 0xba, 0x50, 0x5b, 0x25, 0x22, 0xde, 0x99, 0xe1, 0x1e, 0xb6, 0x00,
-	0x5d, 0x41, 0x75, 0x29, 0xa5, 0x19, 0xeb, 0xe1, 0x22, 0x1c, 0xfc, 0x75, 0xa1, 0x33, 0xa3, 0xeb,
-	0xe5, 0x6d, 0xa2, 0x6f, 0x04, 0xa1, 0x73, 0xbe, 0x16, 0x28, 0x82, 0x2b, 0x59, 0x2a, 0x2d, 0xbe,
-	0x5b, 0x29, 0xa3, 0xd1, 0x9a, 0x84, 0xa3, 0xa7, 0xc7, 0x8f, 0xce, 0x36, 0x9a, 0x7a, 0x77, 0x59,
-	0x58, 0xc1, 0x1d, 0x79, 0xb6, 0xe8, 0x3b, 0xe8, 0x10, 0x2b, 0xb2, 0xe0, 0x82, 0xd0, 0x05, 0x23,
-	0xe5, 0xd1, 0x2f, 0xf3, 0x2c, 0x6c, 0x9f, 0xea, 0xcf, 0x70, 0x9b, 0x9c, 0x40, 0x82, 0x42, 0x68,
-	0x25, 0x2c, 0xd5, 0x94, 0x2f, 0x96, 0x84, 0x28, 0xb3, 0xfa, 0x05, 0x06, 0x9b, 0x2a, 0xec, 0x45,
-	0x3e, 0x34, 0x38, 0xd5, 0x3f, 0x84, 0xda, 0xf9, 0x9e, 0x21, 0x1f, 0x60, 0xc1, 0x3c, 0xac, 0x5f,
-	0xb3, 0x4c, 0x09, 0x51, 0x0f, 0x9a, 0xf1, 0x76, 0xc9, 0x39, 0x4d, 0x52, 0xbf, 0xde, 0x77, 0x86,
-	0x2f, 0xf0, 0x7f, 0x5c, 0x74, 0xed, 0x05, 0x67, 0x3b, 0xaa, 0xfc, 0x86, 0xed, 0x2a, 0x21, 0x7a,
-	0x0f, 0x35, 0xa1, 0xb7, 0x54, 0xf9, 0x4d, 0x63, 0xc6, 0xeb, 0x73, 0x33, 0xce, 0x7c, 0xfc, 0x5c,
-	0xd4, 0x96, 0x8e, 0xd8, 0xc6, 0xc1, 0x0a, 0xba, 0xcf, 0x15, 0xa1, 0x6b, 0x68, 0xea, 0xc3, 0x82,
-	0x71, 0x42, 0x0f, 0xf6, 0x4b, 0x70, 0x43, 0x1f, 0xe6, 0x05, 0x44, 0x63, 0x68, 0x29, 0x19, 0x9b,
-	0xe3, 0x69, 0x9a, 0x96, 0xb6, 0x5d, 0xe6, 0x59, 0x08, 0x38, 0xfa, 0x58, 0xfe, 0x17, 0x06, 0x25,
-	0xe3, 0x32, 0x9e, 0x7e, 0xba, 0xcb, 0x03, 0xe7, 0x3e, 0x0f, 0x9c, 0x3f, 0x79, 0xe0, 0xfc, 0x3a,
-	0x06, 0x95, 0xfb, 0x63, 0x50, 0xf9, 0x7d, 0x0c, 0x2a, 0xdf, 0xde, 0x6c, 0x98, 0xde, 0xde, 0xae,
-	0x46, 0xb1, 0xd8, 0x8f, 0x63, 0xb1, 0xa7, 0x7a, 0xb5, 0xd6, 0x8f, 0x81, 0x7d, 0xe1, 0xa7, 0x8f,
-	0xbf, 0xaa, 0x9b, 0xec, 0xdb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0xdb, 0x56, 0x6d, 0x11,
-	0x03, 0x00, 0x00,
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0x9a, 0x6c, 0xdd, 0xde, 0xd8, 0x3a, 0xac, 0x0a, 0xb2, 0x22, 0x25, 0x55, 0xc5, 0xa1,
+	0xa7, 0x56, 0x94, 0x13, 0x12, 0x07, 0x28, 0xbd, 0x54, 0x88, 0x11, 0x19, 0xc4, 0x81, 0x4b, 0x94,
+	0xc6, 0x6e, 0x6b, 0x35, 0x89, 0x2d, 0xdb, 0x85, 0x6e, 0xbf, 0x82, 0x3f, 0x85, 0xb4, 0xe3, 0x8e,
+	0x9c, 0x2a, 0x94, 0xfe, 0x11, 0x14, 0x27, 0xdb, 0xba, 0x8a, 0xdb, 0xfb, 0xde, 0xf7, 0xde, 0xfb,
+	0x9e, 0x3f, 0xdb, 0xd0, 0xd6, 0x34, 0x23, 0x54, 0xa6, 0x2c, 0xd3, 0x03, 0x31, 0x14, 0x03, 0x7d,
+	0x25, 0xa8, 0xea, 0x0b, 0xc9, 0x35, 0x47, 0x67, 0x0f, 0x5c, 0x5f, 0x0c, 0x45, 0xbb, 0x35, 0xe7,
+	0x73, 0x6e, 0xa8, 0x41, 0x11, 0x95, 0x55, 0xdd, 0x00, 0xe0, 0x92, 0xea, 0xf7, 0x84, 0x48, 0xaa,
+	0x14, 0x7a, 0x06, 0x75, 0x46, 0x5c, 0xab, 0x63, 0xf5, 0x8e, 0x47, 0x87, 0xf9, 0xc6, 0xaf, 0x4f,
+	0xc6, 0xb8, 0xce, 0x88, 0xc9, 0x0b, 0xb7, 0xbe, 0x93, 0x0f, 0x70, 0x9d, 0x09, 0x84, 0xc0, 0x11,
+	0x5c, 0x6a, 0xd7, 0xee, 0x58, 0xbd, 0x53, 0x6c, 0xe2, 0xee, 0x57, 0x68, 0x06, 0xc5, 0xe8, 0x98,
+	0x27, 0xdf, 0xa8, 0x54, 0x8c, 0x67, 0xe8, 0x02, 0x6c, 0x31, 0x14, 0x66, 0xae, 0x33, 0x6a, 0xe4,
+	0x1b, 0xdf, 0x0e, 0x86, 0x01, 0x2e, 0x72, 0xa8, 0x05, 0x07, 0xd3, 0x84, 0xc7, 0x4b, 0x33, 0xdc,
+	0xc1, 0x25, 0x40, 0xe7, 0x60, 0x47, 0x42, 0x98, 0xb1, 0x0e, 0x2e, 0xc2, 0xee, 0x6f, 0x1b, 0x9a,
+	0x63, 0x3a, 0x8b, 0x56, 0x89, 0xbe, 0xe4, 0x84, 0x4e, 0xb2, 0x19, 0x47, 0x01, 0x9c, 0x8b, 0x4a,
+	0x29, 0xfc, 0x51, 0x4a, 0x19, 0x8d, 0x93, 0xa1, 0xdf, 0x7f, 0x7c, 0xf8, 0xfe, 0xde, 0x46, 0x23,
+	0xe7, 0x66, 0xe3, 0xd7, 0x70, 0x53, 0xec, 0x2d, 0xfa, 0x06, 0x9a, 0xa4, 0x14, 0x09, 0x33, 0x4e,
+	0x68, 0xc8, 0x48, 0x75, 0xe8, 0xa7, 0xf9, 0xc6, 0x3f, 0xdd, 0xd5, 0x1f, 0xe3, 0x53, 0xb2, 0x03,
+	0x09, 0xf2, 0xe1, 0x24, 0x61, 0x4a, 0xd3, 0x2c, 0x8c, 0x08, 0x91, 0x66, 0xf5, 0x63, 0x0c, 0x65,
+	0xaa, 0xb0, 0x17, 0xb9, 0xd0, 0xc8, 0xa8, 0xfe, 0xc9, 0xe5, 0xd2, 0x75, 0x0c, 0x79, 0x07, 0x0b,
+	0xe6, 0x6e, 0xfd, 0x83, 0x92, 0xa9, 0x20, 0x6a, 0xc3, 0x51, 0xbc, 0x88, 0xb2, 0x8c, 0x26, 0xca,
+	0x3d, 0xec, 0x58, 0xbd, 0x27, 0xf8, 0x1e, 0x17, 0x5d, 0x29, 0xcf, 0xd8, 0x92, 0x4a, 0xb7, 0x51,
+	0x76, 0x55, 0x10, 0xbd, 0x83, 0x03, 0xae, 0x17, 0x54, 0xba, 0x47, 0xc6, 0x8c, 0x97, 0xfb, 0x66,
+	0xec, 0xf9, 0xf8, 0xb9, 0xa8, 0xad, 0x1c, 0x29, 0x1b, 0x0b, 0xdd, 0x19, 0x8d, 0xf4, 0x4a, 0x52,
+	0xe5, 0x1e, 0x9b, 0x4b, 0xb8, 0xc7, 0xe8, 0x2d, 0xbc, 0x48, 0xa3, 0x75, 0x28, 0xa2, 0x78, 0x49,
+	0x75, 0x98, 0xaa, 0x79, 0x28, 0xa2, 0xab, 0x84, 0x47, 0x24, 0x54, 0xec, 0x9a, 0xba, 0xd0, 0xb1,
+	0x7a, 0x36, 0x7e, 0x9e, 0x46, 0xeb, 0xc0, 0x54, 0x7c, 0x52, 0xf3, 0xa0, 0xe4, 0xbf, 0xb0, 0x6b,
+	0xda, 0x9d, 0x42, 0xeb, 0x7f, 0xf2, 0xe8, 0x02, 0x8e, 0xf4, 0x3a, 0x64, 0x19, 0xa1, 0xeb, 0xf2,
+	0xfd, 0xe1, 0x86, 0x5e, 0x4f, 0x0a, 0x88, 0x06, 0x70, 0x22, 0x45, 0x6c, 0x6c, 0xa5, 0x4a, 0x55,
+	0x17, 0x72, 0x96, 0x6f, 0x7c, 0xc0, 0xc1, 0x87, 0xea, 0xe5, 0x62, 0x90, 0x22, 0xae, 0xe2, 0xd1,
+	0xc7, 0x9b, 0xdc, 0xb3, 0x6e, 0x73, 0xcf, 0xfa, 0x9b, 0x7b, 0xd6, 0xaf, 0xad, 0x57, 0xbb, 0xdd,
+	0x7a, 0xb5, 0x3f, 0x5b, 0xaf, 0xf6, 0xfd, 0xd5, 0x9c, 0xe9, 0xc5, 0x6a, 0xda, 0x8f, 0x79, 0x3a,
+	0x88, 0x79, 0x4a, 0xf5, 0x74, 0xa6, 0x1f, 0x82, 0xf2, 0x73, 0x3c, 0xfe, 0x52, 0xd3, 0x43, 0x93,
+	0x7d, 0xfd, 0x6f, 0x00, 0xd6, 0x1f, 0x07, 0x5f, 0x6b, 0x03, 0x00, 0x00,
 }
 
 func (m *NetAddress) Marshal() (dAtA []byte, err error) {
@@ -439,6 +463,16 @@ func (m *DefaultNodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPacketMsgPayloadSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxPacketMsgPayloadSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Features != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Features))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size, err := m.Other.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -624,6 +658,12 @@ func (m *DefaultNodeInfo) Size() (n int) {
 	}
 	l = m.Other.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	if m.Features != 0 {
+		n += 1 + sovTypes(uint64(m.Features))
+	}
+	if m.MaxPacketMsgPayloadSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxPacketMsgPayloadSize))
+	}
 	return n
 }
 
@@ -1179,6 +1219,44 @@ func (m *DefaultNodeInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			m.Features = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Features |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketMsgPayloadSize", wireType)
+			}
+			m.MaxPacketMsgPayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketMsgPayloadSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
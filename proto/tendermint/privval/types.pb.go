@@ -500,6 +500,7 @@ var xxx_messageInfo_PingResponse proto.InternalMessageInfo
 
 type Message struct {
 	// Types that are valid to be assigned to Sum:
+	//
 	//	*Message_PubKeyRequest
 	//	*Message_PubKeyResponse
 	//	*Message_SignVoteRequest
@@ -509,6 +510,11 @@ type Message struct {
 	//	*Message_PingRequest
 	//	*Message_PingResponse
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
+	// request_id correlates a response with its request, allowing several
+	// requests to be in flight on one connection. Responses echo the id of
+	// their request. 0 means the peer does not pipeline requests and responses
+	// are returned in request order.
+	RequestId uint64 `protobuf:"varint,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
 func (m *Message) Reset()         { *m = Message{} }
@@ -647,6 +653,13 @@ func (m *Message) GetPingResponse() *PingResponse {
 	return nil
 }
 
+func (m *Message) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -678,55 +691,56 @@ func init() {
 func init() { proto.RegisterFile("tendermint/privval/types.proto", fileDescriptor_cb4e437a5328cf9c) }
 
 var fileDescriptor_cb4e437a5328cf9c = []byte{
-	// 756 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x4f, 0xe3, 0x46,
-	0x18, 0xb6, 0x21, 0x1f, 0xf0, 0x86, 0x84, 0x30, 0x50, 0x1a, 0x22, 0x6a, 0xd2, 0x54, 0x6d, 0x51,
-	0x0e, 0x49, 0x45, 0xd5, 0x5e, 0xe8, 0xa5, 0x80, 0xd5, 0x44, 0x11, 0x76, 0x3a, 0x09, 0x05, 0x21,
-	0x55, 0x56, 0x3e, 0x06, 0x63, 0x41, 0x3c, 0x5e, 0x8f, 0x83, 0x94, 0xf3, 0xde, 0xf6, 0xb4, 0xd2,
-	0xfe, 0x89, 0x3d, 0xef, 0xaf, 0xe0, 0xc8, 0x71, 0x4f, 0xab, 0x15, 0xfc, 0x91, 0x55, 0xc6, 0x13,
-	0xdb, 0xf9, 0x42, 0xbb, 0xe2, 0x36, 0xf3, 0xbe, 0xef, 0x3c, 0x1f, 0x33, 0x8f, 0x65, 0x50, 0x3c,
-	0x62, 0xf7, 0x88, 0xdb, 0xb7, 0x6c, 0xaf, 0xe2, 0xb8, 0xd6, 0xdd, 0x5d, 0xfb, 0xb6, 0xe2, 0x0d,
-	0x1d, 0xc2, 0xca, 0x8e, 0x4b, 0x3d, 0x8a, 0x50, 0xd8, 0x2f, 0x8b, 0x7e, 0x7e, 0x37, 0x72, 0xa6,
-	0xeb, 0x0e, 0x1d, 0x8f, 0x56, 0x6e, 0xc8, 0x50, 0x9c, 0x98, 0xe8, 0x72, 0xa4, 0x28, 0x5e, 0x7e,
-	0xcb, 0xa4, 0x26, 0xe5, 0xcb, 0xca, 0x68, 0xe5, 0x57, 0x8b, 0x35, 0xd8, 0xc0, 0xa4, 0x4f, 0x3d,
-	0xd2, 0xb4, 0x4c, 0x9b, 0xb8, 0xaa, 0xeb, 0x52, 0x17, 0x21, 0x88, 0x75, 0x69, 0x8f, 0xe4, 0xe4,
-	0x82, 0xbc, 0x1f, 0xc7, 0x7c, 0x8d, 0x0a, 0x90, 0xea, 0x11, 0xd6, 0x75, 0x2d, 0xc7, 0xb3, 0xa8,
-	0x9d, 0x5b, 0x2a, 0xc8, 0xfb, 0xab, 0x38, 0x5a, 0x2a, 0x96, 0x20, 0xdd, 0x18, 0x74, 0xea, 0x64,
-	0x88, 0xc9, 0xab, 0x01, 0x61, 0x1e, 0xda, 0x81, 0x95, 0xee, 0x75, 0xdb, 0xb2, 0x0d, 0xab, 0xc7,
-	0xa1, 0x56, 0x71, 0x92, 0xef, 0x6b, 0xbd, 0xe2, 0x1b, 0x19, 0x32, 0xe3, 0x61, 0xe6, 0x50, 0x9b,
-	0x11, 0x74, 0x08, 0x49, 0x67, 0xd0, 0x31, 0x6e, 0xc8, 0x90, 0x0f, 0xa7, 0x0e, 0x76, 0xcb, 0x91,
-	0x1b, 0xf0, 0xdd, 0x96, 0x1b, 0x83, 0xce, 0xad, 0xd5, 0xad, 0x93, 0xe1, 0x51, 0xec, 0xfe, 0xd3,
-	0x9e, 0x84, 0x13, 0x0e, 0x07, 0x41, 0x87, 0x10, 0x27, 0x23, 0xe9, 0x5c, 0x57, 0xea, 0xe0, 0xe7,
-	0xf2, 0xec, 0xe5, 0x95, 0x67, 0x7c, 0x62, 0xff, 0x4c, 0xf1, 0x02, 0xd6, 0x47, 0xd5, 0xff, 0xa8,
-	0x47, 0xc6, 0xd2, 0x4b, 0x10, 0xbb, 0xa3, 0x1e, 0x11, 0x4a, 0xb6, 0xa3, 0x70, 0xfe, 0x9d, 0xf2,
-	0x61, 0x3e, 0x33, 0x61, 0x73, 0x69, 0xd2, 0xe6, 0x6b, 0x19, 0x10, 0x27, 0xec, 0xf9, 0xe0, 0xc2,
-	0xea, 0x6f, 0x5f, 0x83, 0x2e, 0x1c, 0xfa, 0x1c, 0x2f, 0xf2, 0x77, 0x0d, 0x9b, 0xa3, 0x6a, 0xc3,
-	0xa5, 0x0e, 0x65, 0xed, 0xdb, 0xb1, 0xc7, 0x3f, 0x61, 0xc5, 0x11, 0x25, 0xa1, 0x24, 0x3f, 0xab,
-	0x24, 0x38, 0x14, 0xcc, 0x3e, 0xe7, 0xf7, 0x9d, 0x0c, 0xdb, 0xbe, 0xdf, 0x90, 0x4c, 0x78, 0xfe,
-	0xeb, 0x5b, 0xd8, 0x84, 0xf7, 0x90, 0xf3, 0x45, 0xfe, 0xd3, 0x90, 0x6a, 0x58, 0xb6, 0x29, 0x7c,
-	0x17, 0x33, 0xb0, 0xe6, 0x6f, 0x7d, 0x65, 0xc5, 0x0f, 0x71, 0x48, 0x9e, 0x12, 0xc6, 0xda, 0x26,
-	0x41, 0x75, 0x58, 0x17, 0x21, 0x34, 0x5c, 0x7f, 0x5c, 0x88, 0xfd, 0x71, 0x1e, 0xe3, 0x44, 0xdc,
-	0xab, 0x12, 0x4e, 0x3b, 0x13, 0xf9, 0xd7, 0x20, 0x1b, 0x82, 0xf9, 0x64, 0x42, 0x7f, 0xf1, 0x39,
-	0x34, 0x7f, 0xb2, 0x2a, 0xe1, 0x8c, 0x33, 0xf9, 0x85, 0xfc, 0x0b, 0x1b, 0xcc, 0x32, 0x6d, 0x63,
-	0x94, 0x88, 0x40, 0xde, 0x32, 0x07, 0xfc, 0x69, 0x1e, 0xe0, 0x54, 0xa8, 0xab, 0x12, 0x5e, 0x67,
-	0x53, 0x39, 0xbf, 0x84, 0x2d, 0xc6, 0xdf, 0x6b, 0x0c, 0x2a, 0x64, 0xc6, 0x38, 0xea, 0x2f, 0x8b,
-	0x50, 0x27, 0xf3, 0x5c, 0x95, 0x30, 0x62, 0xb3, 0x29, 0xff, 0x1f, 0xbe, 0xe3, 0x72, 0xc7, 0x8f,
-	0x18, 0x48, 0x8e, 0x73, 0xf0, 0x5f, 0x17, 0x81, 0x4f, 0xe5, 0xb4, 0x2a, 0xe1, 0x4d, 0x36, 0x27,
-	0xbe, 0x57, 0x90, 0x13, 0xd2, 0x23, 0x04, 0x42, 0x7e, 0x82, 0x33, 0x94, 0x16, 0xcb, 0x9f, 0x8e,
-	0x67, 0x55, 0xc2, 0xdb, 0x6c, 0x7e, 0x70, 0x4f, 0x60, 0xcd, 0xb1, 0x6c, 0x33, 0x50, 0x9f, 0xe4,
-	0xd8, 0x7b, 0x73, 0x5f, 0x30, 0x4c, 0x59, 0x55, 0xc2, 0x29, 0x27, 0xdc, 0xa2, 0x7f, 0x20, 0x2d,
-	0x50, 0x84, 0xc4, 0x15, 0x0e, 0x53, 0x58, 0x0c, 0x13, 0x08, 0x5b, 0x73, 0x22, 0xfb, 0xa3, 0x38,
-	0x2c, 0xb3, 0x41, 0xbf, 0xf4, 0x5e, 0x86, 0x04, 0x0f, 0x39, 0x43, 0x08, 0x32, 0x2a, 0xc6, 0x3a,
-	0x6e, 0x1a, 0x67, 0x5a, 0x5d, 0xd3, 0xcf, 0xb5, 0xac, 0x84, 0x14, 0xc8, 0x07, 0x35, 0xf5, 0xa2,
-	0xa1, 0x1e, 0xb7, 0xd4, 0x13, 0x03, 0xab, 0xcd, 0x86, 0xae, 0x35, 0xd5, 0xac, 0x8c, 0x72, 0xb0,
-	0x25, 0xfa, 0x9a, 0x6e, 0x1c, 0xeb, 0x9a, 0xa6, 0x1e, 0xb7, 0x6a, 0xba, 0x96, 0x5d, 0x42, 0x3f,
-	0xc0, 0x8e, 0xe8, 0x84, 0x65, 0xa3, 0x55, 0x3b, 0x55, 0xf5, 0xb3, 0x56, 0x76, 0x19, 0x7d, 0x0f,
-	0x9b, 0xa2, 0x8d, 0xd5, 0xbf, 0x4f, 0x82, 0x46, 0x2c, 0x82, 0x78, 0x8e, 0x6b, 0x2d, 0x35, 0xe8,
-	0xc4, 0x8f, 0xf4, 0xfb, 0x47, 0x45, 0x7e, 0x78, 0x54, 0xe4, 0xcf, 0x8f, 0x8a, 0xfc, 0xf6, 0x49,
-	0x91, 0x1e, 0x9e, 0x14, 0xe9, 0xe3, 0x93, 0x22, 0x5d, 0xfe, 0x61, 0x5a, 0xde, 0xf5, 0xa0, 0x53,
-	0xee, 0xd2, 0x7e, 0xa5, 0x4b, 0xfb, 0xc4, 0xeb, 0x5c, 0x79, 0xe1, 0xc2, 0xff, 0x57, 0xcd, 0xfe,
-	0x25, 0x3b, 0x09, 0xde, 0xf9, 0xfd, 0x4b, 0x00, 0x00, 0x00, 0xff, 0xff, 0xda, 0x9f, 0x99, 0x3e,
-	0x42, 0x07, 0x00, 0x00,
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcd, 0x4e, 0xeb, 0x46,
+	0x14, 0xc7, 0x6d, 0xc8, 0x07, 0x39, 0x21, 0x21, 0x0c, 0x94, 0x86, 0x08, 0x4c, 0x9a, 0xaa, 0x2d,
+	0xca, 0x22, 0xa9, 0xa8, 0xda, 0x0d, 0xdd, 0x14, 0xb0, 0x9a, 0x28, 0xc2, 0x4e, 0x27, 0xa1, 0x20,
+	0xa4, 0xca, 0xca, 0xc7, 0x60, 0x2c, 0x88, 0xc7, 0xf5, 0x38, 0x48, 0x59, 0x77, 0xd7, 0x55, 0xa5,
+	0xbe, 0x44, 0x1f, 0x85, 0x25, 0xdd, 0xdd, 0xd5, 0xd5, 0x15, 0xbc, 0xc8, 0x55, 0xc6, 0x13, 0xdb,
+	0xf9, 0x42, 0xf7, 0x8a, 0xdd, 0xcc, 0x39, 0x67, 0x7e, 0xe7, 0xff, 0xf7, 0x9c, 0x91, 0x41, 0xf1,
+	0x88, 0xdd, 0x27, 0xee, 0xc0, 0xb2, 0xbd, 0xaa, 0xe3, 0x5a, 0x0f, 0x0f, 0x9d, 0xfb, 0xaa, 0x37,
+	0x72, 0x08, 0xab, 0x38, 0x2e, 0xf5, 0x28, 0x42, 0x61, 0xbe, 0x22, 0xf2, 0x85, 0xbd, 0xc8, 0x99,
+	0x9e, 0x3b, 0x72, 0x3c, 0x5a, 0xbd, 0x23, 0x23, 0x71, 0x62, 0x2a, 0xcb, 0x49, 0x51, 0x5e, 0x61,
+	0xdb, 0xa4, 0x26, 0xe5, 0xcb, 0xea, 0x78, 0xe5, 0x47, 0x4b, 0x75, 0xd8, 0xc4, 0x64, 0x40, 0x3d,
+	0xd2, 0xb2, 0x4c, 0x9b, 0xb8, 0xaa, 0xeb, 0x52, 0x17, 0x21, 0x88, 0xf5, 0x68, 0x9f, 0xe4, 0xe5,
+	0xa2, 0x7c, 0x18, 0xc7, 0x7c, 0x8d, 0x8a, 0x90, 0xee, 0x13, 0xd6, 0x73, 0x2d, 0xc7, 0xb3, 0xa8,
+	0x9d, 0x5f, 0x29, 0xca, 0x87, 0x29, 0x1c, 0x0d, 0x95, 0xca, 0x90, 0x69, 0x0e, 0xbb, 0x0d, 0x32,
+	0xc2, 0xe4, 0xcf, 0x21, 0x61, 0x1e, 0xda, 0x85, 0xb5, 0xde, 0x6d, 0xc7, 0xb2, 0x0d, 0xab, 0xcf,
+	0x51, 0x29, 0x9c, 0xe4, 0xfb, 0x7a, 0xbf, 0xf4, 0xb7, 0x0c, 0xd9, 0x49, 0x31, 0x73, 0xa8, 0xcd,
+	0x08, 0x3a, 0x86, 0xa4, 0x33, 0xec, 0x1a, 0x77, 0x64, 0xc4, 0x8b, 0xd3, 0x47, 0x7b, 0x95, 0xc8,
+	0x17, 0xf0, 0xdd, 0x56, 0x9a, 0xc3, 0xee, 0xbd, 0xd5, 0x6b, 0x90, 0xd1, 0x49, 0xec, 0xf1, 0xfd,
+	0x81, 0x84, 0x13, 0x0e, 0x87, 0xa0, 0x63, 0x88, 0x93, 0xb1, 0x74, 0xae, 0x2b, 0x7d, 0xf4, 0x4d,
+	0x65, 0xfe, 0xe3, 0x55, 0xe6, 0x7c, 0x62, 0xff, 0x4c, 0xe9, 0x0a, 0x36, 0xc6, 0xd1, 0xdf, 0xa9,
+	0x47, 0x26, 0xd2, 0xcb, 0x10, 0x7b, 0xa0, 0x1e, 0x11, 0x4a, 0x76, 0xa2, 0x38, 0xff, 0x9b, 0xf2,
+	0x62, 0x5e, 0x33, 0x65, 0x73, 0x65, 0xda, 0xe6, 0x5f, 0x32, 0x20, 0xde, 0xb0, 0xef, 0xc3, 0x85,
+	0xd5, 0xef, 0x3f, 0x85, 0x2e, 0x1c, 0xfa, 0x3d, 0xde, 0xe4, 0xef, 0x16, 0xb6, 0xc6, 0xd1, 0xa6,
+	0x4b, 0x1d, 0xca, 0x3a, 0xf7, 0x13, 0x8f, 0x3f, 0xc1, 0x9a, 0x23, 0x42, 0x42, 0x49, 0x61, 0x5e,
+	0x49, 0x70, 0x28, 0xa8, 0x7d, 0xcd, 0xef, 0xbf, 0x32, 0xec, 0xf8, 0x7e, 0xc3, 0x66, 0xc2, 0xf3,
+	0xcf, 0x9f, 0xd3, 0x4d, 0x78, 0x0f, 0x7b, 0xbe, 0xc9, 0x7f, 0x06, 0xd2, 0x4d, 0xcb, 0x36, 0x85,
+	0xef, 0x52, 0x16, 0xd6, 0xfd, 0xad, 0xaf, 0xac, 0xf4, 0x7f, 0x1c, 0x92, 0xe7, 0x84, 0xb1, 0x8e,
+	0x49, 0x50, 0x03, 0x36, 0xc4, 0x10, 0x1a, 0xae, 0x5f, 0x2e, 0xc4, 0x7e, 0xb5, 0xa8, 0xe3, 0xd4,
+	0xb8, 0xd7, 0x24, 0x9c, 0x71, 0xa6, 0xe6, 0x5f, 0x83, 0x5c, 0x08, 0xf3, 0x9b, 0x09, 0xfd, 0xa5,
+	0xd7, 0x68, 0x7e, 0x65, 0x4d, 0xc2, 0x59, 0x67, 0xfa, 0x85, 0xfc, 0x06, 0x9b, 0xcc, 0x32, 0x6d,
+	0x63, 0x3c, 0x11, 0x81, 0xbc, 0x55, 0x0e, 0xfc, 0x7a, 0x11, 0x70, 0x66, 0xa8, 0x6b, 0x12, 0xde,
+	0x60, 0x33, 0x73, 0x7e, 0x0d, 0xdb, 0x8c, 0xdf, 0xd7, 0x04, 0x2a, 0x64, 0xc6, 0x38, 0xf5, 0xdb,
+	0x65, 0xd4, 0xe9, 0x79, 0xae, 0x49, 0x18, 0xb1, 0xf9, 0x29, 0xff, 0x03, 0xbe, 0xe0, 0x72, 0x27,
+	0x97, 0x18, 0x48, 0x8e, 0x73, 0xf8, 0x77, 0xcb, 0xe0, 0x33, 0x73, 0x5a, 0x93, 0xf0, 0x16, 0x9b,
+	0x0f, 0xa3, 0x1b, 0xc8, 0x0b, 0xe9, 0x91, 0x06, 0x42, 0x7e, 0x82, 0x77, 0x28, 0x2f, 0x97, 0x3f,
+	0x3b, 0x9e, 0x35, 0x09, 0xef, 0xb0, 0xc5, 0x83, 0x7b, 0x06, 0xeb, 0x8e, 0x65, 0x9b, 0x81, 0xfa,
+	0x24, 0x67, 0x1f, 0x2c, 0xbc, 0xc1, 0x70, 0xca, 0x6a, 0x12, 0x4e, 0x3b, 0xe1, 0x16, 0xfd, 0x0a,
+	0x19, 0x41, 0x11, 0x12, 0xd7, 0x38, 0xa6, 0xb8, 0x1c, 0x13, 0x08, 0x5b, 0x77, 0x22, 0x7b, 0xb4,
+	0x0f, 0x20, 0x94, 0x8c, 0xdf, 0x5f, 0xaa, 0x28, 0x1f, 0xc6, 0x70, 0x4a, 0x44, 0xea, 0xfd, 0x93,
+	0x38, 0xac, 0xb2, 0xe1, 0xa0, 0xfc, 0x9f, 0x0c, 0x09, 0xfe, 0x06, 0x18, 0x42, 0x90, 0x55, 0x31,
+	0xd6, 0x71, 0xcb, 0xb8, 0xd0, 0x1a, 0x9a, 0x7e, 0xa9, 0xe5, 0x24, 0xa4, 0x40, 0x21, 0x88, 0xa9,
+	0x57, 0x4d, 0xf5, 0xb4, 0xad, 0x9e, 0x19, 0x58, 0x6d, 0x35, 0x75, 0xad, 0xa5, 0xe6, 0x64, 0x94,
+	0x87, 0x6d, 0x91, 0xd7, 0x74, 0xe3, 0x54, 0xd7, 0x34, 0xf5, 0xb4, 0x5d, 0xd7, 0xb5, 0xdc, 0x0a,
+	0xda, 0x87, 0x5d, 0x91, 0x09, 0xc3, 0x46, 0xbb, 0x7e, 0xae, 0xea, 0x17, 0xed, 0xdc, 0x2a, 0xfa,
+	0x12, 0xb6, 0x44, 0x1a, 0xab, 0xbf, 0x9c, 0x05, 0x89, 0x58, 0x84, 0x78, 0x89, 0xeb, 0x6d, 0x35,
+	0xc8, 0xc4, 0x4f, 0xf4, 0xc7, 0x67, 0x45, 0x7e, 0x7a, 0x56, 0xe4, 0x0f, 0xcf, 0x8a, 0xfc, 0xcf,
+	0x8b, 0x22, 0x3d, 0xbd, 0x28, 0xd2, 0xbb, 0x17, 0x45, 0xba, 0xfe, 0xd1, 0xb4, 0xbc, 0xdb, 0x61,
+	0xb7, 0xd2, 0xa3, 0x83, 0x6a, 0x8f, 0x0e, 0x88, 0xd7, 0xbd, 0xf1, 0xc2, 0x85, 0xff, 0x2b, 0x9b,
+	0xff, 0x89, 0x76, 0x13, 0x3c, 0xf3, 0xc3, 0xc7, 0x01, 0x00, 0x4c, 0x2a, 0xfd, 0xe4, 0x61, 0x07,
+	0x00, 0x00,
 }
 
 func (m *RemoteSignerError) Marshal() (dAtA []byte, err error) {
@@ -1079,6 +1093,11 @@ func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RequestId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Sum != nil {
 		{
 			size := m.Sum.Size()
@@ -1405,6 +1424,9 @@ func (m *Message) Size() (n int) {
 	if m.Sum != nil {
 		n += m.Sum.Size()
 	}
+	if m.RequestId != 0 {
+		n += 1 + sovTypes(uint64(m.RequestId))
+	}
 	return n
 }
 
@@ -2696,6 +2718,25 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_PingResponse{v}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
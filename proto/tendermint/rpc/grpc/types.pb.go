@@ -116,6 +116,50 @@ func (m *RequestBroadcastTx) GetTx() []byte {
 	return nil
 }
 
+type RequestBroadcastTxBatch struct {
+	Txs [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *RequestBroadcastTxBatch) Reset()         { *m = RequestBroadcastTxBatch{} }
+func (m *RequestBroadcastTxBatch) String() string { return proto.CompactTextString(m) }
+func (*RequestBroadcastTxBatch) ProtoMessage()    {}
+func (*RequestBroadcastTxBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffff5682c662b95, []int{2}
+}
+func (m *RequestBroadcastTxBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestBroadcastTxBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestBroadcastTxBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestBroadcastTxBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestBroadcastTxBatch.Merge(m, src)
+}
+func (m *RequestBroadcastTxBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestBroadcastTxBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestBroadcastTxBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestBroadcastTxBatch proto.InternalMessageInfo
+
+func (m *RequestBroadcastTxBatch) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
 type ResponsePing struct {
 }
 
@@ -123,7 +167,7 @@ func (m *ResponsePing) Reset()         { *m = ResponsePing{} }
 func (m *ResponsePing) String() string { return proto.CompactTextString(m) }
 func (*ResponsePing) ProtoMessage()    {}
 func (*ResponsePing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{2}
+	return fileDescriptor_0ffff5682c662b95, []int{3}
 }
 func (m *ResponsePing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +205,7 @@ func (m *ResponseBroadcastTx) Reset()         { *m = ResponseBroadcastTx{} }
 func (m *ResponseBroadcastTx) String() string { return proto.CompactTextString(m) }
 func (*ResponseBroadcastTx) ProtoMessage()    {}
 func (*ResponseBroadcastTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{3}
+	return fileDescriptor_0ffff5682c662b95, []int{4}
 }
 func (m *ResponseBroadcastTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,6 +248,112 @@ func (m *ResponseBroadcastTx) GetTxResult() *types.ExecTxResult {
 	return nil
 }
 
+type ResponseBroadcastTxBatch struct {
+	// results holds the result of each transaction, in the order of the request.
+	Results []*BroadcastTxBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *ResponseBroadcastTxBatch) Reset()         { *m = ResponseBroadcastTxBatch{} }
+func (m *ResponseBroadcastTxBatch) String() string { return proto.CompactTextString(m) }
+func (*ResponseBroadcastTxBatch) ProtoMessage()    {}
+func (*ResponseBroadcastTxBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffff5682c662b95, []int{5}
+}
+func (m *ResponseBroadcastTxBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseBroadcastTxBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseBroadcastTxBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseBroadcastTxBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseBroadcastTxBatch.Merge(m, src)
+}
+func (m *ResponseBroadcastTxBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseBroadcastTxBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseBroadcastTxBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseBroadcastTxBatch proto.InternalMessageInfo
+
+func (m *ResponseBroadcastTxBatch) GetResults() []*BroadcastTxBatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type BroadcastTxBatchResult struct {
+	Hash    []byte                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	CheckTx *types.ResponseCheckTx `protobuf:"bytes,2,opt,name=check_tx,json=checkTx,proto3" json:"check_tx,omitempty"`
+	// error is set if the transaction could not be submitted to the mempool.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *BroadcastTxBatchResult) Reset()         { *m = BroadcastTxBatchResult{} }
+func (m *BroadcastTxBatchResult) String() string { return proto.CompactTextString(m) }
+func (*BroadcastTxBatchResult) ProtoMessage()    {}
+func (*BroadcastTxBatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffff5682c662b95, []int{6}
+}
+func (m *BroadcastTxBatchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BroadcastTxBatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BroadcastTxBatchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BroadcastTxBatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BroadcastTxBatchResult.Merge(m, src)
+}
+func (m *BroadcastTxBatchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BroadcastTxBatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BroadcastTxBatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BroadcastTxBatchResult proto.InternalMessageInfo
+
+func (m *BroadcastTxBatchResult) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *BroadcastTxBatchResult) GetCheckTx() *types.ResponseCheckTx {
+	if m != nil {
+		return m.CheckTx
+	}
+	return nil
+}
+
+func (m *BroadcastTxBatchResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // BlockByHashRequest is a request to get a block by its hash.
 type BlockByHashRequest struct {
 	Hash  []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
@@ -214,7 +364,7 @@ func (m *BlockByHashRequest) Reset()         { *m = BlockByHashRequest{} }
 func (m *BlockByHashRequest) String() string { return proto.CompactTextString(m) }
 func (*BlockByHashRequest) ProtoMessage()    {}
 func (*BlockByHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{4}
+	return fileDescriptor_0ffff5682c662b95, []int{7}
 }
 func (m *BlockByHashRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -271,7 +421,7 @@ func (m *BlockByHeightRequest) Reset()         { *m = BlockByHeightRequest{} }
 func (m *BlockByHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*BlockByHeightRequest) ProtoMessage()    {}
 func (*BlockByHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{5}
+	return fileDescriptor_0ffff5682c662b95, []int{8}
 }
 func (m *BlockByHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,7 +476,7 @@ func (m *CommitRequest) Reset()         { *m = CommitRequest{} }
 func (m *CommitRequest) String() string { return proto.CompactTextString(m) }
 func (*CommitRequest) ProtoMessage()    {}
 func (*CommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{6}
+	return fileDescriptor_0ffff5682c662b95, []int{9}
 }
 func (m *CommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,7 +524,7 @@ func (m *ValidatorSetRequest) Reset()         { *m = ValidatorSetRequest{} }
 func (m *ValidatorSetRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSetRequest) ProtoMessage()    {}
 func (*ValidatorSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{7}
+	return fileDescriptor_0ffff5682c662b95, []int{10}
 }
 func (m *ValidatorSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,7 +568,7 @@ func (m *SubscribeNewHeightsRequest) Reset()         { *m = SubscribeNewHeightsR
 func (m *SubscribeNewHeightsRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeNewHeightsRequest) ProtoMessage()    {}
 func (*SubscribeNewHeightsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{8}
+	return fileDescriptor_0ffff5682c662b95, []int{11}
 }
 func (m *SubscribeNewHeightsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -455,7 +605,7 @@ func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
 func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
 func (*StatusRequest) ProtoMessage()    {}
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{9}
+	return fileDescriptor_0ffff5682c662b95, []int{12}
 }
 func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,7 +650,7 @@ func (m *BlockByHashResponse) Reset()         { *m = BlockByHashResponse{} }
 func (m *BlockByHashResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockByHashResponse) ProtoMessage()    {}
 func (*BlockByHashResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{10}
+	return fileDescriptor_0ffff5682c662b95, []int{13}
 }
 func (m *BlockByHashResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -573,7 +723,7 @@ func (m *BlockByHeightResponse) Reset()         { *m = BlockByHeightResponse{} }
 func (m *BlockByHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockByHeightResponse) ProtoMessage()    {}
 func (*BlockByHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{11}
+	return fileDescriptor_0ffff5682c662b95, []int{14}
 }
 func (m *BlockByHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +789,7 @@ func (m *CommitResponse) Reset()         { *m = CommitResponse{} }
 func (m *CommitResponse) String() string { return proto.CompactTextString(m) }
 func (*CommitResponse) ProtoMessage()    {}
 func (*CommitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{12}
+	return fileDescriptor_0ffff5682c662b95, []int{15}
 }
 func (m *CommitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +838,7 @@ func (m *ValidatorSetResponse) Reset()         { *m = ValidatorSetResponse{} }
 func (m *ValidatorSetResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSetResponse) ProtoMessage()    {}
 func (*ValidatorSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{13}
+	return fileDescriptor_0ffff5682c662b95, []int{16}
 }
 func (m *ValidatorSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -741,7 +891,7 @@ func (m *SubscribeNewHeightsResponse) Reset()         { *m = SubscribeNewHeights
 func (m *SubscribeNewHeightsResponse) String() string { return proto.CompactTextString(m) }
 func (*SubscribeNewHeightsResponse) ProtoMessage()    {}
 func (*SubscribeNewHeightsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{14}
+	return fileDescriptor_0ffff5682c662b95, []int{17}
 }
 func (m *SubscribeNewHeightsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +945,7 @@ func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
 func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
 func (*StatusResponse) ProtoMessage()    {}
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{15}
+	return fileDescriptor_0ffff5682c662b95, []int{18}
 }
 func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +1012,7 @@ func (m *SyncInfo) Reset()         { *m = SyncInfo{} }
 func (m *SyncInfo) String() string { return proto.CompactTextString(m) }
 func (*SyncInfo) ProtoMessage()    {}
 func (*SyncInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{16}
+	return fileDescriptor_0ffff5682c662b95, []int{19}
 }
 func (m *SyncInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -965,7 +1115,7 @@ func (m *ValidatorInfo) Reset()         { *m = ValidatorInfo{} }
 func (m *ValidatorInfo) String() string { return proto.CompactTextString(m) }
 func (*ValidatorInfo) ProtoMessage()    {}
 func (*ValidatorInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{17}
+	return fileDescriptor_0ffff5682c662b95, []int{20}
 }
 func (m *ValidatorInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1178,7 @@ func (m *DataRootInclusionProofRequest) Reset()         { *m = DataRootInclusion
 func (m *DataRootInclusionProofRequest) String() string { return proto.CompactTextString(m) }
 func (*DataRootInclusionProofRequest) ProtoMessage()    {}
 func (*DataRootInclusionProofRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{18}
+	return fileDescriptor_0ffff5682c662b95, []int{21}
 }
 func (m *DataRootInclusionProofRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1086,7 +1236,7 @@ func (m *DataRootInclusionProofResponse) Reset()         { *m = DataRootInclusio
 func (m *DataRootInclusionProofResponse) String() string { return proto.CompactTextString(m) }
 func (*DataRootInclusionProofResponse) ProtoMessage()    {}
 func (*DataRootInclusionProofResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{19}
+	return fileDescriptor_0ffff5682c662b95, []int{22}
 }
 func (m *DataRootInclusionProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1125,8 +1275,11 @@ func (m *DataRootInclusionProofResponse) GetProof() crypto.Proof {
 func init() {
 	proto.RegisterType((*RequestPing)(nil), "tendermint.rpc.grpc.RequestPing")
 	proto.RegisterType((*RequestBroadcastTx)(nil), "tendermint.rpc.grpc.RequestBroadcastTx")
+	proto.RegisterType((*RequestBroadcastTxBatch)(nil), "tendermint.rpc.grpc.RequestBroadcastTxBatch")
 	proto.RegisterType((*ResponsePing)(nil), "tendermint.rpc.grpc.ResponsePing")
 	proto.RegisterType((*ResponseBroadcastTx)(nil), "tendermint.rpc.grpc.ResponseBroadcastTx")
+	proto.RegisterType((*ResponseBroadcastTxBatch)(nil), "tendermint.rpc.grpc.ResponseBroadcastTxBatch")
+	proto.RegisterType((*BroadcastTxBatchResult)(nil), "tendermint.rpc.grpc.BroadcastTxBatchResult")
 	proto.RegisterType((*BlockByHashRequest)(nil), "tendermint.rpc.grpc.BlockByHashRequest")
 	proto.RegisterType((*BlockByHeightRequest)(nil), "tendermint.rpc.grpc.BlockByHeightRequest")
 	proto.RegisterType((*CommitRequest)(nil), "tendermint.rpc.grpc.CommitRequest")
@@ -1148,82 +1301,88 @@ func init() {
 func init() { proto.RegisterFile("tendermint/rpc/grpc/types.proto", fileDescriptor_0ffff5682c662b95) }
 
 var fileDescriptor_0ffff5682c662b95 = []byte{
-	// 1198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
-	0x13, 0x37, 0x6d, 0x45, 0x96, 0x47, 0x92, 0xf3, 0xcf, 0xca, 0xc9, 0x5f, 0x60, 0x62, 0xc9, 0x61,
-	0x8b, 0xc6, 0x31, 0x50, 0xca, 0x50, 0x9a, 0x4b, 0x53, 0x14, 0x88, 0xec, 0x02, 0x31, 0x5c, 0x04,
-	0x2a, 0xed, 0xe6, 0xd0, 0x0b, 0x4b, 0x52, 0x2b, 0x89, 0xb0, 0xc4, 0x65, 0xb9, 0x4b, 0x47, 0xea,
-	0xad, 0xe8, 0xbd, 0x08, 0xd0, 0xcf, 0xd3, 0x7b, 0x8e, 0xb9, 0x14, 0xe8, 0xa1, 0x48, 0x0a, 0xfb,
-	0xd0, 0xaf, 0x51, 0xec, 0x83, 0x12, 0x69, 0x3d, 0xe2, 0xf4, 0xd8, 0x8b, 0x30, 0x9c, 0x99, 0xdf,
-	0xbc, 0x76, 0x76, 0x67, 0x04, 0x75, 0x86, 0x83, 0x0e, 0x8e, 0x86, 0x7e, 0xc0, 0x1a, 0x51, 0xe8,
-	0x35, 0x7a, 0xfc, 0x87, 0x8d, 0x43, 0x4c, 0xcd, 0x30, 0x22, 0x8c, 0xa0, 0xca, 0x54, 0xc1, 0x8c,
-	0x42, 0xcf, 0xe4, 0x0a, 0xfa, 0xdd, 0x14, 0xca, 0x71, 0x3d, 0x3f, 0x8d, 0xd0, 0xef, 0xa5, 0x84,
-	0x82, 0x9f, 0x91, 0xea, 0x29, 0x69, 0xd8, 0x0c, 0x17, 0x22, 0xbd, 0x68, 0x1c, 0x32, 0xd2, 0x38,
-	0xc3, 0xe3, 0x44, 0xba, 0x3d, 0x2b, 0x0d, 0x23, 0x42, 0xba, 0x4a, 0xbc, 0x33, 0xe3, 0xf6, 0xdc,
-	0x19, 0xf8, 0x1d, 0x87, 0x91, 0x48, 0x69, 0xd4, 0x7b, 0x84, 0xf4, 0x06, 0xb8, 0x21, 0xbe, 0xdc,
-	0xb8, 0xdb, 0x60, 0xfe, 0x10, 0x53, 0xe6, 0x0c, 0x43, 0xa5, 0xb0, 0xd5, 0x23, 0x3d, 0x22, 0xc8,
-	0x06, 0xa7, 0x24, 0xd7, 0x28, 0x43, 0xd1, 0xc2, 0x3f, 0xc4, 0x98, 0xb2, 0xb6, 0x1f, 0xf4, 0x8c,
-	0x8f, 0x01, 0xa9, 0xcf, 0x56, 0x44, 0x9c, 0x8e, 0xe7, 0x50, 0x76, 0x3a, 0x42, 0x9b, 0xb0, 0xca,
-	0x46, 0x55, 0x6d, 0x47, 0xdb, 0x2d, 0x59, 0xab, 0x6c, 0x64, 0x6c, 0x42, 0xc9, 0xc2, 0x34, 0x24,
-	0x01, 0xc5, 0x02, 0xf5, 0x8b, 0x06, 0x95, 0x84, 0x91, 0xc6, 0x3d, 0x81, 0x82, 0xd7, 0xc7, 0xde,
-	0x99, 0xad, 0xd0, 0xc5, 0xe6, 0x8e, 0x99, 0xaa, 0x38, 0x2f, 0xae, 0x99, 0xe0, 0x0e, 0xb8, 0xe2,
-	0xe9, 0xc8, 0x5a, 0xf7, 0x24, 0x81, 0x3e, 0x87, 0x0d, 0x36, 0xb2, 0x23, 0x4c, 0xe3, 0x01, 0xab,
-	0xae, 0x0a, 0xf4, 0xf6, 0x0c, 0xfa, 0xab, 0x11, 0xf6, 0x4e, 0x47, 0x96, 0x50, 0xb2, 0x0a, 0x4c,
-	0x51, 0xc6, 0x97, 0x80, 0x5a, 0x03, 0xe2, 0x9d, 0xb5, 0xc6, 0xcf, 0x1c, 0xda, 0x57, 0x19, 0x21,
-	0x04, 0xb9, 0xbe, 0x43, 0xfb, 0x2a, 0x11, 0x41, 0xa3, 0x2d, 0xb8, 0x11, 0x46, 0xe4, 0x1c, 0x0b,
-	0x0f, 0x05, 0x4b, 0x7e, 0x18, 0x87, 0xb0, 0x95, 0xe0, 0xb1, 0xdf, 0xeb, 0xb3, 0xc4, 0xc2, 0x1d,
-	0xc8, 0xf7, 0x05, 0x43, 0xd8, 0x58, 0xb3, 0xd4, 0xd7, 0x02, 0x2b, 0x0f, 0xa0, 0x7c, 0x40, 0x86,
-	0x43, 0xff, 0x7d, 0x70, 0xe3, 0x53, 0xa8, 0xbc, 0x48, 0x8e, 0xf3, 0x04, 0xbf, 0x57, 0xfd, 0x1e,
-	0xe8, 0x27, 0xb1, 0x4b, 0xbd, 0xc8, 0x77, 0xf1, 0x73, 0xfc, 0x52, 0x86, 0x48, 0x15, 0xca, 0xb8,
-	0x09, 0xe5, 0x13, 0xe6, 0xb0, 0x78, 0xc2, 0xf8, 0x53, 0x83, 0x4a, 0xa6, 0x1a, 0xb2, 0xe0, 0xe8,
-	0x31, 0x80, 0xcb, 0xd9, 0x76, 0xe8, 0x44, 0x4c, 0x9d, 0xcf, 0x9d, 0x74, 0x85, 0x65, 0xf7, 0xb6,
-	0x9d, 0x88, 0x59, 0x1b, 0x42, 0x93, 0x93, 0x68, 0x1f, 0xf2, 0x9e, 0xc8, 0x4a, 0x1d, 0x4a, 0x75,
-	0x16, 0xa2, 0xb2, 0x56, 0x7a, 0xe8, 0x00, 0xca, 0x93, 0x6e, 0xb5, 0x29, 0x66, 0xd5, 0x35, 0x01,
-	0xac, 0xcd, 0x02, 0x33, 0x55, 0x28, 0x9d, 0xa7, 0xbe, 0xd0, 0xff, 0x61, 0xdd, 0xa7, 0xf6, 0xc0,
-	0xa1, 0xac, 0x9a, 0x13, 0x45, 0xce, 0xfb, 0xf4, 0x6b, 0x87, 0x32, 0xe3, 0xad, 0x06, 0xb7, 0xaf,
-	0x1c, 0xd6, 0x7f, 0x2b, 0xc1, 0x16, 0x6c, 0x26, 0x6d, 0xa4, 0x12, 0x9b, 0x46, 0xa8, 0x5d, 0x2f,
-	0x42, 0x83, 0xc2, 0x56, 0xb6, 0xc3, 0x94, 0xa5, 0x99, 0xc8, 0xb5, 0x7f, 0x11, 0xf9, 0xb4, 0x4f,
-	0x57, 0x33, 0x7d, 0x7a, 0x04, 0x77, 0xe7, 0xf6, 0xa9, 0xf2, 0xbd, 0xe8, 0x32, 0x25, 0xd7, 0x74,
-	0x75, 0x7a, 0x4d, 0x8d, 0xdf, 0x35, 0xd8, 0x4c, 0xba, 0x5a, 0xc1, 0xbf, 0x80, 0x8d, 0x80, 0x74,
-	0xb0, 0xed, 0x07, 0x5d, 0xa2, 0xc2, 0xae, 0xa7, 0xc3, 0x0e, 0x9b, 0xa1, 0x79, 0x88, 0xbb, 0x4e,
-	0x3c, 0x60, 0xcf, 0x49, 0x07, 0x1f, 0x05, 0x5d, 0x62, 0x15, 0x02, 0x45, 0xf1, 0xd7, 0x85, 0x8e,
-	0x03, 0x4f, 0xa2, 0xe7, 0xbc, 0x2e, 0xc9, 0x34, 0x30, 0x4f, 0xc6, 0x81, 0x27, 0xb1, 0x54, 0x51,
-	0xe8, 0x08, 0x36, 0xa7, 0x45, 0x13, 0x06, 0xe4, 0x79, 0x1b, 0x73, 0x0d, 0x4c, 0x0a, 0x27, 0xac,
-	0x4c, 0xcb, 0xcd, 0x3f, 0x8d, 0xbf, 0xd7, 0xa0, 0x90, 0x78, 0x40, 0x7b, 0x70, 0x6b, 0xe0, 0x30,
-	0x4c, 0x99, 0x2d, 0xdb, 0x36, 0xf5, 0x58, 0xdd, 0x94, 0x02, 0xd1, 0xe7, 0xfc, 0x12, 0xa3, 0x4f,
-	0x40, 0xb1, 0x6c, 0x27, 0x0c, 0xed, 0x54, 0xbd, 0xca, 0x92, 0xfd, 0x34, 0x0c, 0x85, 0x9e, 0x09,
-	0x95, 0xac, 0x4d, 0x59, 0xf1, 0x35, 0x51, 0xf1, 0x5b, 0x69, 0xab, 0xb2, 0xf8, 0xed, 0x2b, 0x31,
-	0xf0, 0x29, 0x22, 0xfa, 0xb1, 0xd8, 0xd4, 0x4d, 0x39, 0x62, 0xcc, 0x64, 0xc4, 0x98, 0xa7, 0xc9,
-	0x88, 0x69, 0x15, 0x5e, 0xbf, 0xad, 0xaf, 0xbc, 0x7a, 0x57, 0xd7, 0x32, 0x91, 0x72, 0x39, 0x8f,
-	0x00, 0x3b, 0xd1, 0xc0, 0xbf, 0x92, 0xd7, 0x0d, 0x11, 0xed, 0xad, 0x44, 0x34, 0xcd, 0x6c, 0x0f,
-	0x26, 0xcc, 0x69, 0x6e, 0x79, 0x59, 0x85, 0x44, 0x90, 0x64, 0xd7, 0x84, 0xdb, 0x57, 0x6d, 0xcb,
-	0xfc, 0xd6, 0x45, 0x7e, 0x95, 0xac, 0x75, 0x99, 0xe1, 0xe9, 0x4c, 0x3c, 0x22, 0xc7, 0xc2, 0x07,
-	0xe4, 0x98, 0x8d, 0x5a, 0x64, 0x59, 0x87, 0xa2, 0xe7, 0x30, 0xaf, 0xef, 0x07, 0x3d, 0x3b, 0x0e,
-	0xab, 0x1b, 0xe2, 0x06, 0x43, 0xc2, 0xfa, 0x36, 0x34, 0x7e, 0xd6, 0xa0, 0x9c, 0x69, 0x05, 0x54,
-	0x85, 0x75, 0xa7, 0xd3, 0x89, 0x30, 0xa5, 0xea, 0x90, 0x93, 0x4f, 0xf4, 0x18, 0xd6, 0xc3, 0xd8,
-	0xb5, 0xcf, 0xf0, 0x58, 0xb5, 0xe6, 0xbd, 0x74, 0x67, 0xc9, 0xf5, 0xc0, 0x6c, 0xc7, 0xee, 0xc0,
-	0xf7, 0x8e, 0xf1, 0xd8, 0xca, 0x87, 0xb1, 0x7b, 0x8c, 0xc7, 0xe8, 0x3e, 0x94, 0xce, 0x09, 0xe3,
-	0x11, 0x84, 0xe4, 0x25, 0x8e, 0xd4, 0x21, 0x17, 0x25, 0xaf, 0xcd, 0x59, 0x86, 0x0d, 0xdb, 0x87,
-	0x0e, 0x73, 0x2c, 0x42, 0xd8, 0x51, 0xe0, 0x0d, 0x62, 0xea, 0x93, 0xa0, 0xcd, 0xf7, 0x8c, 0x6b,
-	0x4c, 0x38, 0xca, 0xf8, 0x33, 0xca, 0x03, 0xca, 0x59, 0xf2, 0x03, 0xfd, 0x0f, 0xd6, 0x70, 0xd0,
-	0x11, 0x8e, 0x72, 0x16, 0x27, 0x8d, 0x17, 0x50, 0x5b, 0xe4, 0x40, 0xdd, 0xdb, 0xcf, 0xc4, 0xac,
-	0x24, 0xdd, 0x79, 0x6f, 0x57, 0x92, 0x1a, 0x97, 0xb7, 0x72, 0xbc, 0xde, 0x96, 0x54, 0x6e, 0xfe,
-	0xa6, 0x41, 0x69, 0xb2, 0x5a, 0x3c, 0x6d, 0x1f, 0xa1, 0x63, 0xc8, 0xf1, 0xdd, 0x03, 0xed, 0xcc,
-	0xbd, 0x74, 0xa9, 0x9d, 0x46, 0xbf, 0xbf, 0x40, 0x63, 0xba, 0xc0, 0xa0, 0xef, 0xa1, 0x98, 0xde,
-	0x5b, 0x1e, 0x2c, 0xb3, 0x99, 0x52, 0xd4, 0x77, 0x97, 0x9a, 0x4e, 0x69, 0x36, 0xdf, 0xe5, 0xa0,
-	0x20, 0xba, 0x85, 0xc7, 0xee, 0x42, 0x31, 0x35, 0x90, 0x17, 0xb8, 0x9b, 0x5d, 0x60, 0x16, 0xb8,
-	0x9b, 0x33, 0xdb, 0xf7, 0x35, 0xd4, 0x87, 0x72, 0x66, 0x2a, 0xa2, 0x87, 0x4b, 0xc1, 0xe9, 0x35,
-	0x47, 0xdf, 0xbb, 0x8e, 0xea, 0xc4, 0xd3, 0x37, 0x90, 0x97, 0xd3, 0x06, 0xcd, 0x7f, 0x00, 0x33,
-	0x3b, 0x90, 0xfe, 0xd1, 0x52, 0x1d, 0xd5, 0x23, 0x1e, 0x94, 0xd2, 0xf3, 0x06, 0xed, 0x2e, 0x7f,
-	0x59, 0xa7, 0x3b, 0x93, 0xfe, 0xf0, 0x1a, 0x9a, 0xca, 0xc9, 0x8f, 0x50, 0x99, 0x33, 0x9e, 0x50,
-	0x63, 0xfe, 0x18, 0x58, 0xb8, 0x70, 0xe9, 0xfb, 0xd7, 0x07, 0xa4, 0x6b, 0x26, 0xc7, 0xd9, 0x82,
-	0x9a, 0x65, 0x36, 0xb8, 0x05, 0x35, 0xcb, 0xce, 0xc3, 0xe6, 0xaf, 0x9a, 0x38, 0x71, 0x97, 0xb2,
-	0x08, 0x3b, 0x43, 0xde, 0x66, 0x3f, 0x69, 0x70, 0x67, 0xfe, 0x65, 0x44, 0xcd, 0xb9, 0x16, 0x97,
-	0x3e, 0x0d, 0xfa, 0xa3, 0x0f, 0xc2, 0xa8, 0x2b, 0xf0, 0xec, 0xf5, 0x45, 0x4d, 0x7b, 0x73, 0x51,
-	0xd3, 0xfe, 0xba, 0xa8, 0x69, 0xaf, 0x2e, 0x6b, 0x2b, 0x6f, 0x2e, 0x6b, 0x2b, 0x7f, 0x5c, 0xd6,
-	0x56, 0xbe, 0x33, 0x7b, 0x3e, 0xeb, 0xc7, 0xae, 0xe9, 0x91, 0x61, 0xc3, 0x23, 0x43, 0xcc, 0xdc,
-	0x2e, 0x9b, 0x12, 0xc9, 0xdf, 0xb5, 0x27, 0x1e, 0x89, 0x30, 0x27, 0xdc, 0xbc, 0x78, 0x92, 0x1f,
-	0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0xea, 0x95, 0x89, 0xc8, 0xd5, 0x0d, 0x00, 0x00,
+	// 1288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4f, 0x8f, 0xd3, 0x46,
+	0x14, 0x5f, 0x67, 0x43, 0x36, 0xfb, 0x92, 0x2c, 0x30, 0x59, 0x96, 0xc8, 0x40, 0x76, 0x31, 0x55,
+	0x59, 0xa0, 0x38, 0x28, 0x94, 0x4b, 0xa9, 0x2a, 0x11, 0x40, 0x62, 0x45, 0x85, 0x52, 0xef, 0x96,
+	0x43, 0x2f, 0xa9, 0xed, 0x4c, 0x12, 0x6b, 0x13, 0x8f, 0xeb, 0x19, 0x2f, 0x49, 0x2f, 0x55, 0xd5,
+	0x7b, 0x85, 0xd4, 0xef, 0xd1, 0xcf, 0xc1, 0x91, 0x4b, 0xa5, 0x1e, 0x2a, 0xa8, 0xe0, 0xd0, 0xaf,
+	0x51, 0xcd, 0x1f, 0x27, 0xf6, 0xc6, 0x09, 0xa1, 0xc7, 0x5e, 0xa2, 0xe7, 0x37, 0xbf, 0xf7, 0x77,
+	0xde, 0x9b, 0xf7, 0x02, 0xbb, 0x0c, 0xfb, 0x5d, 0x1c, 0x8e, 0x3c, 0x9f, 0x35, 0xc2, 0xc0, 0x6d,
+	0xf4, 0xf9, 0x0f, 0x9b, 0x04, 0x98, 0x9a, 0x41, 0x48, 0x18, 0x41, 0xd5, 0x19, 0xc0, 0x0c, 0x03,
+	0xd7, 0xe4, 0x00, 0xfd, 0x52, 0x42, 0xca, 0x76, 0x5c, 0x2f, 0x29, 0xa1, 0x5f, 0x4e, 0x1c, 0x0a,
+	0x7e, 0xea, 0x54, 0x4f, 0x9c, 0x06, 0xcd, 0x60, 0xa1, 0xa4, 0x1b, 0x4e, 0x02, 0x46, 0x1a, 0xc7,
+	0x78, 0x12, 0x9f, 0x5e, 0x99, 0x3f, 0x0d, 0x42, 0x42, 0x7a, 0xea, 0x78, 0x6f, 0xce, 0xec, 0x89,
+	0x3d, 0xf4, 0xba, 0x36, 0x23, 0xa1, 0x42, 0xec, 0xf6, 0x09, 0xe9, 0x0f, 0x71, 0x43, 0x7c, 0x39,
+	0x51, 0xaf, 0xc1, 0xbc, 0x11, 0xa6, 0xcc, 0x1e, 0x05, 0x0a, 0xb0, 0xdd, 0x27, 0x7d, 0x22, 0xc8,
+	0x06, 0xa7, 0x24, 0xd7, 0xa8, 0x40, 0xc9, 0xc2, 0x3f, 0x44, 0x98, 0xb2, 0xb6, 0xe7, 0xf7, 0x8d,
+	0x4f, 0x00, 0xa9, 0xcf, 0x56, 0x48, 0xec, 0xae, 0x6b, 0x53, 0x76, 0x34, 0x46, 0x5b, 0x90, 0x63,
+	0xe3, 0x9a, 0xb6, 0xa7, 0xed, 0x97, 0xad, 0x1c, 0x1b, 0x1b, 0xb7, 0xe0, 0xe2, 0x3c, 0xaa, 0x65,
+	0x33, 0x77, 0x80, 0xce, 0xc1, 0x3a, 0x1b, 0xd3, 0x9a, 0xb6, 0xb7, 0xbe, 0x5f, 0xb6, 0x38, 0x69,
+	0x6c, 0x41, 0xd9, 0xc2, 0x34, 0x20, 0x3e, 0xc5, 0xc2, 0xc4, 0xaf, 0x1a, 0x54, 0x63, 0x46, 0xd2,
+	0xc8, 0x7d, 0x28, 0xba, 0x03, 0xec, 0x1e, 0x77, 0x94, 0xa9, 0x52, 0x73, 0xcf, 0x4c, 0x5c, 0x0f,
+	0xbf, 0x09, 0x33, 0x96, 0x7b, 0xc8, 0x81, 0x47, 0x63, 0x6b, 0xc3, 0x95, 0x04, 0xfa, 0x02, 0x36,
+	0xd9, 0xb8, 0x13, 0x62, 0x1a, 0x0d, 0x59, 0x2d, 0x27, 0xa4, 0xaf, 0xcc, 0x49, 0x3f, 0x1e, 0x63,
+	0xf7, 0x68, 0x6c, 0x09, 0x90, 0x55, 0x64, 0x8a, 0x32, 0x6c, 0xa8, 0x65, 0xf8, 0x23, 0xc3, 0x79,
+	0x0c, 0x1b, 0x52, 0xa9, 0x0c, 0xa9, 0xd4, 0xbc, 0x65, 0x66, 0x94, 0x8c, 0x79, 0x5a, 0x4e, 0xd9,
+	0x88, 0x65, 0x8d, 0x9f, 0x60, 0x27, 0x1b, 0x82, 0x10, 0xe4, 0x07, 0x36, 0x1d, 0xa8, 0xe4, 0x0a,
+	0x3a, 0x95, 0x89, 0xdc, 0xc7, 0x66, 0x62, 0x1b, 0xce, 0xe0, 0x30, 0x24, 0x61, 0x6d, 0x7d, 0x4f,
+	0xdb, 0xdf, 0xb4, 0xe4, 0x87, 0xf1, 0x15, 0xa0, 0xd6, 0x90, 0xb8, 0xc7, 0xad, 0xc9, 0x13, 0x9b,
+	0x0e, 0xd4, 0xe5, 0x65, 0x1a, 0xdf, 0x86, 0x33, 0x41, 0x48, 0x4e, 0xb0, 0xb0, 0x5c, 0xb4, 0xe4,
+	0x87, 0xf1, 0x08, 0xb6, 0x63, 0x79, 0xec, 0xf5, 0x07, 0x2c, 0xd6, 0xb0, 0x03, 0x85, 0x81, 0x60,
+	0x08, 0x1d, 0xeb, 0x96, 0xfa, 0x5a, 0xa0, 0xe5, 0x3a, 0x54, 0x1e, 0x92, 0xd1, 0xc8, 0xfb, 0x90,
+	0xb8, 0x71, 0x1b, 0xaa, 0xcf, 0xe3, 0xfa, 0x3e, 0xc4, 0x1f, 0x84, 0x5f, 0x06, 0xfd, 0x30, 0x72,
+	0xa8, 0x1b, 0x7a, 0x0e, 0x7e, 0x86, 0x5f, 0x48, 0x17, 0xa9, 0x92, 0x32, 0xce, 0x42, 0xe5, 0x90,
+	0xd9, 0x2c, 0x9a, 0x32, 0xfe, 0xd2, 0xa0, 0x9a, 0xca, 0x86, 0x4c, 0x25, 0xba, 0x07, 0xe0, 0x70,
+	0x76, 0x27, 0xb0, 0x43, 0xa6, 0x6a, 0x70, 0x27, 0x99, 0x79, 0xd9, 0xce, 0x6d, 0x3b, 0x64, 0xd6,
+	0xa6, 0x40, 0x72, 0x12, 0xdd, 0x81, 0x82, 0x2b, 0xa2, 0x52, 0x97, 0x55, 0x9b, 0x17, 0x51, 0x51,
+	0x2b, 0x1c, 0x7a, 0x08, 0x95, 0x69, 0xfb, 0x76, 0x28, 0x66, 0xe2, 0xae, 0x4a, 0xcd, 0xfa, 0xbc,
+	0x60, 0x2a, 0x0b, 0xe5, 0x93, 0xc4, 0x17, 0xba, 0x08, 0x1b, 0x1e, 0xed, 0x0c, 0x6d, 0xca, 0x6a,
+	0x79, 0x91, 0xe4, 0x82, 0x47, 0xbf, 0xb6, 0x29, 0x33, 0xde, 0x68, 0x70, 0xe1, 0xd4, 0x65, 0xfd,
+	0xbf, 0x02, 0x6c, 0xc1, 0x56, 0x5c, 0x46, 0x2a, 0xb0, 0x99, 0x87, 0xda, 0x6a, 0x1e, 0x1a, 0x14,
+	0xb6, 0xd3, 0x15, 0xa6, 0x34, 0xcd, 0x79, 0xae, 0xfd, 0x07, 0xcf, 0x67, 0x75, 0x9a, 0x4b, 0xd5,
+	0xe9, 0x01, 0x5c, 0xca, 0xac, 0x53, 0x65, 0x7b, 0x51, 0x33, 0xc5, 0x6d, 0x9a, 0x9b, 0xb5, 0xa9,
+	0xf1, 0x87, 0x06, 0x5b, 0x71, 0x55, 0x2b, 0xf1, 0x2f, 0x61, 0xd3, 0x27, 0x5d, 0xdc, 0xf1, 0xfc,
+	0x1e, 0x51, 0x6e, 0xef, 0x26, 0xdd, 0x0e, 0x9a, 0x81, 0xf9, 0x08, 0xf7, 0xec, 0x68, 0xc8, 0x9e,
+	0x91, 0x2e, 0x3e, 0xf0, 0x7b, 0xc4, 0x2a, 0xfa, 0x8a, 0xe2, 0x2f, 0x28, 0x9d, 0xf8, 0xae, 0x94,
+	0xce, 0x78, 0x41, 0xa7, 0x6f, 0xdd, 0xe1, 0xc4, 0x77, 0xa5, 0x2c, 0x55, 0x14, 0x3a, 0x80, 0xad,
+	0x59, 0xd2, 0x84, 0x02, 0x79, 0xdf, 0x46, 0xa6, 0x82, 0x69, 0xe2, 0x84, 0x96, 0xca, 0x49, 0xf2,
+	0xd3, 0xf8, 0x67, 0x1d, 0x8a, 0xb1, 0x05, 0x74, 0x13, 0xce, 0x0f, 0x6d, 0x86, 0x29, 0xeb, 0xc8,
+	0xb2, 0x4d, 0x3c, 0x56, 0x67, 0xe5, 0x81, 0xa8, 0x73, 0xde, 0xc4, 0xe8, 0x53, 0x50, 0xac, 0x8e,
+	0x1d, 0x04, 0x9d, 0x44, 0xbe, 0x2a, 0x92, 0xfd, 0x20, 0x08, 0x04, 0xce, 0x84, 0x6a, 0x5a, 0xa7,
+	0xcc, 0xf8, 0xba, 0xc8, 0xf8, 0xf9, 0xa4, 0x56, 0x99, 0xfc, 0xf6, 0x29, 0x1f, 0xf8, 0x58, 0x15,
+	0xf5, 0x58, 0x6a, 0xea, 0xa6, 0x9c, 0xb9, 0x66, 0x3c, 0x73, 0xcd, 0xa3, 0x78, 0xe6, 0xb6, 0x8a,
+	0xaf, 0xde, 0xec, 0xae, 0xbd, 0x7c, 0xbb, 0xab, 0xa5, 0x3c, 0xe5, 0xe7, 0xdc, 0x03, 0x6c, 0x87,
+	0x43, 0xef, 0x54, 0x5c, 0x67, 0x84, 0xb7, 0xe7, 0xe3, 0xa3, 0x59, 0x64, 0x37, 0x61, 0xca, 0x9c,
+	0xc5, 0x56, 0x90, 0x59, 0x88, 0x0f, 0xe2, 0xe8, 0x9a, 0x70, 0xe1, 0xb4, 0x6e, 0x19, 0xdf, 0x86,
+	0x88, 0xaf, 0x9a, 0xd6, 0x2e, 0x23, 0x3c, 0x9a, 0xf3, 0x47, 0xc4, 0x58, 0xfc, 0x88, 0x18, 0xd3,
+	0x5e, 0x8b, 0x28, 0x77, 0xa1, 0xe4, 0xf2, 0x39, 0xe7, 0xf9, 0xfd, 0x4e, 0x14, 0xd4, 0x36, 0x45,
+	0x07, 0x43, 0xcc, 0xfa, 0x36, 0x30, 0x7e, 0xd1, 0xa0, 0x92, 0x2a, 0x05, 0x54, 0x83, 0x0d, 0xbb,
+	0xdb, 0x0d, 0x31, 0xa5, 0xea, 0x92, 0xe3, 0x4f, 0x74, 0x0f, 0x36, 0x82, 0xc8, 0xe9, 0x1c, 0xe3,
+	0x89, 0x2a, 0xcd, 0xcb, 0xc9, 0xca, 0x92, 0xfb, 0x92, 0xd9, 0x8e, 0x9c, 0xa1, 0xe7, 0x3e, 0xc5,
+	0x13, 0xab, 0x10, 0x44, 0xce, 0x53, 0x3c, 0x41, 0x57, 0xa1, 0x7c, 0x42, 0x18, 0xf7, 0x20, 0x20,
+	0x2f, 0x70, 0xa8, 0x2e, 0xb9, 0x24, 0x79, 0x6d, 0xce, 0x32, 0x3a, 0x70, 0xe5, 0x91, 0xcd, 0x6c,
+	0x8b, 0x10, 0x76, 0xe0, 0xbb, 0xc3, 0x88, 0x7a, 0xc4, 0x6f, 0xf3, 0xc5, 0x6b, 0x85, 0x09, 0x47,
+	0x19, 0x7f, 0x46, 0xb9, 0x43, 0x79, 0x4b, 0x7e, 0xf0, 0xf5, 0x07, 0xfb, 0x5d, 0x61, 0x28, 0x6f,
+	0x71, 0xd2, 0x78, 0x0e, 0xf5, 0x45, 0x06, 0x54, 0xdf, 0x7e, 0x2e, 0x66, 0x25, 0xe9, 0x65, 0xbd,
+	0x5d, 0x71, 0x68, 0xfc, 0xbc, 0x95, 0xe7, 0xf9, 0xb6, 0x24, 0xb8, 0xf9, 0x7b, 0x0e, 0xca, 0xd3,
+	0x9d, 0xe2, 0x41, 0xfb, 0x00, 0x3d, 0x85, 0x3c, 0xdf, 0xaf, 0xd0, 0x5e, 0x66, 0xd3, 0x25, 0x96,
+	0x3c, 0xfd, 0xea, 0x02, 0xc4, 0x6c, 0x49, 0x43, 0xdf, 0x43, 0x29, 0xb9, 0x9b, 0x5d, 0x5f, 0xa6,
+	0x33, 0x01, 0xd4, 0xf7, 0x97, 0xaa, 0x4e, 0xaa, 0x24, 0x70, 0x6e, 0x6e, 0xdb, 0xfa, 0x6c, 0x45,
+	0x33, 0x02, 0xad, 0xdf, 0x5e, 0xd5, 0x96, 0x80, 0x37, 0xdf, 0xe6, 0xa1, 0x28, 0xca, 0x93, 0x27,
+	0xcb, 0x81, 0x52, 0x62, 0x03, 0x58, 0x10, 0xdf, 0xfc, 0xc6, 0xa4, 0xef, 0x7f, 0x18, 0x28, 0xcd,
+	0xdf, 0xd1, 0xd0, 0x00, 0x2a, 0xa9, 0x31, 0x8c, 0x6e, 0x2c, 0x15, 0x4e, 0xee, 0x55, 0xfa, 0xcd,
+	0x55, 0xa0, 0x53, 0x4b, 0xdf, 0x40, 0x41, 0x8e, 0x37, 0x94, 0xfd, 0xe2, 0xa6, 0x96, 0x2e, 0xfd,
+	0xda, 0x52, 0x8c, 0x2a, 0x4a, 0x17, 0xca, 0xc9, 0x01, 0x87, 0xf6, 0x97, 0x3f, 0xe5, 0xb3, 0x25,
+	0x4d, 0xbf, 0xb1, 0x02, 0x52, 0x19, 0xf9, 0x11, 0xaa, 0x19, 0xf3, 0x10, 0x35, 0xb2, 0xe7, 0xce,
+	0xc2, 0x0d, 0x4f, 0xbf, 0xb3, 0xba, 0x40, 0x32, 0x67, 0x72, 0x7e, 0x2e, 0xc8, 0x59, 0x6a, 0x65,
+	0xd4, 0xaf, 0x2d, 0xc5, 0x48, 0xa5, 0xcd, 0xdf, 0x34, 0x71, 0xe3, 0x0e, 0x65, 0x21, 0xb6, 0x47,
+	0xbc, 0xcc, 0x7e, 0xd6, 0x60, 0x27, 0xbb, 0xfb, 0x51, 0x33, 0x53, 0xe3, 0xd2, 0xb7, 0x48, 0xbf,
+	0xfb, 0x51, 0x32, 0xaa, 0x0f, 0x9e, 0xbc, 0x7a, 0x57, 0xd7, 0x5e, 0xbf, 0xab, 0x6b, 0x7f, 0xbf,
+	0xab, 0x6b, 0x2f, 0xdf, 0xd7, 0xd7, 0x5e, 0xbf, 0xaf, 0xaf, 0xfd, 0xf9, 0xbe, 0xbe, 0xf6, 0x9d,
+	0xd9, 0xf7, 0xd8, 0x20, 0x72, 0x4c, 0x97, 0x8c, 0x1a, 0x2e, 0x19, 0x61, 0xe6, 0xf4, 0xd8, 0x8c,
+	0x88, 0xff, 0x30, 0xdf, 0x77, 0x49, 0x88, 0x39, 0xe1, 0x14, 0xc4, 0x0c, 0xb8, 0xfb, 0xef, 0x00,
+	0xa5, 0xf1, 0x45, 0x0b, 0x57, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1240,6 +1399,9 @@ const _ = grpc.SupportPackageIsVersion4
 type BroadcastAPIClient interface {
 	Ping(ctx context.Context, in *RequestPing, opts ...grpc.CallOption) (*ResponsePing, error)
 	BroadcastTx(ctx context.Context, in *RequestBroadcastTx, opts ...grpc.CallOption) (*ResponseBroadcastTx, error)
+	// BroadcastTxBatch submits several transactions at once and returns the
+	// CheckTx response of each of them.
+	BroadcastTxBatch(ctx context.Context, in *RequestBroadcastTxBatch, opts ...grpc.CallOption) (*ResponseBroadcastTxBatch, error)
 }
 
 type broadcastAPIClient struct {
@@ -1268,10 +1430,22 @@ func (c *broadcastAPIClient) BroadcastTx(ctx context.Context, in *RequestBroadca
 	return out, nil
 }
 
+func (c *broadcastAPIClient) BroadcastTxBatch(ctx context.Context, in *RequestBroadcastTxBatch, opts ...grpc.CallOption) (*ResponseBroadcastTxBatch, error) {
+	out := new(ResponseBroadcastTxBatch)
+	err := c.cc.Invoke(ctx, "/tendermint.rpc.grpc.BroadcastAPI/BroadcastTxBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BroadcastAPIServer is the server API for BroadcastAPI service.
 type BroadcastAPIServer interface {
 	Ping(context.Context, *RequestPing) (*ResponsePing, error)
 	BroadcastTx(context.Context, *RequestBroadcastTx) (*ResponseBroadcastTx, error)
+	// BroadcastTxBatch submits several transactions at once and returns the
+	// CheckTx response of each of them.
+	BroadcastTxBatch(context.Context, *RequestBroadcastTxBatch) (*ResponseBroadcastTxBatch, error)
 }
 
 // UnimplementedBroadcastAPIServer can be embedded to have forward compatible implementations.
@@ -1284,6 +1458,9 @@ func (*UnimplementedBroadcastAPIServer) Ping(ctx context.Context, req *RequestPi
 func (*UnimplementedBroadcastAPIServer) BroadcastTx(ctx context.Context, req *RequestBroadcastTx) (*ResponseBroadcastTx, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BroadcastTx not implemented")
 }
+func (*UnimplementedBroadcastAPIServer) BroadcastTxBatch(ctx context.Context, req *RequestBroadcastTxBatch) (*ResponseBroadcastTxBatch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastTxBatch not implemented")
+}
 
 func RegisterBroadcastAPIServer(s grpc1.Server, srv BroadcastAPIServer) {
 	s.RegisterService(&_BroadcastAPI_serviceDesc, srv)
@@ -1325,6 +1502,24 @@ func _BroadcastAPI_BroadcastTx_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BroadcastAPI_BroadcastTxBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestBroadcastTxBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BroadcastAPIServer).BroadcastTxBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.rpc.grpc.BroadcastAPI/BroadcastTxBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BroadcastAPIServer).BroadcastTxBatch(ctx, req.(*RequestBroadcastTxBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var BroadcastAPI_serviceDesc = _BroadcastAPI_serviceDesc
 var _BroadcastAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tendermint.rpc.grpc.BroadcastAPI",
@@ -1338,6 +1533,10 @@ var _BroadcastAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BroadcastTx",
 			Handler:    _BroadcastAPI_BroadcastTx_Handler,
 		},
+		{
+			MethodName: "BroadcastTxBatch",
+			Handler:    _BroadcastAPI_BroadcastTxBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tendermint/rpc/grpc/types.proto",
@@ -1822,6 +2021,38 @@ func (m *RequestBroadcastTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RequestBroadcastTxBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestBroadcastTxBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestBroadcastTxBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ResponsePing) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1892,6 +2123,92 @@ func (m *ResponseBroadcastTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResponseBroadcastTxBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseBroadcastTxBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseBroadcastTxBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BroadcastTxBatchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BroadcastTxBatchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BroadcastTxBatchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CheckTx != nil {
+		{
+			size, err := m.CheckTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BlockByHashRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2409,12 +2726,12 @@ func (m *SyncInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x48
 	}
-	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EarliestBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EarliestBlockTime):])
-	if err14 != nil {
-		return 0, err14
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EarliestBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EarliestBlockTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n14
-	i = encodeVarintTypes(dAtA, i, uint64(n14))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x42
 	if m.EarliestBlockHeight != 0 {
@@ -2436,12 +2753,12 @@ func (m *SyncInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LatestBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LatestBlockTime):])
-	if err15 != nil {
-		return 0, err15
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LatestBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LatestBlockTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n15
-	i = encodeVarintTypes(dAtA, i, uint64(n15))
+	i -= n16
+	i = encodeVarintTypes(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x22
 	if m.LatestBlockHeight != 0 {
@@ -2617,6 +2934,21 @@ func (m *RequestBroadcastTx) Size() (n int) {
 	return n
 }
 
+func (m *RequestBroadcastTxBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ResponsePing) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2643,15 +2975,51 @@ func (m *ResponseBroadcastTx) Size() (n int) {
 	return n
 }
 
-func (m *BlockByHashRequest) Size() (n int) {
+func (m *ResponseBroadcastTxBatch) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BroadcastTxBatchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CheckTx != nil {
+		l = m.CheckTx.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
 	if m.Prove {
 		n += 2
@@ -3057,6 +3425,88 @@ func (m *RequestBroadcastTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RequestBroadcastTxBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestBroadcastTxBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestBroadcastTxBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ResponsePing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3229,6 +3679,242 @@ func (m *ResponseBroadcastTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResponseBroadcastTxBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseBroadcastTxBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseBroadcastTxBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &BroadcastTxBatchResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastTxBatchResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastTxBatchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastTxBatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CheckTx == nil {
+				m.CheckTx = &types.ResponseCheckTx{}
+			}
+			if err := m.CheckTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BlockByHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "github.com/golang/protobuf/ptypes/duration"
 	io "io"
@@ -35,6 +36,8 @@ type ConsensusParams struct {
 	Validator *ValidatorParams `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
 	Version   *VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
 	Abci      *ABCIParams      `protobuf:"bytes,5,opt,name=abci,proto3" json:"abci,omitempty"`
+	Gossip    *GossipParams    `protobuf:"bytes,6,opt,name=gossip,proto3" json:"gossip,omitempty"`
+	Upgrade   *UpgradeParams   `protobuf:"bytes,7,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
@@ -105,6 +108,20 @@ func (m *ConsensusParams) GetAbci() *ABCIParams {
 	return nil
 }
 
+func (m *ConsensusParams) GetGossip() *GossipParams {
+	if m != nil {
+		return m.Gossip
+	}
+	return nil
+}
+
+func (m *ConsensusParams) GetUpgrade() *UpgradeParams {
+	if m != nil {
+		return m.Upgrade
+	}
+	return nil
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Max block size, in bytes.
@@ -435,6 +452,112 @@ func (m *ABCIParams) GetVoteExtensionsEnableHeight() int64 {
 	return 0
 }
 
+// GossipParams configure how the nodes of the network gossip consensus data.
+type GossipParams struct {
+	// lazy_empty_proposals allows proposers to replace the parts of an empty
+	// proposal block with a compact message, from which peers rebuild the block
+	// locally. It must only be enabled once all the nodes of the network
+	// understand these messages.
+	LazyEmptyProposals bool `protobuf:"varint,1,opt,name=lazy_empty_proposals,json=lazyEmptyProposals,proto3" json:"lazy_empty_proposals,omitempty"`
+}
+
+func (m *GossipParams) Reset()         { *m = GossipParams{} }
+func (m *GossipParams) String() string { return proto.CompactTextString(m) }
+func (*GossipParams) ProtoMessage()    {}
+func (*GossipParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{7}
+}
+func (m *GossipParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GossipParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GossipParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GossipParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipParams.Merge(m, src)
+}
+func (m *GossipParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *GossipParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipParams proto.InternalMessageInfo
+
+func (m *GossipParams) GetLazyEmptyProposals() bool {
+	if m != nil {
+		return m.LazyEmptyProposals
+	}
+	return false
+}
+
+// UpgradeParams coordinate the halt of the network for an upgrade.
+type UpgradeParams struct {
+	// halt_height makes the nodes stop after committing the block at this
+	// height. 0 disables it.
+	HaltHeight int64 `protobuf:"varint,1,opt,name=halt_height,json=haltHeight,proto3" json:"halt_height,omitempty"`
+	// halt_time makes the nodes stop after committing the first block whose
+	// time is at or after it. The zero time disables it.
+	HaltTime time.Time `protobuf:"bytes,2,opt,name=halt_time,json=haltTime,proto3,stdtime" json:"halt_time"`
+}
+
+func (m *UpgradeParams) Reset()         { *m = UpgradeParams{} }
+func (m *UpgradeParams) String() string { return proto.CompactTextString(m) }
+func (*UpgradeParams) ProtoMessage()    {}
+func (*UpgradeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{8}
+}
+func (m *UpgradeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeParams.Merge(m, src)
+}
+func (m *UpgradeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeParams proto.InternalMessageInfo
+
+func (m *UpgradeParams) GetHaltHeight() int64 {
+	if m != nil {
+		return m.HaltHeight
+	}
+	return 0
+}
+
+func (m *UpgradeParams) GetHaltTime() time.Time {
+	if m != nil {
+		return m.HaltTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*ConsensusParams)(nil), "tendermint.types.ConsensusParams")
 	proto.RegisterType((*BlockParams)(nil), "tendermint.types.BlockParams")
@@ -443,48 +566,58 @@ func init() {
 	proto.RegisterType((*VersionParams)(nil), "tendermint.types.VersionParams")
 	proto.RegisterType((*HashedParams)(nil), "tendermint.types.HashedParams")
 	proto.RegisterType((*ABCIParams)(nil), "tendermint.types.ABCIParams")
+	proto.RegisterType((*GossipParams)(nil), "tendermint.types.GossipParams")
+	proto.RegisterType((*UpgradeParams)(nil), "tendermint.types.UpgradeParams")
 }
 
 func init() { proto.RegisterFile("tendermint/types/params.proto", fileDescriptor_e12598271a686f57) }
 
 var fileDescriptor_e12598271a686f57 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x73, 0x75, 0xda, 0xa6, 0x4f, 0x7e, 0x69, 0xa2, 0xd3, 0x4f, 0xc2, 0x14, 0xe2, 0x04,
-	0x0f, 0xa8, 0x52, 0x25, 0x1b, 0x91, 0x09, 0x84, 0x54, 0x25, 0x25, 0x6a, 0x0b, 0x2a, 0x7f, 0x2c,
-	0xc4, 0xd0, 0xc5, 0x3a, 0x27, 0x57, 0xc7, 0x6a, 0xec, 0xb3, 0x7c, 0xe7, 0x28, 0x79, 0x17, 0x8c,
-	0x8c, 0x1d, 0x61, 0x65, 0xe2, 0x25, 0x74, 0xec, 0xc8, 0x04, 0x28, 0x59, 0x78, 0x19, 0xc8, 0x67,
-	0xbb, 0x6e, 0x12, 0xb6, 0xbb, 0x7b, 0x3e, 0x9f, 0xfb, 0xf3, 0x7d, 0x74, 0xd0, 0x14, 0x34, 0x18,
-	0xd2, 0xc8, 0xf7, 0x02, 0x61, 0x8a, 0x59, 0x48, 0xb9, 0x19, 0x92, 0x88, 0xf8, 0xdc, 0x08, 0x23,
-	0x26, 0x18, 0x6e, 0x14, 0x65, 0x43, 0x96, 0xf7, 0xfe, 0x77, 0x99, 0xcb, 0x64, 0xd1, 0x4c, 0x46,
-	0x29, 0xb7, 0xa7, 0xb9, 0x8c, 0xb9, 0x63, 0x6a, 0xca, 0x99, 0x13, 0x5f, 0x98, 0xc3, 0x38, 0x22,
-	0xc2, 0x63, 0x41, 0x5a, 0xd7, 0xbf, 0x6d, 0x40, 0xfd, 0x88, 0x05, 0x9c, 0x06, 0x3c, 0xe6, 0xef,
-	0xe4, 0x09, 0xb8, 0x03, 0x9b, 0xce, 0x98, 0x0d, 0x2e, 0x55, 0xd4, 0x46, 0xfb, 0xd5, 0xa7, 0x4d,
-	0x63, 0xf5, 0x2c, 0xa3, 0x97, 0x94, 0x53, 0xda, 0x4a, 0x59, 0xfc, 0x02, 0x2a, 0x74, 0xe2, 0x0d,
-	0x69, 0x30, 0xa0, 0xea, 0x86, 0xf4, 0xda, 0xeb, 0x5e, 0x3f, 0x23, 0x32, 0xf5, 0xd6, 0xc0, 0x87,
-	0xb0, 0x33, 0x21, 0x63, 0x6f, 0x48, 0x04, 0x8b, 0x54, 0x45, 0xea, 0x8f, 0xd6, 0xf5, 0x8f, 0x39,
-	0x92, 0xf9, 0x85, 0x83, 0x9f, 0xc1, 0xf6, 0x84, 0x46, 0xdc, 0x63, 0x81, 0x5a, 0x96, 0x7a, 0xeb,
-	0x1f, 0x7a, 0x0a, 0x64, 0x72, 0xce, 0xe3, 0x27, 0x50, 0x26, 0xce, 0xc0, 0x53, 0x37, 0xa5, 0xf7,
-	0x70, 0xdd, 0xeb, 0xf6, 0x8e, 0x4e, 0x33, 0x49, 0x92, 0xfa, 0x29, 0x54, 0xef, 0x24, 0x80, 0x1f,
-	0xc0, 0x8e, 0x4f, 0xa6, 0xb6, 0x33, 0x13, 0x94, 0xcb, 0xcc, 0x14, 0xab, 0xe2, 0x93, 0x69, 0x2f,
-	0x99, 0xe3, 0x7b, 0xb0, 0x9d, 0x14, 0x5d, 0xc2, 0x65, 0x2c, 0x8a, 0xb5, 0xe5, 0x93, 0xe9, 0x31,
-	0xe1, 0xaf, 0xca, 0x15, 0xa5, 0x51, 0xd6, 0xbf, 0x22, 0xd8, 0x5d, 0x4e, 0x05, 0x1f, 0x00, 0x4e,
-	0x0c, 0xe2, 0x52, 0x3b, 0x88, 0x7d, 0x5b, 0xc6, 0x9b, 0xef, 0x5b, 0xf7, 0xc9, 0xb4, 0xeb, 0xd2,
-	0x37, 0xb1, 0x2f, 0x2f, 0xc0, 0xf1, 0x19, 0x34, 0x72, 0x38, 0xef, 0x6c, 0x16, 0xff, 0x7d, 0x23,
-	0x6d, 0xbd, 0x91, 0xb7, 0xde, 0x78, 0x99, 0x01, 0xbd, 0xca, 0xf5, 0xcf, 0x56, 0xe9, 0xf3, 0xaf,
-	0x16, 0xb2, 0x76, 0xd3, 0xfd, 0xf2, 0xca, 0xf2, 0x53, 0x94, 0xe5, 0xa7, 0xe8, 0x87, 0x50, 0x5f,
-	0xe9, 0x00, 0xd6, 0xa1, 0x16, 0xc6, 0x8e, 0x7d, 0x49, 0x67, 0xb6, 0xcc, 0x4a, 0x45, 0x6d, 0x65,
-	0x7f, 0xc7, 0xaa, 0x86, 0xb1, 0xf3, 0x9a, 0xce, 0x3e, 0x24, 0x4b, 0xcf, 0x2b, 0xdf, 0xaf, 0x5a,
-	0xe8, 0xcf, 0x55, 0x0b, 0xe9, 0x07, 0x50, 0x5b, 0xea, 0x01, 0x6e, 0x80, 0x42, 0xc2, 0x50, 0xbe,
-	0xad, 0x6c, 0x25, 0xc3, 0x3b, 0xf0, 0x39, 0xfc, 0x77, 0x42, 0xf8, 0x88, 0x0e, 0x33, 0xf6, 0x31,
-	0xd4, 0x65, 0x14, 0xf6, 0x6a, 0xd6, 0x35, 0xb9, 0x7c, 0x96, 0x07, 0xae, 0x43, 0xad, 0xe0, 0x8a,
-	0xd8, 0xab, 0x39, 0x75, 0x4c, 0xb8, 0xfe, 0x16, 0xa0, 0x68, 0x2a, 0xee, 0x42, 0x73, 0xc2, 0x04,
-	0xb5, 0xe9, 0x54, 0xd0, 0x20, 0xb9, 0x1d, 0xb7, 0x69, 0x40, 0x9c, 0x31, 0xb5, 0x47, 0xd4, 0x73,
-	0x47, 0x22, 0x3b, 0x67, 0x2f, 0x81, 0xfa, 0xb7, 0x4c, 0x5f, 0x22, 0x27, 0x92, 0xe8, 0xbd, 0xff,
-	0x32, 0xd7, 0xd0, 0xf5, 0x5c, 0x43, 0x37, 0x73, 0x0d, 0xfd, 0x9e, 0x6b, 0xe8, 0xd3, 0x42, 0x2b,
-	0xdd, 0x2c, 0xb4, 0xd2, 0x8f, 0x85, 0x56, 0x3a, 0xef, 0xb8, 0x9e, 0x18, 0xc5, 0x8e, 0x31, 0x60,
-	0xbe, 0x39, 0x60, 0x3e, 0x15, 0xce, 0x85, 0x28, 0x06, 0xe9, 0x9f, 0x5d, 0xfd, 0xee, 0xce, 0x96,
-	0x5c, 0xef, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xb9, 0xe8, 0xce, 0x9a, 0x09, 0x04, 0x00, 0x00,
+	// 701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x14, 0x85, 0xe3, 0xdf, 0x69, 0x9a, 0xdc, 0x34, 0x4d, 0x34, 0xaa, 0xf4, 0x9b, 0x40, 0x9d, 0xe2,
+	0x05, 0xaa, 0x54, 0xc9, 0xa9, 0xa8, 0x84, 0x04, 0x42, 0x2a, 0x49, 0x89, 0xda, 0x82, 0x0a, 0x25,
+	0x2a, 0x2c, 0xba, 0xb1, 0xc6, 0xc9, 0xd4, 0xb1, 0x6a, 0x7b, 0x2c, 0xcf, 0x38, 0x4a, 0x78, 0x8a,
+	0x2e, 0x59, 0x76, 0x83, 0x04, 0x6f, 0xc0, 0x23, 0x74, 0xd9, 0x25, 0x2b, 0x40, 0xe9, 0x86, 0xc7,
+	0x40, 0x33, 0xb6, 0x9b, 0x26, 0x81, 0xdd, 0x78, 0xce, 0x77, 0x3c, 0x77, 0xee, 0xb9, 0x1a, 0x58,
+	0xe7, 0x24, 0xe8, 0x93, 0xc8, 0x77, 0x03, 0xde, 0xe4, 0xe3, 0x90, 0xb0, 0x66, 0x88, 0x23, 0xec,
+	0x33, 0x33, 0x8c, 0x28, 0xa7, 0xa8, 0x36, 0x95, 0x4d, 0x29, 0xd7, 0xd7, 0x1c, 0xea, 0x50, 0x29,
+	0x36, 0xc5, 0x2a, 0xe1, 0xea, 0xba, 0x43, 0xa9, 0xe3, 0x91, 0xa6, 0xfc, 0xb2, 0xe3, 0xb3, 0x66,
+	0x3f, 0x8e, 0x30, 0x77, 0x69, 0x90, 0xea, 0x8d, 0x79, 0x9d, 0xbb, 0x3e, 0x61, 0x1c, 0xfb, 0x61,
+	0x02, 0x18, 0x9f, 0x55, 0xa8, 0xee, 0xd1, 0x80, 0x91, 0x80, 0xc5, 0xec, 0x58, 0x96, 0x80, 0x76,
+	0x60, 0xc9, 0xf6, 0x68, 0xef, 0x5c, 0x53, 0x36, 0x94, 0xcd, 0xf2, 0xe3, 0x75, 0x73, 0xbe, 0x18,
+	0xb3, 0x2d, 0xe4, 0x84, 0xee, 0x26, 0x2c, 0x7a, 0x0e, 0x45, 0x32, 0x74, 0xfb, 0x24, 0xe8, 0x11,
+	0xed, 0x3f, 0xe9, 0xdb, 0x58, 0xf4, 0x75, 0x52, 0x22, 0xb5, 0xde, 0x3a, 0xd0, 0x2e, 0x94, 0x86,
+	0xd8, 0x73, 0xfb, 0x98, 0xd3, 0x48, 0x53, 0xa5, 0xfd, 0xe1, 0xa2, 0xfd, 0x43, 0x86, 0xa4, 0xfe,
+	0xa9, 0x07, 0x3d, 0x85, 0xe5, 0x21, 0x89, 0x98, 0x4b, 0x03, 0x2d, 0x2f, 0xed, 0x8d, 0xbf, 0xd8,
+	0x13, 0x20, 0x35, 0x67, 0x3c, 0xda, 0x86, 0x3c, 0xb6, 0x7b, 0xae, 0xb6, 0x24, 0x7d, 0x0f, 0x16,
+	0x7d, 0xad, 0xf6, 0xde, 0x61, 0x6a, 0x92, 0x24, 0x7a, 0x02, 0x05, 0x87, 0x32, 0xe6, 0x86, 0x5a,
+	0x41, 0x7a, 0xf4, 0x45, 0xcf, 0xbe, 0xd4, 0x53, 0x57, 0x4a, 0x8b, 0x22, 0xe3, 0xd0, 0x89, 0x70,
+	0x9f, 0x68, 0xcb, 0xff, 0x2a, 0xf2, 0x7d, 0x02, 0x64, 0x45, 0xa6, 0xbc, 0x71, 0x08, 0xe5, 0x3b,
+	0x4d, 0x47, 0xf7, 0xa1, 0xe4, 0xe3, 0x91, 0x65, 0x8f, 0x39, 0x61, 0x32, 0x26, 0xb5, 0x5b, 0xf4,
+	0xf1, 0xa8, 0x2d, 0xbe, 0xd1, 0xff, 0xb0, 0x2c, 0x44, 0x07, 0x33, 0x99, 0x84, 0xda, 0x2d, 0xf8,
+	0x78, 0xb4, 0x8f, 0xd9, 0xab, 0x7c, 0x51, 0xad, 0xe5, 0x8d, 0xaf, 0x0a, 0xac, 0xce, 0x06, 0x81,
+	0xb6, 0x00, 0x09, 0x07, 0x76, 0x88, 0x15, 0xc4, 0xbe, 0x25, 0x13, 0xcd, 0xfe, 0x5b, 0xf5, 0xf1,
+	0xa8, 0xe5, 0x90, 0x37, 0xb1, 0x2f, 0x0b, 0x60, 0xe8, 0x08, 0x6a, 0x19, 0x9c, 0x4d, 0x5b, 0x9a,
+	0xf8, 0x3d, 0x33, 0x19, 0x37, 0x33, 0x1b, 0x37, 0xf3, 0x65, 0x0a, 0xb4, 0x8b, 0x57, 0x3f, 0x1a,
+	0xb9, 0x4f, 0x3f, 0x1b, 0x4a, 0x77, 0x35, 0xf9, 0x5f, 0xa6, 0xcc, 0x5e, 0x45, 0x9d, 0xbd, 0x8a,
+	0xb1, 0x0b, 0xd5, 0xb9, 0xd0, 0x91, 0x01, 0x95, 0x30, 0xb6, 0xad, 0x73, 0x32, 0xb6, 0x64, 0xc7,
+	0x34, 0x65, 0x43, 0xdd, 0x2c, 0x75, 0xcb, 0x61, 0x6c, 0xbf, 0x26, 0xe3, 0x13, 0xb1, 0xf5, 0xac,
+	0xf8, 0xed, 0xb2, 0xa1, 0xfc, 0xbe, 0x6c, 0x28, 0xc6, 0x16, 0x54, 0x66, 0x62, 0x47, 0x35, 0x50,
+	0x71, 0x18, 0xca, 0xbb, 0xe5, 0xbb, 0x62, 0x79, 0x07, 0x3e, 0x85, 0x95, 0x03, 0xcc, 0x06, 0xa4,
+	0x9f, 0xb2, 0x8f, 0xa0, 0x2a, 0x5b, 0x61, 0xcd, 0xf7, 0xba, 0x22, 0xb7, 0x8f, 0xb2, 0x86, 0x1b,
+	0x50, 0x99, 0x72, 0xd3, 0xb6, 0x97, 0x33, 0x6a, 0x1f, 0x33, 0xe3, 0x2d, 0xc0, 0x74, 0x8e, 0x50,
+	0x0b, 0xd6, 0x87, 0x94, 0x13, 0x8b, 0x8c, 0x38, 0x09, 0x44, 0x75, 0xcc, 0x22, 0x01, 0xb6, 0x3d,
+	0x62, 0x0d, 0x88, 0xeb, 0x0c, 0x78, 0x7a, 0x4e, 0x5d, 0x40, 0x9d, 0x5b, 0xa6, 0x23, 0x91, 0x03,
+	0x49, 0x18, 0x2f, 0x60, 0xe5, 0xee, 0x90, 0xa1, 0x6d, 0x58, 0xf3, 0xf0, 0xc7, 0xb1, 0x45, 0xfc,
+	0x90, 0x8f, 0xad, 0x30, 0xa2, 0x21, 0x65, 0xd8, 0x4b, 0x2a, 0x2e, 0x76, 0x91, 0xd0, 0x3a, 0x42,
+	0x3a, 0xce, 0x14, 0x83, 0x41, 0x65, 0x66, 0xda, 0x50, 0x03, 0xca, 0x03, 0xec, 0xf1, 0xd9, 0x1a,
+	0x40, 0x6c, 0x25, 0x67, 0xa2, 0x16, 0x94, 0x24, 0x20, 0x5e, 0x91, 0x34, 0xf3, 0xfa, 0x42, 0xe6,
+	0x27, 0xd9, 0x13, 0x93, 0x84, 0x7e, 0x21, 0x42, 0x2f, 0x0a, 0x9b, 0x10, 0xda, 0xef, 0xbe, 0x4c,
+	0x74, 0xe5, 0x6a, 0xa2, 0x2b, 0xd7, 0x13, 0x5d, 0xf9, 0x35, 0xd1, 0x95, 0x8b, 0x1b, 0x3d, 0x77,
+	0x7d, 0xa3, 0xe7, 0xbe, 0xdf, 0xe8, 0xb9, 0xd3, 0x1d, 0xc7, 0xe5, 0x83, 0xd8, 0x36, 0x7b, 0xd4,
+	0x6f, 0xf6, 0xa8, 0x4f, 0xb8, 0x7d, 0xc6, 0xa7, 0x8b, 0xe4, 0xf9, 0x9b, 0x7f, 0x39, 0xed, 0x82,
+	0xdc, 0xdf, 0xf9, 0x33, 0x00, 0x7d, 0xc8, 0x66, 0x01, 0x54, 0x05, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -521,6 +654,12 @@ func (this *ConsensusParams) Equal(that interface{}) bool {
 	if !this.Abci.Equal(that1.Abci) {
 		return false
 	}
+	if !this.Gossip.Equal(that1.Gossip) {
+		return false
+	}
+	if !this.Upgrade.Equal(that1.Upgrade) {
+		return false
+	}
 	return true
 }
 func (this *BlockParams) Equal(that interface{}) bool {
@@ -684,6 +823,57 @@ func (this *ABCIParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GossipParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GossipParams)
+	if !ok {
+		that2, ok := that.(GossipParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LazyEmptyProposals != that1.LazyEmptyProposals {
+		return false
+	}
+	return true
+}
+func (this *UpgradeParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpgradeParams)
+	if !ok {
+		that2, ok := that.(UpgradeParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HaltHeight != that1.HaltHeight {
+		return false
+	}
+	if !this.HaltTime.Equal(that1.HaltTime) {
+		return false
+	}
+	return true
+}
 func (m *ConsensusParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -704,6 +894,30 @@ func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Gossip != nil {
+		{
+			size, err := m.Gossip.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Abci != nil {
 		{
 			size, err := m.Abci.MarshalToSizedBuffer(dAtA[:i])
@@ -825,12 +1039,12 @@ func (m *EvidenceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration):])
-	if err6 != nil {
-		return 0, err6
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n6
-	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i -= n8
+	i = encodeVarintParams(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
 	if m.MaxAgeNumBlocks != 0 {
@@ -962,6 +1176,75 @@ func (m *ABCIParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GossipParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GossipParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GossipParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LazyEmptyProposals {
+		i--
+		if m.LazyEmptyProposals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.HaltTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.HaltTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintParams(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if m.HaltHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HaltHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -1091,6 +1374,14 @@ func (m *ConsensusParams) Size() (n int) {
 		l = m.Abci.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.Gossip != nil {
+		l = m.Gossip.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -1180,6 +1471,32 @@ func (m *ABCIParams) Size() (n int) {
 	return n
 }
 
+func (m *GossipParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LazyEmptyProposals {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpgradeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HaltHeight != 0 {
+		n += 1 + sovParams(uint64(m.HaltHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.HaltTime)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1395,6 +1712,78 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gossip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gossip == nil {
+				m.Gossip = &GossipParams{}
+			}
+			if err := m.Gossip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upgrade == nil {
+				m.Upgrade = &UpgradeParams{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1933,6 +2322,178 @@ func (m *ABCIParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GossipParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GossipParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GossipParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LazyEmptyProposals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LazyEmptyProposals = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltHeight", wireType)
+			}
+			m.HaltHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.HaltTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,170 @@
+// Package prove generates merkle proofs of committed data at past heights from
+// the block and state stores of a node, without the node running.
+package prove
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	rpccore "github.com/cometbft/cometbft/rpc/core"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// ErrNoTxProofProvider is returned when proving a transaction without a
+// TxProofProvider: only the application knows how blocks are laid out in
+// shares.
+var ErrNoTxProofProvider = errors.New("proving transactions requires a tx proof provider")
+
+// Prover generates proofs from the stores of a node. It does not modify them.
+type Prover struct {
+	blockStore sm.BlockStore
+	stateStore sm.Store
+	txProofs   rpccore.TxProofProvider // nil if txs can't be proven
+}
+
+// NewProver returns a Prover reading from the given stores. txProofs, which
+// may be nil, proves the inclusion of transactions.
+func NewProver(blockStore sm.BlockStore, stateStore sm.Store, txProofs rpccore.TxProofProvider) *Prover {
+	return &Prover{
+		blockStore: blockStore,
+		stateStore: stateStore,
+		txProofs:   txProofs,
+	}
+}
+
+// TxProof proves the inclusion of a transaction in the block at Height.
+type TxProof struct {
+	Height int64            `json:"height"`
+	Index  uint32           `json:"index"`
+	Tx     types.Tx         `json:"tx"`
+	Proof  types.ShareProof `json:"proof"`
+}
+
+// Validate verifies the proof against the data root of the block.
+func (p TxProof) Validate(dataHash []byte) error {
+	return p.Proof.Validate(dataHash)
+}
+
+// ResultProof proves the result of executing a transaction of the block at
+// Height. RootHash is the LastResultsHash of the header of the next block.
+type ResultProof struct {
+	Height   int64              `json:"height"`
+	Index    uint32             `json:"index"`
+	Result   *abci.ExecTxResult `json:"result"`
+	RootHash cmtbytes.HexBytes  `json:"root_hash"`
+	Proof    merkle.Proof       `json:"proof"`
+}
+
+// Validate verifies the proof against RootHash.
+func (p ResultProof) Validate() error {
+	bz, err := p.Result.Marshal()
+	if err != nil {
+		return err
+	}
+	return p.Proof.Verify(p.RootHash, bz)
+}
+
+// ValidatorProof proves that a validator is part of the validator set of the
+// block at Height. RootHash is the ValidatorsHash of its header.
+type ValidatorProof struct {
+	Height    int64             `json:"height"`
+	Validator *types.Validator  `json:"validator"`
+	RootHash  cmtbytes.HexBytes `json:"root_hash"`
+	Proof     merkle.Proof      `json:"proof"`
+}
+
+// Validate verifies the proof against RootHash.
+func (p ValidatorProof) Validate() error {
+	return p.Proof.Verify(p.RootHash, p.Validator.Bytes())
+}
+
+// ProveTx proves the inclusion of the transaction at index in the block at
+// height.
+func (p *Prover) ProveTx(height int64, index uint32) (*TxProof, error) {
+	if p.txProofs == nil {
+		return nil, ErrNoTxProofProvider
+	}
+	block := p.blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+	if int(index) >= len(block.Txs) {
+		return nil, fmt.Errorf("block at height %d has %d txs, no tx at index %d", height, len(block.Txs), index)
+	}
+	proof, err := p.txProofs.ProveTx(height, index)
+	if err != nil {
+		return nil, err
+	}
+	if err := proof.Validate(block.DataHash); err != nil {
+		return nil, fmt.Errorf("invalid proof of tx %d at height %d: %w", index, height, err)
+	}
+	return &TxProof{
+		Height: height,
+		Index:  index,
+		Tx:     block.Txs[index],
+		Proof:  proof,
+	}, nil
+}
+
+// ProveResult proves the result of executing the transaction at index in the
+// block at height. The header of the next block, committing to the results,
+// must be stored.
+func (p *Prover) ProveResult(height int64, index uint32) (*ResultProof, error) {
+	resp, err := p.stateStore.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		return nil, err
+	}
+	if int(index) >= len(resp.TxResults) {
+		return nil, fmt.Errorf("block at height %d has %d tx results, no result at index %d",
+			height, len(resp.TxResults), index)
+	}
+	next := p.blockStore.LoadBlockMeta(height + 1)
+	if next == nil {
+		return nil, fmt.Errorf("block at height %d, committing to the results, not found", height+1)
+	}
+
+	results := types.NewResults(resp.TxResults)
+	if !bytes.Equal(results.Hash(), next.Header.LastResultsHash) {
+		return nil, fmt.Errorf("stored results of height %d don't match the LastResultsHash of the next block", height)
+	}
+	return &ResultProof{
+		Height:   height,
+		Index:    index,
+		Result:   results[index],
+		RootHash: next.Header.LastResultsHash,
+		Proof:    results.ProveResult(int(index)),
+	}, nil
+}
+
+// ProveValidators proves that each validator of the block at height is part of
+// its validator set, in validator set order.
+func (p *Prover) ProveValidators(height int64) ([]*ValidatorProof, error) {
+	meta := p.blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+	vals, err := p.stateStore.LoadValidators(height)
+	if err != nil {
+		return nil, err
+	}
+
+	root, proofs := vals.HashWithProofs()
+	if !bytes.Equal(root, meta.Header.ValidatorsHash) {
+		return nil, fmt.Errorf("stored validators of height %d don't match the ValidatorsHash of its header", height)
+	}
+	valProofs := make([]*ValidatorProof, len(vals.Validators))
+	for i, val := range vals.Validators {
+		valProofs[i] = &ValidatorProof{
+			Height:    height,
+			Validator: val,
+			RootHash:  meta.Header.ValidatorsHash,
+			Proof:     *proofs[i],
+		}
+	}
+	return valProofs, nil
+}
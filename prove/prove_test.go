@@ -0,0 +1,65 @@
+package prove_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/prove"
+	statemocks "github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestProveResult(t *testing.T) {
+	const height int64 = 10
+	txResults := []*abci.ExecTxResult{
+		{Code: 0, Data: []byte("a"), GasUsed: 10},
+		{Code: 1, Data: []byte("b"), GasUsed: 20, Log: "non-deterministic"},
+	}
+	resultsHash := types.NewResults(txResults).Hash()
+
+	stateStoreMock := &statemocks.Store{}
+	stateStoreMock.On("LoadFinalizeBlockResponse", height).
+		Return(&abci.ResponseFinalizeBlock{TxResults: txResults}, nil)
+	blockStoreMock := &statemocks.BlockStore{}
+	blockStoreMock.On("LoadBlockMeta", height+1).
+		Return(&types.BlockMeta{Header: types.Header{Height: height + 1, LastResultsHash: resultsHash}})
+	p := prove.NewProver(blockStoreMock, stateStoreMock, nil)
+
+	proof, err := p.ProveResult(height, 1)
+	require.NoError(t, err)
+	require.NoError(t, proof.Validate())
+	assert.Equal(t, uint32(20), uint32(proof.Result.GasUsed))
+	assert.Empty(t, proof.Result.Log)
+
+	proof.Result.GasUsed = 21
+	assert.Error(t, proof.Validate())
+
+	_, err = p.ProveResult(height, 2)
+	assert.Error(t, err)
+}
+
+func TestProveValidators(t *testing.T) {
+	const height int64 = 10
+	vals, _ := types.RandValidatorSet(4, 10)
+
+	stateStoreMock := &statemocks.Store{}
+	stateStoreMock.On("LoadValidators", height).Return(vals, nil)
+	blockStoreMock := &statemocks.BlockStore{}
+	blockStoreMock.On("LoadBlockMeta", height).
+		Return(&types.BlockMeta{Header: types.Header{Height: height, ValidatorsHash: vals.Hash()}})
+	p := prove.NewProver(blockStoreMock, stateStoreMock, nil)
+
+	proofs, err := p.ProveValidators(height)
+	require.NoError(t, err)
+	require.Len(t, proofs, vals.Size())
+	for i, proof := range proofs {
+		assert.Equal(t, vals.Validators[i], proof.Validator)
+		require.NoError(t, proof.Validate())
+	}
+
+	_, err = p.ProveTx(height, 0)
+	assert.ErrorIs(t, err, prove.ErrNoTxProofProvider)
+}
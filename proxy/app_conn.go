@@ -8,6 +8,8 @@ import (
 
 	abcicli "github.com/cometbft/cometbft/abci/client"
 	"github.com/cometbft/cometbft/abci/types"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 )
 
 //go:generate ../scripts/mockery_generate.sh AppConnConsensus|AppConnMempool|AppConnQuery|AppConnSnapshot
@@ -24,6 +26,7 @@ type AppConnConsensus interface {
 	VerifyVoteExtension(context.Context, *types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error)
 	FinalizeBlock(context.Context, *types.RequestFinalizeBlock) (*types.ResponseFinalizeBlock, error)
 	Commit(context.Context) (*types.ResponseCommit, error)
+	CommitWithSnapshot(context.Context, bool) (*types.ResponseCommit, error)
 }
 
 type AppConnMempool interface {
@@ -56,8 +59,15 @@ type AppConnSnapshot interface {
 // Implements AppConnConsensus (subset of abcicli.Client)
 
 type appConnConsensus struct {
-	metrics *Metrics
-	appConn abcicli.Client
+	metrics     *Metrics
+	client      connClient
+	eventLimits EventLimits
+	logger      cmtlog.Logger
+
+	// finalizing is the FinalizeBlock request of the block being finalized,
+	// sent again to the client replacing a client failing on Commit.
+	mtx        cmtsync.Mutex
+	finalizing *types.RequestFinalizeBlock
 }
 
 var _ AppConnConsensus = (*appConnConsensus)(nil)
@@ -65,48 +75,97 @@ var _ AppConnConsensus = (*appConnConsensus)(nil)
 func NewAppConnConsensus(appConn abcicli.Client, metrics *Metrics) AppConnConsensus {
 	return &appConnConsensus{
 		metrics: metrics,
-		appConn: appConn,
+		client:  staticClient{appConn},
 	}
 }
 
 func (app *appConnConsensus) Error() error {
-	return app.appConn.Error()
+	return app.client.get().Error()
 }
 
 func (app *appConnConsensus) InitChain(ctx context.Context, req *types.RequestInitChain) (*types.ResponseInitChain, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "init_chain", "type", "sync"))()
-	return app.appConn.InitChain(ctx, req)
+	return call(app.client, func(c abcicli.Client) (*types.ResponseInitChain, error) {
+		return c.InitChain(ctx, req)
+	})
 }
 
 func (app *appConnConsensus) PrepareProposal(ctx context.Context,
 	req *types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "prepare_proposal", "type", "sync"))()
-	return app.appConn.PrepareProposal(ctx, req)
+	return call(app.client, func(c abcicli.Client) (*types.ResponsePrepareProposal, error) {
+		return c.PrepareProposal(ctx, req)
+	})
 }
 
 func (app *appConnConsensus) ProcessProposal(ctx context.Context, req *types.RequestProcessProposal) (*types.ResponseProcessProposal, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "process_proposal", "type", "sync"))()
-	return app.appConn.ProcessProposal(ctx, req)
+	return call(app.client, func(c abcicli.Client) (*types.ResponseProcessProposal, error) {
+		return c.ProcessProposal(ctx, req)
+	})
 }
 
 func (app *appConnConsensus) ExtendVote(ctx context.Context, req *types.RequestExtendVote) (*types.ResponseExtendVote, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "extend_vote", "type", "sync"))()
-	return app.appConn.ExtendVote(ctx, req)
+	return call(app.client, func(c abcicli.Client) (*types.ResponseExtendVote, error) {
+		return c.ExtendVote(ctx, req)
+	})
 }
 
 func (app *appConnConsensus) VerifyVoteExtension(ctx context.Context, req *types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "verify_vote_extension", "type", "sync"))()
-	return app.appConn.VerifyVoteExtension(ctx, req)
+	return call(app.client, func(c abcicli.Client) (*types.ResponseVerifyVoteExtension, error) {
+		return c.VerifyVoteExtension(ctx, req)
+	})
 }
 
 func (app *appConnConsensus) FinalizeBlock(ctx context.Context, req *types.RequestFinalizeBlock) (*types.ResponseFinalizeBlock, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "finalize_block", "type", "sync"))()
-	return app.appConn.FinalizeBlock(ctx, req)
+	app.mtx.Lock()
+	app.finalizing = req
+	app.mtx.Unlock()
+	res, err := call(app.client, func(c abcicli.Client) (*types.ResponseFinalizeBlock, error) {
+		return c.FinalizeBlock(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, err := range app.eventLimits.EnforceFinalizeBlock(res) {
+		app.logger.Error("Events of the application exceed the limits", "height", req.Height, "err", err)
+	}
+	return res, nil
 }
 
 func (app *appConnConsensus) Commit(ctx context.Context) (*types.ResponseCommit, error) {
+	return app.CommitWithSnapshot(ctx, false)
+}
+
+// CommitWithSnapshot commits the finalized block, asking the application to
+// take a state sync snapshot of the committed state if snapshot is set.
+func (app *appConnConsensus) CommitWithSnapshot(ctx context.Context, snapshot bool) (*types.ResponseCommit, error) {
+	req := &types.RequestCommit{Snapshot: snapshot}
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "commit", "type", "sync"))()
-	return app.appConn.Commit(ctx, &types.RequestCommit{})
+	c := app.client.get()
+	res, err := c.Commit(ctx, req)
+	if next, ok := app.client.retry(c, err); ok {
+		// The replacing client is connected to an application at the previous
+		// height, so the block is finalized again before being committed.
+		app.mtx.Lock()
+		finalizing := app.finalizing
+		app.mtx.Unlock()
+		if finalizing != nil {
+			if _, err := next.FinalizeBlock(ctx, finalizing); err != nil {
+				return nil, err
+			}
+		}
+		res, err = next.Commit(ctx, req)
+	}
+	if err == nil {
+		app.mtx.Lock()
+		app.finalizing = nil
+		app.mtx.Unlock()
+	}
+	return res, err
 }
 
 //------------------------------------------------
@@ -114,37 +173,44 @@ func (app *appConnConsensus) Commit(ctx context.Context) (*types.ResponseCommit,
 
 type appConnMempool struct {
 	metrics *Metrics
-	appConn abcicli.Client
+	client  connClient
 }
 
 func NewAppConnMempool(appConn abcicli.Client, metrics *Metrics) AppConnMempool {
 	return &appConnMempool{
 		metrics: metrics,
-		appConn: appConn,
+		client:  staticClient{appConn},
 	}
 }
 
 func (app *appConnMempool) SetResponseCallback(cb abcicli.Callback) {
-	app.appConn.SetResponseCallback(cb)
+	app.client.setResponseCallback(cb)
 }
 
 func (app *appConnMempool) Error() error {
-	return app.appConn.Error()
+	return app.client.get().Error()
 }
 
 func (app *appConnMempool) Flush(ctx context.Context) error {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "flush", "type", "sync"))()
-	return app.appConn.Flush(ctx)
+	_, err := call(app.client, func(c abcicli.Client) (struct{}, error) {
+		return struct{}{}, c.Flush(ctx)
+	})
+	return err
 }
 
 func (app *appConnMempool) CheckTx(ctx context.Context, req *types.RequestCheckTx) (*types.ResponseCheckTx, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "check_tx", "type", "sync"))()
-	return app.appConn.CheckTx(ctx, req)
+	return call(app.client, func(c abcicli.Client) (*types.ResponseCheckTx, error) {
+		return c.CheckTx(ctx, req)
+	})
 }
 
 func (app *appConnMempool) CheckTxAsync(ctx context.Context, req *types.RequestCheckTx) (*abcicli.ReqRes, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "check_tx", "type", "async"))()
-	return app.appConn.CheckTxAsync(ctx, req)
+	return call(app.client, func(c abcicli.Client) (*abcicli.ReqRes, error) {
+		return c.CheckTxAsync(ctx, req)
+	})
 }
 
 //------------------------------------------------
@@ -152,33 +218,39 @@ func (app *appConnMempool) CheckTxAsync(ctx context.Context, req *types.RequestC
 
 type appConnQuery struct {
 	metrics *Metrics
-	appConn abcicli.Client
+	client  connClient
 }
 
 func NewAppConnQuery(appConn abcicli.Client, metrics *Metrics) AppConnQuery {
 	return &appConnQuery{
 		metrics: metrics,
-		appConn: appConn,
+		client:  staticClient{appConn},
 	}
 }
 
 func (app *appConnQuery) Error() error {
-	return app.appConn.Error()
+	return app.client.get().Error()
 }
 
 func (app *appConnQuery) Echo(ctx context.Context, msg string) (*types.ResponseEcho, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "echo", "type", "sync"))()
-	return app.appConn.Echo(ctx, msg)
+	return call(app.client, func(c abcicli.Client) (*types.ResponseEcho, error) {
+		return c.Echo(ctx, msg)
+	})
 }
 
 func (app *appConnQuery) Info(ctx context.Context, req *types.RequestInfo) (*types.ResponseInfo, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "info", "type", "sync"))()
-	return app.appConn.Info(ctx, req)
+	return call(app.client, func(c abcicli.Client) (*types.ResponseInfo, error) {
+		return c.Info(ctx, req)
+	})
 }
 
 func (app *appConnQuery) Query(ctx context.Context, req *types.RequestQuery) (*types.ResponseQuery, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "query", "type", "sync"))()
-	return app.appConn.Query(ctx, req)
+	return call(app.client, func(c abcicli.Client) (*types.ResponseQuery, error) {
+		return c.Query(ctx, req)
+	})
 }
 
 //------------------------------------------------
@@ -186,38 +258,46 @@ func (app *appConnQuery) Query(ctx context.Context, req *types.RequestQuery) (*t
 
 type appConnSnapshot struct {
 	metrics *Metrics
-	appConn abcicli.Client
+	client  connClient
 }
 
 func NewAppConnSnapshot(appConn abcicli.Client, metrics *Metrics) AppConnSnapshot {
 	return &appConnSnapshot{
 		metrics: metrics,
-		appConn: appConn,
+		client:  staticClient{appConn},
 	}
 }
 
 func (app *appConnSnapshot) Error() error {
-	return app.appConn.Error()
+	return app.client.get().Error()
 }
 
 func (app *appConnSnapshot) ListSnapshots(ctx context.Context, req *types.RequestListSnapshots) (*types.ResponseListSnapshots, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "list_snapshots", "type", "sync"))()
-	return app.appConn.ListSnapshots(ctx, req)
+	return call(app.client, func(c abcicli.Client) (*types.ResponseListSnapshots, error) {
+		return c.ListSnapshots(ctx, req)
+	})
 }
 
 func (app *appConnSnapshot) OfferSnapshot(ctx context.Context, req *types.RequestOfferSnapshot) (*types.ResponseOfferSnapshot, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "offer_snapshot", "type", "sync"))()
-	return app.appConn.OfferSnapshot(ctx, req)
+	return call(app.client, func(c abcicli.Client) (*types.ResponseOfferSnapshot, error) {
+		return c.OfferSnapshot(ctx, req)
+	})
 }
 
 func (app *appConnSnapshot) LoadSnapshotChunk(ctx context.Context, req *types.RequestLoadSnapshotChunk) (*types.ResponseLoadSnapshotChunk, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "load_snapshot_chunk", "type", "sync"))()
-	return app.appConn.LoadSnapshotChunk(ctx, req)
+	return call(app.client, func(c abcicli.Client) (*types.ResponseLoadSnapshotChunk, error) {
+		return c.LoadSnapshotChunk(ctx, req)
+	})
 }
 
 func (app *appConnSnapshot) ApplySnapshotChunk(ctx context.Context, req *types.RequestApplySnapshotChunk) (*types.ResponseApplySnapshotChunk, error) {
 	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "apply_snapshot_chunk", "type", "sync"))()
-	return app.appConn.ApplySnapshotChunk(ctx, req)
+	return call(app.client, func(c abcicli.Client) (*types.ResponseApplySnapshotChunk, error) {
+		return c.ApplySnapshotChunk(ctx, req)
+	})
 }
 
 // addTimeSample returns a function that, when called, adds an observation to m.
@@ -0,0 +1,92 @@
+package proxy
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/abci/types"
+)
+
+// EventLimits bounds the events of each transaction in the FinalizeBlock
+// responses of the application. A zero limit is disabled.
+type EventLimits struct {
+	MaxEventsPerTx              int
+	MaxEventBytesPerTx          int
+	MaxEventAttributeKeyBytes   int
+	MaxEventAttributeValueBytes int
+}
+
+// Enabled returns whether any limit is set.
+func (l EventLimits) Enabled() bool {
+	return l.MaxEventsPerTx > 0 || l.MaxEventBytesPerTx > 0 ||
+		l.MaxEventAttributeKeyBytes > 0 || l.MaxEventAttributeValueBytes > 0
+}
+
+// ErrEventLimit reports the events of a transaction which don't satisfy the
+// event limits, and were dropped from the FinalizeBlock response. TxIndex is
+// -1 for the events of the block.
+type ErrEventLimit struct {
+	TxIndex int
+	Err     error
+}
+
+func (e ErrEventLimit) Error() string {
+	if e.TxIndex < 0 {
+		return fmt.Sprintf("events of the block dropped: %v", e.Err)
+	}
+	return fmt.Sprintf("events of transaction %d dropped: %v", e.TxIndex, e.Err)
+}
+
+func (e ErrEventLimit) Unwrap() error {
+	return e.Err
+}
+
+// ValidateEvents checks events, the events of a transaction, against the
+// limits. It does nothing if no limit is set.
+func (l EventLimits) ValidateEvents(events []types.Event) error {
+	if !l.Enabled() {
+		return nil
+	}
+	if l.MaxEventsPerTx > 0 && len(events) > l.MaxEventsPerTx {
+		return fmt.Errorf("%d events, max: %d", len(events), l.MaxEventsPerTx)
+	}
+	size := 0
+	for i, event := range events {
+		for j, attr := range event.Attributes {
+			if l.MaxEventAttributeKeyBytes > 0 && len(attr.Key) > l.MaxEventAttributeKeyBytes {
+				return fmt.Errorf("event %d (%s), attribute %d: key of %d bytes, max: %d",
+					i, event.Type, j, len(attr.Key), l.MaxEventAttributeKeyBytes)
+			}
+			if l.MaxEventAttributeValueBytes > 0 && len(attr.Value) > l.MaxEventAttributeValueBytes {
+				return fmt.Errorf("event %d (%s), attribute %s: value of %d bytes, max: %d",
+					i, event.Type, attr.Key, len(attr.Value), l.MaxEventAttributeValueBytes)
+			}
+		}
+		size += event.Size()
+	}
+	if l.MaxEventBytesPerTx > 0 && size > l.MaxEventBytesPerTx {
+		return fmt.Errorf("%d bytes of events, max: %d", size, l.MaxEventBytesPerTx)
+	}
+	return nil
+}
+
+// EnforceFinalizeBlock drops the events of the block and of each transaction
+// of res which don't satisfy the limits, returning an ErrEventLimit for each
+// of them. The events are not part of the results hash, so this doesn't
+// affect the consensus on the block.
+func (l EventLimits) EnforceFinalizeBlock(res *types.ResponseFinalizeBlock) []error {
+	if !l.Enabled() {
+		return nil
+	}
+	var errs []error
+	if err := l.ValidateEvents(res.Events); err != nil {
+		res.Events = nil
+		errs = append(errs, ErrEventLimit{TxIndex: -1, Err: err})
+	}
+	for i, txResult := range res.TxResults {
+		if err := l.ValidateEvents(txResult.Events); err != nil {
+			txResult.Events = nil
+			errs = append(errs, ErrEventLimit{TxIndex: i, Err: err})
+		}
+	}
+	return errs
+}
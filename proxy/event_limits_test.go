@@ -0,0 +1,73 @@
+package proxy
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+func TestEventLimitsValidateEvents(t *testing.T) {
+	event := func(typ string, attrs ...string) abci.Event {
+		ev := abci.Event{Type: typ}
+		for i := 0; i+1 < len(attrs); i += 2 {
+			ev.Attributes = append(ev.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+		}
+		return ev
+	}
+	events := []abci.Event{event("transfer", "sender", "alice", "amount", "10")}
+
+	testCases := []struct {
+		name    string
+		limits  EventLimits
+		events  []abci.Event
+		wantErr bool
+	}{
+		{"no limits", EventLimits{}, []abci.Event{event("", "", strings.Repeat("v", 1000))}, false},
+		{"within limits", EventLimits{2, 1000, 10, 10}, events, false},
+		{"too many events", EventLimits{MaxEventsPerTx: 1}, append(events, events...), true},
+		{"too many bytes", EventLimits{MaxEventBytesPerTx: 10}, events, true},
+		{"key too long", EventLimits{MaxEventAttributeKeyBytes: 5}, events, true},
+		{"value too long", EventLimits{MaxEventAttributeValueBytes: 4}, events, true},
+		{"empty type and key", EventLimits{MaxEventsPerTx: 10}, []abci.Event{event("", "", "v")}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.limits.ValidateEvents(tc.events)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFinalizeBlockEventLimits(t *testing.T) {
+	// the kvstore emits two events per transaction
+	for _, maxEvents := range []int{1, 2} {
+		appConns := NewAppConns(NewLocalClientCreator(kvstore.NewInMemoryApplication()), NopMetrics(),
+			WithEventLimits(EventLimits{MaxEventsPerTx: maxEvents}))
+		require.NoError(t, appConns.Start())
+		t.Cleanup(func() { _ = appConns.Stop() })
+
+		// the events exceeding the limits are dropped, not the block
+		res, err := appConns.Consensus().FinalizeBlock(context.Background(), &abci.RequestFinalizeBlock{
+			Height: 1,
+			Txs:    [][]byte{[]byte("a=b")},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.TxResults, 1)
+		assert.Equal(t, abci.CodeTypeOK, res.TxResults[0].Code)
+		if maxEvents == 1 {
+			assert.Empty(t, res.TxResults[0].Events)
+		} else {
+			assert.Len(t, res.TxResults[0].Events, 2)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package proxy
+
+import (
+	"fmt"
+	"sync"
+
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+//---------------------------------------------------------------
+// failover proxy connects to the first reachable of a list of app addresses
+
+// FailoverClientCreator is a [ClientCreator] for an application served at
+// several addresses, e.g. by replicas of the application. Its clients connect
+// to the current address, which starts as the first one. When the application
+// at the current address becomes unreachable, the multiAppConn using the
+// creator fails over to the next address, in order, instead of killing the
+// node.
+type FailoverClientCreator struct {
+	transport string
+
+	mtx     cmtsync.Mutex
+	addrs   []string
+	current int
+}
+
+// NewFailoverClientCreator returns a [FailoverClientCreator] for the given
+// addresses, in order of priority, and transport (e.g. "socket").
+func NewFailoverClientCreator(addrs []string, transport string) *FailoverClientCreator {
+	return &FailoverClientCreator{
+		transport: transport,
+		addrs:     addrs,
+	}
+}
+
+// NewABCIClient returns a client for the current address, which fails to
+// start if the application is not reachable.
+func (f *FailoverClientCreator) NewABCIClient() (abcicli.Client, error) {
+	if f.transport == "socket" {
+		return abcicli.NewFailoverSocketClient(f.Address(), true), nil
+	}
+	remoteApp, err := abcicli.NewClient(f.Address(), f.transport, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to proxy: %w", err)
+	}
+	return remoteApp, nil
+}
+
+// Address returns the current address of the application.
+func (f *FailoverClientCreator) Address() string {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	return f.addrs[f.current]
+}
+
+// next makes the next address, wrapping around, the current one and
+// returns it.
+func (f *FailoverClientCreator) next() string {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.current = (f.current + 1) % len(f.addrs)
+	return f.addrs[f.current]
+}
+
+func (f *FailoverClientCreator) numAddresses() int {
+	return len(f.addrs)
+}
+
+//---------------------------------------------------------------
+
+// connClient provides the ABCI client of a connection to the application.
+type connClient interface {
+	// get returns the current client.
+	get() abcicli.Client
+	// retry returns the client to retry a call on, which returned err on c,
+	// or false if the call is not to be retried. A call is retried if c
+	// failed or was stopped during the call, once c is replaced.
+	retry(c abcicli.Client, err error) (abcicli.Client, bool)
+	// setResponseCallback sets the response callback of the current client
+	// and of the clients replacing it.
+	setResponseCallback(cb abcicli.Callback)
+}
+
+// call calls fn with the client of cc. If the call fails because the client
+// failed, it is retried once with the client replacing it.
+func call[T any](cc connClient, fn func(abcicli.Client) (T, error)) (T, error) {
+	c := cc.get()
+	res, err := fn(c)
+	if next, ok := cc.retry(c, err); ok {
+		return fn(next)
+	}
+	return res, err
+}
+
+// staticClient is a connClient whose client is never replaced.
+type staticClient struct {
+	client abcicli.Client
+}
+
+func (sc staticClient) get() abcicli.Client { return sc.client }
+
+func (staticClient) retry(abcicli.Client, error) (abcicli.Client, bool) { return nil, false }
+
+func (sc staticClient) setResponseCallback(cb abcicli.Callback) { sc.client.SetResponseCallback(cb) }
+
+// failoverClients holds the clients of the connections to an application
+// with failover. While a failover is in progress, the connections wait for
+// the clients connected to the next address.
+type failoverClients struct {
+	mtx         sync.Mutex
+	cond        *sync.Cond
+	clients     map[string]abcicli.Client
+	callbacks   map[string]abcicli.Callback
+	failingOver bool
+	closed      bool
+}
+
+func newFailoverClients(clients map[string]abcicli.Client) *failoverClients {
+	fc := &failoverClients{
+		clients:   clients,
+		callbacks: make(map[string]abcicli.Callback),
+	}
+	fc.cond = sync.NewCond(&fc.mtx)
+	return fc
+}
+
+// conn returns the connClient of connection conn.
+func (fc *failoverClients) conn(conn string) connClient {
+	return failoverConn{clients: fc, conn: conn}
+}
+
+// begin marks the start of a failover.
+func (fc *failoverClients) begin() {
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	fc.failingOver = true
+}
+
+// replace ends a failover, replacing the clients with the given ones.
+func (fc *failoverClients) replace(clients map[string]abcicli.Client) {
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	for conn, cb := range fc.callbacks {
+		clients[conn].SetResponseCallback(cb)
+	}
+	fc.clients = clients
+	fc.failingOver = false
+	fc.cond.Broadcast()
+}
+
+// close ends the failovers, releasing the connections waiting for a
+// replacement with their current clients.
+func (fc *failoverClients) close() {
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	fc.closed = true
+	fc.failingOver = false
+	fc.cond.Broadcast()
+}
+
+type failoverConn struct {
+	clients *failoverClients
+	conn    string
+}
+
+func (c failoverConn) get() abcicli.Client {
+	fc := c.clients
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	for fc.failingOver {
+		fc.cond.Wait()
+	}
+	return fc.clients[c.conn]
+}
+
+func (c failoverConn) retry(failed abcicli.Client, err error) (abcicli.Client, bool) {
+	// The requests in flight when a client is stopped, e.g. to fail over
+	// after another connection failed, complete without a response nor an
+	// error.
+	if failed.IsRunning() && (err == nil || failed.Error() == nil) {
+		return nil, false
+	}
+	fc := c.clients
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	for !fc.closed && (fc.failingOver || fc.clients[c.conn] == failed) {
+		fc.cond.Wait()
+	}
+	if fc.clients[c.conn] == failed {
+		return nil, false
+	}
+	return fc.clients[c.conn], true
+}
+
+func (c failoverConn) setResponseCallback(cb abcicli.Callback) {
+	fc := c.clients
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	fc.callbacks[c.conn] = cb
+	fc.clients[c.conn].SetResponseCallback(cb)
+}
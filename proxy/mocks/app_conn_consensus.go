@@ -45,6 +45,36 @@ func (_m *AppConnConsensus) Commit(_a0 context.Context) (*types.ResponseCommit,
 	return r0, r1
 }
 
+// CommitWithSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *AppConnConsensus) CommitWithSnapshot(_a0 context.Context, _a1 bool) (*types.ResponseCommit, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitWithSnapshot")
+	}
+
+	var r0 *types.ResponseCommit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) (*types.ResponseCommit, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) *types.ResponseCommit); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ResponseCommit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Error provides a mock function with no fields
 func (_m *AppConnConsensus) Error() error {
 	ret := _m.Called()
@@ -1,12 +1,14 @@
 package proxy
 
 import (
+	"errors"
 	"fmt"
 
 	abcicli "github.com/cometbft/cometbft/abci/client"
 	cmtlog "github.com/cometbft/cometbft/libs/log"
 	cmtos "github.com/cometbft/cometbft/libs/os"
 	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 )
 
 const (
@@ -32,15 +34,16 @@ type AppConns interface {
 }
 
 // NewAppConns calls NewMultiAppConn.
-func NewAppConns(clientCreator ClientCreator, metrics *Metrics) AppConns {
-	return NewMultiAppConn(clientCreator, metrics)
+func NewAppConns(clientCreator ClientCreator, metrics *Metrics, options ...MultiAppConnOption) AppConns {
+	return NewMultiAppConn(clientCreator, metrics, options...)
 }
 
 // multiAppConn implements AppConns.
 //
 // A multiAppConn is made of a few appConns and manages their underlying abci
-// clients.
-// TODO: on app restart, clients must reboot together
+// clients. If one of them fails, the node is killed or, with a
+// FailoverClientCreator, all of them are replaced by clients connected to the
+// next address of the application.
 type multiAppConn struct {
 	service.BaseService
 
@@ -50,20 +53,50 @@ type multiAppConn struct {
 	queryConn     AppConnQuery
 	snapshotConn  AppConnSnapshot
 
+	clientsMtx          cmtsync.Mutex
 	consensusConnClient abcicli.Client
 	mempoolConnClient   abcicli.Client
 	queryConnClient     abcicli.Client
 	snapshotConnClient  abcicli.Client
 
 	clientCreator ClientCreator
+	eventLimits   EventLimits
+
+	// set if clientCreator is a FailoverClientCreator
+	failoverCreator *FailoverClientCreator
+	failover        *failoverClients
+	onFailover      func(AppConns) error
+}
+
+// MultiAppConnOption sets an optional parameter on the multiAppConn.
+type MultiAppConnOption func(*multiAppConn)
+
+// WithEventLimits enforces limits on the events of the FinalizeBlock responses
+// of the application, dropping the events exceeding them before they are
+// stored, indexed or served, and logging an ErrEventLimit for each.
+func WithEventLimits(limits EventLimits) MultiAppConnOption {
+	return func(app *multiAppConn) { app.eventLimits = limits }
+}
+
+// WithFailoverHook sets a function run with the connections to the next
+// address of the application when failing over to it, before they replace
+// the failed ones, e.g. to replay the blocks missing in the application. The
+// address is skipped if it returns an error. It is only used with a
+// FailoverClientCreator.
+func WithFailoverHook(hook func(AppConns) error) MultiAppConnOption {
+	return func(app *multiAppConn) { app.onFailover = hook }
 }
 
 // NewMultiAppConn makes all necessary abci connections to the application.
-func NewMultiAppConn(clientCreator ClientCreator, metrics *Metrics) AppConns {
+func NewMultiAppConn(clientCreator ClientCreator, metrics *Metrics, options ...MultiAppConnOption) AppConns {
 	multiAppConn := &multiAppConn{
 		metrics:       metrics,
 		clientCreator: clientCreator,
 	}
+	multiAppConn.failoverCreator, _ = clientCreator.(*FailoverClientCreator)
+	for _, option := range options {
+		option(multiAppConn)
+	}
 	multiAppConn.BaseService = *service.NewBaseService(nil, "multiAppConn", multiAppConn)
 	return multiAppConn
 }
@@ -85,97 +118,207 @@ func (app *multiAppConn) Snapshot() AppConnSnapshot {
 }
 
 func (app *multiAppConn) OnStart() error {
-	c, err := app.abciClientFor(connQuery)
+	clients, err := app.startClients()
 	if err != nil {
-		return err
+		if app.failoverCreator == nil {
+			return err
+		}
+		app.Logger.Error("Failed to connect to the application", "addr", app.failoverCreator.Address(), "err", err)
+		if clients, err = app.connectNext(app.failoverCreator.numAddresses() - 1); err != nil {
+			return err
+		}
 	}
-	app.queryConnClient = c
-	app.queryConn = NewAppConnQuery(c, app.metrics)
+	app.setClients(clients)
 
-	c, err = app.abciClientFor(connSnapshot)
-	if err != nil {
-		app.stopAllClients()
-		return err
-	}
-	app.snapshotConnClient = c
-	app.snapshotConn = NewAppConnSnapshot(c, app.metrics)
+	if app.failoverCreator == nil {
+		app.queryConn = NewAppConnQuery(clients[connQuery], app.metrics)
+		app.snapshotConn = NewAppConnSnapshot(clients[connSnapshot], app.metrics)
+		app.mempoolConn = NewAppConnMempool(clients[connMempool], app.metrics)
+		app.consensusConn = &appConnConsensus{
+			metrics:     app.metrics,
+			client:      staticClient{clients[connConsensus]},
+			eventLimits: app.eventLimits,
+			logger:      app.Logger,
+		}
 
-	c, err = app.abciClientFor(connMempool)
-	if err != nil {
-		app.stopAllClients()
-		return err
+		// Kill CometBFT if the ABCI application crashes.
+		go app.killTMOnClientError()
+		return nil
 	}
-	app.mempoolConnClient = c
-	app.mempoolConn = NewAppConnMempool(c, app.metrics)
 
-	c, err = app.abciClientFor(connConsensus)
-	if err != nil {
-		app.stopAllClients()
-		return err
+	app.failover = newFailoverClients(clients)
+	app.queryConn = &appConnQuery{metrics: app.metrics, client: app.failover.conn(connQuery)}
+	app.snapshotConn = &appConnSnapshot{metrics: app.metrics, client: app.failover.conn(connSnapshot)}
+	app.mempoolConn = &appConnMempool{metrics: app.metrics, client: app.failover.conn(connMempool)}
+	app.consensusConn = &appConnConsensus{
+		metrics:     app.metrics,
+		client:      app.failover.conn(connConsensus),
+		eventLimits: app.eventLimits,
+		logger:      app.Logger,
 	}
-	app.consensusConnClient = c
-	app.consensusConn = NewAppConnConsensus(c, app.metrics)
 
-	// Kill CometBFT if the ABCI application crashes.
-	go app.killTMOnClientError()
+	// Fail over to the next address of the application if it crashes.
+	go app.failOverOnClientError()
 
 	return nil
 }
 
 func (app *multiAppConn) OnStop() {
+	if app.failover != nil {
+		app.failover.close()
+	}
 	app.stopAllClients()
 }
 
 func (app *multiAppConn) killTMOnClientError() {
-	killFn := func(conn string, err error, logger cmtlog.Logger) {
-		logger.Error(
-			fmt.Sprintf("%s connection terminated. Did the application crash? Please restart CometBFT", conn),
-			"err", err)
-		killErr := cmtos.Kill()
-		if killErr != nil {
-			logger.Error("Failed to kill this process - please do so manually", "err", killErr)
-		}
+	conn, err := app.waitClientError()
+	if err != nil {
+		app.kill(conn, err)
 	}
+}
 
-	select {
-	case <-app.consensusConnClient.Quit():
-		if err := app.consensusConnClient.Error(); err != nil {
-			killFn(connConsensus, err, app.Logger)
-		}
-	case <-app.mempoolConnClient.Quit():
-		if err := app.mempoolConnClient.Error(); err != nil {
-			killFn(connMempool, err, app.Logger)
+func (app *multiAppConn) failOverOnClientError() {
+	for {
+		conn, err := app.waitClientError()
+		if err == nil {
+			return
 		}
-	case <-app.queryConnClient.Quit():
-		if err := app.queryConnClient.Error(); err != nil {
-			killFn(connQuery, err, app.Logger)
+		app.Logger.Error(
+			fmt.Sprintf("%s connection terminated, failing over to the next address of the application", conn),
+			"addr", app.failoverCreator.Address(), "err", err)
+		app.failover.begin()
+		app.stopAllClients()
+		clients, err := app.connectNext(app.failoverCreator.numAddresses())
+		if !app.IsRunning() {
+			stopClients(clients, app.Logger)
+			return
 		}
-	case <-app.snapshotConnClient.Quit():
-		if err := app.snapshotConnClient.Error(); err != nil {
-			killFn(connSnapshot, err, app.Logger)
+		if err != nil {
+			app.failover.close()
+			app.kill(conn, err)
+			return
 		}
+		app.setClients(clients)
+		app.failover.replace(clients)
 	}
 }
 
-func (app *multiAppConn) stopAllClients() {
-	if app.consensusConnClient != nil {
-		if err := app.consensusConnClient.Stop(); err != nil {
-			app.Logger.Error("error while stopping consensus client", "error", err)
-		}
+// waitClientError waits for a client to stop, and returns its connection and
+// error, which is nil if the client was stopped on purpose.
+func (app *multiAppConn) waitClientError() (string, error) {
+	app.clientsMtx.Lock()
+	consensusConnClient, mempoolConnClient := app.consensusConnClient, app.mempoolConnClient
+	queryConnClient, snapshotConnClient := app.queryConnClient, app.snapshotConnClient
+	app.clientsMtx.Unlock()
+
+	select {
+	case <-consensusConnClient.Quit():
+		return connConsensus, consensusConnClient.Error()
+	case <-mempoolConnClient.Quit():
+		return connMempool, mempoolConnClient.Error()
+	case <-queryConnClient.Quit():
+		return connQuery, queryConnClient.Error()
+	case <-snapshotConnClient.Quit():
+		return connSnapshot, snapshotConnClient.Error()
+	}
+}
+
+func (app *multiAppConn) kill(conn string, err error) {
+	app.Logger.Error(
+		fmt.Sprintf("%s connection terminated. Did the application crash? Please restart CometBFT", conn),
+		"err", err)
+	killErr := cmtos.Kill()
+	if killErr != nil {
+		app.Logger.Error("Failed to kill this process - please do so manually", "err", killErr)
 	}
-	if app.mempoolConnClient != nil {
-		if err := app.mempoolConnClient.Stop(); err != nil {
-			app.Logger.Error("error while stopping mempool client", "error", err)
+}
+
+// connectNext tries to connect to the application at each of the next
+// attempts addresses of the failover client creator, in order, and returns
+// the clients of the first one connected to, after running the failover hook
+// with them.
+func (app *multiAppConn) connectNext(attempts int) (map[string]abcicli.Client, error) {
+	err := errors.New("no other address of the application")
+	for i := 0; i < attempts; i++ {
+		addr := app.failoverCreator.next()
+		var clients map[string]abcicli.Client
+		clients, err = app.startClients()
+		if err == nil && app.onFailover != nil {
+			if err = app.onFailover(app.staticAppConns(clients)); err != nil {
+				stopClients(clients, app.Logger)
+				err = fmt.Errorf("failover hook: %w", err)
+			}
+		}
+		if err != nil {
+			app.Logger.Error("Failed to connect to the application", "addr", addr, "err", err)
+			continue
 		}
+		app.Logger.Info("Connected to the application", "addr", addr)
+		return clients, nil
 	}
-	if app.queryConnClient != nil {
-		if err := app.queryConnClient.Stop(); err != nil {
-			app.Logger.Error("error while stopping query client", "error", err)
+	return nil, err
+}
+
+// staticAppConns returns the connections to the application over the given
+// clients, without failover.
+func (app *multiAppConn) staticAppConns(clients map[string]abcicli.Client) AppConns {
+	conns := &multiAppConn{
+		metrics:      app.metrics,
+		queryConn:    NewAppConnQuery(clients[connQuery], app.metrics),
+		snapshotConn: NewAppConnSnapshot(clients[connSnapshot], app.metrics),
+		mempoolConn:  NewAppConnMempool(clients[connMempool], app.metrics),
+		consensusConn: &appConnConsensus{
+			metrics:     app.metrics,
+			client:      staticClient{clients[connConsensus]},
+			eventLimits: app.eventLimits,
+			logger:      app.Logger,
+		},
+	}
+	conns.BaseService = *service.NewBaseService(app.Logger, "multiAppConn", conns)
+	return conns
+}
+
+// startClients starts a client for each of the connections to the
+// application.
+func (app *multiAppConn) startClients() (map[string]abcicli.Client, error) {
+	clients := make(map[string]abcicli.Client, 4)
+	for _, conn := range []string{connQuery, connSnapshot, connMempool, connConsensus} {
+		c, err := app.abciClientFor(conn)
+		if err != nil {
+			stopClients(clients, app.Logger)
+			return nil, err
 		}
+		clients[conn] = c
 	}
-	if app.snapshotConnClient != nil {
-		if err := app.snapshotConnClient.Stop(); err != nil {
-			app.Logger.Error("error while stopping snapshot client", "error", err)
+	return clients, nil
+}
+
+func (app *multiAppConn) setClients(clients map[string]abcicli.Client) {
+	app.clientsMtx.Lock()
+	defer app.clientsMtx.Unlock()
+	app.queryConnClient = clients[connQuery]
+	app.snapshotConnClient = clients[connSnapshot]
+	app.mempoolConnClient = clients[connMempool]
+	app.consensusConnClient = clients[connConsensus]
+}
+
+func (app *multiAppConn) stopAllClients() {
+	app.clientsMtx.Lock()
+	defer app.clientsMtx.Unlock()
+	stopClients(map[string]abcicli.Client{
+		connConsensus: app.consensusConnClient,
+		connMempool:   app.mempoolConnClient,
+		connQuery:     app.queryConnClient,
+		connSnapshot:  app.snapshotConnClient,
+	}, app.Logger)
+}
+
+func stopClients(clients map[string]abcicli.Client, logger cmtlog.Logger) {
+	for _, conn := range []string{connConsensus, connMempool, connQuery, connSnapshot} {
+		if c := clients[conn]; c != nil {
+			if err := c.Stop(); err != nil && !errors.Is(err, service.ErrAlreadyStopped) {
+				logger.Error(fmt.Sprintf("error while stopping %s client", conn), "error", err)
+			}
 		}
 	}
 }
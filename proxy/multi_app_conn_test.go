@@ -1,9 +1,12 @@
 package proxy
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"os"
 	"os/signal"
+	"sync/atomic"
 	"syscall"
 	"testing"
 	"time"
@@ -12,6 +15,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abcimocks "github.com/cometbft/cometbft/abci/client/mocks"
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/abci/server"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/proxy/mocks"
 )
 
@@ -88,3 +97,67 @@ func TestAppConns_Failure(t *testing.T) {
 		t.Fatal("expected process to receive SIGTERM signal")
 	}
 }
+
+func TestAppConns_Failover(t *testing.T) {
+	startServer := func(app abci.Application) (string, service.Service) {
+		addr := fmt.Sprintf("unix:///tmp/failover_%v.sock", cmtrand.Str(6))
+		s := server.NewSocketServer(addr, app)
+		s.SetLogger(log.TestingLogger().With("module", "abci-server"))
+		require.NoError(t, s.Start())
+		t.Cleanup(func() { _ = s.Stop() })
+		return addr, s
+	}
+	primaryAddr, primary := startServer(abci.NewBaseApplication())
+	secondaryAddr, _ := startServer(kvstore.NewInMemoryApplication())
+
+	var failovers atomic.Int32
+	creator := NewFailoverClientCreator([]string{primaryAddr, secondaryAddr}, SOCKET)
+	appConns := NewAppConns(creator, NopMetrics(), WithFailoverHook(func(conns AppConns) error {
+		failovers.Add(1)
+		_, err := conns.Query().Echo(context.Background(), "hook")
+		return err
+	}))
+	appConns.SetLogger(log.TestingLogger())
+	require.NoError(t, appConns.Start())
+	t.Cleanup(func() { _ = appConns.Stop() })
+
+	res, err := appConns.Query().Info(context.Background(), RequestInfo)
+	require.NoError(t, err)
+	require.Zero(t, res.AppVersion)
+
+	// the connections fail over to the secondary once the primary is down
+	require.NoError(t, primary.Stop())
+	require.Eventually(t, func() bool {
+		res, err := appConns.Query().Info(context.Background(), RequestInfo)
+		return err == nil && res.AppVersion == kvstore.AppVersion
+	}, 10*time.Second, 50*time.Millisecond)
+	require.Equal(t, secondaryAddr, creator.Address())
+	require.EqualValues(t, 1, failovers.Load())
+
+	_, err = appConns.Mempool().CheckTx(context.Background(), &abci.RequestCheckTx{Tx: []byte("a=b")})
+	require.NoError(t, err)
+}
+
+func TestAppConns_FailoverOnStart(t *testing.T) {
+	addr := fmt.Sprintf("unix:///tmp/failover_%v.sock", cmtrand.Str(6))
+	s := server.NewSocketServer(addr, kvstore.NewInMemoryApplication())
+	s.SetLogger(log.TestingLogger().With("module", "abci-server"))
+	require.NoError(t, s.Start())
+	t.Cleanup(func() { _ = s.Stop() })
+
+	unreachable := fmt.Sprintf("unix:///tmp/failover_%v.sock", cmtrand.Str(6))
+	creator := NewFailoverClientCreator([]string{unreachable, addr}, SOCKET)
+	appConns := NewAppConns(creator, NopMetrics())
+	appConns.SetLogger(log.TestingLogger())
+	require.NoError(t, appConns.Start())
+	t.Cleanup(func() { _ = appConns.Stop() })
+	require.Equal(t, addr, creator.Address())
+
+	_, err := appConns.Query().Info(context.Background(), RequestInfo)
+	require.NoError(t, err)
+
+	// none of the addresses is reachable
+	unreachableConns := NewAppConns(NewFailoverClientCreator([]string{unreachable}, SOCKET), NopMetrics())
+	unreachableConns.SetLogger(log.TestingLogger())
+	require.Error(t, unreachableConns.Start())
+}
@@ -156,6 +156,61 @@ func testTxEventsSent(t *testing.T, broadcastMethod string) {
 	}
 }
 
+func TestNewMempoolTxEvents(t *testing.T) {
+	for _, c := range GetClients() {
+		c := c
+		t.Run(reflect.TypeOf(c).String(), func(t *testing.T) {
+
+			// start for this test it if it wasn't already running
+			if !c.IsRunning() {
+				// if so, then we start it, listen, and stop it.
+				err := c.Start()
+				require.Nil(t, err)
+				t.Cleanup(func() {
+					if err := c.Stop(); err != nil {
+						t.Error(err)
+					}
+				})
+			}
+
+			const subscriber = "TestNewMempoolTxEvents"
+
+			eventCh, err := c.Subscribe(context.Background(), subscriber, types.EventQueryNewMempoolTx.String())
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				if err := c.UnsubscribeAll(context.Background(), subscriber); err != nil {
+					t.Error(err)
+				}
+			})
+
+			// the subscription may not be in place yet when the first
+			// transactions are admitted, so keep sending them until one
+			// is received
+			sent := make(map[string]bool)
+			ticker := time.NewTicker(100 * time.Millisecond)
+			defer ticker.Stop()
+			timeout := time.After(waitForEventTimeout)
+			for {
+				select {
+				case <-ticker.C:
+					_, _, tx := MakeTxKV()
+					sent[string(tx)] = true
+					_, err := c.BroadcastTxAsync(context.Background(), tx)
+					require.NoError(t, err)
+				case event := <-eventCh:
+					txe, ok := event.Data.(types.EventDataNewMempoolTx)
+					require.True(t, ok)
+					if sent[string(txe.Tx)] {
+						return
+					}
+				case <-timeout:
+					t.Fatal("did not receive a new mempool tx event")
+				}
+			}
+		})
+	}
+}
+
 func TestHTTPReturnsErrorIfClientIsNotRunning(t *testing.T) {
 	c := getHTTPClient()
 
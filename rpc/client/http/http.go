@@ -274,6 +274,18 @@ func (c *baseRPCClient) BroadcastTxSync(
 	return c.broadcastTX(ctx, "broadcast_tx_sync", tx)
 }
 
+func (c *baseRPCClient) BroadcastTxBatch(
+	ctx context.Context,
+	txs types.Txs,
+) (*ctypes.ResultBroadcastTxBatch, error) {
+	result := new(ctypes.ResultBroadcastTxBatch)
+	_, err := c.caller.Call(ctx, "broadcast_tx_batch", map[string]interface{}{"txs": txs}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) broadcastTX(
 	ctx context.Context,
 	route string,
@@ -312,6 +324,15 @@ func (c *baseRPCClient) NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUn
 	return result, nil
 }
 
+func (c *baseRPCClient) FeeMarket(ctx context.Context) (*ctypes.ResultFeeMarket, error) {
+	result := new(ctypes.ResultFeeMarket)
+	_, err := c.caller.Call(ctx, "fee_market", map[string]interface{}{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
 	result := new(ctypes.ResultCheckTx)
 	_, err := c.caller.Call(ctx, "check_tx", map[string]interface{}{"tx": tx}, result)
@@ -321,6 +342,15 @@ func (c *baseRPCClient) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.Resul
 	return result, nil
 }
 
+func (c *baseRPCClient) StorageStatus(ctx context.Context) (*ctypes.ResultStorageStatus, error) {
+	result := new(ctypes.ResultStorageStatus)
+	_, err := c.caller.Call(ctx, "storage_status", map[string]interface{}{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	result := new(ctypes.ResultNetInfo)
 	_, err := c.caller.Call(ctx, "net_info", map[string]interface{}{}, result)
@@ -339,6 +369,28 @@ func (c *baseRPCClient) DumpConsensusState(ctx context.Context) (*ctypes.ResultD
 	return result, nil
 }
 
+func (c *baseRPCClient) DumpConsensusStateV2(
+	ctx context.Context,
+	sections string,
+	page,
+	perPage *int,
+	encoding string,
+) (*ctypes.ResultDumpConsensusStateV2, error) {
+	result := new(ctypes.ResultDumpConsensusStateV2)
+	params := map[string]interface{}{"sections": sections, "encoding": encoding}
+	if page != nil {
+		params["page"] = page
+	}
+	if perPage != nil {
+		params["per_page"] = perPage
+	}
+	_, err := c.caller.Call(ctx, "dump_consensus_state_v2", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error) {
 	result := new(ctypes.ResultConsensusState)
 	_, err := c.caller.Call(ctx, "consensus_state", map[string]interface{}{}, result)
@@ -485,6 +537,41 @@ func (c *baseRPCClient) Commit(ctx context.Context, height *int64) (*ctypes.Resu
 	return result, nil
 }
 
+func (c *baseRPCClient) BlockTime(ctx context.Context, height *int64) (*ctypes.ResultBlockTime, error) {
+	result := new(ctypes.ResultBlockTime)
+	params := make(map[string]interface{})
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "block_time", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) HeightAtTime(ctx context.Context, t time.Time) (*ctypes.ResultHeightAtTime, error) {
+	result := new(ctypes.ResultHeightAtTime)
+	_, err := c.caller.Call(ctx, "height_at_time", map[string]interface{}{"time": t}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) BlockPart(ctx context.Context, height int64, index int) (*ctypes.ResultBlockPart, error) {
+	result := new(ctypes.ResultBlockPart)
+	params := map[string]interface{}{
+		"height": height,
+		"index":  index,
+	}
+	_, err := c.caller.Call(ctx, "block_part", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	result := new(ctypes.ResultTx)
 	params := map[string]interface{}{
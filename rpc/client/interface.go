@@ -22,6 +22,7 @@ implementation.
 
 import (
 	"context"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/libs/service"
@@ -59,6 +60,7 @@ type ABCIClient interface {
 	BroadcastTxCommit(context.Context, types.Tx) (*ctypes.ResultBroadcastTxCommit, error)
 	BroadcastTxAsync(context.Context, types.Tx) (*ctypes.ResultBroadcastTx, error)
 	BroadcastTxSync(context.Context, types.Tx) (*ctypes.ResultBroadcastTx, error)
+	BroadcastTxBatch(context.Context, types.Txs) (*ctypes.ResultBroadcastTxBatch, error)
 }
 
 // SignClient groups together the functionality needed to get valid signatures
@@ -70,6 +72,9 @@ type SignClient interface {
 	Header(ctx context.Context, height *int64) (*ctypes.ResultHeader, error)
 	HeaderByHash(ctx context.Context, hash bytes.HexBytes) (*ctypes.ResultHeader, error)
 	Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error)
+	BlockTime(ctx context.Context, height *int64) (*ctypes.ResultBlockTime, error)
+	HeightAtTime(ctx context.Context, t time.Time) (*ctypes.ResultHeightAtTime, error)
+	BlockPart(ctx context.Context, height int64, index int) (*ctypes.ResultBlockPart, error)
 	Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error)
 	Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
 
@@ -128,9 +133,16 @@ type StatusClient interface {
 type NetworkClient interface {
 	NetInfo(context.Context) (*ctypes.ResultNetInfo, error)
 	DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensusState, error)
+	DumpConsensusStateV2(
+		ctx context.Context,
+		sections string,
+		page, perPage *int,
+		encoding string,
+	) (*ctypes.ResultDumpConsensusStateV2, error)
 	ConsensusState(context.Context) (*ctypes.ResultConsensusState, error)
 	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
 	Health(context.Context) (*ctypes.ResultHealth, error)
+	StorageStatus(context.Context) (*ctypes.ResultStorageStatus, error)
 }
 
 // EventsClient is reactive, you can subscribe to any message, given the proper
@@ -154,6 +166,7 @@ type EventsClient interface {
 type MempoolClient interface {
 	UnconfirmedTxs(ctx context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error)
 	NumUnconfirmedTxs(context.Context) (*ctypes.ResultUnconfirmedTxs, error)
+	FeeMarket(context.Context) (*ctypes.ResultFeeMarket, error)
 	CheckTx(context.Context, types.Tx) (*ctypes.ResultCheckTx, error)
 }
 
@@ -98,6 +98,10 @@ func (c *Local) BroadcastTxSync(_ context.Context, tx types.Tx) (*ctypes.ResultB
 	return c.env.BroadcastTxSync(c.ctx, tx)
 }
 
+func (c *Local) BroadcastTxBatch(_ context.Context, txs types.Txs) (*ctypes.ResultBroadcastTxBatch, error) {
+	return c.env.BroadcastTxBatch(c.ctx, txs)
+}
+
 func (c *Local) UnconfirmedTxs(_ context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error) {
 	return c.env.UnconfirmedTxs(c.ctx, limit)
 }
@@ -106,6 +110,10 @@ func (c *Local) NumUnconfirmedTxs(context.Context) (*ctypes.ResultUnconfirmedTxs
 	return c.env.NumUnconfirmedTxs(c.ctx)
 }
 
+func (c *Local) FeeMarket(context.Context) (*ctypes.ResultFeeMarket, error) {
+	return c.env.FeeMarket(c.ctx)
+}
+
 func (c *Local) CheckTx(_ context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
 	return c.env.CheckTx(c.ctx, tx)
 }
@@ -114,10 +122,23 @@ func (c *Local) NetInfo(context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.env.NetInfo(c.ctx)
 }
 
+func (c *Local) StorageStatus(context.Context) (*ctypes.ResultStorageStatus, error) {
+	return c.env.StorageStatus(c.ctx)
+}
+
 func (c *Local) DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	return c.env.DumpConsensusState(c.ctx)
 }
 
+func (c *Local) DumpConsensusStateV2(
+	_ context.Context,
+	sections string,
+	page, perPage *int,
+	encoding string,
+) (*ctypes.ResultDumpConsensusStateV2, error) {
+	return c.env.DumpConsensusStateV2(c.ctx, sections, page, perPage, encoding)
+}
+
 func (c *Local) ConsensusState(context.Context) (*ctypes.ResultConsensusState, error) {
 	return c.env.GetConsensusState(c.ctx)
 }
@@ -144,6 +165,30 @@ func (c *Local) DialPeers(
 	return c.env.UnsafeDialPeers(c.ctx, peers, persistent, unconditional, private)
 }
 
+func (c *Local) DialControl(
+	_ context.Context,
+	list string,
+	add, remove []string,
+) (*ctypes.ResultDialControl, error) {
+	return c.env.UnsafeDialControl(c.ctx, list, add, remove)
+}
+
+func (c *Local) BlockResultsCompare(
+	_ context.Context,
+	height *int64,
+	peer string,
+) (*ctypes.ResultBlockResultsCompare, error) {
+	return c.env.UnsafeBlockResultsCompare(c.ctx, height, peer)
+}
+
+func (c *Local) PeerBans(
+	_ context.Context,
+	unban []string,
+	unbanAll bool,
+) (*ctypes.ResultPeerBans, error) {
+	return c.env.UnsafePeerBans(c.ctx, unban, unbanAll)
+}
+
 func (c *Local) BlockchainInfo(_ context.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
 	return c.env.BlockchainInfo(c.ctx, minHeight, maxHeight)
 }
@@ -180,6 +225,18 @@ func (c *Local) Commit(_ context.Context, height *int64) (*ctypes.ResultCommit,
 	return c.env.Commit(c.ctx, height)
 }
 
+func (c *Local) BlockTime(_ context.Context, height *int64) (*ctypes.ResultBlockTime, error) {
+	return c.env.BlockTime(c.ctx, height)
+}
+
+func (c *Local) HeightAtTime(_ context.Context, t time.Time) (*ctypes.ResultHeightAtTime, error) {
+	return c.env.HeightAtTime(c.ctx, t)
+}
+
+func (c *Local) BlockPart(_ context.Context, height int64, index int) (*ctypes.ResultBlockPart, error) {
+	return c.env.BlockPart(c.ctx, height, index)
+}
+
 func (c *Local) Validators(_ context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
 	return c.env.Validators(c.ctx, height, page, perPage)
 }
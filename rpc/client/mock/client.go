@@ -17,6 +17,7 @@ want to directly call a CometBFT node in process, you can use the
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/libs/service"
@@ -124,6 +125,10 @@ func (c Client) NetInfo(_ context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.env.NetInfo(&rpctypes.Context{})
 }
 
+func (c Client) StorageStatus(_ context.Context) (*ctypes.ResultStorageStatus, error) {
+	return c.env.StorageStatus(&rpctypes.Context{})
+}
+
 func (c Client) ConsensusState(_ context.Context) (*ctypes.ResultConsensusState, error) {
 	return c.env.GetConsensusState(&rpctypes.Context{})
 }
@@ -132,6 +137,15 @@ func (c Client) DumpConsensusState(_ context.Context) (*ctypes.ResultDumpConsens
 	return c.env.DumpConsensusState(&rpctypes.Context{})
 }
 
+func (c Client) DumpConsensusStateV2(
+	_ context.Context,
+	sections string,
+	page, perPage *int,
+	encoding string,
+) (*ctypes.ResultDumpConsensusStateV2, error) {
+	return c.env.DumpConsensusStateV2(&rpctypes.Context{}, sections, page, perPage, encoding)
+}
+
 func (c Client) ConsensusParams(_ context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	return c.env.ConsensusParams(&rpctypes.Context{}, height)
 }
@@ -154,6 +168,30 @@ func (c Client) DialPeers(
 	return c.env.UnsafeDialPeers(&rpctypes.Context{}, peers, persistent, unconditional, private)
 }
 
+func (c Client) DialControl(
+	_ context.Context,
+	list string,
+	add, remove []string,
+) (*ctypes.ResultDialControl, error) {
+	return c.env.UnsafeDialControl(&rpctypes.Context{}, list, add, remove)
+}
+
+func (c Client) BlockResultsCompare(
+	_ context.Context,
+	height *int64,
+	peer string,
+) (*ctypes.ResultBlockResultsCompare, error) {
+	return c.env.UnsafeBlockResultsCompare(&rpctypes.Context{}, height, peer)
+}
+
+func (c Client) PeerBans(
+	_ context.Context,
+	unban []string,
+	unbanAll bool,
+) (*ctypes.ResultPeerBans, error) {
+	return c.env.UnsafePeerBans(&rpctypes.Context{}, unban, unbanAll)
+}
+
 func (c Client) BlockchainInfo(_ context.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
 	return c.env.BlockchainInfo(&rpctypes.Context{}, minHeight, maxHeight)
 }
@@ -174,6 +212,18 @@ func (c Client) Commit(_ context.Context, height *int64) (*ctypes.ResultCommit,
 	return c.env.Commit(&rpctypes.Context{}, height)
 }
 
+func (c Client) BlockTime(_ context.Context, height *int64) (*ctypes.ResultBlockTime, error) {
+	return c.env.BlockTime(&rpctypes.Context{}, height)
+}
+
+func (c Client) HeightAtTime(_ context.Context, t time.Time) (*ctypes.ResultHeightAtTime, error) {
+	return c.env.HeightAtTime(&rpctypes.Context{}, t)
+}
+
+func (c Client) BlockPart(_ context.Context, height int64, index int) (*ctypes.ResultBlockPart, error) {
+	return c.env.BlockPart(&rpctypes.Context{}, height, index)
+}
+
 func (c Client) Validators(_ context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
 	return c.env.Validators(&rpctypes.Context{}, height, page, perPage)
 }
@@ -19,12 +19,14 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	mempl "github.com/cometbft/cometbft/mempool"
+	cmtcons "github.com/cometbft/cometbft/proto/tendermint/consensus"
 	"github.com/cometbft/cometbft/rpc/client"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	rpclocal "github.com/cometbft/cometbft/rpc/client/local"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	rpctest "github.com/cometbft/cometbft/rpc/test"
+	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -131,6 +133,20 @@ func TestNetInfo(t *testing.T) {
 	}
 }
 
+func TestStorageStatus(t *testing.T) {
+	for i, c := range GetClients() {
+		nc, ok := c.(client.NetworkClient)
+		require.True(t, ok, "%d", i)
+		status, err := nc.StorageStatus(context.Background())
+		require.NoError(t, err, "%d", i)
+		names := make([]string, len(status.Stores))
+		for j, s := range status.Stores {
+			names[j] = s.Name
+		}
+		assert.Subset(t, names, []string{"blockstore", "evidence", "state"})
+	}
+}
+
 func TestDumpConsensusState(t *testing.T) {
 	for i, c := range GetClients() {
 		// FIXME: fix server so it doesn't panic on invalid input
@@ -143,6 +159,31 @@ func TestDumpConsensusState(t *testing.T) {
 	}
 }
 
+func TestDumpConsensusStateV2(t *testing.T) {
+	for i, c := range GetClients() {
+		nc, ok := c.(client.NetworkClient)
+		require.True(t, ok, "%d", i)
+
+		cons, err := nc.DumpConsensusStateV2(context.Background(), "round_state", nil, nil, "")
+		require.NoError(t, err, "%d", i)
+		assert.NotEmpty(t, cons.RoundState)
+		assert.Empty(t, cons.Votes)
+		assert.Zero(t, cons.TotalPeers)
+
+		cons, err = nc.DumpConsensusStateV2(context.Background(), "", nil, nil, "proto")
+		require.NoError(t, err, "%d", i)
+		assert.Empty(t, cons.RoundState)
+		var dump cmtcons.StateDump
+		require.NoError(t, dump.Unmarshal(cons.Proto), "%d", i)
+		require.NotNil(t, dump.RoundState)
+		assert.Positive(t, dump.RoundState.Height)
+		assert.NotEmpty(t, dump.Votes)
+
+		_, err = nc.DumpConsensusStateV2(context.Background(), "validators", nil, nil, "")
+		assert.Error(t, err, "%d", i)
+	}
+}
+
 func TestConsensusState(t *testing.T) {
 	for i, c := range GetClients() {
 		// FIXME: fix server so it doesn't panic on invalid input
@@ -213,6 +254,95 @@ func TestGenesisChunked(t *testing.T) {
 	}
 }
 
+func TestBlockTime(t *testing.T) {
+	for _, c := range GetClients() {
+		require.NoError(t, client.WaitForHeight(c, 3, nil))
+
+		// the first block's time is the genesis time
+		h := int64(1)
+		res, err := c.BlockTime(context.Background(), &h)
+		require.NoError(t, err)
+		gen, err := c.Genesis(context.Background())
+		require.NoError(t, err)
+		assert.True(t, res.Time.Equal(gen.Genesis.GenesisTime))
+		assert.Empty(t, res.Timestamps)
+		assert.Nil(t, res.LastCommit)
+
+		h = 2
+		res, err = c.BlockTime(context.Background(), &h)
+		require.NoError(t, err)
+		assert.Equal(t, h, res.Height)
+		assert.True(t, res.Time.Equal(res.SignedHeader.Time))
+		require.NotNil(t, res.LastCommit)
+		assert.EqualValues(t, res.SignedHeader.LastCommitHash, res.LastCommit.Hash())
+		assert.True(t, res.Time.Equal(sm.MedianTime(res.LastCommit, res.LastValidators)))
+		require.Len(t, res.Timestamps, 1)
+		assert.True(t, res.Timestamps[0].Timestamp.Equal(res.LastCommit.Signatures[0].Timestamp))
+
+		prev := h - 1
+		commit, err := c.Commit(context.Background(), &prev)
+		require.NoError(t, err)
+		assert.EqualValues(t, commit.ValidatorsHash, res.LastValidators.Hash())
+	}
+}
+
+func TestHeightAtTime(t *testing.T) {
+	for _, c := range GetClients() {
+		require.NoError(t, client.WaitForHeight(c, 3, nil))
+
+		h := int64(2)
+		header, err := c.Header(context.Background(), &h)
+		require.NoError(t, err)
+
+		res, err := c.HeightAtTime(context.Background(), header.Header.Time)
+		require.NoError(t, err)
+		assert.Equal(t, h, res.Height)
+		assert.True(t, res.Time.Equal(header.Header.Time))
+
+		res, err = c.HeightAtTime(context.Background(), header.Header.Time.Add(-time.Nanosecond))
+		require.NoError(t, err)
+		assert.Equal(t, h-1, res.Height)
+
+		_, err = c.HeightAtTime(context.Background(), time.Time{})
+		require.Error(t, err)
+	}
+}
+
+func TestBlockResultsCompare(t *testing.T) {
+	c := getLocalClient()
+	require.NoError(t, client.WaitForHeight(c, 2, nil))
+
+	// the node compared with itself has the same results
+	h := int64(2)
+	remote := rpctest.GetConfig().RPC.ListenAddress
+	res, err := c.BlockResultsCompare(context.Background(), &h, remote)
+	require.NoError(t, err)
+	assert.Equal(t, h, res.Height)
+	assert.Empty(t, res.Diffs)
+
+	_, err = c.BlockResultsCompare(context.Background(), &h, "")
+	require.Error(t, err)
+}
+
+func TestBlockPart(t *testing.T) {
+	for _, c := range GetClients() {
+		require.NoError(t, client.WaitForHeight(c, 2, nil))
+
+		h := int64(2)
+		block, err := c.Block(context.Background(), &h)
+		require.NoError(t, err)
+		res, err := c.BlockPart(context.Background(), h, 0)
+		require.NoError(t, err)
+		assert.Equal(t, h, res.Height)
+		assert.Equal(t, block.BlockID.PartSetHeader, res.PartSetHeader)
+		require.NoError(t, res.Part.ValidateBasic())
+		require.NoError(t, res.Part.Proof.Verify(res.PartSetHeader.Hash, res.Part.Bytes))
+
+		_, err = c.BlockPart(context.Background(), h, int(res.PartSetHeader.Total))
+		assert.Error(t, err)
+	}
+}
+
 func TestABCIQuery(t *testing.T) {
 	for i, c := range GetClients() {
 		// write something
@@ -359,6 +489,33 @@ func TestBroadcastTxSync(t *testing.T) {
 	}
 }
 
+func TestBroadcastTxBatch(t *testing.T) {
+	mempool := node.Mempool()
+	for i, c := range GetClients() {
+		// A single valid tx per batch, so that TestTxSearch finds one tx per
+		// block in case it is committed before the mempool is flushed.
+		_, _, tx := MakeTxKV()
+		invalid := types.Tx("invalid")
+		bres, err := c.BroadcastTxBatch(context.Background(), types.Txs{tx, invalid, tx})
+		require.NoError(t, err, "%d", i)
+		require.Len(t, bres.Results, 3)
+
+		require.Empty(t, bres.Results[0].Error)
+		require.Equal(t, abci.CodeTypeOK, bres.Results[0].Code)
+		require.EqualValues(t, types.Tx(tx).Hash(), bres.Results[0].Hash)
+		// the invalid tx and the duplicate are rejected without failing the batch
+		require.Empty(t, bres.Results[1].Error)
+		require.NotEqual(t, abci.CodeTypeOK, bres.Results[1].Code)
+		require.EqualValues(t, invalid.Hash(), bres.Results[1].Hash)
+		require.NotEmpty(t, bres.Results[2].Error)
+
+		mempool.Flush()
+
+		_, err = c.BroadcastTxBatch(context.Background(), types.Txs{})
+		require.Error(t, err)
+	}
+}
+
 func TestBroadcastTxCommit(t *testing.T) {
 	require := require.New(t)
 
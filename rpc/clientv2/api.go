@@ -0,0 +1,302 @@
+package clientv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// validatorsPerPage is the number of validators requested per page, the
+// maximum allowed by the node.
+const validatorsPerPage = 100
+
+// maxValidatorPages bounds the number of pages requested for a validator set,
+// so that a misbehaving node cannot keep us paging forever.
+const maxValidatorPages = 100
+
+// BroadcastAPI mirrors the BroadcastAPI gRPC service.
+type BroadcastAPI interface {
+	// Ping returns nil if the node is up.
+	Ping(ctx context.Context) error
+	// BroadcastTx submits a transaction and waits until it is committed.
+	BroadcastTx(ctx context.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error)
+	// BroadcastTxBatch submits several transactions at once and returns the
+	// CheckTx response of each of them.
+	BroadcastTxBatch(ctx context.Context, txs types.Txs) (*ctypes.ResultBroadcastTxBatch, error)
+}
+
+// BlockAPI mirrors the BlockAPI gRPC service. A height of 0 designates the
+// latest height.
+type BlockAPI interface {
+	// BlockByHash returns a block by its hash.
+	BlockByHash(ctx context.Context, hash []byte) (*types.Block, error)
+	// BlockByHeight returns a block by its height.
+	BlockByHeight(ctx context.Context, height int64) (*types.Block, error)
+	// Commit returns the commit of a block.
+	Commit(ctx context.Context, height int64) (*types.Commit, error)
+	// ValidatorSet returns the validator set of a block.
+	ValidatorSet(ctx context.Context, height int64) (*types.ValidatorSet, error)
+	// SubscribeNewHeights subscribes to new heights until ctx is done, when
+	// the returned channel is closed.
+	SubscribeNewHeights(ctx context.Context) (<-chan NewHeight, error)
+	// Status returns the status of the node.
+	Status(ctx context.Context) (*ctypes.ResultStatus, error)
+}
+
+// BlobstreamAPI mirrors the BlobstreamAPI gRPC service.
+type BlobstreamAPI interface {
+	// DataRootInclusionProof creates an inclusion proof for the data root of
+	// block height in the set of blocks defined by start and end. The range is
+	// end exclusive.
+	DataRootInclusionProof(ctx context.Context, height, start, end uint64) (*ctypes.ResultDataRootInclusionProof, error)
+}
+
+// API is the interface of the gRPC services, implemented by Client.
+type API interface {
+	BroadcastAPI
+	BlockAPI
+	BlobstreamAPI
+}
+
+// NewHeight is published by SubscribeNewHeights for every new block.
+type NewHeight struct {
+	Height int64
+	Hash   bytes.HexBytes
+}
+
+// Ping returns nil if the node is up.
+func (c *Client) Ping(ctx context.Context) error {
+	return c.retry(ctx, func() error {
+		_, err := c.rpc.Health(ctx)
+		return err
+	})
+}
+
+// BroadcastTx submits a transaction and waits until it is committed. It is
+// not retried.
+func (c *Client) BroadcastTx(ctx context.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	return c.rpc.BroadcastTxCommit(ctx, tx)
+}
+
+// BroadcastTxSync submits a transaction and returns its CheckTx response. It
+// is not retried.
+func (c *Client) BroadcastTxSync(ctx context.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return c.rpc.BroadcastTxSync(ctx, tx)
+}
+
+// BroadcastTxBatch submits several transactions at once and returns the
+// CheckTx response of each of them. It is not retried.
+func (c *Client) BroadcastTxBatch(ctx context.Context, txs types.Txs) (*ctypes.ResultBroadcastTxBatch, error) {
+	return c.rpc.BroadcastTxBatch(ctx, txs)
+}
+
+// BlockByHash returns a block by its hash.
+func (c *Client) BlockByHash(ctx context.Context, hash []byte) (*types.Block, error) {
+	var res *ctypes.ResultBlock
+	err := c.retry(ctx, func() (err error) {
+		res, err = c.rpc.BlockByHash(ctx, hash)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Block == nil {
+		return nil, fmt.Errorf("block %X not found", hash)
+	}
+	return res.Block, nil
+}
+
+// BlockByHeight returns a block by its height, or the latest block if height
+// is 0.
+func (c *Client) BlockByHeight(ctx context.Context, height int64) (*types.Block, error) {
+	var res *ctypes.ResultBlock
+	err := c.retry(ctx, func() (err error) {
+		res, err = c.rpc.Block(ctx, heightPtr(height))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Block == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	return res.Block, nil
+}
+
+// Header returns the header of a block, or of the latest block if height is 0.
+func (c *Client) Header(ctx context.Context, height int64) (*types.Header, error) {
+	var res *ctypes.ResultHeader
+	err := c.retry(ctx, func() (err error) {
+		res, err = c.rpc.Header(ctx, heightPtr(height))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Header == nil {
+		return nil, fmt.Errorf("header %d not found", height)
+	}
+	return res.Header, nil
+}
+
+// Commit returns the commit of a block, or of the latest block if height is
+// 0.
+func (c *Client) Commit(ctx context.Context, height int64) (*types.Commit, error) {
+	var res *ctypes.ResultCommit
+	err := c.retry(ctx, func() (err error) {
+		res, err = c.rpc.Commit(ctx, heightPtr(height))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Commit == nil {
+		return nil, fmt.Errorf("commit %d not found", height)
+	}
+	return res.Commit, nil
+}
+
+// BlockResults returns the results of the execution of a block, or of the
+// latest block if height is 0.
+func (c *Client) BlockResults(ctx context.Context, height int64) (*ctypes.ResultBlockResults, error) {
+	var res *ctypes.ResultBlockResults
+	err := c.retry(ctx, func() (err error) {
+		res, err = c.rpc.BlockResults(ctx, heightPtr(height))
+		return err
+	})
+	return res, err
+}
+
+// ValidatorSet returns the validator set of a block, or of the latest block if
+// height is 0, requesting as many pages as needed.
+func (c *Client) ValidatorSet(ctx context.Context, height int64) (*types.ValidatorSet, error) {
+	var (
+		vals    []*types.Validator
+		perPage = validatorsPerPage
+		total   = -1
+	)
+	for page := 1; len(vals) != total; page++ {
+		if page > maxValidatorPages {
+			return nil, fmt.Errorf("validator set of height %d has more than %d pages", height, maxValidatorPages)
+		}
+		var res *ctypes.ResultValidators
+		err := c.retry(ctx, func() (err error) {
+			res, err = c.rpc.Validators(ctx, heightPtr(height), &page, &perPage)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(res.Validators) == 0 || res.Total <= 0 {
+			return nil, fmt.Errorf("empty page %d of the validator set of height %d", page, height)
+		}
+		// request the next pages at the same height, even if it was 0
+		height = res.BlockHeight
+		total = res.Total
+		vals = append(vals, res.Validators...)
+	}
+	return types.ValidatorSetFromExistingValidators(vals)
+}
+
+// Status returns the status of the node.
+func (c *Client) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
+	var res *ctypes.ResultStatus
+	err := c.retry(ctx, func() (err error) {
+		res, err = c.rpc.Status(ctx)
+		return err
+	})
+	return res, err
+}
+
+// Tx returns a committed transaction by its hash, with a proof of its
+// inclusion in the block if prove is true.
+func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
+	var res *ctypes.ResultTx
+	err := c.retry(ctx, func() (err error) {
+		res, err = c.rpc.Tx(ctx, hash, prove)
+		return err
+	})
+	return res, err
+}
+
+// TxStatus returns the status of a transaction by its hash.
+func (c *Client) TxStatus(ctx context.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+	var res *ctypes.ResultTxStatus
+	err := c.retry(ctx, func() (err error) {
+		res, err = c.rpc.TxStatus(ctx, hash)
+		return err
+	})
+	return res, err
+}
+
+// ABCIQuery queries the application at the latest height.
+func (c *Client) ABCIQuery(ctx context.Context, path string, data []byte) (*ctypes.ResultABCIQuery, error) {
+	return c.ABCIQueryWithOptions(ctx, path, data, rpcclient.DefaultABCIQueryOptions)
+}
+
+// ABCIQueryWithOptions queries the application.
+func (c *Client) ABCIQueryWithOptions(
+	ctx context.Context,
+	path string,
+	data []byte,
+	opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+	var res *ctypes.ResultABCIQuery
+	err := c.retry(ctx, func() (err error) {
+		res, err = c.rpc.ABCIQueryWithOptions(ctx, path, data, opts)
+		return err
+	})
+	return res, err
+}
+
+// DataRootInclusionProof creates an inclusion proof for the data root of block
+// height in the set of blocks defined by start and end. The range is end
+// exclusive.
+func (c *Client) DataRootInclusionProof(
+	ctx context.Context,
+	height, start, end uint64,
+) (*ctypes.ResultDataRootInclusionProof, error) {
+	var res *ctypes.ResultDataRootInclusionProof
+	err := c.retry(ctx, func() (err error) {
+		res, err = c.rpc.DataRootInclusionProof(ctx, height, start, end)
+		return err
+	})
+	return res, err
+}
+
+// SubscribeNewHeights publishes the height and hash of every new block until
+// ctx is done, when the returned channel is closed.
+func (c *Client) SubscribeNewHeights(ctx context.Context) (<-chan NewHeight, error) {
+	events, err := c.Subscribe(ctx, types.EventQueryNewBlockHeader.String())
+	if err != nil {
+		return nil, err
+	}
+	heights := make(chan NewHeight)
+	go func() {
+		defer close(heights)
+		for event := range events {
+			data, ok := event.Data.(types.EventDataNewBlockHeader)
+			if !ok {
+				c.logger.Error("Unexpected event data", "type", fmt.Sprintf("%T", event.Data))
+				continue
+			}
+			select {
+			case heights <- NewHeight{Height: data.Header.Height, Hash: data.Header.Hash()}:
+			case <-ctx.Done():
+				// drain events until the subscription is closed
+			}
+		}
+	}()
+	return heights, nil
+}
+
+func heightPtr(height int64) *int64 {
+	if height == 0 {
+		return nil
+	}
+	return &height
+}
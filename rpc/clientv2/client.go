@@ -0,0 +1,183 @@
+/*
+Package clientv2 provides a typed client for the RPC of a CometBFT node.
+
+Unlike the general purpose client.Client, whose methods mirror the JSON-RPC
+endpoints one to one, Client exposes context-first methods taking and
+returning the types of the node (heights as int64, 0 meaning the latest,
+blocks as *types.Block, and so on), including the methods of the gRPC
+services. Read-only requests are retried with exponential backoff when the
+node cannot be reached, and subscriptions survive the loss of the websocket
+connection: it is redialed and the queries are subscribed to again.
+
+	c, err := clientv2.New("tcp://localhost:26657")
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	block, err := c.BlockByHeight(ctx, 0)
+*/
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+const (
+	defaultWebsocketEndpoint = "/websocket"
+	defaultMaxRetries        = 3
+	defaultMinBackoff        = 100 * time.Millisecond
+	defaultMaxBackoff        = 5 * time.Second
+)
+
+// Client is a typed client for the RPC of a CometBFT node. It is safe for
+// concurrent use. Close must be called to release the websocket connection
+// used by subscriptions.
+type Client struct {
+	rpc    *rpchttp.HTTP
+	events *eventStream
+
+	remote            string
+	websocketEndpoint string
+	timeout           time.Duration
+	maxRetries        int
+	minBackoff        time.Duration
+	maxBackoff        time.Duration
+	logger            log.Logger
+}
+
+var _ API = (*Client)(nil)
+
+// Option sets an optional parameter of the Client.
+type Option func(*Client)
+
+// WithRetries sets how many times a read-only request is retried when the
+// node cannot be reached. Defaults to 3. Broadcasts are never retried.
+func WithRetries(maxRetries int) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+	}
+}
+
+// WithBackoff sets the delay before the first retry, which doubles with every
+// attempt up to maxBackoff. It also paces the redials of the websocket
+// connection. Defaults to 100ms and 5s.
+func WithBackoff(minBackoff, maxBackoff time.Duration) Option {
+	return func(c *Client) {
+		c.minBackoff = minBackoff
+		c.maxBackoff = maxBackoff
+	}
+}
+
+// WithTimeout sets the timeout of each HTTP request. No timeout is set by
+// default; the context of the request applies.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithWebsocketEndpoint sets the path of the websocket endpoint of the node.
+// Defaults to "/websocket".
+func WithWebsocketEndpoint(endpoint string) Option {
+	return func(c *Client) {
+		c.websocketEndpoint = endpoint
+	}
+}
+
+// WithLogger sets the logger of the Client.
+func WithLogger(logger log.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// New returns a Client for the node listening at remote, e.g.
+// "tcp://localhost:26657".
+func New(remote string, options ...Option) (*Client, error) {
+	c := &Client{
+		remote:            remote,
+		websocketEndpoint: defaultWebsocketEndpoint,
+		maxRetries:        defaultMaxRetries,
+		minBackoff:        defaultMinBackoff,
+		maxBackoff:        defaultMaxBackoff,
+		logger:            log.NewNopLogger(),
+	}
+	for _, option := range options {
+		option(c)
+	}
+
+	httpClient, err := jsonrpcclient.DefaultHTTPClient(remote)
+	if err != nil {
+		return nil, err
+	}
+	httpClient.Timeout = c.timeout
+	c.rpc, err = rpchttp.NewWithClient(remote, c.websocketEndpoint, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	c.events = newEventStream(c)
+	return c, nil
+}
+
+// Remote returns the address of the node.
+func (c *Client) Remote() string {
+	return c.remote
+}
+
+// Close unsubscribes from all queries and closes the websocket connection.
+func (c *Client) Close() error {
+	return c.events.close()
+}
+
+// retry calls fn until it succeeds, fails with an error which retrying cannot
+// fix, or the retries are exhausted.
+func (c *Client) retry(ctx context.Context, fn func() error) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = fn()
+		if err == nil || !isRetryable(ctx, err) || attempt >= c.maxRetries {
+			return err
+		}
+		c.logger.Debug("Retrying request", "attempt", attempt+1, "err", err)
+		if err := sleep(ctx, c.backoff(attempt)); err != nil {
+			return err
+		}
+	}
+}
+
+// backoff returns the delay before the given retry attempt, starting at 0.
+func (c *Client) backoff(attempt int) time.Duration {
+	backoff := c.minBackoff << cmtmath.MinInt(attempt, 30)
+	if backoff > c.maxBackoff || backoff <= 0 {
+		return c.maxBackoff
+	}
+	return backoff
+}
+
+// isRetryable reports whether the request may succeed if sent again, i.e.
+// whether it failed before the node could answer it.
+func isRetryable(ctx context.Context, err error) bool {
+	var rpcErr *rpctypes.RPCError
+	return ctx.Err() == nil && !errors.As(err, &rpcErr)
+}
+
+// sleep waits for d, or returns the error of ctx if it is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
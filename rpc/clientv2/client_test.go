@@ -0,0 +1,152 @@
+package clientv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	"github.com/cometbft/cometbft/rpc/clientv2"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	rpctest "github.com/cometbft/cometbft/rpc/test"
+	"github.com/cometbft/cometbft/types"
+)
+
+func newClient(t *testing.T) *clientv2.Client {
+	c, err := clientv2.New(rpctest.GetConfig().RPC.ListenAddress, clientv2.WithLogger(log.TestingLogger()))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+	return c
+}
+
+func TestClient(t *testing.T) {
+	c := newClient(t)
+	ctx := context.Background()
+
+	require.NoError(t, c.Ping(ctx))
+
+	tx := types.Tx(kvstore.NewTx(cmtrand.Str(8), "value"))
+	bres, err := c.BroadcastTx(ctx, tx)
+	require.NoError(t, err)
+	require.True(t, bres.TxResult.IsOK())
+	height := bres.Height
+
+	block, err := c.BlockByHeight(ctx, height)
+	require.NoError(t, err)
+	assert.Equal(t, height, block.Height)
+	assert.Contains(t, block.Txs, tx)
+
+	byHash, err := c.BlockByHash(ctx, block.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, block.Hash(), byHash.Hash())
+
+	header, err := c.Header(ctx, height)
+	require.NoError(t, err)
+	assert.Equal(t, block.Hash(), header.Hash())
+
+	commit, err := c.Commit(ctx, height)
+	require.NoError(t, err)
+	assert.Equal(t, block.Hash(), commit.BlockID.Hash)
+
+	vals, err := c.ValidatorSet(ctx, height)
+	require.NoError(t, err)
+	assert.EqualValues(t, block.ValidatorsHash, vals.Hash())
+
+	res, err := c.Tx(ctx, tx.Hash(), true)
+	require.NoError(t, err)
+	assert.Equal(t, height, res.Height)
+
+	latest, err := c.BlockByHeight(ctx, 0)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, latest.Height, height)
+
+	// the node answered, so the error is not retried
+	_, err = c.BlockByHeight(ctx, latest.Height+1000)
+	require.Error(t, err)
+}
+
+func TestSubscribeNewHeights(t *testing.T) {
+	c := newClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	heights, err := c.SubscribeNewHeights(ctx)
+	require.NoError(t, err)
+
+	var last clientv2.NewHeight
+	for i := 0; i < 2; i++ {
+		select {
+		case h := <-heights:
+			if i > 0 {
+				assert.Equal(t, last.Height+1, h.Height)
+			}
+			header, err := c.Header(ctx, h.Height)
+			require.NoError(t, err)
+			assert.Equal(t, header.Hash(), h.Hash)
+			last = h
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for a new height")
+		}
+	}
+
+	_, err = c.SubscribeNewHeights(ctx)
+	require.Error(t, err, "already subscribed")
+
+	// the channel is closed once the context is done
+	cancel()
+	require.Eventually(t, func() bool {
+		select {
+		case _, ok := <-heights:
+			return !ok
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestClientRetries(t *testing.T) {
+	var requests atomic.Int32
+	rpcError := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if rpcError {
+			resp := rpctypes.RPCInternalError(nil, errors.New("boom"))
+			require.NoError(t, json.NewEncoder(w).Encode(resp))
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c, err := clientv2.New(server.URL, clientv2.WithRetries(2), clientv2.WithBackoff(time.Millisecond, 2*time.Millisecond))
+	require.NoError(t, err)
+	defer c.Close()
+
+	// the node could not be reached
+	_, err = c.Status(context.Background())
+	require.Error(t, err)
+	assert.EqualValues(t, 3, requests.Load())
+
+	// broadcasts are never retried
+	requests.Store(0)
+	_, err = c.BroadcastTxSync(context.Background(), []byte("tx"))
+	require.Error(t, err)
+	assert.EqualValues(t, 1, requests.Load())
+
+	// nor are requests the node answered
+	requests.Store(0)
+	rpcError = true
+	_, err = c.Status(context.Background())
+	require.Error(t, err)
+	assert.EqualValues(t, 1, requests.Load())
+}
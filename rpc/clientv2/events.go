@@ -0,0 +1,278 @@
+package clientv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+)
+
+const (
+	// maxReconnectAttempts is the number of times the websocket client tries
+	// to reconnect on its own before giving up, after which a new connection
+	// is dialed.
+	maxReconnectAttempts = 3
+	// subscriptionBufferSize is the number of events buffered per
+	// subscription; further events are dropped until the subscriber catches
+	// up.
+	subscriptionBufferSize = 100
+	// unsubscribeTimeout bounds the time spent unsubscribing from a query
+	// whose subscriber is gone.
+	unsubscribeTimeout = 5 * time.Second
+)
+
+var errClosed = errors.New("client closed")
+
+// Subscribe publishes the events matching query until ctx is done, when the
+// returned channel is closed. If the websocket connection is lost, it is
+// redialed and the query subscribed to again; events published in the
+// meantime are missed. A query can only be subscribed to once at a time.
+func (c *Client) Subscribe(ctx context.Context, query string) (<-chan ctypes.ResultEvent, error) {
+	return c.events.subscribe(ctx, query)
+}
+
+// eventStream multiplexes the subscriptions of a Client over a websocket
+// connection, which is dialed on the first subscription.
+type eventStream struct {
+	c    *Client
+	quit chan struct{}
+
+	mtx    cmtsync.Mutex
+	ws     *jsonrpcclient.WSClient // nil until the first subscription
+	subs   map[string]*subscription
+	closed bool
+}
+
+type subscription struct {
+	query string
+	in    chan ctypes.ResultEvent
+}
+
+func newEventStream(c *Client) *eventStream {
+	return &eventStream{
+		c:    c,
+		quit: make(chan struct{}),
+		subs: make(map[string]*subscription),
+	}
+}
+
+func (s *eventStream) subscribe(ctx context.Context, query string) (<-chan ctypes.ResultEvent, error) {
+	s.mtx.Lock()
+	if s.closed {
+		s.mtx.Unlock()
+		return nil, errClosed
+	}
+	if _, ok := s.subs[query]; ok {
+		s.mtx.Unlock()
+		return nil, fmt.Errorf("already subscribed to %q", query)
+	}
+	if s.ws == nil {
+		ws, err := s.dial()
+		if err != nil {
+			s.mtx.Unlock()
+			return nil, err
+		}
+		s.ws = ws
+		go s.run(ws)
+	}
+	ws := s.ws
+	sub := &subscription{query: query, in: make(chan ctypes.ResultEvent, subscriptionBufferSize)}
+	// register the subscription first, so that it is renewed if the
+	// connection is lost from now on
+	s.subs[query] = sub
+	s.mtx.Unlock()
+
+	if err := call(ctx, ws, func(ctx context.Context) error { return ws.Subscribe(ctx, query) }); err != nil {
+		s.remove(sub)
+		return nil, err
+	}
+
+	out := make(chan ctypes.ResultEvent)
+	go s.forward(ctx, sub, out)
+	return out, nil
+}
+
+// forward passes the events of sub on to out until ctx is done or the stream
+// is closed.
+func (s *eventStream) forward(ctx context.Context, sub *subscription, out chan<- ctypes.ResultEvent) {
+	defer close(out)
+	for {
+		select {
+		case event := <-sub.in:
+			select {
+			case out <- event:
+				continue
+			case <-ctx.Done():
+			case <-s.quit:
+				return
+			}
+		case <-ctx.Done():
+		case <-s.quit:
+			return
+		}
+		s.unsubscribe(sub)
+		return
+	}
+}
+
+func (s *eventStream) unsubscribe(sub *subscription) {
+	ws := s.remove(sub)
+	if ws == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), unsubscribeTimeout)
+	defer cancel()
+	if err := call(ctx, ws, func(ctx context.Context) error { return ws.Unsubscribe(ctx, sub.query) }); err != nil {
+		s.c.logger.Error("Failed to unsubscribe", "query", sub.query, "err", err)
+	}
+}
+
+// remove forgets sub and returns the current connection.
+func (s *eventStream) remove(sub *subscription) *jsonrpcclient.WSClient {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.subs[sub.query] == sub {
+		delete(s.subs, sub.query)
+	}
+	return s.ws
+}
+
+// run dispatches the events received on ws to the subscriptions, and replaces
+// ws once it stops.
+func (s *eventStream) run(ws *jsonrpcclient.WSClient) {
+	for {
+		resp, ok := <-ws.ResponsesCh
+		if !ok {
+			if ws = s.redial(); ws == nil {
+				return
+			}
+			continue
+		}
+		if resp.Error != nil {
+			s.c.logger.Error("Websocket error", "err", resp.Error)
+			continue
+		}
+
+		event := new(ctypes.ResultEvent)
+		if err := cmtjson.Unmarshal(resp.Result, event); err != nil {
+			s.c.logger.Error("Failed to unmarshal event", "err", err)
+			continue
+		}
+		s.mtx.Lock()
+		sub, ok := s.subs[event.Query]
+		s.mtx.Unlock()
+		if !ok {
+			// e.g. the response to a subscribe request
+			continue
+		}
+		select {
+		case sub.in <- *event:
+		default:
+			s.c.logger.Error("Dropping event, subscriber is too slow", "query", event.Query)
+		}
+	}
+}
+
+// dial connects to the websocket endpoint of the node.
+func (s *eventStream) dial() (*jsonrpcclient.WSClient, error) {
+	ws, err := jsonrpcclient.NewWS(s.c.remote, s.c.websocketEndpoint,
+		jsonrpcclient.MaxReconnectAttempts(maxReconnectAttempts),
+		// the subscriptions of a connection do not survive it
+		jsonrpcclient.OnReconnect(s.resubscribe),
+	)
+	if err != nil {
+		return nil, err
+	}
+	ws.SetLogger(s.c.logger)
+	if err := ws.Start(); err != nil {
+		return nil, err
+	}
+	return ws, nil
+}
+
+// redial dials a new connection after the previous one gave up reconnecting,
+// and subscribes to all queries again. It returns nil once the stream is
+// closed.
+func (s *eventStream) redial() *jsonrpcclient.WSClient {
+	for attempt := 0; ; attempt++ {
+		select {
+		case <-time.After(s.c.backoff(attempt)):
+		case <-s.quit:
+			return nil
+		}
+
+		ws, err := s.dial()
+		if err != nil {
+			s.c.logger.Error("Failed to redial websocket", "attempt", attempt+1, "err", err)
+			continue
+		}
+		s.mtx.Lock()
+		if s.closed {
+			s.mtx.Unlock()
+			if err := ws.Stop(); err != nil {
+				s.c.logger.Error("Failed to stop websocket", "err", err)
+			}
+			return nil
+		}
+		s.ws = ws
+		s.mtx.Unlock()
+
+		s.resubscribe()
+		return ws
+	}
+}
+
+// resubscribe subscribes to all queries on the current connection.
+func (s *eventStream) resubscribe() {
+	s.mtx.Lock()
+	ws := s.ws
+	queries := make([]string, 0, len(s.subs))
+	for query := range s.subs {
+		queries = append(queries, query)
+	}
+	s.mtx.Unlock()
+
+	for _, query := range queries {
+		query := query
+		if err := call(context.Background(), ws, func(ctx context.Context) error { return ws.Subscribe(ctx, query) }); err != nil {
+			s.c.logger.Error("Failed to resubscribe", "query", query, "err", err)
+		}
+	}
+}
+
+func (s *eventStream) close() error {
+	s.mtx.Lock()
+	if s.closed {
+		s.mtx.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.quit)
+	ws := s.ws
+	s.mtx.Unlock()
+
+	if ws == nil || !ws.IsRunning() {
+		return nil
+	}
+	return ws.Stop()
+}
+
+// call sends a request on ws, giving up if ctx is done or ws stops, since a
+// stopped client never sends its requests.
+func call(ctx context.Context, ws *jsonrpcclient.WSClient, send func(context.Context) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-ws.Quit():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return send(ctx)
+}
@@ -2,29 +2,86 @@ package core
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/proxy"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 )
 
+// queryRoute limits the queries whose path starts with its prefix.
+type queryRoute struct {
+	cfg.ABCIQueryRoute
+	slots chan struct{} // nil if concurrent queries are not limited
+}
+
+// queryRouter routes queries by path to the routes of abci_query_routes.
+type queryRouter struct {
+	routes    []*queryRoute // from the longest prefix to the shortest
+	allowlist bool
+}
+
+// InitQueryRoutes enables routing /abci_query requests if abci_query_routes
+// is set, and should be called on service startup.
+func (env *Environment) InitQueryRoutes() error {
+	routes, err := env.Config.QueryRoutes()
+	if err != nil {
+		return err
+	}
+	if len(routes) == 0 && !env.Config.ABCIQueryAllowlist {
+		return nil
+	}
+
+	env.queryRouter = &queryRouter{allowlist: env.Config.ABCIQueryAllowlist}
+	for _, r := range routes {
+		route := &queryRoute{ABCIQueryRoute: r}
+		if r.MaxConcurrent > 0 {
+			route.slots = make(chan struct{}, r.MaxConcurrent)
+		}
+		env.queryRouter.routes = append(env.queryRouter.routes, route)
+	}
+	return nil
+}
+
+// route returns the route of path, or nil if none matches.
+func (qr *queryRouter) route(path string) *queryRoute {
+	for _, route := range qr.routes {
+		if strings.HasPrefix(path, route.Prefix) {
+			return route
+		}
+	}
+	return nil
+}
+
 // ABCIQuery queries the application for some information.
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/ABCI/abci_query
 func (env *Environment) ABCIQuery(
-	_ *rpctypes.Context,
+	ctx *rpctypes.Context,
 	path string,
 	data bytes.HexBytes,
 	height int64,
 	prove bool,
 ) (*ctypes.ResultABCIQuery, error) {
-	resQuery, err := env.ProxyAppQuery.Query(context.TODO(), &abci.RequestQuery{
+	req := &abci.RequestQuery{
 		Path:   path,
 		Data:   data,
 		Height: height,
 		Prove:  prove,
-	})
+	}
+
+	var (
+		resQuery *abci.ResponseQuery
+		err      error
+	)
+	if env.queryRouter == nil {
+		resQuery, err = env.ProxyAppQuery.Query(context.TODO(), req)
+	} else {
+		resQuery, err = env.routeQuery(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -32,6 +89,56 @@ func (env *Environment) ABCIQuery(
 	return &ctypes.ResultABCIQuery{Response: *resQuery}, nil
 }
 
+// routeQuery runs the query within the limits of its route.
+func (env *Environment) routeQuery(ctx *rpctypes.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+	route := env.queryRouter.route(req.Path)
+	if route == nil {
+		// in-process clients have neither an HTTP request nor a websocket
+		public := ctx.HTTPReq != nil || ctx.WSConn != nil
+		if env.queryRouter.allowlist && public {
+			return nil, fmt.Errorf("path %q is not allowed", req.Path)
+		}
+		return env.ProxyAppQuery.Query(context.TODO(), req)
+	}
+
+	if route.slots != nil {
+		select {
+		case route.slots <- struct{}{}:
+		default:
+			return nil, fmt.Errorf("too many concurrent queries for path prefix %q, try again later", route.Prefix)
+		}
+	}
+	if route.Timeout == 0 {
+		if route.slots != nil {
+			defer func() { <-route.slots }()
+		}
+		return env.ProxyAppQuery.Query(context.TODO(), req)
+	}
+
+	type result struct {
+		res *abci.ResponseQuery
+		err error
+	}
+	qctx, cancel := context.WithTimeout(context.Background(), route.Timeout)
+	defer cancel()
+	resCh := make(chan result, 1)
+	// A query running past its timeout keeps its slot until the application
+	// answers, so that slow queries cannot pile up on the application.
+	go func() {
+		if route.slots != nil {
+			defer func() { <-route.slots }()
+		}
+		res, err := env.ProxyAppQuery.Query(qctx, req)
+		resCh <- result{res, err}
+	}()
+	select {
+	case r := <-resCh:
+		return r.res, r.err
+	case <-qctx.Done():
+		return nil, fmt.Errorf("query for path %q timed out after %v", req.Path, route.Timeout)
+	}
+}
+
 // ABCIInfo gets some info about the application.
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/ABCI/abci_info
 func (env *Environment) ABCIInfo(_ *rpctypes.Context) (*ctypes.ResultABCIInfo, error) {
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/proxy/mocks"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+func TestABCIQueryRoutes(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	app := &mocks.AppConnQuery{}
+	isPath := func(path string) interface{} {
+		return mock.MatchedBy(func(req *abci.RequestQuery) bool { return req.Path == path })
+	}
+	app.On("Query", mock.Anything, isPath("/slow/query")).
+		Run(func(mock.Arguments) { <-release }).
+		Return(&abci.ResponseQuery{}, nil)
+	app.On("Query", mock.Anything, isPath("/fast/query")).Return(&abci.ResponseQuery{Value: []byte("fast")}, nil)
+	app.On("Query", mock.Anything, isPath("/other")).Return(&abci.ResponseQuery{}, nil)
+
+	env := &Environment{ProxyAppQuery: app, Config: *cfg.DefaultRPCConfig()}
+	env.Config.ABCIQueryRoutes = "/slow=50ms/1,/fast=0s/0"
+	env.Config.ABCIQueryAllowlist = true
+	require.NoError(t, env.InitQueryRoutes())
+
+	public := &rpctypes.Context{HTTPReq: &http.Request{}}
+	res, err := env.ABCIQuery(public, "/fast/query", nil, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("fast"), res.Response.Value)
+
+	// the slow query times out, but keeps its slot until the app answers
+	start := time.Now()
+	_, err = env.ABCIQuery(public, "/slow/query", nil, 0, false)
+	require.ErrorContains(t, err, "timed out")
+	assert.Less(t, time.Since(start), time.Second)
+	_, err = env.ABCIQuery(public, "/slow/query", nil, 0, false)
+	require.ErrorContains(t, err, "too many concurrent queries")
+
+	// the allowlist only applies to public clients
+	_, err = env.ABCIQuery(public, "/other", nil, 0, false)
+	require.ErrorContains(t, err, "not allowed")
+	_, err = env.ABCIQuery(&rpctypes.Context{}, "/other", nil, 0, false)
+	require.NoError(t, err)
+}
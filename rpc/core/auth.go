@@ -0,0 +1,141 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+// authChallengeSize is the number of random bytes in a challenge.
+const authChallengeSize = 32
+
+// subscriptionAuth tracks the identities websocket clients authenticated as.
+// Connections are identified by their remote address, like subscriptions.
+type subscriptionAuth struct {
+	quotas map[p2p.ID]int
+
+	mtx        cmtsync.Mutex
+	challenges map[string][]byte
+	identities map[string]p2p.ID
+}
+
+// InitSubscriptionAuth enables websocket authentication if
+// authenticated_subscription_quotas is set, and should be called on service
+// startup.
+func (env *Environment) InitSubscriptionAuth() error {
+	quotas, err := env.Config.SubscriptionQuotas()
+	if err != nil {
+		return err
+	}
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	env.subAuth = &subscriptionAuth{
+		quotas:     make(map[p2p.ID]int, len(quotas)),
+		challenges: make(map[string][]byte),
+		identities: make(map[string]p2p.ID),
+	}
+	for id, maxSubs := range quotas {
+		env.subAuth.quotas[p2p.ID(id)] = maxSubs
+	}
+	return nil
+}
+
+// DropSubscriptionAuth forgets the challenge and identity of a websocket
+// connection. It should be called when the connection is closed.
+func (env *Environment) DropSubscriptionAuth(remoteAddr string) {
+	if env.subAuth == nil {
+		return
+	}
+	env.subAuth.mtx.Lock()
+	defer env.subAuth.mtx.Unlock()
+	delete(env.subAuth.challenges, remoteAddr)
+	delete(env.subAuth.identities, remoteAddr)
+}
+
+// AuthChallenge returns a random challenge for the websocket connection. The
+// client authenticates by signing ctypes.AuthSignBytes(challenge) and calling
+// Authenticate. Only the last challenge of a connection is valid.
+func (env *Environment) AuthChallenge(ctx *rpctypes.Context) (*ctypes.ResultAuthChallenge, error) {
+	if env.subAuth == nil {
+		return nil, errors.New("subscription authentication is disabled")
+	}
+
+	challenge := crypto.CRandBytes(authChallengeSize)
+
+	env.subAuth.mtx.Lock()
+	env.subAuth.challenges[ctx.RemoteAddr()] = challenge
+	env.subAuth.mtx.Unlock()
+
+	return &ctypes.ResultAuthChallenge{Challenge: challenge}, nil
+}
+
+// Authenticate verifies the signature of the connection's challenge by the
+// ed25519 key pubKey. If the key's node ID has a subscription quota, the
+// connection is granted that quota for the rest of its lifetime.
+func (env *Environment) Authenticate(
+	ctx *rpctypes.Context,
+	pubKey, signature []byte,
+) (*ctypes.ResultAuthenticate, error) {
+	if env.subAuth == nil {
+		return nil, errors.New("subscription authentication is disabled")
+	}
+	if len(pubKey) != ed25519.PubKeySize {
+		return nil, fmt.Errorf("expected an ed25519 public key of %d bytes, got %d", ed25519.PubKeySize, len(pubKey))
+	}
+
+	addr := ctx.RemoteAddr()
+	env.subAuth.mtx.Lock()
+	defer env.subAuth.mtx.Unlock()
+
+	// A challenge can only be answered once.
+	challenge, ok := env.subAuth.challenges[addr]
+	if !ok {
+		return nil, errors.New("no pending challenge, call auth_challenge first")
+	}
+	delete(env.subAuth.challenges, addr)
+
+	pk := ed25519.PubKey(pubKey)
+	if !pk.VerifySignature(ctypes.AuthSignBytes(challenge), signature) {
+		return nil, errors.New("invalid signature")
+	}
+	id := p2p.PubKeyToID(pk)
+	maxSubs, ok := env.subAuth.quotas[id]
+	if !ok {
+		return nil, fmt.Errorf("node ID %s has no subscription quota", id)
+	}
+	env.subAuth.identities[addr] = id
+
+	env.Logger.Info("Authenticated websocket client", "remote", addr, "id", id)
+	return &ctypes.ResultAuthenticate{NodeID: string(id), MaxSubscriptions: maxSubs}, nil
+}
+
+// subscriptionQuota returns the identity the connection authenticated as and
+// the number of subscriptions still available to it.
+func (env *Environment) subscriptionQuota(remoteAddr string) (p2p.ID, int, bool) {
+	if env.subAuth == nil {
+		return "", 0, false
+	}
+	env.subAuth.mtx.Lock()
+	defer env.subAuth.mtx.Unlock()
+
+	id, ok := env.subAuth.identities[remoteAddr]
+	if !ok {
+		return "", 0, false
+	}
+	// The quota is shared by all connections of the identity.
+	available := env.subAuth.quotas[id]
+	for addr, otherID := range env.subAuth.identities {
+		if otherID == id {
+			available -= env.EventBus.NumClientSubscriptions(addr)
+		}
+	}
+	return id, available, true
+}
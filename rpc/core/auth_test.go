@@ -0,0 +1,118 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+type testWSConn struct {
+	remoteAddr string
+}
+
+func (c testWSConn) GetRemoteAddr() string { return c.remoteAddr }
+
+func (testWSConn) WriteRPCResponse(context.Context, rpctypes.RPCResponse) error { return nil }
+
+func (testWSConn) TryWriteRPCResponse(rpctypes.RPCResponse) bool { return true }
+
+func (testWSConn) Context() context.Context { return context.Background() }
+
+func newWSContext(remoteAddr string) *rpctypes.Context {
+	return &rpctypes.Context{
+		JSONReq: &rpctypes.RPCRequest{ID: rpctypes.JSONRPCIntID(1)},
+		WSConn:  testWSConn{remoteAddr: remoteAddr},
+	}
+}
+
+func authenticate(env *Environment, ctx *rpctypes.Context, key ed25519.PrivKey) (*ctypes.ResultAuthenticate, error) {
+	challenge, err := env.AuthChallenge(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := key.Sign(ctypes.AuthSignBytes(challenge.Challenge))
+	if err != nil {
+		return nil, err
+	}
+	return env.Authenticate(ctx, key.PubKey().Bytes(), sig)
+}
+
+func TestSubscriptionAuth(t *testing.T) {
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	key := ed25519.GenPrivKey()
+	rpcConfig := cfg.DefaultRPCConfig()
+	rpcConfig.MaxSubscriptionClients = 0
+	rpcConfig.AuthenticatedSubscriptionQuotas = fmt.Sprintf("%s=2", p2p.PubKeyToID(key.PubKey()))
+
+	env := &Environment{Logger: log.TestingLogger(), EventBus: eventBus, Config: *rpcConfig}
+	require.NoError(t, env.InitSubscriptionAuth())
+
+	ctx1, ctx2 := newWSContext("1.2.3.4:1"), newWSContext("1.2.3.4:2")
+
+	// unauthenticated clients are subject to max_subscription_clients
+	_, err := env.Subscribe(ctx1, "tm.event = 'NewBlock'")
+	require.ErrorContains(t, err, "max_subscription_clients")
+
+	// a challenge is required and can only be answered once
+	_, err = env.Authenticate(ctx1, key.PubKey().Bytes(), make([]byte, 64))
+	require.ErrorContains(t, err, "no pending challenge")
+	challenge, err := env.AuthChallenge(ctx1)
+	require.NoError(t, err)
+	sig, err := key.Sign(ctypes.AuthSignBytes(challenge.Challenge))
+	require.NoError(t, err)
+	_, err = env.Authenticate(ctx1, key.PubKey().Bytes(), sig[1:])
+	require.ErrorContains(t, err, "invalid signature")
+	_, err = env.Authenticate(ctx1, key.PubKey().Bytes(), sig)
+	require.ErrorContains(t, err, "no pending challenge")
+
+	// keys without a quota are rejected
+	_, err = authenticate(env, ctx1, ed25519.GenPrivKey())
+	require.ErrorContains(t, err, "has no subscription quota")
+
+	res, err := authenticate(env, ctx1, key)
+	require.NoError(t, err)
+	assert.Equal(t, string(p2p.PubKeyToID(key.PubKey())), res.NodeID)
+	assert.Equal(t, 2, res.MaxSubscriptions)
+
+	_, err = env.Subscribe(ctx1, "tm.event = 'NewBlock'")
+	require.NoError(t, err)
+
+	// the quota is shared by all connections of an identity
+	_, err = authenticate(env, ctx2, key)
+	require.NoError(t, err)
+	_, err = env.Subscribe(ctx2, "tm.event = 'Tx'")
+	require.NoError(t, err)
+	_, err = env.Subscribe(ctx2, "tm.event = 'NewBlockHeader'")
+	require.ErrorContains(t, err, "subscription quota")
+
+	// dropped connections lose their identity
+	env.DropSubscriptionAuth(ctx1.RemoteAddr())
+	_, err = env.Subscribe(ctx1, "tm.event = 'Tx'")
+	require.ErrorContains(t, err, "max_subscription_clients")
+}
+
+func TestSubscriptionAuthDisabled(t *testing.T) {
+	env := &Environment{Logger: log.TestingLogger(), Config: *cfg.DefaultRPCConfig()}
+	require.NoError(t, env.InitSubscriptionAuth())
+
+	_, err := env.AuthChallenge(newWSContext("1.2.3.4:1"))
+	require.ErrorContains(t, err, "disabled")
+}
@@ -1,18 +1,22 @@
 package core
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"sort"
 	"strconv"
+	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/libs/bytes"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	sm "github.com/cometbft/cometbft/state"
 	blockidxnull "github.com/cometbft/cometbft/state/indexer/block/null"
 	"github.com/cometbft/cometbft/types"
 )
@@ -33,7 +37,7 @@ const (
 //
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Info/blockchain
 func (env *Environment) BlockchainInfo(
-	_ *rpctypes.Context,
+	ctx *rpctypes.Context,
 	minHeight, maxHeight int64,
 ) (*ctypes.ResultBlockchainInfo, error) {
 	const limit int64 = 20
@@ -51,6 +55,9 @@ func (env *Environment) BlockchainInfo(
 
 	blockMetas := []*types.BlockMeta{}
 	for height := maxHeight; height >= minHeight; height-- {
+		if err := checkContext(ctx.Context()); err != nil {
+			return nil, err
+		}
 		blockMeta := env.BlockStore.LoadBlockMeta(height)
 		blockMetas = append(blockMetas, blockMeta)
 	}
@@ -135,10 +142,13 @@ func (env *Environment) Block(_ *rpctypes.Context, heightPtr *int64) (*ctypes.Re
 		return nil, err
 	}
 
-	block := env.BlockStore.LoadBlock(height)
 	blockMeta := env.BlockStore.LoadBlockMeta(height)
 	if blockMeta == nil {
-		return &ctypes.ResultBlock{BlockID: types.BlockID{}, Block: block}, nil
+		return &ctypes.ResultBlock{BlockID: types.BlockID{}, Block: nil}, nil
+	}
+	block, err := loadBlock(env.BlockStore, height, blockMeta)
+	if err != nil {
+		return nil, err
 	}
 	return &ctypes.ResultBlock{BlockID: blockMeta.BlockID, Block: block}, nil
 }
@@ -146,15 +156,37 @@ func (env *Environment) Block(_ *rpctypes.Context, heightPtr *int64) (*ctypes.Re
 // BlockByHash gets block by hash.
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Info/block_by_hash
 func (env *Environment) BlockByHash(_ *rpctypes.Context, hash []byte) (*ctypes.ResultBlock, error) {
-	block := env.BlockStore.LoadBlockByHash(hash)
-	if block == nil {
+	blockMeta := env.BlockStore.LoadBlockMetaByHash(hash)
+	if blockMeta == nil {
 		return &ctypes.ResultBlock{BlockID: types.BlockID{}, Block: nil}, nil
 	}
-	// If block is not nil, then blockMeta can't be nil.
-	blockMeta := env.BlockStore.LoadBlockMeta(block.Height)
+	block, err := loadBlock(env.BlockStore, blockMeta.Header.Height, blockMeta)
+	if err != nil {
+		return nil, err
+	}
 	return &ctypes.ResultBlock{BlockID: blockMeta.BlockID, Block: block}, nil
 }
 
+// loadBlock returns the block of blockMeta at the given height, decoded
+// lazily from its parts so that its transactions are not copied, unlike with
+// BlockStore.LoadBlock. The block stores which don't keep the parts of their
+// blocks, e.g. mocks, are read with LoadBlock.
+func loadBlock(bs sm.BlockStore, height int64, blockMeta *types.BlockMeta) (*types.Block, error) {
+	total := blockMeta.BlockID.PartSetHeader.Total
+	if total == 0 {
+		return bs.LoadBlock(height), nil
+	}
+	rawBlock, err := joinBlockParts(bs, height, total)
+	if err != nil {
+		return nil, err
+	}
+	lb, err := types.NewLazyBlock(rawBlock)
+	if err != nil {
+		return nil, err
+	}
+	return lb.Block()
+}
+
 // Commit gets block commit at a given height.
 // If no height is provided, it will fetch the commit for the latest block.
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Info/commit
@@ -170,16 +202,174 @@ func (env *Environment) Commit(_ *rpctypes.Context, heightPtr *int64) (*ctypes.R
 	}
 	header := blockMeta.Header
 
-	// If the next block has not been committed yet,
-	// use a non-canonical commit
+	var res *ctypes.ResultCommit
 	if height == env.BlockStore.Height() {
+		// If the next block has not been committed yet,
+		// use a non-canonical commit
 		commit := env.BlockStore.LoadSeenCommit(height)
-		return ctypes.NewResultCommit(&header, commit, false), nil
+		res = ctypes.NewResultCommit(&header, commit, false)
+	} else {
+		// Return the canonical commit (comes from the block at height+1)
+		commit := env.BlockStore.LoadBlockCommit(height)
+		res = ctypes.NewResultCommit(&header, commit, true)
+	}
+
+	res.VoteExtensions = env.voteExtensions(height)
+	return res, nil
+}
+
+// voteExtensions returns the vote extensions of the precommits for the block
+// at the given height, or nil if no extended commit is stored for it, i.e.
+// vote extensions were not enabled at the height. The extensions are omitted,
+// and the error logged, if they can't be matched with the validators.
+func (env *Environment) voteExtensions(height int64) *abci.ExtendedCommitInfo {
+	ec := env.BlockStore.LoadBlockExtendedCommit(height)
+	if ec == nil {
+		return nil
+	}
+	if err := ec.EnsureExtensions(true); err != nil {
+		env.Logger.Error("Omitting vote extensions", "height", height, "err", err)
+		return nil
+	}
+	vals, err := env.StateStore.LoadValidators(height)
+	if err != nil {
+		env.Logger.Error("Omitting vote extensions: failed to load validators", "height", height, "err", err)
+		return nil
+	}
+	if ec.Size() != vals.Size() {
+		env.Logger.Error("Omitting vote extensions: extended commit size does not match the validator set",
+			"height", height, "signatures", ec.Size(), "validators", vals.Size())
+		return nil
+	}
+	// extensions are present, so they were enabled at the commit's height
+	info := sm.BuildExtendedCommitInfo(ec, vals, height, types.ABCIParams{VoteExtensionsEnableHeight: height})
+	return &info
+}
+
+// BlockTime gets the BFT time of the block at the given height, along with
+// the precommit timestamps it is the weighted median of and the data needed to
+// verify them against the block header. If no height is provided, it fetches
+// the time of the latest block.
+func (env *Environment) BlockTime(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlockTime, error) {
+	height, err := env.getHeight(env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	blockMeta := env.BlockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+	header := blockMeta.Header
+
+	var commit *types.Commit
+	if height == env.BlockStore.Height() {
+		commit = env.BlockStore.LoadSeenCommit(height)
+	} else {
+		commit = env.BlockStore.LoadBlockCommit(height)
+	}
+
+	res := &ctypes.ResultBlockTime{
+		Height:       height,
+		Time:         header.Time,
+		Timestamps:   []ctypes.PrecommitTimestamp{},
+		SignedHeader: types.SignedHeader{Header: &header, Commit: commit},
+	}
+
+	// The first block has no last commit, its time is the genesis time.
+	if header.LastBlockID.IsZero() {
+		return res, nil
+	}
+
+	res.LastCommit = env.BlockStore.LoadBlockCommit(height - 1)
+	if res.LastCommit == nil {
+		return nil, fmt.Errorf("last commit of block at height %d not found", height)
+	}
+	res.LastValidators, err = env.StateStore.LoadValidators(height - 1)
+	if err != nil {
+		return nil, err
+	}
+	res.Timestamps = ctypes.PrecommitTimestamps(res.LastCommit, res.LastValidators)
+
+	return res, nil
+}
+
+// HeightAtTime gets the height of the last block whose time is at or before
+// the given time, so that the state of the chain can be queried as of that
+// time.
+func (env *Environment) HeightAtTime(_ *rpctypes.Context, t time.Time) (*ctypes.ResultHeightAtTime, error) {
+	height, err := env.heightAtTime(t)
+	if err != nil {
+		return nil, err
+	}
+	blockMeta := env.BlockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+	return &ctypes.ResultHeightAtTime{
+		Height: height,
+		Time:   blockMeta.Header.Time,
+	}, nil
+}
+
+// heightAtTime returns the height of the last block whose time is at or
+// before t, searching the block times of the blockstore, which increase with
+// the height, by bisection.
+func (env *Environment) heightAtTime(t time.Time) (int64, error) {
+	base, height := env.BlockStore.Base(), env.BlockStore.Height()
+	if height == 0 {
+		return 0, errors.New("no blocks stored")
 	}
 
-	// Return the canonical commit (comes from the block at height+1)
-	commit := env.BlockStore.LoadBlockCommit(height)
-	return ctypes.NewResultCommit(&header, commit, true), nil
+	var err error
+	// index of the first block after t
+	i := sort.Search(int(height-base+1), func(i int) bool {
+		if err != nil {
+			return true
+		}
+		blockMeta := env.BlockStore.LoadBlockMeta(base + int64(i))
+		if blockMeta == nil {
+			err = fmt.Errorf("block at height %d not found", base+int64(i))
+			return true
+		}
+		return blockMeta.Header.Time.After(t)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if i == 0 {
+		return 0, fmt.Errorf("time %v is before the time of the lowest available height %d", t, base)
+	}
+	return base + int64(i) - 1, nil
+}
+
+// BlockPart gets the part of the given index of the block at the given
+// height, with its merkle proof against the PartSetHeader of the block, so
+// that blocks can be retrieved piecewise from untrusted nodes.
+func (env *Environment) BlockPart(_ *rpctypes.Context, height int64, index int) (*ctypes.ResultBlockPart, error) {
+	if _, err := env.getHeight(env.BlockStore.Height(), &height); err != nil {
+		return nil, err
+	}
+
+	blockMeta := env.BlockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+	partSetHeader := blockMeta.BlockID.PartSetHeader
+	if index < 0 || index >= int(partSetHeader.Total) {
+		return nil, fmt.Errorf("part index %d out of range, block at height %d has %d parts",
+			index, height, partSetHeader.Total)
+	}
+
+	part := env.BlockStore.LoadBlockPart(height, index)
+	if part == nil {
+		return nil, fmt.Errorf("part %d of block at height %d not found", index, height)
+	}
+	return &ctypes.ResultBlockPart{
+		Height:        height,
+		PartSetHeader: partSetHeader,
+		Part:          part,
+	}, nil
 }
 
 // BlockResults gets ABCIResults at a given height.
@@ -195,11 +385,21 @@ func (env *Environment) BlockResults(_ *rpctypes.Context, heightPtr *int64) (*ct
 		return nil, err
 	}
 
+	if err := env.shedBlockResults(height); err != nil {
+		return nil, err
+	}
+
 	results, err := env.StateStore.LoadFinalizeBlockResponse(height)
 	if err != nil {
 		env.Logger.Error("failed to LoadFinalizeBlockResponse", "err", err)
 		return nil, err
 	}
+	env.EventLimits.EnforceFinalizeBlock(results)
+
+	var extCommitInfo *abci.ExtendedCommitInfo
+	if height > env.BlockStore.Base() {
+		extCommitInfo = env.voteExtensions(height - 1)
+	}
 
 	return &ctypes.ResultBlockResults{
 		Height:                height,
@@ -208,6 +408,7 @@ func (env *Environment) BlockResults(_ *rpctypes.Context, heightPtr *int64) (*ct
 		ValidatorUpdates:      results.ValidatorUpdates,
 		ConsensusParamUpdates: results.ConsensusParamUpdates,
 		AppHash:               results.AppHash,
+		ExtendedCommitInfo:    extCommitInfo,
 	}, nil
 }
 
@@ -224,6 +425,10 @@ func (env *Environment) BlockSearch(
 		return nil, errors.New("block indexing is disabled")
 	}
 
+	if env.sheddingLoad() {
+		return nil, ErrLoadShedding
+	}
+
 	q, err := cmtquery.New(query)
 	if err != nil {
 		return nil, err
@@ -233,6 +438,11 @@ func (env *Environment) BlockSearch(
 	if err != nil {
 		return nil, err
 	}
+	// the indexer returns the heights found so far when the request is
+	// canceled, which must not be mistaken for the complete results
+	if err := checkContext(ctx.Context()); err != nil {
+		return nil, err
+	}
 
 	// sort results (must be done before pagination)
 	switch orderBy {
@@ -260,16 +470,21 @@ func (env *Environment) BlockSearch(
 
 	apiResults := make([]*ctypes.ResultBlock, 0, pageSize)
 	for i := skipCount; i < skipCount+pageSize; i++ {
-		block := env.BlockStore.LoadBlock(results[i])
-		if block != nil {
-			blockMeta := env.BlockStore.LoadBlockMeta(block.Height)
-			if blockMeta != nil {
-				apiResults = append(apiResults, &ctypes.ResultBlock{
-					Block:   block,
-					BlockID: blockMeta.BlockID,
-				})
-			}
+		if err := checkContext(ctx.Context()); err != nil {
+			return nil, err
+		}
+		blockMeta := env.BlockStore.LoadBlockMeta(results[i])
+		if blockMeta == nil {
+			continue
 		}
+		block, err := loadBlock(env.BlockStore, results[i], blockMeta)
+		if err != nil {
+			return nil, err
+		}
+		apiResults = append(apiResults, &ctypes.ResultBlock{
+			Block:   block,
+			BlockID: blockMeta.BlockID,
+		})
 	}
 
 	return &ctypes.ResultBlockSearch{Blocks: apiResults, TotalCount: totalCount}, nil
@@ -283,10 +498,24 @@ func (env *Environment) SignedBlock(ctx *rpctypes.Context, heightPtr *int64) (*c
 		return nil, err
 	}
 
-	block := env.BlockStore.LoadBlock(height)
-	if block == nil {
+	// only the header and the data are needed, the evidence and the last
+	// commit of the block are not decoded
+	rawBlock, err := loadRawBlock(env.BlockStore, height)
+	if err != nil {
 		return nil, errors.New("block not found")
 	}
+	lb, err := types.NewLazyBlock(rawBlock)
+	if err != nil {
+		return nil, err
+	}
+	header, err := lb.Header()
+	if err != nil {
+		return nil, err
+	}
+	data, err := lb.Data()
+	if err != nil {
+		return nil, err
+	}
 	seenCommit := env.BlockStore.LoadSeenCommit(height)
 	if seenCommit == nil {
 		return nil, errors.New("seen commit not found")
@@ -297,10 +526,10 @@ func (env *Environment) SignedBlock(ctx *rpctypes.Context, heightPtr *int64) (*c
 	}
 
 	return &ctypes.ResultSignedBlock{
-		Header:       block.Header,
+		Header:       header,
 		Commit:       *seenCommit,
 		ValidatorSet: *validatorSet,
-		Data:         block.Data,
+		Data:         data,
 	}, nil
 }
 
@@ -311,7 +540,7 @@ func (env *Environment) DataCommitment(ctx *rpctypes.Context, start, end uint64)
 	if err != nil {
 		return nil, err
 	}
-	tuples, err := env.fetchDataRootTuples(start, end)
+	tuples, err := env.fetchDataRootTuples(ctx.Context(), start, end)
 	if err != nil {
 		return nil, err
 	}
@@ -494,20 +723,23 @@ func (env *Environment) proveDataRootTuples(tuples []DataRootTuple, height int64
 }
 
 // fetchDataRootTuples takes an end exclusive range of heights and fetches its
-// corresponding data root tuples.
-func (env *Environment) fetchDataRootTuples(start, end uint64) ([]DataRootTuple, error) {
-
+// corresponding data root tuples. It stops when ctx is done.
+func (env *Environment) fetchDataRootTuples(ctx context.Context, start, end uint64) ([]DataRootTuple, error) {
 	tuples := make([]DataRootTuple, 0, end-start)
 	for height := start; height < end; height++ {
+		if err := checkContext(ctx); err != nil {
+			return nil, err
+		}
+		// only the header is needed, so the block itself is not decoded
 		//nolint:gosec
-		block := env.BlockStore.LoadBlock(int64(height))
-		if block == nil {
+		blockMeta := env.BlockStore.LoadBlockMeta(int64(height))
+		if blockMeta == nil {
 			return nil, fmt.Errorf("couldn't load block %d", height)
 		}
 		tuples = append(tuples, DataRootTuple{
 			//nolint:gosec
-			height:   uint64(block.Height),
-			dataRoot: *(*[32]byte)(block.DataHash),
+			height:   uint64(blockMeta.Header.Height),
+			dataRoot: *(*[32]byte)(blockMeta.Header.DataHash),
 		})
 	}
 	return tuples, nil
@@ -523,20 +755,32 @@ func (env *Environment) DataRootInclusionProof(
 	end uint64,
 ) (*ctypes.ResultDataRootInclusionProof, error) {
 	//nolint:gosec
-	proof, err := env.GenerateDataRootInclusionProof(height, start, end)
+	proof, err := env.GenerateDataRootInclusionProofWithContext(ctx.Context(), height, start, end)
 	if err != nil {
 		return nil, err
 	}
 	return &ctypes.ResultDataRootInclusionProof{Proof: *proof}, nil
 }
 
+// GenerateDataRootInclusionProof creates an inclusion proof for the data root
+// of block height `height` in the set of blocks defined by `start` and `end`.
 func (env *Environment) GenerateDataRootInclusionProof(height int64, start, end uint64) (*merkle.Proof, error) {
+	return env.GenerateDataRootInclusionProofWithContext(context.Background(), height, start, end)
+}
 
+// GenerateDataRootInclusionProofWithContext is GenerateDataRootInclusionProof,
+// reading the headers of the range until ctx is done.
+func (env *Environment) GenerateDataRootInclusionProofWithContext(
+	ctx context.Context,
+	height int64,
+	start,
+	end uint64,
+) (*merkle.Proof, error) {
 	err := env.validateDataRootInclusionProofRequest(uint64(height), start, end)
 	if err != nil {
 		return nil, err
 	}
-	tuples, err := env.fetchDataRootTuples(start, end)
+	tuples, err := env.fetchDataRootTuples(ctx, start, end)
 	if err != nil {
 		return nil, err
 	}
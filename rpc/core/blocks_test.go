@@ -6,7 +6,10 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,15 +17,20 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	cmtstore "github.com/cometbft/cometbft/proto/tendermint/store"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/proxy"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/store"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -93,9 +101,32 @@ func TestBlockResults(t *testing.T) {
 	})
 	err := env.StateStore.SaveFinalizeBlockResponse(100, results)
 	require.NoError(t, err)
+
+	// vote extensions of the commit of block 99
+	val, _ := types.RandValidator(false, 10)
+	vals := types.NewValidatorSet([]*types.Validator{val})
+	err = env.StateStore.Save(sm.State{
+		LastBlockHeight:             97,
+		LastHeightValidatorsChanged: 99,
+		Validators:                  vals,
+		NextValidators:              vals,
+		LastValidators:              vals,
+		ConsensusParams:             *types.DefaultConsensusParams(),
+	})
+	require.NoError(t, err)
+	extCommit := &types.ExtendedCommit{
+		Height: 99,
+		ExtendedSignatures: []types.ExtendedCommitSig{{
+			CommitSig:          types.CommitSig{BlockIDFlag: types.BlockIDFlagCommit, ValidatorAddress: val.Address},
+			Extension:          []byte("extension"),
+			ExtensionSignature: []byte("signature"),
+		}},
+	}
+
 	mockstore := &mocks.BlockStore{}
 	mockstore.On("Height").Return(int64(100))
 	mockstore.On("Base").Return(int64(1))
+	mockstore.On("LoadBlockExtendedCommit", int64(99)).Return(extCommit)
 	env.BlockStore = mockstore
 
 	testCases := []struct {
@@ -113,6 +144,14 @@ func TestBlockResults(t *testing.T) {
 			ValidatorUpdates:      results.ValidatorUpdates,
 			ConsensusParamUpdates: results.ConsensusParamUpdates,
 			AppHash:               make([]byte, 1),
+			ExtendedCommitInfo: &abci.ExtendedCommitInfo{
+				Votes: []abci.ExtendedVoteInfo{{
+					Validator:          types.TM2PB.Validator(val),
+					BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+					VoteExtension:      []byte("extension"),
+					ExtensionSignature: []byte("signature"),
+				}},
+			},
 		}},
 	}
 
@@ -125,6 +164,25 @@ func TestBlockResults(t *testing.T) {
 			assert.Equal(t, tc.wantRes, res)
 		}
 	}
+
+	// the events stored before the limits were set are not served
+	event := abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "sender", Value: "alice"}}}
+	results.TxResults[0].Events = []abci.Event{event, event}
+	results.TxResults[1].Events = []abci.Event{event}
+	require.NoError(t, env.StateStore.SaveFinalizeBlockResponse(100, results))
+	env.EventLimits = proxy.EventLimits{MaxEventsPerTx: 1}
+	height := int64(100)
+	res, err := env.BlockResults(&rpctypes.Context{}, &height)
+	require.NoError(t, err)
+	assert.Empty(t, res.TxsResults[0].Events)
+	assert.Equal(t, []abci.Event{event}, res.TxsResults[1].Events)
+
+	// vote extensions not matching the validators are omitted
+	env.Logger = log.NewNopLogger()
+	extCommit.ExtendedSignatures = append(extCommit.ExtendedSignatures, extCommit.ExtendedSignatures[0])
+	res, err = env.BlockResults(&rpctypes.Context{}, &height)
+	require.NoError(t, err)
+	assert.Nil(t, res.ExtendedCommitInfo)
 }
 
 func TestEncodeDataRootTuple(t *testing.T) {
@@ -414,3 +472,105 @@ func (store mockBlockStore) Close() error {
 func (store mockBlockStore) DeleteLatestBlock() error {
 	return nil
 }
+
+func TestHeightAtTime(t *testing.T) {
+	height := int64(20)
+	blocks := randomBlocks(height)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for h, block := range blocks {
+		block.Header.Time = start.Add(time.Duration(h) * time.Second)
+	}
+	env := &Environment{BlockStore: mockBlockStore{height: height, blocks: blocks}}
+
+	testCases := []struct {
+		time   time.Time
+		height int64
+	}{
+		{start.Add(time.Second), 1},
+		{start.Add(5*time.Second + time.Millisecond), 5},
+		{start.Add(6*time.Second - time.Millisecond), 5},
+		{start.Add(6 * time.Second), 6},
+		{start.Add(time.Hour), height},
+	}
+	for _, tc := range testCases {
+		res, err := env.HeightAtTime(&rpctypes.Context{}, tc.time)
+		require.NoError(t, err, tc.time)
+		assert.Equal(t, tc.height, res.Height, tc.time)
+		assert.Equal(t, blocks[tc.height].Header.Time, res.Time)
+	}
+
+	_, err := env.HeightAtTime(&rpctypes.Context{}, start)
+	require.Error(t, err)
+
+	h, err := env.heightAtTime(start.Add(7 * time.Second))
+	require.NoError(t, err)
+	assert.EqualValues(t, 7, h)
+}
+
+func TestCanceledRequest(t *testing.T) {
+	height := int64(100)
+	env := &Environment{
+		BlockStore: mockBlockStore{height: height, blocks: randomBlocks(height)},
+		Logger:     log.NewNopLogger(),
+		BlockIndexer: mockBlockIndexer{
+			height:          height,
+			beginQueryBlock: 1,
+			endQueryBlock:   10,
+		},
+	}
+
+	reqCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ctx := &rpctypes.Context{HTTPReq: httptest.NewRequest(http.MethodGet, "/", nil).WithContext(reqCtx)}
+
+	_, err := env.BlockchainInfo(ctx, 1, 10)
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = env.BlockSearch(ctx, "block.height > 0", nil, nil, "")
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = env.DataCommitment(ctx, 1, 10)
+	require.ErrorIs(t, err, context.Canceled)
+
+	// the same requests succeed without being canceled
+	_, err = env.BlockchainInfo(&rpctypes.Context{}, 1, 10)
+	require.NoError(t, err)
+	_, err = env.DataCommitment(&rpctypes.Context{}, 1, 10)
+	require.NoError(t, err)
+}
+
+// BenchmarkBlock measures serving /block for a large block, compared to
+// decoding the block with BlockStore.LoadBlock as /block used to.
+func BenchmarkBlock(b *testing.B) {
+	bs := store.NewBlockStore(dbm.NewMemDB())
+	txs := make(types.Txs, 4096)
+	for i := range txs {
+		txs[i] = cmtrand.Bytes(1024)
+	}
+	block := types.MakeBlock(1, types.Data{Txs: txs}, &types.Commit{}, nil)
+	block.ProposerAddress = cmtrand.Bytes(crypto.AddressSize)
+	ps, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(b, err)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: ps.Header()}
+	bs.SaveBlock(block, ps, &types.Commit{Height: 1, BlockID: blockID})
+	env := &Environment{BlockStore: bs}
+	height := int64(1)
+
+	b.Run("LoadBlock", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			res := &ctypes.ResultBlock{BlockID: bs.LoadBlockMeta(height).BlockID, Block: bs.LoadBlock(height)}
+			_, err := cmtjson.Marshal(res)
+			require.NoError(b, err)
+		}
+	})
+	b.Run("Block", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			res, err := env.Block(&rpctypes.Context{}, &height)
+			require.NoError(b, err)
+			_, err = cmtjson.Marshal(res)
+			require.NoError(b, err)
+		}
+	})
+}
@@ -1,13 +1,36 @@
 package core
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	cm "github.com/cometbft/cometbft/consensus"
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
+	"github.com/cometbft/cometbft/p2p"
+	cmtcons "github.com/cometbft/cometbft/proto/tendermint/consensus"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	"github.com/cometbft/cometbft/types"
 )
 
+// Sections of the consensus state selectable in DumpConsensusStateV2.
+const (
+	ConsensusSectionRoundState = "round_state"
+	ConsensusSectionVotes      = "votes"
+	ConsensusSectionPeers      = "peers"
+)
+
+// Encodings of DumpConsensusStateV2 responses.
+const (
+	ConsensusEncodingJSON  = "json"
+	ConsensusEncodingProto = "proto"
+)
+
 // Validators gets the validator set at the given block height.
 //
 // If no height is provided, it will fetch the latest validator set. Note the
@@ -50,6 +73,21 @@ func (env *Environment) Validators(
 	}, nil
 }
 
+// ValidatorsAtTime gets the validator set of the last block at or before the
+// given time, so that it can be queried as of that time.
+// More: https://docs.cometbft.com/v0.38.x/rpc/#/Info/validators_at_time
+func (env *Environment) ValidatorsAtTime(
+	ctx *rpctypes.Context,
+	t time.Time,
+	pagePtr, perPagePtr *int,
+) (*ctypes.ResultValidators, error) {
+	height, err := env.heightAtTime(t)
+	if err != nil {
+		return nil, err
+	}
+	return env.Validators(ctx, &height, pagePtr, perPagePtr)
+}
+
 // DumpConsensusState dumps consensus state.
 // UNSTABLE
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Info/dump_consensus_state
@@ -58,7 +96,7 @@ func (env *Environment) DumpConsensusState(*rpctypes.Context) (*ctypes.ResultDum
 	peers := env.P2PPeers.Peers().List()
 	peerStates := make([]ctypes.PeerStateInfo, len(peers))
 	for i, peer := range peers {
-		peerState, ok := peer.Get(types.PeerStateKey).(*cm.PeerState)
+		peerState, ok := cm.GetPeerState(peer)
 		if !ok { // peer does not have a state yet
 			continue
 		}
@@ -84,6 +122,207 @@ func (env *Environment) DumpConsensusState(*rpctypes.Context) (*ctypes.ResultDum
 	}, nil
 }
 
+// DumpConsensusStateV2 dumps the selected sections of the consensus state.
+// sections is a comma separated list of round_state, votes and peers, all of
+// them being dumped if empty. Peer states are paginated, as they make up most
+// of the dump on well connected nodes. If encoding is "proto", the sections
+// are returned as a binary consensus.StateDump message instead of JSON.
+// UNSTABLE
+func (env *Environment) DumpConsensusStateV2(
+	_ *rpctypes.Context,
+	sections string,
+	pagePtr, perPagePtr *int,
+	encoding string,
+) (*ctypes.ResultDumpConsensusStateV2, error) {
+	selected, err := parseConsensusSections(sections)
+	if err != nil {
+		return nil, err
+	}
+	if encoding != "" && encoding != ConsensusEncodingJSON && encoding != ConsensusEncodingProto {
+		return nil, fmt.Errorf("unknown encoding %q, expected %q or %q",
+			encoding, ConsensusEncodingJSON, ConsensusEncodingProto)
+	}
+
+	// Sort peers by ID so that pages are stable.
+	peers := env.P2PPeers.Peers().List()
+	sort.Slice(peers, func(i, j int) bool { return peers[i].ID() < peers[j].ID() })
+	totalPeers := len(peers)
+	if selected[ConsensusSectionPeers] {
+		perPage := env.validatePerPage(perPagePtr)
+		page, err := validatePage(pagePtr, perPage, totalPeers)
+		if err != nil {
+			return nil, err
+		}
+		skipCount := validateSkipCount(page, perPage)
+		peers = peers[skipCount : skipCount+cmtmath.MinInt(perPage, totalPeers-skipCount)]
+	} else {
+		peers = nil
+	}
+
+	var rs *cstypes.RoundState
+	if selected[ConsensusSectionRoundState] || selected[ConsensusSectionVotes] {
+		rs = env.ConsensusState.GetRoundState()
+	}
+
+	if encoding == ConsensusEncodingProto {
+		dump, err := consensusStateDumpProto(rs, selected, peers)
+		if err != nil {
+			return nil, err
+		}
+		dump.TotalPeers = int64(totalPeers)
+		bz, err := dump.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		return &ctypes.ResultDumpConsensusStateV2{TotalPeers: totalPeers, Proto: bz}, nil
+	}
+
+	result := &ctypes.ResultDumpConsensusStateV2{TotalPeers: totalPeers}
+	if selected[ConsensusSectionRoundState] {
+		withoutVotes := *rs
+		withoutVotes.Votes, withoutVotes.LastCommit = nil, nil
+		if result.RoundState, err = cmtjson.Marshal(withoutVotes); err != nil {
+			return nil, err
+		}
+	}
+	if selected[ConsensusSectionVotes] {
+		votes := struct {
+			Votes      *cstypes.HeightVoteSet `json:"votes"`
+			LastCommit *types.VoteSet         `json:"last_commit"`
+		}{rs.Votes, rs.LastCommit}
+		if result.Votes, err = cmtjson.Marshal(votes); err != nil {
+			return nil, err
+		}
+	}
+	for _, peer := range peers {
+		peerState, ok := cm.GetPeerState(peer)
+		if !ok { // peer does not have a state yet
+			continue
+		}
+		peerStateJSON, err := peerState.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result.Peers = append(result.Peers, ctypes.PeerStateInfo{
+			NodeAddress: peer.SocketAddr().String(),
+			PeerState:   peerStateJSON,
+		})
+	}
+	return result, nil
+}
+
+func parseConsensusSections(sections string) (map[string]bool, error) {
+	selected := make(map[string]bool)
+	for _, section := range strings.Split(sections, ",") {
+		section = strings.TrimSpace(section)
+		switch section {
+		case "":
+		case ConsensusSectionRoundState, ConsensusSectionVotes, ConsensusSectionPeers:
+			selected[section] = true
+		default:
+			return nil, fmt.Errorf("unknown section %q", section)
+		}
+	}
+	if len(selected) == 0 {
+		selected[ConsensusSectionRoundState] = true
+		selected[ConsensusSectionVotes] = true
+		selected[ConsensusSectionPeers] = true
+	}
+	return selected, nil
+}
+
+func consensusStateDumpProto(
+	rs *cstypes.RoundState,
+	selected map[string]bool,
+	peers []p2p.Peer,
+) (*cmtcons.StateDump, error) {
+	dump := new(cmtcons.StateDump)
+
+	if selected[ConsensusSectionRoundState] {
+		rsd := &cmtcons.RoundStateDump{
+			Height:                    rs.Height,
+			Round:                     rs.Round,
+			Step:                      uint32(rs.Step),
+			StartTime:                 rs.StartTime,
+			CommitTime:                rs.CommitTime,
+			ProposalBlockHash:         rs.ProposalBlock.Hash(),
+			LockedRound:               rs.LockedRound,
+			LockedBlockHash:           rs.LockedBlock.Hash(),
+			ValidRound:                rs.ValidRound,
+			ValidBlockHash:            rs.ValidBlock.Hash(),
+			CommitRound:               rs.CommitRound,
+			TriggeredTimeoutPrecommit: rs.TriggeredTimeoutPrecommit,
+		}
+		if rs.Proposal != nil {
+			rsd.Proposal = rs.Proposal.ToProto()
+		}
+		var err error
+		if rs.Validators != nil {
+			if rsd.Validators, err = rs.Validators.ToProto(); err != nil {
+				return nil, err
+			}
+		}
+		if rs.LastValidators != nil {
+			if rsd.LastValidators, err = rs.LastValidators.ToProto(); err != nil {
+				return nil, err
+			}
+		}
+		dump.RoundState = rsd
+	}
+
+	if selected[ConsensusSectionVotes] {
+		if rs.Votes != nil {
+			for round := int32(0); round <= rs.Votes.Round(); round++ {
+				dump.Votes = append(dump.Votes, &cmtcons.RoundVotes{
+					Round:      round,
+					Prevotes:   votesToProto(rs.Votes.Prevotes(round)),
+					Precommits: votesToProto(rs.Votes.Precommits(round)),
+				})
+			}
+		}
+		dump.LastCommit = votesToProto(rs.LastCommit)
+	}
+
+	for _, peer := range peers {
+		peerState, ok := cm.GetPeerState(peer)
+		if !ok { // peer does not have a state yet
+			continue
+		}
+		prs := peerState.GetRoundState()
+		dump.Peers = append(dump.Peers, &cmtcons.PeerStateDump{
+			NodeId:                     string(peer.ID()),
+			NodeAddress:                peer.SocketAddr().String(),
+			Height:                     prs.Height,
+			Round:                      prs.Round,
+			Step:                       uint32(prs.Step),
+			StartTime:                  prs.StartTime,
+			Proposal:                   prs.Proposal,
+			ProposalBlockPartSetHeader: prs.ProposalBlockPartSetHeader.ToProto(),
+			ProposalBlockParts:         prs.ProposalBlockParts.ToProto(),
+			ProposalPolRound:           prs.ProposalPOLRound,
+			ProposalPol:                prs.ProposalPOL.ToProto(),
+			Prevotes:                   prs.Prevotes.ToProto(),
+			Precommits:                 prs.Precommits.ToProto(),
+			LastCommitRound:            prs.LastCommitRound,
+			LastCommit:                 prs.LastCommit.ToProto(),
+			CatchupCommitRound:         prs.CatchupCommitRound,
+			CatchupCommit:              prs.CatchupCommit.ToProto(),
+		})
+	}
+	return dump, nil
+}
+
+// votesToProto returns the votes of voteSet, which may be nil.
+func votesToProto(voteSet *types.VoteSet) []*cmtproto.Vote {
+	var votes []*cmtproto.Vote
+	for i := 0; i < voteSet.Size(); i++ {
+		if vote := voteSet.GetByIndex(int32(i)); vote != nil {
+			votes = append(votes, vote.ToProto())
+		}
+	}
+	return votes
+}
+
 // ConsensusState returns a concise summary of the consensus state.
 // UNSTABLE
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Info/consensus_state
@@ -116,3 +355,17 @@ func (env *Environment) ConsensusParams(
 		ConsensusParams: consensusParams,
 	}, nil
 }
+
+// ConsensusParamsAtTime gets the consensus parameters of the last block at or
+// before the given time, so that they can be queried as of that time.
+// More: https://docs.cometbft.com/v0.38.x/rpc/#/Info/consensus_params_at_time
+func (env *Environment) ConsensusParamsAtTime(
+	ctx *rpctypes.Context,
+	t time.Time,
+) (*ctypes.ResultConsensusParams, error) {
+	height, err := env.heightAtTime(t)
+	if err != nil {
+		return nil, err
+	}
+	return env.ConsensusParams(ctx, &height)
+}
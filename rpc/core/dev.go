@@ -1,12 +1,158 @@
 package core
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 )
 
+// blockResultsCompareTimeout is the timeout, in seconds, of the requests to
+// the peer compared with.
+const blockResultsCompareTimeout = 10
+
 // UnsafeFlushMempool removes all transactions from the mempool.
 func (env *Environment) UnsafeFlushMempool(*rpctypes.Context) (*ctypes.ResultUnsafeFlushMempool, error) {
 	env.Mempool.Flush()
 	return &ctypes.ResultUnsafeFlushMempool{}, nil
 }
+
+// UnsafeBlockResultsCompare fetches the results of the block at the given
+// height from the RPC of another node, and returns how they differ from the
+// results of this node, to debug app hash mismatches. The logs and infos of
+// the transaction results are not compared, as they are not deterministic.
+func (env *Environment) UnsafeBlockResultsCompare(
+	ctx *rpctypes.Context,
+	heightPtr *int64,
+	peer string,
+) (*ctypes.ResultBlockResultsCompare, error) {
+	if peer == "" {
+		return nil, errors.New("no peer RPC address given")
+	}
+
+	local, err := env.BlockResults(ctx, heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := rpchttp.NewWithTimeout(peer, "/websocket", blockResultsCompareTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid peer RPC address %q: %w", peer, err)
+	}
+	remote, err := c.BlockResults(ctx.Context(), &local.Height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the block results from %s: %w", peer, err)
+	}
+
+	diffs, err := diffBlockResults(local, remote)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultBlockResultsCompare{
+		Height: local.Height,
+		Peer:   peer,
+		Diffs:  diffs,
+	}, nil
+}
+
+// blockResultsDiffer collects the fields whose JSON encoding differs.
+type blockResultsDiffer struct {
+	diffs []ctypes.BlockResultsDiff
+	err   error
+}
+
+func (d *blockResultsDiffer) value(field string, local, peer interface{}) {
+	if d.err != nil {
+		return
+	}
+	lbz, err := cmtjson.Marshal(local)
+	if err != nil {
+		d.err = err
+		return
+	}
+	pbz, err := cmtjson.Marshal(peer)
+	if err != nil {
+		d.err = err
+		return
+	}
+	if !bytes.Equal(lbz, pbz) {
+		d.diffs = append(d.diffs, ctypes.BlockResultsDiff{Field: field, Local: lbz, Peer: pbz})
+	}
+}
+
+// list compares the elements of two lists of lengths nLocal and nPeer with
+// compare, or as a whole when one of the lists is missing the element.
+func (d *blockResultsDiffer) list(
+	field string,
+	nLocal, nPeer int,
+	elem func(i int, local bool) interface{},
+	compare func(field string, i int),
+) {
+	for i := 0; i < cmtmath.MaxInt(nLocal, nPeer); i++ {
+		field := fmt.Sprintf("%s[%d]", field, i)
+		switch {
+		case i >= nLocal:
+			d.value(field, nil, elem(i, false))
+		case i >= nPeer:
+			d.value(field, elem(i, true), nil)
+		default:
+			compare(field, i)
+		}
+	}
+}
+
+func (d *blockResultsDiffer) events(field string, local, peer []abci.Event) {
+	d.list(field, len(local), len(peer),
+		func(i int, isLocal bool) interface{} {
+			if isLocal {
+				return local[i]
+			}
+			return peer[i]
+		},
+		func(field string, i int) { d.value(field, local[i], peer[i]) },
+	)
+}
+
+// diffBlockResults returns the fields of the block results differing between
+// this node and a peer.
+func diffBlockResults(local, peer *ctypes.ResultBlockResults) ([]ctypes.BlockResultsDiff, error) {
+	d := &blockResultsDiffer{}
+
+	d.value("app_hash", local.AppHash, peer.AppHash)
+	d.list("txs_results", len(local.TxsResults), len(peer.TxsResults),
+		func(i int, isLocal bool) interface{} {
+			if isLocal {
+				return local.TxsResults[i]
+			}
+			return peer.TxsResults[i]
+		},
+		func(field string, i int) {
+			l, p := local.TxsResults[i], peer.TxsResults[i]
+			d.value(field+".code", l.Code, p.Code)
+			d.value(field+".codespace", l.Codespace, p.Codespace)
+			d.value(field+".data", l.Data, p.Data)
+			d.value(field+".gas_wanted", l.GasWanted, p.GasWanted)
+			d.value(field+".gas_used", l.GasUsed, p.GasUsed)
+			d.events(field+".events", l.Events, p.Events)
+		},
+	)
+	d.events("finalize_block_events", local.FinalizeBlockEvents, peer.FinalizeBlockEvents)
+	d.list("validator_updates", len(local.ValidatorUpdates), len(peer.ValidatorUpdates),
+		func(i int, isLocal bool) interface{} {
+			if isLocal {
+				return local.ValidatorUpdates[i]
+			}
+			return peer.ValidatorUpdates[i]
+		},
+		func(field string, i int) { d.value(field, local.ValidatorUpdates[i], peer.ValidatorUpdates[i]) },
+	)
+	d.value("consensus_param_updates", local.ConsensusParamUpdates, peer.ConsensusParamUpdates)
+
+	return d.diffs, d.err
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+func TestDiffBlockResults(t *testing.T) {
+	local := &ctypes.ResultBlockResults{
+		Height: 10,
+		TxsResults: []*abci.ExecTxResult{
+			{Code: 0, GasUsed: 10, Log: "local", Events: []abci.Event{{Type: "transfer"}}},
+			{Code: 1},
+		},
+		FinalizeBlockEvents: []abci.Event{{Type: "begin"}},
+		AppHash:             []byte{1},
+	}
+	peer := &ctypes.ResultBlockResults{
+		Height: 10,
+		TxsResults: []*abci.ExecTxResult{
+			{Code: 0, GasUsed: 11, Log: "peer", Events: []abci.Event{{Type: "transfer"}, {Type: "fee"}}},
+		},
+		FinalizeBlockEvents: []abci.Event{{Type: "begin"}},
+		ValidatorUpdates:    []abci.ValidatorUpdate{{Power: 10}},
+		AppHash:             []byte{2},
+	}
+
+	diffs, err := diffBlockResults(local, local)
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+
+	diffs, err = diffBlockResults(local, peer)
+	require.NoError(t, err)
+	fields := make([]string, len(diffs))
+	for i, diff := range diffs {
+		fields[i] = diff.Field
+	}
+	// the logs are not compared
+	assert.Equal(t, []string{
+		"app_hash",
+		"txs_results[0].gas_used",
+		"txs_results[0].events[1]",
+		"txs_results[1]",
+		"validator_updates[0]",
+	}, fields)
+	assert.Equal(t, `"10"`, string(diffs[1].Local))
+	assert.Equal(t, `"11"`, string(diffs[1].Peer))
+	assert.Equal(t, "null", string(diffs[2].Local))
+	assert.Equal(t, "null", string(diffs[3].Peer))
+}
@@ -1,20 +1,25 @@
 package core
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"time"
 
 	cfg "github.com/cometbft/cometbft/config"
+	cstypes "github.com/cometbft/cometbft/consensus/types"
 	"github.com/cometbft/cometbft/crypto"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	mempl "github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/pex"
 	"github.com/cometbft/cometbft/proxy"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/store"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -37,12 +42,55 @@ const (
 
 type Consensus interface {
 	GetState() sm.State
+	GetRoundState() *cstypes.RoundState
 	GetValidators() (int64, []*types.Validator)
 	GetLastHeight() int64
 	GetRoundStateJSON() ([]byte, error)
 	GetRoundStateSimpleJSON() ([]byte, error)
 }
 
+// ownPerformanceReporter is implemented by consensus states counting the
+// proposals and votes of the validator which were not sent on time.
+type ownPerformanceReporter interface {
+	OwnPerformance() cstypes.OwnPerformance
+}
+
+// clockSkewReporter is implemented by switches estimating the skew of the
+// local clock relative to the peers.
+type clockSkewReporter interface {
+	ClockSkew() (time.Duration, bool)
+}
+
+// messageStatsReporter is implemented by peers counting the messages
+// exchanged with them.
+type messageStatsReporter interface {
+	MessageStats() p2p.PeerMessageStats
+}
+
+// peerScorerReporter is implemented by switches scoring the peers.
+type peerScorerReporter interface {
+	PeerScorer() *p2p.PeerScorer
+}
+
+// subnetFilterer is implemented by transports filtering the inbound
+// connections by subnet.
+type subnetFilterer interface {
+	SubnetFilter() *p2p.SubnetFilter
+}
+
+// peerBanner is implemented by transports keeping the peers banned from the
+// address book.
+type peerBanner interface {
+	PeerBans() []pex.Ban
+	UnbanPeer(p2p.ID) bool
+}
+
+// seedStatusReporter is implemented by transports keeping the statuses
+// reported by the seeds.
+type seedStatusReporter interface {
+	LatestSeedStatus() *pex.SeedStatus
+}
+
 type transport interface {
 	Listeners() []string
 	IsListening() bool
@@ -61,6 +109,45 @@ type consensusReactor interface {
 	WaitSync() bool
 }
 
+// safeHeightReporter is implemented by data availability samplers, reporting
+// the height up to which the data of the committed blocks was sampled.
+type safeHeightReporter interface {
+	SafeHeight() int64
+}
+
+// blockRelayFollower is implemented by the block relay reactor, reporting
+// whether the node follows the chain through relayed blocks.
+type blockRelayFollower interface {
+	Following() bool
+}
+
+// lifecycleReporter reports the phase of the lifecycle of the node and the
+// transitions which led to it.
+type lifecycleReporter interface {
+	Phase() types.NodePhase
+	Transitions() []types.EventDataNodePhase
+}
+
+type storageCollector interface {
+	Stats() []store.StorageStats
+}
+
+// TxProofProvider creates proofs of inclusion of committed transactions,
+// returned by /tx and /tx_search when prove is set. The proofs are share range
+// proofs against the data root (Header.DataHash) of the transaction's block.
+type TxProofProvider interface {
+	ProveTx(height int64, index uint32) (types.ShareProof, error)
+}
+
+// TxBroadcaster submits transactions to another node, typically through its
+// RPC, e.g. an rpc/client/http client.
+type TxBroadcaster interface {
+	BroadcastTxAsync(ctx context.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error)
+	BroadcastTxSync(ctx context.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error)
+	BroadcastTxBatch(ctx context.Context, txs types.Txs) (*ctypes.ResultBroadcastTxBatch, error)
+	BroadcastTxCommit(ctx context.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error)
+}
+
 // ----------------------------------------------
 // Environment contains objects and interfaces used by the RPC. It is expected
 // to be setup once during startup.
@@ -86,12 +173,47 @@ type Environment struct {
 	EventBus     *types.EventBus // thread safe
 	Mempool      mempl.Mempool
 
+	// EventLimits bound the events of the stored FinalizeBlock responses
+	// served, which may predate the limits.
+	EventLimits proxy.EventLimits
+
+	// StorageCollector is nil if storage metrics are disabled.
+	StorageCollector storageCollector
+
+	// TxProofProvider defaults to querying the application if nil.
+	TxProofProvider TxProofProvider
+
+	// DASampling is nil if no data availability sampler is registered, in
+	// which case all the committed blocks are safe.
+	DASampling safeHeightReporter
+
+	// Lifecycle is nil if the node doesn't report its phase.
+	Lifecycle lifecycleReporter
+
+	// BlockRelay is nil if the node doesn't follow relayed blocks, in which
+	// case it's catching up until it switches to consensus.
+	BlockRelay blockRelayFollower
+
+	// TxBroadcasters, if set, receive the transactions of the broadcast_tx
+	// RPCs in place of the mempool, tried in order until one of them can be
+	// reached.
+	TxBroadcasters []TxBroadcaster
+
 	Logger log.Logger
 
 	Config cfg.RPCConfig
 
 	// cache of chunked genesis data.
 	genChunks []string
+
+	// nil if subscription authentication is disabled.
+	subAuth *subscriptionAuth
+
+	// nil if /abci_query requests are not routed.
+	queryRouter *queryRouter
+
+	// nil if the request IDs of /broadcast_tx_* requests are ignored.
+	txRequests *txRequests
 }
 
 //----------------------------------------------
@@ -160,6 +282,16 @@ func (env *Environment) InitGenesisChunks() error {
 	return nil
 }
 
+// checkContext returns an error if the request was canceled or timed out, so
+// that handlers stop reading from the stores for clients which won't get the
+// response. Handlers reading more than a few entries call it between reads.
+func checkContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("request terminated: %w", err)
+	}
+	return nil
+}
+
 func validateSkipCount(page, perPage int) int {
 	skipCount := (page - 1) * perPage
 	if skipCount < 0 {
@@ -10,24 +10,75 @@ import (
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
 )
 
 const (
 	// maxQueryLength is the maximum length of a query string that will be
 	// accepted. This is just a safety check to avoid outlandish queries.
 	maxQueryLength = 512
+
+	// maxBatchDelay is the maximum delay of the events of a batched
+	// subscription.
+	maxBatchDelay = 10 * time.Second
 )
 
 // Subscribe for events via WebSocket.
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Websocket/subscribe
 func (env *Environment) Subscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, error) {
+	sub, err := env.subscribe(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	go env.writeEvents(ctx, sub, query, 0, 0)
+	return &ctypes.ResultSubscribe{}, nil
+}
+
+// SubscribeBatched for events via WebSocket, delivered in batches to reduce
+// the overhead of the websocket frames for frequent events. A batch is
+// written once it holds maxEvents events, or maxDelayMs milliseconds after
+// its first event.
+func (env *Environment) SubscribeBatched(
+	ctx *rpctypes.Context,
+	query string,
+	maxEvents int,
+	maxDelayMs int64,
+) (*ctypes.ResultSubscribe, error) {
+	if maxEvents <= 0 {
+		return nil, errors.New("max_events must be positive")
+	}
+	if maxEvents > env.Config.SubscriptionBufferSize {
+		return nil, fmt.Errorf("max_events can't exceed experimental_subscription_buffer_size (%d)",
+			env.Config.SubscriptionBufferSize)
+	}
+	maxDelay := time.Duration(maxDelayMs) * time.Millisecond
+	if maxDelay <= 0 || maxDelay > maxBatchDelay {
+		return nil, fmt.Errorf("max_delay_ms must be between 1 and %d", maxBatchDelay.Milliseconds())
+	}
+
+	sub, err := env.subscribe(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	go env.writeEvents(ctx, sub, query, maxEvents, maxDelay)
+	return &ctypes.ResultSubscribe{}, nil
+}
+
+// subscribe subscribes the websocket client of ctx to query, enforcing the
+// subscription limits.
+func (env *Environment) subscribe(ctx *rpctypes.Context, query string) (types.Subscription, error) {
 	addr := ctx.RemoteAddr()
 
-	if env.EventBus.NumClients() >= env.Config.MaxSubscriptionClients {
+	if id, available, ok := env.subscriptionQuota(addr); ok {
+		if available <= 0 {
+			return nil, fmt.Errorf("subscription quota of %s reached", id)
+		}
+	} else if env.EventBus.NumClients() >= env.Config.MaxSubscriptionClients {
 		return nil, fmt.Errorf("max_subscription_clients %d reached", env.Config.MaxSubscriptionClients)
 	} else if env.EventBus.NumClientSubscriptions(addr) >= env.Config.MaxSubscriptionsPerClient {
 		return nil, fmt.Errorf("max_subscriptions_per_client %d reached", env.Config.MaxSubscriptionsPerClient)
-	} else if len(query) > maxQueryLength {
+	}
+	if len(query) > maxQueryLength {
 		return nil, errors.New("maximum query length exceeded")
 	}
 
@@ -41,64 +92,110 @@ func (env *Environment) Subscribe(ctx *rpctypes.Context, query string) (*ctypes.
 	subCtx, cancel := context.WithTimeout(ctx.Context(), SubscribeTimeout)
 	defer cancel()
 
-	sub, err := env.EventBus.Subscribe(subCtx, addr, q, env.Config.SubscriptionBufferSize)
-	if err != nil {
-		return nil, err
-	}
+	return env.EventBus.Subscribe(subCtx, addr, q, env.Config.SubscriptionBufferSize)
+}
+
+// writeEvents writes the events of sub to the websocket client of ctx until
+// the subscription is canceled. If maxEvents is positive, the events are
+// written in batches of up to maxEvents events, each written at most maxDelay
+// after its first event.
+func (env *Environment) writeEvents(
+	ctx *rpctypes.Context,
+	sub types.Subscription,
+	query string,
+	maxEvents int,
+	maxDelay time.Duration,
+) {
+	var (
+		addr        = ctx.RemoteAddr()
+		closeIfSlow = env.Config.CloseOnSlowClient
+		// Capture the current ID, since it can change in the future.
+		subscriptionID = ctx.JSONReq.ID
 
-	closeIfSlow := env.Config.CloseOnSlowClient
+		batch      []ctypes.ResultEvent
+		batchTimer *time.Timer
+		batchDue   <-chan time.Time
+	)
 
-	// Capture the current ID, since it can change in the future.
-	subscriptionID := ctx.JSONReq.ID
-	go func() {
-		for {
-			select {
-			case msg := <-sub.Out():
+	// write writes result, returning false if the subscription was canceled
+	// because the client is slow.
+	write := func(result interface{}) bool {
+		resp := rpctypes.NewRPCSuccessResponse(subscriptionID, result)
+		writeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := ctx.WSConn.WriteRPCResponse(writeCtx, resp); err != nil {
+			env.Logger.Info("Can't write response (slow client)",
+				"to", addr, "subscriptionID", subscriptionID, "err", err)
+
+			if closeIfSlow {
 				var (
-					resultEvent = &ctypes.ResultEvent{Query: query, Data: msg.Data(), Events: msg.Events()}
-					resp        = rpctypes.NewRPCSuccessResponse(subscriptionID, resultEvent)
+					err  = errors.New("subscription was canceled (reason: slow client)")
+					resp = rpctypes.RPCServerError(subscriptionID, err)
 				)
-				writeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-				defer cancel()
-				if err := ctx.WSConn.WriteRPCResponse(writeCtx, resp); err != nil {
+				if !ctx.WSConn.TryWriteRPCResponse(resp) {
 					env.Logger.Info("Can't write response (slow client)",
 						"to", addr, "subscriptionID", subscriptionID, "err", err)
-
-					if closeIfSlow {
-						var (
-							err  = errors.New("subscription was canceled (reason: slow client)")
-							resp = rpctypes.RPCServerError(subscriptionID, err)
-						)
-						if !ctx.WSConn.TryWriteRPCResponse(resp) {
-							env.Logger.Info("Can't write response (slow client)",
-								"to", addr, "subscriptionID", subscriptionID, "err", err)
-						}
-						return
-					}
 				}
-			case <-sub.Canceled():
-				if sub.Err() != cmtpubsub.ErrUnsubscribed {
-					var reason string
-					if sub.Err() == nil {
-						reason = "CometBFT exited"
-					} else {
-						reason = sub.Err().Error()
-					}
-					var (
-						err  = fmt.Errorf("subscription was canceled (reason: %s)", reason)
-						resp = rpctypes.RPCServerError(subscriptionID, err)
-					)
-					if !ctx.WSConn.TryWriteRPCResponse(resp) {
-						env.Logger.Info("Can't write response (slow client)",
-							"to", addr, "subscriptionID", subscriptionID, "err", err)
-					}
+				return false
+			}
+		}
+		return true
+	}
+	writeBatch := func() bool {
+		if batchTimer != nil {
+			batchTimer.Stop()
+		}
+		result := &ctypes.ResultEventBatch{Query: query, Events: batch}
+		batch, batchTimer, batchDue = nil, nil, nil
+		return write(result)
+	}
+
+	for {
+		select {
+		case msg := <-sub.Out():
+			resultEvent := ctypes.ResultEvent{Query: query, Data: msg.Data(), Events: msg.Events()}
+			if maxEvents <= 0 {
+				if !write(&resultEvent) {
+					return
 				}
+				continue
+			}
+			batch = append(batch, resultEvent)
+			if len(batch) == 1 {
+				batchTimer = time.NewTimer(maxDelay)
+				batchDue = batchTimer.C
+			}
+			if len(batch) >= maxEvents && !writeBatch() {
+				return
+			}
+		case <-batchDue:
+			if !writeBatch() {
 				return
 			}
+		case <-sub.Canceled():
+			// deliver the events received before the cancellation
+			if len(batch) > 0 && !writeBatch() {
+				return
+			}
+			if sub.Err() != cmtpubsub.ErrUnsubscribed {
+				var reason string
+				if sub.Err() == nil {
+					reason = "CometBFT exited"
+				} else {
+					reason = sub.Err().Error()
+				}
+				var (
+					err  = fmt.Errorf("subscription was canceled (reason: %s)", reason)
+					resp = rpctypes.RPCServerError(subscriptionID, err)
+				)
+				if !ctx.WSConn.TryWriteRPCResponse(resp) {
+					env.Logger.Info("Can't write response (slow client)",
+						"to", addr, "subscriptionID", subscriptionID, "err", err)
+				}
+			}
+			return
 		}
-	}()
-
-	return &ctypes.ResultSubscribe{}, nil
+	}
 }
 
 // Unsubscribe from events via WebSocket.
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/log"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// recordingWSConn passes the responses written to it to a channel.
+type recordingWSConn struct {
+	testWSConn
+	responses chan rpctypes.RPCResponse
+}
+
+func (c recordingWSConn) WriteRPCResponse(_ context.Context, resp rpctypes.RPCResponse) error {
+	c.responses <- resp
+	return nil
+}
+
+func TestSubscribeBatched(t *testing.T) {
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	env := &Environment{Logger: log.TestingLogger(), EventBus: eventBus, Config: *cfg.DefaultRPCConfig()}
+
+	conn := recordingWSConn{testWSConn{"1.2.3.4:1"}, make(chan rpctypes.RPCResponse, 10)}
+	ctx := &rpctypes.Context{JSONReq: &rpctypes.RPCRequest{ID: rpctypes.JSONRPCIntID(1)}, WSConn: conn}
+
+	_, err := env.SubscribeBatched(ctx, "tm.event = 'NewRoundStep'", 0, 100)
+	require.ErrorContains(t, err, "max_events")
+	_, err = env.SubscribeBatched(ctx, "tm.event = 'NewRoundStep'", 3, 0)
+	require.ErrorContains(t, err, "max_delay_ms")
+	_, err = env.SubscribeBatched(ctx, "tm.event = 'NewRoundStep'", 3, maxBatchDelay.Milliseconds()+1)
+	require.ErrorContains(t, err, "max_delay_ms")
+
+	_, err = env.SubscribeBatched(ctx, "tm.event = 'NewRoundStep'", 3, 100)
+	require.NoError(t, err)
+
+	nextBatch := func() *ctypes.ResultEventBatch {
+		select {
+		case resp := <-conn.responses:
+			require.Nil(t, resp.Error)
+			batch := new(ctypes.ResultEventBatch)
+			require.NoError(t, cmtjson.Unmarshal(resp.Result, batch))
+			return batch
+		case <-time.After(5 * time.Second):
+			t.Fatal("no batch written")
+			return nil
+		}
+	}
+
+	// a full batch is written at once
+	for round := int32(0); round < 4; round++ {
+		require.NoError(t, eventBus.PublishEventNewRoundStep(types.EventDataRoundState{Height: 1, Round: round}))
+	}
+	batch := nextBatch()
+	assert.Equal(t, "tm.event = 'NewRoundStep'", batch.Query)
+	require.Len(t, batch.Events, 3)
+	for i, event := range batch.Events {
+		assert.Equal(t, int32(i), event.Data.(types.EventDataRoundState).Round)
+	}
+
+	// the rest is written after the delay
+	batch = nextBatch()
+	require.Len(t, batch.Events, 1)
+	assert.Equal(t, int32(3), batch.Events[0].Data.(types.EventDataRoundState).Round)
+}
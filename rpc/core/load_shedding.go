@@ -0,0 +1,45 @@
+package core
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrLoadShedding is returned by expensive queries rejected while consensus
+// is stressed. Clients should retry later or use another node.
+var ErrLoadShedding = errors.New("consensus is under stress, expensive queries are temporarily rejected")
+
+// sheddingLoad returns true if expensive queries should be rejected to
+// protect validator duties, i.e. if consensus has been working on the current
+// height for longer than LoadSheddingConsensusLatency or reached
+// LoadSheddingRound.
+func (env *Environment) sheddingLoad() bool {
+	if !env.Config.IsLoadSheddingEnabled() || env.ConsensusState == nil {
+		return false
+	}
+	// don't shed load while catching up, consensus is not running yet
+	if env.ConsensusReactor != nil && env.ConsensusReactor.WaitSync() {
+		return false
+	}
+
+	rs := env.ConsensusState.GetRoundState()
+	if env.Config.LoadSheddingRound > 0 && rs.Round >= env.Config.LoadSheddingRound {
+		return true
+	}
+	// StartTime is in the future while waiting for timeout_commit.
+	latency := env.Config.LoadSheddingConsensusLatency
+	return latency > 0 && !rs.StartTime.IsZero() && time.Since(rs.StartTime) > latency
+}
+
+// shedBlockResults returns ErrLoadShedding if the results of the block at
+// height are too large to be served while shedding load.
+func (env *Environment) shedBlockResults(height int64) error {
+	if !env.sheddingLoad() {
+		return nil
+	}
+	meta := env.BlockStore.LoadBlockMeta(height)
+	if meta == nil || meta.NumTxs > env.Config.LoadSheddingMaxBlockResultsTxs {
+		return ErrLoadShedding
+	}
+	return nil
+}
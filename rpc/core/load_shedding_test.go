@@ -0,0 +1,91 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+// roundStateConsensus implements Consensus, returning a fixed round state.
+type roundStateConsensus struct {
+	Consensus
+	rs cstypes.RoundState
+}
+
+func (c *roundStateConsensus) GetRoundState() *cstypes.RoundState {
+	rs := c.rs
+	return &rs
+}
+
+func TestSheddingLoad(t *testing.T) {
+	testCases := []struct {
+		name     string
+		latency  time.Duration
+		round    int32
+		rs       cstypes.RoundState
+		wantShed bool
+	}{
+		{"disabled", 0, 0, cstypes.RoundState{Round: 10, StartTime: time.Now().Add(-time.Hour)}, false},
+		{"fast height", time.Second, 0, cstypes.RoundState{StartTime: time.Now()}, false},
+		{"waiting for timeout_commit", time.Second, 0, cstypes.RoundState{StartTime: time.Now().Add(time.Second)}, false},
+		{"slow height", time.Second, 0, cstypes.RoundState{StartTime: time.Now().Add(-time.Minute)}, true},
+		{"low round", 0, 2, cstypes.RoundState{Round: 1}, false},
+		{"high round", 0, 2, cstypes.RoundState{Round: 2}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			env := &Environment{ConsensusState: &roundStateConsensus{rs: tc.rs}}
+			env.Config.LoadSheddingConsensusLatency = tc.latency
+			env.Config.LoadSheddingRound = tc.round
+			assert.Equal(t, tc.wantShed, env.sheddingLoad())
+		})
+	}
+}
+
+func TestBlockResultsLoadShedding(t *testing.T) {
+	env := &Environment{
+		Config:         *cfg.DefaultRPCConfig(),
+		ConsensusState: &roundStateConsensus{rs: cstypes.RoundState{Round: 5}},
+	}
+	env.Config.LoadSheddingRound = 5
+	env.Config.LoadSheddingMaxBlockResultsTxs = 2
+
+	env.StateStore = sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	for _, height := range []int64{1, 2} {
+		err := env.StateStore.SaveFinalizeBlockResponse(height, &abci.ResponseFinalizeBlock{})
+		require.NoError(t, err)
+	}
+	mockstore := &mocks.BlockStore{}
+	mockstore.On("Height").Return(int64(2))
+	mockstore.On("Base").Return(int64(1))
+	mockstore.On("LoadBlockMeta", int64(1)).Return(&types.BlockMeta{NumTxs: 2})
+	mockstore.On("LoadBlockMeta", int64(2)).Return(&types.BlockMeta{NumTxs: 3})
+	mockstore.On("LoadBlockExtendedCommit", int64(1)).Return(nil)
+	env.BlockStore = mockstore
+
+	height := int64(1)
+	_, err := env.BlockResults(&rpctypes.Context{}, &height)
+	require.NoError(t, err)
+
+	height = 2
+	_, err = env.BlockResults(&rpctypes.Context{}, &height)
+	require.ErrorIs(t, err, ErrLoadShedding)
+
+	// large blocks are served again once consensus recovers
+	env.ConsensusState = &roundStateConsensus{}
+	_, err = env.BlockResults(&rpctypes.Context{}, &height)
+	require.NoError(t, err)
+}
@@ -16,10 +16,29 @@ import (
 //-----------------------------------------------------------------------------
 // NOTE: tx should be signed, but this is only checked at the app level (not by CometBFT!)
 
+// BroadcastTxAsyncWithRequestID is BroadcastTxAsync, except that the retries
+// of a request with the same requestID, if set, get the result of the first
+// one within tx_request_id_window (same for the other WithRequestID variants).
+func (env *Environment) BroadcastTxAsyncWithRequestID(
+	ctx *rpctypes.Context,
+	tx types.Tx,
+	requestID string,
+) (*ctypes.ResultBroadcastTx, error) {
+	return withRequestID(env, ctx, "broadcast_tx_async", requestID, tx, env.BroadcastTxAsync)
+}
+
 // BroadcastTxAsync returns right away, with no response. Does not wait for
 // CheckTx nor transaction results.
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Tx/broadcast_tx_async
-func (env *Environment) BroadcastTxAsync(_ *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+func (env *Environment) BroadcastTxAsync(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+	if len(env.TxBroadcasters) > 0 {
+		var res *ctypes.ResultBroadcastTx
+		err := env.proxyBroadcast(func(b TxBroadcaster) (err error) {
+			res, err = b.BroadcastTxAsync(ctx.Context(), tx)
+			return err
+		})
+		return res, err
+	}
 	err := env.Mempool.CheckTx(tx, nil, mempl.TxInfo{})
 	if err != nil {
 		return nil, err
@@ -27,10 +46,28 @@ func (env *Environment) BroadcastTxAsync(_ *rpctypes.Context, tx types.Tx) (*cty
 	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
 }
 
+// BroadcastTxSyncWithRequestID is BroadcastTxSync, deduplicating the retries
+// of a request by requestID.
+func (env *Environment) BroadcastTxSyncWithRequestID(
+	ctx *rpctypes.Context,
+	tx types.Tx,
+	requestID string,
+) (*ctypes.ResultBroadcastTx, error) {
+	return withRequestID(env, ctx, "broadcast_tx_sync", requestID, tx, env.BroadcastTxSync)
+}
+
 // BroadcastTxSync returns with the response from CheckTx. Does not wait for
 // the transaction result.
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Tx/broadcast_tx_sync
 func (env *Environment) BroadcastTxSync(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+	if len(env.TxBroadcasters) > 0 {
+		var res *ctypes.ResultBroadcastTx
+		err := env.proxyBroadcast(func(b TxBroadcaster) (err error) {
+			res, err = b.BroadcastTxSync(ctx.Context(), tx)
+			return err
+		})
+		return res, err
+	}
 	resCh := make(chan *abci.ResponseCheckTx, 1)
 	err := env.Mempool.CheckTx(tx, func(res *abci.ResponseCheckTx) {
 		select {
@@ -56,9 +93,89 @@ func (env *Environment) BroadcastTxSync(ctx *rpctypes.Context, tx types.Tx) (*ct
 	}
 }
 
+// BroadcastTxBatch submits several transactions at once and returns with the
+// response from CheckTx of each of them, in order. A transaction which could
+// not be submitted to the mempool does not fail the batch; its result reports
+// the error instead. Does not wait for the transaction results.
+func (env *Environment) BroadcastTxBatch(ctx *rpctypes.Context, txs []types.Tx) (*ctypes.ResultBroadcastTxBatch, error) {
+	return env.BroadcastTxBatchWithContext(ctx.Context(), txs)
+}
+
+// BroadcastTxBatchWithContext is BroadcastTxBatch, giving up waiting for the
+// CheckTx responses once ctx is done.
+func (env *Environment) BroadcastTxBatchWithContext(
+	ctx context.Context,
+	txs []types.Tx,
+) (*ctypes.ResultBroadcastTxBatch, error) {
+	if len(txs) == 0 {
+		return nil, errors.New("no transactions to broadcast")
+	}
+	if max := env.Config.MaxTxBatchSize; max > 0 && len(txs) > max {
+		return nil, fmt.Errorf("too many transactions in batch: %d, max: %d", len(txs), max)
+	}
+	if len(env.TxBroadcasters) > 0 {
+		var res *ctypes.ResultBroadcastTxBatch
+		err := env.proxyBroadcast(func(b TxBroadcaster) (err error) {
+			res, err = b.BroadcastTxBatch(ctx, txs)
+			return err
+		})
+		return res, err
+	}
+
+	results := make([]*ctypes.ResultBroadcastTxBatchEntry, len(txs))
+	resChs := make([]chan *abci.ResponseCheckTx, len(txs))
+	for i, tx := range txs {
+		results[i] = &ctypes.ResultBroadcastTxBatchEntry{Hash: tx.Hash()}
+		resCh := make(chan *abci.ResponseCheckTx, 1)
+		err := env.Mempool.CheckTx(tx, func(res *abci.ResponseCheckTx) {
+			resCh <- res
+		}, mempl.TxInfo{})
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		resChs[i] = resCh
+	}
+
+	for i, resCh := range resChs {
+		if resCh == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("broadcast confirmation not received: %w", ctx.Err())
+		case res := <-resCh:
+			results[i].Code = res.Code
+			results[i].Data = res.Data
+			results[i].Log = res.Log
+			results[i].Codespace = res.Codespace
+		}
+	}
+	return &ctypes.ResultBroadcastTxBatch{Results: results}, nil
+}
+
+// BroadcastTxCommitWithRequestID is BroadcastTxCommit, deduplicating the
+// retries of a request by requestID.
+func (env *Environment) BroadcastTxCommitWithRequestID(
+	ctx *rpctypes.Context,
+	tx types.Tx,
+	requestID string,
+) (*ctypes.ResultBroadcastTxCommit, error) {
+	return withRequestID(env, ctx, "broadcast_tx_commit", requestID, tx, env.BroadcastTxCommit)
+}
+
 // BroadcastTxCommit returns with the responses from CheckTx and ExecTxResult.
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Tx/broadcast_tx_commit
 func (env *Environment) BroadcastTxCommit(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	if len(env.TxBroadcasters) > 0 {
+		var res *ctypes.ResultBroadcastTxCommit
+		err := env.proxyBroadcast(func(b TxBroadcaster) (err error) {
+			res, err = b.BroadcastTxCommit(ctx.Context(), tx)
+			return err
+		})
+		return res, err
+	}
+
 	subscriber := ctx.RemoteAddr()
 
 	if env.EventBus.NumClients() >= env.Config.MaxSubscriptionClients {
@@ -143,6 +260,23 @@ func (env *Environment) BroadcastTxCommit(ctx *rpctypes.Context, tx types.Tx) (*
 	}
 }
 
+// proxyBroadcast calls broadcast with the TxBroadcasters in order, until one
+// of them can be reached. Only transport errors are retried with the next
+// broadcaster: a transaction rejected by CheckTx is a successful result, and
+// the RPC errors of a reached node, e.g. a full mempool, are returned as is.
+func (env *Environment) proxyBroadcast(broadcast func(TxBroadcaster) error) error {
+	var err error
+	for i, b := range env.TxBroadcasters {
+		err = broadcast(b)
+		var rpcErr *rpctypes.RPCError
+		if err == nil || errors.As(err, &rpcErr) {
+			return err
+		}
+		env.Logger.Debug("Failed to proxy broadcast_tx", "broadcaster", i, "err", err)
+	}
+	return fmt.Errorf("failed to proxy transaction to %d broadcasters, last error: %w", len(env.TxBroadcasters), err)
+}
+
 // UnconfirmedTxs gets unconfirmed transactions (maximum ?limit entries)
 // including their number.
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Info/unconfirmed_txs
@@ -169,6 +303,29 @@ func (env *Environment) NumUnconfirmedTxs(*rpctypes.Context) (*ctypes.ResultUnco
 	}, nil
 }
 
+// FeeMarket returns the priorities of the transactions admitted to and
+// committed from the mempool over a rolling window of heights, to be used for
+// fee estimation. Only the priority and CAT mempools track this data.
+func (env *Environment) FeeMarket(*rpctypes.Context) (*ctypes.ResultFeeMarket, error) {
+	reporter, ok := env.Mempool.(mempl.FeeMarketReporter)
+	if !ok {
+		return nil, errors.New("fee market data is not tracked by the configured mempool")
+	}
+	stats := reporter.FeeMarketStats()
+	return &ctypes.ResultFeeMarket{
+		Window:              stats.Window,
+		Heights:             stats.Heights,
+		AdmittedTxs:         stats.AdmittedTxs,
+		MinAdmittedPriority: stats.MinAdmittedPriority,
+		AdmittedPriorityP50: stats.AdmittedPriorityP50,
+		AdmittedPriorityP90: stats.AdmittedPriorityP90,
+		IncludedTxs:         stats.IncludedTxs,
+		MinIncludedPriority: stats.MinIncludedPriority,
+		EvictedTxs:          stats.EvictedTxs,
+		EvictionRate:        stats.EvictionRate,
+	}, nil
+}
+
 // CheckTx checks the transaction without executing it. The transaction won't
 // be added to the mempool either.
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Tx/check_tx
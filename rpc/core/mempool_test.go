@@ -0,0 +1,154 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+	mempl "github.com/cometbft/cometbft/mempool"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// testBroadcaster accepts transactions with code, or fails with err if set.
+type testBroadcaster struct {
+	code uint32
+	err  error
+	txs  types.Txs
+}
+
+func (b *testBroadcaster) broadcast(tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	b.txs = append(b.txs, tx)
+	return &ctypes.ResultBroadcastTx{Code: b.code, Hash: tx.Hash()}, nil
+}
+
+func (b *testBroadcaster) BroadcastTxAsync(_ context.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return b.broadcast(tx)
+}
+
+func (b *testBroadcaster) BroadcastTxSync(_ context.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return b.broadcast(tx)
+}
+
+func (b *testBroadcaster) BroadcastTxBatch(_ context.Context, txs types.Txs) (*ctypes.ResultBroadcastTxBatch, error) {
+	res := &ctypes.ResultBroadcastTxBatch{}
+	for _, tx := range txs {
+		r, err := b.broadcast(tx)
+		if err != nil {
+			return nil, err
+		}
+		res.Results = append(res.Results, &ctypes.ResultBroadcastTxBatchEntry{Code: r.Code, Hash: r.Hash})
+	}
+	return res, nil
+}
+
+func (b *testBroadcaster) BroadcastTxCommit(_ context.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	r, err := b.broadcast(tx)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultBroadcastTxCommit{Hash: r.Hash}, nil
+}
+
+func TestBroadcastTxProxy(t *testing.T) {
+	unreachable := &testBroadcaster{err: errors.New("connection refused")}
+	rejecting := &testBroadcaster{code: 1}
+	accepting := &testBroadcaster{}
+	env := &Environment{
+		Mempool:        &mempl.NopMempool{},
+		TxBroadcasters: []TxBroadcaster{unreachable, rejecting, accepting},
+		Logger:         log.TestingLogger(),
+	}
+	ctx := &rpctypes.Context{}
+	tx := types.Tx("tx")
+
+	// the first reachable broadcaster gets the transaction, even if rejected
+	res, err := env.BroadcastTxSync(ctx, tx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, res.Code)
+	_, err = env.BroadcastTxAsync(ctx, tx)
+	require.NoError(t, err)
+	_, err = env.BroadcastTxCommit(ctx, tx)
+	require.NoError(t, err)
+	batch, err := env.BroadcastTxBatch(ctx, types.Txs{tx, tx})
+	require.NoError(t, err)
+	assert.Len(t, batch.Results, 2)
+	assert.Len(t, rejecting.txs, 5)
+	assert.Empty(t, accepting.txs)
+
+	// the errors of a reached node are not retried
+	full := &testBroadcaster{err: &rpctypes.RPCError{Code: -32603, Message: "mempool is full"}}
+	env.TxBroadcasters = []TxBroadcaster{unreachable, full, accepting}
+	_, err = env.BroadcastTxSync(ctx, tx)
+	assert.ErrorIs(t, err, full.err)
+	assert.Empty(t, accepting.txs)
+
+	env.TxBroadcasters = []TxBroadcaster{unreachable}
+	_, err = env.BroadcastTxSync(ctx, tx)
+	assert.ErrorIs(t, err, unreachable.err)
+}
+
+func TestBroadcastTxRequestID(t *testing.T) {
+	broadcaster := &testBroadcaster{}
+	env := &Environment{
+		TxBroadcasters: []TxBroadcaster{broadcaster},
+		Logger:         log.TestingLogger(),
+	}
+	env.Config.TxRequestIDWindow = time.Minute
+	env.Config.MaxTxRequestIDs = 2
+	env.InitTxRequestIDs()
+	ctx := &rpctypes.Context{}
+
+	// retries get the result of the first request
+	res, err := env.BroadcastTxSyncWithRequestID(ctx, types.Tx("tx1"), "req1")
+	require.NoError(t, err)
+	retry, err := env.BroadcastTxSyncWithRequestID(ctx, types.Tx("tx1"), "req1")
+	require.NoError(t, err)
+	assert.Equal(t, res, retry)
+	assert.Len(t, broadcaster.txs, 1)
+
+	// request IDs are scoped by method, and can't be reused for other txs
+	_, err = env.BroadcastTxAsyncWithRequestID(ctx, types.Tx("tx1"), "req1")
+	require.NoError(t, err)
+	assert.Len(t, broadcaster.txs, 2)
+	_, err = env.BroadcastTxSyncWithRequestID(ctx, types.Tx("tx2"), "req1")
+	assert.Error(t, err)
+
+	// failed requests are not remembered
+	broadcaster.err = errors.New("connection refused")
+	_, err = env.BroadcastTxSyncWithRequestID(ctx, types.Tx("tx3"), "req3")
+	require.Error(t, err)
+	broadcaster.err = nil
+	_, err = env.BroadcastTxSyncWithRequestID(ctx, types.Tx("tx3"), "req3")
+	require.NoError(t, err)
+	assert.Len(t, broadcaster.txs, 3)
+
+	// the oldest results are forgotten past the max, here req1 of sync
+	_, err = env.BroadcastTxSyncWithRequestID(ctx, types.Tx("tx1"), "req1")
+	require.NoError(t, err)
+	assert.Len(t, broadcaster.txs, 4)
+
+	// and so are the results past the window
+	env.Config.TxRequestIDWindow = time.Nanosecond
+	env.InitTxRequestIDs()
+	_, err = env.BroadcastTxSyncWithRequestID(ctx, types.Tx("tx1"), "req1")
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	_, err = env.BroadcastTxSyncWithRequestID(ctx, types.Tx("tx1"), "req1")
+	require.NoError(t, err)
+	assert.Len(t, broadcaster.txs, 6)
+
+	// without a request ID, all the requests are broadcast
+	_, err = env.BroadcastTxSync(ctx, types.Tx("tx1"))
+	require.NoError(t, err)
+	assert.Len(t, broadcaster.txs, 7)
+}
@@ -13,6 +13,11 @@ import (
 // NetInfo returns network info.
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Info/net_info
 func (env *Environment) NetInfo(*rpctypes.Context) (*ctypes.ResultNetInfo, error) {
+	var scorer *p2p.PeerScorer
+	if reporter, ok := env.P2PPeers.(peerScorerReporter); ok {
+		scorer = reporter.PeerScorer()
+	}
+
 	peersList := env.P2PPeers.Peers().List()
 	peers := make([]ctypes.Peer, 0, len(peersList))
 	for _, peer := range peersList {
@@ -20,24 +25,60 @@ func (env *Environment) NetInfo(*rpctypes.Context) (*ctypes.ResultNetInfo, error
 		if !ok {
 			return nil, fmt.Errorf("peer.NodeInfo() is not DefaultNodeInfo")
 		}
+		var score *p2p.PeerScore
+		if scorer != nil {
+			s := scorer.Score(peer.ID())
+			score = &s
+		}
+		var messageStats *p2p.PeerMessageStats
+		if reporter, ok := peer.(messageStatsReporter); ok {
+			s := reporter.MessageStats()
+			messageStats = &s
+		}
 		peers = append(peers, ctypes.Peer{
 			NodeInfo:         nodeInfo,
 			IsOutbound:       peer.IsOutbound(),
 			ConnectionStatus: peer.Status(),
-			RemoteIP:         peer.RemoteIP().String(),
+			RemoteIP:         peerRemoteIP(peer),
+			Score:            score,
+			MessageStats:     messageStats,
 		})
 	}
+	var seedStatus *ctypes.SeedStatus
+	if reporter, ok := env.P2PTransport.(seedStatusReporter); ok {
+		if status := reporter.LatestSeedStatus(); status != nil {
+			seedStatus = &ctypes.SeedStatus{
+				Peer:   status.Peer,
+				Height: status.Height,
+				Header: status.Header,
+			}
+		}
+	}
 	// TODO: Should we include PersistentPeers and Seeds in here?
 	// PRO: useful info
 	// CON: privacy
 	return &ctypes.ResultNetInfo{
-		Listening: env.P2PTransport.IsListening(),
-		Listeners: env.P2PTransport.Listeners(),
-		NPeers:    len(peers),
-		Peers:     peers,
+		Listening:    env.P2PTransport.IsListening(),
+		Listeners:    env.P2PTransport.Listeners(),
+		NPeers:       len(peers),
+		Peers:        peers,
+		DroppedPeers: scorer.Dropped(),
+		SeedStatus:   seedStatus,
 	}, nil
 }
 
+// peerRemoteIP returns the IP of the peer, or its onion hostname if it was
+// dialed through a proxy.
+func peerRemoteIP(peer p2p.Peer) string {
+	if ip := peer.RemoteIP(); ip != nil {
+		return ip.String()
+	}
+	if addr := peer.SocketAddr(); addr != nil && addr.IsOnion() {
+		return addr.Onion
+	}
+	return ""
+}
+
 // UnsafeDialSeeds dials the given seeds (comma-separated id@IP:PORT).
 func (env *Environment) UnsafeDialSeeds(_ *rpctypes.Context, seeds []string) (*ctypes.ResultDialSeeds, error) {
 	if len(seeds) == 0 {
@@ -94,6 +135,119 @@ func (env *Environment) UnsafeDialPeers(
 	return &ctypes.ResultDialPeers{Log: "Dialing peers in progress. See /net_info for details"}, nil
 }
 
+// Lists of subnets selectable in UnsafeDialControl.
+const (
+	SubnetListAllowed = "allowed"
+	SubnetListBlocked = "blocked"
+)
+
+// UnsafeDialControl adds the given subnets to and removes them from the list,
+// "allowed" or "blocked", of the subnets inbound peer connections are
+// accepted or rejected from, and returns the lists. Subnets are in CIDR
+// notation or single IPs. The lists are returned unchanged if none is given.
+func (env *Environment) UnsafeDialControl(
+	_ *rpctypes.Context,
+	list string,
+	add, remove []string,
+) (*ctypes.ResultDialControl, error) {
+	filterer, ok := env.P2PTransport.(subnetFilterer)
+	if !ok || filterer.SubnetFilter() == nil {
+		return nil, errors.New("the transport does not filter connections by subnet")
+	}
+	filter := filterer.SubnetFilter()
+
+	var addSubnet, removeSubnet func(string) error
+	switch list {
+	case SubnetListAllowed:
+		addSubnet, removeSubnet = filter.Allow, filter.Disallow
+	case SubnetListBlocked:
+		addSubnet, removeSubnet = filter.Block, filter.Unblock
+	case "":
+		if len(add) > 0 || len(remove) > 0 {
+			return nil, errors.New("no list provided")
+		}
+	default:
+		return nil, fmt.Errorf("unknown list %q", list)
+	}
+
+	// change the list only if all the subnets are valid
+	for _, subnets := range [][]string{add, remove} {
+		for _, subnet := range subnets {
+			if _, err := p2p.ParseSubnet(subnet); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(add) > 0 || len(remove) > 0 {
+		env.Logger.Info("DialControl", "list", list, "add", add, "remove", remove)
+	}
+	for _, subnet := range add {
+		if err := addSubnet(subnet); err != nil {
+			return nil, err
+		}
+	}
+	for _, subnet := range remove {
+		if err := removeSubnet(subnet); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ctypes.ResultDialControl{
+		AllowedSubnets: filter.AllowedSubnets(),
+		BlockedSubnets: filter.BlockedSubnets(),
+	}, nil
+}
+
+// UnsafePeerBans lifts the bans of the given peers, or of all the peers if
+// unbanAll is set, and returns the peers banned from the address book. Bans
+// are lifted only if all the given peers are banned.
+func (env *Environment) UnsafePeerBans(
+	_ *rpctypes.Context,
+	unban []string,
+	unbanAll bool,
+) (*ctypes.ResultPeerBans, error) {
+	banner, ok := env.P2PTransport.(peerBanner)
+	if !ok {
+		return nil, errors.New("the node does not keep an address book")
+	}
+
+	banned := make(map[p2p.ID]bool)
+	for _, ban := range banner.PeerBans() {
+		banned[ban.Addr.ID] = true
+	}
+	ids := make([]p2p.ID, 0, len(unban))
+	for _, id := range unban {
+		if !banned[p2p.ID(id)] {
+			return nil, fmt.Errorf("peer %v is not banned", id)
+		}
+		ids = append(ids, p2p.ID(id))
+	}
+	if unbanAll {
+		ids = ids[:0]
+		for id := range banned {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > 0 {
+		env.Logger.Info("PeerBans", "unban", ids)
+	}
+	for _, id := range ids {
+		banner.UnbanPeer(id)
+	}
+
+	bans := banner.PeerBans()
+	res := &ctypes.ResultPeerBans{Bans: make([]ctypes.PeerBan, 0, len(bans))}
+	for _, ban := range bans {
+		res.Bans = append(res.Bans, ctypes.PeerBan{
+			ID:     ban.Addr.ID,
+			Addr:   ban.Addr.String(),
+			Reason: ban.Reason,
+			Until:  ban.Until,
+		})
+	}
+	return res, nil
+}
+
 // Genesis returns genesis file.
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Info/genesis
 func (env *Environment) Genesis(*rpctypes.Context) (*ctypes.ResultGenesis, error) {
@@ -1,14 +1,19 @@
 package core
 
 import (
+	"net"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/pex"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 )
 
@@ -87,3 +92,86 @@ func TestUnsafeDialPeers(t *testing.T) {
 		}
 	}
 }
+
+type subnetFilterTransport struct {
+	filter *p2p.SubnetFilter
+}
+
+func (subnetFilterTransport) Listeners() []string               { return nil }
+func (subnetFilterTransport) IsListening() bool                 { return true }
+func (subnetFilterTransport) NodeInfo() p2p.NodeInfo            { return p2p.DefaultNodeInfo{} }
+func (t subnetFilterTransport) SubnetFilter() *p2p.SubnetFilter { return t.filter }
+
+func TestUnsafeDialControl(t *testing.T) {
+	filter, err := p2p.NewSubnetFilter([]string{"10.0.0.0/8"}, nil)
+	require.NoError(t, err)
+	env := &Environment{Logger: log.TestingLogger(), P2PTransport: subnetFilterTransport{filter}}
+
+	res, err := env.UnsafeDialControl(&rpctypes.Context{}, "", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.0/8"}, res.AllowedSubnets)
+	assert.Empty(t, res.BlockedSubnets)
+
+	res, err = env.UnsafeDialControl(&rpctypes.Context{}, SubnetListBlocked, []string{"10.1.0.0/16", "192.0.2.1"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.1.0.0/16", "192.0.2.1/32"}, res.BlockedSubnets)
+	assert.Error(t, filter.Check(net.ParseIP("10.1.2.3")))
+
+	res, err = env.UnsafeDialControl(&rpctypes.Context{}, SubnetListAllowed, []string{"172.16.0.0/12"}, []string{"10.0.0.0/8"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"172.16.0.0/12"}, res.AllowedSubnets)
+
+	// the lists are unchanged if a subnet is invalid
+	_, err = env.UnsafeDialControl(&rpctypes.Context{}, SubnetListBlocked, []string{"10.2.0.0/16", "invalid"}, nil)
+	require.Error(t, err)
+	assert.Equal(t, []string{"10.1.0.0/16", "192.0.2.1/32"}, filter.BlockedSubnets())
+
+	_, err = env.UnsafeDialControl(&rpctypes.Context{}, "unknown", []string{"10.2.0.0/16"}, nil)
+	require.Error(t, err)
+	_, err = env.UnsafeDialControl(&rpctypes.Context{}, "", []string{"10.2.0.0/16"}, nil)
+	require.Error(t, err)
+}
+
+type peerBannerTransport struct {
+	subnetFilterTransport
+	book pex.AddrBook
+}
+
+func (t peerBannerTransport) PeerBans() []pex.Ban      { return t.book.Bans() }
+func (t peerBannerTransport) UnbanPeer(id p2p.ID) bool { return t.book.Unban(id) }
+
+func TestUnsafePeerBans(t *testing.T) {
+	book := pex.NewAddrBook(filepath.Join(t.TempDir(), "addrbook.json"), false)
+	book.SetLogger(log.TestingLogger())
+	env := &Environment{Logger: log.TestingLogger(), P2PTransport: peerBannerTransport{book: book}}
+
+	addrs := make([]*p2p.NetAddress, 3)
+	for i := range addrs {
+		id := p2p.PubKeyToID(ed25519.GenPrivKey().PubKey())
+		addrs[i] = p2p.NewNetAddressIPPort(net.IPv4(127, 0, 0, byte(i+1)), 26656)
+		addrs[i].ID = id
+		require.NoError(t, book.AddAddress(addrs[i], addrs[i]))
+		book.MarkBadWithReason(addrs[i], time.Hour, "invalid addresses")
+	}
+
+	res, err := env.UnsafePeerBans(&rpctypes.Context{}, nil, false)
+	require.NoError(t, err)
+	require.Len(t, res.Bans, 3)
+	assert.Equal(t, "invalid addresses", res.Bans[0].Reason)
+	assert.True(t, res.Bans[0].Until.After(time.Now()))
+
+	res, err = env.UnsafePeerBans(&rpctypes.Context{}, []string{string(addrs[0].ID)}, false)
+	require.NoError(t, err)
+	require.Len(t, res.Bans, 2)
+	assert.True(t, book.HasAddress(addrs[0]))
+
+	// the bans are kept if a peer is not banned
+	_, err = env.UnsafePeerBans(&rpctypes.Context{}, []string{string(addrs[1].ID), string(addrs[0].ID)}, false)
+	require.Error(t, err)
+	assert.True(t, book.IsBanned(addrs[1]))
+
+	res, err = env.UnsafePeerBans(&rpctypes.Context{}, nil, true)
+	require.NoError(t, err)
+	assert.Empty(t, res.Bans)
+	assert.True(t, book.HasAddress(addrs[2]))
+}
@@ -12,38 +12,51 @@ type RoutesMap map[string]*rpc.RPCFunc
 func (env *Environment) GetRoutes() RoutesMap {
 	return RoutesMap{
 		// subscribe/unsubscribe are reserved for websocket events.
-		"subscribe":       rpc.NewWSRPCFunc(env.Subscribe, "query"),
-		"unsubscribe":     rpc.NewWSRPCFunc(env.Unsubscribe, "query"),
-		"unsubscribe_all": rpc.NewWSRPCFunc(env.UnsubscribeAll, ""),
+		"subscribe":         rpc.NewWSRPCFunc(env.Subscribe, "query"),
+		"subscribe_batched": rpc.NewWSRPCFunc(env.SubscribeBatched, "query,max_events,max_delay_ms"),
+		"unsubscribe":       rpc.NewWSRPCFunc(env.Unsubscribe, "query"),
+		"unsubscribe_all":   rpc.NewWSRPCFunc(env.UnsubscribeAll, ""),
+		"auth_challenge":    rpc.NewWSRPCFunc(env.AuthChallenge, ""),
+		"authenticate":      rpc.NewWSRPCFunc(env.Authenticate, "pub_key,signature"),
 
 		// info AP
-		"health":               rpc.NewRPCFunc(env.Health, ""),
-		"status":               rpc.NewRPCFunc(env.Status, ""),
-		"net_info":             rpc.NewRPCFunc(env.NetInfo, ""),
-		"blockchain":           rpc.NewRPCFunc(env.BlockchainInfo, "minHeight,maxHeight", rpc.Cacheable()),
-		"genesis":              rpc.NewRPCFunc(env.Genesis, "", rpc.Cacheable()),
-		"genesis_chunked":      rpc.NewRPCFunc(env.GenesisChunked, "chunk", rpc.Cacheable()),
-		"block":                rpc.NewRPCFunc(env.Block, "height", rpc.Cacheable("height")),
-		"block_by_hash":        rpc.NewRPCFunc(env.BlockByHash, "hash", rpc.Cacheable()),
-		"block_results":        rpc.NewRPCFunc(env.BlockResults, "height", rpc.Cacheable("height")),
-		"commit":               rpc.NewRPCFunc(env.Commit, "height", rpc.Cacheable("height")),
-		"header":               rpc.NewRPCFunc(env.Header, "height", rpc.Cacheable("height")),
-		"header_by_hash":       rpc.NewRPCFunc(env.HeaderByHash, "hash", rpc.Cacheable()),
-		"check_tx":             rpc.NewRPCFunc(env.CheckTx, "tx"),
-		"tx":                   rpc.NewRPCFunc(env.Tx, "hash,prove", rpc.Cacheable()),
-		"tx_search":            rpc.NewRPCFunc(env.TxSearch, "query,prove,page,per_page,order_by"),
-		"block_search":         rpc.NewRPCFunc(env.BlockSearch, "query,page,per_page,order_by"),
-		"validators":           rpc.NewRPCFunc(env.Validators, "height,page,per_page", rpc.Cacheable("height")),
-		"dump_consensus_state": rpc.NewRPCFunc(env.DumpConsensusState, ""),
-		"consensus_state":      rpc.NewRPCFunc(env.GetConsensusState, ""),
-		"consensus_params":     rpc.NewRPCFunc(env.ConsensusParams, "height", rpc.Cacheable("height")),
-		"unconfirmed_txs":      rpc.NewRPCFunc(env.UnconfirmedTxs, "limit"),
-		"num_unconfirmed_txs":  rpc.NewRPCFunc(env.NumUnconfirmedTxs, ""),
+		"health":                   rpc.NewRPCFunc(env.Health, ""),
+		"status":                   rpc.NewRPCFunc(env.Status, ""),
+		"net_info":                 rpc.NewRPCFunc(env.NetInfo, ""),
+		"storage_status":           rpc.NewRPCFunc(env.StorageStatus, ""),
+		"blockchain":               rpc.NewRPCFunc(env.BlockchainInfo, "minHeight,maxHeight", rpc.Cacheable()),
+		"genesis":                  rpc.NewRPCFunc(env.Genesis, "", rpc.Cacheable()),
+		"genesis_chunked":          rpc.NewRPCFunc(env.GenesisChunked, "chunk", rpc.Cacheable()),
+		"block":                    rpc.NewRPCFunc(env.Block, "height", rpc.Cacheable("height")),
+		"block_by_hash":            rpc.NewRPCFunc(env.BlockByHash, "hash", rpc.Cacheable()),
+		"block_results":            rpc.NewRPCFunc(env.BlockResults, "height", rpc.Cacheable("height")),
+		"commit":                   rpc.NewRPCFunc(env.Commit, "height", rpc.Cacheable("height")),
+		"block_time":               rpc.NewRPCFunc(env.BlockTime, "height", rpc.Cacheable("height")),
+		"block_part":               rpc.NewRPCFunc(env.BlockPart, "height,index", rpc.Cacheable()),
+		"height_at_time":           rpc.NewRPCFunc(env.HeightAtTime, "time"),
+		"header":                   rpc.NewRPCFunc(env.Header, "height", rpc.Cacheable("height")),
+		"header_by_hash":           rpc.NewRPCFunc(env.HeaderByHash, "hash", rpc.Cacheable()),
+		"check_tx":                 rpc.NewRPCFunc(env.CheckTx, "tx"),
+		"tx":                       rpc.NewRPCFunc(env.Tx, "hash,prove", rpc.Cacheable()),
+		"tx_search":                rpc.NewRPCFunc(env.TxSearch, "query,prove,page,per_page,order_by"),
+		"block_search":             rpc.NewRPCFunc(env.BlockSearch, "query,page,per_page,order_by"),
+		"validators":               rpc.NewRPCFunc(env.Validators, "height,page,per_page", rpc.Cacheable("height")),
+		"validators_at_time":       rpc.NewRPCFunc(env.ValidatorsAtTime, "time,page,per_page"),
+		"dump_consensus_state":     rpc.NewRPCFunc(env.DumpConsensusState, ""),
+		"consensus_state":          rpc.NewRPCFunc(env.GetConsensusState, ""),
+		"consensus_params":         rpc.NewRPCFunc(env.ConsensusParams, "height", rpc.Cacheable("height")),
+		"consensus_params_at_time": rpc.NewRPCFunc(env.ConsensusParamsAtTime, "time"),
+		"unconfirmed_txs":          rpc.NewRPCFunc(env.UnconfirmedTxs, "limit"),
+		"num_unconfirmed_txs":      rpc.NewRPCFunc(env.NumUnconfirmedTxs, ""),
+		"fee_market":               rpc.NewRPCFunc(env.FeeMarket, ""),
+		"dump_consensus_state_v2": rpc.NewRPCFunc(
+			env.DumpConsensusStateV2, "sections,page,per_page,encoding"),
 
 		// tx broadcast API
-		"broadcast_tx_commit": rpc.NewRPCFunc(env.BroadcastTxCommit, "tx"),
-		"broadcast_tx_sync":   rpc.NewRPCFunc(env.BroadcastTxSync, "tx"),
-		"broadcast_tx_async":  rpc.NewRPCFunc(env.BroadcastTxAsync, "tx"),
+		"broadcast_tx_commit": rpc.NewRPCFunc(env.BroadcastTxCommitWithRequestID, "tx,request_id", rpc.OptionalArgs("request_id")),
+		"broadcast_tx_sync":   rpc.NewRPCFunc(env.BroadcastTxSyncWithRequestID, "tx,request_id", rpc.OptionalArgs("request_id")),
+		"broadcast_tx_async":  rpc.NewRPCFunc(env.BroadcastTxAsyncWithRequestID, "tx,request_id", rpc.OptionalArgs("request_id")),
+		"broadcast_tx_batch":  rpc.NewRPCFunc(env.BroadcastTxBatch, "txs"),
 
 		// abci API
 		"abci_query": rpc.NewRPCFunc(env.ABCIQuery, "path,data,height,prove"),
@@ -67,5 +80,10 @@ func (env *Environment) AddUnsafeRoutes(routes RoutesMap) {
 	// control API
 	routes["dial_seeds"] = rpc.NewRPCFunc(env.UnsafeDialSeeds, "seeds")
 	routes["dial_peers"] = rpc.NewRPCFunc(env.UnsafeDialPeers, "peers,persistent,unconditional,private")
+	routes["dial_control"] = rpc.NewRPCFunc(env.UnsafeDialControl, "list,add,remove")
+	routes["peer_bans"] = rpc.NewRPCFunc(env.UnsafePeerBans, "unban,unban_all")
 	routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(env.UnsafeFlushMempool, "")
+
+	// debugging API
+	routes["block_results_compare"] = rpc.NewRPCFunc(env.UnsafeBlockResultsCompare, "height,peer")
 }
@@ -62,7 +62,7 @@ func (env *Environment) Status(*rpctypes.Context) (*ctypes.ResultStatus, error)
 			EarliestAppHash:     earliestAppHash,
 			EarliestBlockHeight: earliestBlockHeight,
 			EarliestBlockTime:   time.Unix(0, earliestBlockTimeNano),
-			CatchingUp:          env.ConsensusReactor.WaitSync(),
+			CatchingUp:          env.catchingUp(),
 		},
 		ValidatorInfo: ctypes.ValidatorInfo{
 			Address:     env.PubKey.Address(),
@@ -71,6 +71,27 @@ func (env *Environment) Status(*rpctypes.Context) (*ctypes.ResultStatus, error)
 		},
 	}
 
+	result.SyncInfo.LatestSafeHeight = latestHeight
+	if env.DASampling != nil {
+		result.SyncInfo.LatestSafeHeight = env.DASampling.SafeHeight()
+	}
+
+	if env.Lifecycle != nil {
+		result.SyncInfo.Phase = env.Lifecycle.Phase()
+		result.SyncInfo.PhaseTransitions = env.Lifecycle.Transitions()
+	}
+
+	if reporter, ok := env.P2PPeers.(clockSkewReporter); ok {
+		result.SyncInfo.ClockOffset, result.SyncInfo.ClockSkewed = reporter.ClockSkew()
+	}
+
+	if reporter, ok := env.ConsensusState.(ownPerformanceReporter); ok {
+		perf := reporter.OwnPerformance()
+		result.ValidatorInfo.MissedProposals = perf.MissedProposals
+		result.ValidatorInfo.LatePrevotes = perf.LatePrevotes
+		result.ValidatorInfo.LatePrecommits = perf.LatePrecommits
+	}
+
 	return result, nil
 }
 
@@ -107,3 +128,12 @@ func GetNodeInfo(env *Environment, latestHeight int64) p2p.DefaultNodeInfo {
 	nodeInfo.ProtocolVersion.App = consensusParams.Version.App
 	return nodeInfo
 }
+
+// catchingUp returns true until the node follows the chain, either taking part
+// in consensus or through relayed blocks.
+func (env *Environment) catchingUp() bool {
+	if env.BlockRelay != nil && env.BlockRelay.Following() {
+		return false
+	}
+	return env.ConsensusReactor.WaitSync()
+}
@@ -0,0 +1,32 @@
+package core
+
+import (
+	"errors"
+
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+// StorageStatus returns the last collected size, key count and compaction
+// statistics of the node's databases. Statistics are refreshed every
+// instrumentation.storage_metrics_interval.
+func (env *Environment) StorageStatus(*rpctypes.Context) (*ctypes.ResultStorageStatus, error) {
+	if env.StorageCollector == nil {
+		return nil, errors.New("storage metrics are disabled")
+	}
+
+	stats := env.StorageCollector.Stats()
+	stores := make([]ctypes.StoreStatus, len(stats))
+	for i, s := range stats {
+		stores[i] = ctypes.StoreStatus{
+			Name:                   s.Name,
+			Backend:                s.Backend,
+			SizeBytes:              s.SizeBytes,
+			ApproxKeys:             s.Keys,
+			CompactionBacklogBytes: s.CompactionBacklogBytes,
+			WriteAmplification:     s.WriteAmplification,
+			UpdatedAt:              s.UpdatedAt,
+		}
+	}
+	return &ctypes.ResultStorageStatus{Stores: stores}, nil
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/cometbft/cometbft/libs/consts"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	mempl "github.com/cometbft/cometbft/mempool"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/proxy"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	"github.com/cometbft/cometbft/state"
@@ -46,8 +48,8 @@ func (env *Environment) Tx(_ *rpctypes.Context, hash []byte, prove bool) (*ctype
 
 	var shareProof types.ShareProof
 	if prove {
-		block := env.BlockStore.LoadBlock(r.Height)
-		if block != nil {
+		// only check that the block exists, without decoding it
+		if env.BlockStore.LoadBlockMeta(r.Height) != nil {
 			shareProof, err = env.proveTx(r.Height, r.Index)
 			if err != nil {
 				return nil, err
@@ -82,6 +84,10 @@ func (env *Environment) TxSearch(
 		return nil, errors.New("maximum query length exceeded")
 	}
 
+	if env.sheddingLoad() {
+		return nil, ErrLoadShedding
+	}
+
 	q, err := cmtquery.New(query)
 	if err != nil {
 		return nil, err
@@ -91,6 +97,11 @@ func (env *Environment) TxSearch(
 	if err != nil {
 		return nil, err
 	}
+	// the indexer returns the txs found so far when the request is canceled,
+	// which must not be mistaken for the complete results
+	if err := checkContext(ctx.Context()); err != nil {
+		return nil, err
+	}
 
 	// sort results (must be done before pagination)
 	switch orderBy {
@@ -126,12 +137,14 @@ func (env *Environment) TxSearch(
 
 	apiResults := make([]*ctypes.ResultTx, 0, pageSize)
 	for i := skipCount; i < skipCount+pageSize; i++ {
+		if err := checkContext(ctx.Context()); err != nil {
+			return nil, err
+		}
 		r := results[i]
 
 		var shareProof types.ShareProof
 		if prove {
-			block := env.BlockStore.LoadBlock(r.Height)
-			if block != nil {
+			if env.BlockStore.LoadBlockMeta(r.Height) != nil {
 				shareProof, err = env.proveTx(r.Height, r.Index)
 				if err != nil {
 					return nil, err
@@ -153,15 +166,37 @@ func (env *Environment) TxSearch(
 }
 
 func (env *Environment) proveTx(height int64, index uint32) (types.ShareProof, error) {
+	if env.TxProofProvider != nil {
+		return env.TxProofProvider.ProveTx(height, index)
+	}
+	return NewAppTxProofProvider(env.ProxyAppQuery, env.BlockStore).ProveTx(height, index)
+}
+
+// appTxProofProvider asks the application to prove the inclusion of
+// transactions, as only the application knows how blocks are laid out in
+// shares.
+type appTxProofProvider struct {
+	proxyAppQuery proxy.AppConnQuery
+	blockStore    state.BlockStore
+}
+
+// NewAppTxProofProvider returns a TxProofProvider querying the application
+// at consts.TxInclusionProofQueryPath.
+func NewAppTxProofProvider(proxyAppQuery proxy.AppConnQuery, blockStore state.BlockStore) TxProofProvider {
+	return appTxProofProvider{proxyAppQuery: proxyAppQuery, blockStore: blockStore}
+}
+
+// ProveTx implements TxProofProvider.
+func (p appTxProofProvider) ProveTx(height int64, index uint32) (types.ShareProof, error) {
 	var (
 		pShareProof cmtproto.ShareProof
 		shareProof  types.ShareProof
 	)
-	rawBlock, err := loadRawBlock(env.BlockStore, height)
+	rawBlock, err := loadRawBlock(p.blockStore, height)
 	if err != nil {
 		return shareProof, err
 	}
-	res, err := env.ProxyAppQuery.Query(context.Background(), &abcitypes.RequestQuery{
+	res, err := p.proxyAppQuery.Query(context.Background(), &abcitypes.RequestQuery{
 		Data: rawBlock,
 		Path: fmt.Sprintf(consts.TxInclusionProofQueryPath, index),
 	})
@@ -239,13 +274,21 @@ func (env *Environment) TxStatus(ctx *rpctypes.Context, hash []byte) (*ctypes.Re
 	// Check if the tx is in the mempool
 	txInMempool, ok := env.Mempool.GetTxByKey(txKey)
 	if txInMempool != nil && ok {
-		return &ctypes.ResultTxStatus{Status: TxStatusPending}, nil
+		result := &ctypes.ResultTxStatus{Status: TxStatusPending}
+		if reporter, ok := env.Mempool.(mempl.TxStatusReporter); ok {
+			result.Priority, result.Position, _ = reporter.PendingTxInfo(txKey)
+		}
+		return result, nil
 	}
 
 	// Check if the tx is evicted
 	isEvicted := env.Mempool.WasRecentlyEvicted(txKey)
 	if isEvicted {
-		return &ctypes.ResultTxStatus{Status: TxStatusEvicted}, nil
+		result := &ctypes.ResultTxStatus{Status: TxStatusEvicted}
+		if reporter, ok := env.Mempool.(mempl.TxStatusReporter); ok {
+			result.EvictionReason, _ = reporter.EvictionReason(txKey)
+		}
+		return result, nil
 	}
 
 	// If the tx is not in the mempool, evicted, or committed, return unknown
@@ -272,16 +315,24 @@ func loadRawBlock(bs state.BlockStore, height int64) ([]byte, error) {
 	if blockMeta == nil {
 		return nil, fmt.Errorf("no block found for height %d", height)
 	}
+	return joinBlockParts(bs, height, blockMeta.BlockID.PartSetHeader.Total)
+}
 
-	buf := []byte{}
-	for i := 0; i < int(blockMeta.BlockID.PartSetHeader.Total); i++ {
+// joinBlockParts returns the bytes of the block at the given height, joined
+// from its total parts.
+func joinBlockParts(bs state.BlockStore, height int64, total uint32) ([]byte, error) {
+	missing := -1
+	buf := types.JoinPartBytes(total, func(i int) *types.Part {
 		part := bs.LoadBlockPart(height, i)
-		// If the part is missing (e.g. since it has been deleted after we
-		// loaded the block meta) we consider the whole block to be missing.
 		if part == nil {
-			return nil, fmt.Errorf("missing block part at height %d part %d", height, i)
+			missing = i
 		}
-		buf = append(buf, part.Bytes...)
+		return part
+	})
+	// If the part is missing (e.g. since it has been deleted after we
+	// loaded the block meta) we consider the whole block to be missing.
+	if buf == nil {
+		return nil, fmt.Errorf("missing block part at height %d part %d", height, missing)
 	}
 	return buf, nil
 }
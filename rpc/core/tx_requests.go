@@ -0,0 +1,153 @@
+package core
+
+import (
+	"bytes"
+	"container/list"
+	"fmt"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// maxTxRequestIDLength is the maximum length of a request ID, in bytes.
+const maxTxRequestIDLength = 128
+
+// txRequest is a /broadcast_tx_* request with a request ID.
+type txRequest struct {
+	key  string
+	hash []byte
+	done chan struct{} // closed once the result is set
+
+	res     any
+	err     error
+	expires time.Time
+	elem    *list.Element // nil while in flight
+}
+
+// txRequests remembers the results of the /broadcast_tx_* requests with a
+// request ID for a window, so that the clients retrying them get the result
+// of the first attempt instead of submitting the transaction again.
+type txRequests struct {
+	window time.Duration
+	max    int
+
+	mtx       cmtsync.Mutex
+	requests  map[string]*txRequest
+	completed *list.List // of *txRequest, from the oldest
+}
+
+// InitTxRequestIDs enables the request IDs of the /broadcast_tx_* requests if
+// tx_request_id_window is set, and should be called on service startup.
+func (env *Environment) InitTxRequestIDs() {
+	if env.Config.TxRequestIDWindow == 0 {
+		return
+	}
+	env.txRequests = &txRequests{
+		window:    env.Config.TxRequestIDWindow,
+		max:       env.Config.MaxTxRequestIDs,
+		requests:  make(map[string]*txRequest),
+		completed: list.New(),
+	}
+}
+
+// withRequestID runs broadcast, unless the request ID was already used with
+// method, in which case it returns the result of the first request, waiting
+// for it if needed. Only successful results are remembered, so that failed
+// requests can be retried. An empty request ID, or request IDs being disabled,
+// runs broadcast as is.
+func withRequestID[T any](
+	env *Environment,
+	ctx *rpctypes.Context,
+	method string,
+	requestID string,
+	tx types.Tx,
+	broadcast func(*rpctypes.Context, types.Tx) (T, error),
+) (T, error) {
+	var zero T
+	if requestID == "" || env.txRequests == nil {
+		return broadcast(ctx, tx)
+	}
+	if len(requestID) > maxTxRequestIDLength {
+		return zero, fmt.Errorf("request_id is longer than %d bytes", maxTxRequestIDLength)
+	}
+
+	req, first, err := env.txRequests.start(method+"/"+requestID, tx.Hash())
+	if err != nil {
+		return zero, err
+	}
+	if first {
+		res, err := broadcast(ctx, tx)
+		env.txRequests.complete(req, res, err)
+		return res, err
+	}
+
+	select {
+	case <-req.done:
+	case <-ctx.Context().Done():
+		return zero, fmt.Errorf("request %q in progress: %w", requestID, ctx.Context().Err())
+	}
+	if req.err != nil {
+		return zero, req.err
+	}
+	return req.res.(T), nil
+}
+
+// start returns the request of key, and whether it's its first attempt.
+func (tr *txRequests) start(key string, hash []byte) (*txRequest, bool, error) {
+	tr.mtx.Lock()
+	defer tr.mtx.Unlock()
+
+	now := time.Now()
+	tr.prune(now)
+	if req, ok := tr.requests[key]; ok {
+		if !bytes.Equal(req.hash, hash) {
+			return nil, false, fmt.Errorf("request ID already used for transaction %X", req.hash)
+		}
+		return req, false, nil
+	}
+
+	if len(tr.requests) >= tr.max {
+		// forget the oldest results before their window ends
+		front := tr.completed.Front()
+		if front == nil {
+			return nil, false, fmt.Errorf("too many requests in flight, max: %d", tr.max)
+		}
+		tr.remove(front.Value.(*txRequest))
+	}
+	req := &txRequest{key: key, hash: hash, done: make(chan struct{})}
+	tr.requests[key] = req
+	return req, true, nil
+}
+
+// complete sets the result of req, forgetting it if the request failed.
+func (tr *txRequests) complete(req *txRequest, res any, err error) {
+	tr.mtx.Lock()
+	defer tr.mtx.Unlock()
+
+	req.res, req.err = res, err
+	close(req.done)
+	if err != nil {
+		delete(tr.requests, req.key)
+		return
+	}
+	req.expires = time.Now().Add(tr.window)
+	req.elem = tr.completed.PushBack(req)
+}
+
+// prune forgets the results whose window ended.
+func (tr *txRequests) prune(now time.Time) {
+	for front := tr.completed.Front(); front != nil; front = tr.completed.Front() {
+		req := front.Value.(*txRequest)
+		if now.Before(req.expires) {
+			return
+		}
+		tr.remove(req)
+	}
+}
+
+func (tr *txRequests) remove(req *txRequest) {
+	tr.completed.Remove(req.elem)
+	delete(tr.requests, req.key)
+}
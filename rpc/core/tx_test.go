@@ -0,0 +1,49 @@
+package core
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/state/txindex/kv"
+	"github.com/cometbft/cometbft/types"
+)
+
+type mockTxProofProvider struct {
+	proof types.ShareProof
+	calls []uint32
+}
+
+func (p *mockTxProofProvider) ProveTx(_ int64, index uint32) (types.ShareProof, error) {
+	p.calls = append(p.calls, index)
+	return p.proof, nil
+}
+
+func TestTxCustomProofProvider(t *testing.T) {
+	const height int64 = 2
+	blocks := randomBlocks(height + 1)
+	tx := blocks[height].Data.Txs[1]
+
+	txIndexer := kv.NewTxIndex(dbm.NewMemDB())
+	require.NoError(t, txIndexer.Index(&abci.TxResult{Height: height, Index: 1, Tx: tx}))
+
+	provider := &mockTxProofProvider{proof: types.ShareProof{Data: [][]byte{tx}, NamespaceVersion: 1}}
+	env := &Environment{
+		BlockStore:      mockBlockStore{height: height, blocks: blocks},
+		TxIndexer:       txIndexer,
+		TxProofProvider: provider,
+	}
+
+	res, err := env.Tx(nil, tx.Hash(), false)
+	require.NoError(t, err)
+	assert.Empty(t, provider.calls)
+	assert.Empty(t, res.Proof.Data)
+
+	res, err = env.Tx(nil, tx.Hash(), true)
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{1}, provider.calls)
+	assert.Equal(t, provider.proof, res.Proof)
+}
@@ -49,6 +49,73 @@ type ResultHeader struct {
 type ResultCommit struct {
 	types.SignedHeader `json:"signed_header"`
 	CanonicalCommit    bool `json:"canonical"`
+
+	// Vote extensions and their signatures from the precommits this node saw
+	// for the block, set if vote extensions were enabled at its height.
+	VoteExtensions *abci.ExtendedCommitInfo `json:"vote_extensions,omitempty"`
+}
+
+// ResultBlockTime is the BFT time of a block with the data needed to verify
+// it. The time is the median of the timestamps of the precommits in the
+// block's LastCommit, weighted by the voting power of the validators that
+// signed them. LastCommit is committed to by the LastCommitHash of the header,
+// which is committed to by the commit in SignedHeader, and LastValidators by
+// the ValidatorsHash of the header of the previous block.
+//
+// LastCommit, LastValidators and Timestamps are empty for the first block,
+// whose time is the genesis time.
+type ResultBlockTime struct {
+	Height         int64                `json:"height"`
+	Time           time.Time            `json:"time"`
+	Timestamps     []PrecommitTimestamp `json:"timestamps"`
+	SignedHeader   types.SignedHeader   `json:"signed_header"`
+	LastCommit     *types.Commit        `json:"last_commit"`
+	LastValidators *types.ValidatorSet  `json:"last_validators"`
+}
+
+// ResultHeightAtTime is the height and the time of the last block at or before
+// a given time.
+type ResultHeightAtTime struct {
+	Height int64     `json:"height"`
+	Time   time.Time `json:"time"`
+}
+
+// ResultBlockPart is a part of a block with its merkle proof against the
+// PartSetHeader of the block, which is committed to by the commit of the block.
+type ResultBlockPart struct {
+	Height        int64               `json:"height"`
+	PartSetHeader types.PartSetHeader `json:"part_set_header"`
+	Part          *types.Part         `json:"part"`
+}
+
+// PrecommitTimestamp is the timestamp of a precommit and the voting power it
+// is weighted with in the BFT time.
+type PrecommitTimestamp struct {
+	ValidatorAddress types.Address `json:"validator_address"`
+	VotingPower      int64         `json:"voting_power"`
+	Timestamp        time.Time     `json:"timestamp"`
+}
+
+// PrecommitTimestamps returns the timestamps of the precommits in the commit
+// that the BFT time of the next block is computed from, i.e. those of the
+// validators in vals that didn't vote absent.
+func PrecommitTimestamps(commit *types.Commit, vals *types.ValidatorSet) []PrecommitTimestamp {
+	timestamps := make([]PrecommitTimestamp, 0, len(commit.Signatures))
+	for _, commitSig := range commit.Signatures {
+		if commitSig.BlockIDFlag == types.BlockIDFlagAbsent {
+			continue
+		}
+		_, val := vals.GetByAddress(commitSig.ValidatorAddress)
+		if val == nil {
+			continue
+		}
+		timestamps = append(timestamps, PrecommitTimestamp{
+			ValidatorAddress: commitSig.ValidatorAddress,
+			VotingPower:      val.VotingPower,
+			Timestamp:        commitSig.Timestamp,
+		})
+	}
+	return timestamps
 }
 
 // ABCI results from a block
@@ -59,6 +126,26 @@ type ResultBlockResults struct {
 	ValidatorUpdates      []abci.ValidatorUpdate    `json:"validator_updates"`
 	ConsensusParamUpdates *cmtproto.ConsensusParams `json:"consensus_param_updates"`
 	AppHash               []byte                    `json:"app_hash"`
+
+	// Vote extensions from the commit of the previous block, as handed to the
+	// proposer of this block in PrepareProposal. Set if vote extensions were
+	// enabled at the previous height.
+	ExtendedCommitInfo *abci.ExtendedCommitInfo `json:"extended_commit_info,omitempty"`
+}
+
+// Differences between the results of a block on this node and on a peer
+type ResultBlockResultsCompare struct {
+	Height int64              `json:"height"`
+	Peer   string             `json:"peer"`
+	Diffs  []BlockResultsDiff `json:"diffs"`
+}
+
+// A field of the block results differing between this node and a peer. A
+// value is null when the other node has more elements in a list.
+type BlockResultsDiff struct {
+	Field string          `json:"field"`
+	Local json.RawMessage `json:"local"`
+	Peer  json.RawMessage `json:"peer"`
 }
 
 // NewResultCommit is a helper to initialize the ResultCommit with
@@ -88,6 +175,22 @@ type SyncInfo struct {
 	EarliestBlockTime   time.Time      `json:"earliest_block_time"`
 
 	CatchingUp bool `json:"catching_up"`
+
+	// Height up to which the data of the blocks was sampled by the data
+	// availability sampler of the node, the latest block height if it has
+	// none.
+	LatestSafeHeight int64 `json:"latest_safe_height"`
+
+	// Phase of the lifecycle of the node, and the transitions which led to
+	// it, oldest first. Both are empty if the node doesn't report its phase.
+	Phase            types.NodePhase            `json:"phase"`
+	PhaseTransitions []types.EventDataNodePhase `json:"phase_transitions"`
+
+	// Estimated offset of the local clock relative to the peers, positive if
+	// the peers are ahead, and whether it exceeds p2p.clock_skew_threshold.
+	// Both are zero if clock skew checks are disabled.
+	ClockOffset time.Duration `json:"clock_offset"`
+	ClockSkewed bool          `json:"clock_skewed"`
 }
 
 // Info about the node's validator
@@ -95,6 +198,12 @@ type ValidatorInfo struct {
 	Address     bytes.HexBytes `json:"address"`
 	PubKey      crypto.PubKey  `json:"pub_key"`
 	VotingPower int64          `json:"voting_power"`
+
+	// Counts of the proposals and votes of the validator which were not sent
+	// on time, persisted across restarts.
+	MissedProposals uint64 `json:"missed_proposals"`
+	LatePrevotes    uint64 `json:"late_prevotes"`
+	LatePrecommits  uint64 `json:"late_precommits"`
 }
 
 // Node Status
@@ -112,12 +221,65 @@ func (s *ResultStatus) TxIndexEnabled() bool {
 	return s.NodeInfo.Other.TxIndex == "on"
 }
 
+// Challenge to be signed to authenticate a websocket connection
+type ResultAuthChallenge struct {
+	Challenge []byte `json:"challenge"`
+}
+
+// Identity and subscription quota of an authenticated websocket connection
+type ResultAuthenticate struct {
+	NodeID           string `json:"node_id"`
+	MaxSubscriptions int    `json:"max_subscriptions"`
+}
+
+// authSignBytesPrefix separates authentication signatures from signatures
+// made with the same key for other purposes.
+const authSignBytesPrefix = "cometbft/rpc/authenticate:"
+
+// AuthSignBytes returns the bytes a client signs to answer challenge.
+func AuthSignBytes(challenge []byte) []byte {
+	return append([]byte(authSignBytesPrefix), challenge...)
+}
+
+// Storage statistics of the node's databases
+type ResultStorageStatus struct {
+	Stores []StoreStatus `json:"stores"`
+}
+
+// Storage statistics of a single database. CompactionBacklogBytes and
+// WriteAmplification are zero if the backend does not expose them.
+type StoreStatus struct {
+	Name                   string    `json:"name"`
+	Backend                string    `json:"backend"`
+	SizeBytes              int64     `json:"size_bytes"`
+	ApproxKeys             int64     `json:"approx_keys"`
+	CompactionBacklogBytes int64     `json:"compaction_backlog_bytes"`
+	WriteAmplification     float64   `json:"write_amplification"`
+	UpdatedAt              time.Time `json:"updated_at"`
+}
+
 // Info about peer connections
 type ResultNetInfo struct {
 	Listening bool     `json:"listening"`
 	Listeners []string `json:"listeners"`
 	NPeers    int      `json:"n_peers"`
 	Peers     []Peer   `json:"peers"`
+
+	// Peers recently disconnected because of an error, oldest first, with
+	// their score at the time. Empty if peer scoring is disabled.
+	DroppedPeers []p2p.DroppedPeer `json:"dropped_peers"`
+
+	// Median status reported by the seeds dialed, unverified. Absent if no
+	// seed reported its status.
+	SeedStatus *SeedStatus `json:"seed_status,omitempty"`
+}
+
+// SeedStatus is the latest height of a seed, and its header if it sent it.
+// Neither is verified.
+type SeedStatus struct {
+	Peer   p2p.ID        `json:"peer"`
+	Height int64         `json:"height,string"`
+	Header *types.Header `json:"header,omitempty"`
 }
 
 // Log from dialing seeds
@@ -130,12 +292,37 @@ type ResultDialPeers struct {
 	Log string `json:"log"`
 }
 
+// Subnets inbound peer connections are accepted and rejected from
+type ResultDialControl struct {
+	AllowedSubnets []string `json:"allowed_subnets"`
+	BlockedSubnets []string `json:"blocked_subnets"`
+}
+
+// Peers banned from the address book
+type ResultPeerBans struct {
+	Bans []PeerBan `json:"bans"`
+}
+
+// A peer banned from the address book, which is not dialed nor accepted until
+// the ban expires
+type PeerBan struct {
+	ID     p2p.ID    `json:"id"`
+	Addr   string    `json:"addr"`
+	Reason string    `json:"reason"`
+	Until  time.Time `json:"until"`
+}
+
 // A peer
 type Peer struct {
 	NodeInfo         p2p.DefaultNodeInfo  `json:"node_info"`
 	IsOutbound       bool                 `json:"is_outbound"`
 	ConnectionStatus p2p.ConnectionStatus `json:"connection_status"`
 	RemoteIP         string               `json:"remote_ip"`
+	// Nil if peer scoring is disabled.
+	Score *p2p.PeerScore `json:"score,omitempty"`
+	// Messages exchanged with the peer since the connection started, per
+	// channel and per message type.
+	MessageStats *p2p.PeerMessageStats `json:"message_stats,omitempty"`
 }
 
 // Validators for a height.
@@ -161,6 +348,18 @@ type ResultDumpConsensusState struct {
 	Peers      []PeerStateInfo `json:"peers"`
 }
 
+// UNSTABLE
+type ResultDumpConsensusStateV2 struct {
+	RoundState json.RawMessage `json:"round_state,omitempty"`
+	Votes      json.RawMessage `json:"votes,omitempty"`
+	Peers      []PeerStateInfo `json:"peers,omitempty"`
+	// TotalPeers is the number of peers, of which Peers is a page.
+	TotalPeers int `json:"total_peers"`
+	// Proto is the consensus.StateDump protobuf encoding of the selected
+	// sections if requested, in which case the other sections are empty.
+	Proto []byte `json:"proto,omitempty"`
+}
+
 // UNSTABLE
 type PeerStateInfo struct {
 	NodeAddress string          `json:"node_address"`
@@ -182,6 +381,24 @@ type ResultBroadcastTx struct {
 	Hash bytes.HexBytes `json:"hash"`
 }
 
+// CheckTx results of a batch of transactions, in the order they were sent
+type ResultBroadcastTxBatch struct {
+	Results []*ResultBroadcastTxBatchEntry `json:"results"`
+}
+
+// CheckTx result of a single transaction of a batch. Error is set if the
+// transaction could not be submitted to the mempool, in which case the other
+// fields but the hash are empty.
+type ResultBroadcastTxBatchEntry struct {
+	Code      uint32         `json:"code"`
+	Data      bytes.HexBytes `json:"data"`
+	Log       string         `json:"log"`
+	Codespace string         `json:"codespace"`
+	Error     string         `json:"error,omitempty"`
+
+	Hash bytes.HexBytes `json:"hash"`
+}
+
 // CheckTx and ExecTx results
 type ResultBroadcastTxCommit struct {
 	CheckTx  abci.ResponseCheckTx `json:"check_tx"`
@@ -225,6 +442,20 @@ type ResultUnconfirmedTxs struct {
 	Txs        []types.Tx `json:"txs"`
 }
 
+// Fee market data of the mempool
+type ResultFeeMarket struct {
+	Window              int     `json:"window"`
+	Heights             int     `json:"heights"`
+	AdmittedTxs         int     `json:"admitted_txs"`
+	MinAdmittedPriority int64   `json:"min_admitted_priority"`
+	AdmittedPriorityP50 int64   `json:"admitted_priority_p50"`
+	AdmittedPriorityP90 int64   `json:"admitted_priority_p90"`
+	IncludedTxs         int     `json:"included_txs"`
+	MinIncludedPriority int64   `json:"min_included_priority"`
+	EvictedTxs          int     `json:"evicted_txs"`
+	EvictionRate        float64 `json:"eviction_rate"`
+}
+
 // Info abci msg
 type ResultABCIInfo struct {
 	Response abci.ResponseInfo `json:"response"`
@@ -256,6 +487,12 @@ type ResultEvent struct {
 	Events map[string][]string `json:"events"`
 }
 
+// Events of a batched subscription, oldest first
+type ResultEventBatch struct {
+	Query  string        `json:"query"`
+	Events []ResultEvent `json:"events"`
+}
+
 // Single block with all data for validation
 type ResultSignedBlock struct {
 	Header       types.Header       `json:"header"`
@@ -266,12 +503,18 @@ type ResultSignedBlock struct {
 
 // ResultTxStatus represents the status of a transaction during its life cycle.
 // It contains info to locate a tx in a committed block as well as its execution code, log if it fails and status.
+// Pending txs report their priority and position in the mempool, which are
+// always encoded as 0 is a valid value of both, and evicted txs the reason for
+// their eviction.
 type ResultTxStatus struct {
-	Height        int64  `json:"height"`
-	Index         uint32 `json:"index"`
-	ExecutionCode uint32 `json:"execution_code"`
-	Error         string `json:"error"`
-	Status        string `json:"status"`
+	Height         int64  `json:"height"`
+	Index          uint32 `json:"index"`
+	ExecutionCode  uint32 `json:"execution_code"`
+	Error          string `json:"error"`
+	Status         string `json:"status"`
+	Priority       int64  `json:"priority"`
+	Position       int    `json:"position"`
+	EvictionReason string `json:"eviction_reason,omitempty"`
 }
 
 type ResultDataCommitment struct {
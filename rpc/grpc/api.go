@@ -49,6 +49,34 @@ func (bapi *broadcastAPI) BroadcastTx(_ context.Context, req *RequestBroadcastTx
 	}, nil
 }
 
+func (bapi *broadcastAPI) BroadcastTxBatch(ctx context.Context, req *RequestBroadcastTxBatch) (*ResponseBroadcastTxBatch, error) {
+	txs := make([]eventstypes.Tx, len(req.Txs))
+	for i, tx := range req.Txs {
+		txs[i] = tx
+	}
+	res, err := bapi.env.BroadcastTxBatchWithContext(ctx, txs)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*BroadcastTxBatchResult, len(res.Results))
+	for i, r := range res.Results {
+		results[i] = &BroadcastTxBatchResult{
+			Hash:  r.Hash,
+			Error: r.Error,
+		}
+		if r.Error == "" {
+			results[i].CheckTx = &abci.ResponseCheckTx{
+				Code:      r.Code,
+				Data:      r.Data,
+				Log:       r.Log,
+				Codespace: r.Codespace,
+			}
+		}
+	}
+	return &ResponseBroadcastTxBatch{Results: results}, nil
+}
+
 type BlockAPI struct {
 	env *core.Environment
 	sync.Mutex
@@ -426,8 +454,8 @@ func NewBlobstreamAPI(env *core.Environment) *BlobstreamAPI {
 	return &BlobstreamAPI{env: env}
 }
 
-func (blobAPI *BlobstreamAPI) DataRootInclusionProof(_ context.Context, req *DataRootInclusionProofRequest) (*DataRootInclusionProofResponse, error) {
-	proof, err := blobAPI.env.GenerateDataRootInclusionProof(req.Height, req.Start, req.End)
+func (blobAPI *BlobstreamAPI) DataRootInclusionProof(ctx context.Context, req *DataRootInclusionProofRequest) (*DataRootInclusionProofResponse, error) {
+	proof, err := blobAPI.env.GenerateDataRootInclusionProofWithContext(ctx, req.Height, req.Start, req.End)
 	if err != nil {
 		return nil, err
 	}
@@ -33,3 +33,18 @@ func TestBroadcastTx(t *testing.T) {
 	require.EqualValues(t, 0, res.CheckTx.Code)
 	require.EqualValues(t, 0, res.TxResult.Code)
 }
+
+func TestBroadcastTxBatch(t *testing.T) {
+	tx := kvstore.NewTx("batch", "value")
+	res, err := rpctest.GetGRPCClient().BroadcastTxBatch(
+		context.Background(),
+		&core_grpc.RequestBroadcastTxBatch{Txs: [][]byte{tx, kvstore.NewTx("batch2", "value"), tx}},
+	)
+	require.NoError(t, err)
+	require.Len(t, res.Results, 3)
+	require.EqualValues(t, 0, res.Results[0].CheckTx.Code)
+	require.EqualValues(t, 0, res.Results[1].CheckTx.Code)
+	// the duplicate is rejected by the mempool without failing the batch
+	require.NotEmpty(t, res.Results[2].Error)
+	require.Nil(t, res.Results[2].CheckTx)
+}
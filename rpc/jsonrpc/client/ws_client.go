@@ -553,6 +553,18 @@ func (c *WSClient) Subscribe(ctx context.Context, query string) error {
 	return c.Call(ctx, "subscribe", params)
 }
 
+// SubscribeBatched subscribes to a query, receiving the events in batches of
+// up to maxEvents events, each delivered at most maxDelay after its first
+// event. Note the server must have a "subscribe_batched" route defined.
+func (c *WSClient) SubscribeBatched(ctx context.Context, query string, maxEvents int, maxDelay time.Duration) error {
+	params := map[string]interface{}{
+		"query":        query,
+		"max_events":   maxEvents,
+		"max_delay_ms": maxDelay.Milliseconds(),
+	}
+	return c.Call(ctx, "subscribe_batched", params)
+}
+
 // Unsubscribe from a query. Note the server must have a "unsubscribe" route
 // defined.
 func (c *WSClient) Unsubscribe(ctx context.Context, query string) error {
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"reflect"
 	"sort"
+	"time"
 
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
@@ -105,13 +106,15 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 				cache = false
 			}
 
+			start := time.Now()
 			returns := rpcFunc.f.Call(args)
 			result, err := unreflectResult(returns)
 			if err != nil {
 				responses = append(responses, types.RPCInternalError(request.ID, err))
-				continue
+			} else {
+				responses = append(responses, types.NewRPCSuccessResponse(request.ID, result))
 			}
-			responses = append(responses, types.NewRPCSuccessResponse(request.ID, result))
+			rpcFunc.observe(ctx, start, responses[len(responses)-1])
 		}
 
 		if len(responses) > 0 {
@@ -170,12 +173,12 @@ func arrayParamsToArgs(
 	params []json.RawMessage,
 	argsOffset int,
 ) ([]reflect.Value, error) {
-	if len(rpcFunc.argNames) != len(params) {
+	if len(params) < rpcFunc.minArgs() || len(params) > len(rpcFunc.argNames) {
 		return nil, fmt.Errorf("expected %v parameters (%v), got %v (%v)",
 			len(rpcFunc.argNames), rpcFunc.argNames, len(params), params)
 	}
 
-	values := make([]reflect.Value, len(params))
+	values := make([]reflect.Value, len(rpcFunc.argNames))
 	for i, p := range params {
 		argType := rpcFunc.args[i+argsOffset]
 		val := reflect.New(argType)
@@ -185,6 +188,10 @@ func arrayParamsToArgs(
 		}
 		values[i] = val.Elem()
 	}
+	// use default for the omitted optional args
+	for i := len(params); i < len(values); i++ {
+		values[i] = reflect.Zero(rpcFunc.args[i+argsOffset])
+	}
 	return values, nil
 }
 
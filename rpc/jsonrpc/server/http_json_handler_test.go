@@ -3,11 +3,13 @@ package server
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -276,3 +278,37 @@ func TestRPCResponseCache(t *testing.T) {
 	res.Body.Close()
 	require.Nil(t, err, "reading from the body should not give back an error")
 }
+
+func TestObserveCalls(t *testing.T) {
+	type call struct {
+		method string
+		size   int
+		failed bool
+	}
+	var calls []call
+	funcMap := ObserveCalls(map[string]*RPCFunc{
+		"c":   NewRPCFunc(func(ctx *types.Context) (string, error) { return "foo", nil }, ""),
+		"err": NewRPCFunc(func(ctx *types.Context) (string, error) { return "", errors.New("failed") }, ""),
+	}, func(ctx *types.Context, method string, duration time.Duration, res types.RPCResponse) {
+		assert.NotNil(t, ctx.HTTPReq)
+		calls = append(calls, call{method, len(res.Result), res.Error != nil})
+	})
+	mux := http.NewServeMux()
+	RegisterRPCFuncs(mux, funcMap, log.NewNopLogger())
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest("POST", "http://localhost/", strings.NewReader(
+			`[{"jsonrpc": "2.0", "method": "c", "id": 0}, {"jsonrpc": "2.0", "method": "err", "id": 1}]`)),
+		httptest.NewRequest("GET", "http://localhost/c", nil),
+	} {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		rec.Result().Body.Close()
+	}
+
+	assert.Equal(t, []call{
+		{"c", len(`"foo"`), false},
+		{"err", 0, true},
+		{"c", len(`"foo"`), false},
+	}, calls)
+}
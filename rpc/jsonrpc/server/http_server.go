@@ -4,6 +4,7 @@ package server
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -52,13 +53,15 @@ func DefaultConfig() *Config {
 
 // Serve creates a http.Server and calls Serve with the given listener. It
 // wraps handler with RecoverAndLogHandler and a handler, which limits the max
-// body size to config.MaxBodyBytes.
+// body size to config.MaxBodyBytes. The context of the requests is canceled
+// after config.WriteTimeout.
 //
 // NOTE: This function blocks - you may want to call it in a go-routine.
 func Serve(listener net.Listener, handler http.Handler, logger log.Logger, config *Config) error {
 	logger.Info("serve", "msg", log.NewLazySprintf("Starting RPC HTTP server on %s", listener.Addr()))
+	handler = deadlineHandler(defaultHandler{h: handler}, config.WriteTimeout)
 	s := &http.Server{
-		Handler:           PreChecksHandler(RecoverAndLogHandler(defaultHandler{h: handler}, logger), config),
+		Handler:           PreChecksHandler(RecoverAndLogHandler(handler, logger), config),
 		ReadTimeout:       config.ReadTimeout,
 		ReadHeaderTimeout: config.ReadTimeout,
 		WriteTimeout:      config.WriteTimeout,
@@ -71,7 +74,8 @@ func Serve(listener net.Listener, handler http.Handler, logger log.Logger, confi
 
 // ServeTLS creates a http.Server and calls ServeTLS with the given listener,
 // certFile and keyFile. It wraps handler with RecoverAndLogHandler and a
-// handler, which limits the max body size to config.MaxBodyBytes.
+// handler, which limits the max body size to config.MaxBodyBytes. The context
+// of the requests is canceled after config.WriteTimeout.
 //
 // NOTE: This function blocks - you may want to call it in a go-routine.
 func ServeTLS(
@@ -83,8 +87,9 @@ func ServeTLS(
 ) error {
 	logger.Info("serve tls", "msg", log.NewLazySprintf("Starting RPC HTTPS server on %s (cert: %q, key: %q)",
 		listener.Addr(), certFile, keyFile))
+	handler = deadlineHandler(defaultHandler{h: handler}, config.WriteTimeout)
 	s := &http.Server{
-		Handler:           PreChecksHandler(RecoverAndLogHandler(defaultHandler{h: handler}, logger), config),
+		Handler:           PreChecksHandler(RecoverAndLogHandler(handler, logger), config),
 		ReadTimeout:       config.ReadTimeout,
 		ReadHeaderTimeout: config.ReadTimeout,
 		WriteTimeout:      config.WriteTimeout,
@@ -283,6 +288,21 @@ func Listen(addr string, maxOpenConnections int) (listener net.Listener, err err
 
 // Middleware
 
+// deadlineHandler cancels the context of the requests once their response
+// can't be written anymore, i.e. after the write timeout of the server, so
+// that handlers stop working for clients which won't get the response.
+// Websocket connections have their own context and are not affected.
+func deadlineHandler(next http.Handler, timeout time.Duration) http.Handler {
+	if timeout <= 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 // PreChecksHandler is a middleware function that checks the size of batch requests and returns an error
 // if it exceeds the maximum configured size. It also checks if the request body is not greater than the
 // configured maximum request body bytes limit.
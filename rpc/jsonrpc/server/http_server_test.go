@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -106,6 +107,28 @@ func TestServeTLS(t *testing.T) {
 	assert.Equal(t, []byte("some body"), body)
 }
 
+func TestDeadlineHandler(t *testing.T) {
+	done := make(chan error, 1)
+	handler := deadlineHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			done <- r.Context().Err()
+		case <-time.After(5 * time.Second):
+			done <- errors.New("request context not canceled")
+		}
+	}), 10*time.Millisecond)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	require.ErrorIs(t, <-done, context.DeadlineExceeded)
+
+	// without a write timeout, requests have no deadline
+	handler = deadlineHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, ok := r.Context().Deadline()
+		assert.False(t, ok)
+	}), 0)
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+}
+
 func TestWriteRPCResponseHTTP(t *testing.T) {
 	id := types.JSONRPCIntID(-1)
 
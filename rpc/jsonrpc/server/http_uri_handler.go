@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
@@ -51,13 +52,15 @@ func makeHTTPHandler(rpcFunc *RPCFunc, logger log.Logger) func(http.ResponseWrit
 		}
 		args = append(args, fnArgs...)
 
+		start := time.Now()
 		returns := rpcFunc.f.Call(args)
 
 		logger.Debug("HTTPRestRPC", "method", r.URL.Path, "args", args, "returns", returns)
 		result, err := unreflectResult(returns)
 		if err != nil {
-			if err := WriteRPCResponseHTTPError(w, http.StatusInternalServerError,
-				types.RPCInternalError(dummyID, err)); err != nil {
+			res := types.RPCInternalError(dummyID, err)
+			rpcFunc.observe(ctx, start, res)
+			if err := WriteRPCResponseHTTPError(w, http.StatusInternalServerError, res); err != nil {
 				logger.Error("failed to write response", "err", err)
 				return
 			}
@@ -65,6 +68,7 @@ func makeHTTPHandler(rpcFunc *RPCFunc, logger log.Logger) func(http.ResponseWrit
 		}
 
 		resp := types.NewRPCSuccessResponse(dummyID, result)
+		rpcFunc.observe(ctx, start, resp)
 		if rpcFunc.cacheableWithArgs(args) {
 			err = WriteCacheableRPCResponseHTTP(w, resp)
 		} else {
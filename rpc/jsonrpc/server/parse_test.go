@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/cometbft/cometbft/libs/bytes"
 	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
@@ -170,6 +171,30 @@ func TestParseJSONRPC(t *testing.T) {
 	}
 }
 
+func TestParseJSONRPCOptionalArgs(t *testing.T) {
+	demo := func(ctx *types.Context, height int, name string) {}
+	call := NewRPCFunc(demo, "height,name", OptionalArgs("name"))
+
+	vals, err := jsonParamsToArgs(call, []byte(`["7", "flew"]`))
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), vals[0].Int())
+	assert.Equal(t, "flew", vals[1].String())
+
+	// the optional trailing arg can be omitted
+	vals, err = jsonParamsToArgs(call, []byte(`["7"]`))
+	require.NoError(t, err)
+	if assert.Len(t, vals, 2) {
+		assert.Equal(t, int64(7), vals[0].Int())
+		assert.Equal(t, "", vals[1].String())
+	}
+
+	// but not the required ones
+	_, err = jsonParamsToArgs(call, []byte(`[]`))
+	require.Error(t, err)
+	_, err = jsonParamsToArgs(call, []byte(`["7", "flew", "1"]`))
+	require.Error(t, err)
+}
+
 func TestParseURI(t *testing.T) {
 	demo := func(ctx *types.Context, height int, name string) {}
 	call := NewRPCFunc(demo, "height,name")
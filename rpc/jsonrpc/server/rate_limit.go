@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+// rateLimitPruneInterval is the interval at which the buckets of the clients
+// which can burst again are forgotten.
+const rateLimitPruneInterval = time.Minute
+
+// tokenBucket holds the requests a client can still make.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+type rateLimiter struct {
+	rate  float64
+	burst int
+
+	mtx       cmtsync.Mutex
+	buckets   map[string]*tokenBucket
+	lastPrune time.Time
+}
+
+// allow takes a token from the bucket of client, returning false if empty.
+func (rl *rateLimiter) allow(client string, now time.Time) bool {
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+
+	if now.Sub(rl.lastPrune) >= rateLimitPruneInterval {
+		for c, b := range rl.buckets {
+			if rl.refill(b, now) >= float64(rl.burst) {
+				delete(rl.buckets, c)
+			}
+		}
+		rl.lastPrune = now
+	}
+
+	b, ok := rl.buckets[client]
+	if !ok {
+		b = &tokenBucket{tokens: float64(rl.burst), last: now}
+		rl.buckets[client] = b
+	}
+	if rl.refill(b, now) < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// refill adds the tokens accrued since the last request to b, up to the
+// burst, and returns them.
+func (rl *rateLimiter) refill(b *tokenBucket, now time.Time) float64 {
+	b.tokens += now.Sub(b.last).Seconds() * rl.rate
+	if b.tokens > float64(rl.burst) {
+		b.tokens = float64(rl.burst)
+	}
+	b.last = now
+	return b.tokens
+}
+
+// rateLimitKey is the context key of the function taking a request from the
+// bucket of the client, see RateLimitHandler.
+type rateLimitKey struct{}
+
+// rateLimitFromContext returns the function taking a request from the bucket
+// of the client of ctx, or nil if its requests are not rate limited.
+func rateLimitFromContext(ctx context.Context) func() bool {
+	allow, _ := ctx.Value(rateLimitKey{}).(func() bool)
+	return allow
+}
+
+// RateLimitHandler limits the requests of each client IP to rate per second,
+// allowing bursts of up to burst requests, and rejects the others with a 429
+// status. Opening a websocket connection counts as one request, and so does
+// each request sent over it, the ones over the limit being answered with an
+// error. It returns next as is if rate is 0.
+func RateLimitHandler(next http.Handler, rate float64, burst int) http.Handler {
+	if rate <= 0 {
+		return next
+	}
+	rl := &rateLimiter{
+		rate:      rate,
+		burst:     burst,
+		buckets:   make(map[string]*tokenBucket),
+		lastPrune: time.Now(),
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			client = r.RemoteAddr
+		}
+		if !rl.allow(client, time.Now()) {
+			res := types.RPCInvalidRequestError(nil, errors.New("rate limit exceeded"))
+			_ = WriteRPCResponseHTTPError(w, http.StatusTooManyRequests, res)
+			return
+		}
+		allow := func() bool { return rl.allow(client, time.Now()) }
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), rateLimitKey{}, allow)))
+	})
+}
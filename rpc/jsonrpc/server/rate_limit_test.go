@@ -0,0 +1,73 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+func TestRateLimitHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	handler := RateLimitHandler(next, 0.001, 2)
+
+	get := func(remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodGet, "/status", nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	assert.Equal(t, http.StatusOK, get("1.2.3.4:1000"))
+	assert.Equal(t, http.StatusOK, get("1.2.3.4:1001"))
+	assert.Equal(t, http.StatusTooManyRequests, get("1.2.3.4:1002"))
+	// other clients have their own bucket
+	assert.Equal(t, http.StatusOK, get("5.6.7.8:1000"))
+}
+
+func TestRateLimitHandlerWebsocket(t *testing.T) {
+	funcMap := map[string]*RPCFunc{
+		"c": NewWSRPCFunc(func(ctx *types.Context) (string, error) { return "foo", nil }, ""),
+	}
+	wm := NewWebsocketManager(funcMap)
+	wm.SetLogger(log.TestingLogger())
+	s := httptest.NewServer(RateLimitHandler(http.HandlerFunc(wm.WebsocketHandler), 0.001, 2))
+	defer s.Close()
+
+	c, dialResp, err := websocket.DefaultDialer.Dial("ws://"+s.Listener.Addr().String(), nil)
+	require.NoError(t, err)
+	defer c.Close()
+	dialResp.Body.Close()
+
+	// the connection takes the first request, the messages the others
+	call := func() *types.RPCError {
+		require.NoError(t, c.WriteJSON(types.RPCRequest{JSONRPC: "2.0", ID: types.JSONRPCIntID(1), Method: "c"}))
+		var resp types.RPCResponse
+		require.NoError(t, c.ReadJSON(&resp))
+		return resp.Error
+	}
+	assert.Nil(t, call())
+	assert.NotNil(t, call())
+}
+
+func TestRateLimiterRefill(t *testing.T) {
+	now := time.Now()
+	rl := &rateLimiter{rate: 2, burst: 1, buckets: make(map[string]*tokenBucket), lastPrune: now}
+
+	assert.True(t, rl.allow("a", now))
+	assert.False(t, rl.allow("a", now))
+	assert.False(t, rl.allow("a", now.Add(100*time.Millisecond)))
+	assert.True(t, rl.allow("a", now.Add(600*time.Millisecond)))
+
+	// the full buckets are pruned
+	assert.True(t, rl.allow("b", now.Add(rateLimitPruneInterval+time.Second)))
+	assert.NotContains(t, rl.buckets, "a")
+	assert.Contains(t, rl.buckets, "b")
+}
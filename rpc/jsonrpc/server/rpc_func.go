@@ -5,8 +5,10 @@ import (
 	"net/http"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/log"
+	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 )
 
 // RegisterRPCFuncs adds a route for each function in the funcMap, as well as
@@ -48,6 +50,19 @@ func Ws() Option {
 	}
 }
 
+// OptionalArgs lets the given trailing arguments be omitted from positional
+// parameters, in which case they are set to their zero value, as when they are
+// omitted from named parameters. It allows adding arguments to an RPC function
+// without breaking its positional calls.
+func OptionalArgs(argNames ...string) Option {
+	return func(r *RPCFunc) {
+		r.optionalArgs = make(map[string]struct{}, len(argNames))
+		for _, arg := range argNames {
+			r.optionalArgs[arg] = struct{}{}
+		}
+	}
+}
+
 // RPCFunc contains the introspected type information for a function
 type RPCFunc struct {
 	f              reflect.Value          // underlying rpc function
@@ -57,6 +72,34 @@ type RPCFunc struct {
 	cacheable      bool                   // enable cache control
 	ws             bool                   // enable websocket communication
 	noCacheDefArgs map[string]interface{} // a lookup table of args that, if not supplied or are set to default values, cause us to not cache
+	optionalArgs   map[string]struct{}    // trailing args which can be omitted from positional parameters
+
+	name     string       // name of the function, set with observer
+	observer CallObserver // notified of the calls, if set
+}
+
+// CallObserver is notified of each call to an RPC function named method,
+// which took duration and returned res to the caller of ctx.
+type CallObserver func(ctx *types.Context, method string, duration time.Duration, res types.RPCResponse)
+
+// ObserveCalls returns a copy of funcMap whose functions notify observer of
+// each of their calls, e.g. to trace the usage of the RPC.
+func ObserveCalls(funcMap map[string]*RPCFunc, observer CallObserver) map[string]*RPCFunc {
+	observed := make(map[string]*RPCFunc, len(funcMap))
+	for name, f := range funcMap {
+		o := *f
+		o.name, o.observer = name, observer
+		observed[name] = &o
+	}
+	return observed
+}
+
+// observe notifies the observer, if any, of a call started at start, which
+// returned res.
+func (f *RPCFunc) observe(ctx *types.Context, start time.Time, res types.RPCResponse) {
+	if f.observer != nil {
+		f.observer(ctx, f.name, time.Since(start), res)
+	}
 }
 
 // NewRPCFunc wraps a function for introspection.
@@ -115,6 +158,19 @@ func newRPCFunc(f interface{}, args string, options ...Option) *RPCFunc {
 	return r
 }
 
+// minArgs returns the number of positional parameters a call must provide:
+// all the arguments but the optional trailing ones.
+func (f *RPCFunc) minArgs() int {
+	n := len(f.argNames)
+	for n > 0 {
+		if _, ok := f.optionalArgs[f.argNames[n-1]]; !ok {
+			break
+		}
+		n--
+	}
+	return n
+}
+
 // return a function's argument types
 func funcArgTypes(f interface{}) []reflect.Type {
 	t := reflect.TypeOf(f)
@@ -86,6 +86,7 @@ func (wm *WebsocketManager) WebsocketHandler(w http.ResponseWriter, r *http.Requ
 
 	// register connection
 	con := newWSConnection(wsConn, wm.funcMap, wm.wsConnOptions...)
+	con.allowRequest = rateLimitFromContext(r.Context())
 	con.SetLogger(wm.logger.With("remote", wsConn.RemoteAddr()))
 	wm.logger.Info("New websocket connection", "remote", con.remoteAddr)
 	err = con.Start() // BLOCKING
@@ -136,6 +137,9 @@ type wsConnection struct {
 	// callback which is called upon disconnect
 	onDisconnect func(remoteAddr string)
 
+	// takes a request from the rate limit of the client, nil if unlimited
+	allowRequest func() bool
+
 	ctx    context.Context
 	cancel context.CancelFunc
 }
@@ -354,6 +358,14 @@ func (wsc *wsConnection) readRoutine() {
 				continue
 			}
 
+			if wsc.allowRequest != nil && !wsc.allowRequest() {
+				if err := wsc.WriteRPCResponse(writeCtx,
+					types.RPCInvalidRequestError(request.ID, errors.New("rate limit exceeded"))); err != nil {
+					wsc.Logger.Error("Error writing RPC response", "err", err)
+				}
+				continue
+			}
+
 			// Now, fetch the RPCFunc and execute it.
 			rpcFunc := wsc.funcMap[request.Method]
 			if rpcFunc == nil {
@@ -378,6 +390,7 @@ func (wsc *wsConnection) readRoutine() {
 				args = append(args, fnArgs...)
 			}
 
+			start := time.Now()
 			returns := rpcFunc.f.Call(args)
 
 			// TODO: Need to encode args/returns to string if we want to log them
@@ -385,13 +398,17 @@ func (wsc *wsConnection) readRoutine() {
 
 			result, err := unreflectResult(returns)
 			if err != nil {
-				if err := wsc.WriteRPCResponse(writeCtx, types.RPCInternalError(request.ID, err)); err != nil {
+				res := types.RPCInternalError(request.ID, err)
+				rpcFunc.observe(ctx, start, res)
+				if err := wsc.WriteRPCResponse(writeCtx, res); err != nil {
 					wsc.Logger.Error("Error writing RPC response", "err", err)
 				}
 				continue
 			}
 
-			if err := wsc.WriteRPCResponse(writeCtx, types.NewRPCSuccessResponse(request.ID, result)); err != nil {
+			res := types.NewRPCSuccessResponse(request.ID, result)
+			rpcFunc.observe(ctx, start, res)
+			if err := wsc.WriteRPCResponse(writeCtx, res); err != nil {
 				wsc.Logger.Error("Error writing RPC response", "err", err)
 			}
 		}
@@ -0,0 +1,242 @@
+/*
+	protogenv2 generates the protobuf-go (google.golang.org/protobuf) code of
+	the protocol buffers of CometBFT in the api directory, for the projects
+	which can't depend on gogoproto.
+
+	The descriptors are taken from the gogoproto generated code, stripped of
+	the gogoproto options, which don't change the wire format, so that both
+	generated codes stay in sync and their messages are interchangeable.
+
+	Usage:
+			go run ./scripts/protogenv2 [<output dir>]
+*/
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	gengo "google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	// register the gogoproto descriptors
+	_ "github.com/cometbft/cometbft/abci/types"
+	_ "github.com/cometbft/cometbft/proto/tendermint/blockrelay"
+	_ "github.com/cometbft/cometbft/proto/tendermint/blocksync"
+	_ "github.com/cometbft/cometbft/proto/tendermint/consensus"
+	_ "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	_ "github.com/cometbft/cometbft/proto/tendermint/libs/bits"
+	_ "github.com/cometbft/cometbft/proto/tendermint/mempool"
+	_ "github.com/cometbft/cometbft/proto/tendermint/p2p"
+	_ "github.com/cometbft/cometbft/proto/tendermint/privval"
+	_ "github.com/cometbft/cometbft/proto/tendermint/state"
+	_ "github.com/cometbft/cometbft/proto/tendermint/statesync"
+	_ "github.com/cometbft/cometbft/proto/tendermint/store"
+	_ "github.com/cometbft/cometbft/proto/tendermint/types"
+	_ "github.com/cometbft/cometbft/proto/tendermint/version"
+	_ "github.com/cometbft/cometbft/rpc/grpc"
+)
+
+const (
+	module    = "github.com/cometbft/cometbft"
+	apiPrefix = module + "/api/"
+	gogoFile  = "gogoproto/gogo.proto"
+)
+
+// files are the proto files to generate, in dependency order.
+var files = []string{
+	"tendermint/crypto/keys.proto",
+	"tendermint/crypto/proof.proto",
+	"tendermint/crypto/vrf.proto",
+	"tendermint/libs/bits/types.proto",
+	"tendermint/version/types.proto",
+	"tendermint/types/validator.proto",
+	"tendermint/types/types.proto",
+	"tendermint/types/params.proto",
+	"tendermint/types/events.proto",
+	"tendermint/types/evidence.proto",
+	"tendermint/types/block.proto",
+	"tendermint/types/canonical.proto",
+	"tendermint/abci/types.proto",
+	"tendermint/blockrelay/types.proto",
+	"tendermint/blocksync/types.proto",
+	"tendermint/consensus/types.proto",
+	"tendermint/consensus/wal.proto",
+	"tendermint/consensus/state_dump.proto",
+	"tendermint/mempool/types.proto",
+	"tendermint/p2p/types.proto",
+	"tendermint/p2p/conn.proto",
+	"tendermint/p2p/pex.proto",
+	"tendermint/privval/types.proto",
+	"tendermint/rpc/grpc/types.proto",
+	"tendermint/state/types.proto",
+	"tendermint/statesync/types.proto",
+	"tendermint/store/types.proto",
+}
+
+func main() {
+	out := "."
+	if len(os.Args) > 1 {
+		out = os.Args[1]
+	}
+
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: files,
+		Parameter:      proto.String("module=" + module),
+		ProtoFile: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(timestamppb.File_google_protobuf_timestamp_proto),
+			protodesc.ToFileDescriptorProto(durationpb.File_google_protobuf_duration_proto),
+		},
+	}
+	for _, name := range files {
+		fd, err := gogoFileDescriptor(name)
+		if err != nil {
+			exit(err)
+		}
+		req.ProtoFile = append(req.ProtoFile, stripGogo(fd))
+	}
+
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		exit(err)
+	}
+	for _, f := range gen.Files {
+		if f.Generate {
+			gengo.GenerateFile(gen, f)
+		}
+	}
+	resp := gen.Response()
+	if resp.Error != nil {
+		exit(fmt.Errorf("generating code: %s", resp.GetError()))
+	}
+	for _, f := range resp.File {
+		name := filepath.Join(out, f.GetName())
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			exit(err)
+		}
+		if err := os.WriteFile(name, []byte(f.GetContent()), 0o644); err != nil {
+			exit(err)
+		}
+		fmt.Println("wrote", name)
+	}
+}
+
+func exit(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
+
+// gogoFileDescriptor returns the descriptor of the file name registered by
+// the gogoproto generated code.
+func gogoFileDescriptor(name string) (*descriptorpb.FileDescriptorProto, error) {
+	gz := gogoproto.FileDescriptor(name)
+	if gz == nil {
+		return nil, fmt.Errorf("%s is not registered", name)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing %s: %w", name, err)
+	}
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("decompressing %s: %w", name, err)
+	}
+	fd := &descriptorpb.FileDescriptorProto{}
+	if err := proto.Unmarshal(raw, fd); err != nil {
+		return nil, fmt.Errorf("unmarshaling %s: %w", name, err)
+	}
+	return fd, nil
+}
+
+// stripGogo removes the gogoproto options and import from fd, and sets its Go
+// package to the api directory.
+func stripGogo(fd *descriptorpb.FileDescriptorProto) *descriptorpb.FileDescriptorProto {
+	deps := fd.Dependency[:0]
+	for _, dep := range fd.Dependency {
+		if dep != gogoFile {
+			deps = append(deps, dep)
+		}
+	}
+	fd.Dependency = deps
+
+	if fd.Options == nil {
+		fd.Options = &descriptorpb.FileOptions{}
+	}
+	clearUnknown(fd.Options)
+	fd.Options.GoPackage = proto.String(apiPrefix + path.Dir(fd.GetName()))
+
+	for _, m := range fd.MessageType {
+		stripMessage(m)
+	}
+	for _, e := range fd.EnumType {
+		stripEnum(e)
+	}
+	for _, s := range fd.Service {
+		s.Options = stripOptions(s.Options)
+		for _, m := range s.Method {
+			m.Options = stripOptions(m.Options)
+		}
+	}
+	return fd
+}
+
+func stripMessage(m *descriptorpb.DescriptorProto) {
+	m.Options = stripOptions(m.Options)
+	for _, f := range m.Field {
+		f.Options = stripOptions(f.Options)
+	}
+	for _, o := range m.OneofDecl {
+		o.Options = stripOptions(o.Options)
+	}
+	for _, n := range m.NestedType {
+		stripMessage(n)
+	}
+	for _, e := range m.EnumType {
+		stripEnum(e)
+	}
+}
+
+func stripEnum(e *descriptorpb.EnumDescriptorProto) {
+	e.Options = stripOptions(e.Options)
+	for _, v := range e.Value {
+		v.Options = stripOptions(v.Options)
+	}
+}
+
+// stripOptions clears the gogoproto extensions of opts, which are unknown to
+// protobuf-go, returning nil if no option is left.
+func stripOptions[T proto.Message](opts T) T {
+	var zero T
+	if opts.ProtoReflect().IsValid() {
+		clearUnknown(opts)
+		if proto.Size(opts) == 0 {
+			return zero
+		}
+	}
+	return opts
+}
+
+func clearUnknown(m proto.Message) {
+	m.ProtoReflect().SetUnknown(nil)
+	m.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.IsExtension() && strings.HasPrefix(string(fd.FullName()), "gogoproto.") {
+			m.ProtoReflect().Clear(fd)
+		}
+		return true
+	})
+}
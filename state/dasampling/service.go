@@ -0,0 +1,178 @@
+// Package dasampling notifies an external data availability sampler of the
+// committed blocks, e.g. a celestia-node running alongside the node, and
+// keeps track of the blocks whose data it found available.
+package dasampling
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+const subscriber = "DASamplingService"
+
+// Sampler samples the data of committed blocks.
+type Sampler interface {
+	// SampleBlock is called with the header of each committed block, in
+	// increasing height order, and returns once the data of the block, whose
+	// root is header.DataHash, has been sampled. An error vetoes marking the
+	// block as safe: the block is sampled again after the next commit.
+	SampleBlock(ctx context.Context, header *types.Header) error
+}
+
+// BlockStore is the part of the block store the service reads the headers
+// of the committed blocks from.
+type BlockStore interface {
+	Height() int64
+	LoadBlockMeta(height int64) *types.BlockMeta
+}
+
+// Service passes the headers of the blocks committed by the node to a
+// Sampler, and keeps track of the latest safe height: the height up to
+// which the sampler accepted all the blocks, starting from the latest block
+// stored when the service started.
+type Service struct {
+	service.BaseService
+
+	sampler    Sampler
+	blockStore BlockStore
+	eventBus   *types.EventBus
+
+	// closed when the service stops, to cancel the sampling in progress
+	ctx    context.Context
+	cancel context.CancelFunc
+	// signaled when a block is committed
+	committed chan struct{}
+
+	mtx        cmtsync.RWMutex
+	safeHeight int64
+	vetoed     int64 // height of the last vetoed block, 0 if none
+	vetoErr    error
+}
+
+// NewService returns a service passing the blocks committed to sampler.
+func NewService(sampler Sampler, blockStore BlockStore, eventBus *types.EventBus) *Service {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Service{
+		sampler:    sampler,
+		blockStore: blockStore,
+		eventBus:   eventBus,
+		ctx:        ctx,
+		cancel:     cancel,
+		committed:  make(chan struct{}, 1),
+	}
+	s.BaseService = *service.NewBaseService(nil, "DASamplingService", s)
+	return s
+}
+
+// OnStart implements service.Service by subscribing to the headers of the
+// committed blocks and sampling them in the background.
+func (s *Service) OnStart() error {
+	// The subscription is unbuffered so that it is never canceled, and only
+	// signals the sampling routine, which reads the headers from the block
+	// store, so that a slow sampler doesn't slow consensus down.
+	sub, err := s.eventBus.SubscribeUnbuffered(context.Background(), subscriber, types.EventQueryNewBlockHeader)
+	if err != nil {
+		return err
+	}
+
+	// the blocks committed before the service started are not sampled
+	s.mtx.Lock()
+	if height := s.blockStore.Height(); height > 0 {
+		s.safeHeight = height - 1
+	}
+	s.mtx.Unlock()
+	s.signalCommit()
+
+	go func() {
+		for {
+			select {
+			case <-sub.Out():
+				s.signalCommit()
+			case <-sub.Canceled():
+				if sub.Err() != nil {
+					s.Logger.Error("block header subscription canceled", "err", sub.Err())
+				}
+				return
+			case <-s.Quit():
+				return
+			}
+		}
+	}()
+	go s.sampleRoutine()
+	return nil
+}
+
+// OnStop implements service.Service by unsubscribing from the event bus and
+// canceling the sampling in progress.
+func (s *Service) OnStop() {
+	s.cancel()
+	if s.eventBus.IsRunning() {
+		_ = s.eventBus.UnsubscribeAll(context.Background(), subscriber)
+	}
+}
+
+// SafeHeight returns the latest safe height.
+func (s *Service) SafeHeight() int64 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.safeHeight
+}
+
+// Vetoed returns the height of the last block vetoed by the sampler, and
+// the error it returned, if the block is not safe yet.
+func (s *Service) Vetoed() (int64, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.vetoed, s.vetoErr
+}
+
+func (s *Service) signalCommit() {
+	select {
+	case s.committed <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Service) sampleRoutine() {
+	for {
+		select {
+		case <-s.committed:
+			s.sampleCommitted()
+		case <-s.Quit():
+			return
+		}
+	}
+}
+
+// sampleCommitted samples the blocks committed after the latest safe height,
+// stopping at the first one vetoed.
+func (s *Service) sampleCommitted() {
+	for height := s.SafeHeight() + 1; height <= s.blockStore.Height(); height++ {
+		meta := s.blockStore.LoadBlockMeta(height)
+		if meta == nil {
+			s.Logger.Error("committed block not found", "height", height)
+			return
+		}
+		err := s.sampler.SampleBlock(s.ctx, &meta.Header)
+		if s.ctx.Err() != nil {
+			return
+		}
+
+		s.mtx.Lock()
+		if err != nil {
+			s.vetoed, s.vetoErr = height, fmt.Errorf("block %d vetoed: %w", height, err)
+			s.mtx.Unlock()
+			s.Logger.Info("block data not available yet", "height", height, "data_hash", meta.Header.DataHash, "err", err)
+			return
+		}
+		s.safeHeight = height
+		if s.vetoed == height {
+			s.vetoed, s.vetoErr = 0, nil
+		}
+		s.mtx.Unlock()
+	}
+}
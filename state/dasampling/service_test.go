@@ -0,0 +1,119 @@
+package dasampling_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/state/dasampling"
+	"github.com/cometbft/cometbft/types"
+)
+
+type blockStore struct {
+	mtx     cmtsync.Mutex
+	headers []types.Header
+}
+
+func (bs *blockStore) Height() int64 {
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	return int64(len(bs.headers))
+}
+
+func (bs *blockStore) LoadBlockMeta(height int64) *types.BlockMeta {
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	if height < 1 || height > int64(len(bs.headers)) {
+		return nil
+	}
+	return &types.BlockMeta{Header: bs.headers[height-1]}
+}
+
+// commit stores the header of a new block and publishes it.
+func (bs *blockStore) commit(t *testing.T, eventBus *types.EventBus) {
+	bs.mtx.Lock()
+	header := types.Header{Height: int64(len(bs.headers)) + 1, DataHash: []byte("data")}
+	bs.headers = append(bs.headers, header)
+	bs.mtx.Unlock()
+	require.NoError(t, eventBus.PublishEventNewBlockHeader(types.EventDataNewBlockHeader{Header: header}))
+}
+
+// sampler vetoes the blocks of the heights in unavailable.
+type sampler struct {
+	mtx         cmtsync.Mutex
+	sampled     []int64
+	unavailable map[int64]bool
+}
+
+func (s *sampler) SampleBlock(_ context.Context, header *types.Header) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.sampled = append(s.sampled, header.Height)
+	if s.unavailable[header.Height] {
+		return errors.New("unavailable")
+	}
+	return nil
+}
+
+func (s *sampler) setAvailable(height int64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	delete(s.unavailable, height)
+}
+
+func TestService(t *testing.T) {
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() { _ = eventBus.Stop() })
+
+	// the first block is committed before the service starts, the block
+	// before is never sampled
+	bs := &blockStore{}
+	bs.commit(t, eventBus)
+	bs.commit(t, eventBus)
+
+	sampler := &sampler{unavailable: map[int64]bool{4: true}}
+	s := dasampling.NewService(sampler, bs, eventBus)
+	s.SetLogger(log.TestingLogger())
+	require.NoError(t, s.Start())
+	t.Cleanup(func() { _ = s.Stop() })
+
+	require.Eventually(t, func() bool { return s.SafeHeight() == 2 }, time.Second, 10*time.Millisecond)
+
+	bs.commit(t, eventBus)
+	require.Eventually(t, func() bool { return s.SafeHeight() == 3 }, time.Second, 10*time.Millisecond)
+
+	// the vetoed block and the following ones are not safe
+	bs.commit(t, eventBus)
+	require.Eventually(t, func() bool {
+		height, _ := s.Vetoed()
+		return height == 4
+	}, time.Second, 10*time.Millisecond)
+	bs.commit(t, eventBus)
+	require.Eventually(t, func() bool {
+		sampler.mtx.Lock()
+		defer sampler.mtx.Unlock()
+		return len(sampler.sampled) == 4
+	}, time.Second, 10*time.Millisecond)
+	assert.EqualValues(t, 3, s.SafeHeight())
+	_, err := s.Vetoed()
+	assert.Error(t, err)
+
+	// the vetoed block is sampled again after the next commit
+	sampler.setAvailable(4)
+	bs.commit(t, eventBus)
+	require.Eventually(t, func() bool { return s.SafeHeight() == 6 }, time.Second, 10*time.Millisecond)
+	height, err := s.Vetoed()
+	assert.Zero(t, height)
+	assert.NoError(t, err)
+
+	sampler.mtx.Lock()
+	defer sampler.mtx.Unlock()
+	assert.Equal(t, []int64{2, 3, 4, 4, 4, 5, 6}, sampler.sampled)
+}
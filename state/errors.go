@@ -60,8 +60,18 @@ type (
 		Err    error
 		Height int64
 	}
+
+	// ErrHaltedForUpgrade is returned when applying a block after the
+	// height at which the upgrade consensus params halted the node.
+	ErrHaltedForUpgrade struct {
+		Height int64
+	}
 )
 
+func (e ErrHaltedForUpgrade) Error() string {
+	return fmt.Sprintf("halted for upgrade after height #%d", e.Height)
+}
+
 func (e ErrUnknownBlock) Error() string {
 	return fmt.Sprintf("could not find block #%d", e.Height)
 }
@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
 	"github.com/cometbft/cometbft/libs/fail"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/trace"
 	"github.com/cometbft/cometbft/mempool"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/proxy"
@@ -42,7 +44,20 @@ type BlockExecutor struct {
 
 	logger log.Logger
 
-	metrics *Metrics
+	metrics     *Metrics
+	traceClient trace.Tracer
+	hooks       []ExecutionHook
+
+	// heights below which blocks must not be pruned, regardless of the
+	// retain height requested by the app
+	retainHeightLimits []RetainHeightLimit
+
+	// heights after which the app is asked to take a snapshot
+	snapshotSchedule SnapshotSchedule
+
+	// the height after which the upgrade params halted the node, 0 if they
+	// did not
+	haltHeight atomic.Int64
 }
 
 type BlockExecutorOption func(executor *BlockExecutor)
@@ -53,6 +68,33 @@ func BlockExecutorWithMetrics(metrics *Metrics) BlockExecutorOption {
 	}
 }
 
+func BlockExecutorWithTracer(traceClient trace.Tracer) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.traceClient = traceClient
+	}
+}
+
+// RetainHeightLimit returns the lowest height whose block and state must be
+// kept given the latest state, or 0 if any height may be pruned.
+type RetainHeightLimit func(state State) (int64, error)
+
+// BlockExecutorWithRetainHeightLimit prevents pruning blocks and states at or
+// above the height returned by limit, even if the app requests it.
+func BlockExecutorWithRetainHeightLimit(limit RetainHeightLimit) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.retainHeightLimits = append(blockExec.retainHeightLimits, limit)
+	}
+}
+
+// BlockExecutorWithHaltHeight makes the block executor refuse the blocks after
+// height, as if the upgrade params halted the node after applying it, e.g.
+// when the handshake replayed the block.
+func BlockExecutorWithHaltHeight(height int64) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.haltHeight.Store(height)
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -65,14 +107,15 @@ func NewBlockExecutor(
 	options ...BlockExecutorOption,
 ) *BlockExecutor {
 	res := &BlockExecutor{
-		store:      stateStore,
-		proxyApp:   proxyApp,
-		eventBus:   types.NopEventBus{},
-		mempool:    mempool,
-		evpool:     evpool,
-		logger:     logger,
-		metrics:    NopMetrics(),
-		blockStore: blockStore,
+		store:       stateStore,
+		proxyApp:    proxyApp,
+		eventBus:    types.NopEventBus{},
+		mempool:     mempool,
+		evpool:      evpool,
+		logger:      logger,
+		metrics:     NopMetrics(),
+		traceClient: trace.NoOpTracer(),
+		blockStore:  blockStore,
 	}
 
 	for _, option := range options {
@@ -86,6 +129,13 @@ func (blockExec *BlockExecutor) Store() Store {
 	return blockExec.store
 }
 
+// HaltHeight returns the height of the block after which the upgrade
+// consensus params halted the node, or 0 if they did not. The blocks after it
+// are not applied until the node is restarted.
+func (blockExec *BlockExecutor) HaltHeight() int64 {
+	return blockExec.haltHeight.Load()
+}
+
 // SetEventBus - sets the event bus for publishing block related events.
 // If not called, it defaults to types.NopEventBus.
 func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher) {
@@ -235,6 +285,10 @@ func (blockExec *BlockExecutor) ApplyBlock(
 }
 
 func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, block *types.Block, lastCommit *types.Commit) (State, error) {
+	if halt := blockExec.haltHeight.Load(); halt > 0 && block.Height > halt {
+		return state, ErrHaltedForUpgrade{Height: halt}
+	}
+
 	startTime := time.Now().UnixNano()
 
 	// Unmarshal blob txs
@@ -263,6 +317,7 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 	})
 	endTime := time.Now().UnixNano()
 	blockExec.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
+	blockExec.observePhase(block.Height, PhaseFinalizeBlock, time.Unix(0, startTime))
 	if err != nil {
 		blockExec.logger.Error("error in proxyAppConn.FinalizeBlock", "err", err)
 		return state, err
@@ -286,19 +341,23 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 	// This needs to be done prior to saving state
 	// for correct crash recovery
 	if blockExec.blockStore != nil {
+		phaseStart := time.Now()
 		respCodes := getResponseCodes(abciResponse.TxResults)
 		logs := getLogs(abciResponse.TxResults)
 		if err := blockExec.blockStore.SaveTxInfo(block, respCodes, logs); err != nil {
 			return state, err
 		}
+		blockExec.observePhase(block.Height, PhaseSaveTxInfo, phaseStart)
 	}
 
 	fail.Fail() // XXX
 
 	// Save the results before we commit.
+	phaseStart := time.Now()
 	if err := blockExec.store.SaveFinalizeBlockResponse(block.Height, abciResponse); err != nil {
 		return state, err
 	}
+	blockExec.observePhase(block.Height, PhaseSaveFinalizeBlockResponse, phaseStart)
 
 	fail.Fail() // XXX
 
@@ -320,6 +379,11 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 		blockExec.metrics.ConsensusParamUpdates.Add(1)
 	}
 
+	// Decide before the state moves on to the block, as it is decided
+	// against the time of the previous block.
+	snapshot := blockExec.snapshotSchedule.Due(state.LastBlockTime, block)
+	lastBlockTime := state.LastBlockTime
+
 	// Update the state with the block and responses.
 	state, err = updateState(state, blockID, &block.Header, abciResponse, validatorUpdates)
 	if err != nil {
@@ -327,7 +391,7 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 	}
 
 	// Lock mempool, commit app state, update mempoool.
-	retainHeight, err := blockExec.Commit(state, block, abciResponse)
+	retainHeight, err := blockExec.Commit(state, block, abciResponse, snapshot)
 	if err != nil {
 		return state, fmt.Errorf("commit failed for application: %v", err)
 	}
@@ -339,25 +403,37 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 
 	// Update the app hash and save the state.
 	state.AppHash = abciResponse.AppHash
+	phaseStart = time.Now()
 	if err := blockExec.store.Save(state); err != nil {
 		return state, err
 	}
+	blockExec.observePhase(block.Height, PhaseSaveState, phaseStart)
+
+	// Refuse the next blocks if the upgrade params halt the node after this
+	// one, whether it is applied by consensus, block sync or the handshake.
+	if state.ConsensusParams.Upgrade.HaltsAfter(block.Height, lastBlockTime, block.Time) {
+		blockExec.haltHeight.Store(block.Height)
+	}
 
 	fail.Fail() // XXX
 
 	// Prune old heights, if requested by ABCI app.
 	if retainHeight > 0 {
+		phaseStart = time.Now()
 		pruned, err := blockExec.pruneBlocks(retainHeight, state)
 		if err != nil {
 			blockExec.logger.Error("failed to prune blocks", "retain_height", retainHeight, "err", err)
 		} else {
 			blockExec.logger.Debug("pruned blocks", "pruned", pruned, "retain_height", retainHeight)
 		}
+		blockExec.observePhase(block.Height, PhasePrune, phaseStart)
 	}
 
 	// Events are fired after everything else.
 	// NOTE: if we crash between Commit and Save, events wont be fired during replay
-	fireEvents(blockExec.logger, blockExec.eventBus, block, blockID, abciResponse, validatorUpdates, state.Validators, lastCommit)
+	phaseStart = time.Now()
+	fireEvents(blockExec.logger, blockExec.eventBus, block, blockID, abciResponse, validatorUpdates, state.Validators, state.NextValidators, lastCommit)
+	blockExec.observePhase(block.Height, PhaseFireEvents, phaseStart)
 
 	return state, nil
 }
@@ -421,6 +497,8 @@ func (blockExec *BlockExecutor) VerifyVoteExtension(ctx context.Context, vote *t
 // The application is expected to have persisted its state (if any) before returning
 // from the ABCI Commit call. This is the only place where the application should
 // persist its state.
+// If snapshot is set, the application is asked to take a state sync snapshot
+// of the committed state.
 // The Mempool must be locked during commit and update because state is
 // typically reset on Commit and old txs must be replayed against committed
 // state before new txs are run in the mempool, lest they be invalid.
@@ -428,6 +506,7 @@ func (blockExec *BlockExecutor) Commit(
 	state State,
 	block *types.Block,
 	abciResponse *abci.ResponseFinalizeBlock,
+	snapshot bool,
 ) (int64, error) {
 	blockExec.mempool.Lock()
 	defer blockExec.mempool.Unlock()
@@ -441,11 +520,13 @@ func (blockExec *BlockExecutor) Commit(
 	}
 
 	// Commit block, get hash back
-	res, err := blockExec.proxyApp.Commit(context.TODO())
+	phaseStart := time.Now()
+	res, err := blockExec.proxyApp.CommitWithSnapshot(context.TODO(), snapshot)
 	if err != nil {
 		blockExec.logger.Error("client error during proxyAppConn.CommitSync", "err", err)
 		return 0, err
 	}
+	blockExec.observePhase(block.Height, PhaseCommit, phaseStart)
 
 	// ResponseCommit has no error code - just data
 	blockExec.logger.Info(
@@ -455,6 +536,7 @@ func (blockExec *BlockExecutor) Commit(
 	)
 
 	// Update mempool.
+	phaseStart = time.Now()
 	err = blockExec.mempool.Update(
 		block.Height,
 		block.Txs,
@@ -462,6 +544,7 @@ func (blockExec *BlockExecutor) Commit(
 		TxPreCheck(state),
 		TxPostCheck(state),
 	)
+	blockExec.observePhase(block.Height, PhaseMempoolUpdate, phaseStart)
 
 	return res.RetainHeight, err
 }
@@ -713,6 +796,7 @@ func fireEvents(
 	abciResponse *abci.ResponseFinalizeBlock,
 	validatorUpdates []*types.Validator,
 	currentValidators *types.ValidatorSet,
+	nextValidators *types.ValidatorSet,
 	lastCommit *types.Commit,
 ) {
 	if err := eventBus.PublishEventNewBlock(types.EventDataNewBlock{
@@ -777,7 +861,7 @@ func fireEvents(
 
 	if len(validatorUpdates) > 0 {
 		if err := eventBus.PublishEventValidatorSetUpdates(
-			types.EventDataValidatorSetUpdates{ValidatorUpdates: validatorUpdates}); err != nil {
+			types.NewEventDataValidatorSetUpdates(block.Height, validatorUpdates, nextValidators)); err != nil {
 			logger.Error("failed publishing event", "err", err)
 		}
 	}
@@ -835,6 +919,17 @@ func ExecCommitBlock(
 }
 
 func (blockExec *BlockExecutor) pruneBlocks(retainHeight int64, state State) (uint64, error) {
+	for _, limit := range blockExec.retainHeightLimits {
+		limitHeight, err := limit(state)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get retain height limit: %w", err)
+		}
+		if limitHeight > 0 && limitHeight < retainHeight {
+			blockExec.logger.Debug("lowering retain height", "retain_height", retainHeight, "limit", limitHeight)
+			retainHeight = limitHeight
+		}
+	}
+
 	base := blockExec.blockStore.Base()
 	if retainHeight <= base {
 		return 0, nil
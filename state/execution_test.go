@@ -79,6 +79,92 @@ func TestApplyBlock(t *testing.T) {
 	assert.EqualValues(t, 1, state.Version.Consensus.App, "App version wasn't updated")
 }
 
+func TestPruneBlocksRetainHeightLimit(t *testing.T) {
+	state, _, _ := makeState(1, 1)
+	limit := int64(0)
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Base").Return(int64(1))
+	stateStore := &mocks.Store{}
+	stateStore.On("PruneStates", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), nil, nil, sm.EmptyEvidencePool{}, blockStore,
+		sm.BlockExecutorWithRetainHeightLimit(func(sm.State) (int64, error) { return limit, nil }))
+
+	// no limit
+	blockStore.On("PruneBlocks", int64(10), state).Return(uint64(9), int64(10), nil).Once()
+	pruned, err := blockExec.PruneBlocks(10, state)
+	require.NoError(t, err)
+	assert.EqualValues(t, 9, pruned)
+
+	// the limit lowers the retain height
+	limit = 5
+	blockStore.On("PruneBlocks", int64(5), state).Return(uint64(4), int64(5), nil).Once()
+	pruned, err = blockExec.PruneBlocks(10, state)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, pruned)
+
+	// but never raises it
+	limit = 20
+	blockStore.On("PruneBlocks", int64(10), state).Return(uint64(9), int64(10), nil).Once()
+	_, err = blockExec.PruneBlocks(10, state)
+	require.NoError(t, err)
+
+	blockStore.AssertExpectations(t)
+}
+
+func TestApplyBlockExecutionHooks(t *testing.T) {
+	app := &testApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc, proxy.NopMetrics())
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, _ := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+	mp := &mpmocks.Mempool{}
+	mp.On("Lock").Return()
+	mp.On("Unlock").Return()
+	mp.On("FlushAppConn", mock.Anything).Return(nil)
+	mp.On("Update",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything).Return(nil)
+
+	var phases []sm.ExecutionPhase
+	hook := func(height int64, phase sm.ExecutionPhase, _ time.Duration) {
+		assert.EqualValues(t, 1, height)
+		phases = append(phases, phase)
+	}
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+		mp, sm.EmptyEvidencePool{}, blockStore, sm.BlockExecutorWithExecutionHook(hook))
+
+	block := makeBlock(state, 1, new(types.Commit))
+	bps, err := block.MakePartSet(testPartSize)
+	require.NoError(t, err)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: bps.Header()}
+
+	_, err = blockExec.ApplyBlock(state, blockID, block, nil)
+	require.Nil(t, err)
+
+	assert.Equal(t, []sm.ExecutionPhase{
+		sm.PhaseFinalizeBlock,
+		sm.PhaseSaveTxInfo,
+		sm.PhaseSaveFinalizeBlockResponse,
+		sm.PhaseCommit,
+		sm.PhaseMempoolUpdate,
+		sm.PhaseSaveState,
+		sm.PhasePrune,
+		sm.PhaseFireEvents,
+	}, phases)
+}
+
 // TestFinalizeBlockDecidedLastCommit ensures we correctly send the
 // DecidedLastCommit to the application. The test ensures that the
 // DecidedLastCommit properly reflects which validators signed the preceding
@@ -657,6 +743,9 @@ func TestFinalizeBlockValidatorUpdates(t *testing.T) {
 			assert.Equal(t, pubkey, event.ValidatorUpdates[0].PubKey)
 			assert.EqualValues(t, 10, event.ValidatorUpdates[0].VotingPower)
 		}
+		assert.Equal(t, block.Height, event.Height)
+		assert.EqualValues(t, state.NextValidators.Hash(), event.NextValidatorsHash)
+		assert.NoError(t, event.VerifyProofs())
 	case <-updatesSub.Canceled():
 		t.Fatalf("updatesSub was canceled (reason: %v)", updatesSub.Err())
 	case <-time.After(1 * time.Second):
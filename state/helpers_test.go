@@ -241,6 +241,7 @@ type testApp struct {
 	LastTime         time.Time
 	ValidatorUpdates []abci.ValidatorUpdate
 	AppHash          []byte
+	Snapshot         bool
 }
 
 var _ abci.Application = (*testApp)(nil)
@@ -268,7 +269,8 @@ func (app *testApp) FinalizeBlock(_ context.Context, req *abci.RequestFinalizeBl
 	}, nil
 }
 
-func (app *testApp) Commit(_ context.Context, _ *abci.RequestCommit) (*abci.ResponseCommit, error) {
+func (app *testApp) Commit(_ context.Context, req *abci.RequestCommit) (*abci.ResponseCommit, error) {
+	app.Snapshot = req.Snapshot
 	return &abci.ResponseCommit{RetainHeight: 1}, nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"sort"
 	"strconv"
@@ -35,6 +36,9 @@ type BlockerIndexer struct {
 	// Matching will be done both on height AND eventSeq
 	eventSeq int64
 	log      log.Logger
+
+	// true once the first height indexed with numeric event keys is recorded
+	numericIndexFromSet bool
 }
 
 func New(store dbm.DB) *BlockerIndexer {
@@ -63,6 +67,7 @@ func (idx *BlockerIndexer) Has(height int64) (bool, error) {
 //
 // primary key: encode(block.height | height) => encode(height)
 // FinalizeBlock events: encode(eventType.eventAttr|eventValue|height|finalize_block|eventSeq) => encode(height)
+// numeric FinalizeBlock events: encode(numeric_event|eventType.eventAttr|float(eventValue)|eventValue|height|eventSeq) => encode(height)
 func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockEvents) error {
 	batch := idx.store.NewBatch()
 	defer batch.Close()
@@ -83,7 +88,29 @@ func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockEvents) error {
 		return fmt.Errorf("failed to index FinalizeBlock events: %w", err)
 	}
 
-	return batch.WriteSync()
+	// 3. record the first height indexed with numeric event keys, blocks
+	// indexed before require scanning all values of range queries
+	if !idx.numericIndexFromSet {
+		key, err := numericIndexFromKey()
+		if err != nil {
+			return err
+		}
+		ok, err := idx.store.Has(key)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			if err := batch.Set(key, int64ToBytes(height)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	idx.numericIndexFromSet = true
+	return nil
 }
 
 // Search performs a query for block heights that match a given FinalizeBlock
@@ -270,6 +297,16 @@ func (idx *BlockerIndexer) matchRange(
 		return filteredHeights, nil
 	}
 
+	if _, ok := qr.AnyBound().(*big.Float); ok && qr.Key != types.BlockHeightKey {
+		ok, err := idx.numericIndexComplete()
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return idx.matchNumericRange(ctx, qr, filteredHeights, firstRun, heightInfo)
+		}
+	}
+
 	tmpHeights := make(map[string][]byte)
 
 	it, err := dbm.IteratePrefix(idx.store, startKey)
@@ -384,6 +421,135 @@ FOR_LOOP:
 	return filteredHeights, nil
 }
 
+// numericIndexComplete returns true if all indexed blocks have numeric event
+// keys, i.e. no block was indexed before they were introduced.
+func (idx *BlockerIndexer) numericIndexComplete() (bool, error) {
+	key, err := numericIndexFromKey()
+	if err != nil {
+		return false, err
+	}
+	bz, err := idx.store.Get(key)
+	if err != nil || bz == nil {
+		return false, err
+	}
+
+	start, err := orderedcode.Append(nil, types.BlockHeightKey)
+	if err != nil {
+		return false, err
+	}
+	end, err := heightKey(int64FromBytes(bz))
+	if err != nil {
+		return false, err
+	}
+	it, err := idx.store.Iterator(start, end)
+	if err != nil {
+		return false, err
+	}
+	defer it.Close()
+
+	return !it.Valid(), it.Error()
+}
+
+// matchNumericRange is matchRange for numeric bounds, seeking to the values
+// within the range using the numeric event keys. Values are ordered by their
+// float64 approximation, so the exact bounds are checked on each value.
+func (idx *BlockerIndexer) matchNumericRange(
+	ctx context.Context,
+	qr indexer.QueryRange,
+	filteredHeights map[string][]byte,
+	firstRun bool,
+	heightInfo HeightInfo,
+) (map[string][]byte, error) {
+	prefix, err := orderedcode.Append(nil, numericEventPrefix, qr.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prefix key: %w", err)
+	}
+
+	start, end := prefix, prefixEnd(prefix)
+	if qr.LowerBound != nil {
+		lower, _ := qr.LowerBound.(*big.Float).Float64()
+		if start, err = orderedcode.Append(prefix, lower); err != nil {
+			return nil, err
+		}
+	}
+	if qr.UpperBound != nil {
+		// values rounding to the upper bound may still be within it
+		upper, _ := qr.UpperBound.(*big.Float).Float64()
+		if upper := math.Nextafter(upper, math.Inf(1)); !math.IsInf(upper, 1) {
+			if end, err = orderedcode.Append(prefix, upper); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	it, err := idx.store.Iterator(start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create range iterator: %w", err)
+	}
+	defer it.Close()
+
+	tmpHeights := make(map[string][]byte)
+
+LOOP:
+	for ; it.Valid(); it.Next() {
+		eventValue, keyHeight, eventSeq, err := parseNumericEventKey(it.Key())
+		if err != nil {
+			continue
+		}
+
+		withinHeight, err := checkHeightConditions(heightInfo, keyHeight)
+		if err != nil {
+			idx.log.Error("failure checking for height bounds:", err)
+			continue
+		}
+		if !withinHeight {
+			continue
+		}
+
+		withinBounds, err := checkNumericBounds(qr, eventValue)
+		if err != nil {
+			idx.log.Error("failed to parse bounds:", err)
+		} else if withinBounds {
+			// Copy the value because the iterator will be reused.
+			value := make([]byte, len(it.Value()))
+			copy(value, it.Value())
+			tmpHeights[string(value)+strconv.FormatInt(eventSeq, 10)] = value
+		}
+
+		select {
+		case <-ctx.Done():
+			break LOOP
+		default:
+		}
+	}
+
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	if len(tmpHeights) == 0 || firstRun {
+		return tmpHeights, nil
+	}
+
+	// Remove/reduce matches in filteredHeights that were not found in this
+	// match (tmpHeights).
+FOR_LOOP:
+	for k, v := range filteredHeights {
+		tmpHeight := tmpHeights[k]
+		if tmpHeight == nil || !bytes.Equal(tmpHeight, v) {
+			delete(filteredHeights, k)
+
+			select {
+			case <-ctx.Done():
+				break FOR_LOOP
+			default:
+			}
+		}
+	}
+
+	return filteredHeights, nil
+}
+
 func (idx *BlockerIndexer) setTmpHeights(tmpHeights map[string][]byte, it dbm.Iterator) {
 	// If we return attributes that occur within the same events, then store the
 	// event sequence in the result map as well.
@@ -609,6 +775,17 @@ func (idx *BlockerIndexer) indexEvents(batch dbm.Batch, events []abci.Event, hei
 				if err := batch.Set(key, heightBz); err != nil {
 					return err
 				}
+
+				if v, ok := numericValue(attr.Value); ok {
+					key, err := numericEventKey(compositeKey, v, attr.Value, height, idx.eventSeq)
+					if err != nil {
+						return fmt.Errorf("failed to create block numeric index key: %w", err)
+					}
+
+					if err := batch.Set(key, heightBz); err != nil {
+						return err
+					}
+				}
 			}
 		}
 	}
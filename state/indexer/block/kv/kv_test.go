@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/orderedcode"
 	"github.com/stretchr/testify/require"
 
 	db "github.com/cometbft/cometbft-db"
@@ -437,3 +438,73 @@ func TestBigInt(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockIndexerNumericRange(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	indexer := blockidxkv.New(store)
+
+	values := []string{"-20", "-1.5", "0", "7", "1000000", "1000000.5", "2000001", "not_a_number"}
+	for i, v := range values {
+		require.NoError(t, indexer.Index(types.EventDataNewBlockEvents{
+			Height: int64(i + 1),
+			Events: []abci.Event{
+				{
+					Type: "block",
+					Attributes: []abci.EventAttribute{
+						{Key: "gas_used", Value: v, Index: true},
+					},
+				},
+			},
+		}))
+	}
+
+	testCases := map[string][]int64{
+		"block.gas_used > 1000000":                                                {6, 7},
+		"block.gas_used >= 1000000":                                               {5, 6, 7},
+		"block.gas_used < 0":                                                      {1, 2},
+		"block.gas_used <= 0":                                                     {1, 2, 3},
+		"block.gas_used >= 0 AND block.gas_used < 1000000":                        {3, 4},
+		"block.gas_used >= 7 AND block.gas_used <= 1000000.5":                     {4, 5, 6},
+		"block.gas_used > 1000000.5":                                              {7},
+		"block.gas_used > 2000001":                                                {},
+		"block.gas_used > 0 AND block.height < 6":                                 {4, 5},
+		"block.gas_used > 0 AND block.gas_used < 2000001.5 AND block.height >= 6": {6, 7},
+	}
+
+	for q, want := range testCases {
+		t.Run(q, func(t *testing.T) {
+			results, err := indexer.Search(context.Background(), query.MustCompile(q))
+			require.NoError(t, err)
+			require.Equal(t, want, results)
+		})
+	}
+}
+
+func TestBlockIndexerNumericRangeLegacy(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+
+	// block 1 was indexed before numeric event keys were introduced
+	key, err := orderedcode.Append(nil, types.BlockHeightKey, int64(1))
+	require.NoError(t, err)
+	require.NoError(t, store.Set(key, []byte{0x02}))
+	key, err = orderedcode.Append(nil, "block.gas_used", "500", int64(1), int64(1))
+	require.NoError(t, err)
+	require.NoError(t, store.Set(key, []byte{0x02}))
+
+	indexer := blockidxkv.New(store)
+	require.NoError(t, indexer.Index(types.EventDataNewBlockEvents{
+		Height: 2,
+		Events: []abci.Event{
+			{
+				Type: "block",
+				Attributes: []abci.EventAttribute{
+					{Key: "gas_used", Value: "600", Index: true},
+				},
+			},
+		},
+	}))
+
+	results, err := indexer.Search(context.Background(), query.MustCompile("block.gas_used > 100"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, results)
+}
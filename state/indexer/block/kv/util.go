@@ -14,6 +14,11 @@ import (
 	"github.com/cometbft/cometbft/types"
 )
 
+const (
+	numericEventPrefix = "numeric_event"
+	numericIndexFrom   = "numeric_event_from"
+)
+
 type HeightInfo struct {
 	heightRange     indexer.QueryRange
 	height          int64
@@ -61,6 +66,85 @@ func eventKey(compositeKey, eventValue string, height int64, eventSeq int64) ([]
 	)
 }
 
+// numericEventKey returns the key ordering the numeric value of an event
+// attribute, so that range queries can seek to the matching values instead of
+// scanning all of them. The prefix cannot collide with event keys, which
+// always contain a dot.
+func numericEventKey(compositeKey string, numericValue float64, eventValue string, height int64, eventSeq int64) ([]byte, error) {
+	return orderedcode.Append(
+		nil,
+		numericEventPrefix,
+		compositeKey,
+		numericValue,
+		eventValue,
+		height,
+		eventSeq,
+	)
+}
+
+// numericIndexFromKey returns the key storing the first height indexed with
+// numeric event keys.
+func numericIndexFromKey() ([]byte, error) {
+	return orderedcode.Append(nil, numericIndexFrom)
+}
+
+// numericValue returns the value used to order an event attribute value, if
+// it is a number.
+func numericValue(eventValue string) (float64, bool) {
+	f, _, err := big.ParseFloat(eventValue, 10, 125, big.ToNearestEven)
+	if err != nil {
+		return 0, false
+	}
+	v, _ := f.Float64()
+	return v, true
+}
+
+// checkNumericBounds returns true if the numeric event value is within the
+// bounds of qr.
+func checkNumericBounds(qr indexer.QueryRange, eventValue string) (bool, error) {
+	if v, ok := new(big.Int).SetString(eventValue, 10); ok {
+		return idxutil.CheckBounds(qr, v)
+	}
+	// The precision here is 125. For numbers bigger than this, the value
+	// will not be parsed properly
+	vF, _, err := big.ParseFloat(eventValue, 10, 125, big.ToNearestEven)
+	if err != nil {
+		return false, nil
+	}
+	return idxutil.CheckBounds(qr, vF)
+}
+
+func parseNumericEventKey(key []byte) (eventValue string, height int64, eventSeq int64, err error) {
+	var (
+		prefix, compositeKey string
+		numericValue         float64
+	)
+
+	remaining, err := orderedcode.Parse(string(key), &prefix, &compositeKey, &numericValue, &eventValue, &height, &eventSeq)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("failed to parse numeric event key: %w", err)
+	}
+	if len(remaining) != 0 {
+		return "", 0, 0, fmt.Errorf("unexpected remainder in key: %s", remaining)
+	}
+
+	return eventValue, height, eventSeq, nil
+}
+
+// prefixEnd returns the smallest key greater than all keys starting with
+// prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
 func parseValueFromPrimaryKey(key []byte) (string, error) {
 	var (
 		compositeKey string
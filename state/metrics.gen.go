@@ -46,15 +46,24 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "processed_transactions",
 			Help:      "The number of transactions processed by the application.",
 		}, labels).With(labelsAndValues...),
+		BlockExecutionPhaseSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "block_execution_phase_seconds",
+			Help:      "Time spent in each phase of block execution, such as the ABCI FinalizeBlock and Commit calls and the state store writes.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.001, 10, 10),
+		}, append(labels, "phase")).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		BlockProcessingTime:   discard.NewHistogram(),
-		ConsensusParamUpdates: discard.NewCounter(),
-		ValidatorSetUpdates:   discard.NewCounter(),
-		RejectedTransactions:  discard.NewCounter(),
-		ProcessedTransactions: discard.NewCounter(),
+		BlockProcessingTime:        discard.NewHistogram(),
+		ConsensusParamUpdates:      discard.NewCounter(),
+		ValidatorSetUpdates:        discard.NewCounter(),
+		RejectedTransactions:       discard.NewCounter(),
+		ProcessedTransactions:      discard.NewCounter(),
+		BlockExecutionPhaseSeconds: discard.NewHistogram(),
 	}
 }
@@ -32,4 +32,8 @@ type Metrics struct {
 
 	// The number of transactions processed by the application.
 	ProcessedTransactions metrics.Counter
+
+	// Time spent in each phase of block execution, such as the ABCI
+	// FinalizeBlock and Commit calls and the state store writes.
+	BlockExecutionPhaseSeconds metrics.Histogram `metrics_labels:"phase" metrics_buckettype:"exprange" metrics_bucketsizes:"0.001, 10, 10"`
 }
@@ -0,0 +1,69 @@
+package state
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/libs/trace/schema"
+)
+
+// ExecutionPhase identifies a phase of block execution whose duration is
+// recorded by the BlockExecutor.
+type ExecutionPhase string
+
+const (
+	// PhaseFinalizeBlock is the ABCI FinalizeBlock call to the application.
+	PhaseFinalizeBlock ExecutionPhase = "finalize_block"
+	// PhaseSaveTxInfo is the indexing of the transaction results in the
+	// block store.
+	PhaseSaveTxInfo ExecutionPhase = "save_tx_info"
+	// PhaseSaveFinalizeBlockResponse is the write of the FinalizeBlock
+	// response to the state store.
+	PhaseSaveFinalizeBlockResponse ExecutionPhase = "save_finalize_block_response"
+	// PhaseCommit is the ABCI Commit call to the application.
+	PhaseCommit ExecutionPhase = "commit"
+	// PhaseMempoolUpdate is the update of the mempool with the committed
+	// transactions, including rechecking the remaining ones.
+	PhaseMempoolUpdate ExecutionPhase = "mempool_update"
+	// PhaseSaveState is the write of the new state to the state store.
+	PhaseSaveState ExecutionPhase = "save_state"
+	// PhasePrune is the pruning of blocks and states below the retain height.
+	PhasePrune ExecutionPhase = "prune"
+	// PhaseFireEvents is the publishing of the block events, which are
+	// indexed by the indexer service.
+	PhaseFireEvents ExecutionPhase = "fire_events"
+	// PhaseIndex is the indexing of the block events and transaction results
+	// by the indexer service. It runs after PhaseFireEvents, concurrently
+	// with the execution of the next blocks, and is observed through
+	// ObservePhase.
+	PhaseIndex ExecutionPhase = "index"
+)
+
+// ExecutionHook is called once per phase of block execution with the height
+// of the block and the time spent in the phase. Hooks are called
+// synchronously on the consensus path and must return quickly.
+type ExecutionHook func(height int64, phase ExecutionPhase, duration time.Duration)
+
+// BlockExecutorWithExecutionHook adds a hook which is called with the duration
+// of every phase of block execution.
+func BlockExecutorWithExecutionHook(hook ExecutionHook) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.hooks = append(blockExec.hooks, hook)
+	}
+}
+
+// observePhase records the time elapsed since start as the duration of the
+// given phase.
+func (blockExec *BlockExecutor) observePhase(height int64, phase ExecutionPhase, start time.Time) {
+	blockExec.ObservePhase(height, phase, time.Since(start))
+}
+
+// ObservePhase records the duration of a phase of the execution of the block
+// at height in the metrics and the trace, and passes it on to the hooks. It
+// is used for the phases run outside of the BlockExecutor, i.e. PhaseIndex.
+func (blockExec *BlockExecutor) ObservePhase(height int64, phase ExecutionPhase, duration time.Duration) {
+	blockExec.metrics.BlockExecutionPhaseSeconds.With("phase", string(phase)).Observe(duration.Seconds())
+	schema.WriteBlockExecutionPhase(blockExec.traceClient, height, string(phase), duration)
+	for _, hook := range blockExec.hooks {
+		hook(height, phase, duration)
+	}
+}
@@ -0,0 +1,127 @@
+package state
+
+import (
+	"sort"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+// ResourceUsageSources are the counters sampled by a ResourceUsageReporter.
+// All of them are cumulative since the node started, and any of them may be
+// nil, in which case the corresponding usage is reported as zero.
+type ResourceUsageSources struct {
+	// Traffic returns the bytes sent and received over each p2p channel.
+	Traffic func() []types.ChannelUsage
+	// DBWriteBytes returns the bytes written to the databases.
+	DBWriteBytes func() int64
+	// MempoolTxsAdded returns the number of transactions admitted to the
+	// mempool.
+	MempoolTxsAdded func() int64
+	// MempoolSize returns the number of transactions in the mempool.
+	MempoolSize func() int
+}
+
+// ResourceUsageReporter publishes an EventDataResourceUsage after the
+// execution of every block, with the resources used since the previous one.
+// Its Hook must be added to the BlockExecutor with
+// BlockExecutorWithExecutionHook.
+type ResourceUsageReporter struct {
+	publisher types.ResourceUsageEventPublisher
+	sources   ResourceUsageSources
+
+	mtx      cmtsync.Mutex
+	abciTime time.Duration
+	last     resourceUsageSnapshot
+}
+
+type resourceUsageSnapshot struct {
+	traffic         map[byte]types.ChannelUsage
+	dbWriteBytes    int64
+	mempoolTxsAdded int64
+	mempoolSize     int
+}
+
+// NewResourceUsageReporter returns a ResourceUsageReporter publishing to
+// publisher the usage measured by sources.
+func NewResourceUsageReporter(
+	publisher types.ResourceUsageEventPublisher,
+	sources ResourceUsageSources,
+) *ResourceUsageReporter {
+	r := &ResourceUsageReporter{
+		publisher: publisher,
+		sources:   sources,
+	}
+	r.last = r.snapshot()
+	return r
+}
+
+// Hook is an ExecutionHook accumulating the time spent in the application and
+// publishing the report of the height once its events have been fired.
+func (r *ResourceUsageReporter) Hook(height int64, phase ExecutionPhase, duration time.Duration) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	switch phase {
+	case PhaseFinalizeBlock, PhaseCommit:
+		r.abciTime += duration
+		return
+	case PhaseFireEvents:
+	default:
+		return
+	}
+
+	current := r.snapshot()
+	usage := types.EventDataResourceUsage{
+		Height:          height,
+		ABCITime:        r.abciTime,
+		DBWriteBytes:    current.dbWriteBytes - r.last.dbWriteBytes,
+		MempoolTxsAdded: current.mempoolTxsAdded - r.last.mempoolTxsAdded,
+	}
+	// Transactions leave the mempool when committed, evicted or invalidated
+	// on recheck, which are all accounted for by the change of its size.
+	usage.MempoolTxsRemoved = usage.MempoolTxsAdded - int64(current.mempoolSize-r.last.mempoolSize)
+	for _, id := range sortedChannelIDs(current.traffic) {
+		now, before := current.traffic[id], r.last.traffic[id]
+		sent, received := now.SentBytes-before.SentBytes, now.ReceivedBytes-before.ReceivedBytes
+		if sent == 0 && received == 0 {
+			continue
+		}
+		usage.Channels = append(usage.Channels, types.ChannelUsage{ID: id, SentBytes: sent, ReceivedBytes: received})
+	}
+	r.last = current
+	r.abciTime = 0
+
+	// The publisher only fails if the event bus is stopped, in which case
+	// the node is shutting down.
+	_ = r.publisher.PublishEventResourceUsage(usage)
+}
+
+func (r *ResourceUsageReporter) snapshot() resourceUsageSnapshot {
+	s := resourceUsageSnapshot{traffic: make(map[byte]types.ChannelUsage)}
+	if r.sources.Traffic != nil {
+		for _, ch := range r.sources.Traffic() {
+			s.traffic[ch.ID] = ch
+		}
+	}
+	if r.sources.DBWriteBytes != nil {
+		s.dbWriteBytes = r.sources.DBWriteBytes()
+	}
+	if r.sources.MempoolTxsAdded != nil {
+		s.mempoolTxsAdded = r.sources.MempoolTxsAdded()
+	}
+	if r.sources.MempoolSize != nil {
+		s.mempoolSize = r.sources.MempoolSize()
+	}
+	return s
+}
+
+func sortedChannelIDs(traffic map[byte]types.ChannelUsage) []byte {
+	ids := make([]byte, 0, len(traffic))
+	for id := range traffic {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
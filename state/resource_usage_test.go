@@ -0,0 +1,71 @@
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+type resourceUsageRecorder struct {
+	reports []types.EventDataResourceUsage
+}
+
+func (r *resourceUsageRecorder) PublishEventResourceUsage(u types.EventDataResourceUsage) error {
+	r.reports = append(r.reports, u)
+	return nil
+}
+
+func TestResourceUsageReporter(t *testing.T) {
+	var (
+		traffic    = []types.ChannelUsage{{ID: 0x30, SentBytes: 100, ReceivedBytes: 50}}
+		dbWrites   int64
+		txsAdded   int64
+		mempoolLen int
+	)
+	recorder := &resourceUsageRecorder{}
+	reporter := sm.NewResourceUsageReporter(recorder, sm.ResourceUsageSources{
+		Traffic:         func() []types.ChannelUsage { return traffic },
+		DBWriteBytes:    func() int64 { return dbWrites },
+		MempoolTxsAdded: func() int64 { return txsAdded },
+		MempoolSize:     func() int { return mempoolLen },
+	})
+
+	traffic = []types.ChannelUsage{
+		{ID: 0x30, SentBytes: 150, ReceivedBytes: 50},
+		{ID: 0x20, SentBytes: 10, ReceivedBytes: 20},
+	}
+	dbWrites, txsAdded, mempoolLen = 1000, 5, 3
+	reporter.Hook(1, sm.PhaseFinalizeBlock, 2*time.Millisecond)
+	reporter.Hook(1, sm.PhaseSaveState, time.Second)
+	reporter.Hook(1, sm.PhaseCommit, time.Millisecond)
+	reporter.Hook(1, sm.PhaseFireEvents, time.Millisecond)
+
+	dbWrites, txsAdded, mempoolLen = 1500, 6, 1
+	reporter.Hook(2, sm.PhaseFinalizeBlock, time.Millisecond)
+	reporter.Hook(2, sm.PhaseFireEvents, time.Millisecond)
+
+	require.Len(t, recorder.reports, 2)
+	assert.Equal(t, types.EventDataResourceUsage{
+		Height: 1,
+		Channels: []types.ChannelUsage{
+			{ID: 0x20, SentBytes: 10, ReceivedBytes: 20},
+			{ID: 0x30, SentBytes: 50, ReceivedBytes: 0},
+		},
+		ABCITime:          3 * time.Millisecond,
+		DBWriteBytes:      1000,
+		MempoolTxsAdded:   5,
+		MempoolTxsRemoved: 2,
+	}, recorder.reports[0])
+	assert.Equal(t, types.EventDataResourceUsage{
+		Height:            2,
+		ABCITime:          time.Millisecond,
+		DBWriteBytes:      500,
+		MempoolTxsAdded:   1,
+		MempoolTxsRemoved: 3,
+	}, recorder.reports[1])
+}
@@ -0,0 +1,48 @@
+package state
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/types"
+)
+
+// SnapshotSchedule decides after which blocks the application is asked to
+// take a state sync snapshot, through RequestCommit.Snapshot. The decision
+// only depends on the blocks, so that all the nodes following the schedule
+// take snapshots at the same heights.
+type SnapshotSchedule struct {
+	// Interval asks for a snapshot every Interval heights. Zero disables it.
+	Interval int64
+	// TimeInterval asks for a snapshot after the first block of every
+	// TimeInterval period of block time, e.g. every 6 hours. Zero disables
+	// it.
+	TimeInterval time.Duration
+}
+
+// Enabled returns whether the schedule ever asks for a snapshot.
+func (s SnapshotSchedule) Enabled() bool {
+	return s.Interval > 0 || s.TimeInterval > 0
+}
+
+// Due returns whether a snapshot must be taken of the state after block,
+// given the time of the block before it.
+func (s SnapshotSchedule) Due(lastBlockTime time.Time, block *types.Block) bool {
+	if s.Interval > 0 && block.Height%s.Interval == 0 {
+		return true
+	}
+	// periods are aligned on the zero time rather than on the genesis, so
+	// that they don't depend on the height the node started from
+	if s.TimeInterval > 0 && !block.Time.Truncate(s.TimeInterval).Equal(lastBlockTime.Truncate(s.TimeInterval)) {
+		return true
+	}
+	return false
+}
+
+// BlockExecutorWithSnapshotSchedule asks the application to take snapshots
+// on the given schedule. Without it, the application decides on its own when
+// to take snapshots.
+func BlockExecutorWithSnapshotSchedule(schedule SnapshotSchedule) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.snapshotSchedule = schedule
+	}
+}
@@ -0,0 +1,88 @@
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/libs/log"
+	mpmocks "github.com/cometbft/cometbft/mempool/mocks"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestSnapshotScheduleDue(t *testing.T) {
+	start := time.Date(2024, 1, 1, 5, 0, 0, 0, time.UTC)
+	block := func(height int64, time time.Time) *types.Block {
+		return &types.Block{Header: types.Header{Height: height, Time: time}}
+	}
+
+	testCases := []struct {
+		name          string
+		schedule      sm.SnapshotSchedule
+		lastBlockTime time.Time
+		block         *types.Block
+		due           bool
+	}{
+		{"disabled", sm.SnapshotSchedule{}, start, block(100, start.Add(24*time.Hour)), false},
+		{"height interval", sm.SnapshotSchedule{Interval: 100}, start, block(200, start.Add(time.Second)), true},
+		{"between heights", sm.SnapshotSchedule{Interval: 100}, start, block(201, start.Add(time.Second)), false},
+		{"within period", sm.SnapshotSchedule{TimeInterval: 6 * time.Hour}, start, block(7, start.Add(time.Minute)), false},
+		{"first block of period", sm.SnapshotSchedule{TimeInterval: 6 * time.Hour}, start, block(7, start.Add(time.Hour)), true},
+		{"skipped periods", sm.SnapshotSchedule{TimeInterval: time.Hour}, start, block(7, start.Add(5*time.Hour)), true},
+		{"either", sm.SnapshotSchedule{Interval: 100, TimeInterval: 6 * time.Hour}, start, block(7, start.Add(time.Hour)), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.due, tc.schedule.Due(tc.lastBlockTime, tc.block))
+		})
+	}
+}
+
+func TestApplyBlockSnapshotSchedule(t *testing.T) {
+	for _, interval := range []int64{0, 1} {
+		app := &testApp{}
+		proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app), proxy.NopMetrics())
+		require.NoError(t, proxyApp.Start())
+		defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+		state, stateDB, _ := makeState(1, 1)
+		stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+			DiscardABCIResponses: false,
+		})
+		blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+		mp := &mpmocks.Mempool{}
+		mp.On("Lock").Return()
+		mp.On("Unlock").Return()
+		mp.On("FlushAppConn", mock.Anything).Return(nil)
+		mp.On("Update",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything).Return(nil)
+
+		blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+			mp, sm.EmptyEvidencePool{}, blockStore, sm.BlockExecutorWithSnapshotSchedule(sm.SnapshotSchedule{
+				Interval: interval,
+			}))
+
+		block := makeBlock(state, 1, new(types.Commit))
+		bps, err := block.MakePartSet(testPartSize)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: bps.Header()}
+
+		_, err = blockExec.ApplyBlock(state, blockID, block, nil)
+		require.NoError(t, err)
+		assert.Equal(t, interval == 1, app.Snapshot)
+	}
+}
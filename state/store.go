@@ -1,6 +1,7 @@
 package state
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -39,6 +40,15 @@ func calcABCIResponsesKey(height int64) []byte {
 	return []byte(fmt.Sprintf("abciResponsesKey:%v", height))
 }
 
+// CacheableKey returns true for the keys of the validator sets and consensus
+// params, which are read repeatedly, e.g. to verify the commits of recent
+// heights, unlike the ABCI responses. It is meant to be given to a read
+// cache of the state database.
+func CacheableKey(key []byte) bool {
+	return bytes.HasPrefix(key, []byte("validatorsKey:")) ||
+		bytes.HasPrefix(key, []byte("consensusParamsKey:"))
+}
+
 //----------------------
 
 var lastABCIResponseKey = []byte("lastABCIResponseKey")
@@ -2,8 +2,12 @@ package txindex
 
 import (
 	"context"
+	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/types"
 )
@@ -23,8 +27,17 @@ type IndexerService struct {
 	blockIdxr        indexer.BlockIndexer
 	eventBus         *types.EventBus
 	terminateOnError bool
+
+	indexResourceUsage bool
+
+	mtx       cmtsync.Mutex
+	indexHook IndexHook
 }
 
+// IndexHook is called with the height of every block indexed and the time
+// spent indexing its events and transaction results.
+type IndexHook func(height int64, duration time.Duration)
+
 // NewIndexerService returns a new service instance.
 func NewIndexerService(
 	txIdxr TxIndexer,
@@ -38,6 +51,21 @@ func NewIndexerService(
 	return is
 }
 
+// SetIndexResourceUsage sets whether the resource usage report of each height
+// is indexed with the block events. It must be called before the service is
+// started.
+func (is *IndexerService) SetIndexResourceUsage(index bool) {
+	is.indexResourceUsage = index
+}
+
+// SetIndexHook sets the hook called after indexing every block. It may be
+// called while the service is running.
+func (is *IndexerService) SetIndexHook(hook IndexHook) {
+	is.mtx.Lock()
+	defer is.mtx.Unlock()
+	is.indexHook = hook
+}
+
 // OnStart implements service.Service by subscribing for all transactions
 // and indexing them by events.
 func (is *IndexerService) OnStart() error {
@@ -57,15 +85,34 @@ func (is *IndexerService) OnStart() error {
 		return err
 	}
 
+	// The resource usage reports are indexed by the same routine as the
+	// blocks, as the block indexer is not safe for concurrent use.
+	var usageOut <-chan cmtpubsub.Message // nil unless the reports are indexed
+	if is.indexResourceUsage {
+		usageSub, err := is.eventBus.SubscribeUnbuffered(context.Background(), subscriber, types.EventQueryResourceUsage)
+		if err != nil {
+			return err
+		}
+		usageOut = usageSub.Out()
+	}
+
 	go func() {
 		for {
 			select {
 			case <-blockSub.Canceled():
 				return
+			case msg := <-usageOut:
+				if err := is.indexResourceUsageReport(msg.Data().(types.EventDataResourceUsage)); err != nil && is.terminateOnError {
+					if err := is.Stop(); err != nil {
+						is.Logger.Error("failed to stop", "err", err)
+					}
+					return
+				}
 			case msg := <-blockSub.Out():
 				eventNewBlockEvents := msg.Data().(types.EventDataNewBlockEvents)
 				height := eventNewBlockEvents.Height
 				numTxs := eventNewBlockEvents.NumTxs
+				start := time.Now()
 
 				batch := NewBatch(numTxs)
 
@@ -113,12 +160,32 @@ func (is *IndexerService) OnStart() error {
 				} else {
 					is.Logger.Debug("indexed transactions", "height", height, "num_txs", numTxs)
 				}
+
+				is.mtx.Lock()
+				hook := is.indexHook
+				is.mtx.Unlock()
+				if hook != nil {
+					hook(height, time.Since(start))
+				}
 			}
 		}
 	}()
 	return nil
 }
 
+// indexResourceUsageReport indexes a resource usage report as an additional
+// event of the block at its height.
+func (is *IndexerService) indexResourceUsageReport(usage types.EventDataResourceUsage) error {
+	err := is.blockIdxr.Index(types.EventDataNewBlockEvents{
+		Height: usage.Height,
+		Events: []abci.Event{usage.ABCIEvent()},
+	})
+	if err != nil {
+		is.Logger.Error("failed to index resource usage", "height", usage.Height, "err", err)
+	}
+	return err
+}
+
 // OnStop implements service.Service by unsubscribing from all transactions.
 func (is *IndexerService) OnStop() {
 	if is.eventBus.IsRunning() {
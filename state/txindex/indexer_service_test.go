@@ -1,6 +1,7 @@
 package txindex_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/state/txindex/kv"
@@ -35,6 +37,8 @@ func TestIndexerServiceIndexesBlocks(t *testing.T) {
 
 	service := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus, false)
 	service.SetLogger(log.TestingLogger())
+	indexed := make(chan int64, 1)
+	service.SetIndexHook(func(height int64, _ time.Duration) { indexed <- height })
 	err = service.Start()
 	require.NoError(t, err)
 	t.Cleanup(func() {
@@ -78,7 +82,12 @@ func TestIndexerServiceIndexesBlocks(t *testing.T) {
 	err = eventBus.PublishEventTx(types.EventDataTx{TxResult: *txResult2})
 	require.NoError(t, err)
 
-	time.Sleep(100 * time.Millisecond)
+	select {
+	case height := <-indexed:
+		require.EqualValues(t, 1, height)
+	case <-time.After(time.Second):
+		t.Fatal("block not indexed")
+	}
 
 	res, err := txIndexer.Get(types.Tx("foo").Hash())
 	require.NoError(t, err)
@@ -92,3 +101,42 @@ func TestIndexerServiceIndexesBlocks(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, txResult2, res)
 }
+
+func TestIndexerServiceIndexesResourceUsage(t *testing.T) {
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(log.TestingLogger())
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	store := db.NewMemDB()
+	txIndexer := kv.NewTxIndex(store)
+	blockIndexer := blockidxkv.New(db.NewPrefixDB(store, []byte("block_events")))
+
+	service := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus, false)
+	service.SetLogger(log.TestingLogger())
+	service.SetIndexResourceUsage(true)
+	require.NoError(t, service.Start())
+	t.Cleanup(func() {
+		if err := service.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	err := eventBus.PublishEventNewBlockEvents(types.EventDataNewBlockEvents{Height: 1})
+	require.NoError(t, err)
+	err = eventBus.PublishEventResourceUsage(types.EventDataResourceUsage{
+		Height:   1,
+		ABCITime: 600 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+
+	heights, err := blockIndexer.Search(context.Background(), query.MustCompile("resource_usage.abci_time_ms > 500"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, heights)
+}
@@ -23,6 +23,9 @@ const (
 	ChunkChannel = byte(0x61)
 	// recentSnapshots is the number of recent snapshots to send and receive per peer.
 	recentSnapshots = 10
+	// snapshotHeightsRefreshBlocks is the number of blocks after which the
+	// snapshot heights retained from pruning are listed from the app again.
+	snapshotHeightsRefreshBlocks = 100
 )
 
 // Reactor handles state sync, both restoring snapshots for the local node and serving snapshots
@@ -227,7 +230,52 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 
 // recentSnapshots fetches the n most recent snapshots from the app
 func (r *Reactor) recentSnapshots(n uint32) ([]*snapshot, error) {
-	resp, err := r.conn.ListSnapshots(context.TODO(), &abci.RequestListSnapshots{})
+	return listRecentSnapshots(r.conn, n)
+}
+
+// SnapshotRetainHeight returns a retain height limit keeping the blocks and
+// states needed by peers to restore any of the snapshots advertised by the
+// app: all heights from the oldest advertised snapshot on, along with the
+// evidence window below it (Evidence.MaxAgeNumBlocks), which the peers
+// backfill to verify evidence once restored.
+//
+// The snapshot heights are only listed from the app every
+// snapshotHeightsRefreshBlocks blocks. In between, new snapshots are only
+// taken at newer heights and old ones deleted, so the cached oldest height
+// never retains less than needed.
+func SnapshotRetainHeight(conn proxy.AppConnSnapshot) sm.RetainHeightLimit {
+	var (
+		mtx           cmtsync.Mutex
+		refreshHeight int64
+		oldestHeight  int64
+	)
+	return func(state sm.State) (int64, error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		if refreshHeight == 0 || state.LastBlockHeight-refreshHeight >= snapshotHeightsRefreshBlocks ||
+			state.LastBlockHeight < refreshHeight {
+			snapshots, err := listRecentSnapshots(conn, recentSnapshots)
+			if err != nil {
+				return 0, err
+			}
+			oldestHeight = 0
+			if len(snapshots) > 0 {
+				// snapshots are sorted by descending height
+				oldestHeight = int64(snapshots[len(snapshots)-1].Height)
+			}
+			refreshHeight = state.LastBlockHeight
+		}
+		if oldestHeight == 0 {
+			return 0, nil
+		}
+		return max(oldestHeight-state.ConsensusParams.Evidence.MaxAgeNumBlocks, 1), nil
+	}
+}
+
+// listRecentSnapshots fetches the n most recent snapshots from the app
+func listRecentSnapshots(conn proxy.AppConnSnapshot, n uint32) ([]*snapshot, error) {
+	resp, err := conn.ListSnapshots(context.TODO(), &abci.RequestListSnapshots{})
 	if err != nil {
 		return nil, err
 	}
@@ -245,7 +293,7 @@ func (r *Reactor) recentSnapshots(n uint32) ([]*snapshot, error) {
 	})
 	snapshots := make([]*snapshot, 0, n)
 	for i, s := range resp.Snapshots {
-		if i >= recentSnapshots {
+		if i >= int(n) {
 			break
 		}
 		snapshots = append(snapshots, &snapshot{
@@ -259,8 +307,23 @@ func (r *Reactor) recentSnapshots(n uint32) ([]*snapshot, error) {
 	return snapshots, nil
 }
 
+// syncLocal restores the most recent snapshot of the local app that it accepts.
+func (r *Reactor) syncLocal() (sm.State, *types.Commit, error) {
+	snapshots, err := r.recentSnapshots(recentSnapshots)
+	if err != nil {
+		r.Logger.Error("Failed to list local snapshots", "err", err)
+		return sm.State{}, nil, errNoSnapshots
+	}
+	r.Logger.Info("Restoring local snapshot", "snapshots", len(snapshots))
+	return r.syncer.SyncLocal(snapshots)
+}
+
 // Sync runs a state sync, returning the new state and last commit at the snapshot height.
 // The caller must store the state and commit in the state database and block store.
+//
+// If the config enables LocalSnapshot, the snapshots of the local app are restored first, and
+// snapshots are only restored from peers if none of them could be. The chunks a local snapshot
+// fails to load are fetched from the peers advertising it.
 func (r *Reactor) Sync(stateProvider StateProvider, discoveryTime time.Duration) (sm.State, *types.Commit, error) {
 	r.mtx.Lock()
 	if r.syncer != nil {
@@ -271,6 +334,13 @@ func (r *Reactor) Sync(stateProvider StateProvider, discoveryTime time.Duration)
 	r.syncer = newSyncer(r.cfg, r.Logger, r.conn, r.connQuery, stateProvider, r.tempDir)
 	r.mtx.Unlock()
 
+	defer func() {
+		r.mtx.Lock()
+		r.syncer = nil
+		r.metrics.Syncing.Set(0)
+		r.mtx.Unlock()
+	}()
+
 	hook := func() {
 		r.Logger.Debug("Requesting snapshots from known peers")
 		// Request snapshots from all currently connected peers
@@ -281,13 +351,17 @@ func (r *Reactor) Sync(stateProvider StateProvider, discoveryTime time.Duration)
 		})
 	}
 
+	// snapshots are discovered from peers while restoring the local ones, to
+	// fetch the chunks the app fails to load from them
 	hook()
 
-	state, commit, err := r.syncer.SyncAny(discoveryTime, hook)
+	if r.cfg.LocalSnapshot {
+		state, commit, err := r.syncLocal()
+		if !errors.Is(err, errNoSnapshots) {
+			return state, commit, err
+		}
+		r.Logger.Info("No local snapshot could be restored, restoring snapshots from peers")
+	}
 
-	r.mtx.Lock()
-	r.syncer = nil
-	r.metrics.Syncing.Set(0)
-	r.mtx.Unlock()
-	return state, commit, err
+	return r.syncer.SyncAny(discoveryTime, hook)
 }
@@ -15,6 +15,7 @@ import (
 	p2pmocks "github.com/cometbft/cometbft/p2p/mocks"
 	ssproto "github.com/cometbft/cometbft/proto/tendermint/statesync"
 	proxymocks "github.com/cometbft/cometbft/proxy/mocks"
+	sm "github.com/cometbft/cometbft/state"
 )
 
 func TestReactor_Receive_ChunkRequest(t *testing.T) {
@@ -185,3 +186,35 @@ func TestReactor_Receive_SnapshotsRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestSnapshotRetainHeight(t *testing.T) {
+	state := sm.State{LastBlockHeight: 2000}
+	state.ConsensusParams.Evidence.MaxAgeNumBlocks = 50
+	conn := &proxymocks.AppConnSnapshot{}
+	conn.On("ListSnapshots", mock.Anything, &abci.RequestListSnapshots{}).Return(&abci.ResponseListSnapshots{}, nil).Once()
+	limit := SnapshotRetainHeight(conn)
+
+	height, err := limit(state)
+	require.NoError(t, err)
+	assert.Zero(t, height, "no snapshots, no limit")
+
+	// only the advertised snapshots are retained, along with the evidence window below them
+	snapshots := make([]*abci.Snapshot, 0, 2*recentSnapshots)
+	for h := uint64(1); h <= 2*recentSnapshots; h++ {
+		snapshots = append(snapshots, &abci.Snapshot{Height: h * 100, Format: 1})
+	}
+	conn.On("ListSnapshots", mock.Anything, &abci.RequestListSnapshots{}).Return(&abci.ResponseListSnapshots{
+		Snapshots: snapshots,
+	}, nil).Once()
+	state.LastBlockHeight += snapshotHeightsRefreshBlocks
+	height, err = limit(state)
+	require.NoError(t, err)
+	assert.EqualValues(t, (recentSnapshots+1)*100-50, height)
+
+	// the snapshot heights are cached until the next refresh
+	state.LastBlockHeight += snapshotHeightsRefreshBlocks - 1
+	height, err = limit(state)
+	require.NoError(t, err)
+	assert.EqualValues(t, (recentSnapshots+1)*100-50, height)
+	conn.AssertExpectations(t)
+}
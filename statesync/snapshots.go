@@ -23,6 +23,7 @@ type snapshot struct {
 
 	trustedAppHash    []byte // populated by light client
 	trustedAppVersion uint64 // populated by light client
+	local             bool   // chunks are loaded from the local app instead of peers
 }
 
 // Key generates a snapshot key, used for lookups. It takes into account not only the height and
@@ -236,6 +236,41 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 	}
 }
 
+// SyncLocal tries to restore the given snapshots of the local app, in order,
+// loading their chunks from the app instead of fetching them from peers. It
+// returns errNoSnapshots if the app restored none of them.
+func (s *syncer) SyncLocal(snapshots []*snapshot) (sm.State, *types.Commit, error) {
+	for _, snapshot := range snapshots {
+		snapshot.local = true
+		chunks, err := newChunkQueue(snapshot, s.tempDir)
+		if err != nil {
+			return sm.State{}, nil, fmt.Errorf("failed to create chunk queue: %w", err)
+		}
+
+		newState, commit, err := s.Sync(snapshot, chunks)
+		for errors.Is(err, errRetrySnapshot) {
+			chunks.RetryAll()
+			s.logger.Info("Retrying local snapshot", "height", snapshot.Height, "format", snapshot.Format,
+				"hash", log.NewLazySprintf("%X", snapshot.Hash))
+			newState, commit, err = s.Sync(snapshot, chunks)
+		}
+		if cerr := chunks.Close(); cerr != nil {
+			s.logger.Error("Failed to clean up chunk queue", "err", cerr)
+		}
+
+		switch {
+		case err == nil:
+			return newState, commit, nil
+		case errors.Is(err, errAbort), errors.Is(err, light.ErrNoWitnesses):
+			return sm.State{}, nil, err
+		default:
+			s.logger.Info("Failed to restore local snapshot", "height", snapshot.Height,
+				"format", snapshot.Format, "hash", log.NewLazySprintf("%X", snapshot.Hash), "err", err)
+		}
+	}
+	return sm.State{}, nil, errNoSnapshots
+}
+
 // Sync executes a sync for a specific snapshot, returning the latest state and block commit which
 // the caller must use to bootstrap the node.
 func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.Commit, error) {
@@ -287,8 +322,12 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.
 	// Spawn chunk fetchers. They will terminate when the chunk queue is closed or context canceled.
 	fetchCtx, cancel := context.WithCancel(context.TODO())
 	defer cancel()
-	for i := int32(0); i < s.chunkFetchers; i++ {
-		go s.fetchChunks(fetchCtx, snapshot, chunks)
+	if snapshot.local {
+		go s.loadChunks(fetchCtx, snapshot, chunks)
+	} else {
+		for i := int32(0); i < s.chunkFetchers; i++ {
+			go s.fetchChunks(fetchCtx, snapshot, chunks)
+		}
 	}
 
 	commit, err := s.stateProvider.Commit(pctx, snapshot.Height)
@@ -419,10 +458,17 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 // fetchChunks requests chunks from peers, receiving allocations from the chunk queue. Chunks
 // will be received from the reactor via syncer.AddChunks() to chunkQueue.Add().
 func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *chunkQueue) {
+	s.fetchChunksFrom(ctx, snapshot, chunks, 0, false)
+}
+
+// fetchChunksFrom is fetchChunks, starting with the given chunk if it is
+// already allocated to the caller.
+func (s *syncer) fetchChunksFrom(
+	ctx context.Context, snapshot *snapshot, chunks *chunkQueue, index uint32, allocated bool,
+) {
 	var (
-		next  = true
-		index uint32
-		err   error
+		next = !allocated
+		err  error
 	)
 
 	for {
@@ -467,6 +513,54 @@ func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *ch
 	}
 }
 
+// loadChunks loads the chunks of a local snapshot from the app, receiving
+// allocations from the chunk queue. If the app fails to load a chunk, it and
+// the remaining chunks are fetched from the peers advertising the snapshot
+// instead.
+func (s *syncer) loadChunks(ctx context.Context, snapshot *snapshot, chunks *chunkQueue) {
+	for {
+		index, err := chunks.Allocate()
+		if errors.Is(err, errDone) {
+			// Keep checking until the context is canceled (restore is done), in case any
+			// chunks need to be reloaded.
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(100 * time.Millisecond):
+			}
+			continue
+		}
+		if err != nil {
+			s.logger.Error("Failed to allocate chunk from queue", "err", err)
+			return
+		}
+
+		resp, err := s.conn.LoadSnapshotChunk(ctx, &abci.RequestLoadSnapshotChunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  index,
+		})
+		if err != nil {
+			s.logger.Error("Failed to load local snapshot chunk, fetching the chunks from peers",
+				"height", snapshot.Height, "format", snapshot.Format, "chunk", index, "err", err)
+			for i := int32(1); i < s.chunkFetchers; i++ {
+				go s.fetchChunks(ctx, snapshot, chunks)
+			}
+			s.fetchChunksFrom(ctx, snapshot, chunks, index, true)
+			return
+		}
+		if _, err := chunks.Add(&chunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Index:  index,
+			Chunk:  resp.Chunk,
+		}); err != nil {
+			s.logger.Error("Failed to add local snapshot chunk to queue", "chunk", index, "err", err)
+			return
+		}
+	}
+}
+
 // requestChunk requests a chunk from a peer.
 func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
 	peer := s.snapshots.GetPeer(snapshot)
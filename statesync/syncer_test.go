@@ -235,6 +235,98 @@ func TestSyncer_SyncAny(t *testing.T) {
 	peerB.AssertExpectations(t)
 }
 
+func TestSyncer_SyncLocal(t *testing.T) {
+	state := sm.State{
+		ChainID: "chain",
+		Version: cmtstate.Version{
+			Consensus: cmtversion.Consensus{
+				Block: version.BlockProtocol,
+				App:   testAppVersion,
+			},
+			Software: version.TMCoreSemVer,
+		},
+		LastBlockHeight: 1,
+		AppHash:         []byte("app_hash"),
+		ConsensusParams: *types.DefaultConsensusParams(),
+	}
+	commit := &types.Commit{BlockID: types.BlockID{Hash: []byte("blockhash")}}
+
+	stateProvider := &mocks.StateProvider{}
+	stateProvider.On("AppHash", mock.Anything, uint64(1)).Return(state.AppHash, nil)
+	stateProvider.On("AppHash", mock.Anything, uint64(2)).Return([]byte("app_hash_2"), nil)
+	stateProvider.On("Commit", mock.Anything, uint64(1)).Return(commit, nil)
+	stateProvider.On("State", mock.Anything, mock.Anything).Return(state, nil)
+	connSnapshot := &proxymocks.AppConnSnapshot{}
+	connQuery := &proxymocks.AppConnQuery{}
+
+	cfg := config.DefaultStateSyncConfig()
+	syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+
+	// The app rejects the format of its most recent snapshot and restores the
+	// other one, whose chunks are loaded from the app rather than from peers,
+	// except for the chunk the app fails to load, fetched from a peer instead.
+	s1 := &snapshot{Height: 1, Format: 1, Chunks: 2, Hash: []byte{1}}
+	s2 := &snapshot{Height: 2, Format: 2, Chunks: 2, Hash: []byte{2}}
+	peer := simplePeer("a")
+	_, err := syncer.AddSnapshot(peer, &snapshot{Height: 1, Format: 1, Chunks: 2, Hash: []byte{1}})
+	require.NoError(t, err)
+	peer.On("Send", mock.MatchedBy(func(i interface{}) bool {
+		e, ok := i.(p2p.Envelope)
+		return ok && e.ChannelID == ChunkChannel
+	})).Once().Run(func(args mock.Arguments) {
+		msg := args[0].(p2p.Envelope).Message.(*ssproto.ChunkRequest)
+		_, err := syncer.AddChunk(&chunk{Height: 1, Format: 1, Index: msg.Index, Chunk: []byte{1, 1, 1}, Sender: "a"})
+		require.NoError(t, err)
+	}).Return(true)
+
+	connSnapshot.On("OfferSnapshot", mock.Anything, &abci.RequestOfferSnapshot{
+		Snapshot: toABCI(s2), AppHash: []byte("app_hash_2"),
+	}).Once().Return(&abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT_FORMAT}, nil)
+	connSnapshot.On("OfferSnapshot", mock.Anything, &abci.RequestOfferSnapshot{
+		Snapshot: toABCI(s1), AppHash: []byte("app_hash"),
+	}).Once().Return(&abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}, nil)
+	connSnapshot.On("LoadSnapshotChunk", mock.Anything, &abci.RequestLoadSnapshotChunk{
+		Height: 1, Format: 1, Chunk: 0,
+	}).Once().Return(&abci.ResponseLoadSnapshotChunk{Chunk: []byte{1, 1, 0}}, nil)
+	connSnapshot.On("LoadSnapshotChunk", mock.Anything, &abci.RequestLoadSnapshotChunk{
+		Height: 1, Format: 1, Chunk: 1,
+	}).Once().Return(nil, errors.New("chunk not found"))
+	for i := uint32(0); i < s1.Chunks; i++ {
+		connSnapshot.On("ApplySnapshotChunk", mock.Anything, &abci.RequestApplySnapshotChunk{
+			Index: i, Chunk: []byte{1, 1, byte(i)}, Sender: map[uint32]string{1: "a"}[i],
+		}).Once().Return(&abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}, nil)
+	}
+	connQuery.On("Info", mock.Anything, proxy.RequestInfo).Return(&abci.ResponseInfo{
+		AppVersion:       testAppVersion,
+		LastBlockHeight:  1,
+		LastBlockAppHash: []byte("app_hash"),
+	}, nil)
+
+	newState, lastCommit, err := syncer.SyncLocal([]*snapshot{s2, s1})
+	require.NoError(t, err)
+	assert.Equal(t, state, newState)
+	assert.Equal(t, commit, lastCommit)
+	connSnapshot.AssertExpectations(t)
+	connQuery.AssertExpectations(t)
+	peer.AssertExpectations(t)
+}
+
+func TestSyncer_SyncLocal_noSnapshots(t *testing.T) {
+	syncer, connSnapshot := setupOfferSyncer()
+
+	_, _, err := syncer.SyncLocal(nil)
+	assert.Equal(t, errNoSnapshots, err)
+
+	s := &snapshot{Height: 1, Format: 1, Chunks: 3, Hash: []byte{1, 2, 3}}
+	connSnapshot.On("OfferSnapshot", mock.Anything, &abci.RequestOfferSnapshot{
+		Snapshot: toABCI(s), AppHash: []byte("app_hash"),
+	}).Once().Return(&abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT}, nil)
+
+	_, _, err = syncer.SyncLocal([]*snapshot{s})
+	assert.Equal(t, errNoSnapshots, err)
+	connSnapshot.AssertExpectations(t)
+}
+
 func TestSyncer_SyncAny_noSnapshots(t *testing.T) {
 	syncer, _ := setupOfferSyncer()
 	_, _, err := syncer.SyncAny(0, func() {})
@@ -0,0 +1,35 @@
+package store
+
+import (
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "storage"
+)
+
+//go:generate go run ../scripts/metricsgen -struct=Metrics
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Size of the database on disk, in bytes.
+	SizeBytes metrics.Gauge `metrics_labels:"db"`
+	// Approximate number of keys stored in the database.
+	Keys metrics.Gauge `metrics_labels:"db"`
+	// Estimated number of bytes awaiting compaction. Only reported by
+	// backends that expose compaction statistics.
+	CompactionBacklogBytes metrics.Gauge `metrics_labels:"db"`
+	// Estimated write amplification: bytes written by compactions and
+	// flushes divided by bytes flushed from memory. Only reported by backends
+	// that expose compaction statistics.
+	WriteAmplification metrics.Gauge `metrics_labels:"db"`
+	// Number of reads served by the read cache of the database.
+	CacheHits metrics.Counter `metrics_labels:"db"`
+	// Number of reads of cacheable keys not served by the read cache of the
+	// database.
+	CacheMisses metrics.Counter `metrics_labels:"db"`
+	// Size of the values held by the read cache, in bytes.
+	CacheSizeBytes metrics.Gauge
+}
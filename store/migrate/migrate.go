@@ -0,0 +1,222 @@
+// Package migrate versions the schema of the databases of the node, and
+// upgrades or downgrades them by running the migrations registered for each
+// database.
+//
+// The schema version of a database is stored under a dedicated key. A database
+// created by the node is stamped with the latest version, while an existing
+// database without the key is at version 0.
+package migrate
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// progressInterval is the minimum interval between progress logs.
+const progressInterval = 5 * time.Second
+
+var schemaVersionKey = []byte("schemaVersion")
+
+// ErrMigrationRequired is returned by Check when the schema of a database is
+// older than the latest version, i.e. when `cometbft migrate` must be run.
+var ErrMigrationRequired = errors.New("database migration required")
+
+// Progress reports the progress of a migration, e.g. in keys rewritten out
+// of the total number of keys to rewrite.
+type Progress func(done, total int64)
+
+// Migration upgrades the schema of a database from Version-1 to Version.
+// Migrations should write in batches and check ctx between them.
+type Migration struct {
+	Version     uint64
+	Description string
+	Up          func(ctx context.Context, db dbm.DB, progress Progress) error
+	// Down reverts Up, including an Up which failed halfway. Nil if the
+	// migration can't be reverted.
+	Down func(ctx context.Context, db dbm.DB, progress Progress) error
+}
+
+// Registry holds the migrations of each database, identified by the ID of
+// its config.DBContext, e.g. "blockstore".
+type Registry struct {
+	mtx        cmtsync.RWMutex
+	migrations map[string][]Migration
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{migrations: make(map[string][]Migration)}
+}
+
+// DefaultRegistry holds the migrations of the databases of the node.
+var DefaultRegistry = NewRegistry()
+
+// Register registers a migration of the database dbID in DefaultRegistry.
+func Register(dbID string, m Migration) {
+	DefaultRegistry.Register(dbID, m)
+}
+
+// Register registers a migration of the database dbID. Migrations must be
+// registered in order, starting from version 1.
+func (r *Registry) Register(dbID string, m Migration) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if want := uint64(len(r.migrations[dbID])) + 1; m.Version != want {
+		panic(fmt.Sprintf("migration %d of %s registered, expected version %d", m.Version, dbID, want))
+	}
+	if m.Up == nil {
+		panic(fmt.Sprintf("migration %d of %s has no Up function", m.Version, dbID))
+	}
+	r.migrations[dbID] = append(r.migrations[dbID], m)
+}
+
+// LatestVersion returns the version of the last migration of dbID, 0 if it
+// has none.
+func (r *Registry) LatestVersion(dbID string) uint64 {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	return uint64(len(r.migrations[dbID]))
+}
+
+// Migrations returns the migrations of dbID, in version order.
+func (r *Registry) Migrations(dbID string) []Migration {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	return append([]Migration(nil), r.migrations[dbID]...)
+}
+
+// SchemaVersion returns the schema version of db.
+func SchemaVersion(db dbm.DB) (uint64, error) {
+	bz, err := db.Get(schemaVersionKey)
+	if err != nil {
+		return 0, err
+	}
+	if bz == nil {
+		return 0, nil
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid schema version %X", bz)
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func setSchemaVersion(db dbm.DB, version uint64) error {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, version)
+	return db.SetSync(schemaVersionKey, bz)
+}
+
+// Check stamps an empty database with the latest version of dbID, and
+// returns ErrMigrationRequired if the schema of a database is older than the
+// latest version, or an error if it is newer.
+func (r *Registry) Check(dbID string, db dbm.DB) error {
+	latest := r.LatestVersion(dbID)
+	bz, err := db.Get(schemaVersionKey)
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		empty, err := isEmpty(db)
+		if err != nil {
+			return err
+		}
+		if empty {
+			return setSchemaVersion(db, latest)
+		}
+	}
+
+	version, err := SchemaVersion(db)
+	if err != nil {
+		return err
+	}
+	switch {
+	case version < latest:
+		return fmt.Errorf("%w: %s schema is at version %d, latest is %d", ErrMigrationRequired, dbID, version, latest)
+	case version > latest:
+		return fmt.Errorf("%s schema is at version %d, newer than the latest known version %d", dbID, version, latest)
+	}
+	return nil
+}
+
+func isEmpty(db dbm.DB) (bool, error) {
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return false, err
+	}
+	defer it.Close()
+	return !it.Valid(), it.Error()
+}
+
+// Migrate runs the migrations of dbID needed to bring db from its schema
+// version to target: the Up functions of the following versions, or the Down
+// functions of the current and previous versions. The version is recorded
+// after each migration, so that an interrupted run resumes where it stopped.
+// A failed Up is reverted with Down, if the migration has one.
+func (r *Registry) Migrate(ctx context.Context, dbID string, db dbm.DB, target uint64, logger log.Logger) error {
+	migrations := r.Migrations(dbID)
+	if target > uint64(len(migrations)) {
+		return fmt.Errorf("unknown %s schema version %d, latest is %d", dbID, target, len(migrations))
+	}
+	version, err := SchemaVersion(db)
+	if err != nil {
+		return err
+	}
+	if version > uint64(len(migrations)) {
+		return fmt.Errorf("%s schema is at version %d, newer than the latest known version %d",
+			dbID, version, len(migrations))
+	}
+
+	for ; version < target; version++ {
+		m := migrations[version]
+		logger.Info("Migrating database", "db", dbID, "version", m.Version, "description", m.Description)
+		if err := m.Up(ctx, db, progressLogger(logger, dbID, m.Version)); err != nil {
+			err = fmt.Errorf("migration %d of %s failed: %w", m.Version, dbID, err)
+			if m.Down == nil {
+				return err
+			}
+			logger.Error("Reverting failed migration", "db", dbID, "version", m.Version, "err", err)
+			// the revert isn't canceled with ctx, so that db is left at version
+			if downErr := m.Down(context.Background(), db, progressLogger(logger, dbID, m.Version)); downErr != nil {
+				return fmt.Errorf("%w; revert failed: %v", err, downErr)
+			}
+			return err
+		}
+		if err := setSchemaVersion(db, m.Version); err != nil {
+			return err
+		}
+	}
+
+	for ; version > target; version-- {
+		m := migrations[version-1]
+		if m.Down == nil {
+			return fmt.Errorf("migration %d of %s can't be reverted", m.Version, dbID)
+		}
+		logger.Info("Reverting database migration", "db", dbID, "version", m.Version, "description", m.Description)
+		if err := m.Down(ctx, db, progressLogger(logger, dbID, m.Version)); err != nil {
+			return fmt.Errorf("reverting migration %d of %s failed: %w", m.Version, dbID, err)
+		}
+		if err := setSchemaVersion(db, m.Version-1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// progressLogger returns a Progress logging at most every progressInterval.
+func progressLogger(logger log.Logger, dbID string, version uint64) Progress {
+	var last time.Time
+	return func(done, total int64) {
+		if now := time.Now(); now.Sub(last) >= progressInterval || done == total {
+			last = now
+			logger.Info("Migration progress", "db", dbID, "version", version, "done", done, "total", total)
+		}
+	}
+}
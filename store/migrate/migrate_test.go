@@ -0,0 +1,142 @@
+package migrate_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/store/migrate"
+)
+
+// renameKey returns a migration renaming the key from to to.
+func renameKey(version uint64, from, to string) migrate.Migration {
+	move := func(db dbm.DB, from, to string) error {
+		v, err := db.Get([]byte(from))
+		if err != nil || v == nil {
+			return err
+		}
+		if err := db.Set([]byte(to), v); err != nil {
+			return err
+		}
+		return db.Delete([]byte(from))
+	}
+	return migrate.Migration{
+		Version:     version,
+		Description: "rename " + from,
+		Up: func(_ context.Context, db dbm.DB, progress migrate.Progress) error {
+			progress(1, 1)
+			return move(db, from, to)
+		},
+		Down: func(_ context.Context, db dbm.DB, _ migrate.Progress) error {
+			return move(db, to, from)
+		},
+	}
+}
+
+func TestRegistryCheck(t *testing.T) {
+	registry := migrate.NewRegistry()
+	registry.Register("state", renameKey(1, "a", "b"))
+	assert.Panics(t, func() { registry.Register("state", renameKey(3, "b", "c")) })
+
+	// new databases are stamped with the latest version
+	db := dbm.NewMemDB()
+	require.NoError(t, registry.Check("state", db))
+	version, err := migrate.SchemaVersion(db)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, version)
+
+	// existing databases without a version are at version 0
+	db = dbm.NewMemDB()
+	require.NoError(t, db.Set([]byte("a"), []byte("value")))
+	err = registry.Check("state", db)
+	assert.ErrorIs(t, err, migrate.ErrMigrationRequired)
+
+	require.NoError(t, registry.Migrate(context.Background(), "state", db, 1, log.TestingLogger()))
+	require.NoError(t, registry.Check("state", db))
+
+	// a database migrated by a newer version is rejected
+	err = migrate.NewRegistry().Check("state", db)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, migrate.ErrMigrationRequired)
+}
+
+func TestRegistryMigrate(t *testing.T) {
+	registry := migrate.NewRegistry()
+	registry.Register("blockstore", renameKey(1, "a", "b"))
+	registry.Register("blockstore", renameKey(2, "b", "c"))
+	logger := log.TestingLogger()
+
+	db := dbm.NewMemDB()
+	require.NoError(t, db.Set([]byte("a"), []byte("value")))
+	require.NoError(t, registry.Migrate(context.Background(), "blockstore", db, 2, logger))
+	version, err := migrate.SchemaVersion(db)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, version)
+	v, err := db.Get([]byte("c"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), v)
+
+	// roll back to version 1
+	require.NoError(t, registry.Migrate(context.Background(), "blockstore", db, 1, logger))
+	version, err = migrate.SchemaVersion(db)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, version)
+	v, err = db.Get([]byte("b"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), v)
+
+	assert.Error(t, registry.Migrate(context.Background(), "blockstore", db, 3, logger))
+}
+
+func TestRegistryMigrateFailure(t *testing.T) {
+	registry := migrate.NewRegistry()
+	registry.Register("tx_index", renameKey(1, "a", "b"))
+	reverted := false
+	registry.Register("tx_index", migrate.Migration{
+		Version: 2,
+		Up: func(_ context.Context, db dbm.DB, _ migrate.Progress) error {
+			if err := db.Set([]byte("partial"), []byte{1}); err != nil {
+				return err
+			}
+			return errors.New("disk full")
+		},
+		Down: func(_ context.Context, db dbm.DB, _ migrate.Progress) error {
+			reverted = true
+			return db.Delete([]byte("partial"))
+		},
+	})
+	registry.Register("tx_index", migrate.Migration{
+		Version: 3,
+		Up:      func(context.Context, dbm.DB, migrate.Progress) error { return nil },
+	})
+	logger := log.TestingLogger()
+
+	// the failed migration is reverted, the completed ones are kept
+	db := dbm.NewMemDB()
+	require.NoError(t, db.Set([]byte("a"), []byte("value")))
+	err := registry.Migrate(context.Background(), "tx_index", db, 3, logger)
+	require.ErrorContains(t, err, "disk full")
+	assert.True(t, reverted)
+	version, err := migrate.SchemaVersion(db)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, version)
+	has, err := db.Has([]byte("partial"))
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	// migrations without Down can't be rolled back
+	db = dbm.NewMemDB()
+	registry = migrate.NewRegistry()
+	registry.Register("tx_index", migrate.Migration{
+		Version: 1,
+		Up:      func(context.Context, dbm.DB, migrate.Progress) error { return nil },
+	})
+	require.NoError(t, registry.Migrate(context.Background(), "tx_index", db, 1, logger))
+	assert.Error(t, registry.Migrate(context.Background(), "tx_index", db, 0, logger))
+}
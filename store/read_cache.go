@@ -0,0 +1,212 @@
+package store
+
+import (
+	"container/list"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// ReadCache is an in-memory read-through cache of the values read from the
+// databases it wraps, e.g. the block metas, commits and validator sets read
+// repeatedly to serve RPC queries, catching up peers and light clients. The
+// least recently used values are evicted once the values held exceed the
+// budget, in bytes. Writes to a wrapped database, directly or in batches,
+// evict the values written.
+type ReadCache struct {
+	mtx     cmtsync.Mutex
+	budget  int64
+	size    int64
+	lru     *list.List // of *readCacheEntry, most recently used first
+	entries map[string]*list.Element
+	// incremented on every eviction of written values, so that a value read
+	// from a database concurrently with a write to it is not cached
+	writes  uint64
+	metrics *Metrics
+}
+
+type readCacheEntry struct {
+	key   string
+	value []byte
+	size  int64
+}
+
+// NewReadCache returns a cache holding up to budget bytes of values.
+func NewReadCache(budget int64) *ReadCache {
+	return &ReadCache{
+		budget:  budget,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+		metrics: NopMetrics(),
+	}
+}
+
+// SetMetrics sets the metrics the hits and misses of the cache are reported
+// to.
+func (c *ReadCache) SetMetrics(metrics *Metrics) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.metrics = metrics
+	c.metrics.CacheSizeBytes.Set(float64(c.size))
+}
+
+// Size returns the number of bytes of the values held by the cache.
+func (c *ReadCache) Size() int64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.size
+}
+
+// Wrap returns db with the values of the keys for which cacheable returns
+// true cached by c under name, which must be unique among the databases
+// wrapped by c. All the keys are cacheable if cacheable is nil.
+func (c *ReadCache) Wrap(db dbm.DB, name string, cacheable func(key []byte) bool) dbm.DB {
+	return &cachedDB{DB: db, cache: c, name: name, cacheable: cacheable}
+}
+
+func (c *ReadCache) get(name string, key []byte) (value []byte, writes uint64, ok bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if e, ok := c.entries[name+"/"+string(key)]; ok {
+		c.lru.MoveToFront(e)
+		c.metrics.CacheHits.With("db", name).Add(1)
+		return e.Value.(*readCacheEntry).value, c.writes, true
+	}
+	c.metrics.CacheMisses.With("db", name).Add(1)
+	return nil, c.writes, false
+}
+
+// add caches the value read, unless a value was written to the databases
+// since the read started.
+func (c *ReadCache) add(name string, key, value []byte, writes uint64) {
+	size := int64(len(key) + len(value))
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if writes != c.writes || size > c.budget {
+		return
+	}
+	k := name + "/" + string(key)
+	if _, ok := c.entries[k]; ok {
+		return
+	}
+	c.entries[k] = c.lru.PushFront(&readCacheEntry{key: k, value: value, size: size})
+	c.size += size
+	for c.size > c.budget {
+		c.remove(c.lru.Back())
+	}
+	c.metrics.CacheSizeBytes.Set(float64(c.size))
+}
+
+// evict evicts the values of keys written to the database name.
+func (c *ReadCache) evict(name string, keys ...[]byte) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.writes++
+	for _, key := range keys {
+		if e, ok := c.entries[name+"/"+string(key)]; ok {
+			c.remove(e)
+		}
+	}
+	c.metrics.CacheSizeBytes.Set(float64(c.size))
+}
+
+func (c *ReadCache) remove(e *list.Element) {
+	entry := c.lru.Remove(e).(*readCacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+}
+
+type cachedDB struct {
+	dbm.DB
+	cache     *ReadCache
+	name      string
+	cacheable func(key []byte) bool
+}
+
+func (db *cachedDB) isCacheable(key []byte) bool {
+	return db.cacheable == nil || db.cacheable(key)
+}
+
+func (db *cachedDB) Get(key []byte) ([]byte, error) {
+	if !db.isCacheable(key) {
+		return db.DB.Get(key)
+	}
+	value, writes, ok := db.cache.get(db.name, key)
+	if ok {
+		// the callers may modify the values they get
+		return append([]byte(nil), value...), nil
+	}
+	value, err := db.DB.Get(key)
+	if err != nil || value == nil {
+		return value, err
+	}
+	db.cache.add(db.name, key, append([]byte(nil), value...), writes)
+	return value, nil
+}
+
+func (db *cachedDB) Has(key []byte) (bool, error) {
+	if db.isCacheable(key) {
+		if _, _, ok := db.cache.get(db.name, key); ok {
+			return true, nil
+		}
+	}
+	return db.DB.Has(key)
+}
+
+func (db *cachedDB) Set(key, value []byte) error {
+	defer db.cache.evict(db.name, key)
+	return db.DB.Set(key, value)
+}
+
+func (db *cachedDB) SetSync(key, value []byte) error {
+	defer db.cache.evict(db.name, key)
+	return db.DB.SetSync(key, value)
+}
+
+func (db *cachedDB) Delete(key []byte) error {
+	defer db.cache.evict(db.name, key)
+	return db.DB.Delete(key)
+}
+
+func (db *cachedDB) DeleteSync(key []byte) error {
+	defer db.cache.evict(db.name, key)
+	return db.DB.DeleteSync(key)
+}
+
+func (db *cachedDB) NewBatch() dbm.Batch {
+	return &cachedBatch{Batch: db.DB.NewBatch(), db: db}
+}
+
+// cachedBatch evicts the values it writes from the cache once written.
+type cachedBatch struct {
+	dbm.Batch
+	db   *cachedDB
+	keys [][]byte
+}
+
+func (b *cachedBatch) Set(key, value []byte) error {
+	if err := b.Batch.Set(key, value); err != nil {
+		return err
+	}
+	b.keys = append(b.keys, append([]byte(nil), key...))
+	return nil
+}
+
+func (b *cachedBatch) Delete(key []byte) error {
+	if err := b.Batch.Delete(key); err != nil {
+		return err
+	}
+	b.keys = append(b.keys, append([]byte(nil), key...))
+	return nil
+}
+
+func (b *cachedBatch) Write() error {
+	defer b.db.cache.evict(b.db.name, b.keys...)
+	return b.Batch.Write()
+}
+
+func (b *cachedBatch) WriteSync() error {
+	defer b.db.cache.evict(b.db.name, b.keys...)
+	return b.Batch.WriteSync()
+}
@@ -0,0 +1,108 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+)
+
+// countingGetDB counts the reads reaching the database.
+type countingGetDB struct {
+	dbm.DB
+	gets int
+}
+
+func (db *countingGetDB) Get(key []byte) ([]byte, error) {
+	db.gets++
+	return db.DB.Get(key)
+}
+
+func TestReadCache(t *testing.T) {
+	raw := &countingGetDB{DB: dbm.NewMemDB()}
+	cache := NewReadCache(1 << 20)
+	db := cache.Wrap(raw, "blockstore", CacheableKey)
+
+	require.NoError(t, raw.Set([]byte("H:1"), []byte("meta1")))
+	require.NoError(t, raw.Set([]byte("P:1:0"), []byte("part")))
+
+	// cacheable values are read from the database once
+	for i := 0; i < 3; i++ {
+		v, err := db.Get([]byte("H:1"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("meta1"), v)
+	}
+	assert.Equal(t, 1, raw.gets)
+	assert.EqualValues(t, len("H:1")+len("meta1"), cache.Size())
+
+	// the others every time
+	for i := 0; i < 3; i++ {
+		_, err := db.Get([]byte("P:1:0"))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 4, raw.gets)
+
+	// missing values are not cached
+	v, err := db.Get([]byte("H:2"))
+	require.NoError(t, err)
+	assert.Nil(t, v)
+	_, err = db.Get([]byte("H:2"))
+	require.NoError(t, err)
+	assert.Equal(t, 6, raw.gets)
+
+	// writes evict the values written
+	require.NoError(t, db.Set([]byte("H:1"), []byte("meta1'")))
+	v, err = db.Get([]byte("H:1"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("meta1'"), v)
+
+	batch := db.NewBatch()
+	require.NoError(t, batch.Delete([]byte("H:1")))
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+	v, err = db.Get([]byte("H:1"))
+	require.NoError(t, err)
+	assert.Nil(t, v)
+	assert.Zero(t, cache.Size())
+}
+
+func TestReadCacheBudget(t *testing.T) {
+	raw := dbm.NewMemDB()
+	cache := NewReadCache(20)
+	db := cache.Wrap(raw, "state", nil)
+
+	require.NoError(t, raw.Set([]byte("a"), make([]byte, 9)))
+	require.NoError(t, raw.Set([]byte("b"), make([]byte, 9)))
+	require.NoError(t, raw.Set([]byte("c"), make([]byte, 9)))
+	require.NoError(t, raw.Set([]byte("big"), make([]byte, 100)))
+
+	for _, key := range []string{"a", "b", "c", "big"} {
+		_, err := db.Get([]byte(key))
+		require.NoError(t, err)
+	}
+	// the least recently used value is evicted and values above the budget
+	// are never cached
+	assert.EqualValues(t, 20, cache.Size())
+	_, _, ok := cache.get("state", []byte("a"))
+	assert.False(t, ok)
+	_, _, ok = cache.get("state", []byte("c"))
+	assert.True(t, ok)
+}
+
+func TestReadCacheConcurrentWrite(t *testing.T) {
+	raw := dbm.NewMemDB()
+	cache := NewReadCache(1 << 20)
+	db := cache.Wrap(raw, "state", nil)
+	require.NoError(t, raw.Set([]byte("k"), []byte("old")))
+
+	// a value read before a write completes is not cached
+	_, writes, _ := cache.get("state", []byte("k"))
+	require.NoError(t, db.Set([]byte("k"), []byte("new")))
+	cache.add("state", []byte("k"), []byte("old"), writes)
+
+	v, err := db.Get([]byte("k"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("new"), v)
+}
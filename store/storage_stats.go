@@ -0,0 +1,240 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// keySampleSize is the number of entries read from a database to estimate
+// its average entry size, which is used to approximate the key count.
+const keySampleSize = 1000
+
+// StorageStats is a snapshot of the storage statistics of a single database.
+type StorageStats struct {
+	Name    string
+	Backend string
+	// SizeBytes is the size of the database directory on disk.
+	SizeBytes int64
+	// Keys is exact for small databases and extrapolated from a sample of
+	// entries otherwise. It is capped at the sample size for databases
+	// without an on-disk footprint, e.g. in-memory ones.
+	Keys int64
+	// CompactionBacklogBytes and WriteAmplification are zero if the backend
+	// does not expose compaction statistics.
+	CompactionBacklogBytes int64
+	WriteAmplification     float64
+	UpdatedAt              time.Time
+}
+
+type trackedDB struct {
+	name string
+	db   dbm.DB
+	dir  string
+}
+
+// StorageCollector periodically collects StorageStats for a set of databases
+// and reports them as metrics.
+type StorageCollector struct {
+	service.BaseService
+
+	interval time.Duration
+	metrics  *Metrics
+
+	mtx   cmtsync.RWMutex
+	dbs   []trackedDB
+	stats map[string]StorageStats
+}
+
+// NewStorageCollector returns a collector that refreshes the statistics of
+// the registered databases every interval.
+func NewStorageCollector(interval time.Duration, metrics *Metrics) *StorageCollector {
+	c := &StorageCollector{
+		interval: interval,
+		metrics:  metrics,
+		stats:    make(map[string]StorageStats),
+	}
+	c.BaseService = *service.NewBaseService(nil, "StorageCollector", c)
+	return c
+}
+
+// AddDB registers a database under name. dir is the directory holding the
+// database files and may be empty for in-memory databases.
+func (c *StorageCollector) AddDB(name string, db dbm.DB, dir string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.dbs = append(c.dbs, trackedDB{name: name, db: db, dir: dir})
+}
+
+// OnStart implements service.Service.
+func (c *StorageCollector) OnStart() error {
+	c.Collect()
+	go c.collectRoutine()
+	return nil
+}
+
+func (c *StorageCollector) collectRoutine() {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.Collect()
+		case <-c.Quit():
+			return
+		}
+	}
+}
+
+// Collect refreshes the statistics of every registered database.
+func (c *StorageCollector) Collect() {
+	c.mtx.RLock()
+	dbs := make([]trackedDB, len(c.dbs))
+	copy(dbs, c.dbs)
+	c.mtx.RUnlock()
+
+	for _, tdb := range dbs {
+		stats, err := collectStorageStats(tdb)
+		if err != nil {
+			c.Logger.Error("failed to collect storage stats", "db", tdb.name, "err", err)
+			continue
+		}
+
+		c.metrics.SizeBytes.With("db", tdb.name).Set(float64(stats.SizeBytes))
+		c.metrics.Keys.With("db", tdb.name).Set(float64(stats.Keys))
+		c.metrics.CompactionBacklogBytes.With("db", tdb.name).Set(float64(stats.CompactionBacklogBytes))
+		c.metrics.WriteAmplification.With("db", tdb.name).Set(stats.WriteAmplification)
+
+		c.mtx.Lock()
+		c.stats[tdb.name] = stats
+		c.mtx.Unlock()
+	}
+}
+
+// Stats returns the last collected statistics, sorted by database name.
+func (c *StorageCollector) Stats() []StorageStats {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	stats := make([]StorageStats, 0, len(c.stats))
+	for _, s := range c.stats {
+		stats = append(stats, s)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats
+}
+
+func collectStorageStats(tdb trackedDB) (StorageStats, error) {
+	stats := StorageStats{
+		Name:      tdb.name,
+		Backend:   backendName(tdb.db),
+		UpdatedAt: time.Now(),
+	}
+
+	size, err := dirSize(tdb.dir)
+	if err != nil {
+		return stats, fmt.Errorf("computing size: %w", err)
+	}
+	stats.SizeBytes = size
+
+	keys, err := approxKeys(tdb.db, size)
+	if err != nil {
+		return stats, fmt.Errorf("counting keys: %w", err)
+	}
+	stats.Keys = keys
+
+	if ldb, ok := tdb.db.(*dbm.GoLevelDB); ok {
+		var ls leveldb.DBStats
+		if err := ldb.DB().Stats(&ls); err != nil {
+			return stats, fmt.Errorf("reading leveldb stats: %w", err)
+		}
+		// Tables in level 0 are flushed memtables that have not been
+		// compacted into the sorted levels yet.
+		if len(ls.LevelSizes) > 0 {
+			stats.CompactionBacklogBytes = ls.LevelSizes[0]
+		}
+		if len(ls.LevelWrite) > 0 && ls.LevelWrite[0] > 0 {
+			stats.WriteAmplification = float64(ls.LevelWrite.Sum()) / float64(ls.LevelWrite[0])
+		}
+	}
+
+	return stats, nil
+}
+
+func backendName(db dbm.DB) string {
+	switch db.(type) {
+	case *dbm.GoLevelDB:
+		return string(dbm.GoLevelDBBackend)
+	case *dbm.MemDB:
+		return string(dbm.MemDBBackend)
+	default:
+		return fmt.Sprintf("%T", db)
+	}
+}
+
+// dirSize returns the total size of the regular files under dir. A missing
+// or empty dir has size zero.
+func dirSize(dir string) (int64, error) {
+	if dir == "" {
+		return 0, nil
+	}
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	return size, err
+}
+
+// approxKeys counts the keys of db, reading at most keySampleSize entries. The
+// key count of larger databases is extrapolated from the average entry size
+// of the sample if they have an on-disk footprint, and capped at
+// keySampleSize otherwise.
+func approxKeys(db dbm.DB, size int64) (int64, error) {
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	var keys, sampled int64
+	truncated := false
+	for ; it.Valid(); it.Next() {
+		if keys == keySampleSize {
+			truncated = true
+			break
+		}
+		keys++
+		sampled += int64(len(it.Key()) + len(it.Value()))
+	}
+	if err := it.Error(); err != nil {
+		return 0, err
+	}
+	if truncated && size > 0 && sampled > 0 {
+		return size * keys / sampled, nil
+	}
+	return keys, nil
+}
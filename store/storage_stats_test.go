@@ -0,0 +1,61 @@
+package store
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+)
+
+func TestStorageCollector(t *testing.T) {
+	dir := t.TempDir()
+	ldb, err := dbm.NewGoLevelDB("blockstore", dir)
+	require.NoError(t, err)
+	defer ldb.Close()
+	for i := 0; i < 5000; i++ {
+		require.NoError(t, ldb.Set([]byte(fmt.Sprintf("key-%06d", i)), make([]byte, 100)))
+	}
+	require.NoError(t, ldb.Compact(nil, nil))
+
+	mdb := dbm.NewMemDB()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, mdb.Set([]byte(fmt.Sprintf("key-%d", i)), []byte("value")))
+	}
+
+	c := NewStorageCollector(time.Hour, NopMetrics())
+	c.AddDB("blockstore", ldb, filepath.Join(dir, "blockstore.db"))
+	c.AddDB("state", mdb, "")
+	c.Collect()
+
+	stats := c.Stats()
+	require.Len(t, stats, 2)
+
+	assert.Equal(t, "blockstore", stats[0].Name)
+	assert.Equal(t, string(dbm.GoLevelDBBackend), stats[0].Backend)
+	assert.Positive(t, stats[0].SizeBytes)
+	// compression skews the extrapolation, only check the magnitude
+	assert.Greater(t, stats[0].Keys, int64(keySampleSize))
+	assert.GreaterOrEqual(t, stats[0].WriteAmplification, 1.0)
+
+	assert.Equal(t, "state", stats[1].Name)
+	assert.Equal(t, string(dbm.MemDBBackend), stats[1].Backend)
+	assert.Zero(t, stats[1].SizeBytes)
+	assert.EqualValues(t, 10, stats[1].Keys)
+	assert.Zero(t, stats[1].CompactionBacklogBytes)
+}
+
+func TestApproxKeysCapsSample(t *testing.T) {
+	mdb := dbm.NewMemDB()
+	for i := 0; i < 2*keySampleSize; i++ {
+		require.NoError(t, mdb.Set([]byte(fmt.Sprintf("key-%06d", i)), []byte("value")))
+	}
+	// without an on-disk size, the count can't be extrapolated
+	keys, err := approxKeys(mdb, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, keySampleSize, keys)
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strconv"
@@ -142,15 +143,9 @@ func (bs *BlockStore) LoadBlock(height int64) *types.Block {
 	}
 
 	pbb := new(cmtproto.Block)
-	buf := []byte{}
-	for i := 0; i < int(blockMeta.BlockID.PartSetHeader.Total); i++ {
-		part := bs.LoadBlockPart(height, i)
-		// If the part is missing (e.g. since it has been deleted after we
-		// loaded the block meta) we consider the whole block to be missing.
-		if part == nil {
-			return nil
-		}
-		buf = append(buf, part.Bytes...)
+	buf := bs.loadBlockBytes(blockMeta)
+	if buf == nil {
+		return nil
 	}
 	err := proto.Unmarshal(buf, pbb)
 	if err != nil {
@@ -167,6 +162,16 @@ func (bs *BlockStore) LoadBlock(height int64) *types.Block {
 	return block
 }
 
+// loadBlockBytes returns the concatenated parts of the block described by
+// blockMeta. If a part is missing (e.g. since it has been deleted after we
+// loaded the block meta) we consider the whole block to be missing, and nil
+// is returned.
+func (bs *BlockStore) loadBlockBytes(blockMeta *types.BlockMeta) []byte {
+	return types.JoinPartBytes(blockMeta.BlockID.PartSetHeader.Total, func(i int) *types.Part {
+		return bs.LoadBlockPart(blockMeta.Header.Height, i)
+	})
+}
+
 // LoadBlockByHash returns the block with the given hash.
 // If no block is found for that hash, it returns nil.
 // Panics if it fails to parse height associated with the given hash.
@@ -657,6 +662,22 @@ func calcTxHashKey(hash []byte) []byte {
 	return []byte(fmt.Sprintf("TH:%x", hash))
 }
 
+// cacheablePrefixes are the prefixes of the keys of the block metas, commits
+// and block hashes.
+var cacheablePrefixes = [][]byte{[]byte("H:"), []byte("C:"), []byte("SC:"), []byte("EC:"), []byte("BH:")}
+
+// CacheableKey returns true for the keys of the block metas and commits,
+// which are read repeatedly, e.g. to serve recent headers, unlike the block
+// parts. It is meant to be given to ReadCache.Wrap for the block store.
+func CacheableKey(key []byte) bool {
+	for _, prefix := range cacheablePrefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 //-----------------------------------------------------------------------------
 
 var blockStoreKey = []byte("blockStore")
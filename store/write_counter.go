@@ -0,0 +1,85 @@
+package store
+
+import (
+	"sync/atomic"
+
+	dbm "github.com/cometbft/cometbft-db"
+)
+
+// WriteCounter counts the bytes of the keys and values written to the
+// databases it wraps, whether directly or in batches. Deletions are not
+// counted. The zero value is ready to use.
+type WriteCounter struct {
+	written atomic.Int64
+}
+
+// Wrap returns db with its writes counted by c.
+func (c *WriteCounter) Wrap(db dbm.DB) dbm.DB {
+	return &countingDB{DB: db, counter: c}
+}
+
+// Written returns the number of bytes written so far.
+func (c *WriteCounter) Written() int64 {
+	return c.written.Load()
+}
+
+type countingDB struct {
+	dbm.DB
+	counter *WriteCounter
+}
+
+func (db *countingDB) Set(key, value []byte) error {
+	if err := db.DB.Set(key, value); err != nil {
+		return err
+	}
+	db.counter.written.Add(int64(len(key) + len(value)))
+	return nil
+}
+
+func (db *countingDB) SetSync(key, value []byte) error {
+	if err := db.DB.SetSync(key, value); err != nil {
+		return err
+	}
+	db.counter.written.Add(int64(len(key) + len(value)))
+	return nil
+}
+
+func (db *countingDB) NewBatch() dbm.Batch {
+	return &countingBatch{Batch: db.DB.NewBatch(), counter: db.counter}
+}
+
+// countingBatch counts the bytes set in the batch once it is written.
+type countingBatch struct {
+	dbm.Batch
+	counter *WriteCounter
+	pending int64
+}
+
+func (b *countingBatch) Set(key, value []byte) error {
+	if err := b.Batch.Set(key, value); err != nil {
+		return err
+	}
+	b.pending += int64(len(key) + len(value))
+	return nil
+}
+
+func (b *countingBatch) Write() error {
+	if err := b.Batch.Write(); err != nil {
+		return err
+	}
+	b.flush()
+	return nil
+}
+
+func (b *countingBatch) WriteSync() error {
+	if err := b.Batch.WriteSync(); err != nil {
+		return err
+	}
+	b.flush()
+	return nil
+}
+
+func (b *countingBatch) flush() {
+	b.counter.written.Add(b.pending)
+	b.pending = 0
+}
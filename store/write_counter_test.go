@@ -0,0 +1,32 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+)
+
+func TestWriteCounter(t *testing.T) {
+	var c WriteCounter
+	db := c.Wrap(dbm.NewMemDB())
+
+	require.NoError(t, db.Set([]byte("key"), []byte("value")))
+	require.NoError(t, db.SetSync([]byte("k"), []byte("v")))
+	require.NoError(t, db.Delete([]byte("k")))
+	assert.EqualValues(t, 10, c.Written())
+
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("a"), []byte("bcd")))
+	require.NoError(t, batch.Set([]byte("e"), []byte("f")))
+	assert.EqualValues(t, 10, c.Written(), "batch counted before it is written")
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+	assert.EqualValues(t, 16, c.Written())
+
+	value, err := db.Get([]byte("a"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("bcd"), value)
+}
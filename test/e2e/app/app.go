@@ -108,6 +108,22 @@ type Config struct {
 	// -1 denotes it is set at genesis.
 	// 0 denotes it is set at InitChain.
 	VoteExtensionsUpdateHeight int64 `toml:"vote_extensions_update_height"`
+
+	// StateSize is the number of synthetic keys the state is seeded with at
+	// InitChain, so that state sync is exercised with realistically large
+	// snapshots. Each value is StateValueSize bytes long, 32 by default.
+	// Large states should be combined with a PersistInterval greater than 1,
+	// since the whole state is written to disk when persisting.
+	StateSize      uint64 `toml:"state_size"`
+	StateValueSize int    `toml:"state_value_size"`
+
+	// SnapshotChunkSize is the size in bytes of state sync snapshot chunks.
+	// Defaults to 1MB.
+	SnapshotChunkSize uint32 `toml:"snapshot_chunk_size"`
+
+	// SnapshotChunkApplyDelay adds an artificial delay to every
+	// ApplySnapshotChunk call to mimic slow state restoration.
+	SnapshotChunkApplyDelay time.Duration `toml:"snapshot_chunk_apply_delay"`
 }
 
 func DefaultConfig(dir string) *Config {
@@ -124,7 +140,7 @@ func NewApplication(cfg *Config) (*Application, error) {
 	if err != nil {
 		return nil, err
 	}
-	snapshots, err := NewSnapshotStore(filepath.Join(cfg.Dir, "snapshots"))
+	snapshots, err := NewSnapshotStore(filepath.Join(cfg.Dir, "snapshots"), cfg.SnapshotChunkSize)
 	if err != nil {
 		return nil, err
 	}
@@ -174,6 +190,10 @@ func (app *Application) InitChain(_ context.Context, req *abci.RequestInitChain)
 			return nil, err
 		}
 	}
+	if app.cfg.StateSize > 0 {
+		app.logger.Info("seeding app_state", "keys", app.cfg.StateSize, "value_size", app.cfg.StateValueSize)
+		app.state.Seed(app.cfg.StateSize, app.cfg.StateValueSize)
+	}
 	app.logger.Info("setting ChainID in app_state", "chainId", req.ChainId)
 	app.state.Set(prefixReservedKey+suffixChainID, req.ChainId)
 	app.logger.Info("setting VoteExtensionsHeight in app_state", "height", req.ConsensusParams.Abci.VoteExtensionsEnableHeight)
@@ -281,12 +301,12 @@ func (app *Application) FinalizeBlock(_ context.Context, req *abci.RequestFinali
 }
 
 // Commit implements ABCI.
-func (app *Application) Commit(_ context.Context, _ *abci.RequestCommit) (*abci.ResponseCommit, error) {
+func (app *Application) Commit(_ context.Context, req *abci.RequestCommit) (*abci.ResponseCommit, error) {
 	height, err := app.state.Commit()
 	if err != nil {
 		panic(err)
 	}
-	if app.cfg.SnapshotInterval > 0 && height%app.cfg.SnapshotInterval == 0 {
+	if req.Snapshot || (app.cfg.SnapshotInterval > 0 && height%app.cfg.SnapshotInterval == 0) {
 		snapshot, err := app.snapshots.Create(app.state)
 		if err != nil {
 			panic(err)
@@ -349,6 +369,9 @@ func (app *Application) ApplySnapshotChunk(_ context.Context, req *abci.RequestA
 	if app.restoreSnapshot == nil {
 		panic("No restore in progress")
 	}
+	if app.cfg.SnapshotChunkApplyDelay != 0 {
+		time.Sleep(app.cfg.SnapshotChunkApplyDelay)
+	}
 	app.restoreChunks = append(app.restoreChunks, req.Chunk)
 	if len(app.restoreChunks) == int(app.restoreSnapshot.Chunks) {
 		bz := []byte{}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,7 +14,7 @@ import (
 )
 
 const (
-	snapshotChunkSize = 1e6
+	defaultSnapshotChunkSize = 1e6
 
 	// Keep only the most recent 10 snapshots. Older snapshots are pruned
 	maxSnapshotCount = 10
@@ -21,16 +22,22 @@ const (
 
 // SnapshotStore stores state sync snapshots. Snapshots are stored simply as
 // JSON files, and chunks are generated on-the-fly by splitting the JSON data
-// into fixed-size chunks.
+// into fixed-size chunks. The chunk size of each snapshot is recorded in its
+// metadata, so that snapshots remain servable if the chunk size changes.
 type SnapshotStore struct {
 	sync.RWMutex
-	dir      string
-	metadata []abci.Snapshot
+	dir       string
+	chunkSize uint32
+	metadata  []abci.Snapshot
 }
 
-// NewSnapshotStore creates a new snapshot store.
-func NewSnapshotStore(dir string) (*SnapshotStore, error) {
-	store := &SnapshotStore{dir: dir}
+// NewSnapshotStore creates a new snapshot store, creating snapshots with
+// chunks of chunkSize bytes, or 1MB if zero.
+func NewSnapshotStore(dir string, chunkSize uint32) (*SnapshotStore, error) {
+	if chunkSize == 0 {
+		chunkSize = defaultSnapshotChunkSize
+	}
+	store := &SnapshotStore{dir: dir, chunkSize: chunkSize}
 	if err := os.MkdirAll(dir, 0o755); err != nil {
 		return nil, err
 	}
@@ -88,11 +95,14 @@ func (s *SnapshotStore) Create(state *State) (abci.Snapshot, error) {
 	if err != nil {
 		return abci.Snapshot{}, err
 	}
+	metadata := make([]byte, 4)
+	binary.BigEndian.PutUint32(metadata, s.chunkSize)
 	snapshot := abci.Snapshot{
-		Height: height,
-		Format: 1,
-		Hash:   stateHash,
-		Chunks: byteChunks(bz),
+		Height:   height,
+		Format:   1,
+		Hash:     stateHash,
+		Chunks:   byteChunks(bz, s.chunkSize),
+		Metadata: metadata,
 	}
 	err = os.WriteFile(filepath.Join(s.dir, fmt.Sprintf("%v.json", height)), bz, 0o644) //nolint:gosec
 	if err != nil {
@@ -148,16 +158,25 @@ func (s *SnapshotStore) LoadChunk(height uint64, format uint32, chunk uint32) ([
 			if err != nil {
 				return nil, err
 			}
-			return byteChunk(bz, chunk), nil
+			return byteChunk(bz, chunk, snapshotChunkSize(snapshot)), nil
 		}
 	}
 	return nil, nil
 }
 
+// snapshotChunkSize returns the chunk size recorded in the snapshot metadata.
+// Snapshots taken before it was recorded use the default chunk size.
+func snapshotChunkSize(snapshot abci.Snapshot) uint32 {
+	if len(snapshot.Metadata) != 4 {
+		return defaultSnapshotChunkSize
+	}
+	return binary.BigEndian.Uint32(snapshot.Metadata)
+}
+
 // byteChunk returns the chunk at a given index from the full byte slice.
-func byteChunk(bz []byte, index uint32) []byte {
-	start := int(index * snapshotChunkSize)
-	end := int((index + 1) * snapshotChunkSize)
+func byteChunk(bz []byte, index uint32, chunkSize uint32) []byte {
+	start := int(index) * int(chunkSize)
+	end := int(index+1) * int(chunkSize)
 	switch {
 	case start >= len(bz):
 		return nil
@@ -169,6 +188,6 @@ func byteChunk(bz []byte, index uint32) []byte {
 }
 
 // byteChunks calculates the number of chunks in the byte slice.
-func byteChunks(bz []byte) uint32 {
-	return uint32(math.Ceil(float64(len(bz)) / snapshotChunkSize))
+func byteChunks(bz []byte, chunkSize uint32) uint32 {
+	return uint32(math.Ceil(float64(len(bz)) / float64(chunkSize)))
 }
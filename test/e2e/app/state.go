@@ -3,6 +3,7 @@ package app
 import (
 	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,6 +16,9 @@ import (
 const (
 	stateFileName     = "app_state.json"
 	prevStateFileName = "prev_app_state.json"
+
+	seedKeyPrefix        = "seed/"
+	defaultSeedValueSize = 32
 )
 
 // Intermediate type used exclusively in serialization/deserialization of
@@ -172,6 +176,27 @@ func (s *State) Set(key, value string) {
 	}
 }
 
+// Seed sets n synthetic keys with deterministic values of valueSize bytes
+// (32 if zero), so that every node seeding the same parameters ends up with
+// the same state.
+func (s *State) Seed(n uint64, valueSize int) {
+	if valueSize <= 0 {
+		valueSize = defaultSeedValueSize
+	}
+	s.Lock()
+	defer s.Unlock()
+	value := make([]byte, 0, valueSize)
+	for i := uint64(0); i < n; i++ {
+		key := fmt.Sprintf("%s%010d", seedKeyPrefix, i)
+		sum := sha256.Sum256([]byte(key))
+		value = value[:0]
+		for len(value) < valueSize {
+			value = hex.AppendEncode(value, sum[:])
+		}
+		s.values[key] = string(value[:valueSize])
+	}
+}
+
 // Query is used in the ABCI Query call, and provides both the current height
 // and the value associated with the given key.
 func (s *State) Query(key string) (string, uint64) {
@@ -12,6 +12,12 @@ const (
 	dockerIPv4CIDR = "10.186.73.0/24"
 	dockerIPv6CIDR = "fd80:b10c::/48"
 
+	// the counterparty of a testnet run on docker gets its own network and
+	// ports, so that both can run at the same time
+	counterpartyIPv4CIDR   = "10.186.74.0/24"
+	counterpartyIPv6CIDR   = "fd80:b10d::/48"
+	counterpartyPortOffset = 100
+
 	globalIPv4CIDR = "0.0.0.0/0"
 )
 
@@ -50,6 +56,10 @@ type InfrastructureData struct {
 
 	// PyroscopeProfileTypes is the list of profile types to collect.
 	PyroscopeProfileTypes []string `json:"pyroscope_profile_types,omitempty"`
+
+	// prometheusProxyPortFirst is the first port Prometheus is exposed on, if
+	// not the default one.
+	prometheusProxyPortFirst uint32
 }
 
 // InstanceData contains the relevant information for a machine instance backing
@@ -75,12 +85,32 @@ func NewDockerInfrastructureData(m Manifest) (InfrastructureData, error) {
 	if m.IPv6 {
 		netAddress = dockerIPv6CIDR
 	}
+	return newDockerInfrastructureData(m, netAddress, proxyPortFirst)
+}
+
+// NewCounterpartyDockerInfrastructureData returns the infrastructure data of
+// the counterparty of a testnet run on docker, whose network and ports do not
+// overlap with those of the testnet.
+func NewCounterpartyDockerInfrastructureData(m Manifest) (InfrastructureData, error) {
+	netAddress := counterpartyIPv4CIDR
+	if m.IPv6 {
+		netAddress = counterpartyIPv6CIDR
+	}
+	ifd, err := newDockerInfrastructureData(m, netAddress, proxyPortFirst+counterpartyPortOffset)
+	if err != nil {
+		return InfrastructureData{}, err
+	}
+	ifd.prometheusProxyPortFirst = prometheusProxyPortFirst + counterpartyPortOffset
+	return ifd, nil
+}
+
+func newDockerInfrastructureData(m Manifest, netAddress string, firstPort uint32) (InfrastructureData, error) {
 	_, ipNet, err := net.ParseCIDR(netAddress)
 	if err != nil {
 		return InfrastructureData{}, fmt.Errorf("invalid IP network address %q: %w", netAddress, err)
 	}
 
-	portGen := newPortGenerator(proxyPortFirst)
+	portGen := newPortGenerator(firstPort)
 	ipGen := newIPGenerator(ipNet)
 	ifd := InfrastructureData{
 		Provider:  "docker",
@@ -56,6 +56,13 @@ type Manifest struct {
 	// testnet via the RPC endpoint of a random node. Default is 0
 	Evidence int `toml:"evidence"`
 
+	// Counterparty is the path, relative to this manifest, of the manifest of
+	// a second, independent testnet launched alongside this one. The runner
+	// then relays transactions between the two chains like an IBC relayer,
+	// verifying the blocks they were committed in with light clients. The node
+	// names of the two testnets must differ. Only supported on docker.
+	Counterparty string `toml:"counterparty"`
+
 	// ABCIProtocol specifies the protocol used to communicate with the ABCI
 	// application: "unix", "tcp", "grpc", "builtin" or "builtin_connsync".
 	//
@@ -76,6 +83,20 @@ type Manifest struct {
 	VoteExtensionDelay   time.Duration `toml:"vote_extension_delay"`
 	FinalizeBlockDelay   time.Duration `toml:"finalize_block_delay"`
 
+	// StateSize seeds the application state with this many synthetic keys of
+	// StateValueSize bytes each (32 by default), so that state sync is tested
+	// with realistically large snapshots.
+	StateSize      uint64 `toml:"state_size"`
+	StateValueSize int    `toml:"state_value_size"`
+
+	// SnapshotChunkSize is the size in bytes of the application's state sync
+	// snapshot chunks. Defaults to 1MB.
+	SnapshotChunkSize uint32 `toml:"snapshot_chunk_size"`
+
+	// SnapshotChunkApplyDelay adds an artificial delay to every
+	// ApplySnapshotChunk call to mimic slow state restoration.
+	SnapshotChunkApplyDelay time.Duration `toml:"snapshot_chunk_apply_delay"`
+
 	// UpgradeVersion specifies to which version nodes need to upgrade.
 	// Currently only uncoordinated upgrade is supported
 	UpgradeVersion string `toml:"upgrade_version"`
@@ -198,6 +219,15 @@ type ManifestNode struct {
 
 	// MempoolVersion specifies the mempool version to use: "flood" or "priority".
 	MempoolVersion string `toml:"mempool_version"`
+
+	// PrevoteDelay and PrecommitDelay delay the broadcast of the node's own
+	// prevotes and precommits, plus a jitter of up to VoteJitter derived from
+	// VoteJitterSeed and the height, round and type of the vote, to explore
+	// timing dependent consensus behaviors reproducibly.
+	PrevoteDelay   time.Duration `toml:"prevote_delay"`
+	PrecommitDelay time.Duration `toml:"precommit_delay"`
+	VoteJitter     time.Duration `toml:"vote_jitter"`
+	VoteJitterSeed int64         `toml:"vote_jitter_seed"`
 }
 
 // Save saves the testnet manifest to a file.
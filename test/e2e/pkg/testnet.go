@@ -78,6 +78,7 @@ type Testnet struct {
 	Nodes                                                []*Node
 	KeyType                                              string
 	Evidence                                             int
+	Counterparty                                         string
 	LoadTxSizeBytes                                      int
 	LoadTxBatchSize                                      int
 	LoadTxConnections                                    int
@@ -88,6 +89,10 @@ type Testnet struct {
 	CheckTxDelay                                         time.Duration
 	VoteExtensionDelay                                   time.Duration
 	FinalizeBlockDelay                                   time.Duration
+	StateSize                                            uint64
+	StateValueSize                                       int
+	SnapshotChunkSize                                    uint32
+	SnapshotChunkApplyDelay                              time.Duration
 	UpgradeVersion                                       string
 	LogLevel                                             string
 	LogFormat                                            string
@@ -128,6 +133,10 @@ type Node struct {
 	SendNoLoad          bool
 	Prometheus          bool
 	PrometheusProxyPort uint32
+	PrevoteDelay        time.Duration
+	PrecommitDelay      time.Duration
+	VoteJitter          time.Duration
+	VoteJitterSeed      int64
 
 	MaxInboundConnections  int
 	MaxOutboundConnections int
@@ -152,12 +161,42 @@ func LoadTestnet(file string, ifd InfrastructureData) (*Testnet, error) {
 	return NewTestnetFromManifest(manifest, file, ifd)
 }
 
+// LoadCounterpartyTestnet loads the counterparty of a testnet run on docker,
+// along with the infrastructure data it runs on.
+func LoadCounterpartyTestnet(testnet *Testnet) (*Testnet, InfrastructureData, error) {
+	if testnet.Counterparty == "" {
+		return nil, InfrastructureData{}, fmt.Errorf("network %q has no counterparty", testnet.Name)
+	}
+	manifest, err := LoadManifest(testnet.Counterparty)
+	if err != nil {
+		return nil, InfrastructureData{}, err
+	}
+	if manifest.Counterparty != "" {
+		return nil, InfrastructureData{}, errors.New("counterparty cannot have a counterparty of its own")
+	}
+	ifd, err := NewCounterpartyDockerInfrastructureData(manifest)
+	if err != nil {
+		return nil, InfrastructureData{}, err
+	}
+	counterparty, err := NewTestnetFromManifest(manifest, testnet.Counterparty, ifd)
+	if err != nil {
+		return nil, InfrastructureData{}, err
+	}
+	if err := testnet.ValidateCounterparty(counterparty); err != nil {
+		return nil, InfrastructureData{}, err
+	}
+	return counterparty, ifd, nil
+}
+
 // NewTestnetFromManifest creates and validates a testnet from a manifest
 func NewTestnetFromManifest(manifest Manifest, file string, ifd InfrastructureData) (*Testnet, error) {
 	dir := strings.TrimSuffix(file, filepath.Ext(file))
 
 	keyGen := newKeyGenerator(randomSeed)
 	prometheusProxyPortGen := newPortGenerator(prometheusProxyPortFirst)
+	if ifd.prometheusProxyPortFirst != 0 {
+		prometheusProxyPortGen = newPortGenerator(ifd.prometheusProxyPortFirst)
+	}
 	_, ipNet, err := net.ParseCIDR(ifd.Network)
 	if err != nil {
 		return nil, fmt.Errorf("invalid IP network address %q: %w", ifd.Network, err)
@@ -184,6 +223,10 @@ func NewTestnetFromManifest(manifest Manifest, file string, ifd InfrastructureDa
 		CheckTxDelay:               manifest.CheckTxDelay,
 		VoteExtensionDelay:         manifest.VoteExtensionDelay,
 		FinalizeBlockDelay:         manifest.FinalizeBlockDelay,
+		StateSize:                  manifest.StateSize,
+		StateValueSize:             manifest.StateValueSize,
+		SnapshotChunkSize:          manifest.SnapshotChunkSize,
+		SnapshotChunkApplyDelay:    manifest.SnapshotChunkApplyDelay,
 		UpgradeVersion:             manifest.UpgradeVersion,
 		LogLevel:                   manifest.LogLevel,
 		LogFormat:                  manifest.LogFormat,
@@ -203,6 +246,9 @@ func NewTestnetFromManifest(manifest Manifest, file string, ifd InfrastructureDa
 	if manifest.InitialHeight > 0 {
 		testnet.InitialHeight = manifest.InitialHeight
 	}
+	if manifest.Counterparty != "" {
+		testnet.Counterparty = filepath.Join(filepath.Dir(file), manifest.Counterparty)
+	}
 	if testnet.ABCIProtocol == "" {
 		testnet.ABCIProtocol = string(ProtocolBuiltin)
 	}
@@ -256,6 +302,10 @@ func NewTestnetFromManifest(manifest Manifest, file string, ifd InfrastructureDa
 			Perturbations:    []Perturbation{},
 			SendNoLoad:       nodeManifest.SendNoLoad,
 			Prometheus:       testnet.Prometheus,
+			PrevoteDelay:     nodeManifest.PrevoteDelay,
+			PrecommitDelay:   nodeManifest.PrecommitDelay,
+			VoteJitter:       nodeManifest.VoteJitter,
+			VoteJitterSeed:   nodeManifest.VoteJitterSeed,
 
 			TracePushConfig:       ifd.TracePushConfig,
 			TracePullAddress:      ifd.TracePullAddress,
@@ -380,9 +430,18 @@ func (t Testnet) Validate() error {
 	if len(t.Nodes) == 0 {
 		return errors.New("network has no nodes")
 	}
+	if t.Counterparty != "" && filepath.Clean(t.Counterparty) == filepath.Clean(t.File) {
+		return errors.New("network cannot be its own counterparty")
+	}
 	if t.BlockMaxBytes > types.MaxBlockSizeBytes {
 		return fmt.Errorf("value of BlockMaxBytes cannot be higher than %d", types.MaxBlockSizeBytes)
 	}
+	if t.StateValueSize < 0 {
+		return fmt.Errorf("value of StateValueSize can't be negative; got %d", t.StateValueSize)
+	}
+	if t.SnapshotChunkApplyDelay < 0 {
+		return fmt.Errorf("value of SnapshotChunkApplyDelay can't be negative; got %v", t.SnapshotChunkApplyDelay)
+	}
 	if t.VoteExtensionsUpdateHeight < -1 {
 		return fmt.Errorf("value of VoteExtensionsUpdateHeight must be positive, 0 (InitChain), "+
 			"or -1 (Genesis); update height %d", t.VoteExtensionsUpdateHeight)
@@ -496,6 +555,9 @@ func (n Node) Validate(testnet Testnet) error {
 	if n.SnapshotInterval > 0 && n.RetainBlocks > 0 && n.RetainBlocks < n.SnapshotInterval {
 		return errors.New("snapshot_interval must be less than er equal to retain_blocks")
 	}
+	if n.PrevoteDelay < 0 || n.PrecommitDelay < 0 || n.VoteJitter < 0 {
+		return errors.New("prevote_delay, precommit_delay and vote_jitter can't be negative")
+	}
 
 	var upgradeFound bool
 	for _, perturbation := range n.Perturbations {
@@ -537,6 +599,23 @@ func (t Testnet) ArchiveNodes() []*Node {
 	return nodes
 }
 
+// ValidateCounterparty checks that the testnet can run alongside the given
+// counterparty.
+func (t Testnet) ValidateCounterparty(counterparty *Testnet) error {
+	if t.Name == counterparty.Name {
+		return fmt.Errorf("counterparty has the same name %q", t.Name)
+	}
+	for _, node := range counterparty.Nodes {
+		if t.LookupNode(node.Name) != nil {
+			return fmt.Errorf("node %q is in both the network and its counterparty", node.Name)
+		}
+	}
+	if len(t.ArchiveNodes()) == 0 || len(counterparty.ArchiveNodes()) == 0 {
+		return errors.New("relaying requires archive nodes in both the network and its counterparty")
+	}
+	return nil
+}
+
 // RandomNode returns a random non-seed node.
 func (t Testnet) RandomNode() *Node {
 	for {
@@ -31,6 +31,11 @@ type CLI struct {
 	testnet  *e2e.Testnet
 	preserve bool
 	infp     infra.Provider
+
+	// counterparty is the testnet launched alongside testnet, if any, which
+	// runs on counterpartyInfp
+	counterparty     *e2e.Testnet
+	counterpartyInfp infra.Provider
 }
 
 // NewCLI sets up the CLI.
@@ -104,13 +109,30 @@ func NewCLI() *CLI {
 			default:
 				return fmt.Errorf("bad infrastructure type: %s", inft)
 			}
+
+			if testnet.Counterparty != "" {
+				if inft != "docker" {
+					return errors.New("counterparty testnets are only supported on docker")
+				}
+				counterparty, cifd, err := e2e.LoadCounterpartyTestnet(testnet)
+				if err != nil {
+					return fmt.Errorf("loading counterparty: %s", err)
+				}
+				cli.counterparty = counterparty
+				cli.counterpartyInfp = &docker.Provider{
+					ProviderData: infra.ProviderData{
+						Testnet:            counterparty,
+						InfrastructureData: cifd,
+					},
+				}
+			}
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := Cleanup(cli.testnet); err != nil {
+			if err := cli.cleanup(); err != nil {
 				return err
 			}
-			if err := Setup(cli.testnet, cli.infp); err != nil {
+			if err := cli.setup(); err != nil {
 				return err
 			}
 
@@ -127,7 +149,7 @@ func NewCLI() *CLI {
 				chLoadResult <- err
 			}()
 
-			if err := Start(cmd.Context(), cli.testnet, cli.infp); err != nil {
+			if err := cli.start(cmd.Context()); err != nil {
 				return err
 			}
 
@@ -153,6 +175,12 @@ func NewCLI() *CLI {
 				}
 			}
 
+			if cli.counterparty != nil {
+				if err := Relay(cmd.Context(), cli.testnet, cli.counterparty, relayTxs); err != nil {
+					return err
+				}
+			}
+
 			loadCancel()
 			if err := <-chLoadResult; err != nil {
 				return err
@@ -160,11 +188,11 @@ func NewCLI() *CLI {
 			if err := Wait(cmd.Context(), cli.testnet, 5); err != nil { // wait for network to settle before tests
 				return err
 			}
-			if err := Test(cli.testnet, cli.infp.GetInfrastructureData()); err != nil {
+			if err := cli.test(); err != nil {
 				return err
 			}
 			if !cli.preserve {
-				if err := Cleanup(cli.testnet); err != nil {
+				if err := cli.cleanup(); err != nil {
 					return err
 				}
 			}
@@ -186,7 +214,7 @@ func NewCLI() *CLI {
 		Use:   "setup",
 		Short: "Generates the testnet directory and configuration",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return Setup(cli.testnet, cli.infp)
+			return cli.setup()
 		},
 	})
 
@@ -196,12 +224,12 @@ func NewCLI() *CLI {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			_, err := os.Stat(cli.testnet.Dir)
 			if os.IsNotExist(err) {
-				err = Setup(cli.testnet, cli.infp)
+				err = cli.setup()
 			}
 			if err != nil {
 				return err
 			}
-			return Start(cmd.Context(), cli.testnet, cli.infp)
+			return cli.start(cmd.Context())
 		},
 	})
 
@@ -226,10 +254,26 @@ func NewCLI() *CLI {
 		Short: "Stops the testnet",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			logger.Info("Stopping testnet")
+			if cli.counterparty != nil {
+				if err := cli.counterpartyInfp.StopTestnet(context.Background()); err != nil {
+					return err
+				}
+			}
 			return cli.infp.StopTestnet(context.Background())
 		},
 	})
 
+	cli.root.AddCommand(&cobra.Command{
+		Use:   "relay",
+		Short: "Relays transactions between the testnet and its counterparty",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cli.counterparty == nil {
+				return errors.New("the testnet has no counterparty")
+			}
+			return Relay(cmd.Context(), cli.testnet, cli.counterparty, relayTxs)
+		},
+	})
+
 	cli.root.AddCommand(&cobra.Command{
 		Use:   "load",
 		Short: "Generates transaction load until the command is canceled",
@@ -265,7 +309,7 @@ func NewCLI() *CLI {
 		Use:   "test",
 		Short: "Runs test cases against a running testnet",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return Test(cli.testnet, cli.infp.GetInfrastructureData())
+			return cli.test()
 		},
 	})
 
@@ -273,7 +317,7 @@ func NewCLI() *CLI {
 		Use:   "cleanup",
 		Short: "Removes the testnet directory",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return Cleanup(cli.testnet)
+			return cli.cleanup()
 		},
 	})
 
@@ -349,6 +393,50 @@ Does not run any perturbations.
 	return cli
 }
 
+// setup sets up the testnet and its counterparty, if any.
+func (cli *CLI) setup() error {
+	if err := Setup(cli.testnet, cli.infp); err != nil {
+		return err
+	}
+	if cli.counterparty == nil {
+		return nil
+	}
+	return Setup(cli.counterparty, cli.counterpartyInfp)
+}
+
+// start starts the testnet and its counterparty, if any.
+func (cli *CLI) start(ctx context.Context) error {
+	if err := Start(ctx, cli.testnet, cli.infp); err != nil {
+		return err
+	}
+	if cli.counterparty == nil {
+		return nil
+	}
+	return Start(ctx, cli.counterparty, cli.counterpartyInfp)
+}
+
+// test runs the test cases against the testnet and its counterparty, if any.
+func (cli *CLI) test() error {
+	if err := Test(cli.testnet, cli.infp.GetInfrastructureData()); err != nil {
+		return err
+	}
+	if cli.counterparty == nil {
+		return nil
+	}
+	return TestCounterparty(cli.testnet)
+}
+
+// cleanup removes the testnet and its counterparty, if any.
+func (cli *CLI) cleanup() error {
+	if err := Cleanup(cli.testnet); err != nil {
+		return err
+	}
+	if cli.counterparty == nil {
+		return nil
+	}
+	return cleanupDir(cli.counterparty.Dir)
+}
+
 // Run runs the CLI.
 func (cli *CLI) Run() {
 	if err := cli.root.Execute(); err != nil {
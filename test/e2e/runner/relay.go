@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/light"
+	lightdb "github.com/cometbft/cometbft/light/store/db"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	e2e "github.com/cometbft/cometbft/test/e2e/pkg"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// relayTxs is the number of transactions relayed each way between a
+	// testnet and its counterparty.
+	relayTxs = 5
+
+	// relayTimeout bounds the time it takes to relay a transaction.
+	relayTimeout = time.Minute
+
+	relayTrustPeriod = time.Hour
+)
+
+// Relay mimics an IBC relayer between a testnet and its counterparty: it
+// submits transactions to each chain and, once they are committed, relays
+// them to the other chain along with the hash of the block they were
+// committed in. The transactions are found through the tx indexer, and their
+// blocks verified by a light client of their chain, on both sides.
+func Relay(ctx context.Context, testnet, counterparty *e2e.Testnet, n int) error {
+	logger.Info("relay", "msg", log.NewLazySprintf("Relaying %v transactions each way between %q and %q...",
+		n, testnet.Name, counterparty.Name))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	a, err := newRelayEnd(ctx, testnet)
+	if err != nil {
+		return err
+	}
+	b, err := newRelayEnd(ctx, counterparty)
+	if err != nil {
+		return err
+	}
+
+	errCh := make(chan error, 2)
+	go func() { errCh <- relay(ctx, a, b, n) }()
+	go func() { errCh <- relay(ctx, b, a, n) }()
+	for i := 0; i < 2; i++ {
+		if err := <-errCh; err != nil {
+			return err
+		}
+	}
+	logger.Info("relay", "msg", log.NewLazySprintf("Relayed %v transactions each way", n))
+	return nil
+}
+
+// relay submits n transactions to src and relays them to dst.
+func relay(ctx context.Context, src, dst *relayEnd, n int) error {
+	for i := 0; i < n; i++ {
+		key := fmt.Sprintf("relay-%v-%v", src.testnet.Name, i)
+		block, err := src.commit(ctx, types.Tx(fmt.Sprintf("%v=%v", key, i)))
+		if err != nil {
+			return err
+		}
+
+		// like an IBC client update, the counterparty stores the hash of
+		// the verified block
+		relayedKey := fmt.Sprintf("relayed/%v/%v", src.testnet.Name, key)
+		relayedValue := fmt.Sprintf("%v:%X", block.Height, block.Hash())
+		if _, err := dst.commit(ctx, types.Tx(relayedKey+"="+relayedValue)); err != nil {
+			return err
+		}
+		res, err := dst.client.ABCIQuery(ctx, "", []byte(relayedKey))
+		if err != nil {
+			return err
+		}
+		if string(res.Response.Value) != relayedValue {
+			return fmt.Errorf("relayed value of %q on %q is %q, expected %q",
+				relayedKey, dst.testnet.Name, res.Response.Value, relayedValue)
+		}
+	}
+	return nil
+}
+
+// relayEnd is a chain connected by the relayer, which follows it with a
+// light client.
+type relayEnd struct {
+	testnet *e2e.Testnet
+	client  *rpchttp.HTTP
+	light   *light.Client
+}
+
+func newRelayEnd(ctx context.Context, testnet *e2e.Testnet) (*relayEnd, error) {
+	nodes := testnet.ArchiveNodes()
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no archive nodes in %q to relay from", testnet.Name)
+	}
+	client, err := nodes[0].Client()
+	if err != nil {
+		return nil, err
+	}
+
+	// trust the latest block, as a relayer creating a new client would
+	commit, err := client.Commit(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	primary := rpcURL(nodes[0])
+	witnesses := []string{primary}
+	if len(nodes) > 1 {
+		witnesses = witnesses[:0]
+		for _, node := range nodes[1:] {
+			witnesses = append(witnesses, rpcURL(node))
+		}
+	}
+	lc, err := light.NewHTTPClient(ctx, testnet.Name,
+		light.TrustOptions{
+			Period: relayTrustPeriod,
+			Height: commit.Height,
+			Hash:   commit.Hash(),
+		},
+		primary,
+		witnesses,
+		lightdb.New(dbm.NewMemDB(), testnet.Name),
+		light.Logger(logger.With("module", "light", "chain", testnet.Name)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create light client for %q: %w", testnet.Name, err)
+	}
+	return &relayEnd{testnet: testnet, client: client, light: lc}, nil
+}
+
+// commit submits tx and waits for it to be committed. It returns the block it
+// was committed in, once verified by the light client.
+func (e *relayEnd) commit(ctx context.Context, tx types.Tx) (*types.LightBlock, error) {
+	ctx, cancel := context.WithTimeout(ctx, relayTimeout)
+	defer cancel()
+
+	res, err := e.client.BroadcastTxSync(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, fmt.Errorf("tx %X rejected by %q with code %v: %v", tx.Hash(), e.testnet.Name, res.Code, res.Log)
+	}
+
+	txRes, err := e.search(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	lb, err := e.light.VerifyLightBlockAtHeight(ctx, txRes.Height, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify block %v of %q: %w", txRes.Height, e.testnet.Name, err)
+	}
+	block, err := e.client.Block(ctx, &txRes.Height)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(block.Block.Hash(), lb.Hash()) {
+		return nil, fmt.Errorf("block %v of %q has hash %X, but the light client verified %X",
+			txRes.Height, e.testnet.Name, block.Block.Hash(), lb.Hash())
+	}
+	if block.Block.Txs.Index(tx) == -1 {
+		return nil, fmt.Errorf("tx %X not in block %v of %q, where it was indexed", tx.Hash(), txRes.Height, e.testnet.Name)
+	}
+	return lb, nil
+}
+
+// search waits for tx to be indexed.
+func (e *relayEnd) search(ctx context.Context, tx types.Tx) (*rpctypes.ResultTx, error) {
+	query := fmt.Sprintf("tx.hash='%X'", tx.Hash())
+	for {
+		res, err := e.client.TxSearch(ctx, query, false, nil, nil, "asc")
+		switch {
+		case err != nil && ctx.Err() == nil:
+			logger.Debug("relay", "msg", log.NewLazySprintf("Failed to search %q: %v", e.testnet.Name, err))
+		case err != nil:
+			return nil, err
+		case len(res.Txs) > 1:
+			return nil, fmt.Errorf("tx %X indexed %v times by %q", tx.Hash(), len(res.Txs), e.testnet.Name)
+		case len(res.Txs) == 1:
+			return res.Txs[0], nil
+		}
+
+		select {
+		case <-time.After(500 * time.Millisecond):
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("tx %X was not indexed by %q in time", tx.Hash(), e.testnet.Name)
+			}
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func rpcURL(node *e2e.Node) string {
+	return fmt.Sprintf("http://%s:%v", node.ExternalIP, node.ProxyPort)
+}
@@ -180,6 +180,10 @@ func MakeConfig(node *e2e.Node) (*config.Config, error) {
 	cfg.BlockSync.Version = node.BlockSyncVersion
 	cfg.Mempool.ExperimentalMaxGossipConnectionsToNonPersistentPeers = int(node.Testnet.ExperimentalMaxGossipConnectionsToNonPersistentPeers)
 	cfg.Mempool.ExperimentalMaxGossipConnectionsToPersistentPeers = int(node.Testnet.ExperimentalMaxGossipConnectionsToPersistentPeers)
+	cfg.Consensus.TestPrevoteDelay = node.PrevoteDelay
+	cfg.Consensus.TestPrecommitDelay = node.PrecommitDelay
+	cfg.Consensus.TestVoteJitter = node.VoteJitter
+	cfg.Consensus.TestVoteJitterSeed = node.VoteJitterSeed
 
 	cfg.Instrumentation.TraceType = "celestia"
 	cfg.Instrumentation.TracePushConfig = node.TracePushConfig
@@ -301,6 +305,10 @@ func MakeAppConfig(node *e2e.Node) ([]byte, error) {
 		"check_tx_delay":                node.Testnet.CheckTxDelay,
 		"vote_extension_delay":          node.Testnet.VoteExtensionDelay,
 		"finalize_block_delay":          node.Testnet.FinalizeBlockDelay,
+		"state_size":                    node.Testnet.StateSize,
+		"state_value_size":              node.Testnet.StateValueSize,
+		"snapshot_chunk_size":           node.Testnet.SnapshotChunkSize,
+		"snapshot_chunk_apply_delay":    node.Testnet.SnapshotChunkApplyDelay,
 		"vote_extensions_enable_height": node.Testnet.VoteExtensionsEnableHeight,
 		"vote_extensions_update_height": node.Testnet.VoteExtensionsUpdateHeight,
 	}
@@ -29,3 +29,16 @@ func Test(testnet *e2e.Testnet, ifd *e2e.InfrastructureData) error {
 
 	return exec.CommandVerbose(context.Background(), "go", "test", "-count", "1", "./tests/...")
 }
+
+// TestCounterparty runs test cases under tests/ against the counterparty of a
+// testnet.
+func TestCounterparty(testnet *e2e.Testnet) error {
+	logger.Info("Running tests against the counterparty...")
+
+	if err := os.Setenv("E2E_COUNTERPARTY", "1"); err != nil {
+		return err
+	}
+	defer os.Unsetenv("E2E_COUNTERPARTY") //nolint:errcheck
+
+	return Test(testnet, &e2e.InfrastructureData{Provider: "docker"})
+}
@@ -62,7 +62,8 @@ func testNode(t *testing.T, testFunc func(*testing.T, e2e.Node)) {
 	}
 }
 
-// loadTestnet loads the testnet based on the E2E_MANIFEST envvar.
+// loadTestnet loads the testnet based on the E2E_MANIFEST envvar. If
+// E2E_COUNTERPARTY is set, the counterparty of the testnet is loaded instead.
 func loadTestnet(t *testing.T) e2e.Testnet {
 	t.Helper()
 
@@ -100,6 +101,10 @@ func loadTestnet(t *testing.T) e2e.Testnet {
 
 	testnet, err := e2e.LoadTestnet(manifestFile, ifd)
 	require.NoError(t, err)
+	if os.Getenv("E2E_COUNTERPARTY") != "" {
+		testnet, _, err = e2e.LoadCounterpartyTestnet(testnet)
+		require.NoError(t, err)
+	}
 	testnetCache[manifestFile] = *testnet
 	return *testnet
 }
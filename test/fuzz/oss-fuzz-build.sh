@@ -18,6 +18,8 @@ go get github.com/prometheus/common/expfmt@v0.32.1
 
 build_go_fuzzer FuzzP2PSecretConnection fuzz_p2p_secretconnection
 
+build_go_fuzzer FuzzP2PDecode fuzz_p2p_decode
+
 build_go_fuzzer FuzzMempool fuzz_mempool
 
 build_go_fuzzer FuzzRPCJSONRPCServer fuzz_rpc_jsonrpc_server
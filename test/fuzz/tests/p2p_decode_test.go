@@ -0,0 +1,71 @@
+//go:build gofuzz || go1.21
+
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cometbft/cometbft/libs/protoio"
+	bcproto "github.com/cometbft/cometbft/proto/tendermint/blocksync"
+	tmcons "github.com/cometbft/cometbft/proto/tendermint/consensus"
+	memproto "github.com/cometbft/cometbft/proto/tendermint/mempool"
+	tmp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
+	ssproto "github.com/cometbft/cometbft/proto/tendermint/statesync"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+// p2pMessageTypes are the message types of the channels of the reactors,
+// selected by the first byte of the fuzzed input.
+var p2pMessageTypes = []proto.Message{
+	&tmcons.Message{},
+	&bcproto.Message{},
+	&memproto.Message{},
+	&tmp2p.Message{},
+	&ssproto.Message{},
+	&cmtproto.EvidenceList{},
+}
+
+func FuzzP2PDecode(f *testing.F) {
+	for i, msg := range []proto.Message{
+		&tmcons.Message{Sum: &tmcons.Message_Vote{Vote: &tmcons.Vote{Vote: &cmtproto.Vote{Height: 1}}}},
+		&bcproto.Message{Sum: &bcproto.Message_StatusResponse{StatusResponse: &bcproto.StatusResponse{Height: 1}}},
+		&memproto.Message{Sum: &memproto.Message_Txs{Txs: &memproto.Txs{Txs: [][]byte{{1}, {2}}}}},
+		&tmp2p.Message{Sum: &tmp2p.Message_PexAddrs{PexAddrs: &tmp2p.PexAddrs{Addrs: make([]tmp2p.NetAddress, 2)}}},
+	} {
+		bz, err := proto.Marshal(msg)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(append([]byte{byte(i)}, bz...))
+	}
+
+	limits := protoio.DecodeLimits{MaxDepth: 8, MaxRepeated: 16}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) == 0 {
+			return
+		}
+		mt := p2pMessageTypes[int(data[0])%len(p2pMessageTypes)]
+		bz := data[1:]
+
+		// arbitrary input must not make the checks panic
+		_ = protoio.UnmarshalLimited(bz, proto.Clone(mt), limits)
+
+		// the checks are stricter than the decoder on malformed input, but
+		// must accept the canonical encoding of any message within the limits
+		msg := proto.Clone(mt)
+		if err := proto.Unmarshal(bz, msg); err != nil {
+			return
+		}
+		canonical, err := proto.Marshal(msg)
+		if err != nil {
+			return
+		}
+		err = protoio.UnmarshalLimited(canonical, proto.Clone(mt), limits)
+		if err != nil && !errors.Is(err, protoio.ErrDecodeLimit) {
+			t.Fatalf("valid %T rejected: %v", mt, err)
+		}
+	})
+}
@@ -73,3 +73,11 @@ test_deadlock:
 	@echo "--> Running go test --deadlock"
 	@go test -p 1 -v  $(PACKAGES) -tags deadlock 
 .PHONY: test_race
+
+# run the model-based consensus tests at length, from a random seed, which is
+# printed along with the steps of a failing run
+MODEL_RUNS ?= 500
+test-model:
+	@echo "--> Running model-based consensus tests"
+	@go test ./consensus -run TestModel -count=1 -args -model.runs=$(MODEL_RUNS) -model.seed=$$(date +%s)
+.PHONY: test-model
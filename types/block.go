@@ -4,11 +4,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
-	"github.com/cosmos/gogoproto/proto"
 	gogotypes "github.com/cosmos/gogoproto/types"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/merkle"
@@ -154,11 +155,89 @@ func (b *Block) MakePartSet(partSize uint32) (*PartSet, error) {
 	if err != nil {
 		return nil, err
 	}
-	bz, err := proto.Marshal(pbb)
-	if err != nil {
+	// the parts are hashed while the block is serialized
+	w := NewPartSetWriter(partSize)
+	if err := writeBlockProto(w, pbb); err != nil {
 		return nil, err
 	}
-	return NewPartSetFromData(bz, partSize), nil
+	return w.PartSet(), nil
+}
+
+// Field numbers of the protobuf encodings of Block and Data.
+const (
+	blockHeaderField     = 1
+	blockDataField       = 2
+	blockEvidenceField   = 3
+	blockLastCommitField = 4
+
+	dataTxsField        = 1
+	dataSquareSizeField = 5
+	dataHashField       = 6
+)
+
+// writeBlockProto writes the protobuf encoding of pbb to w, as proto.Marshal
+// encodes it, but streaming the transactions of the block rather than first
+// encoding the whole block into a buffer.
+func writeBlockProto(w io.Writer, pbb *cmtproto.Block) error {
+	var scratch []byte
+	writeTag := func(num protowire.Number, typ protowire.Type, v uint64) error {
+		scratch = protowire.AppendTag(scratch[:0], num, typ)
+		scratch = protowire.AppendVarint(scratch, v)
+		_, err := w.Write(scratch)
+		return err
+	}
+	writeBytes := func(num protowire.Number, bz []byte) error {
+		if err := writeTag(num, protowire.BytesType, uint64(len(bz))); err != nil {
+			return err
+		}
+		_, err := w.Write(bz)
+		return err
+	}
+
+	header, err := pbb.Header.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := writeBytes(blockHeaderField, header); err != nil {
+		return err
+	}
+
+	if err := writeTag(blockDataField, protowire.BytesType, uint64(pbb.Data.Size())); err != nil {
+		return err
+	}
+	for _, tx := range pbb.Data.Txs {
+		if err := writeBytes(dataTxsField, tx); err != nil {
+			return err
+		}
+	}
+	if pbb.Data.SquareSize != 0 {
+		if err := writeTag(dataSquareSizeField, protowire.VarintType, pbb.Data.SquareSize); err != nil {
+			return err
+		}
+	}
+	if len(pbb.Data.Hash) > 0 {
+		if err := writeBytes(dataHashField, pbb.Data.Hash); err != nil {
+			return err
+		}
+	}
+
+	evidence, err := pbb.Evidence.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := writeBytes(blockEvidenceField, evidence); err != nil {
+		return err
+	}
+	if pbb.LastCommit != nil {
+		lastCommit, err := pbb.LastCommit.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := writeBytes(blockLastCommitField, lastCommit); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // HashesTo is a convenience function that checks if a block hashes to the given argument.
@@ -141,6 +141,41 @@ func TestBlockMakePartSetWithEvidence(t *testing.T) {
 	assert.EqualValues(t, 4, partSet.Total())
 }
 
+func TestBlockMakePartSetEncoding(t *testing.T) {
+	h := int64(3)
+	voteSet, _, vals := randVoteSet(h-1, 1, cmtproto.PrecommitType, 10, 1, false)
+	extCommit, err := MakeExtCommit(makeBlockIDRandom(), h-1, 1, voteSet, vals, time.Now(), false)
+	require.NoError(t, err)
+	ev, err := NewMockDuplicateVoteEvidenceWithValidator(h, time.Now(), vals[0], "block-test-chain")
+	require.NoError(t, err)
+
+	txs := make(Txs, 100)
+	for i := range txs {
+		txs[i] = cmtrand.Bytes(i * 10)
+	}
+	withData := MakeBlock(h, Data{Txs: txs, SquareSize: 8}, extCommit.ToCommit(), []Evidence{ev})
+	withData.DataHash = cmtrand.Bytes(32)
+	withData.Data.hash = withData.DataHash
+
+	for name, block := range map[string]*Block{
+		"empty":     MakeBlock(h, Data{}, nil, nil),
+		"one tx":    MakeBlock(h, Data{Txs: []Tx{Tx("Hello World")}}, extCommit.ToCommit(), nil),
+		"with data": withData,
+	} {
+		// the streamed encoding is the same as the marshaled one
+		pbb, err := block.ToProto()
+		require.NoError(t, err)
+		bz, err := pbb.Marshal()
+		require.NoError(t, err)
+		expected := NewPartSetFromData(bz, 512)
+
+		partSet, err := block.MakePartSet(512)
+		require.NoError(t, err)
+		assert.Equal(t, expected.Header(), partSet.Header(), name)
+		assert.Equal(t, expected.ByteSize(), partSet.ByteSize(), name)
+	}
+}
+
 func TestBlockHashesTo(t *testing.T) {
 	assert.False(t, (*Block)(nil).HashesTo(nil))
 
@@ -1004,3 +1039,29 @@ func TestBlob(t *testing.T) {
 		assert.Equal(t, want, got)
 	})
 }
+
+// BenchmarkBlockMakePartSet compares streaming an 8MB block into its parts
+// with marshaling it before splitting it.
+func BenchmarkBlockMakePartSet(b *testing.B) {
+	txs := make(Txs, 8*1024)
+	for i := range txs {
+		txs[i] = cmtrand.Bytes(1024)
+	}
+	block := MakeBlock(1, Data{Txs: txs}, &Commit{}, nil)
+
+	b.Run("marshal", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pbb, err := block.ToProto()
+			require.NoError(b, err)
+			bz, err := pbb.Marshal()
+			require.NoError(b, err)
+			_ = NewPartSetFromData(bz, BlockPartSizeBytes)
+		}
+	})
+	b.Run("stream", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, err := block.MakePartSet(BlockPartSizeBytes)
+			require.NoError(b, err)
+		}
+	})
+}
@@ -189,6 +189,22 @@ func (b *EventBus) PublishEventTx(data EventDataTx) error {
 	return b.pubsub.PublishWithEvents(ctx, data, events)
 }
 
+func (b *EventBus) PublishEventNewMempoolTx(data EventDataNewMempoolTx) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+
+	events := map[string][]string{
+		EventTypeKey:         {EventNewMempoolTx},
+		MempoolTxHashKey:     {fmt.Sprintf("%X", data.Tx.Hash())},
+		MempoolTxPriorityKey: {fmt.Sprintf("%d", data.Priority)},
+	}
+	if len(data.Sender) > 0 {
+		events[MempoolTxSenderKey] = []string{data.Sender.String()}
+	}
+
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
 func (b *EventBus) PublishEventNewRoundStep(data EventDataRoundState) error {
 	return b.Publish(EventNewRoundStep, data)
 }
@@ -229,6 +245,30 @@ func (b *EventBus) PublishEventValidatorSetUpdates(data EventDataValidatorSetUpd
 	return b.Publish(EventValidatorSetUpdates, data)
 }
 
+func (b *EventBus) PublishEventWALRepaired(data EventDataWALRepaired) error {
+	return b.Publish(EventWALRepaired, data)
+}
+
+func (b *EventBus) PublishEventClockSkew(data EventDataClockSkew) error {
+	return b.Publish(EventClockSkew, data)
+}
+
+func (b *EventBus) PublishEventNodePhase(data EventDataNodePhase) error {
+	return b.Publish(EventNodePhase, data)
+}
+
+func (b *EventBus) PublishEventUpgradeReady(data EventDataUpgradeReady) error {
+	return b.Publish(EventUpgradeReady, data)
+}
+
+func (b *EventBus) PublishEventVoteExtensions(data EventDataVoteExtensions) error {
+	return b.Publish(EventVoteExtensions, data)
+}
+
+func (b *EventBus) PublishEventResourceUsage(data EventDataResourceUsage) error {
+	return b.Publish(EventResourceUsage, data)
+}
+
 // -----------------------------------------------------------------------------
 type NopEventBus struct{}
 
@@ -277,6 +317,10 @@ func (NopEventBus) PublishEventTx(EventDataTx) error {
 	return nil
 }
 
+func (NopEventBus) PublishEventNewMempoolTx(EventDataNewMempoolTx) error {
+	return nil
+}
+
 func (NopEventBus) PublishEventNewRoundStep(EventDataRoundState) error {
 	return nil
 }
@@ -316,3 +360,27 @@ func (NopEventBus) PublishEventLock(EventDataRoundState) error {
 func (NopEventBus) PublishEventValidatorSetUpdates(EventDataValidatorSetUpdates) error {
 	return nil
 }
+
+func (NopEventBus) PublishEventWALRepaired(EventDataWALRepaired) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventClockSkew(EventDataClockSkew) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventNodePhase(EventDataNodePhase) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventUpgradeReady(EventDataUpgradeReady) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventVoteExtensions(EventDataVoteExtensions) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventResourceUsage(EventDataResourceUsage) error {
+	return nil
+}
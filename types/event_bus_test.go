@@ -64,6 +64,49 @@ func TestEventBusPublishEventTx(t *testing.T) {
 	}
 }
 
+func TestEventBusPublishEventNewMempoolTx(t *testing.T) {
+	eventBus := NewEventBus()
+	err := eventBus.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	sender := []byte{0xab, 0xcd}
+	query := fmt.Sprintf("tm.event='NewMempoolTx' AND mempool_tx.sender='%X' AND mempool_tx.priority >= 10", sender)
+	txsSub, err := eventBus.Subscribe(context.Background(), "test", cmtquery.MustCompile(query))
+	require.NoError(t, err)
+
+	// only the transaction from the sender with a high enough priority matches
+	for _, res := range []*abci.ResponseCheckTx{
+		{Address: sender, Priority: 5},
+		{Address: []byte{0x01}, Priority: 20},
+		{Address: sender, Priority: 10, GasWanted: 3},
+	} {
+		err = eventBus.PublishEventNewMempoolTx(NewEventDataNewMempoolTx(Tx("foo"), res))
+		require.NoError(t, err)
+	}
+
+	select {
+	case msg := <-txsSub.Out():
+		edt := msg.Data().(EventDataNewMempoolTx)
+		assert.EqualValues(t, "foo", edt.Tx)
+		assert.EqualValues(t, sender, edt.Sender)
+		assert.EqualValues(t, 10, edt.Priority)
+		assert.EqualValues(t, 3, edt.GasWanted)
+		assert.Equal(t, []string{fmt.Sprintf("%X", Tx("foo").Hash())}, msg.Events()[MempoolTxHashKey])
+	case <-time.After(1 * time.Second):
+		t.Fatal("did not receive a transaction after 1 sec.")
+	}
+	select {
+	case <-txsSub.Out():
+		t.Fatal("received a transaction not matching the query")
+	default:
+	}
+}
+
 func TestEventBusPublishEventNewBlock(t *testing.T) {
 	eventBus := NewEventBus()
 	err := eventBus.Start()
@@ -2,8 +2,12 @@ package types
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
@@ -24,6 +28,30 @@ const (
 	EventTx                  = "Tx"
 	EventValidatorSetUpdates = "ValidatorSetUpdates"
 
+	// Fired when a block is committed with vote extensions, carrying the
+	// extensions of the precommits for it.
+	EventVoteExtensions = "VoteExtensions"
+
+	// Fired after each block is executed, summarizing the resources used by
+	// the node for its height.
+	EventResourceUsage = "ResourceUsage"
+
+	// Mempool events, triggered when a transaction is admitted to the
+	// mempool after passing CheckTx.
+	EventNewMempoolTx = "NewMempoolTx"
+
+	// P2P events, triggered when the local clock is found to be skewed from
+	// the clocks of peers, and when it is back in sync.
+	EventClockSkew = "ClockSkew"
+
+	// Node events, triggered when the node moves to another phase of its
+	// lifecycle.
+	EventNodePhase = "NodePhase"
+
+	// Upgrade events, triggered when the nodes halted after committing the
+	// block set by the upgrade consensus parameters.
+	EventUpgradeReady = "UpgradeReady"
+
 	// Internal consensus events.
 	// These are used for testing the consensus state machine.
 	// They can also be used to build real-time consensus visualizers.
@@ -38,6 +66,7 @@ const (
 	EventUnlock           = "Unlock"
 	EventValidBlock       = "ValidBlock"
 	EventVote             = "Vote"
+	EventWALRepaired      = "WALRepaired"
 )
 
 // ENCODING / DECODING
@@ -54,11 +83,18 @@ func init() {
 	cmtjson.RegisterType(EventDataNewBlockEvents{}, "tendermint/event/NewBlockEvents")
 	cmtjson.RegisterType(EventDataNewEvidence{}, "tendermint/event/NewEvidence")
 	cmtjson.RegisterType(EventDataTx{}, "tendermint/event/Tx")
+	cmtjson.RegisterType(EventDataNewMempoolTx{}, "tendermint/event/NewMempoolTx")
 	cmtjson.RegisterType(EventDataRoundState{}, "tendermint/event/RoundState")
 	cmtjson.RegisterType(EventDataNewRound{}, "tendermint/event/NewRound")
 	cmtjson.RegisterType(EventDataCompleteProposal{}, "tendermint/event/CompleteProposal")
 	cmtjson.RegisterType(EventDataVote{}, "tendermint/event/Vote")
 	cmtjson.RegisterType(EventDataValidatorSetUpdates{}, "tendermint/event/ValidatorSetUpdates")
+	cmtjson.RegisterType(EventDataWALRepaired{}, "tendermint/event/WALRepaired")
+	cmtjson.RegisterType(EventDataClockSkew{}, "tendermint/event/ClockSkew")
+	cmtjson.RegisterType(EventDataNodePhase{}, "tendermint/event/NodePhase")
+	cmtjson.RegisterType(EventDataUpgradeReady{}, "tendermint/event/UpgradeReady")
+	cmtjson.RegisterType(EventDataVoteExtensions{}, "tendermint/event/VoteExtensions")
+	cmtjson.RegisterType(EventDataResourceUsage{}, "tendermint/event/ResourceUsage")
 	cmtjson.RegisterType(EventDataString(""), "tendermint/event/ProposalString")
 }
 
@@ -100,6 +136,133 @@ type EventDataTx struct {
 	abci.TxResult
 }
 
+// EventDataNewMempoolTx is fired for every transaction admitted to the
+// mempool, with the sender and priority the application assigned to it in
+// CheckTx.
+type EventDataNewMempoolTx struct {
+	Tx        Tx                `json:"tx"`
+	Sender    cmtbytes.HexBytes `json:"sender"`
+	Priority  int64             `json:"priority"`
+	GasWanted int64             `json:"gas_wanted"`
+}
+
+// NewEventDataNewMempoolTx builds the event data for tx given its CheckTx
+// response.
+func NewEventDataNewMempoolTx(tx Tx, res *abci.ResponseCheckTx) EventDataNewMempoolTx {
+	return EventDataNewMempoolTx{
+		Tx:        tx,
+		Sender:    res.Address,
+		Priority:  res.Priority,
+		GasWanted: res.GasWanted,
+	}
+}
+
+// EventDataWALRepaired is fired when the consensus WAL, or its replica, was
+// found corrupted on start and its tail was dropped, keeping the records
+// before the corruption.
+type EventDataWALRepaired struct {
+	Height       int64  `json:"height"`
+	WALFile      string `json:"wal_file"`
+	BackupFile   string `json:"backup_file"`
+	RecordsKept  int    `json:"records_kept"`
+	BytesDropped int64  `json:"bytes_dropped"`
+}
+
+// EventDataClockSkew is fired when the median clock offset of peers crosses
+// the configured threshold. Offset is how far ahead of the local clock the
+// clocks of peers are.
+type EventDataClockSkew struct {
+	Offset time.Duration `json:"offset"`
+	Skewed bool          `json:"skewed"`
+}
+
+// NodePhase is a phase of the lifecycle of a node.
+type NodePhase string
+
+const (
+	NodePhaseStarting  NodePhase = "starting"  // services are being started
+	NodePhaseSyncing   NodePhase = "syncing"   // state or block syncing
+	NodePhaseConsensus NodePhase = "consensus" // participating in consensus
+	NodePhaseDraining  NodePhase = "draining"  // services are being stopped
+	NodePhaseStopped   NodePhase = "stopped"
+)
+
+// EventDataNodePhase is fired when the node moves from one phase of its
+// lifecycle to another. The move to NodePhaseStopped is not fired, as the
+// event bus is stopped while draining.
+type EventDataNodePhase struct {
+	From NodePhase `json:"from"`
+	To   NodePhase `json:"to"`
+	Time time.Time `json:"time"`
+}
+
+// EventDataUpgradeReady is fired when consensus halted after committing the
+// block at Height, as set by the upgrade consensus parameters. The node can
+// then be stopped and upgraded.
+type EventDataUpgradeReady struct {
+	Height int64     `json:"height"`
+	Time   time.Time `json:"time"`
+}
+
+// EventDataVoteExtensions carries the vote extensions and their signatures
+// from the precommits this node saw for the block committed at Height. They
+// are handed to the proposer of the next block in PrepareProposal.
+type EventDataVoteExtensions struct {
+	Height             int64                   `json:"height"`
+	ExtendedCommitInfo abci.ExtendedCommitInfo `json:"extended_commit_info"`
+}
+
+// EventDataResourceUsage summarizes the resources used by the node between the
+// execution of the previous block and the execution of the block at Height.
+type EventDataResourceUsage struct {
+	Height int64 `json:"height"`
+	// Bytes of the messages sent and received over each p2p channel used.
+	Channels []ChannelUsage `json:"channels"`
+	// Time spent by the application in FinalizeBlock and Commit.
+	ABCITime time.Duration `json:"abci_time"`
+	// Bytes written to the databases of the node.
+	DBWriteBytes int64 `json:"db_write_bytes"`
+	// Transactions admitted to and removed from the mempool.
+	MempoolTxsAdded   int64 `json:"mempool_txs_added"`
+	MempoolTxsRemoved int64 `json:"mempool_txs_removed"`
+}
+
+// ChannelUsage is the p2p traffic of a channel, across all peers.
+type ChannelUsage struct {
+	ID            byte  `json:"id"`
+	SentBytes     int64 `json:"sent_bytes"`
+	ReceivedBytes int64 `json:"received_bytes"`
+}
+
+// ABCIEvent returns the report as an event of type EventTypeResourceUsage,
+// so that it can be indexed with the block events of its height. Per channel
+// traffic is reported under the attributes "<channel ID>_sent_bytes" and
+// "<channel ID>_received_bytes", e.g. "0x30_sent_bytes".
+func (u EventDataResourceUsage) ABCIEvent() abci.Event {
+	attr := func(key string, value int64) abci.EventAttribute {
+		return abci.EventAttribute{Key: key, Value: strconv.FormatInt(value, 10), Index: true}
+	}
+	var sent, received int64
+	attrs := make([]abci.EventAttribute, 0, 6+2*len(u.Channels))
+	for _, ch := range u.Channels {
+		attrs = append(attrs,
+			attr(fmt.Sprintf("%#x_sent_bytes", ch.ID), ch.SentBytes),
+			attr(fmt.Sprintf("%#x_received_bytes", ch.ID), ch.ReceivedBytes),
+		)
+		sent += ch.SentBytes
+		received += ch.ReceivedBytes
+	}
+	attrs = append(attrs,
+		attr("sent_bytes", sent),
+		attr("received_bytes", received),
+		attr("abci_time_ms", u.ABCITime.Milliseconds()),
+		attr("db_write_bytes", u.DBWriteBytes),
+		attr("mempool_txs_added", u.MempoolTxsAdded),
+		attr("mempool_txs_removed", u.MempoolTxsRemoved),
+	)
+	return abci.Event{Type: EventTypeResourceUsage, Attributes: attrs}
+}
+
 // NOTE: This goes into the replay WAL
 type EventDataRoundState struct {
 	Height int64  `json:"height"`
@@ -134,8 +297,68 @@ type EventDataVote struct {
 
 type EventDataString string
 
+// EventDataValidatorSetUpdates is published when the application returns
+// validator updates for the block at Height. The updates take effect at
+// Height+2.
 type EventDataValidatorSetUpdates struct {
 	ValidatorUpdates []*Validator `json:"validator_updates"`
+
+	Height int64 `json:"height"`
+	// NextValidatorsHash is the hash of the validator set with the updates
+	// applied. It matches the NextValidatorsHash of the header at Height+1.
+	NextValidatorsHash cmtbytes.HexBytes `json:"next_validators_hash"`
+	// Proofs are aligned with ValidatorUpdates and prove the inclusion of each
+	// updated validator in NextValidatorsHash. Removed validators (zero voting
+	// power) have a nil proof.
+	Proofs []*merkle.Proof `json:"proofs"`
+}
+
+// NewEventDataValidatorSetUpdates builds the event data for the updates
+// returned at height, given the resulting validator set.
+func NewEventDataValidatorSetUpdates(
+	height int64,
+	updates []*Validator,
+	nextVals *ValidatorSet,
+) EventDataValidatorSetUpdates {
+	hash, proofs := nextVals.HashWithProofs()
+	updateProofs := make([]*merkle.Proof, len(updates))
+	for i, val := range updates {
+		if val.VotingPower == 0 {
+			continue
+		}
+		if idx, _ := nextVals.GetByAddress(val.Address); idx >= 0 {
+			updateProofs[i] = proofs[idx]
+		}
+	}
+	return EventDataValidatorSetUpdates{
+		ValidatorUpdates:   updates,
+		Height:             height,
+		NextValidatorsHash: hash,
+		Proofs:             updateProofs,
+	}
+}
+
+// VerifyProofs checks that every non-removed validator update is included in
+// NextValidatorsHash.
+func (data EventDataValidatorSetUpdates) VerifyProofs() error {
+	if len(data.Proofs) != len(data.ValidatorUpdates) {
+		return fmt.Errorf("expected %d proofs, got %d", len(data.ValidatorUpdates), len(data.Proofs))
+	}
+	for i, val := range data.ValidatorUpdates {
+		if val.VotingPower == 0 {
+			if data.Proofs[i] != nil {
+				return fmt.Errorf("unexpected proof for removed validator %v", val.Address)
+			}
+			continue
+		}
+		if data.Proofs[i] == nil {
+			return fmt.Errorf("missing proof for validator %v", val.Address)
+		}
+		if err := data.Proofs[i].Verify(data.NextValidatorsHash, val.Bytes()); err != nil {
+			return fmt.Errorf("invalid proof for validator %v: %w", val.Address, err)
+		}
+	}
+	return nil
 }
 
 // PUBSUB
@@ -154,9 +377,23 @@ const (
 
 	// BlockHeightKey is a reserved key used for indexing FinalizeBlock events.
 	BlockHeightKey = "block.height"
+
+	// EventTypeResourceUsage is the type of the block event indexing the
+	// resource usage of a height, e.g. "resource_usage.abci_time_ms > 500".
+	// see EventDataResourceUsage#ABCIEvent
+	EventTypeResourceUsage = "resource_usage"
+
+	// MempoolTxHashKey, MempoolTxSenderKey and MempoolTxPriorityKey are
+	// reserved keys used to filter mempool transactions, e.g. with
+	// "tm.event='NewMempoolTx' AND mempool_tx.priority >= 10".
+	// see EventBus#PublishEventNewMempoolTx
+	MempoolTxHashKey     = "mempool_tx.hash"
+	MempoolTxSenderKey   = "mempool_tx.sender"
+	MempoolTxPriorityKey = "mempool_tx.priority"
 )
 
 var (
+	EventQueryClockSkew           = QueryForEvent(EventClockSkew)
 	EventQueryCompleteProposal    = QueryForEvent(EventCompleteProposal)
 	EventQueryLock                = QueryForEvent(EventLock)
 	EventQueryNewBlock            = QueryForEvent(EventNewBlock)
@@ -164,17 +401,23 @@ var (
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeader)
 	EventQueryNewBlockEvents      = QueryForEvent(EventNewBlockEvents)
 	EventQueryNewEvidence         = QueryForEvent(EventNewEvidence)
+	EventQueryNewMempoolTx        = QueryForEvent(EventNewMempoolTx)
 	EventQueryNewRound            = QueryForEvent(EventNewRound)
 	EventQueryNewRoundStep        = QueryForEvent(EventNewRoundStep)
+	EventQueryNodePhase           = QueryForEvent(EventNodePhase)
 	EventQueryPolka               = QueryForEvent(EventPolka)
 	EventQueryRelock              = QueryForEvent(EventRelock)
+	EventQueryResourceUsage       = QueryForEvent(EventResourceUsage)
 	EventQueryTimeoutPropose      = QueryForEvent(EventTimeoutPropose)
 	EventQueryTimeoutWait         = QueryForEvent(EventTimeoutWait)
 	EventQueryTx                  = QueryForEvent(EventTx)
+	EventQueryUpgradeReady        = QueryForEvent(EventUpgradeReady)
 	EventQueryUnlock              = QueryForEvent(EventUnlock)
 	EventQueryValidatorSetUpdates = QueryForEvent(EventValidatorSetUpdates)
 	EventQueryValidBlock          = QueryForEvent(EventValidBlock)
 	EventQueryVote                = QueryForEvent(EventVote)
+	EventQueryVoteExtensions      = QueryForEvent(EventVoteExtensions)
+	EventQueryWALRepaired         = QueryForEvent(EventWALRepaired)
 )
 
 func EventQueryTxFor(tx Tx) cmtpubsub.Query {
@@ -199,3 +442,13 @@ type BlockEventPublisher interface {
 type TxEventPublisher interface {
 	PublishEventTx(EventDataTx) error
 }
+
+// ResourceUsageEventPublisher publishes the resource usage of each height.
+type ResourceUsageEventPublisher interface {
+	PublishEventResourceUsage(EventDataResourceUsage) error
+}
+
+// MempoolEventPublisher publishes the transactions admitted to the mempool.
+type MempoolEventPublisher interface {
+	PublishEventNewMempoolTx(EventDataNewMempoolTx) error
+}
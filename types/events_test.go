@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestQueryTxFor(t *testing.T) {
@@ -25,3 +26,21 @@ func TestQueryForEvent(t *testing.T) {
 		QueryForEvent(EventNewEvidence).String(),
 	)
 }
+
+func TestEventDataValidatorSetUpdatesProofs(t *testing.T) {
+	vals, _ := RandValidatorSet(4, 10)
+	removed := vals.Validators[0].Copy()
+	removed.VotingPower = 0
+	require.NoError(t, vals.UpdateWithChangeSet([]*Validator{removed}))
+
+	added := vals.Validators[0].Copy()
+	updates := []*Validator{removed, added}
+	data := NewEventDataValidatorSetUpdates(5, updates, vals)
+	assert.EqualValues(t, vals.Hash(), data.NextValidatorsHash)
+	assert.Nil(t, data.Proofs[0])
+	assert.NotNil(t, data.Proofs[1])
+	require.NoError(t, data.VerifyProofs())
+
+	data.NextValidatorsHash = make([]byte, len(data.NextValidatorsHash))
+	assert.Error(t, data.VerifyProofs())
+}
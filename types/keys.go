@@ -1,6 +1,14 @@
 package types
 
+import "github.com/cometbft/cometbft/p2p/peerdata"
+
+// PeerState is the consensus state of a peer, as seen by the other reactors.
+type PeerState interface {
+	GetHeight() int64
+}
+
+// PeerStateKey is the key under which the consensus reactor stores the
+// PeerState of every peer in its metadata store.
+//
 // UNSTABLE
-var (
-	PeerStateKey = "ConsensusReactor.peerState"
-)
+var PeerStateKey = peerdata.Register[PeerState]("ConsensusReactor.peerState")
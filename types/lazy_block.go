@@ -0,0 +1,158 @@
+package types
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+// LazyBlock is the protobuf encoding of a block, e.g. as stored in its parts,
+// whose fields are only decoded when accessed. It lets the RPC serve the
+// header or the transactions of a large block without decoding the rest of
+// it, and the transactions it returns alias the encoding instead of being
+// copied, which must therefore not be modified.
+type LazyBlock struct {
+	header     []byte
+	data       []byte
+	evidence   []byte
+	lastCommit []byte
+}
+
+// NewLazyBlock splits the encoding of a block into its fields, without
+// decoding them.
+func NewLazyBlock(bz []byte) (*LazyBlock, error) {
+	lb := new(LazyBlock)
+	err := walkProto(bz, func(num protowire.Number, v []byte, _ uint64) {
+		switch num {
+		case blockHeaderField:
+			lb.header = mergeProto(lb.header, v)
+		case blockDataField:
+			lb.data = mergeProto(lb.data, v)
+		case blockEvidenceField:
+			lb.evidence = mergeProto(lb.evidence, v)
+		case blockLastCommitField:
+			lb.lastCommit = mergeProto(lb.lastCommit, v)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("decoding block: %w", err)
+	}
+	return lb, nil
+}
+
+// Header decodes the header of the block.
+func (lb *LazyBlock) Header() (Header, error) {
+	var ph cmtproto.Header
+	if err := ph.Unmarshal(lb.header); err != nil {
+		return Header{}, fmt.Errorf("decoding header: %w", err)
+	}
+	return HeaderFromProto(&ph)
+}
+
+// NumTxs returns the number of transactions of the block, without decoding
+// them.
+func (lb *LazyBlock) NumTxs() (int, error) {
+	n := 0
+	err := walkProto(lb.data, func(num protowire.Number, _ []byte, _ uint64) {
+		if num == dataTxsField {
+			n++
+		}
+	})
+	if err != nil {
+		return 0, fmt.Errorf("decoding data: %w", err)
+	}
+	return n, nil
+}
+
+// Data decodes the data of the block, like DataFromProto. The transactions
+// alias the encoding of the block.
+func (lb *LazyBlock) Data() (Data, error) {
+	data := Data{Txs: Txs{}}
+	err := walkProto(lb.data, func(num protowire.Number, v []byte, x uint64) {
+		switch num {
+		case dataTxsField:
+			data.Txs = append(data.Txs, Tx(v))
+		case dataSquareSizeField:
+			data.SquareSize = x
+		case dataHashField:
+			data.hash = v
+		}
+	})
+	if err != nil {
+		return Data{}, fmt.Errorf("decoding data: %w", err)
+	}
+	return data, nil
+}
+
+// Block decodes the whole block and validates it, like BlockFromProto. The
+// transactions alias the encoding of the block.
+func (lb *LazyBlock) Block() (*Block, error) {
+	b := new(Block)
+	var err error
+	if b.Header, err = lb.Header(); err != nil {
+		return nil, err
+	}
+	if b.Data, err = lb.Data(); err != nil {
+		return nil, err
+	}
+	var pev cmtproto.EvidenceList
+	if err := pev.Unmarshal(lb.evidence); err != nil {
+		return nil, fmt.Errorf("decoding evidence: %w", err)
+	}
+	if err := b.Evidence.FromProto(&pev); err != nil {
+		return nil, err
+	}
+	if lb.lastCommit != nil {
+		var pc cmtproto.Commit
+		if err := pc.Unmarshal(lb.lastCommit); err != nil {
+			return nil, fmt.Errorf("decoding last commit: %w", err)
+		}
+		if b.LastCommit, err = CommitFromProto(&pc); err != nil {
+			return nil, err
+		}
+	}
+	return b, b.ValidateBasic()
+}
+
+// walkProto calls f with the number and the value of each varint or bytes
+// field of the protobuf encoding b, skipping the fields of other types.
+func walkProto(b []byte, f func(num protowire.Number, v []byte, x uint64)) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		var (
+			v []byte
+			x uint64
+		)
+		switch typ {
+		case protowire.VarintType:
+			x, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			v, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		if typ == protowire.VarintType || typ == protowire.BytesType {
+			f(num, v, x)
+		}
+	}
+	return nil
+}
+
+// mergeProto returns the encoding of a message field given its previous
+// encoding and a repeated occurrence of it, which protobuf merges.
+func mergeProto(prev, v []byte) []byte {
+	if prev == nil {
+		return v
+	}
+	return append(append([]byte{}, prev...), v...)
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+func TestLazyBlock(t *testing.T) {
+	h := cmtrand.Int63()
+	block := MakeBlock(h, Data{Txs: []Tx{Tx("a"), Tx("bc"), Tx{}}, SquareSize: 4}, randCommit(time.Now()), []Evidence{})
+	block.ProposerAddress = cmtrand.Bytes(crypto.AddressSize)
+	evi, err := NewMockDuplicateVoteEvidence(h, time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), "block-test-chain")
+	require.NoError(t, err)
+	block.Evidence = EvidenceData{Evidence: EvidenceList{evi}}
+	block.EvidenceHash = block.Evidence.Hash()
+
+	pb, err := block.ToProto()
+	require.NoError(t, err)
+	bz, err := pb.Marshal()
+	require.NoError(t, err)
+	// compare with the block fully decoded
+	var decodedPb cmtproto.Block
+	require.NoError(t, decodedPb.Unmarshal(bz))
+	expected, err := BlockFromProto(&decodedPb)
+	require.NoError(t, err)
+
+	lb, err := NewLazyBlock(bz)
+	require.NoError(t, err)
+	header, err := lb.Header()
+	require.NoError(t, err)
+	assert.Equal(t, expected.Header, header)
+	numTxs, err := lb.NumTxs()
+	require.NoError(t, err)
+	assert.Equal(t, 3, numTxs)
+	data, err := lb.Data()
+	require.NoError(t, err)
+	assert.Equal(t, expected.Data, data)
+
+	decoded, err := lb.Block()
+	require.NoError(t, err)
+	assert.Equal(t, expected.Header, decoded.Header)
+	assert.Equal(t, expected.Data, decoded.Data)
+	assert.Equal(t, expected.Evidence.Evidence, decoded.Evidence.Evidence)
+	assert.Equal(t, *expected.LastCommit, *decoded.LastCommit)
+
+	// a truncated encoding is rejected
+	_, err = NewLazyBlock(bz[:len(bz)-1])
+	assert.Error(t, err)
+}
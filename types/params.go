@@ -38,6 +38,8 @@ type ConsensusParams struct {
 	Validator ValidatorParams `json:"validator"`
 	Version   VersionParams   `json:"version"`
 	ABCI      ABCIParams      `json:"abci"`
+	Gossip    GossipParams    `json:"gossip"`
+	Upgrade   UpgradeParams   `json:"upgrade"`
 }
 
 // BlockParams define limits on the block size and gas plus minimum time
@@ -82,6 +84,34 @@ func (a ABCIParams) VoteExtensionsEnabled(h int64) bool {
 	return a.VoteExtensionsEnableHeight <= h
 }
 
+// GossipParams configure how the nodes of the network gossip consensus data.
+type GossipParams struct {
+	// LazyEmptyProposals allows proposers to send a compact message instead
+	// of the parts of empty proposal blocks.
+	LazyEmptyProposals bool `json:"lazy_empty_proposals"`
+}
+
+// UpgradeParams coordinate the halt of the network for an upgrade.
+type UpgradeParams struct {
+	// HaltHeight makes the nodes stop after committing the block at this
+	// height. 0 disables it.
+	HaltHeight int64 `json:"halt_height"`
+	// HaltTime makes the nodes stop after committing the first block whose
+	// time is at or after it. The zero time disables it.
+	HaltTime time.Time `json:"halt_time"`
+}
+
+// HaltsAfter returns true if the nodes must stop after committing the block
+// at height h with the given time, the previous block having lastBlockTime.
+// Only the first block reaching HaltTime halts the nodes, so that they can
+// resume once restarted.
+func (u UpgradeParams) HaltsAfter(h int64, lastBlockTime, blockTime time.Time) bool {
+	if u.HaltHeight > 0 && u.HaltHeight == h {
+		return true
+	}
+	return !u.HaltTime.IsZero() && lastBlockTime.Before(u.HaltTime) && !blockTime.Before(u.HaltTime)
+}
+
 // DefaultConsensusParams returns a default ConsensusParams.
 func DefaultConsensusParams() *ConsensusParams {
 	return &ConsensusParams{
@@ -90,6 +120,8 @@ func DefaultConsensusParams() *ConsensusParams {
 		Validator: DefaultValidatorParams(),
 		Version:   DefaultVersionParams(),
 		ABCI:      DefaultABCIParams(),
+		Gossip:    DefaultGossipParams(),
+		Upgrade:   DefaultUpgradeParams(),
 	}
 }
 
@@ -131,6 +163,20 @@ func DefaultABCIParams() ABCIParams {
 	}
 }
 
+func DefaultGossipParams() GossipParams {
+	return GossipParams{
+		LazyEmptyProposals: false,
+	}
+}
+
+func DefaultUpgradeParams() UpgradeParams {
+	return UpgradeParams{
+		// When zero, the nodes never halt.
+		HaltHeight: 0,
+		HaltTime:   time.Time{},
+	}
+}
+
 func IsValidPubkeyType(params ValidatorParams, pubkeyType string) bool {
 	for i := 0; i < len(params.PubKeyTypes); i++ {
 		if params.PubKeyTypes[i] == pubkeyType {
@@ -189,6 +235,10 @@ func (params ConsensusParams) ValidateBasic() error {
 		return fmt.Errorf("ABCI.VoteExtensionsEnableHeight cannot be negative. Got: %d", params.ABCI.VoteExtensionsEnableHeight)
 	}
 
+	if params.Upgrade.HaltHeight < 0 {
+		return fmt.Errorf("upgrade.HaltHeight cannot be negative. Got: %d", params.Upgrade.HaltHeight)
+	}
+
 	if len(params.Validator.PubKeyTypes) == 0 {
 		return errors.New("len(Validator.PubKeyTypes) must be greater than 0")
 	}
@@ -205,7 +255,8 @@ func (params ConsensusParams) ValidateBasic() error {
 	return nil
 }
 
-// ValidateUpdate validates the updated VoteExtensionsEnableHeight.
+// ValidateUpdate validates the updated HaltHeight, which cannot be a past
+// height, and the updated VoteExtensionsEnableHeight.
 // | r | params...EnableHeight | updated...EnableHeight | result (nil == pass)
 // |  1 | *                    | (nil)                  | nil
 // |  2 | *                    | < 0                    | VoteExtensionsEnableHeight must be positive
@@ -218,6 +269,12 @@ func (params ConsensusParams) ValidateBasic() error {
 // |  9 | (> 0) <=height       | > height (*)           | vote extensions cannot be modified once enabled
 // | 10 | (> 0) > height       | > height (*)           | nil
 func (params ConsensusParams) ValidateUpdate(updated *cmtproto.ConsensusParams, h int64) error {
+	if updated != nil && updated.Upgrade != nil &&
+		updated.Upgrade.HaltHeight != 0 && updated.Upgrade.HaltHeight < h {
+		return fmt.Errorf("halt height cannot be updated to a past height, "+
+			"halt height: %d, current height %d",
+			updated.Upgrade.HaltHeight, h)
+	}
 	// 1
 	if updated == nil || updated.Abci == nil {
 		return nil
@@ -319,6 +376,13 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 	if params2.Abci != nil {
 		res.ABCI.VoteExtensionsEnableHeight = params2.Abci.GetVoteExtensionsEnableHeight()
 	}
+	if params2.Gossip != nil {
+		res.Gossip.LazyEmptyProposals = params2.Gossip.GetLazyEmptyProposals()
+	}
+	if params2.Upgrade != nil {
+		res.Upgrade.HaltHeight = params2.Upgrade.GetHaltHeight()
+		res.Upgrade.HaltTime = params2.Upgrade.GetHaltTime()
+	}
 	return res
 }
 
@@ -342,6 +406,13 @@ func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
 		Abci: &cmtproto.ABCIParams{
 			VoteExtensionsEnableHeight: params.ABCI.VoteExtensionsEnableHeight,
 		},
+		Gossip: &cmtproto.GossipParams{
+			LazyEmptyProposals: params.Gossip.LazyEmptyProposals,
+		},
+		Upgrade: &cmtproto.UpgradeParams{
+			HaltHeight: params.Upgrade.HaltHeight,
+			HaltTime:   params.Upgrade.HaltTime,
+		},
 	}
 }
 
@@ -366,5 +437,12 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 	if pbParams.Abci != nil {
 		c.ABCI.VoteExtensionsEnableHeight = pbParams.Abci.GetVoteExtensionsEnableHeight()
 	}
+	if pbParams.Gossip != nil {
+		c.Gossip.LazyEmptyProposals = pbParams.Gossip.GetLazyEmptyProposals()
+	}
+	if pbParams.Upgrade != nil {
+		c.Upgrade.HaltHeight = pbParams.Upgrade.GetHaltHeight()
+		c.Upgrade.HaltTime = pbParams.Upgrade.GetHaltTime()
+	}
 	return c
 }
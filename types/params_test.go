@@ -153,6 +153,71 @@ func TestConsensusParamsUpdate_AppVersion(t *testing.T) {
 	assert.EqualValues(t, 1, updated.Version.App)
 }
 
+func TestConsensusParamsUpdate_LazyEmptyProposals(t *testing.T) {
+	params := makeParams(1, 2, 3, 0, valEd25519, 0)
+
+	assert.False(t, params.Gossip.LazyEmptyProposals)
+
+	updated := params.Update(
+		&cmtproto.ConsensusParams{Gossip: &cmtproto.GossipParams{LazyEmptyProposals: true}})
+	assert.True(t, updated.Gossip.LazyEmptyProposals)
+	assert.True(t, ConsensusParamsFromProto(updated.ToProto()).Gossip.LazyEmptyProposals)
+
+	// updates without gossip params keep the current ones
+	assert.True(t, updated.Update(&cmtproto.ConsensusParams{}).Gossip.LazyEmptyProposals)
+}
+
+func TestConsensusParamsUpdate_Upgrade(t *testing.T) {
+	params := makeParams(1, 2, 3, 0, valEd25519, 0)
+	haltTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, DefaultUpgradeParams(), params.Upgrade)
+
+	updated := params.Update(&cmtproto.ConsensusParams{
+		Upgrade: &cmtproto.UpgradeParams{HaltHeight: 10, HaltTime: haltTime},
+	})
+	assert.EqualValues(t, 10, updated.Upgrade.HaltHeight)
+	assert.Equal(t, haltTime, updated.Upgrade.HaltTime)
+	assert.Equal(t, updated.Upgrade, ConsensusParamsFromProto(updated.ToProto()).Upgrade)
+
+	// updates without upgrade params keep the current ones
+	assert.Equal(t, updated.Upgrade, updated.Update(&cmtproto.ConsensusParams{}).Upgrade)
+
+	assert.Error(t, params.ValidateUpdate(
+		&cmtproto.ConsensusParams{Upgrade: &cmtproto.UpgradeParams{HaltHeight: 9}}, 10))
+	assert.NoError(t, params.ValidateUpdate(
+		&cmtproto.ConsensusParams{Upgrade: &cmtproto.UpgradeParams{HaltHeight: 10}}, 10))
+	assert.NoError(t, params.ValidateUpdate(
+		&cmtproto.ConsensusParams{Upgrade: &cmtproto.UpgradeParams{HaltHeight: 0}}, 10))
+
+	updated.Upgrade.HaltHeight = -1
+	assert.Error(t, updated.ValidateBasic())
+}
+
+func TestUpgradeParamsHaltsAfter(t *testing.T) {
+	haltTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		params        UpgradeParams
+		height        int64
+		lastBlockTime time.Time
+		blockTime     time.Time
+		halts         bool
+	}{
+		{UpgradeParams{}, 10, haltTime.Add(-time.Second), haltTime, false},
+		{UpgradeParams{HaltHeight: 10}, 9, haltTime, haltTime, false},
+		{UpgradeParams{HaltHeight: 10}, 10, haltTime, haltTime, true},
+		{UpgradeParams{HaltHeight: 10}, 11, haltTime, haltTime, false},
+		{UpgradeParams{HaltTime: haltTime}, 10, haltTime.Add(-2 * time.Second), haltTime.Add(-time.Second), false},
+		{UpgradeParams{HaltTime: haltTime}, 10, haltTime.Add(-time.Second), haltTime, true},
+		{UpgradeParams{HaltTime: haltTime}, 10, haltTime.Add(-time.Second), haltTime.Add(time.Second), true},
+		// only the first block reaching the halt time halts
+		{UpgradeParams{HaltTime: haltTime}, 10, haltTime, haltTime.Add(time.Second), false},
+	}
+	for i, tc := range testCases {
+		assert.Equal(t, tc.halts, tc.params.HaltsAfter(tc.height, tc.lastBlockTime, tc.blockTime), "case %d", i)
+	}
+}
+
 func TestConsensusParamsUpdate_VoteExtensionsEnableHeight(t *testing.T) {
 	const nilTest = -10000000
 	testCases := []struct {
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"runtime"
+	"sync"
 
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/libs/bits"
@@ -207,7 +209,7 @@ func NewPartSetFromData(data []byte, partSize uint32) *PartSet {
 		partsBitArray.SetIndex(int(i), true)
 	}
 	// Compute merkle proofs
-	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
+	root, proofs := merkle.ProofsFromLeafHashes(partsLeafHashes(partsBytes))
 	for i := uint32(0); i < total; i++ {
 		parts[i].Proof = *proofs[i]
 	}
@@ -221,6 +223,130 @@ func NewPartSetFromData(data []byte, partSize uint32) *PartSet {
 	}
 }
 
+// minPartsPerHasher is the minimum number of parts each goroutine hashes, so
+// that small part sets are hashed without spawning goroutines.
+const minPartsPerHasher = 4
+
+// partsLeafHashes returns the merkle leaf hashes of the parts, hashed
+// concurrently, which speeds up the part sets of large blocks.
+func partsLeafHashes(partsBytes [][]byte) [][]byte {
+	hashes := make([][]byte, len(partsBytes))
+	hashers := cmtmath.MinInt(runtime.GOMAXPROCS(0), len(partsBytes)/minPartsPerHasher)
+	if hashers <= 1 {
+		for i, bz := range partsBytes {
+			hashes[i] = merkle.LeafHash(bz)
+		}
+		return hashes
+	}
+	var wg sync.WaitGroup
+	for h := 0; h < hashers; h++ {
+		wg.Add(1)
+		go func(h int) {
+			defer wg.Done()
+			for i := h; i < len(partsBytes); i += hashers {
+				hashes[i] = merkle.LeafHash(partsBytes[i])
+			}
+		}(h)
+	}
+	wg.Wait()
+	return hashes
+}
+
+// PartSetWriter builds a PartSet incrementally from the data written to it,
+// e.g. a block while it is serialized: each part is hashed in the background
+// as soon as it is complete, rather than once the whole data is, so that the
+// hashing of the parts of a large block overlaps with its serialization.
+type PartSetWriter struct {
+	partSize uint32
+	buf      []byte // the incomplete last part
+	parts    []*Part
+	size     int64
+
+	hashers chan struct{} // bounds the parts hashed concurrently
+	wg      sync.WaitGroup
+}
+
+var _ io.Writer = (*PartSetWriter)(nil)
+
+// NewPartSetWriter returns a writer building a PartSet from the data written
+// to it, split into partSize chunks.
+// CONTRACT: partSize is greater than zero.
+func NewPartSetWriter(partSize uint32) *PartSetWriter {
+	return &PartSetWriter{
+		partSize: partSize,
+		hashers:  make(chan struct{}, runtime.GOMAXPROCS(0)),
+	}
+}
+
+// Write implements io.Writer. It never fails.
+func (w *PartSetWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		if w.buf == nil {
+			w.buf = make([]byte, 0, w.partSize)
+		}
+		m := cmtmath.MinInt(len(p), int(w.partSize)-len(w.buf))
+		w.buf = append(w.buf, p[:m]...)
+		p = p[m:]
+		if len(w.buf) == int(w.partSize) {
+			w.cutPart()
+		}
+	}
+	w.size += int64(n)
+	return n, nil
+}
+
+// cutPart turns the buffered data into a part, and hashes it in the
+// background.
+func (w *PartSetWriter) cutPart() {
+	//nolint:gosec
+	part := &Part{Index: uint32(len(w.parts)), Bytes: w.buf}
+	w.parts = append(w.parts, part)
+	w.buf = nil
+
+	w.hashers <- struct{}{}
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		part.Proof.LeafHash = merkle.LeafHash(part.Bytes)
+		<-w.hashers
+	}()
+}
+
+// PartSet returns the immutable, full PartSet of the data written, once its
+// parts are hashed. It is the same as NewPartSetFromData would return. The
+// writer must not be used afterwards.
+func (w *PartSetWriter) PartSet() *PartSet {
+	if len(w.buf) > 0 {
+		w.cutPart()
+	}
+	w.wg.Wait()
+
+	//nolint:gosec
+	total := uint32(len(w.parts))
+	leafHashes := make([][]byte, total)
+	partsBitArray := bits.NewBitArray(int(total))
+	for i, part := range w.parts {
+		leafHashes[i] = part.Proof.LeafHash
+		partsBitArray.SetIndex(i, true)
+	}
+	root, proofs := merkle.ProofsFromLeafHashes(leafHashes)
+	if w.parts == nil {
+		w.parts = []*Part{}
+	}
+	for i, part := range w.parts {
+		part.Proof = *proofs[i]
+	}
+	return &PartSet{
+		total:         total,
+		hash:          root,
+		parts:         w.parts,
+		partsBitArray: partsBitArray,
+		count:         total,
+		byteSize:      w.size,
+	}
+}
+
 // Returns an empty PartSet ready to be populated.
 func NewPartSetFromHeader(header PartSetHeader) *PartSet {
 	return &PartSet{
@@ -355,6 +481,26 @@ func (ps *PartSet) GetReader() io.Reader {
 	return NewPartSetReader(ps.parts)
 }
 
+// JoinPartBytes returns the concatenated bytes of the total parts returned by
+// loadPart, e.g. the encoding of a stored block, or nil if one is missing. The
+// buffer is allocated once, rather than grown part by part, as blocks may span
+// hundreds of parts.
+func JoinPartBytes(total uint32, loadPart func(index int) *Part) []byte {
+	parts := make([]*Part, total)
+	size := 0
+	for i := range parts {
+		if parts[i] = loadPart(i); parts[i] == nil {
+			return nil
+		}
+		size += len(parts[i].Bytes)
+	}
+	buf := make([]byte, 0, size)
+	for _, part := range parts {
+		buf = append(buf, part.Bytes...)
+	}
+	return buf
+}
+
 type PartSetReader struct {
 	i      int
 	parts  []*Part
@@ -63,6 +63,58 @@ func TestBasicPartSet(t *testing.T) {
 	assert.Equal(t, data, data2)
 }
 
+func TestPartSetFromDataHash(t *testing.T) {
+	// small part sets are hashed sequentially, large ones concurrently
+	for _, nParts := range []int{1, 3, 8, 33} {
+		data := cmtrand.Bytes(1024*nParts - 1)
+		partSet := NewPartSetFromData(data, 1024)
+
+		partsBytes := make([][]byte, nParts)
+		for i := range partsBytes {
+			partsBytes[i] = partSet.GetPart(i).Bytes
+		}
+		assert.Equal(t, merkle.HashFromByteSlices(partsBytes), partSet.Hash(), nParts)
+		for i := 0; i < nParts; i++ {
+			part := partSet.GetPart(i)
+			assert.NoError(t, part.Proof.Verify(partSet.Hash(), part.Bytes), nParts)
+		}
+	}
+}
+
+func TestPartSetWriter(t *testing.T) {
+	for _, size := range []int{0, 1, 1024, 1024*8 - 1, 1024 * 33} {
+		data := cmtrand.Bytes(size)
+		w := NewPartSetWriter(1024)
+		// write the data in chunks not aligned with the parts
+		for rest := data; len(rest) > 0; {
+			n := cmtrand.Intn(3000) + 1
+			if n > len(rest) {
+				n = len(rest)
+			}
+			_, err := w.Write(rest[:n])
+			require.NoError(t, err)
+			rest = rest[n:]
+		}
+		partSet := w.PartSet()
+
+		expected := NewPartSetFromData(data, 1024)
+		assert.Equal(t, expected.Header(), partSet.Header(), size)
+		assert.Equal(t, expected.ByteSize(), partSet.ByteSize(), size)
+		assert.True(t, partSet.IsComplete(), size)
+		for i := 0; i < int(expected.Total()); i++ {
+			assert.Equal(t, expected.GetPart(i), partSet.GetPart(i), size)
+		}
+	}
+}
+
+func BenchmarkNewPartSetFromData(b *testing.B) {
+	data := cmtrand.Bytes(8 * 1024 * 1024) // 8MB
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = NewPartSetFromData(data, BlockPartSizeBytes)
+	}
+}
+
 func TestWrongProof(t *testing.T) {
 	// Construct random data of size partSize * 100
 	data := cmtrand.Bytes(testPartSize * 100)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/vrf"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 )
 
@@ -19,6 +20,14 @@ type PrivValidator interface {
 	SignProposal(chainID string, proposal *cmtproto.Proposal) error
 }
 
+// VRFPrivValidator is implemented by PrivValidators that can compute VRF
+// proofs with their key. EXPERIMENTAL: nothing in consensus uses VRFs yet.
+type VRFPrivValidator interface {
+	PrivValidator
+
+	ProveVRF(msg []byte) (vrf.Proof, error)
+}
+
 type PrivValidatorsByAddress []PrivValidator
 
 func (pvs PrivValidatorsByAddress) Len() int {
@@ -114,6 +123,11 @@ func (pv MockPV) SignProposal(chainID string, proposal *cmtproto.Proposal) error
 	return nil
 }
 
+// Implements VRFPrivValidator.
+func (pv MockPV) ProveVRF(msg []byte) (vrf.Proof, error) {
+	return vrf.Prove(pv.PrivKey, msg)
+}
+
 func (pv MockPV) ExtractIntoValidator(votingPower int64) *Validator {
 	pubKey, _ := pv.GetPubKey()
 	return &Validator{
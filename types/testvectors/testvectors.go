@@ -0,0 +1,330 @@
+// Package testvectors generates canonical encodings of the consensus-critical
+// data structures, so that client libraries in other languages can check
+// their encoding, hashing and signing against the reference implementation.
+//
+// All the structures are derived deterministically from the chain ID and the
+// height, using fixed timestamps and validator keys, so generating vectors
+// twice with the same inputs yields identical output.
+package testvectors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+)
+
+const (
+	// NumValidators is the number of validators signing the generated
+	// structures.
+	NumValidators = 4
+	// validatorPower is the voting power of each validator.
+	validatorPower = 10
+)
+
+// genesisTime is the timestamp the generated times are derived from.
+var genesisTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Vector is the canonical encoding of a single structure.
+type Vector struct {
+	// Name identifies the vector, e.g. "vote/precommit".
+	Name string `json:"name"`
+	// Type is the fully qualified name of the protobuf message in Proto.
+	Type string `json:"type"`
+	// Value is the structure encoded with the canonical JSON encoding.
+	Value json.RawMessage `json:"value"`
+	// Proto is the protobuf encoding of the structure.
+	Proto cmtbytes.HexBytes `json:"proto"`
+	// SignBytes are the bytes signed by validators, for signable structures.
+	SignBytes cmtbytes.HexBytes `json:"sign_bytes,omitempty"`
+	// Hash is the hash of the structure, for hashable structures.
+	Hash cmtbytes.HexBytes `json:"hash,omitempty"`
+}
+
+// Vectors is a set of test vectors generated for a given chain ID and height.
+type Vectors struct {
+	ChainID string `json:"chain_id"`
+	Height  int64  `json:"height"`
+	// Validators are the ed25519 public keys, in validator set order, which
+	// sign the generated structures. Each validator has a voting power of 10.
+	Validators []crypto.PubKey `json:"validators"`
+	Vectors    []Vector        `json:"vectors"`
+}
+
+// PrivKeys returns the deterministic keys of the validators signing the
+// generated structures.
+func PrivKeys() []crypto.PrivKey {
+	keys := make([]crypto.PrivKey, NumValidators)
+	for i := range keys {
+		keys[i] = ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("testvectors-validator-%d", i)))
+	}
+	return keys
+}
+
+// Generate returns the test vectors of the headers, votes, proposals, commits
+// and evidence for the given chain ID and height.
+func Generate(chainID string, height int64) (*Vectors, error) {
+	if chainID == "" {
+		return nil, errors.New("chain ID can't be empty")
+	}
+	if height < 2 {
+		return nil, errors.New("height must be at least 2")
+	}
+
+	g := newGenerator(chainID, height)
+	header := g.header(tmhash.Sum([]byte("app_hash")))
+	blockID := g.blockID(header)
+	proposal, err := g.proposal(blockID)
+	if err != nil {
+		return nil, err
+	}
+	prevote, err := g.vote(0, cmtproto.PrevoteType, blockID)
+	if err != nil {
+		return nil, err
+	}
+	precommit, err := g.vote(0, cmtproto.PrecommitType, blockID)
+	if err != nil {
+		return nil, err
+	}
+	nilPrecommit, err := g.vote(0, cmtproto.PrecommitType, types.BlockID{})
+	if err != nil {
+		return nil, err
+	}
+	commit, err := g.commit(blockID)
+	if err != nil {
+		return nil, err
+	}
+	dve, err := g.duplicateVoteEvidence(prevote)
+	if err != nil {
+		return nil, err
+	}
+	lcae, err := g.lightClientAttackEvidence()
+	if err != nil {
+		return nil, err
+	}
+
+	vectors := &Vectors{ChainID: chainID, Height: height}
+	for _, val := range g.valSet.Validators {
+		vectors.Validators = append(vectors.Validators, val.PubKey)
+	}
+
+	add := func(name string, value interface{}, pb proto.Message, signBytes, hash []byte) error {
+		v, err := newVector(name, value, pb, signBytes, hash)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		vectors.Vectors = append(vectors.Vectors, v)
+		return nil
+	}
+
+	lcaeProto, err := lcae.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	blockIDProto := blockID.ToProto()
+	proposalProto := proposal.ToProto()
+	prevoteProto := prevote.ToProto()
+	precommitProto := precommit.ToProto()
+	nilPrecommitProto := nilPrecommit.ToProto()
+	for _, err := range []error{
+		add("header", header, header.ToProto(), nil, header.Hash()),
+		add("block_id", blockID, &blockIDProto, nil, nil),
+		add("proposal", proposal, proposalProto, types.ProposalSignBytes(chainID, proposalProto), nil),
+		add("vote/prevote", prevote, prevoteProto, types.VoteSignBytes(chainID, prevoteProto), nil),
+		add("vote/precommit", precommit, precommitProto, types.VoteSignBytes(chainID, precommitProto), nil),
+		add("vote/precommit_nil", nilPrecommit, nilPrecommitProto, types.VoteSignBytes(chainID, nilPrecommitProto), nil),
+		add("commit", commit, commit.ToProto(), nil, commit.Hash()),
+		add("evidence/duplicate_vote", dve, dve.ToProto(), nil, dve.Hash()),
+		add("evidence/light_client_attack", lcae, lcaeProto, nil, lcae.Hash()),
+	} {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return vectors, nil
+}
+
+func newVector(name string, value interface{}, pb proto.Message, signBytes, hash []byte) (Vector, error) {
+	js, err := cmtjson.Marshal(value)
+	if err != nil {
+		return Vector{}, err
+	}
+	bz, err := proto.Marshal(pb)
+	if err != nil {
+		return Vector{}, err
+	}
+	return Vector{
+		Name:      name,
+		Type:      proto.MessageName(pb),
+		Value:     js,
+		Proto:     bz,
+		SignBytes: signBytes,
+		Hash:      hash,
+	}, nil
+}
+
+type generator struct {
+	chainID  string
+	height   int64
+	valSet   *types.ValidatorSet
+	privKeys map[string]crypto.PrivKey // by address
+}
+
+func newGenerator(chainID string, height int64) *generator {
+	g := &generator{
+		chainID:  chainID,
+		height:   height,
+		privKeys: make(map[string]crypto.PrivKey),
+	}
+	vals := make([]*types.Validator, 0, NumValidators)
+	for _, key := range PrivKeys() {
+		pubKey := key.PubKey()
+		g.privKeys[string(pubKey.Address())] = key
+		vals = append(vals, types.NewValidator(pubKey, validatorPower))
+	}
+	g.valSet = types.NewValidatorSet(vals)
+	return g
+}
+
+// time returns the timestamp of the block at the given height.
+func (g *generator) time(height int64) time.Time {
+	return genesisTime.Add(time.Duration(height) * time.Second)
+}
+
+func (g *generator) header(appHash []byte) *types.Header {
+	valHash := g.valSet.Hash()
+	return &types.Header{
+		Version: cmtversion.Consensus{Block: version.BlockProtocol, App: 1},
+		ChainID: g.chainID,
+		Height:  g.height,
+		Time:    g.time(g.height),
+		LastBlockID: types.BlockID{
+			Hash: tmhash.Sum([]byte("last_block")),
+			PartSetHeader: types.PartSetHeader{
+				Total: 1,
+				Hash:  tmhash.Sum([]byte("last_block_parts")),
+			},
+		},
+		LastCommitHash:     tmhash.Sum([]byte("last_commit")),
+		DataHash:           tmhash.Sum([]byte("data")),
+		ValidatorsHash:     valHash,
+		NextValidatorsHash: valHash,
+		ConsensusHash:      types.DefaultConsensusParams().Hash(),
+		AppHash:            appHash,
+		LastResultsHash:    tmhash.Sum([]byte("last_results")),
+		EvidenceHash:       tmhash.Sum([]byte("evidence")),
+		ProposerAddress:    g.valSet.GetProposer().Address,
+	}
+}
+
+func (g *generator) blockID(header *types.Header) types.BlockID {
+	return types.BlockID{
+		Hash: header.Hash(),
+		PartSetHeader: types.PartSetHeader{
+			Total: 1,
+			Hash:  tmhash.Sum([]byte("parts")),
+		},
+	}
+}
+
+func (g *generator) sign(address []byte, signBytes []byte) ([]byte, error) {
+	key, ok := g.privKeys[string(address)]
+	if !ok {
+		return nil, fmt.Errorf("unknown validator %X", address)
+	}
+	return key.Sign(signBytes)
+}
+
+func (g *generator) proposal(blockID types.BlockID) (*types.Proposal, error) {
+	proposal := types.NewProposal(g.height, 0, -1, blockID)
+	proposal.Timestamp = g.time(g.height)
+	sig, err := g.sign(g.valSet.GetProposer().Address, types.ProposalSignBytes(g.chainID, proposal.ToProto()))
+	if err != nil {
+		return nil, err
+	}
+	proposal.Signature = sig
+	return proposal, nil
+}
+
+func (g *generator) vote(valIdx int32, msgType cmtproto.SignedMsgType, blockID types.BlockID) (*types.Vote, error) {
+	addr, _ := g.valSet.GetByIndex(valIdx)
+	vote := &types.Vote{
+		Type:             msgType,
+		Height:           g.height,
+		Round:            0,
+		BlockID:          blockID,
+		Timestamp:        g.time(g.height).Add(time.Duration(valIdx+1) * time.Millisecond),
+		ValidatorAddress: addr,
+		ValidatorIndex:   valIdx,
+	}
+	sig, err := g.sign(addr, types.VoteSignBytes(g.chainID, vote.ToProto()))
+	if err != nil {
+		return nil, err
+	}
+	vote.Signature = sig
+	return vote, nil
+}
+
+func (g *generator) commit(blockID types.BlockID) (*types.Commit, error) {
+	sigs := make([]types.CommitSig, g.valSet.Size())
+	for i := range sigs {
+		vote, err := g.vote(int32(i), cmtproto.PrecommitType, blockID)
+		if err != nil {
+			return nil, err
+		}
+		sigs[i] = vote.CommitSig()
+	}
+	return &types.Commit{
+		Height:     g.height,
+		Round:      0,
+		BlockID:    blockID,
+		Signatures: sigs,
+	}, nil
+}
+
+func (g *generator) duplicateVoteEvidence(vote *types.Vote) (*types.DuplicateVoteEvidence, error) {
+	conflicting, err := g.vote(vote.ValidatorIndex, vote.Type, types.BlockID{
+		Hash: tmhash.Sum([]byte("conflicting_block")),
+		PartSetHeader: types.PartSetHeader{
+			Total: 1,
+			Hash:  tmhash.Sum([]byte("conflicting_parts")),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return types.NewDuplicateVoteEvidence(vote, conflicting, g.time(g.height), g.valSet)
+}
+
+// lightClientAttackEvidence returns evidence of a lunatic attack, in which all
+// the validators signed a header with a different app hash.
+func (g *generator) lightClientAttackEvidence() (*types.LightClientAttackEvidence, error) {
+	header := g.header(tmhash.Sum([]byte("conflicting_app_hash")))
+	commit, err := g.commit(g.blockID(header))
+	if err != nil {
+		return nil, err
+	}
+	ev := &types.LightClientAttackEvidence{
+		ConflictingBlock: &types.LightBlock{
+			SignedHeader: &types.SignedHeader{Header: header, Commit: commit},
+			ValidatorSet: g.valSet,
+		},
+		CommonHeight:        g.height - 1,
+		ByzantineValidators: g.valSet.Copy().Validators,
+		TotalVotingPower:    g.valSet.TotalVotingPower(),
+		Timestamp:           g.time(g.height - 1),
+	}
+	return ev, nil
+}
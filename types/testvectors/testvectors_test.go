@@ -0,0 +1,94 @@
+package testvectors
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	v1, err := Generate("test-chain", 10)
+	require.NoError(t, err)
+	v2, err := Generate("test-chain", 10)
+	require.NoError(t, err)
+
+	bz1, err := json.Marshal(v1)
+	require.NoError(t, err)
+	bz2, err := json.Marshal(v2)
+	require.NoError(t, err)
+	assert.Equal(t, bz1, bz2)
+
+	v3, err := Generate("other-chain", 10)
+	require.NoError(t, err)
+	bz3, err := json.Marshal(v3)
+	require.NoError(t, err)
+	assert.NotEqual(t, bz1, bz3)
+}
+
+func TestGenerateInvalidInput(t *testing.T) {
+	_, err := Generate("", 10)
+	require.Error(t, err)
+	_, err = Generate("test-chain", 1)
+	require.Error(t, err)
+}
+
+func TestGeneratedVectorsVerify(t *testing.T) {
+	const chainID = "test-chain"
+	vectors, err := Generate(chainID, 10)
+	require.NoError(t, err)
+
+	byName := make(map[string]Vector)
+	for _, v := range vectors.Vectors {
+		byName[v.Name] = v
+	}
+
+	var ph cmtproto.Header
+	require.NoError(t, proto.Unmarshal(byName["header"].Proto, &ph))
+	header, err := types.HeaderFromProto(&ph)
+	require.NoError(t, err)
+	require.NoError(t, header.ValidateBasic())
+	assert.EqualValues(t, byName["header"].Hash, header.Hash())
+
+	for _, name := range []string{"vote/prevote", "vote/precommit", "vote/precommit_nil"} {
+		var pv cmtproto.Vote
+		require.NoError(t, proto.Unmarshal(byName[name].Proto, &pv), name)
+		assert.EqualValues(t, byName[name].SignBytes, types.VoteSignBytes(chainID, &pv), name)
+		vote, err := types.VoteFromProto(&pv)
+		require.NoError(t, err, name)
+		require.NoError(t, vote.Verify(chainID, vectors.Validators[vote.ValidatorIndex]), name)
+	}
+
+	var pp cmtproto.Proposal
+	require.NoError(t, proto.Unmarshal(byName["proposal"].Proto, &pp))
+	proposal, err := types.ProposalFromProto(&pp)
+	require.NoError(t, err)
+	assert.EqualValues(t, header.Hash(), proposal.BlockID.Hash)
+
+	var pc cmtproto.Commit
+	require.NoError(t, proto.Unmarshal(byName["commit"].Proto, &pc))
+	commit, err := types.CommitFromProto(&pc)
+	require.NoError(t, err)
+	valSet := types.NewValidatorSet(nil)
+	for _, pubKey := range vectors.Validators {
+		require.NoError(t, valSet.UpdateWithChangeSet([]*types.Validator{types.NewValidator(pubKey, validatorPower)}))
+	}
+	require.NoError(t, valSet.VerifyCommit(chainID, commit.BlockID, commit.Height, commit))
+
+	var pdve cmtproto.DuplicateVoteEvidence
+	require.NoError(t, proto.Unmarshal(byName["evidence/duplicate_vote"].Proto, &pdve))
+	dve, err := types.DuplicateVoteEvidenceFromProto(&pdve)
+	require.NoError(t, err)
+	assert.EqualValues(t, byName["evidence/duplicate_vote"].Hash, dve.Hash())
+
+	var plcae cmtproto.LightClientAttackEvidence
+	require.NoError(t, proto.Unmarshal(byName["evidence/light_client_attack"].Proto, &plcae))
+	lcae, err := types.LightClientAttackEvidenceFromProto(&plcae)
+	require.NoError(t, err)
+	assert.EqualValues(t, byName["evidence/light_client_attack"].Hash, lcae.Hash())
+}
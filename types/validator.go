@@ -8,6 +8,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 	ce "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/crypto/merkle"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 )
@@ -133,6 +134,22 @@ func (v *Validator) Bytes() []byte {
 	return bz
 }
 
+// leafHash returns the merkle leaf hash of Bytes, caching it for the other
+// validators with the same public key and voting power.
+func (v *Validator) leafHash() []byte {
+	key := validatorLeafHashKey{
+		pubKeyType:  v.PubKey.Type(),
+		pubKey:      string(v.PubKey.Bytes()),
+		votingPower: v.VotingPower,
+	}
+	if hash, ok := validatorLeafHashes.Get(key); ok {
+		return hash
+	}
+	hash := merkle.LeafHash(v.Bytes())
+	validatorLeafHashes.Add(key, hash)
+	return hash
+}
+
 // ToProto converts Valiator to protobuf
 func (v *Validator) ToProto() (*cmtproto.Validator, error) {
 	if v == nil {
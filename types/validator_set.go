@@ -10,6 +10,8 @@ import (
 	"sort"
 	"strings"
 
+	lru "github.com/hashicorp/golang-lru/v2"
+
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
@@ -32,6 +34,46 @@ const (
 	PriorityWindowSizeFactor = 2
 )
 
+const (
+	// validatorLeafHashCacheSize bounds the number of validators whose leaf
+	// hash is cached, enough for the validator sets of the current heights of
+	// the largest chains.
+	validatorLeafHashCacheSize = 10000
+
+	// validatorSetHashCacheSize bounds the number of validator set hashes
+	// cached, enough for the current, next and last validator sets of a few
+	// heights.
+	validatorSetHashCacheSize = 16
+)
+
+var (
+	// validatorLeafHashes caches the merkle leaf hash of each validator, so
+	// that only the validators which changed are encoded and hashed again by
+	// ValidatorSet.Hash from one height to the next.
+	validatorLeafHashes = mustNewLRU[validatorLeafHashKey, []byte](validatorLeafHashCacheSize)
+
+	// validatorSetHashes caches the hash of the validator sets by their
+	// concatenated leaf hashes, which are cheaper to look up than the inner
+	// nodes of the merkle tree are to compute.
+	validatorSetHashes = mustNewLRU[string, []byte](validatorSetHashCacheSize)
+)
+
+// validatorLeafHashKey identifies the content of a validator hashed by
+// ValidatorSet.Hash.
+type validatorLeafHashKey struct {
+	pubKeyType  string
+	pubKey      string
+	votingPower int64
+}
+
+func mustNewLRU[K comparable, V any](size int) *lru.Cache[K, V] {
+	cache, err := lru.New[K, V](size)
+	if err != nil {
+		panic(err)
+	}
+	return cache
+}
+
 // ErrTotalVotingPowerOverflow is returned if the total voting power of the
 // resulting validator set exceeds MaxTotalVotingPower.
 var ErrTotalVotingPowerOverflow = fmt.Errorf("total voting power of resulting valset exceeds max %d",
@@ -179,13 +221,14 @@ func (vals *ValidatorSet) RescalePriorities(diffMax int64) {
 }
 
 func (vals *ValidatorSet) incrementProposerPriority() *Validator {
+	var mostest *Validator
 	for _, val := range vals.Validators {
 		// Check for overflow for sum.
 		newPrio := safeAddClip(val.ProposerPriority, val.VotingPower)
 		val.ProposerPriority = newPrio
+		mostest = mostest.CompareProposerPriority(val)
 	}
 	// Decrement the validator with most ProposerPriority.
-	mostest := vals.getValWithMostPriority()
 	// Mind the underflow.
 	mostest.ProposerPriority = safeSubClip(mostest.ProposerPriority, vals.TotalVotingPower())
 
@@ -195,11 +238,28 @@ func (vals *ValidatorSet) incrementProposerPriority() *Validator {
 // Should not be called on an empty validator set.
 func (vals *ValidatorSet) computeAvgProposerPriority() int64 {
 	n := int64(len(vals.Validators))
-	sum := big.NewInt(0)
+	// sum the priorities as int64, falling back to big.Int on overflow
+	var sum int64
+	overflow := false
 	for _, val := range vals.Validators {
-		sum.Add(sum, big.NewInt(val.ProposerPriority))
+		if sum, overflow = safeAdd(sum, val.ProposerPriority); overflow {
+			break
+		}
 	}
-	avg := sum.Div(sum, big.NewInt(n))
+	if !overflow {
+		// round towards negative infinity, as big.Int.Div
+		avg := sum / n
+		if sum%n < 0 {
+			avg--
+		}
+		return avg
+	}
+
+	bigSum := big.NewInt(0)
+	for _, val := range vals.Validators {
+		bigSum.Add(bigSum, big.NewInt(val.ProposerPriority))
+	}
+	avg := bigSum.Div(bigSum, big.NewInt(n))
 	if avg.IsInt64() {
 		return avg.Int64()
 	}
@@ -230,14 +290,6 @@ func computeMaxMinPriorityDiff(vals *ValidatorSet) int64 {
 	return diff
 }
 
-func (vals *ValidatorSet) getValWithMostPriority() *Validator {
-	var res *Validator
-	for _, val := range vals.Validators {
-		res = res.CompareProposerPriority(val)
-	}
-	return res
-}
-
 func (vals *ValidatorSet) shiftByAvgProposerPriority() {
 	if vals.IsNilOrEmpty() {
 		panic("empty validator set")
@@ -253,9 +305,13 @@ func validatorListCopy(valsList []*Validator) []*Validator {
 	if valsList == nil {
 		return nil
 	}
+	// allocate the validators at once, as sets are copied at every height
+	// and round
 	valsCopy := make([]*Validator, len(valsList))
+	vals := make([]Validator, len(valsList))
 	for i, val := range valsList {
-		valsCopy[i] = val.Copy()
+		vals[i] = *val
+		valsCopy[i] = &vals[i]
 	}
 	return valsCopy
 }
@@ -362,12 +418,37 @@ func (vals *ValidatorSet) findProposer() *Validator {
 // set.
 //
 // See merkle.HashFromByteSlices.
+// The leaf hashes of the validators and the hashes of the sets are cached
+// across sets, so that hashing the sets of consecutive heights only hashes
+// the validators which changed.
 func (vals *ValidatorSet) Hash() []byte {
+	if len(vals.Validators) == 0 {
+		return merkle.HashFromByteSlices(nil)
+	}
+	leafHashes := make([][]byte, len(vals.Validators))
+	concat := make([]byte, 0, len(vals.Validators)*tmhash.Size)
+	for i, val := range vals.Validators {
+		leafHashes[i] = val.leafHash()
+		concat = append(concat, leafHashes[i]...)
+	}
+	key := string(concat)
+	if hash, ok := validatorSetHashes.Get(key); ok {
+		return bytes.Clone(hash)
+	}
+	hash := merkle.HashFromLeafHashes(leafHashes)
+	validatorSetHashes.Add(key, hash)
+	return bytes.Clone(hash)
+}
+
+// HashWithProofs returns the same hash as Hash together with a merkle proof
+// of inclusion for every validator, in validator set order. Each proof
+// verifies against the validator's Bytes.
+func (vals *ValidatorSet) HashWithProofs() ([]byte, []*merkle.Proof) {
 	bzs := make([][]byte, len(vals.Validators))
 	for i, val := range vals.Validators {
 		bzs[i] = val.Bytes()
 	}
-	return merkle.HashFromByteSlices(bzs)
+	return merkle.ProofsFromByteSlices(bzs)
 }
 
 // ProposerPriorityHash returns the tmhash of the proposer priorities.
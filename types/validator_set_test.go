@@ -14,6 +14,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/crypto/sr25519"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
@@ -160,6 +161,34 @@ func TestCopy(t *testing.T) {
 	}
 }
 
+func TestValidatorSetHashCached(t *testing.T) {
+	uncachedHash := func(vset *ValidatorSet) []byte {
+		bzs := make([][]byte, len(vset.Validators))
+		for i, val := range vset.Validators {
+			bzs[i] = val.Bytes()
+		}
+		return merkle.HashFromByteSlices(bzs)
+	}
+
+	assert.Equal(t, uncachedHash(NewValidatorSet(nil)), NewValidatorSet(nil).Hash())
+
+	vset := randValidatorSet(10)
+	hash := vset.Hash()
+	assert.Equal(t, uncachedHash(vset), hash)
+	assert.Equal(t, hash, vset.Hash())
+	// the returned hash can be modified
+	hash[0]++
+	assert.Equal(t, uncachedHash(vset), vset.Hash())
+
+	// the validators changing are hashed again
+	vset.Validators[3].VotingPower++
+	assert.Equal(t, uncachedHash(vset), vset.Hash())
+	vset.Validators[5] = randValidator(10)
+	assert.Equal(t, uncachedHash(vset), vset.Hash())
+	vset.Validators = vset.Validators[:1]
+	assert.Equal(t, uncachedHash(vset), vset.Hash())
+}
+
 func TestValidatorSet_ProposerPriorityHash(t *testing.T) {
 	vset := NewValidatorSet(nil)
 	assert.Equal(t, []byte(nil), vset.ProposerPriorityHash())
@@ -216,6 +245,40 @@ func BenchmarkValidatorSetCopy(b *testing.B) {
 	}
 }
 
+func BenchmarkValidatorSetHash(b *testing.B) {
+	b.Run("unchanged", func(b *testing.B) {
+		vset, _ := RandValidatorSet(150, 10)
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			vset.Hash()
+		}
+	})
+
+	b.Run("one change per height", func(b *testing.B) {
+		vset, _ := RandValidatorSet(150, 10)
+		changes := make([]*Validator, b.N)
+		for i := range changes {
+			changes[i] = NewValidator(ed25519.GenPrivKey().PubKey(), 10)
+		}
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			vset.Validators[i%len(vset.Validators)] = changes[i]
+			vset.Hash()
+		}
+	})
+}
+
+func BenchmarkValidatorSetCopyIncrementProposerPriority(b *testing.B) {
+	vset, _ := RandValidatorSet(150, 10)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		vset = vset.CopyIncrementProposerPriority(1)
+	}
+}
+
 //-------------------------------------------------------------------
 
 func TestProposerSelection1(t *testing.T) {
@@ -498,6 +561,14 @@ func TestAvgProposerPriority(t *testing.T) {
 				Validators: []*Validator{{ProposerPriority: math.MinInt64}, {ProposerPriority: math.MinInt64}},
 			}, math.MinInt64,
 		},
+		// rounded towards negative infinity
+		5: {ValidatorSet{Validators: []*Validator{{ProposerPriority: -7}, {ProposerPriority: 0}}}, -4},
+		6: {ValidatorSet{Validators: []*Validator{{ProposerPriority: 7}, {ProposerPriority: 0}}}, 3},
+		7: {
+			ValidatorSet{
+				Validators: []*Validator{{ProposerPriority: math.MinInt64}, {ProposerPriority: -1}, {ProposerPriority: 0}},
+			}, math.MinInt64/3 - 1,
+		},
 	}
 	for i, tc := range tcs {
 		got := tc.vs.computeAvgProposerPriority()